@@ -41,6 +41,7 @@ var cmdFlags struct {
 	BaseInstallerImage    string
 	ImageCache            string
 	EmbeddedConfigPath    string
+	ImageVerificationPath string
 	OutputPath            string
 	OutputKind            string
 	TarToStdout           bool
@@ -215,6 +216,15 @@ var rootCmd = &cobra.Command{
 
 				prof.Customization.EmbeddedMachineConfiguration = string(data)
 			}
+
+			if cmdFlags.ImageVerificationPath != "" {
+				data, err := os.ReadFile(cmdFlags.ImageVerificationPath)
+				if err != nil {
+					return xerrors.NewTaggedf[imager.IOTag]("error reading image verification config file: %w", err)
+				}
+
+				prof.Input.ImageVerificationConfig = string(data)
+			}
 		}
 
 		if err := os.MkdirAll(cmdFlags.OutputPath, 0o755); err != nil {
@@ -307,6 +317,10 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cmdFlags.OverlayImage, "overlay-image", "", "The image reference to the overlay")
 	rootCmd.PersistentFlags().StringArrayVar(&cmdFlags.OverlayOptions, "overlay-option", []string{}, "Extra options to pass to the overlay")
 	rootCmd.PersistentFlags().StringVar(&cmdFlags.EmbeddedConfigPath, "embedded-config-path", "", "Path to a file containing the machine configuration to embed into the image")
+	rootCmd.PersistentFlags().StringVar(
+		&cmdFlags.ImageVerificationPath, "image-verification-config-path", "",
+		"Path to a file containing the ImageVerificationConfig document to verify the system extension images against",
+	)
 	rootCmd.PersistentFlags().BoolVar(
 		&cmdFlags.SecurebootIncludeWellKnownCerts, "secureboot-include-well-known-certs", false, "Include well-known (Microsoft) UEFI certificates when generating a secure boot database",
 	)
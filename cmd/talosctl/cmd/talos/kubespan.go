@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/client/multiplex"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+)
+
+// kubespanCmd represents the kubespan command.
+var kubespanCmd = &cobra.Command{
+	Use:   "kubespan",
+	Short: "Manage KubeSpan",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var kubespanRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Force the rotation of the KubeSpan identity key",
+	Long: `Force the rotation of the KubeSpan identity key.
+
+The next key is announced to the peers via the cluster discovery, and replaces the current key
+after the overlap window, so the connectivity to the peers is preserved during the rotation.
+Rotation progress is reported in the KubeSpanIdentity resource.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		clientFactory, err := NewClientFactory(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		defer clientFactory.Close() //nolint:errcheck
+
+		requested := time.Now().UTC().Format(time.RFC3339)
+
+		respCh := multiplex.UnaryViaFactory(
+			ctx, clientFactory,
+			func(ctx context.Context, c *client.Client) (struct{}, error) {
+				return struct{}{}, c.MetaWrite(ctx, meta.KubeSpanKeyRotation, []byte(requested))
+			},
+		)
+
+		var errs error
+
+		for resp := range respCh {
+			if resp.Err != nil {
+				errs = errors.Join(errs, fmt.Errorf("error requesting KubeSpan key rotation on node %s: %w", resp.Node, resp.Err))
+			}
+		}
+
+		return errs
+	},
+}
+
+func init() {
+	kubespanCmd.AddCommand(kubespanRotateKeyCmd)
+	addCommand(kubespanCmd)
+}
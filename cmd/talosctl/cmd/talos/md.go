@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/client/multiplex"
+)
+
+var mdCmdFlags struct {
+	global.InsecureFlags
+
+	remove     bool
+	syncAction string
+}
+
+// mdCmd represents the md command.
+var mdCmd = &cobra.Command{
+	Use:   "md",
+	Short: "Maintain MD (software RAID) arrays",
+	Long: `Maintain MD (software RAID) arrays.
+
+Array and member arguments are full device paths, e.g. /dev/disk/by-id/md-name-data and /dev/sdc.
+Sync progress, mismatch counts and degraded state are reported in the MDArraySyncStatus resource.`,
+	Args: cobra.NoArgs,
+}
+
+var mdAddSpareCmd = &cobra.Command{
+	Use:   "add-spare <device> <member>",
+	Short: "Add a spare device to an MD array",
+	Long: `Add a spare device to an MD array.
+
+If the array is degraded, rebuilding onto the spare starts right away.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMDCmd(cmd.Context(), args, func(ctx context.Context, c *client.Client) error {
+			return c.MDAddSpare(ctx, &machine.MDAddSpareRequest{
+				Device: args[0],
+				Member: args[1],
+			})
+		})
+	},
+}
+
+var mdReplaceCmd = &cobra.Command{
+	Use:   "replace <device> <member> <replacement>",
+	Short: "Hot-replace a member of an MD array",
+	Long: `Hot-replace a member of an MD array with a new device.
+
+The replacement is rebuilt while the old member stays active. Once the rebuild finishes,
+the old member is marked faulty and can be removed with 'talosctl md fail --remove'.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMDCmd(cmd.Context(), args, func(ctx context.Context, c *client.Client) error {
+			return c.MDReplaceMember(ctx, &machine.MDReplaceMemberRequest{
+				Device:      args[0],
+				Member:      args[1],
+				Replacement: args[2],
+			})
+		})
+	},
+}
+
+var mdFailCmd = &cobra.Command{
+	Use:   "fail <device> <member>",
+	Short: "Mark a member of an MD array faulty",
+	Long:  ``,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMDCmd(cmd.Context(), args, func(ctx context.Context, c *client.Client) error {
+			return c.MDFailMember(ctx, &machine.MDFailMemberRequest{
+				Device: args[0],
+				Member: args[1],
+				Remove: mdCmdFlags.remove,
+			})
+		})
+	},
+}
+
+var mdSyncCmd = &cobra.Command{
+	Use:   "sync <device>",
+	Short: "Start or abort a check or repair of an MD array",
+	Long: `Start or abort a check or repair of an MD array.
+
+'check' counts mismatched blocks between members, 'repair' also rewrites them, 'idle' aborts the running check or repair.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		action, ok := machine.MDSetSyncActionRequest_Action_value[strings.ToUpper(mdCmdFlags.syncAction)]
+		if !ok {
+			return fmt.Errorf("invalid sync action %q", mdCmdFlags.syncAction)
+		}
+
+		return runMDCmd(cmd.Context(), args, func(ctx context.Context, c *client.Client) error {
+			return c.MDSetSyncAction(ctx, &machine.MDSetSyncActionRequest{
+				Device: args[0],
+				Action: machine.MDSetSyncActionRequest_Action(action),
+			})
+		})
+	},
+}
+
+// runMDCmd validates the device arguments and runs the MDService call on every node.
+func runMDCmd(ctx context.Context, devices []string, call func(context.Context, *client.Client) error) error {
+	for _, device := range devices {
+		if !strings.HasPrefix(device, "/dev/") {
+			return fmt.Errorf("device %q must be a full /dev path", device)
+		}
+	}
+
+	clientFactory, err := NewClientFactory(ctx, &mdCmdFlags)
+	if err != nil {
+		return err
+	}
+
+	defer clientFactory.Close() //nolint:errcheck
+
+	responseChan := multiplex.UnaryViaFactory(
+		ctx, clientFactory,
+		func(ctx context.Context, c *client.Client) (struct{}, error) {
+			return struct{}{}, call(ctx, c)
+		},
+	)
+
+	var errs error
+
+	for resp := range responseChan {
+		if resp.Err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error from node %s: %w", resp.Node, resp.Err))
+		}
+	}
+
+	return errs
+}
+
+func init() {
+	mdFailCmd.Flags().BoolVar(&mdCmdFlags.remove, "remove", false, "remove the member from the array after marking it faulty")
+	mdSyncCmd.Flags().StringVar(&mdCmdFlags.syncAction, "action", "check", "sync action to run (check, repair, idle)")
+
+	for _, c := range []*cobra.Command{mdAddSpareCmd, mdReplaceCmd, mdFailCmd, mdSyncCmd} {
+		mdCmdFlags.InsecureFlags.AddFlags(c)
+	}
+
+	mdCmd.AddCommand(mdAddSpareCmd, mdReplaceCmd, mdFailCmd, mdSyncCmd)
+	addCommand(mdCmd)
+}
@@ -52,6 +52,10 @@ func apidMain() error {
 
 	go runDebugServer(ctx)
 
+	metrics := newGRPCMetrics()
+
+	go runMetricsServer(ctx, metrics)
+
 	startup.LimitMaxProcs(constants.ApidMaxProcs)
 
 	runtimeConn, err := grpc.NewClient(
@@ -129,7 +133,7 @@ outerLoop:
 
 			go func() {
 				serviceErrCh <- panicsafe.RunErr(func() error {
-					return runService(serviceCtx, resources, serviceConfig)
+					return runService(serviceCtx, resources, serviceConfig, metrics)
 				})
 			}()
 		}
@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apid
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// grpcMetrics counts the gRPC requests handled by apid.
+//
+// The metrics are served over a unix socket, machined exposes them on the metrics endpoint (see MetricsConfig).
+type grpcMetrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func newGRPCMetrics() *grpcMetrics {
+	m := &grpcMetrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "talos",
+			Subsystem: "apid",
+			Name:      "grpc_requests_total",
+			Help:      "Number of gRPC requests handled by apid, by method and status code.",
+		}, []string{"method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "talos",
+			Subsystem: "apid",
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of gRPC requests handled by apid, by method.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"method"}),
+	}
+
+	m.registry.MustRegister(m.requests, m.duration)
+
+	return m
+}
+
+func (m *grpcMetrics) observe(method string, start time.Time, err error) {
+	code := status.Code(err)
+
+	// apid proxies any method, so don't let unknown method names blow up the label cardinality
+	if code == codes.Unimplemented {
+		method = "unknown"
+	}
+
+	m.requests.WithLabelValues(method, code.String()).Inc()
+	m.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryInterceptor returns the gRPC unary server interceptor recording the metrics.
+func (m *grpcMetrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		m.observe(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns the gRPC stream server interceptor recording the metrics.
+//
+// Proxied requests are handled as streams, so this covers all of the requests routed by apid.
+func (m *grpcMetrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		m.observe(info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// runMetricsServer serves the metrics over the unix socket until the context is canceled.
+func runMetricsServer(ctx context.Context, m *grpcMetrics) {
+	// clean up the socket if it already exists (e.g. apid was restarted)
+	if err := os.RemoveAll(constants.APIMetricsSocketPath); err != nil {
+		log.Printf("failed to remove stale metrics socket: %s", err)
+
+		return
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "unix", constants.APIMetricsSocketPath)
+	if err != nil {
+		log.Printf("failed to listen for metrics: %s", err)
+
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+
+		server.Shutdown(shutdownCtx) //nolint:errcheck
+	}()
+
+	if err = server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("metrics server failed: %s", err)
+	}
+}
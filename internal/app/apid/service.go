@@ -31,7 +31,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
-func runService(ctx context.Context, resources state.State, config *runtime.APIServiceConfig) error {
+func runService(ctx context.Context, resources state.State, config *runtime.APIServiceConfig, metrics *grpcMetrics) error {
 	log.Printf(
 		"starting apid with config: listen address %s, skip client cert verify %v, node routing disabled %v, readonly role mode %v",
 		config.TypedSpec().ListenAddress,
@@ -140,6 +140,8 @@ func runService(ctx context.Context, resources state.State, config *runtime.APIS
 				),
 				grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
 			),
+			factory.WithUnaryInterceptor(metrics.UnaryInterceptor()),
+			factory.WithStreamInterceptor(metrics.StreamInterceptor()),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
 		)
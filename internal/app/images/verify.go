@@ -47,5 +47,10 @@ func (svc *Service) Verify(ctx context.Context, req *machine.ImageServiceVerifyR
 	resolver := image.NewResolver(registries)
 	tagFetcher := image.NewTagFetcher(registries)
 
-	return verify.ImageSignature(ctx, svc.logger, svc.controller.Runtime().State().V1Alpha2().Resources(), resolver, tagFetcher, req.GetImageRef())
+	result, err := verify.ImageSignature(ctx, svc.logger, svc.controller.Runtime().State().V1Alpha2().Resources(), resolver, tagFetcher, req.GetImageRef())
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Response(), nil
 }
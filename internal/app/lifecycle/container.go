@@ -21,6 +21,7 @@ import (
 	"github.com/containerd/containerd/v2/pkg/cio"
 	"github.com/containerd/containerd/v2/pkg/oci"
 	"github.com/containerd/errdefs"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/go-procfs/procfs"
 
@@ -31,12 +32,14 @@ import (
 	containerdrunner "github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/containerd"
 	"github.com/siderolabs/talos/internal/pkg/capability"
 	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
 	"github.com/siderolabs/talos/internal/pkg/environment"
 	"github.com/siderolabs/talos/internal/pkg/install"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	configcore "github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	crires "github.com/siderolabs/talos/pkg/machinery/resources/cri"
 )
 
 func generateContainerID() (string, error) {
@@ -57,6 +60,7 @@ type sendExitCodeFunc func(exitCode int32) error
 // containerRunConfig holds all parameters needed to create and run the installer container.
 type containerRunConfig struct {
 	containerdInst *common.ContainerdInstance
+	resources      state.State
 	imageRef       string
 	disk           string
 	platform       string
@@ -102,6 +106,12 @@ func runInstallerContainer(ctx context.Context, pidRecorder pid.Recorder, rc *co
 		return fmt.Errorf("installer image %q not found in containerd store: %w", rc.imageRef, err)
 	}
 
+	// The image service verifies the installer image when pulling it, but it might as well have been
+	// imported, or pulled before the image verification rules changed: check it as it is in the store.
+	if err = image.VerifyPulled(ctx, crires.RegistryBuilder(rc.resources), rc.resources, img); err != nil {
+		return fmt.Errorf("installer image %q failed verification: %w", rc.imageRef, err)
+	}
+
 	containerID, err := generateContainerID()
 	if err != nil {
 		return fmt.Errorf("failed to generate container ID: %v", err)
@@ -95,6 +95,7 @@ func (s *Service) Install(req *machine.LifecycleServiceInstallRequest, ss grpc.S
 		pid.NewStateRecorder(s.runtime.State().V1Alpha2().Resources()).Record,
 		&containerRunConfig{
 			containerdInst: req.GetContainerd(),
+			resources:      s.runtime.State().V1Alpha2().Resources(),
 			imageRef:       installerImage,
 			disk:           targetDisk,
 			platform:       s.runtime.State().Platform().Name(),
@@ -203,6 +204,7 @@ func (s *Service) Upgrade(req *machine.LifecycleServiceUpgradeRequest, ss grpc.S
 		pid.NewStateRecorder(s.runtime.State().V1Alpha2().Resources()).Record,
 		&containerRunConfig{
 			containerdInst: req.GetContainerd(),
+			resources:      s.runtime.State().V1Alpha2().Resources(),
 			imageRef:       installerImage,
 			disk:           devname,
 			platform:       s.runtime.State().Platform().Name(),
@@ -70,9 +70,12 @@ func (svc *Service) authorize(ctx context.Context) error {
 // with a generic message so operators do not see flags or hints that
 // talosctl does not implement (e.g. `--force twice`).
 func lvmStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		// already a gRPC status (or nil), e.g. a precondition checked by the handler
+		return err
+	}
+
 	switch {
-	case err == nil:
-		return nil
 	case errors.Is(err, lvm.ErrNotFound):
 		return status.Error(codes.NotFound, lvm.ErrNotFound.Error())
 	case errors.Is(err, lvm.ErrInUse):
@@ -94,10 +97,10 @@ func lvmStatus(err error) error {
 	}
 }
 
-// invokeRemove is the shared skeleton for the three remove RPCs: authorize,
-// validate, lazily init the LVM instance, then run the per-RPC action with
-// structured logging and error normalization.
-func (svc *Service) invokeRemove(ctx context.Context, op string, fields []zap.Field, validate func() error, action func(*lvm.LVM) error) (*emptypb.Empty, error) {
+// invoke is the shared skeleton for the mutating RPCs: authorize, validate,
+// lazily init the LVM instance, then run the per-RPC action with structured
+// logging and error normalization.
+func (svc *Service) invoke(ctx context.Context, op, msg string, fields []zap.Field, validate func() error, action func(*lvm.LVM) error) (*emptypb.Empty, error) {
 	if err := svc.authorize(ctx); err != nil {
 		return nil, err
 	}
@@ -111,7 +114,7 @@ func (svc *Service) invokeRemove(ctx context.Context, op string, fields []zap.Fi
 		return nil, status.Errorf(codes.Internal, "failed to initialize LVM: %v", err)
 	}
 
-	svc.logger.Info("removing LVM resource", append([]zap.Field{zap.String("op", op)}, fields...)...)
+	svc.logger.Info(msg, append([]zap.Field{zap.String("op", op)}, fields...)...)
 
 	if err := action(lvmInst); err != nil {
 		svc.logFailure(op, fields, err)
@@ -140,15 +143,15 @@ func (svc *Service) logFailure(op string, fields []zap.Field, err error) {
 		)
 	}
 
-	svc.logger.Error("lvm remove failed", all...)
+	svc.logger.Error("lvm operation failed", all...)
 }
 
 // LogicalVolumeRemove removes an LVM logical volume.
 func (svc *Service) LogicalVolumeRemove(ctx context.Context, req *machine.LVMServiceLogicalVolumeRemoveRequest) (*emptypb.Empty, error) {
 	vg, lv := req.GetVolumeGroup(), req.GetLogicalVolume()
 
-	return svc.invokeRemove(
-		ctx, "lvremove",
+	return svc.invoke(
+		ctx, "lvremove", "removing LVM resource",
 		[]zap.Field{zap.String("vg", vg), zap.String("lv", lv)},
 		func() error {
 			if vg == "" || lv == "" {
@@ -168,8 +171,8 @@ func (svc *Service) LogicalVolumeRemove(ctx context.Context, req *machine.LVMSer
 func (svc *Service) VolumeGroupRemove(ctx context.Context, req *machine.LVMServiceVolumeGroupRemoveRequest) (*emptypb.Empty, error) {
 	vg := req.GetVolumeGroup()
 
-	return svc.invokeRemove(
-		ctx, "vgremove",
+	return svc.invoke(
+		ctx, "vgremove", "removing LVM resource",
 		[]zap.Field{zap.String("vg", vg)},
 		func() error {
 			if vg == "" {
@@ -186,8 +189,8 @@ func (svc *Service) VolumeGroupRemove(ctx context.Context, req *machine.LVMServi
 func (svc *Service) PhysicalVolumeRemove(ctx context.Context, req *machine.LVMServicePhysicalVolumeRemoveRequest) (*emptypb.Empty, error) {
 	device := req.GetDevice()
 
-	return svc.invokeRemove(
-		ctx, "pvremove",
+	return svc.invoke(
+		ctx, "pvremove", "removing LVM resource",
 		[]zap.Field{zap.String("device", device)},
 		func() error {
 			if device == "" {
@@ -199,3 +202,140 @@ func (svc *Service) PhysicalVolumeRemove(ctx context.Context, req *machine.LVMSe
 		func(l *lvm.LVM) error { return l.PVRemove(ctx, device) },
 	)
 }
+
+// findLV returns the LV with the given name in the VG from the current lvs report.
+func findLV(ctx context.Context, l *lvm.LVM, vg, name string) (lvm.LV, error) {
+	lvs, err := l.LVS(ctx)
+	if err != nil {
+		return lvm.LV{}, err
+	}
+
+	for _, lv := range lvs {
+		if lv.VGName == vg && lv.Name == name {
+			return lv, nil
+		}
+	}
+
+	return lvm.LV{}, lvm.ErrNotFound
+}
+
+// LogicalVolumeSnapshotCreate takes a snapshot of an LVM logical volume.
+func (svc *Service) LogicalVolumeSnapshotCreate(ctx context.Context, req *machine.LVMServiceLogicalVolumeSnapshotCreateRequest) (*emptypb.Empty, error) {
+	vg, origin, snapshot, sizeBytes := req.GetVolumeGroup(), req.GetLogicalVolume(), req.GetSnapshot(), req.GetSizeBytes()
+
+	return svc.invoke(
+		ctx, "lvcreate", "creating LVM snapshot",
+		[]zap.Field{zap.String("vg", vg), zap.String("lv", origin), zap.String("snapshot", snapshot), zap.Uint64("size_bytes", sizeBytes)},
+		func() error {
+			if vg == "" || origin == "" || snapshot == "" {
+				return status.Error(codes.InvalidArgument, "volume_group, logical_volume and snapshot must be set")
+			}
+
+			return nil
+		},
+		func(l *lvm.LVM) error {
+			lv, err := findLV(ctx, l, vg, origin)
+			if err != nil {
+				return err
+			}
+
+			// thin snapshots share the pool with the origin, thick ones need their own COW space
+			switch thin := lv.PoolLV != ""; {
+			case thin && sizeBytes != 0:
+				return status.Error(codes.InvalidArgument, "size_bytes must not be set for snapshots of thin logical volumes")
+			case !thin && sizeBytes == 0:
+				return status.Error(codes.InvalidArgument, "size_bytes must be set for snapshots of thick logical volumes")
+			}
+
+			return l.SnapshotCreate(ctx, vg, origin, snapshot, lvm.SnapshotCreateOptions{SizeBytes: sizeBytes})
+		},
+	)
+}
+
+// LogicalVolumeSnapshotList lists the snapshots of LVM logical volumes.
+func (svc *Service) LogicalVolumeSnapshotList(ctx context.Context, req *machine.LVMServiceLogicalVolumeSnapshotListRequest) (*machine.LVMServiceLogicalVolumeSnapshotListResponse, error) {
+	lvmInst, err := svc.lvmInstance()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to initialize LVM: %v", err)
+	}
+
+	lvs, err := lvmInst.LVS(ctx)
+	if err != nil {
+		svc.logFailure("lvs", nil, err)
+
+		return nil, lvmStatus(err)
+	}
+
+	var snapshots []*machine.LVMLogicalVolumeSnapshot
+
+	for _, lv := range lvs {
+		if !lv.IsSnapshot() {
+			continue
+		}
+
+		if req.GetVolumeGroup() != "" && lv.VGName != req.GetVolumeGroup() {
+			continue
+		}
+
+		if req.GetLogicalVolume() != "" && lv.Origin != req.GetLogicalVolume() {
+			continue
+		}
+
+		dataPercent := lv.SnapPercent
+		if lv.PoolLV != "" {
+			dataPercent = lv.DataPercent
+		}
+
+		snapshots = append(snapshots, &machine.LVMLogicalVolumeSnapshot{
+			VolumeGroup: lv.VGName,
+			Name:        lv.Name,
+			Origin:      lv.Origin,
+			Size:        lv.Size,
+			Created:     lv.Time,
+			Thin:        lv.PoolLV != "",
+			DataPercent: dataPercent,
+			Invalid:     lv.SnapshotInvalid == "snapshot invalid",
+			Merging:     lv.Merging == "merging",
+		})
+	}
+
+	return &machine.LVMServiceLogicalVolumeSnapshotListResponse{
+		Messages: []*machine.LVMServiceLogicalVolumeSnapshotList{
+			{
+				Snapshots: snapshots,
+			},
+		},
+	}, nil
+}
+
+// LogicalVolumeSnapshotRollback merges a snapshot back into its origin.
+//
+// See lvm.SnapshotMerge for the deferred merge semantics of an origin in use.
+func (svc *Service) LogicalVolumeSnapshotRollback(ctx context.Context, req *machine.LVMServiceLogicalVolumeSnapshotRollbackRequest) (*emptypb.Empty, error) {
+	vg, snapshot := req.GetVolumeGroup(), req.GetSnapshot()
+
+	return svc.invoke(
+		ctx, "lvconvert", "rolling back to LVM snapshot",
+		[]zap.Field{zap.String("vg", vg), zap.String("snapshot", snapshot)},
+		func() error {
+			if vg == "" || snapshot == "" {
+				return status.Error(codes.InvalidArgument, "volume_group and snapshot must be set")
+			}
+
+			return nil
+		},
+		func(l *lvm.LVM) error {
+			lv, err := findLV(ctx, l, vg, snapshot)
+			if err != nil {
+				return err
+			}
+
+			// lvconvert --merge also accepts other kinds of LVs (e.g. split mirror images)
+			if !lv.IsSnapshot() {
+				return status.Errorf(codes.FailedPrecondition, "%s/%s is not a snapshot", vg, snapshot)
+			}
+
+			return l.SnapshotMerge(ctx, vg, snapshot)
+		},
+	)
+}
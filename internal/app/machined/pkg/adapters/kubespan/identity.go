@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"time"
 
 	"github.com/mdlayher/netx/eui64"
 	"github.com/siderolabs/gen/value"
@@ -16,6 +17,7 @@ import (
 	"go4.org/netipx"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/fipsmode"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -36,26 +38,115 @@ type identity struct {
 
 // GenerateKey generates new Wireguard key.
 func (a identity) GenerateKey(logger *zap.Logger) error {
-	var (
-		key wgtypes.Key
-		err error
-	)
+	privateKey, publicKey, err := generateKeyPair(logger)
+	if err != nil {
+		return err
+	}
+
+	a.IdentitySpec.PrivateKey = privateKey
+	a.IdentitySpec.PublicKey = publicKey
+
+	return nil
+}
+
+func generateKeyPair(logger *zap.Logger) (privateKey, publicKey string, err error) {
+	var key wgtypes.Key
 
 	fipsmode.SkipEnforcement(logger, "kubespan.GenerateKey", func() {
 		key, err = wgtypes.GeneratePrivateKey()
 	})
 
 	if err != nil {
-		return err
+		return "", "", err
 	}
 
-	a.IdentitySpec.PrivateKey = key.String()
-
 	fipsmode.SkipEnforcement(logger, "kubespan.GenerateKey", func() {
-		a.IdentitySpec.PublicKey = key.PublicKey().String()
+		publicKey = key.PublicKey().String()
 	})
 
-	return nil
+	return key.String(), publicKey, nil
+}
+
+// NextKeyRotationEvent returns the time of the next step of the key rotation.
+//
+// If no key rotation steps are planned, false is returned.
+func (a identity) NextKeyRotationEvent(interval time.Duration, requested time.Time) (time.Time, bool) {
+	switch {
+	case a.IdentitySpec.KeyCreated.IsZero():
+		// identity created by an older version of Talos, the key creation time should be recorded
+		return time.Time{}, true
+	case a.IdentitySpec.NextPrivateKey != "":
+		return a.IdentitySpec.NextKeyCreated.Add(constants.KubeSpanKeyRotationOverlap), true
+	case a.IdentitySpec.PreviousPublicKey != "":
+		return a.IdentitySpec.KeyCreated.Add(constants.KubeSpanKeyRotationOverlap), true
+	case requested.After(a.IdentitySpec.KeyCreated):
+		return requested, true
+	case interval > 0:
+		return a.IdentitySpec.KeyCreated.Add(interval - constants.KubeSpanKeyRotationOverlap), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// RotateKey advances the key rotation up to the specified time.
+//
+// The key rotation goes through the following steps:
+//   - the next key is generated and announced to the peers for the overlap time,
+//   - the next key replaces the current key, and the previous key is still announced for the overlap time.
+//
+// The rotation is started when the key gets older than the rotation interval (minus the overlap time),
+// or if the rotation was requested after the current key was put in use.
+//
+// RotateKey returns true if the identity was changed.
+func (a identity) RotateKey(logger *zap.Logger, now time.Time, interval time.Duration, requested time.Time) (bool, error) {
+	changed := false
+
+	for {
+		at, ok := a.NextKeyRotationEvent(interval, requested)
+		if !ok || at.After(now) {
+			return changed, nil
+		}
+
+		changed = true
+
+		switch {
+		case a.IdentitySpec.KeyCreated.IsZero():
+			a.IdentitySpec.KeyCreated = now
+		case a.IdentitySpec.NextPrivateKey != "":
+			logger.Info("rotating KubeSpan key", zap.String("previous_public_key", a.IdentitySpec.PublicKey), zap.String("public_key", a.IdentitySpec.NextPublicKey))
+
+			a.IdentitySpec.PreviousPublicKey = a.IdentitySpec.PublicKey
+			a.IdentitySpec.PrivateKey = a.IdentitySpec.NextPrivateKey
+			a.IdentitySpec.PublicKey = a.IdentitySpec.NextPublicKey
+			a.IdentitySpec.KeyCreated = now
+
+			a.IdentitySpec.NextPrivateKey = ""
+			a.IdentitySpec.NextPublicKey = ""
+			a.IdentitySpec.NextKeyCreated = time.Time{}
+		case a.IdentitySpec.PreviousPublicKey != "":
+			a.IdentitySpec.PreviousPublicKey = ""
+		default:
+			privateKey, publicKey, err := generateKeyPair(logger)
+			if err != nil {
+				return changed, err
+			}
+
+			logger.Info("announcing next KubeSpan key", zap.String("public_key", a.IdentitySpec.PublicKey), zap.String("next_public_key", publicKey))
+
+			a.IdentitySpec.NextPrivateKey = privateKey
+			a.IdentitySpec.NextPublicKey = publicKey
+			a.IdentitySpec.NextKeyCreated = now
+		}
+	}
+}
+
+// AlternatePublicKey returns the public key which should be accepted by the peers in addition to the current one.
+func (a identity) AlternatePublicKey() string {
+	if a.IdentitySpec.NextPublicKey != "" {
+		return a.IdentitySpec.NextPublicKey
+	}
+
+	return a.IdentitySpec.PreviousPublicKey
 }
 
 // UpdateAddress re-calculates node address based on input data.
@@ -7,12 +7,15 @@ package kubespan_test
 import (
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
 
 	kubespanadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/kubespan"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 )
 
@@ -22,6 +25,85 @@ func TestIdentityGenerateKey(t *testing.T) {
 	assert.NoError(t, kubespanadapter.IdentitySpec(&spec).GenerateKey(zap.NewNop()))
 }
 
+func TestIdentityRotateKey(t *testing.T) {
+	var spec kubespan.IdentitySpec
+
+	logger := zaptest.NewLogger(t)
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, kubespanadapter.IdentitySpec(&spec).GenerateKey(logger))
+
+	initialPublicKey := spec.PublicKey
+
+	// key creation time is recorded
+	changed, err := kubespanadapter.IdentitySpec(&spec).RotateKey(logger, now, 0, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, now, spec.KeyCreated)
+
+	_, ok := kubespanadapter.IdentitySpec(&spec).NextKeyRotationEvent(0, time.Time{})
+	assert.False(t, ok)
+
+	// scheduled rotation: next key is announced ahead of the rotation
+	interval := 24 * time.Hour
+
+	at, ok := kubespanadapter.IdentitySpec(&spec).NextKeyRotationEvent(interval, time.Time{})
+	require.True(t, ok)
+	assert.Equal(t, now.Add(interval-constants.KubeSpanKeyRotationOverlap), at)
+
+	changed, err = kubespanadapter.IdentitySpec(&spec).RotateKey(logger, at, interval, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, initialPublicKey, spec.PublicKey)
+	assert.NotEmpty(t, spec.NextPublicKey)
+	assert.Equal(t, spec.NextPublicKey, kubespanadapter.IdentitySpec(&spec).AlternatePublicKey())
+
+	nextPublicKey := spec.NextPublicKey
+
+	// nothing happens until the end of the overlap window
+	changed, err = kubespanadapter.IdentitySpec(&spec).RotateKey(logger, at.Add(time.Minute), interval, time.Time{})
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// next key replaces the current one, previous key is still announced
+	rotatedAt := at.Add(constants.KubeSpanKeyRotationOverlap)
+
+	changed, err = kubespanadapter.IdentitySpec(&spec).RotateKey(logger, rotatedAt, interval, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, nextPublicKey, spec.PublicKey)
+	assert.Equal(t, initialPublicKey, spec.PreviousPublicKey)
+	assert.Empty(t, spec.NextPublicKey)
+	assert.Empty(t, spec.NextPrivateKey)
+	assert.Equal(t, rotatedAt, spec.KeyCreated)
+	assert.Equal(t, initialPublicKey, kubespanadapter.IdentitySpec(&spec).AlternatePublicKey())
+
+	// previous key is dropped after the overlap window
+	changed, err = kubespanadapter.IdentitySpec(&spec).RotateKey(logger, rotatedAt.Add(constants.KubeSpanKeyRotationOverlap), interval, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Empty(t, spec.PreviousPublicKey)
+	assert.Empty(t, kubespanadapter.IdentitySpec(&spec).AlternatePublicKey())
+
+	// forced rotation
+	requested := rotatedAt.Add(time.Hour)
+
+	changed, err = kubespanadapter.IdentitySpec(&spec).RotateKey(logger, requested, interval, requested)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotEmpty(t, spec.NextPublicKey)
+
+	changed, err = kubespanadapter.IdentitySpec(&spec).RotateKey(logger, requested.Add(constants.KubeSpanKeyRotationOverlap), interval, requested)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotEqual(t, nextPublicKey, spec.PublicKey)
+
+	// the request is satisfied
+	at, ok = kubespanadapter.IdentitySpec(&spec).NextKeyRotationEvent(interval, requested)
+	require.True(t, ok)
+	assert.Equal(t, spec.KeyCreated.Add(constants.KubeSpanKeyRotationOverlap), at)
+}
+
 func TestIdentityUpdateAddress(t *testing.T) {
 	var spec kubespan.IdentitySpec
 
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// GeneveSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive
+func GeneveSpec(r *network.GeneveSpec) geneveSpec {
+	return geneveSpec{
+		GeneveSpec: r,
+	}
+}
+
+type geneveSpec struct {
+	*network.GeneveSpec
+}
+
+// Encode the GeneveSpec into netlink attributes.
+func (a geneveSpec) Encode() ([]byte, error) {
+	geneve := a.GeneveSpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_GENEVE_ID, geneve.VNI)
+
+	if geneve.Remote.IsValid() {
+		if geneve.Remote.Is4() {
+			encoder.Bytes(unix.IFLA_GENEVE_REMOTE, geneve.Remote.AsSlice())
+		} else {
+			encoder.Bytes(unix.IFLA_GENEVE_REMOTE6, geneve.Remote.AsSlice())
+		}
+	}
+
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, geneve.Port)
+	encoder.Bytes(unix.IFLA_GENEVE_PORT, buf)
+
+	return encoder.Encode()
+}
+
+// Decode the GeneveSpec from netlink attributes.
+func (a geneveSpec) Decode(data []byte) error {
+	geneve := a.GeneveSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_GENEVE_ID:
+			geneve.VNI = decoder.Uint32()
+		case unix.IFLA_GENEVE_REMOTE, unix.IFLA_GENEVE_REMOTE6:
+			geneve.Remote = decodeTunnelAddr(decoder.Bytes())
+		case unix.IFLA_GENEVE_PORT:
+			geneve.Port = binary.BigEndian.Uint16(decoder.Bytes())
+		}
+	}
+
+	return decoder.Err()
+}
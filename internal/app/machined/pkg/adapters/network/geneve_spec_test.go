@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestGeneveSpec(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []network.GeneveSpec{
+		{
+			VNI:    42,
+			Remote: netip.MustParseAddr("192.168.2.10"),
+			Port:   6081,
+		},
+		{
+			VNI:    100,
+			Remote: netip.MustParseAddr("2001:db8::2"),
+			Port:   6082,
+		},
+	} {
+		b, err := networkadapter.GeneveSpec(&spec).Encode()
+		require.NoError(t, err)
+
+		var decodedSpec network.GeneveSpec
+
+		require.NoError(t, networkadapter.GeneveSpec(&decodedSpec).Decode(b))
+
+		require.Equal(t, spec, decodedSpec)
+	}
+}
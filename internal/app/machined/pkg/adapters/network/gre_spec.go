@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+
+	"github.com/mdlayher/netlink"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// GRE netlink attributes, see include/uapi/linux/if_tunnel.h.
+//
+// Unlike the VXLAN and Geneve ones, golang.org/x/sys/unix does not define these.
+const (
+	iflaGRELink   = 1
+	iflaGREIFlags = 2
+	iflaGREOFlags = 3
+	iflaGREIKey   = 4
+	iflaGREOKey   = 5
+	iflaGRELocal  = 6
+	iflaGRERemote = 7
+)
+
+// greKeyFlag is GRE_KEY from include/uapi/linux/if_tunnel.h, not defined by golang.org/x/sys/unix either.
+const greKeyFlag = 0x2000
+
+// GRESpec adapter provides encoding/decoding to netlink structures.
+//
+// The same attributes are used for gre, gretap, ip6gre and ip6gretap links.
+//
+//nolint:revive
+func GRESpec(r *network.GRESpec) greSpec {
+	return greSpec{
+		GRESpec: r,
+	}
+}
+
+type greSpec struct {
+	*network.GRESpec
+}
+
+// Encode the GRESpec into netlink attributes.
+func (a greSpec) Encode() ([]byte, error) {
+	gre := a.GRESpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	if gre.LinkIndex != 0 {
+		encoder.Uint32(iflaGRELink, gre.LinkIndex)
+	}
+
+	if gre.Local.IsValid() {
+		encoder.Bytes(iflaGRELocal, gre.Local.AsSlice())
+	}
+
+	if gre.Remote.IsValid() {
+		encoder.Bytes(iflaGRERemote, gre.Remote.AsSlice())
+	}
+
+	if gre.Key != 0 {
+		flags := make([]byte, 2)
+		binary.BigEndian.PutUint16(flags, greKeyFlag)
+
+		key := make([]byte, 4)
+		binary.BigEndian.PutUint32(key, gre.Key)
+
+		encoder.Bytes(iflaGREIFlags, flags)
+		encoder.Bytes(iflaGREOFlags, flags)
+		encoder.Bytes(iflaGREIKey, key)
+		encoder.Bytes(iflaGREOKey, key)
+	}
+
+	return encoder.Encode()
+}
+
+// Decode the GRESpec from netlink attributes.
+func (a greSpec) Decode(data []byte) error {
+	gre := a.GRESpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	var (
+		keyed bool
+		key   uint32
+	)
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case iflaGRELink:
+			gre.LinkIndex = decoder.Uint32()
+		case iflaGRELocal:
+			gre.Local = decodeTunnelAddr(decoder.Bytes())
+		case iflaGRERemote:
+			gre.Remote = decodeTunnelAddr(decoder.Bytes())
+		case iflaGREIFlags:
+			keyed = binary.BigEndian.Uint16(decoder.Bytes())&greKeyFlag != 0
+		case iflaGREIKey:
+			key = binary.BigEndian.Uint32(decoder.Bytes())
+		}
+	}
+
+	if keyed {
+		gre.Key = key
+	} else {
+		gre.Key = 0
+	}
+
+	return decoder.Err()
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestGRESpec(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []network.GRESpec{
+		{
+			Local:     netip.MustParseAddr("192.168.1.10"),
+			Remote:    netip.MustParseAddr("192.168.2.10"),
+			Key:       42,
+			LinkIndex: 3,
+		},
+		{
+			Local:  netip.MustParseAddr("2001:db8::1"),
+			Remote: netip.MustParseAddr("2001:db8::2"),
+		},
+		{
+			Remote: netip.MustParseAddr("192.168.2.10"),
+		},
+	} {
+		b, err := networkadapter.GRESpec(&spec).Encode()
+		require.NoError(t, err)
+
+		var decodedSpec network.GRESpec
+
+		require.NoError(t, networkadapter.GRESpec(&decodedSpec).Decode(b))
+
+		require.Equal(t, spec, decodedSpec)
+	}
+}
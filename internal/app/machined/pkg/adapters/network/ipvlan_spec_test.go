@@ -0,0 +1,32 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestIPVLANSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := network.IPVLANSpec{
+		Mode: nethelpers.IPVLANModeL3S,
+	}
+
+	b, err := networkadapter.IPVLANSpec(&spec).Encode()
+	require.NoError(t, err)
+
+	var decodedSpec network.IPVLANSpec
+
+	require.NoError(t, networkadapter.IPVLANSpec(&decodedSpec).Decode(b))
+
+	require.Equal(t, spec, decodedSpec)
+}
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// MACVLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive
+func MACVLANSpec(r *network.MACVLANSpec) macvlanSpec {
+	return macvlanSpec{
+		MACVLANSpec: r,
+	}
+}
+
+type macvlanSpec struct {
+	*network.MACVLANSpec
+}
+
+// Encode the MACVLANSpec into netlink attributes.
+func (a macvlanSpec) Encode() ([]byte, error) {
+	macvlan := a.MACVLANSpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_MACVLAN_MODE, uint32(macvlan.Mode))
+
+	return encoder.Encode()
+}
+
+// Decode the MACVLANSpec from netlink attributes.
+func (a macvlanSpec) Decode(data []byte) error {
+	macvlan := a.MACVLANSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		if decoder.Type() == unix.IFLA_MACVLAN_MODE {
+			macvlan.Mode = nethelpers.MACVLANMode(decoder.Uint32())
+		}
+	}
+
+	return decoder.Err()
+}
@@ -9,9 +9,11 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net"
 	"net/netip"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/google/nftables"
 	"github.com/google/nftables/binaryutil"
@@ -48,8 +50,13 @@ const (
 	SetKindIfName
 	SetKindConntrackState
 	SetKindICMPType
+	SetKindMeterIPv4
+	SetKindMeterIPv6
 )
 
+// MeterSetSize is the maximum number of the sources tracked in a meter set.
+const MeterSetSize = 65535
+
 // NfTablesSet is a compiled representation of the set.
 type NfTablesSet struct {
 	Kind            SetKind
@@ -58,6 +65,8 @@ type NfTablesSet struct {
 	Strings         [][]byte
 	ConntrackStates []nethelpers.ConntrackState
 	ICMPTypes       []nethelpers.ICMPType
+	// Timeout of the dynamic set elements, zero means no timeout.
+	Timeout time.Duration
 }
 
 // IsInterval returns true if the set is an interval set.
@@ -65,19 +74,24 @@ func (set NfTablesSet) IsInterval() bool {
 	switch set.Kind {
 	case SetKindIPv4, SetKindIPv6, SetKindPort:
 		return true
-	case SetKindIfName, SetKindConntrackState, SetKindICMPType:
+	case SetKindIfName, SetKindConntrackState, SetKindICMPType, SetKindMeterIPv4, SetKindMeterIPv6:
 		return false
 	default:
 		panic(fmt.Sprintf("unknown set kind: %d", set.Kind))
 	}
 }
 
+// IsDynamic returns true if the set is populated from the packet path (meter).
+func (set NfTablesSet) IsDynamic() bool {
+	return set.Kind == SetKindMeterIPv4 || set.Kind == SetKindMeterIPv6
+}
+
 // KeyType returns the type of the set.
 func (set NfTablesSet) KeyType() nftables.SetDatatype {
 	switch set.Kind {
-	case SetKindIPv4:
+	case SetKindIPv4, SetKindMeterIPv4:
 		return nftables.TypeIPAddr
-	case SetKindIPv6:
+	case SetKindIPv6, SetKindMeterIPv6:
 		return nftables.TypeIP6Addr
 	case SetKindPort:
 		return nftables.TypeInetService
@@ -190,6 +204,8 @@ func (set NfTablesSet) SetElements() []nftables.SetElement {
 				Key: []byte{byte(t)},
 			}
 		})
+	case SetKindMeterIPv4, SetKindMeterIPv6:
+		return nil
 	default:
 		panic(fmt.Sprintf("unknown set kind: %d", set.Kind))
 	}
@@ -618,6 +634,113 @@ func (a nftablesRule) Compile() (*NfTablesCompiled, error) {
 		)
 	}
 
+	// meterExpression updates the meter set keyed by the source prefix, the stateful expression is evaluated per set element
+	meterExpression := func(kind SetKind, prefixLength int, op uint32, timeout time.Duration, stateful expr.Any) []expr.Any {
+		var (
+			offset, length uint32
+			bits           int
+		)
+
+		switch kind { //nolint:exhaustive
+		case SetKindMeterIPv4:
+			offset, length, bits = 12, 4, 32
+		case SetKindMeterIPv6:
+			offset, length, bits = 8, 16, 128
+		default:
+			panic(fmt.Sprintf("unexpected meter set kind: %d", kind))
+		}
+
+		result.Sets = append(result.Sets,
+			NfTablesSet{
+				Kind:    kind,
+				Timeout: timeout,
+			})
+
+		exprs := []expr.Any{
+			// [ payload load 4b/16b @ network header + <offset> => reg 1 ]
+			&expr.Payload{
+				DestRegister: 1,
+				Base:         expr.PayloadBaseNetworkHeader,
+				Offset:       offset,
+				Len:          length,
+			},
+		}
+
+		if prefixLength < bits {
+			exprs = append(exprs,
+				// [ bitwise reg 1 = ( reg 1 & <mask> ) ^ 0 ]
+				&expr.Bitwise{
+					SourceRegister: 1,
+					DestRegister:   1,
+					Len:            length,
+					Mask:           net.CIDRMask(prefixLength, bits),
+					Xor:            make([]byte, length),
+				},
+			)
+		}
+
+		return append(exprs,
+			// [ dynset add|update reg_key 1 set <set> expr <stateful> ]
+			&expr.Dynset{
+				SrcRegKey: 1,
+				SetID:     uint32(len(result.Sets) - 1), // reference will be fixed up by the controller
+				Operation: op,
+				Exprs:     []expr.Any{stateful},
+			},
+		)
+	}
+
+	if a.NfTablesRule.MatchSourceRateLimit != nil || a.NfTablesRule.MatchSourceConnectionLimit != nil {
+		// meters should only account packets matching the rule, so all the matches collected so far are moved
+		// in front of the meter, which is per IP family
+		if rule4 == nil && rule6 == nil {
+			rule4, rule6 = []expr.Any{}, []expr.Any{}
+		}
+
+		if rule4 != nil {
+			rule4 = append(rule4, rulePost...)
+		}
+
+		if rule6 != nil {
+			rule6 = append(rule6, rulePost...)
+		}
+
+		rulePost = nil
+
+		meter := func(prefixLength4, prefixLength6 int, op uint32, timeout time.Duration, stateful func() expr.Any) {
+			if rule4 != nil {
+				rule4 = append(rule4, meterExpression(SetKindMeterIPv4, prefixLength4, op, timeout, stateful())...)
+			}
+
+			if rule6 != nil {
+				rule6 = append(rule6, meterExpression(SetKindMeterIPv6, prefixLength6, op, timeout, stateful())...)
+			}
+		}
+
+		if match := a.NfTablesRule.MatchSourceRateLimit; match != nil {
+			meter(match.SourcePrefixLength4, match.SourcePrefixLength6, unix.NFT_DYNSET_OP_UPDATE, time.Minute, func() expr.Any {
+				// [ limit rate over <rate>/second burst <burst> ]
+				return &expr.Limit{
+					Type:  expr.LimitTypePkts,
+					Rate:  match.PacketRatePerSecond,
+					Over:  true,
+					Burst: match.Burst,
+					Unit:  expr.LimitTimeSecond,
+				}
+			})
+		}
+
+		if match := a.NfTablesRule.MatchSourceConnectionLimit; match != nil {
+			meter(match.SourcePrefixLength4, match.SourcePrefixLength6, unix.NFT_DYNSET_OP_ADD, 0, func() expr.Any {
+				// [ connlimit count over <count> ]
+				return &expr.Connlimit{
+					Count: match.MaxConnections,
+					Flags: expr.NFT_CONNLIMIT_F_INV,
+				}
+			})
+		}
+	}
+
 	clampMSS := func(family nftables.TableFamily, mtu uint16) []expr.Any {
 		var mss uint16
 
@@ -748,6 +871,59 @@ func (a nftablesRule) Compile() (*NfTablesCompiled, error) {
 		)
 	}
 
+	// NAT statements are terminal, and they go last, after all the matches and the counter.
+	//
+	// NAT to the specific address is only possible for the address family of the address,
+	// so the rule for the other family is dropped.
+	var natPost4, natPost6 []expr.Any
+
+	if a.NfTablesRule.SourceNAT != nil || a.NfTablesRule.DestinationNAT != nil {
+		if a.NfTablesRule.Verdict != nil {
+			return nil, errors.New("NAT rule can't have a verdict")
+		}
+
+		natType, nat := expr.NATTypeSourceNAT, a.NfTablesRule.SourceNAT
+
+		if a.NfTablesRule.DestinationNAT != nil {
+			if nat != nil {
+				return nil, errors.New("rule can't have both source and destination NAT")
+			}
+
+			natType, nat = expr.NATTypeDestNAT, a.NfTablesRule.DestinationNAT
+		}
+
+		switch {
+		case !nat.Address.IsValid() && natType == expr.NATTypeDestNAT:
+			return nil, errors.New("destination NAT requires an address")
+		case !nat.Address.IsValid():
+			rulePost = append(rulePost, masquerade(nat.Port)...)
+		case nat.Address.Is4():
+			if rule4 == nil && rule6 != nil {
+				// this rule matches only IPv6 traffic
+				return &NfTablesCompiled{}, nil
+			}
+
+			if rule4 == nil {
+				rule4 = []expr.Any{}
+			}
+
+			rule6 = nil
+			natPost4 = natTo(natType, unix.NFPROTO_IPV4, nat)
+		default:
+			if rule6 == nil && rule4 != nil {
+				// this rule matches only IPv4 traffic
+				return &NfTablesCompiled{}, nil
+			}
+
+			if rule6 == nil {
+				rule6 = []expr.Any{}
+			}
+
+			rule4 = nil
+			natPost6 = natTo(natType, unix.NFPROTO_IPV6, nat)
+		}
+	}
+
 	// Build v4/v6 rules as requested.
 	//
 	// If there's no IPv4/IPv6 part, generate a single rule.
@@ -759,11 +935,11 @@ func (a nftablesRule) Compile() (*NfTablesCompiled, error) {
 		result.Rules = [][]expr.Any{append(rulePre, rulePost...)}
 	case rule4 != nil && rule6 == nil:
 		result.Rules = [][]expr.Any{
-			slices.Concat(rulePre, matchV4, rule4, rulePost),
+			slices.Concat(rulePre, matchV4, rule4, rulePost, natPost4),
 		}
 	case rule4 == nil && rule6 != nil:
 		result.Rules = [][]expr.Any{
-			slices.Concat(rulePre, matchV6, rule6, rulePost),
+			slices.Concat(rulePre, matchV6, rule6, rulePost, natPost6),
 		}
 	case rule4 != nil && rule6 != nil:
 		result.Rules = [][]expr.Any{
@@ -775,6 +951,62 @@ func (a nftablesRule) Compile() (*NfTablesCompiled, error) {
 	return &result, nil
 }
 
+func natTo(natType expr.NATType, family uint32, nat *network.NfTablesNAT) []expr.Any {
+	addr, _ := nat.Address.MarshalBinary() //nolint:errcheck // doesn't fail
+
+	exprs := []expr.Any{
+		// [ immediate reg 1 <address> ]
+		&expr.Immediate{
+			Register: 1,
+			Data:     addr,
+		},
+	}
+
+	natExpr := &expr.NAT{
+		Type:       natType,
+		Family:     family,
+		RegAddrMin: 1,
+	}
+
+	if nat.Port != 0 {
+		exprs = append(exprs,
+			// [ immediate reg 2 <port> ]
+			&expr.Immediate{
+				Register: 2,
+				Data:     binaryutil.BigEndian.PutUint16(nat.Port),
+			},
+		)
+
+		natExpr.RegProtoMin = 2
+		natExpr.Specified = true
+	}
+
+	// [ nat snat|dnat ip|ip6 addr_min reg 1 (proto_min reg 2) ]
+	return append(exprs, natExpr)
+}
+
+func masquerade(port uint16) []expr.Any {
+	if port == 0 {
+		return []expr.Any{
+			// [ masq ]
+			&expr.Masq{},
+		}
+	}
+
+	return []expr.Any{
+		// [ immediate reg 1 <port> ]
+		&expr.Immediate{
+			Register: 1,
+			Data:     binaryutil.BigEndian.PutUint16(port),
+		},
+		// [ masq proto_min reg 1 ]
+		&expr.Masq{
+			ToPorts:     true,
+			RegProtoMin: 1,
+		},
+	}
+}
+
 func ifname(name string) []byte {
 	b := make([]byte, 16)
 	copy(b, []byte(name))
@@ -7,12 +7,14 @@ package network_test
 import (
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/google/nftables"
 	"github.com/google/nftables/expr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go4.org/netipx"
+	"golang.org/x/sys/unix"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
@@ -765,6 +767,310 @@ func TestNfTablesRuleCompile(t *testing.T) { //nolint:tparallel
 				},
 			},
 		},
+		{
+			name: "source rate limit",
+			spec: networkres.NfTablesRule{
+				MatchLayer4: &networkres.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolTCP,
+					MatchDestinationPort: &networkres.NfTablesPortMatch{
+						Ranges: []networkres.PortRange{
+							{
+								Lo: 50000,
+								Hi: 50000,
+							},
+						},
+					},
+				},
+				MatchSourceRateLimit: &networkres.NfTablesSourceRateLimitMatch{
+					PacketRatePerSecond: 10,
+					Burst:               20,
+					SourcePrefixLength4: 32,
+					SourcePrefixLength6: 64,
+				},
+				AnonCounter: true,
+				Verdict:     new(nethelpers.VerdictDrop),
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{0x6},
+					},
+					&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nftables.TableFamilyIPv4)},
+					},
+					&expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseTransportHeader,
+						Offset:       2,
+						Len:          2,
+					},
+					&expr.Lookup{
+						SourceRegister: 1,
+						SetID:          0,
+					},
+					&expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseNetworkHeader,
+						Offset:       12,
+						Len:          4,
+					},
+					&expr.Dynset{
+						SrcRegKey: 1,
+						SetID:     1,
+						Operation: unix.NFT_DYNSET_OP_UPDATE,
+						Exprs: []expr.Any{
+							&expr.Limit{
+								Type:  expr.LimitTypePkts,
+								Rate:  10,
+								Over:  true,
+								Burst: 20,
+								Unit:  expr.LimitTimeSecond,
+							},
+						},
+					},
+					&expr.Counter{},
+					&expr.Verdict{
+						Kind: expr.VerdictDrop,
+					},
+				},
+				{
+					&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{0x6},
+					},
+					&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nftables.TableFamilyIPv6)},
+					},
+					&expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseTransportHeader,
+						Offset:       2,
+						Len:          2,
+					},
+					&expr.Lookup{
+						SourceRegister: 1,
+						SetID:          0,
+					},
+					&expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseNetworkHeader,
+						Offset:       8,
+						Len:          16,
+					},
+					&expr.Bitwise{
+						SourceRegister: 1,
+						DestRegister:   1,
+						Len:            16,
+						Mask:           []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0, 0, 0, 0, 0},
+						Xor:            make([]byte, 16),
+					},
+					&expr.Dynset{
+						SrcRegKey: 1,
+						SetID:     2,
+						Operation: unix.NFT_DYNSET_OP_UPDATE,
+						Exprs: []expr.Any{
+							&expr.Limit{
+								Type:  expr.LimitTypePkts,
+								Rate:  10,
+								Over:  true,
+								Burst: 20,
+								Unit:  expr.LimitTimeSecond,
+							},
+						},
+					},
+					&expr.Counter{},
+					&expr.Verdict{
+						Kind: expr.VerdictDrop,
+					},
+				},
+			},
+			expectedSets: []network.NfTablesSet{
+				{
+					Kind: network.SetKindPort,
+					Ports: [][2]uint16{
+						{50000, 50000},
+					},
+				},
+				{
+					Kind:    network.SetKindMeterIPv4,
+					Timeout: time.Minute,
+				},
+				{
+					Kind:    network.SetKindMeterIPv6,
+					Timeout: time.Minute,
+				},
+			},
+		},
+		{
+			name: "v4 source connection limit",
+			spec: networkres.NfTablesRule{
+				MatchSourceAddress: &networkres.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("0.0.0.0/0"),
+					},
+				},
+				MatchSourceConnectionLimit: &networkres.NfTablesSourceConnectionLimitMatch{
+					MaxConnections:      100,
+					SourcePrefixLength4: 24,
+					SourcePrefixLength6: 64,
+				},
+				AnonCounter: true,
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nftables.TableFamilyIPv4)},
+					},
+					&expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseNetworkHeader,
+						Offset:       12,
+						Len:          4,
+					},
+					&expr.Bitwise{
+						SourceRegister: 1,
+						DestRegister:   1,
+						Len:            4,
+						Mask:           []byte{0xff, 0xff, 0xff, 0},
+						Xor:            make([]byte, 4),
+					},
+					&expr.Dynset{
+						SrcRegKey: 1,
+						SetID:     0,
+						Operation: unix.NFT_DYNSET_OP_ADD,
+						Exprs: []expr.Any{
+							&expr.Connlimit{
+								Count: 100,
+								Flags: expr.NFT_CONNLIMIT_F_INV,
+							},
+						},
+					},
+					&expr.Counter{},
+				},
+			},
+			expectedSets: []network.NfTablesSet{
+				{
+					Kind: network.SetKindMeterIPv4,
+				},
+			},
+		},
+		{
+			name: "masquerade",
+			spec: networkres.NfTablesRule{
+				MatchOIfName: &networkres.NfTablesIfNameMatch{
+					InterfaceNames: []string{"eth0"},
+					Operator:       nethelpers.OperatorEqual,
+				},
+				SourceNAT: &networkres.NfTablesNAT{},
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte("eth0\000\000\000\000\000\000\000\000\000\000\000\000"),
+					},
+					&expr.Masq{},
+				},
+			},
+		},
+		{
+			name: "dnat v4 with port",
+			spec: networkres.NfTablesRule{
+				MatchLayer4: &networkres.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolTCP,
+					MatchDestinationPort: &networkres.NfTablesPortMatch{
+						Ranges: []networkres.PortRange{
+							{
+								Lo: 8443,
+								Hi: 8443,
+							},
+						},
+					},
+				},
+				DestinationNAT: &networkres.NfTablesNAT{
+					Address: netip.MustParseAddr("10.5.0.10"),
+					Port:    443,
+				},
+			},
+			expectedRules: [][]expr.Any{
+				{
+					&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{0x6},
+					},
+					&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+					&expr.Cmp{
+						Op:       expr.CmpOpEq,
+						Register: 1,
+						Data:     []byte{byte(nftables.TableFamilyIPv4)},
+					},
+					&expr.Payload{
+						DestRegister: 1,
+						Base:         expr.PayloadBaseTransportHeader,
+						Offset:       2,
+						Len:          2,
+					},
+					&expr.Lookup{
+						SourceRegister: 1,
+						SetID:          0,
+					},
+					&expr.Immediate{
+						Register: 1,
+						Data:     []byte{10, 5, 0, 10},
+					},
+					&expr.Immediate{
+						Register: 2,
+						Data:     []byte{0x01, 0xbb},
+					},
+					&expr.NAT{
+						Type:        expr.NATTypeDestNAT,
+						Family:      unix.NFPROTO_IPV4,
+						RegAddrMin:  1,
+						RegProtoMin: 2,
+						Specified:   true,
+					},
+				},
+			},
+			expectedSets: []network.NfTablesSet{
+				{
+					Kind: network.SetKindPort,
+					Ports: [][2]uint16{
+						{8443, 8443},
+					},
+				},
+			},
+		},
+		{
+			name: "snat v6 for v4 source",
+			spec: networkres.NfTablesRule{
+				MatchSourceAddress: &networkres.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("192.168.0.0/16"),
+					},
+				},
+				SourceNAT: &networkres.NfTablesNAT{
+					Address: netip.MustParseAddr("2001:db8::1"),
+				},
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			result, err := network.NfTablesRule(&test.spec).Compile()
@@ -776,6 +1082,49 @@ func TestNfTablesRuleCompile(t *testing.T) { //nolint:tparallel
 	}
 }
 
+func TestNfTablesRuleCompileNATErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		spec networkres.NfTablesRule
+
+		expectedError string
+	}{
+		{
+			name: "nat with verdict",
+			spec: networkres.NfTablesRule{
+				SourceNAT: &networkres.NfTablesNAT{},
+				Verdict:   new(nethelpers.VerdictAccept),
+			},
+			expectedError: "NAT rule can't have a verdict",
+		},
+		{
+			name: "snat and dnat",
+			spec: networkres.NfTablesRule{
+				SourceNAT:      &networkres.NfTablesNAT{},
+				DestinationNAT: &networkres.NfTablesNAT{Address: netip.MustParseAddr("10.5.0.10")},
+			},
+			expectedError: "rule can't have both source and destination NAT",
+		},
+		{
+			name: "dnat without address",
+			spec: networkres.NfTablesRule{
+				DestinationNAT: &networkres.NfTablesNAT{Port: 80},
+			},
+			expectedError: "destination NAT requires an address",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := network.NfTablesRule(&test.spec).Compile()
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
 func TestNftablesSet(t *testing.T) { //nolint:tparallel
 	t.Parallel()
 
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/binary"
+	"net/netip"
+
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// VXLANSpec adapter provides encoding/decoding to netlink structures.
+//
+//nolint:revive
+func VXLANSpec(r *network.VXLANSpec) vxlanSpec {
+	return vxlanSpec{
+		VXLANSpec: r,
+	}
+}
+
+type vxlanSpec struct {
+	*network.VXLANSpec
+}
+
+// Encode the VXLANSpec into netlink attributes.
+func (a vxlanSpec) Encode() ([]byte, error) {
+	vxlan := a.VXLANSpec
+
+	encoder := netlink.NewAttributeEncoder()
+
+	encoder.Uint32(unix.IFLA_VXLAN_ID, vxlan.VNI)
+
+	if vxlan.LinkIndex != 0 {
+		encoder.Uint32(unix.IFLA_VXLAN_LINK, vxlan.LinkIndex)
+	}
+
+	if vxlan.Local.IsValid() {
+		if vxlan.Local.Is4() {
+			encoder.Bytes(unix.IFLA_VXLAN_LOCAL, vxlan.Local.AsSlice())
+		} else {
+			encoder.Bytes(unix.IFLA_VXLAN_LOCAL6, vxlan.Local.AsSlice())
+		}
+	}
+
+	if vxlan.Remote.IsValid() {
+		if vxlan.Remote.Is4() {
+			encoder.Bytes(unix.IFLA_VXLAN_GROUP, vxlan.Remote.AsSlice())
+		} else {
+			encoder.Bytes(unix.IFLA_VXLAN_GROUP6, vxlan.Remote.AsSlice())
+		}
+	}
+
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, vxlan.Port)
+	encoder.Bytes(unix.IFLA_VXLAN_PORT, buf)
+
+	return encoder.Encode()
+}
+
+// Decode the VXLANSpec from netlink attributes.
+func (a vxlanSpec) Decode(data []byte) error {
+	vxlan := a.VXLANSpec
+
+	decoder, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	for decoder.Next() {
+		switch decoder.Type() {
+		case unix.IFLA_VXLAN_ID:
+			vxlan.VNI = decoder.Uint32()
+		case unix.IFLA_VXLAN_LINK:
+			vxlan.LinkIndex = decoder.Uint32()
+		case unix.IFLA_VXLAN_LOCAL, unix.IFLA_VXLAN_LOCAL6:
+			vxlan.Local = decodeTunnelAddr(decoder.Bytes())
+		case unix.IFLA_VXLAN_GROUP, unix.IFLA_VXLAN_GROUP6:
+			vxlan.Remote = decodeTunnelAddr(decoder.Bytes())
+		case unix.IFLA_VXLAN_PORT:
+			vxlan.Port = binary.BigEndian.Uint16(decoder.Bytes())
+		}
+	}
+
+	return decoder.Err()
+}
+
+// decodeTunnelAddr decodes a tunnel endpoint address, mapping the unspecified address to an unset one.
+func decodeTunnelAddr(b []byte) netip.Addr {
+	addr, ok := netip.AddrFromSlice(b)
+	if !ok || addr.IsUnspecified() {
+		return netip.Addr{}
+	}
+
+	return addr
+}
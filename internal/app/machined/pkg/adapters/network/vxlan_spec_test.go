@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestVXLANSpec(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []network.VXLANSpec{
+		{
+			VNI:       42,
+			Local:     netip.MustParseAddr("192.168.1.10"),
+			Remote:    netip.MustParseAddr("239.1.1.1"),
+			Port:      4789,
+			LinkIndex: 3,
+		},
+		{
+			VNI:    16777215,
+			Local:  netip.MustParseAddr("2001:db8::1"),
+			Remote: netip.MustParseAddr("2001:db8::2"),
+			Port:   8472,
+		},
+		{
+			VNI:  1,
+			Port: 4789,
+		},
+	} {
+		b, err := networkadapter.VXLANSpec(&spec).Encode()
+		require.NoError(t, err)
+
+		var decodedSpec network.VXLANSpec
+
+		require.NoError(t, networkadapter.VXLANSpec(&decodedSpec).Decode(b))
+
+		require.Equal(t, spec, decodedSpec)
+	}
+}
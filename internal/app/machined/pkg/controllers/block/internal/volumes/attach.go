@@ -0,0 +1,392 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package volumes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/iscsi"
+	"github.com/siderolabs/talos/internal/pkg/nvmeof"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+const attachTimeout = time.Minute
+
+// Attacher runs the attaches of the network targets in the background.
+//
+// Logging into a remote target might take up to attachTimeout, so the attaches are run off the
+// volume manager reconcile loop, and the loop is notified via notifyCh once an attach finishes.
+type Attacher struct {
+	ctx      context.Context //nolint:containedctx
+	cancel   context.CancelFunc
+	notifyCh chan<- struct{}
+	wg       sync.WaitGroup
+
+	mu          sync.Mutex
+	attachments map[string]*attachment
+}
+
+// attachment tracks the attach of a single volume.
+type attachment struct {
+	key    attachKey
+	cancel context.CancelFunc
+	done   chan struct{}
+	result attachResult
+}
+
+// attachKey is what the attach depends on: if it changes, the attach is restarted.
+type attachKey struct {
+	target   block.NetworkTargetSpec
+	identity NetworkIdentity
+}
+
+// attachResult is the outcome of the attach.
+type attachResult struct {
+	// location is the block device of the attached target.
+	location string
+	// addr is the resolved address of the NFS server.
+	addr netip.Addr
+	// missing is set if the target is attached, but the volume (LUN, namespace) is not found.
+	missing bool
+	err     error
+}
+
+type attachFunc func(ctx context.Context, logger *zap.Logger, key attachKey) attachResult
+
+// NewAttacher creates a new Attacher.
+func NewAttacher(ctx context.Context, notifyCh chan<- struct{}) *Attacher {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &Attacher{
+		ctx:         ctx,
+		cancel:      cancel,
+		notifyCh:    notifyCh,
+		attachments: map[string]*attachment{},
+	}
+}
+
+// Close aborts all in-flight attaches and waits for them to finish.
+func (a *Attacher) Close() {
+	a.cancel()
+	a.wg.Wait()
+}
+
+// run returns the result of the attach of the volume, starting the attach if needed.
+//
+// The second return value is false while the attach is in progress.
+// Retryable failures are forgotten once returned, so that the next call starts a fresh attach.
+func (a *Attacher) run(logger *zap.Logger, volumeID string, key attachKey, fn attachFunc) (attachResult, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if att, ok := a.attachments[volumeID]; ok {
+		if att.key == key {
+			select {
+			case <-att.done:
+				if att.result.err != nil && xerrors.TagIs[Retryable](att.result.err) {
+					delete(a.attachments, volumeID)
+				}
+
+				return att.result, true
+			default:
+				return attachResult{}, false
+			}
+		}
+
+		// the target or the identity has changed, abandon the previous attach
+		att.cancel()
+		delete(a.attachments, volumeID)
+	}
+
+	ctx, cancel := context.WithTimeout(a.ctx, attachTimeout)
+
+	att := &attachment{
+		key:    key,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	a.attachments[volumeID] = att
+
+	a.wg.Go(func() {
+		defer cancel()
+
+		att.result = fn(ctx, logger, key)
+		close(att.done)
+
+		channel.SendWithContext(a.ctx, a.notifyCh, struct{}{})
+	})
+
+	return attachResult{}, false
+}
+
+// forget aborts the attach of the volume (if any) and waits for it to finish.
+func (a *Attacher) forget(volumeID string) {
+	a.mu.Lock()
+	att, ok := a.attachments[volumeID]
+	delete(a.attachments, volumeID)
+	a.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	att.cancel()
+	<-att.done
+}
+
+// Attach attaches the network target of the external volume, so that it can be mounted.
+//
+// For NFS, the server address is resolved (the kernel doesn't do name resolution);
+// for iSCSI and NVMe/TCP the target is logged into and the block device is located.
+// The attach runs in the background (see Attacher), the volume stays in the waiting phase until it finishes.
+//
+// Returns true if the volume has a network target and it was handled.
+func Attach(logger *zap.Logger, vc ManagerContext) (bool, error) {
+	spec := vc.Cfg.TypedSpec()
+
+	if spec.Type != block.VolumeTypeExternal {
+		return false, nil
+	}
+
+	target := spec.Provisioning.DiskSelector.NetworkTarget
+
+	var fn attachFunc
+
+	switch target.Type {
+	case block.NetworkTargetTypeNone:
+		return false, nil
+	case block.NetworkTargetTypeNFS:
+		fn = attachNFS
+	case block.NetworkTargetTypeISCSI:
+		if vc.NetworkIdentity.InitiatorName == "" {
+			vc.Status.Phase = block.VolumePhaseWaiting
+
+			return true, nil
+		}
+
+		fn = attachISCSI
+	case block.NetworkTargetTypeNVMETCP:
+		if vc.NetworkIdentity.HostNQN == "" || vc.NetworkIdentity.HostID == "" {
+			vc.Status.Phase = block.VolumePhaseWaiting
+
+			return true, nil
+		}
+
+		fn = attachNVMeTCP
+	default:
+		return true, fmt.Errorf("unsupported network target type %s", target.Type)
+	}
+
+	result, done := vc.Attacher.run(logger, vc.Cfg.Metadata().ID(), attachKey{target: target, identity: vc.NetworkIdentity}, fn)
+	if !done {
+		vc.Status.Phase = block.VolumePhaseWaiting
+
+		return true, nil
+	}
+
+	if result.missing {
+		vc.Status.Phase = block.VolumePhaseMissing
+	}
+
+	if result.err != nil {
+		return true, result.err
+	}
+
+	if target.Type == block.NetworkTargetTypeNFS {
+		vc.Status.MountSpec.Parameters = append(
+			slices.Clone(vc.Status.MountSpec.Parameters),
+			block.NewStringParameter("addr", result.addr.String()),
+		)
+
+		result.location = spec.Provisioning.DiskSelector.External
+	}
+
+	setAttachedStatus(vc, result.location)
+
+	return true, nil
+}
+
+func attachNFS(ctx context.Context, _ *zap.Logger, key attachKey) attachResult {
+	addr, err := resolveAddress(ctx, key.target.Address)
+	if err != nil {
+		return attachResult{err: xerrors.NewTaggedf[Retryable]("error resolving NFS server %q: %w", key.target.Address, err)}
+	}
+
+	return attachResult{addr: addr}
+}
+
+func attachISCSI(ctx context.Context, logger *zap.Logger, key attachKey) attachResult {
+	target := key.target
+	iscsiTarget := iscsiTargetFromSpec(target)
+
+	initiator, err := iscsi.New(iscsi.WithInitiatorName(key.identity.InitiatorName))
+	if err != nil {
+		return attachResult{err: fmt.Errorf("error attaching iSCSI target %q: %w", target.Name, err)}
+	}
+
+	if err = initiator.Login(ctx, iscsiTarget); err != nil {
+		if errors.Is(err, iscsi.ErrLogin) {
+			return attachResult{err: fmt.Errorf("error logging into iSCSI target %q: %w", target.Name, err)}
+		}
+
+		return attachResult{err: xerrors.NewTaggedf[Retryable]("error logging into iSCSI target %q: %w", target.Name, err)}
+	}
+
+	session, err := iscsi.FindSession(iscsiTarget)
+	if err != nil {
+		return attachResult{err: xerrors.NewTaggedf[Retryable]("error finding iSCSI session for %q: %w", target.Name, err)}
+	}
+
+	dev, err := iscsi.FindLUN(session, target.Unit)
+	if err != nil {
+		if errors.Is(err, iscsi.ErrNotFound) {
+			return attachResult{
+				missing: true,
+				err:     xerrors.NewTaggedf[Retryable]("LUN %d of iSCSI target %q not found", target.Unit, target.Name),
+			}
+		}
+
+		return attachResult{err: err}
+	}
+
+	logger.Info("iSCSI target attached", zap.String("target", target.Name), zap.String("session", session), zap.String("device", dev))
+
+	return attachResult{location: dev}
+}
+
+func attachNVMeTCP(_ context.Context, logger *zap.Logger, key attachKey) attachResult {
+	target := key.target
+
+	controller, err := nvmeof.Connect(nvmeTargetFromSpec(target), nvmeof.Host{
+		NQN: key.identity.HostNQN,
+		ID:  key.identity.HostID,
+	})
+	if err != nil {
+		return attachResult{err: xerrors.NewTaggedf[Retryable]("error connecting to NVMe subsystem %q: %w", target.Name, err)}
+	}
+
+	dev, err := nvmeof.FindNamespace(target.Name, target.Unit)
+	if err != nil {
+		if errors.Is(err, nvmeof.ErrNotFound) {
+			return attachResult{
+				missing: true,
+				err:     xerrors.NewTaggedf[Retryable]("namespace %d of NVMe subsystem %q not found", target.Unit, target.Name),
+			}
+		}
+
+		return attachResult{err: err}
+	}
+
+	logger.Info("NVMe/TCP subsystem attached", zap.String("subsystem", target.Name), zap.String("controller", controller), zap.String("device", dev))
+
+	return attachResult{location: dev}
+}
+
+// Detach detaches the network target of the external volume.
+func Detach(ctx context.Context, logger *zap.Logger, vc ManagerContext) error {
+	target := vc.Cfg.TypedSpec().Provisioning.DiskSelector.NetworkTarget
+
+	// an in-flight attach might log in after the logout below, so abort it first
+	vc.Attacher.forget(vc.Cfg.Metadata().ID())
+
+	ctx, cancel := context.WithTimeout(ctx, attachTimeout)
+	defer cancel()
+
+	switch target.Type {
+	case block.NetworkTargetTypeNone, block.NetworkTargetTypeNFS:
+		// nothing to detach
+	case block.NetworkTargetTypeISCSI:
+		initiator, err := iscsi.New()
+		if err != nil {
+			if errors.Is(err, iscsi.ErrNotInstalled) {
+				// nothing could have been attached without iscsiadm
+				return nil
+			}
+
+			return err
+		}
+
+		if err = initiator.Logout(ctx, iscsiTargetFromSpec(target)); err != nil {
+			return xerrors.NewTaggedf[Retryable]("error logging out of iSCSI target %q: %w", target.Name, err)
+		}
+
+		logger.Info("iSCSI target detached", zap.String("target", target.Name))
+	case block.NetworkTargetTypeNVMETCP:
+		controller, err := nvmeof.FindController(nvmeTargetFromSpec(target))
+		if err != nil {
+			if errors.Is(err, nvmeof.ErrNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = nvmeof.Disconnect(controller); err != nil {
+			return xerrors.NewTaggedf[Retryable]("error disconnecting from NVMe subsystem %q: %w", target.Name, err)
+		}
+
+		logger.Info("NVMe/TCP subsystem detached", zap.String("subsystem", target.Name), zap.String("controller", controller))
+	}
+
+	return nil
+}
+
+func setAttachedStatus(vc ManagerContext, location string) {
+	vc.Status.Phase = block.VolumePhaseReady
+	vc.Status.Filesystem = vc.Cfg.TypedSpec().Provisioning.FilesystemSpec.Type
+	vc.Status.Location = location
+	vc.Status.MountLocation = location
+}
+
+func iscsiTargetFromSpec(target block.NetworkTargetSpec) iscsi.Target {
+	return iscsi.Target{
+		Portal: target.Address,
+		Port:   target.Port,
+		Name:   target.Name,
+	}
+}
+
+func nvmeTargetFromSpec(target block.NetworkTargetSpec) nvmeof.Target {
+	return nvmeof.Target{
+		Transport:    nvmeof.TransportTCP,
+		Address:      target.Address,
+		Port:         target.Port,
+		SubsystemNQN: target.Name,
+	}
+}
+
+// resolveAddress resolves the host to an IP address, preferring IPv4.
+func resolveAddress(ctx context.Context, host string) (netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if len(addrs) == 0 {
+		return netip.Addr{}, fmt.Errorf("no addresses found for %q", host)
+	}
+
+	slices.SortStableFunc(addrs, func(a, b netip.Addr) int {
+		return cmpBool(!a.Is4(), !b.Is4())
+	})
+
+	return addrs[0].Unmap(), nil
+}
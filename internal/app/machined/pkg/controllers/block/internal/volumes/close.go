@@ -18,7 +18,16 @@ import (
 // Close the encrypted volumes.
 func Close(ctx context.Context, logger *zap.Logger, volumeContext ManagerContext) error {
 	switch volumeContext.Cfg.TypedSpec().Type {
-	case block.VolumeTypeTmpfs, block.VolumeTypeDirectory, block.VolumeTypeSymlink, block.VolumeTypeOverlay, block.VolumeTypeExternal:
+	case block.VolumeTypeExternal:
+		// network targets should be detached once the volume is unmounted
+		if err := Detach(ctx, logger, volumeContext); err != nil {
+			return err
+		}
+
+		volumeContext.Status.Phase = block.VolumePhaseClosed
+
+		return nil
+	case block.VolumeTypeTmpfs, block.VolumeTypeDirectory, block.VolumeTypeSymlink, block.VolumeTypeOverlay:
 		// volume types can be always closed
 		volumeContext.Status.Phase = block.VolumePhaseClosed
 
@@ -25,18 +25,23 @@ func LocateAndProvision(ctx context.Context, logger *zap.Logger, vc ManagerConte
 	vc.Status.MountSpec = vc.Cfg.TypedSpec().Mount
 	vc.Status.SymlinkSpec = vc.Cfg.TypedSpec().Symlink
 
-	// 2. Handle simple types (Tmpfs, Overlay, External, etc.)
+	// 2. Attach network targets of external volumes (NFS, iSCSI, NVMe/TCP)
+	if attached, err := Attach(logger, vc); attached || err != nil {
+		return err
+	}
+
+	// 3. Handle simple types (Tmpfs, Overlay, External, etc.)
 	// If handled, we return early.
 	if done := handleSimpleVolumeTypes(vc); done {
 		return nil
 	}
 
-	// 3. Validation for Disk/Partition types
+	// 4. Validation for Disk/Partition types
 	if value.IsZero(vc.Cfg.TypedSpec().Locator) {
 		return fmt.Errorf("volume locator is not set")
 	}
 
-	// 4. Attempt to locate an existing volume
+	// 5. Attempt to locate an existing volume
 	located, err := locateExistingVolume(vc)
 	if err != nil {
 		return err
@@ -46,7 +51,7 @@ func LocateAndProvision(ctx context.Context, logger *zap.Logger, vc ManagerConte
 		return nil
 	}
 
-	// 5. Handle Waiting State
+	// 6. Handle Waiting State
 	// If not found and devices aren't ready, we must wait.
 	if !vc.DevicesReady {
 		vc.Status.Phase = block.VolumePhaseWaiting
@@ -54,7 +59,7 @@ func LocateAndProvision(ctx context.Context, logger *zap.Logger, vc ManagerConte
 		return nil
 	}
 
-	// 6. Provision new volume
+	// 7. Provision new volume
 	return provisionNewVolume(ctx, logger, vc)
 }
 
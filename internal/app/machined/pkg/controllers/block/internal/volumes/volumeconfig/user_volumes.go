@@ -246,7 +246,8 @@ func ExternalVolumeTransformer(c configconfig.Config) ([]VolumeResource, error)
 				WithProvisioning(block.ProvisioningSpec{
 					Wave: block.WaveUserVolumes,
 					DiskSelector: block.DiskSelector{
-						External: externalVolumeSource(externalVolumeConfig),
+						External:      externalVolumeSource(externalVolumeConfig),
+						NetworkTarget: externalVolumeNetworkTarget(externalVolumeConfig),
 					},
 					FilesystemSpec: block.FilesystemSpec{
 						Type: externalVolumeConfig.Type(),
@@ -276,7 +277,13 @@ func externalVolumeSource(ext configconfig.ExternalVolumeConfig) string {
 			return ext.Mount().Virtiofs().ValueOrZero().Source()
 		}
 
+	case block.FilesystemTypeNFS:
+		if ext.Mount().NFS().IsPresent() {
+			return ext.Mount().NFS().ValueOrZero().Source()
+		}
+
 	case block.FilesystemTypeNone, block.FilesystemTypeXFS, block.FilesystemTypeVFAT, block.FilesystemTypeEXT4, block.FilesystemTypeISO9660, block.FilesystemTypeSwap, block.FilesystemTypeBtrfs:
+		// block devices are located once the network target is attached
 		fallthrough
 
 	default:
@@ -286,6 +293,37 @@ func externalVolumeSource(ext configconfig.ExternalVolumeConfig) string {
 	return ""
 }
 
+func externalVolumeNetworkTarget(ext configconfig.ExternalVolumeConfig) block.NetworkTargetSpec {
+	if nfs, ok := ext.Mount().NFS().Get(); ok && ext.Type() == block.FilesystemTypeNFS {
+		return block.NetworkTargetSpec{
+			Type:    block.NetworkTargetTypeNFS,
+			Address: nfs.Server(),
+		}
+	}
+
+	if iscsi, ok := ext.Mount().ISCSI().Get(); ok {
+		return block.NetworkTargetSpec{
+			Type:    block.NetworkTargetTypeISCSI,
+			Address: iscsi.Portal(),
+			Port:    iscsi.Port(),
+			Name:    iscsi.Target(),
+			Unit:    iscsi.LUN(),
+		}
+	}
+
+	if nvme, ok := ext.Mount().NVMeTCP().Get(); ok {
+		return block.NetworkTargetSpec{
+			Type:    block.NetworkTargetTypeNVMETCP,
+			Address: nvme.Address(),
+			Port:    nvme.Port(),
+			Name:    nvme.SubsystemNQN(),
+			Unit:    nvme.NamespaceID(),
+		}
+	}
+
+	return block.NetworkTargetSpec{}
+}
+
 func externalVolumeParameters(ext configconfig.ExternalVolumeConfig) ([]block.ParameterSpec, error) {
 	switch ext.Type() {
 	case block.FilesystemTypeVirtiofs:
@@ -295,7 +333,21 @@ func externalVolumeParameters(ext configconfig.ExternalVolumeConfig) ([]block.Pa
 
 		return nil, errors.New("virtiofs mount specification is required for Virtiofs external volume")
 
-	case block.FilesystemTypeNone, block.FilesystemTypeXFS, block.FilesystemTypeVFAT, block.FilesystemTypeEXT4, block.FilesystemTypeISO9660, block.FilesystemTypeSwap, block.FilesystemTypeBtrfs:
+	case block.FilesystemTypeNFS:
+		if ext.Mount().NFS().IsPresent() {
+			return ext.Mount().NFS().ValueOrZero().Parameters()
+		}
+
+		return nil, errors.New("nfs mount specification is required for NFS external volume")
+
+	case block.FilesystemTypeXFS, block.FilesystemTypeEXT4, block.FilesystemTypeBtrfs:
+		if ext.Mount().ISCSI().IsPresent() || ext.Mount().NVMeTCP().IsPresent() {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("iscsi or nvmeTCP mount specification is required for %s external volume", ext.Type())
+
+	case block.FilesystemTypeNone, block.FilesystemTypeVFAT, block.FilesystemTypeISO9660, block.FilesystemTypeSwap:
 		fallthrough
 
 	default:
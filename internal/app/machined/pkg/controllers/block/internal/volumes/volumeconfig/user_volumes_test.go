@@ -455,6 +455,81 @@ func TestExternalVolumeTransformer(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "external volume NFS",
+			cfg: []*blockcfg.ExternalVolumeConfigV1Alpha1{
+				{
+					Meta: meta.Meta{
+						MetaKind:       blockcfg.ExternalVolumeConfigKind,
+						MetaAPIVersion: "v1alpha1",
+					},
+					MetaName:       "shared",
+					FilesystemType: block.FilesystemTypeNFS,
+					MountSpec: blockcfg.ExternalMountSpec{
+						MountNFS: &blockcfg.NFSMountSpec{
+							NFSServer: "nfs.example.com",
+							NFSPath:   "/exports/shared",
+						},
+					},
+				},
+			},
+			checkFunc: func(t *testing.T, resources []volumeconfig.VolumeResource) {
+				require.Len(t, resources, 1)
+
+				testTransformFunc(t, resources[0].TransformFunc, func(t *testing.T, vc *block.VolumeConfig, err error) {
+					require.NoError(t, err)
+
+					assert.Equal(t, block.VolumeTypeExternal, vc.TypedSpec().Type)
+					assert.Equal(t, block.FilesystemTypeNFS, vc.TypedSpec().Provisioning.FilesystemSpec.Type)
+					assert.Equal(t, "nfs.example.com:/exports/shared", vc.TypedSpec().Provisioning.DiskSelector.External)
+					assert.Equal(t, block.NetworkTargetSpec{
+						Type:    block.NetworkTargetTypeNFS,
+						Address: "nfs.example.com",
+					}, vc.TypedSpec().Provisioning.DiskSelector.NetworkTarget)
+					assert.Equal(t, []block.ParameterSpec{
+						block.NewStringParameter("vers", "4.2"),
+					}, vc.TypedSpec().Mount.Parameters)
+				})
+			},
+		},
+		{
+			name: "external volume iSCSI",
+			cfg: []*blockcfg.ExternalVolumeConfigV1Alpha1{
+				{
+					Meta: meta.Meta{
+						MetaKind:       blockcfg.ExternalVolumeConfigKind,
+						MetaAPIVersion: "v1alpha1",
+					},
+					MetaName:       "lun",
+					FilesystemType: block.FilesystemTypeXFS,
+					MountSpec: blockcfg.ExternalMountSpec{
+						MountISCSI: &blockcfg.ISCSIMountSpec{
+							ISCSIPortal: "10.0.0.1",
+							ISCSITarget: "iqn.2003-01.org.linux-iscsi.target:storage",
+							ISCSILUN:    1,
+						},
+					},
+				},
+			},
+			checkFunc: func(t *testing.T, resources []volumeconfig.VolumeResource) {
+				require.Len(t, resources, 1)
+
+				testTransformFunc(t, resources[0].TransformFunc, func(t *testing.T, vc *block.VolumeConfig, err error) {
+					require.NoError(t, err)
+
+					assert.Equal(t, block.FilesystemTypeXFS, vc.TypedSpec().Provisioning.FilesystemSpec.Type)
+					assert.Empty(t, vc.TypedSpec().Provisioning.DiskSelector.External)
+					assert.Equal(t, block.NetworkTargetSpec{
+						Type:    block.NetworkTargetTypeISCSI,
+						Address: "10.0.0.1",
+						Port:    3260,
+						Name:    "iqn.2003-01.org.linux-iscsi.target:storage",
+						Unit:    1,
+					}, vc.TypedSpec().Provisioning.DiskSelector.NetworkTarget)
+					assert.Empty(t, vc.TypedSpec().Mount.Parameters)
+				})
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -104,9 +104,20 @@ type ManagerContext struct {
 	DevicesReady            bool
 	PreviousWaveProvisioned bool
 	EncryptionHelpers       encryption.Helpers
+	NetworkIdentity         NetworkIdentity
+	Attacher                *Attacher
 	ShouldCloseVolume       bool
 }
 
+// NetworkIdentity is the host identity presented to network storage targets.
+type NetworkIdentity struct {
+	// InitiatorName is the iSCSI initiator name (IQN).
+	InitiatorName string
+	// HostNQN and HostID identify the NVMe-oF host.
+	HostNQN string
+	HostID  string
+}
+
 // FindDisk returns the disk with the given device path, or nil if it is not known.
 func (ctx ManagerContext) FindDisk(devPath string) *blockpb.DiskSpec {
 	if devPath == "" {
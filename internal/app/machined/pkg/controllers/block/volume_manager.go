@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -30,6 +31,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -100,6 +102,11 @@ func (ctrl *VolumeManagerController) Inputs() []controller.Input {
 			ID:        optional.Some(secrets.EncryptionSaltID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: files.NamespaceName,
+			Type:      files.EtcFileSpecType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -122,9 +129,16 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 
 	shouldRetry := false
 
+	// attaches of the network targets run in the background, and notify on completion
+	attachNotifyCh := make(chan struct{}, 1)
+
+	attacher := volumes.NewAttacher(ctx, attachNotifyCh)
+	defer attacher.Close()
+
 	for {
 		select {
 		case <-r.EventCh():
+		case <-attachNotifyCh:
 		case <-ctx.Done():
 			return nil
 		case <-retryTicker.C:
@@ -182,6 +196,11 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 			}
 		}
 
+		networkIdentity, err := ctrl.getNetworkIdentity(ctx, r)
+		if err != nil {
+			return err
+		}
+
 		diskSpecs, err := safe.Map(disks, func(d *block.Disk) (volumes.DiskContext, error) {
 			spec := &blockpb.DiskSpec{}
 
@@ -359,6 +378,8 @@ func (ctrl *VolumeManagerController) Run(ctx context.Context, r controller.Runti
 						TPMLocker:            hardware.LockPCRStatus(r, constants.UKIPCR, vc.Metadata().ID()),
 						SaltGetter:           ctrl.getSaltGetter(r),
 					},
+					NetworkIdentity:   networkIdentity,
+					Attacher:          attacher,
 					ShouldCloseVolume: shouldCloseVolume,
 				},
 			); err != nil {
@@ -589,7 +610,17 @@ func (ctrl *VolumeManagerController) processVolumeConfig(ctx context.Context, lo
 				if err := volumes.Close(ctx, logger, volumeContext); err != nil {
 					return err
 				}
-			case block.VolumePhaseWaiting, block.VolumePhaseMissing, block.VolumePhaseLocated, block.VolumePhaseProvisioned:
+			case block.VolumePhaseWaiting, block.VolumePhaseMissing:
+				// external volumes might be waiting on an in-flight attach,
+				// or missing with the network target attached (e.g. LUN not found)
+				if volumeContext.Cfg.TypedSpec().Type == block.VolumeTypeExternal {
+					if err := volumes.Close(ctx, logger, volumeContext); err != nil {
+						return err
+					}
+				} else {
+					volumeContext.Status.Phase = block.VolumePhaseClosed
+				}
+			case block.VolumePhaseLocated, block.VolumePhaseProvisioned:
 				volumeContext.Status.Phase = block.VolumePhaseClosed
 			case block.VolumePhaseClosed:
 				// done
@@ -645,3 +676,30 @@ func (ctrl *VolumeManagerController) getSaltGetter(r controller.Reader) helpers.
 		return salt.TypedSpec().DiskSalt, nil
 	}
 }
+
+// getNetworkIdentity reads the iSCSI and NVMe-oF host identity from the files generated by IQNController and NQNController.
+func (ctrl *VolumeManagerController) getNetworkIdentity(ctx context.Context, r controller.Reader) (volumes.NetworkIdentity, error) {
+	var identity volumes.NetworkIdentity
+
+	for id, dest := range map[resource.ID]*string{
+		"iscsi/initiatorname.iscsi": &identity.InitiatorName,
+		"nvme/hostnqn":              &identity.HostNQN,
+		"nvme/hostid":               &identity.HostID,
+	} {
+		spec, err := safe.ReaderGetByID[*files.EtcFileSpec](ctx, r, id)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return identity, fmt.Errorf("error fetching %q etc file spec: %w", id, err)
+		}
+
+		contents := strings.TrimSpace(string(spec.TypedSpec().Contents))
+		contents, _ = strings.CutPrefix(contents, "InitiatorName=")
+
+		*dest = contents
+	}
+
+	return identity, nil
+}
@@ -23,6 +23,7 @@ import (
 	discoveryclient "github.com/siderolabs/discovery-client/pkg/client"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/protoenc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
@@ -518,6 +519,11 @@ func pbAffiliate(affiliate *cluster.AffiliateSpec) *pb.Affiliate {
 				}
 			}),
 		}
+
+		setKubeSpanExtensions(kubeSpan, kubeSpanExtensions{
+			AlternatePublicKey: affiliate.KubeSpan.AlternatePublicKey,
+			Relay:              affiliate.KubeSpan.Relay,
+		})
 	}
 
 	return &pb.Affiliate{
@@ -680,11 +686,47 @@ func NewAffiliateSpec(affiliate *pb.Affiliate, endpoints []*pb.Endpoint) cluster
 				result.KubeSpan.ExcludeAdvertisedNetworks = append(result.KubeSpan.ExcludeAdvertisedNetworks, netip.PrefixFrom(ip, int(affiliate.Kubespan.ExcludeAdvertisedAddresses[i].Bits)))
 			}
 		}
+
+		ext := kubeSpanExtensionsFromPb(affiliate.Kubespan)
+
+		result.KubeSpan.Relay = ext.Relay
+		result.KubeSpan.AlternatePublicKey = ext.AlternatePublicKey
 	}
 
 	return result
 }
 
+// kubeSpanExtensions are the KubeSpan affiliate fields which are not part of the discovery API.
+//
+// The affiliate protobuf messages come from the discovery-api module, so the fields are carried
+// as extension fields of the KubeSpan message, encoded from the struct tags with protoenc.
+// The discovery service only stores the encrypted affiliate data, and nodes which don't know about
+// the fields keep them as unknown fields and ignore them.
+//
+// Field numbers are kept well above the ones used by the discovery API to avoid clashes.
+type kubeSpanExtensions struct {
+	Relay              bool   `protobuf:"1000"`
+	AlternatePublicKey string `protobuf:"1001"`
+}
+
+// setKubeSpanExtensions encodes the extension fields into the KubeSpan affiliate data.
+func setKubeSpanExtensions(kubeSpan *pb.KubeSpan, ext kubeSpanExtensions) {
+	kubeSpan.ProtoReflect().SetUnknown(takeResult(protoenc.Marshal(&ext)))
+}
+
+// kubeSpanExtensionsFromPb decodes the extension fields from the KubeSpan affiliate data.
+//
+// Malformed extension fields are ignored.
+func kubeSpanExtensionsFromPb(kubeSpan *pb.KubeSpan) kubeSpanExtensions {
+	var ext kubeSpanExtensions
+
+	if err := protoenc.Unmarshal(kubeSpan.ProtoReflect().GetUnknown(), &ext); err != nil {
+		return kubeSpanExtensions{}
+	}
+
+	return ext
+}
+
 // controlPlaneFromPb converts protobuf control plane info into the affiliate spec form, returning nil
 // if absent.
 func controlPlaneFromPb(plane *pb.ControlPlane) *cluster.ControlPlane {
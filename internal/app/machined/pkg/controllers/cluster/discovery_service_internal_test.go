@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/siderolabs/discovery-api/api/v1alpha1/client/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+func TestAffiliateKubeSpanExtensionsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, ext := range []kubeSpanExtensions{
+		{},
+		{Relay: true},
+		{AlternatePublicKey: "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs="},
+		{Relay: true, AlternatePublicKey: "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs="},
+	} {
+		spec := cluster.AffiliateSpec{
+			NodeID:      "7x1SuC8Ege5BGXdAfTEff5iQnlWZLfv9h1LGMxA2pYkC",
+			Hostname:    "foo.com",
+			Nodename:    "bar",
+			MachineType: machine.TypeWorker,
+			Addresses:   []netip.Addr{netip.MustParseAddr("192.168.3.4")},
+			KubeSpan: cluster.KubeSpanAffiliateSpec{
+				PublicKey:           "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
+				Address:             netip.MustParseAddr("fd50:8d60:4238:6302:f857:23ff:fe21:d1e0"),
+				AdditionalAddresses: []netip.Prefix{netip.MustParsePrefix("10.244.3.1/24")},
+				AlternatePublicKey:  ext.AlternatePublicKey,
+				Relay:               ext.Relay,
+			},
+		}
+
+		data, err := proto.Marshal(pbAffiliate(&spec))
+		require.NoError(t, err)
+
+		var decoded pb.Affiliate
+
+		require.NoError(t, proto.Unmarshal(data, &decoded))
+
+		// the fields are not part of the discovery API, they should survive the round-trip as extension fields
+		result := NewAffiliateSpec(&decoded, nil)
+
+		assert.Equal(t, ext.Relay, result.KubeSpan.Relay)
+		assert.Equal(t, ext.AlternatePublicKey, result.KubeSpan.AlternatePublicKey)
+		assert.Equal(t, spec.KubeSpan.PublicKey, result.KubeSpan.PublicKey)
+		assert.Equal(t, spec.KubeSpan.AdditionalAddresses, result.KubeSpan.AdditionalAddresses)
+	}
+}
+
+func TestKubeSpanExtensionsWireFormat(t *testing.T) {
+	t.Parallel()
+
+	kubeSpan := &pb.KubeSpan{}
+	setKubeSpanExtensions(kubeSpan, kubeSpanExtensions{Relay: true})
+
+	// field 1000, varint 1
+	assert.Equal(t, []byte{0xc0, 0x3e, 0x01}, []byte(kubeSpan.ProtoReflect().GetUnknown()))
+
+	// unknown fields added by newer versions are skipped
+	kubeSpan.ProtoReflect().SetUnknown([]byte{0xd0, 0x3e, 0x05, 0xc0, 0x3e, 0x01})
+
+	assert.Equal(t, kubeSpanExtensions{Relay: true}, kubeSpanExtensionsFromPb(kubeSpan))
+}
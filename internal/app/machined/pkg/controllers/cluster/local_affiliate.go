@@ -19,6 +19,7 @@ import (
 	"github.com/siderolabs/net"
 	"go.uber.org/zap"
 
+	kubespanadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -251,6 +252,7 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 				if kubespanIdentity != nil && kubespanConfig != nil {
 					spec.KubeSpan.Address = kubespanIdentity.TypedSpec().Address.Addr()
 					spec.KubeSpan.PublicKey = kubespanIdentity.TypedSpec().PublicKey
+					spec.KubeSpan.AlternatePublicKey = kubespanadapter.IdentitySpec(kubespanIdentity.TypedSpec()).AlternatePublicKey()
 
 					if kubespanConfig.TypedSpec().AdvertiseKubernetesNetworks && nodeStatus != nil {
 						spec.KubeSpan.AdditionalAddresses = slices.Clone(nodeStatus.TypedSpec().PodCIDRs)
@@ -259,6 +261,7 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 					}
 
 					spec.KubeSpan.ExcludeAdvertisedNetworks = kubespanConfig.TypedSpec().ExcludeAdvertisedNetworks
+					spec.KubeSpan.Relay = kubespanConfig.TypedSpec().Relay
 
 					endpointIPs := xslices.Filter(currentNodeIPs, func(ip netip.Addr) bool {
 						if ip == spec.KubeSpan.Address {
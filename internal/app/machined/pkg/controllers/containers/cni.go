@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/containernetworking/cni/libcni"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+)
+
+const (
+	// cniBinDir holds the CNI plugins shipped with Talos.
+	cniBinDir = "/opt/cni/bin"
+	// cniCacheDir is where the plugin results are cached, shared with the CRI.
+	cniCacheDir = "/var/lib/cni"
+	// cniNetworkName names the network list, which together with the container ID keys the cache.
+	cniNetworkName = "talos-containers"
+	// cniIfName is the link a container attached to a host link sees.
+	cniIfName = "eth0"
+)
+
+// cniNetwork is an instance's attachment to its host link.
+type cniNetwork struct {
+	link        string
+	config      *libcni.CNIConfig
+	list        *libcni.NetworkConfigList
+	runtimeConf *libcni.RuntimeConf
+}
+
+// attachNetwork attaches the network namespace of a created, not yet started, task to the host link
+// of its instance.
+//
+// NetworkController has already allocated the address, so the plugins are run with the static IPAM:
+// they keep no state of their own, and nothing is leaked when a task goes away without detaching.
+func attachNetwork(ctx context.Context, id string, spec *containers.ContainerInstanceSpecSpec, pid uint32) (*cniNetwork, error) {
+	confList, err := cniConfList(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := libcni.ConfListFromBytes(confList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CNI configuration: %w", err)
+	}
+
+	network := &cniNetwork{
+		link:   spec.Network.Link,
+		config: libcni.NewCNIConfigWithCacheDir([]string{cniBinDir}, cniCacheDir, nil),
+		list:   list,
+		runtimeConf: &libcni.RuntimeConf{
+			ContainerID: id,
+			NetNS:       "/proc/" + strconv.FormatUint(uint64(pid), 10) + "/ns/net",
+			IfName:      cniIfName,
+		},
+	}
+
+	if _, err = network.config.AddNetworkList(ctx, network.list, network.runtimeConf); err != nil {
+		return nil, fmt.Errorf("failed to attach to link %q: %w", spec.Network.Link, err)
+	}
+
+	return network, nil
+}
+
+// detach removes the container's end of the attachment.
+func (network *cniNetwork) detach(ctx context.Context) error {
+	if network == nil {
+		return nil
+	}
+
+	if err := network.config.DelNetworkList(ctx, network.list, network.runtimeConf); err != nil {
+		return fmt.Errorf("failed to detach from link %q: %w", network.link, err)
+	}
+
+	return nil
+}
+
+type cniIPAMAddress struct {
+	Address string `json:"address"`
+	Gateway string `json:"gateway,omitempty"`
+}
+
+type cniIPAMRoute struct {
+	Dst string `json:"dst"`
+	GW  string `json:"gw,omitempty"`
+}
+
+type cniIPAM struct {
+	Type      string           `json:"type"`
+	Addresses []cniIPAMAddress `json:"addresses"`
+	Routes    []cniIPAMRoute   `json:"routes,omitempty"`
+}
+
+type cniPlugin struct {
+	Type string `json:"type"`
+
+	// bridge
+	Bridge      string `json:"bridge,omitempty"`
+	HairpinMode bool   `json:"hairpinMode,omitempty"`
+
+	// macvlan
+	Master string `json:"master,omitempty"`
+	Mode   string `json:"mode,omitempty"`
+
+	IPAM cniIPAM `json:"ipam"`
+}
+
+type cniNetworkList struct {
+	CNIVersion string      `json:"cniVersion"`
+	Name       string      `json:"name"`
+	Plugins    []cniPlugin `json:"plugins"`
+}
+
+// cniConfList builds the CNI network list attaching an instance to its host link.
+//
+// The bridge plugin is not made the gateway, nor does it masquerade: the bridge and its address are
+// configured by the machine configuration, and the masquerading is done by NetworkController.
+func cniConfList(spec *containers.ContainerInstanceSpecSpec) ([]byte, error) {
+	ipam := cniIPAM{
+		Type:      "static",
+		Addresses: []cniIPAMAddress{{Address: spec.Address.String()}},
+	}
+
+	if gateway := spec.Network.Gateway; gateway.IsValid() {
+		ipam.Addresses[0].Gateway = gateway.String()
+
+		defaultRoute := netip.PrefixFrom(netip.IPv4Unspecified(), 0)
+		if gateway.Is6() {
+			defaultRoute = netip.PrefixFrom(netip.IPv6Unspecified(), 0)
+		}
+
+		ipam.Routes = []cniIPAMRoute{{Dst: defaultRoute.String(), GW: gateway.String()}}
+	}
+
+	var plugin cniPlugin
+
+	switch spec.Network.Kind {
+	case containers.NetworkKindBridge:
+		plugin = cniPlugin{
+			Type:   "bridge",
+			Bridge: spec.Network.Link,
+			// Lets a container reach itself through a published port.
+			HairpinMode: true,
+			IPAM:        ipam,
+		}
+	case containers.NetworkKindMacvlan:
+		plugin = cniPlugin{
+			Type:   "macvlan",
+			Master: spec.Network.Link,
+			Mode:   "bridge",
+			IPAM:   ipam,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported network kind %q", spec.Network.Kind)
+	}
+
+	return json.Marshal(cniNetworkList{
+		CNIVersion: "1.0.0",
+		Name:       cniNetworkName,
+		Plugins:    []cniPlugin{plugin},
+	})
+}
@@ -136,6 +136,36 @@ func (ctrl *ConfigController) reconcile(ctx context.Context, r controller.Runtim
 	return nil
 }
 
+// resolveNetwork resolves the network settings of a container.
+func resolveNetwork(network configcfg.ContainerNetworkConfig) containers.ContainerNetworkSpec {
+	spec := containers.ContainerNetworkSpec{
+		HostNetwork: network.Mode() == configcfg.ContainerNetworkModeHost,
+	}
+
+	switch network.Mode() { //nolint:exhaustive
+	case configcfg.ContainerNetworkModeBridge:
+		spec.Kind = containers.NetworkKindBridge
+	case configcfg.ContainerNetworkModeMacvlan:
+		spec.Kind = containers.NetworkKindMacvlan
+	default:
+		return spec
+	}
+
+	spec.Link = network.Link()
+	spec.Subnet = network.Subnet().ValueOrZero()
+	spec.Gateway = network.Gateway().ValueOrZero()
+
+	for _, port := range network.Ports() {
+		spec.Ports = append(spec.Ports, containers.ContainerPortSpec{
+			Protocol:      port.Protocol(),
+			HostPort:      port.HostPort(),
+			ContainerPort: port.ContainerPort(),
+		})
+	}
+
+	return spec
+}
+
 // applyConfig resolves a ContainerConfig document into a ContainerSpec.
 func applyConfig(spec *containers.ContainerSpecSpec, cfg configcfg.ContainerConfig) error {
 	// Image() already returns the canonical form: normalizing in the config layer rather than
@@ -169,9 +199,7 @@ func applyConfig(spec *containers.ContainerSpecSpec, cfg configcfg.ContainerConf
 		CapabilitiesDrop: security.CapabilitiesDrop(),
 	}
 
-	spec.Network = containers.ContainerNetworkSpec{
-		HostNetwork: cfg.Network().Mode() == configcfg.ContainerNetworkModeHost,
-	}
+	spec.Network = resolveNetwork(cfg.Network())
 
 	resources := cfg.Resources()
 	spec.Resources = containers.ContainerResourcesSpec{
@@ -187,13 +215,58 @@ func applyConfig(spec *containers.ContainerSpecSpec, cfg configcfg.ContainerConf
 		Containers: dependsOn.Containers(),
 	}
 
+	restartPolicy := cfg.RestartPolicy()
+	spec.Restart = containers.ContainerRestartSpec{
+		Mode:         string(restartPolicy.Mode()),
+		MaxRetries:   restartPolicy.MaxRetries(),
+		InitialDelay: restartPolicy.InitialDelay(),
+		MaxDelay:     restartPolicy.MaxDelay(),
+	}
+
+	spec.HealthCheck = resolveHealthCheck(cfg.HealthCheck())
+
 	return nil
 }
 
+// resolveHealthCheck flattens the typed health check configuration into a single spec.
+func resolveHealthCheck(healthCheck optional.Optional[configcfg.ContainerHealthCheckConfig]) containers.ContainerHealthCheckSpec {
+	check, ok := healthCheck.Get()
+	if !ok {
+		return containers.ContainerHealthCheckSpec{}
+	}
+
+	out := containers.ContainerHealthCheckSpec{
+		InitialDelay: check.InitialDelay(),
+		Period:       check.Period(),
+		Timeout:      check.Timeout(),
+	}
+
+	switch {
+	case check.Exec().IsPresent():
+		exec, _ := check.Exec().Get()
+
+		out.Kind = containers.HealthCheckKindExec
+		out.Command = exec.Command()
+	case check.HTTP().IsPresent():
+		http, _ := check.HTTP().Get()
+
+		out.Kind = containers.HealthCheckKindHTTP
+		out.Port = http.Port()
+		out.Path = http.Path()
+	case check.TCP().IsPresent():
+		tcp, _ := check.TCP().Get()
+
+		out.Kind = containers.HealthCheckKindTCP
+		out.Port = tcp.Port()
+	}
+
+	return out
+}
+
 // resolveMounts turns typed configuration mounts into resolved mount specs.
 //
 // A user volume is resolved to its block volume ID here rather than to a host path: the path is
-// only known once the volume is actually mounted, which is ContainerMountController's job.
+// only known once the volume is actually mounted, which is MountController's job.
 func resolveMounts(configMounts []configcfg.ContainerMountConfig) ([]containers.ContainerMountSpec, error) {
 	if len(configMounts) == 0 {
 		return nil, nil
@@ -5,6 +5,7 @@
 package containers_test
 
 import (
+	"net/netip"
 	"testing"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 	configcfg "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	containercfg "github.com/siderolabs/talos/pkg/machinery/config/types/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
 )
@@ -71,6 +74,58 @@ func (suite *ConfigSuite) TestNormalizesImageAndResolvesDefaults() {
 		asrt.False(spec.TypedSpec().Security.Privileged)
 		asrt.False(spec.TypedSpec().Network.HostNetwork)
 		asrt.Zero(spec.TypedSpec().Resources.MemoryLimit)
+
+		// Always restarted with the default backoff, and no health check.
+		asrt.Equal(containers.ContainerRestartSpec{
+			Mode:         containers.RestartModeAlways,
+			InitialDelay: 5 * time.Second,
+			MaxDelay:     5 * time.Minute,
+		}, spec.TypedSpec().Restart)
+		asrt.Empty(spec.TypedSpec().HealthCheck.Kind)
+	})
+}
+
+func (suite *ConfigSuite) TestResolvesRestartPolicyAndHealthCheck() {
+	withExec := newDoc("registry", "docker.io/library/registry:2")
+	withExec.RestartPolicyConfig = &containercfg.ContainerRestartPolicy{
+		RestartMode:         configcfg.ContainerRestartModeOnFailure,
+		RestartMaxRetries:   3,
+		RestartInitialDelay: time.Second,
+	}
+	withExec.HealthCheckConfig = &containercfg.ContainerHealthCheck{
+		ExecCheck:    &containercfg.ContainerExecHealthCheck{ExecCommand: []string{"wget", "-q", "-O-", "http://localhost:5000/v2/"}},
+		HealthPeriod: 10 * time.Second,
+	}
+
+	withHTTP := newDoc("agent", "ghcr.io/siderolabs/agent:v1.0.0")
+	withHTTP.NetworkConfig = &containercfg.ContainerNetwork{NetworkMode: "host"}
+	withHTTP.HealthCheckConfig = &containercfg.ContainerHealthCheck{
+		HTTPCheck: &containercfg.ContainerHTTPHealthCheck{HTTPPort: 8080},
+	}
+
+	suite.applyContainers(withExec, withHTTP)
+
+	ctest.AssertResource(suite, "registry", func(spec *containers.ContainerSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerRestartSpec{
+			Mode:         containers.RestartModeOnFailure,
+			MaxRetries:   3,
+			InitialDelay: time.Second,
+			MaxDelay:     5 * time.Minute,
+		}, spec.TypedSpec().Restart)
+
+		asrt.Equal(containers.ContainerHealthCheckSpec{
+			Kind:         containers.HealthCheckKindExec,
+			Command:      []string{"wget", "-q", "-O-", "http://localhost:5000/v2/"},
+			InitialDelay: time.Second,
+			Period:       10 * time.Second,
+			Timeout:      time.Second,
+		}, spec.TypedSpec().HealthCheck)
+	})
+
+	ctest.AssertResource(suite, "agent", func(spec *containers.ContainerSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.HealthCheckKindHTTP, spec.TypedSpec().HealthCheck.Kind)
+		asrt.EqualValues(8080, spec.TypedSpec().HealthCheck.Port)
+		asrt.Equal("/", spec.TypedSpec().HealthCheck.Path)
 	})
 }
 
@@ -180,6 +235,35 @@ func (suite *ConfigSuite) TestResolvesSecurityNetworkAndResources() {
 	})
 }
 
+func (suite *ConfigSuite) TestResolvesBridgeNetwork() {
+	doc := newDoc("web", "nginx")
+	doc.NetworkConfig = &containercfg.ContainerNetwork{
+		NetworkMode:    "bridge",
+		NetworkLink:    "br-containers",
+		NetworkSubnet:  meta.Prefix{Prefix: netip.MustParsePrefix("10.88.0.0/24")},
+		NetworkGateway: meta.Addr{Addr: netip.MustParseAddr("10.88.0.1")},
+		NetworkPorts: []containercfg.ContainerPort{
+			{PortHostPort: 8080, PortContainerPort: 80},
+			{PortHostPort: 5353, PortContainerPort: 53, PortProtocol: nethelpers.ProtocolUDP},
+		},
+	}
+
+	suite.applyContainers(doc)
+
+	ctest.AssertResource(suite, "web", func(spec *containers.ContainerSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerNetworkSpec{
+			Kind:    containers.NetworkKindBridge,
+			Link:    "br-containers",
+			Subnet:  netip.MustParsePrefix("10.88.0.0/24"),
+			Gateway: netip.MustParseAddr("10.88.0.1"),
+			Ports: []containers.ContainerPortSpec{
+				{Protocol: nethelpers.ProtocolTCP, HostPort: 8080, ContainerPort: 80},
+				{Protocol: nethelpers.ProtocolUDP, HostPort: 5353, ContainerPort: 53},
+			},
+		}, spec.TypedSpec().Network)
+	})
+}
+
 func (suite *ConfigSuite) TestRemovesSpecWhenDocumentGoesAway() {
 	suite.applyContainers(newDoc("a", "nginx"), newDoc("b", "nginx"))
 
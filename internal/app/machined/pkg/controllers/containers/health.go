@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/health"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+)
+
+// healthCheck builds the check a running task is probed with.
+//
+// The http and tcp checks connect to host, see checkHost.
+func healthCheck(spec containers.ContainerHealthCheckSpec, host netip.Addr, task Task) health.Check {
+	address := net.JoinHostPort(host.String(), strconv.Itoa(int(spec.Port)))
+
+	switch spec.Kind {
+	case containers.HealthCheckKindExec:
+		return func(ctx context.Context) error {
+			exitCode, err := task.Exec(ctx, spec.Command)
+			if err != nil {
+				return err
+			}
+
+			if exitCode != 0 {
+				return fmt.Errorf("command exited with code %d", exitCode)
+			}
+
+			return nil
+		}
+	case containers.HealthCheckKindHTTP:
+		client := &http.Client{
+			// A redirect is a response in its own right, and following it could leave the container.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		url := "http://" + address + spec.Path
+
+		return func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+
+			defer resp.Body.Close() //nolint:errcheck
+
+			io.Copy(io.Discard, resp.Body) //nolint:errcheck
+
+			if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+				return fmt.Errorf("unexpected HTTP status %s", resp.Status)
+			}
+
+			return nil
+		}
+	case containers.HealthCheckKindTCP:
+		return func(ctx context.Context) error {
+			var d net.Dialer
+
+			conn, err := d.DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+
+			return conn.Close()
+		}
+	default:
+		return func(context.Context) error {
+			return fmt.Errorf("unsupported health check kind %q", spec.Kind)
+		}
+	}
+}
+
+// checkHost is the address the http and tcp checks of an instance connect to.
+//
+// Config validation only allows those checks on the host and bridge networks: on the host network
+// the host loopback address reaches the container, on a bridge its own address does.
+func checkHost(spec *containers.ContainerInstanceSpecSpec) netip.Addr {
+	if spec.Address.IsValid() {
+		return spec.Address.Addr()
+	}
+
+	return netip.AddrFrom4([4]byte{127, 0, 0, 1})
+}
+
+// healthSettings returns the timing a health check runs with.
+//
+// ConfigController always resolves the timings; a zero period or timeout only falls back to the
+// service defaults so that it can't stall or fail every check.
+func healthSettings(spec containers.ContainerHealthCheckSpec) *health.Settings {
+	settings := health.DefaultSettings
+
+	settings.InitialDelay = spec.InitialDelay
+
+	if spec.Period > 0 {
+		settings.Period = spec.Period
+	}
+
+	if spec.Timeout > 0 {
+		settings.Timeout = spec.Timeout
+	}
+
+	return &settings
+}
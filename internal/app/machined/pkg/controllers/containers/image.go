@@ -7,6 +7,8 @@ package containers
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	containerdapi "github.com/containerd/containerd/v2/client"
@@ -25,6 +27,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cri"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
@@ -36,12 +39,20 @@ const criServiceID = "cri"
 // This is the seam that keeps the controller testable: the default implementation talks to
 // containerd, tests substitute a fake.
 type Puller interface {
-	// Pull fetches the reference and returns the resolved digest reference.
-	Pull(ctx context.Context, logger *zap.Logger, ref string) (string, error)
+	// Pull fetches the reference, verifying it against the image verification rules.
+	Pull(ctx context.Context, logger *zap.Logger, ref string) (PullResult, error)
 	// Close releases the underlying client.
 	Close() error
 }
 
+// PullResult is the outcome of a successful pull.
+type PullResult struct {
+	// Digest is the resolved digest.
+	Digest string
+	// Verification records how the image was checked against the image verification rules.
+	Verification containers.ContainerImageVerificationSpec
+}
+
 // ImageController owns pulling images for declared containers.
 //
 // The pull is the one side effect this controller owns. It runs in a goroutine per container
@@ -90,6 +101,11 @@ func (ctrl *ImageController) Inputs() []controller.Input {
 			Type:      cri.ImageCacheConfigType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: security.NamespaceName,
+			Type:      security.ImageVerificationRuleType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -106,28 +122,30 @@ func (ctrl *ImageController) Outputs() []controller.Output {
 // pullState tracks one in-flight pull.
 type pullState struct {
 	ref string
+	// rules is the version of the image verification rules the pull is checked against.
+	rules string
 
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 
 	mu     sync.Mutex
-	digest string
+	result PullResult
 	err    error
 	done   bool
 }
 
-func (state *pullState) snapshot() (digest string, err error, done bool) {
+func (state *pullState) snapshot() (result PullResult, err error, done bool) {
 	state.mu.Lock()
 	defer state.mu.Unlock()
 
-	return state.digest, state.err, state.done
+	return state.result, state.err, state.done
 }
 
-func (state *pullState) finish(digest string, err error) {
+func (state *pullState) finish(result PullResult, err error) {
 	state.mu.Lock()
 	defer state.mu.Unlock()
 
-	state.digest, state.err, state.done = digest, err, true
+	state.result, state.err, state.done = result, err, true
 }
 
 func (state *pullState) stop() {
@@ -174,7 +192,7 @@ func (ctrl *ImageController) Run(ctx context.Context, r controller.Runtime, logg
 
 		// Nothing can be pulled until the CRI containerd instance is up, since that is the socket
 		// the taloscontainers namespace lives on.
-		criUp, err := ctrl.criIsUp(ctx, r)
+		criUp, err := criIsUp(ctx, r)
 		if err != nil {
 			return err
 		}
@@ -197,7 +215,9 @@ func (ctrl *ImageController) Run(ctx context.Context, r controller.Runtime, logg
 	}
 }
 
-func (ctrl *ImageController) criIsUp(ctx context.Context, r controller.Runtime) (bool, error) {
+// criIsUp reports whether the CRI containerd instance, which hosts the taloscontainers namespace, is
+// running and healthy.
+func criIsUp(ctx context.Context, r controller.Reader) (bool, error) {
 	service, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, criServiceID)
 	if err != nil {
 		if state.IsNotFoundError(err) {
@@ -222,6 +242,11 @@ func (ctrl *ImageController) reconcile(
 		return fmt.Errorf("failed to list container specs: %w", err)
 	}
 
+	rules, err := rulesVersion(ctx, r)
+	if err != nil {
+		return err
+	}
+
 	r.StartTrackingOutputs()
 
 	wanted := map[string]struct{}{}
@@ -230,7 +255,7 @@ func (ctrl *ImageController) reconcile(
 		containerID := spec.Metadata().ID()
 		wanted[containerID] = struct{}{}
 
-		if err := ctrl.reconcileContainer(ctx, r, logger, puller, notifyCh, containerID, spec.TypedSpec().Image.Ref); err != nil {
+		if err := ctrl.reconcileContainer(ctx, r, logger, puller, notifyCh, containerID, spec.TypedSpec().Image.Ref, rules); err != nil {
 			return err
 		}
 	}
@@ -240,6 +265,22 @@ func (ctrl *ImageController) reconcile(
 	return safe.CleanupOutputs[*containers.ContainerImageStatus](ctx, r)
 }
 
+// rulesVersion identifies the current set of image verification rules.
+func rulesVersion(ctx context.Context, r controller.Reader) (string, error) {
+	rules, err := safe.ReaderListAll[*security.ImageVerificationRule](ctx, r)
+	if err != nil {
+		return "", fmt.Errorf("failed to list image verification rules: %w", err)
+	}
+
+	var version strings.Builder
+
+	for rule := range rules.All() {
+		fmt.Fprintf(&version, "%s@%s;", rule.Metadata().ID(), rule.Metadata().Version())
+	}
+
+	return version.String(), nil
+}
+
 // reconcileContainer syncs the pull state for a single container and writes its resulting status.
 func (ctrl *ImageController) reconcileContainer(
 	ctx context.Context,
@@ -247,9 +288,9 @@ func (ctrl *ImageController) reconcileContainer(
 	logger *zap.Logger,
 	puller Puller,
 	notifyCh chan struct{},
-	containerID, ref string,
+	containerID, ref, rules string,
 ) error {
-	pull := ctrl.currentPull(logger, containerID, ref)
+	pull := ctrl.currentPull(logger, containerID, ref, rules)
 
 	if pull == nil {
 		if puller == nil {
@@ -260,14 +301,14 @@ func (ctrl *ImageController) reconcileContainer(
 				zap.String("image", ref),
 			)
 
-			return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhasePending, "", "")
+			return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhasePending, PullResult{}, "")
 		}
 
-		pull = ctrl.startPull(ctx, logger, puller, containerID, ref, notifyCh)
+		pull = ctrl.startPull(ctx, logger, puller, containerID, ref, rules, notifyCh)
 		ctrl.pulls[containerID] = pull
 	}
 
-	digest, pullErr, done := pull.snapshot()
+	result, pullErr, done := pull.snapshot()
 
 	switch {
 	case !done:
@@ -277,35 +318,44 @@ func (ctrl *ImageController) reconcileContainer(
 			zap.String("image", ref),
 		)
 
-		return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhasePulling, "", "")
+		return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhasePulling, PullResult{}, "")
 	case pullErr != nil:
-		return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhaseFailed, "", pullErr.Error())
+		return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhaseFailed, PullResult{}, pullErr.Error())
 	default:
-		return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhaseReady, digest, "")
+		return ctrl.writeStatus(ctx, r, containerID, ref, containers.ContainerImagePhaseReady, result, "")
 	}
 }
 
 // currentPull returns the existing pull for containerID, or nil if there is none.
 //
 // A changed reference invalidates an in-flight or completed pull: it is stopped and removed, and
-// nil is returned so the caller starts a fresh one.
-func (ctrl *ImageController) currentPull(logger *zap.Logger, containerID, ref string) *pullState {
+// nil is returned so the caller starts a fresh one. So do changed image verification rules, for the
+// image to be checked against the rules in force: an image already on the node is not re-fetched,
+// only re-verified.
+func (ctrl *ImageController) currentPull(logger *zap.Logger, containerID, ref, rules string) *pullState {
 	pull, exists := ctrl.pulls[containerID]
 	if !exists {
 		return nil
 	}
 
-	if pull.ref == ref {
+	switch {
+	case pull.ref != ref:
+		logger.Info(
+			"container image reference changed, restarting the pull",
+			zap.String("container", containerID),
+			zap.String("from", pull.ref),
+			zap.String("to", ref),
+		)
+	case pull.rules != rules:
+		logger.Info(
+			"image verification rules changed, restarting the pull",
+			zap.String("container", containerID),
+			zap.String("image", ref),
+		)
+	default:
 		return pull
 	}
 
-	logger.Info(
-		"container image reference changed, restarting the pull",
-		zap.String("container", containerID),
-		zap.String("from", pull.ref),
-		zap.String("to", ref),
-	)
-
 	pull.stop()
 	delete(ctrl.pulls, containerID)
 
@@ -332,7 +382,7 @@ func (ctrl *ImageController) writeStatus(
 	containerID string,
 	ref string,
 	phase containers.ContainerImagePhase,
-	digest string,
+	result PullResult,
 	errText string,
 ) error {
 	if err := safe.WriterModify(
@@ -341,8 +391,9 @@ func (ctrl *ImageController) writeStatus(
 		func(res *containers.ContainerImageStatus) error {
 			res.TypedSpec().Phase = phase
 			res.TypedSpec().Image = ref
-			res.TypedSpec().Digest = digest
+			res.TypedSpec().Digest = result.Digest
 			res.TypedSpec().Error = errText
+			res.TypedSpec().Verification = result.Verification
 
 			return nil
 		},
@@ -358,15 +409,16 @@ func (ctrl *ImageController) writeStatus(
 // Transient failures are retried inside the pull itself, for up to image.PullTimeout. Once it does
 // give up — or fails terminally, as a denied signature does — the failure is recorded and not
 // retried here: the instance controller never opens the gate, the container stays visible as
-// failed, and the operator sees the error. A changed reference starts a fresh pull.
+// failed, and the operator sees the error. A changed reference, or changed image verification rules,
+// start a fresh pull.
 func (ctrl *ImageController) startPull(
 	ctx context.Context,
 	logger *zap.Logger,
 	puller Puller,
-	containerID, ref string,
+	containerID, ref, rules string,
 	notifyCh chan struct{},
 ) *pullState {
-	pull := &pullState{ref: ref}
+	pull := &pullState{ref: ref, rules: rules}
 
 	pullCtx, cancel := context.WithCancel(ctx)
 	pull.cancel = cancel
@@ -376,18 +428,18 @@ func (ctrl *ImageController) startPull(
 
 		logger.Info("pulling container image", zap.String("container", containerID), zap.String("image", ref))
 
-		var digest string
+		var result PullResult
 
 		// A panic in a pull must not take down machined.
 		err := panicsafe.RunErr(func() error {
 			var pullErr error
 
-			digest, pullErr = puller.Pull(pullCtx, logger, ref)
+			result, pullErr = puller.Pull(pullCtx, logger, ref)
 
 			return pullErr
 		})
 
-		pull.finish(digest, err)
+		pull.finish(result, err)
 
 		switch {
 		case panicsafe.IsPanic(err):
@@ -403,7 +455,9 @@ func (ctrl *ImageController) startPull(
 			logger.Info(
 				"image pulled",
 				zap.String("container", containerID),
-				zap.String("digest", digest),
+				zap.String("digest", result.Digest),
+				zap.Bool("verified", result.Verification.Verified),
+				zap.String("signer", result.Verification.Signer),
 			)
 		}
 	})
@@ -431,14 +485,14 @@ type containerdPuller struct {
 	registryBuilder image.RegistriesBuilder
 }
 
-func (p *containerdPuller) Pull(ctx context.Context, logger *zap.Logger, ref string) (string, error) {
+func (p *containerdPuller) Pull(ctx context.Context, logger *zap.Logger, ref string) (PullResult, error) {
 	// The taloscontainers namespace keeps these images away from both Kubernetes pods and Talos'
 	// own system images.
 	ctx = namespaces.WithNamespace(ctx, constants.TalosContainersContainerdNamespace)
 
 	// Retries live inside the pull: a registry that is briefly unreachable, or a mirror that comes
 	// up mid-retry, resolves without anything upstream having to re-trigger. A denied signature is
-	// terminal and comes straight back.
+	// terminal and comes straight back, as does a denied image already on the node.
 	logWriter := &zapio.Writer{Log: logger, Level: zapcore.DebugLevel}
 
 	ctx, cancel := context.WithTimeout(ctx, image.PullTimeout)
@@ -449,13 +503,42 @@ func (p *containerdPuller) Pull(ctx context.Context, logger *zap.Logger, ref str
 		// IfNotPresent semantics: an image already on the node is not re-fetched, which also keeps a
 		// crash-looping container from hammering the registry on every restart.
 		image.WithSkipIfAlreadyPulled(),
+		// ... but the image verification rules are still enforced on it.
+		image.WithVerifyAlreadyPulled(),
 		image.WithLogWriter(logWriter),
 	)
 	if err != nil {
-		return "", err
+		return PullResult{}, err
+	}
+
+	return PullResult{
+		Digest:       img.Target().Digest.String(),
+		Verification: verificationFromLabels(img.Labels()),
+	}, nil
+}
+
+// verificationFromLabels reads back the verification of an image, which the pull records as its
+// labels.
+func verificationFromLabels(labels map[string]string) containers.ContainerImageVerificationSpec {
+	message, verified := labels[constants.ImageLabelVerified]
+	if !verified {
+		return containers.ContainerImageVerificationSpec{}
+	}
+
+	verification := containers.ContainerImageVerificationSpec{
+		Verified:   true,
+		Rule:       labels[constants.ImageLabelVerifiedRule],
+		Message:    message,
+		Signer:     labels[constants.ImageLabelVerifiedSigner],
+		Issuer:     labels[constants.ImageLabelVerifiedIssuer],
+		RekorLogID: labels[constants.ImageLabelVerifiedRekorLogID],
+	}
+
+	if index, err := strconv.ParseInt(labels[constants.ImageLabelVerifiedRekorLogIndex], 10, 64); err == nil {
+		verification.RekorLogIndex = index
 	}
 
-	return img.Target().Digest.String(), nil
+	return verification
 }
 
 func (p *containerdPuller) Close() error {
@@ -19,6 +19,7 @@ import (
 	containersctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/containers"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
@@ -33,7 +34,7 @@ type fakePuller struct {
 }
 
 type fakePullResult struct {
-	digest string
+	result containersctrl.PullResult
 	err    error
 }
 
@@ -49,7 +50,14 @@ func (p *fakePuller) setResult(ref, digest string, err error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	p.results[ref] = fakePullResult{digest: digest, err: err}
+	p.results[ref] = fakePullResult{result: containersctrl.PullResult{Digest: digest}, err: err}
+}
+
+func (p *fakePuller) setVerifiedResult(ref, digest string, verification containers.ContainerImageVerificationSpec) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.results[ref] = fakePullResult{result: containersctrl.PullResult{Digest: digest, Verification: verification}}
 }
 
 // block makes pulls of ref hang until release is called.
@@ -78,7 +86,7 @@ func (p *fakePuller) attemptCount(ref string) int {
 	return p.attempts[ref]
 }
 
-func (p *fakePuller) Pull(ctx context.Context, logger *zap.Logger, ref string) (string, error) {
+func (p *fakePuller) Pull(ctx context.Context, logger *zap.Logger, ref string) (containersctrl.PullResult, error) {
 	p.mu.Lock()
 	p.attempts[ref]++
 	blocked := p.blocked[ref]
@@ -88,12 +96,12 @@ func (p *fakePuller) Pull(ctx context.Context, logger *zap.Logger, ref string) (
 	if blocked != nil {
 		select {
 		case <-ctx.Done():
-			return "", ctx.Err()
+			return containersctrl.PullResult{}, ctx.Err()
 		case <-blocked:
 		}
 	}
 
-	return result.digest, result.err
+	return result.result, result.err
 }
 
 func (p *fakePuller) Close() error {
@@ -174,6 +182,29 @@ func (suite *ImageSuite) TestPullsAndReportsDigest() {
 	})
 }
 
+func (suite *ImageSuite) TestReportsVerification() {
+	const ref = "ghcr.io/siderolabs/signed:1.0"
+
+	verification := containers.ContainerImageVerificationSpec{
+		Verified:      true,
+		Rule:          "0",
+		Message:       "verified via bundle",
+		Signer:        "releasemgr-svc@talos-production.iam.gserviceaccount.com",
+		Issuer:        "https://accounts.google.com",
+		RekorLogID:    "c0d23d6ad406973f9559f3ba2d1ca01f84147d8ffc5b8445c224f98b9591801d",
+		RekorLogIndex: 123456789,
+	}
+
+	suite.puller.setVerifiedResult(ref, "sha256:signed", verification)
+	suite.criUp()
+	suite.createSpecWithImage("signed", ref)
+
+	ctest.AssertResource(suite, "signed", func(status *containers.ContainerImageStatus, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerImagePhaseReady, status.TypedSpec().Phase)
+		asrt.Equal(verification, status.TypedSpec().Verification)
+	})
+}
+
 func (suite *ImageSuite) TestReportsPullingWhileBlocked() {
 	const ref = "docker.io/library/slow:1.0"
 
@@ -270,6 +301,35 @@ func (suite *ImageSuite) TestRepullsWhenReferenceChanges() {
 	})
 }
 
+func (suite *ImageSuite) TestRepullsWhenVerificationRulesChange() {
+	const ref = "docker.io/library/ruled:1.0"
+
+	suite.puller.setResult(ref, "sha256:ruled", nil)
+	suite.criUp()
+	suite.createSpecWithImage("ruled", ref)
+
+	ctest.AssertResource(suite, "ruled", func(status *containers.ContainerImageStatus, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerImagePhaseReady, status.TypedSpec().Phase)
+	})
+
+	suite.Assert().Equal(1, suite.puller.attemptCount(ref))
+
+	// A rule denying the image now has to be enforced on it, although it is already pulled.
+	suite.puller.setResult(ref, "", errors.New("verification denied by matched rule (0)"))
+
+	rule := security.NewImageVerificationRule("0")
+	rule.TypedSpec().ImagePattern = "docker.io/library/*"
+	rule.TypedSpec().Deny = true
+	suite.Create(rule)
+
+	ctest.AssertResource(suite, "ruled", func(status *containers.ContainerImageStatus, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerImagePhaseFailed, status.TypedSpec().Phase)
+		asrt.Contains(status.TypedSpec().Error, "verification denied")
+	})
+
+	suite.Assert().Equal(2, suite.puller.attemptCount(ref))
+}
+
 func (suite *ImageSuite) TestRemovesStatusWhenSpecGoesAway() {
 	const ref = "docker.io/library/gone:1.0"
 
@@ -7,6 +7,7 @@ package containers
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"slices"
 	"time"
 
@@ -29,11 +30,15 @@ import (
 // statuses, it decides whether a ContainerInstanceSpec should exist. That makes dependency gating
 // testable without any infrastructure.
 //
-// Three gates the RFD describes are not yet enforced here: a userVolume mount (no MountController
-// yet to resolve its host path, so a container declaring one simply stays pending),
-// dependsOn.containers (no aggregated ContainerStatus yet to check a peer's health against), and
-// restart-after-termination (no ContainerInstanceStatus producer yet, so a generation only advances
-// on a spec change). All three land with their respective controllers.
+// A generation advances on a spec change, and once RuntimeController reports the instance's task as
+// terminated, if and when the container's restart policy says so.
+//
+// A userVolume mount is gated on MountController reporting the volume mounted, and an instance is
+// also stopped when one of its volumes closes: MountController only lets the volume go once no
+// instance binds from it any more.
+//
+// A container attached to a host link is gated on NetworkController allocating its address, which
+// the instance carries from then on.
 type InstanceController struct{}
 
 // Name implements controller.Controller interface.
@@ -59,7 +64,27 @@ func (ctrl *InstanceController) Inputs() []controller.Input {
 			Type:      containers.ContainerInstanceSpecType,
 			Kind:      controller.InputDestroyReady,
 		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerInstanceStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerMountStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerNetworkStatusType,
+			Kind:      controller.InputWeak,
+		},
 		// Needed to check whether dependsOn is satisfied.
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerStatusType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.StatusType,
@@ -230,9 +255,27 @@ func (ctrl *InstanceController) reconcileInstance(
 		return optional.None[time.Duration](), err
 	}
 
-	mounts, _ := containers.ResolveInstanceMounts(spec.TypedSpec().Mounts)
+	mounts, _, err := containers.ResolveContainerMounts(ctx, r, containerID, spec.TypedSpec().Mounts)
+	if err != nil {
+		return optional.None[time.Duration](), err
+	}
 
-	if err := ctrl.createInstanceSpec(ctx, r, containerID, nextGeneration, spec, imageDigest, mounts); err != nil {
+	var (
+		address       netip.Prefix
+		networkStatus *containers.ContainerNetworkStatusSpec
+	)
+
+	if spec.TypedSpec().Network.Kind != "" {
+		networkStatus, err = containers.GetNetworkStatus(ctx, r, containerID)
+		if err != nil {
+			return optional.None[time.Duration](), err
+		}
+
+		// Ready has just checked the address is allocated.
+		address, _ = networkStatus.AddressOn(spec.TypedSpec().Network)
+	}
+
+	if err := ctrl.createInstanceSpec(ctx, r, containerID, nextGeneration, spec, imageDigest, mounts, address); err != nil {
 		return optional.None[time.Duration](), err
 	}
 
@@ -310,8 +353,8 @@ func (ctrl *InstanceController) destroyInstance(
 // tears it down if it is not.
 //
 // Returns (wasDestroyed, wakeUpAfter, error). A false wasDestroyed means the instance is to be left
-// where it is for now, either because it matches the spec or because its replacement cannot start
-// yet.
+// where it is for now, either because it matches the spec and is not due for a restart, or because
+// its replacement cannot start yet.
 func (ctrl *InstanceController) reconcileExistingInstance(
 	ctx context.Context,
 	r controller.Runtime,
@@ -326,19 +369,54 @@ func (ctrl *InstanceController) reconcileExistingInstance(
 	// to restore the old values cannot take it back. Comparing it against the spec here would report
 	// it in sync and strand it tearing down forever.
 	if newestInstance.Metadata().Phase() != resource.PhaseTearingDown {
+		mountStatus, err := safe.ReaderGetByID[*containers.ContainerMountStatus](ctx, r, containerID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return false, optional.None[time.Duration](), fmt.Errorf("failed to get mount status %q: %w", containerID, err)
+		}
+
+		var mounted *containers.ContainerMountStatusSpec
+		if mountStatus != nil {
+			mounted = mountStatus.TypedSpec()
+		}
+
+		// A closing volume stops the container whatever the other gates say: MountController holds
+		// the volume mounted until the instance is gone.
+		if !newestInstance.TypedSpec().VolumesMounted(mounted) {
+			logger.Info("container volume is closing, stopping the instance",
+				zap.String("container", containerID),
+				zap.Uint64("generation", newestInstance.TypedSpec().Generation),
+			)
+
+			return ctrl.replaceInstance(ctx, r, logger, newestInstance)
+		}
+
 		inSync, err := newestInstance.TypedSpec().InSyncWithContainerSpec(ctx, r, spec.TypedSpec())
 		if err != nil {
 			return false, optional.None[time.Duration](), err
 		}
 
 		if inSync {
-			return false, optional.None[time.Duration](), nil
+			restartAfter, err := ctrl.restartAfter(ctx, r, spec, newestInstance)
+			if err != nil {
+				return false, optional.None[time.Duration](), err
+			}
+
+			if remaining, scheduled := restartAfter.Get(); !scheduled || remaining > 0 {
+				return false, restartAfter, nil
+			}
+
+			logger.Info("container terminated, restarting",
+				zap.String("container", containerID),
+				zap.Uint64("generation", newestInstance.TypedSpec().Generation),
+			)
+
+			return ctrl.replaceInstance(ctx, r, logger, newestInstance)
 		}
 
 		// A spec change invalidates the existing instance: a running container is never mutated in
 		// place, it is replaced. Its replacement has to be startable first, though, otherwise a spec
 		// edit made while a gate is unmet stops a healthy container for as long as the gate stays
-		// unmet, which for a userVolume mount is forever.
+		// unmet, which for a user volume which is not there may be indefinitely.
 		waitingFor, wakeUpAfter, err := spec.TypedSpec().Ready(ctx, r, containerID)
 		if err != nil {
 			return false, optional.None[time.Duration](), err
@@ -359,7 +437,17 @@ func (ctrl *InstanceController) reconcileExistingInstance(
 		)
 	}
 
-	destroyed, err := ctrl.destroyInstance(ctx, r, logger, newestInstance)
+	return ctrl.replaceInstance(ctx, r, logger, newestInstance)
+}
+
+// replaceInstance tears down an instance which is to be replaced by the next generation.
+func (ctrl *InstanceController) replaceInstance(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	instance *containers.ContainerInstanceSpec,
+) (bool, optional.Optional[time.Duration], error) {
+	destroyed, err := ctrl.destroyInstance(ctx, r, logger, instance)
 	if err != nil {
 		return false, optional.None[time.Duration](), err
 	}
@@ -373,6 +461,34 @@ func (ctrl *InstanceController) reconcileExistingInstance(
 	return true, optional.None[time.Duration](), nil
 }
 
+// restartAfter reports how long until a terminated instance is due to be replaced, or None if it is
+// not to be replaced: its task has not terminated, or the restart policy leaves it stopped.
+func (ctrl *InstanceController) restartAfter(
+	ctx context.Context,
+	r controller.Reader,
+	spec *containers.ContainerSpec,
+	instance *containers.ContainerInstanceSpec,
+) (optional.Optional[time.Duration], error) {
+	status, err := safe.ReaderGetByID[*containers.ContainerInstanceStatus](ctx, r, instance.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return optional.None[time.Duration](), nil
+		}
+
+		return optional.None[time.Duration](), fmt.Errorf("failed to get instance status %q: %w", instance.Metadata().ID(), err)
+	}
+
+	policy := spec.TypedSpec().Restart
+
+	if !policy.ShouldRestart(*status.TypedSpec()) {
+		return optional.None[time.Duration](), nil
+	}
+
+	delay := policy.Delay(status.TypedSpec().Restarts)
+
+	return optional.Some(max(0, time.Until(status.TypedSpec().FinishedAt.Add(delay)))), nil
+}
+
 // createInstanceSpec creates a new ContainerInstanceSpec with all fields populated from the spec and resolved values.
 func (ctrl *InstanceController) createInstanceSpec(
 	ctx context.Context,
@@ -382,6 +498,7 @@ func (ctrl *InstanceController) createInstanceSpec(
 	spec *containers.ContainerSpec,
 	digest string,
 	mounts []containers.ResolvedMountSpec,
+	address netip.Prefix,
 ) error {
 	instanceID := containers.InstanceID(containerID, generation)
 
@@ -401,6 +518,8 @@ func (ctrl *InstanceController) createInstanceSpec(
 			instanceSpec.Security = spec.TypedSpec().Security
 			instanceSpec.Network = spec.TypedSpec().Network
 			instanceSpec.Resources = spec.TypedSpec().Resources
+			instanceSpec.HealthCheck = spec.TypedSpec().HealthCheck
+			instanceSpec.Address = address
 
 			return nil
 		},
@@ -5,6 +5,7 @@
 package containers_test
 
 import (
+	"net/netip"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 
 	containersctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/containers"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	timeres "github.com/siderolabs/talos/pkg/machinery/resources/time"
@@ -65,9 +67,16 @@ func (suite *InstanceSuite) createSpec(mutate ...func(*containers.ContainerSpecS
 }
 
 // createNamedSpec creates a ContainerSpec with a fixed image, applying any mutators.
+//
+// The restart policy is the one ConfigController resolves for a container which sets none.
 func (suite *InstanceSuite) createNamedSpec(name string, mutate ...func(*containers.ContainerSpecSpec)) {
 	spec := containers.NewContainerSpec(containers.NamespaceName, name)
 	spec.TypedSpec().Image = containers.ContainerImageSpec{Ref: testImageRef}
+	spec.TypedSpec().Restart = containers.ContainerRestartSpec{
+		Mode:         containers.RestartModeAlways,
+		InitialDelay: 5 * time.Second,
+		MaxDelay:     5 * time.Minute,
+	}
 
 	for _, m := range mutate {
 		m(spec.TypedSpec())
@@ -212,7 +221,16 @@ func (suite *InstanceSuite) TestInstanceMirrorsFullSpec() {
 		}
 		spec.Network = containers.ContainerNetworkSpec{HostNetwork: true}
 		spec.Resources = containers.ContainerResourcesSpec{MemoryLimit: 1 << 29, CPULimit: 1500}
-		// DependsOn gates whether the instance exists; it is not carried onto it.
+		spec.HealthCheck = containers.ContainerHealthCheckSpec{
+			Kind:         containers.HealthCheckKindHTTP,
+			Port:         8080,
+			Path:         "/healthz",
+			InitialDelay: time.Second,
+			Period:       5 * time.Second,
+			Timeout:      time.Second,
+		}
+		// DependsOn gates whether the instance exists, and Restart when it is replaced; neither is
+		// carried onto it.
 		spec.DependsOn = containers.ContainerDependsOnSpec{Time: true}
 	})
 	suite.markImageReady()
@@ -253,6 +271,14 @@ func (suite *InstanceSuite) TestInstanceMirrorsFullSpec() {
 			},
 			Network:   containers.ContainerNetworkSpec{HostNetwork: true},
 			Resources: containers.ContainerResourcesSpec{MemoryLimit: 1 << 29, CPULimit: 1500},
+			HealthCheck: containers.ContainerHealthCheckSpec{
+				Kind:         containers.HealthCheckKindHTTP,
+				Port:         8080,
+				Path:         "/healthz",
+				InitialDelay: time.Second,
+				Period:       5 * time.Second,
+				Timeout:      time.Second,
+			},
 		}, *instance.TypedSpec())
 	})
 }
@@ -438,22 +464,156 @@ func (suite *InstanceSuite) TestGatesOnPath() {
 	suite.assertInstance(0)
 }
 
-func (suite *InstanceSuite) TestUserVolumeMountStaysPending() {
+// setContainerStatus fakes the status controller's output for a peer container.
+func (suite *InstanceSuite) setContainerStatus(name string, healthy bool) {
+	status := containers.NewContainerStatus(containers.NamespaceName, name)
+	status.TypedSpec().Phase = containers.ContainerPhaseRunning
+	status.TypedSpec().Healthy = healthy
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+}
+
+// TestGatesOnContainers covers dependsOn.containers: a running peer is not enough, it has to be
+// healthy.
+func (suite *InstanceSuite) TestGatesOnContainers() {
+	suite.createSpec(func(spec *containers.ContainerSpecSpec) {
+		spec.DependsOn.Containers = []string{"registry"}
+	})
+	suite.markImageReady()
+
+	suite.tick()
+	suite.assertNoInstance(0)
+
+	suite.setContainerStatus("registry", false)
+
+	suite.tick()
+	suite.assertNoInstance(0)
+
+	ctest.UpdateWithConflicts(suite, containers.NewContainerStatus(containers.NamespaceName, "registry"),
+		func(status *containers.ContainerStatus) error {
+			status.TypedSpec().Healthy = true
+
+			return nil
+		})
+
+	suite.assertInstance(0)
+}
+
+// setMountStatus fakes MountController's output for testContainer, creating or replacing it.
+func (suite *InstanceSuite) setMountStatus(volumes ...containers.ContainerVolumeMountSpec) {
+	status := containers.NewContainerMountStatus(containers.NamespaceName, testContainer)
+	status.TypedSpec().Volumes = volumes
+
+	if _, err := suite.State().Get(suite.Ctx(), status.Metadata()); err != nil {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+
+		return
+	}
+
+	ctest.UpdateWithConflicts(suite, status, func(res *containers.ContainerMountStatus) error {
+		*res.TypedSpec() = *status.TypedSpec()
+
+		return nil
+	})
+}
+
+// TestUserVolumeMountWaitsForVolume covers the userVolume gate: the container waits for the volume
+// to be mounted, binds from its host path, and is stopped when the volume closes.
+func (suite *InstanceSuite) TestUserVolumeMountWaitsForVolume() {
 	suite.createSpec(func(spec *containers.ContainerSpecSpec) {
 		spec.Mounts = []containers.ContainerMountSpec{
 			{
 				Kind:        containers.MountKindUserVolume,
 				VolumeID:    "u-web-content",
 				Destination: "/usr/share/nginx/html",
+				Options:     []string{"ro"},
+			},
+		}
+	})
+	suite.markImageReady()
+
+	suite.tick()
+	suite.assertNoInstance(0)
+
+	volume := containers.ContainerVolumeMountSpec{VolumeID: "u-web-content", Target: "/var/mnt/web-content"}
+
+	suite.setMountStatus(volume)
+
+	ctest.AssertResource(suite, containers.InstanceID(testContainer, 0), func(instance *containers.ContainerInstanceSpec, asrt *assert.Assertions) {
+		asrt.Equal([]containers.ResolvedMountSpec{
+			{
+				Kind:        containers.MountKindUserVolume,
+				Source:      "/var/mnt/web-content",
+				Destination: "/usr/share/nginx/html",
+				Options:     []string{"ro"},
 			},
+		}, instance.TypedSpec().Mounts)
+	})
+
+	// The volume closing stops the instance, and the next generation waits for it to come back.
+	suite.setMountStatus()
+
+	suite.assertNoInstance(0)
+	suite.tick()
+	suite.assertNoInstance(1)
+
+	suite.setMountStatus(volume)
+
+	suite.assertInstance(1)
+}
+
+// setNetworkStatus fakes NetworkController's output for testContainer, creating or replacing it.
+func (suite *InstanceSuite) setNetworkStatus(address string, linkUp bool) {
+	status := containers.NewContainerNetworkStatus(containers.NamespaceName, testContainer)
+	status.TypedSpec().Address = netip.MustParsePrefix(address)
+	status.TypedSpec().Link = "br-containers"
+	status.TypedSpec().LinkUp = linkUp
+
+	if _, err := suite.State().Get(suite.Ctx(), status.Metadata()); err != nil {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+
+		return
+	}
+
+	ctest.UpdateWithConflicts(suite, status, func(res *containers.ContainerNetworkStatus) error {
+		*res.TypedSpec() = *status.TypedSpec()
+
+		return nil
+	})
+}
+
+// TestBridgeNetworkWaitsForAddress covers the network gate: the container waits for its address and
+// its link, carries the address on the instance, and is replaced when the address changes.
+func (suite *InstanceSuite) TestBridgeNetworkWaitsForAddress() {
+	suite.createSpec(func(spec *containers.ContainerSpecSpec) {
+		spec.Network = containers.ContainerNetworkSpec{
+			Kind:    containers.NetworkKindBridge,
+			Link:    "br-containers",
+			Subnet:  netip.MustParsePrefix("10.88.0.0/24"),
+			Gateway: netip.MustParseAddr("10.88.0.1"),
 		}
 	})
 	suite.markImageReady()
 
-	// There is no MountController yet to resolve the volume's host path, so the container never
-	// becomes ready.
 	suite.tick()
 	suite.assertNoInstance(0)
+
+	suite.setNetworkStatus("10.88.0.2/24", false)
+
+	suite.tick()
+	suite.assertNoInstance(0)
+
+	suite.setNetworkStatus("10.88.0.2/24", true)
+
+	ctest.AssertResource(suite, containers.InstanceID(testContainer, 0), func(instance *containers.ContainerInstanceSpec, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParsePrefix("10.88.0.2/24"), instance.TypedSpec().Address)
+	})
+
+	suite.setNetworkStatus("10.88.0.3/24", true)
+
+	ctest.AssertResource(suite, containers.InstanceID(testContainer, 1), func(instance *containers.ContainerInstanceSpec, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParsePrefix("10.88.0.3/24"), instance.TypedSpec().Address)
+	})
 }
 
 // TestResolvesTmpfsAndHostPathMounts covers the mount kinds that need no MountController.
@@ -541,11 +701,18 @@ func (suite *InstanceSuite) TestEveryComparedFieldReplacesInstance() {
 		func(spec *containers.ContainerSpecSpec) { spec.Security.CapabilitiesAdd = []string{"NET_ADMIN"} },
 		func(spec *containers.ContainerSpecSpec) { spec.Security.CapabilitiesDrop = []string{"ALL"} },
 		func(spec *containers.ContainerSpecSpec) { spec.Network.HostNetwork = true },
+		func(spec *containers.ContainerSpecSpec) {
+			spec.Network.Ports = []containers.ContainerPortSpec{{Protocol: nethelpers.ProtocolTCP, HostPort: 8080, ContainerPort: 80}}
+		},
 		func(spec *containers.ContainerSpecSpec) { spec.Resources.MemoryLimit = 1 << 29 },
 		func(spec *containers.ContainerSpecSpec) { spec.Resources.CPULimit = 1500 },
 		func(spec *containers.ContainerSpecSpec) {
 			spec.Mounts = []containers.ContainerMountSpec{{Kind: containers.MountKindTmpfs, Destination: "/tmp"}}
 		},
+		func(spec *containers.ContainerSpecSpec) {
+			spec.HealthCheck = containers.ContainerHealthCheckSpec{Kind: containers.HealthCheckKindTCP, Port: 5000}
+		},
+		func(spec *containers.ContainerSpecSpec) { spec.HealthCheck.Port = 5001 },
 	}
 
 	for i, mutate := range mutations {
@@ -835,3 +1002,135 @@ func (suite *InstanceSuite) TestRemovesInstancesWhenSpecGoesAway() {
 
 	suite.assertNoInstance(0)
 }
+
+// setInstanceStatus fakes the runtime controller's output for the given generation of testContainer,
+// applying any mutators.
+func (suite *InstanceSuite) setInstanceStatus(
+	generation uint64,
+	phase containers.ContainerInstancePhase,
+	finishedAt time.Time,
+	mutate ...func(*containers.ContainerInstanceStatusSpec),
+) {
+	status := containers.NewContainerInstanceStatus(containers.NamespaceName, containers.InstanceID(testContainer, generation))
+	status.TypedSpec().ContainerID = testContainer
+	status.TypedSpec().Generation = generation
+	status.TypedSpec().Phase = phase
+	status.TypedSpec().FinishedAt = finishedAt
+
+	for _, m := range mutate {
+		m(status.TypedSpec())
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), status))
+}
+
+func (suite *InstanceSuite) TestRunningInstanceIsKept() {
+	suite.createSpec()
+	suite.markImageReady()
+
+	suite.assertInstance(0)
+
+	suite.setInstanceStatus(0, containers.ContainerInstancePhaseRunning, time.Time{})
+
+	suite.tick()
+	suite.assertInstance(0)
+	suite.assertNoInstance(1)
+}
+
+func (suite *InstanceSuite) TestTerminatedInstanceIsRestarted() {
+	suite.createSpec()
+	suite.markImageReady()
+
+	suite.assertInstance(0)
+
+	suite.setInstanceStatus(0, containers.ContainerInstancePhaseExited, time.Now().Add(-time.Minute))
+
+	suite.assertNoInstance(0)
+	suite.assertInstance(1)
+}
+
+// TestRestartIsDelayed covers the restart delay: a task which has just exited is not replaced until
+// the delay has passed, and nothing but the controller's own timer wakes it up for that.
+func (suite *InstanceSuite) TestRestartIsDelayed() {
+	suite.createSpec()
+	suite.markImageReady()
+
+	suite.assertInstance(0)
+
+	suite.setInstanceStatus(0, containers.ContainerInstancePhaseFailed, time.Now())
+
+	suite.tick()
+	suite.assertInstance(0)
+	suite.assertNoInstance(1)
+
+	suite.assertInstance(1)
+}
+
+// TestRestartPolicyNeverKeepsInstance covers a terminated task the policy leaves stopped: the
+// instance stays in place, recording the outcome, and no next generation appears.
+func (suite *InstanceSuite) TestRestartPolicyNeverKeepsInstance() {
+	suite.createSpec(func(spec *containers.ContainerSpecSpec) {
+		spec.Restart.Mode = containers.RestartModeNever
+	})
+	suite.markImageReady()
+
+	suite.assertInstance(0)
+
+	suite.setInstanceStatus(0, containers.ContainerInstancePhaseFailed, time.Now().Add(-time.Hour))
+
+	suite.tick()
+	suite.assertInstance(0)
+	suite.assertNoInstance(1)
+}
+
+func (suite *InstanceSuite) TestRestartPolicyOnFailure() {
+	suite.createSpec(func(spec *containers.ContainerSpecSpec) {
+		spec.Restart.Mode = containers.RestartModeOnFailure
+	})
+	suite.markImageReady()
+
+	suite.assertInstance(0)
+
+	// A clean exit is left alone.
+	suite.setInstanceStatus(0, containers.ContainerInstancePhaseExited, time.Now().Add(-time.Hour))
+
+	suite.tick()
+	suite.assertInstance(0)
+	suite.assertNoInstance(1)
+
+	// A config change starts the container again regardless of the policy.
+	suite.updateSpec(func(spec *containers.ContainerSpecSpec) {
+		spec.Args = []string{"--verbose"}
+	})
+
+	suite.assertInstance(1)
+
+	// A non-zero exit is restarted.
+	suite.setInstanceStatus(1, containers.ContainerInstancePhaseExited, time.Now().Add(-time.Hour),
+		func(status *containers.ContainerInstanceStatusSpec) {
+			status.ExitCode = 1
+		})
+
+	suite.assertNoInstance(1)
+	suite.assertInstance(2)
+}
+
+// TestRestartPolicyMaxRetries covers the retry budget: once the runtime has restarted the container
+// maxRetries times, the next termination is final.
+func (suite *InstanceSuite) TestRestartPolicyMaxRetries() {
+	suite.createSpec(func(spec *containers.ContainerSpecSpec) {
+		spec.Restart.MaxRetries = 3
+	})
+	suite.markImageReady()
+
+	suite.assertInstance(0)
+
+	suite.setInstanceStatus(0, containers.ContainerInstancePhaseFailed, time.Now().Add(-time.Hour),
+		func(status *containers.ContainerInstanceStatusSpec) {
+			status.Restarts = 3
+		})
+
+	suite.tick()
+	suite.assertInstance(0)
+	suite.assertNoInstance(1)
+}
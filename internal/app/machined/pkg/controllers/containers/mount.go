@@ -0,0 +1,283 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+)
+
+// MountController keeps the user volumes the containers mount mounted, and reports where.
+//
+// Each volume mounted by any container gets one VolumeMountRequest, and a finalizer on the matching
+// VolumeMountStatus, which is what orders a volume close: the closing volume drops out of the
+// ContainerMountStatus of every container mounting it, InstanceController stops their instances, and
+// the finalizer is only released once no instance binds from the volume any more. The volume is
+// never unmounted from under a running container.
+type MountController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *MountController) Name() string {
+	return "containers.MountController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MountController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerSpecType,
+			Kind:      controller.InputWeak,
+		},
+		// Needed to hold a closing volume until no instance binds from it.
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerInstanceSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountStatusType,
+			Kind:      controller.InputStrong,
+		},
+		{
+			Namespace: block.NamespaceName,
+			Type:      block.VolumeMountRequestType,
+			Kind:      controller.InputDestroyReady,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MountController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: block.VolumeMountRequestType,
+			Kind: controller.OutputShared,
+		},
+		{
+			Type: containers.ContainerMountStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MountController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			logger.Error("failed to reconcile container mounts", zap.Error(err))
+
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// requestID is the ID of the VolumeMountRequest, and so of the VolumeMountStatus, for a volume.
+func (ctrl *MountController) requestID(volumeID string) string {
+	return ctrl.Name() + "-" + volumeID
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *MountController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	specs, err := safe.ReaderListAll[*containers.ContainerSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list container specs: %w", err)
+	}
+
+	instances, err := safe.ReaderListAll[*containers.ContainerInstanceSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list container instances: %w", err)
+	}
+
+	// The volumes each container mounts, in declaration order, and all of them together.
+	containerVolumes := map[string][]string{}
+	wanted := map[string]struct{}{}
+
+	for spec := range specs.All() {
+		for _, mount := range spec.TypedSpec().Mounts {
+			if mount.Kind != containers.MountKindUserVolume {
+				continue
+			}
+
+			containerVolumes[spec.Metadata().ID()] = append(containerVolumes[spec.Metadata().ID()], mount.VolumeID)
+			wanted[mount.VolumeID] = struct{}{}
+		}
+	}
+
+	// The host paths the instances bind from, whatever their phase: an instance being torn down may
+	// still have a task running.
+	inUse := map[string]struct{}{}
+
+	for instance := range instances.All() {
+		for _, mount := range instance.TypedSpec().Mounts {
+			if mount.Kind == containers.MountKindUserVolume {
+				inUse[mount.Source] = struct{}{}
+			}
+		}
+	}
+
+	for volumeID := range wanted {
+		if err = safe.WriterModify(ctx, r,
+			block.NewVolumeMountRequest(block.NamespaceName, ctrl.requestID(volumeID)),
+			func(req *block.VolumeMountRequest) error {
+				req.TypedSpec().Requester = ctrl.Name()
+				req.TypedSpec().VolumeID = volumeID
+
+				return nil
+			},
+		); err != nil {
+			if state.IsPhaseConflictError(err) {
+				// The request of an earlier use of the volume is still being torn down; it is
+				// recreated once it is gone.
+				continue
+			}
+
+			return fmt.Errorf("failed to create volume mount request for %q: %w", volumeID, err)
+		}
+	}
+
+	mountStatuses, err := safe.ReaderListAll[*block.VolumeMountStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list volume mount statuses: %w", err)
+	}
+
+	// The volumes held mounted for the containers, with their host paths.
+	mounted := map[string]string{}
+	// The volumes whose mount status still carries our finalizer.
+	held := map[string]struct{}{}
+
+	for mountStatus := range mountStatuses.All() {
+		if mountStatus.TypedSpec().Requester != ctrl.Name() {
+			continue
+		}
+
+		volumeID := mountStatus.TypedSpec().VolumeID
+		target := mountStatus.TypedSpec().Target
+
+		if _, isWanted := wanted[volumeID]; isWanted && mountStatus.Metadata().Phase() == resource.PhaseRunning {
+			if !mountStatus.Metadata().Finalizers().Has(ctrl.Name()) {
+				if err = r.AddFinalizer(ctx, mountStatus.Metadata(), ctrl.Name()); err != nil {
+					return fmt.Errorf("failed to add finalizer to volume mount status %q: %w", mountStatus.Metadata().ID(), err)
+				}
+			}
+
+			mounted[volumeID] = target
+			held[volumeID] = struct{}{}
+
+			continue
+		}
+
+		// The volume is closing, or no container mounts it any more.
+		if !mountStatus.Metadata().Finalizers().Has(ctrl.Name()) {
+			continue
+		}
+
+		if _, bound := inUse[target]; bound {
+			logger.Debug("volume is closing, waiting for the containers using it to stop", zap.String("volume", volumeID))
+
+			held[volumeID] = struct{}{}
+
+			continue
+		}
+
+		if err = r.RemoveFinalizer(ctx, mountStatus.Metadata(), ctrl.Name()); err != nil {
+			return fmt.Errorf("failed to remove finalizer from volume mount status %q: %w", mountStatus.Metadata().ID(), err)
+		}
+
+		logger.Debug("released container volume", zap.String("volume", volumeID))
+	}
+
+	if err = ctrl.cleanupRequests(ctx, r, wanted, held); err != nil {
+		return err
+	}
+
+	r.StartTrackingOutputs()
+
+	for containerID, volumeIDs := range containerVolumes {
+		if err = safe.WriterModify(ctx, r,
+			containers.NewContainerMountStatus(containers.NamespaceName, containerID),
+			func(status *containers.ContainerMountStatus) error {
+				status.TypedSpec().Volumes = nil
+
+				for _, volumeID := range volumeIDs {
+					if target, ok := mounted[volumeID]; ok {
+						status.TypedSpec().Volumes = append(status.TypedSpec().Volumes, containers.ContainerVolumeMountSpec{
+							VolumeID: volumeID,
+							Target:   target,
+						})
+					}
+				}
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("failed to write mount status %q: %w", containerID, err)
+		}
+	}
+
+	return safe.CleanupOutputs[*containers.ContainerMountStatus](ctx, r)
+}
+
+// cleanupRequests tears down the requests of volumes which no container mounts any more, once their
+// mount status has been released.
+func (ctrl *MountController) cleanupRequests(
+	ctx context.Context,
+	r controller.Runtime,
+	wanted, held map[string]struct{},
+) error {
+	requests, err := safe.ReaderListAll[*block.VolumeMountRequest](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list volume mount requests: %w", err)
+	}
+
+	for request := range requests.All() {
+		if request.Metadata().Owner() != ctrl.Name() {
+			continue
+		}
+
+		volumeID := request.TypedSpec().VolumeID
+
+		if _, isWanted := wanted[volumeID]; isWanted && request.Metadata().Phase() == resource.PhaseRunning {
+			continue
+		}
+
+		if _, isHeld := held[volumeID]; isHeld {
+			continue
+		}
+
+		okToDestroy, err := r.Teardown(ctx, request.Metadata())
+		if err != nil {
+			return fmt.Errorf("failed to tear down volume mount request %q: %w", request.Metadata().ID(), err)
+		}
+
+		if !okToDestroy {
+			continue
+		}
+
+		if err = r.Destroy(ctx, request.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to destroy volume mount request %q: %w", request.Metadata().ID(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	containersctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/containers"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+)
+
+const (
+	// testVolumeID is the user volume the test containers mount.
+	testVolumeID = "u-web-content"
+	// testVolumeTarget is where the faked block controllers mount testVolumeID.
+	testVolumeTarget = "/var/mnt/web-content"
+)
+
+type MountSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestMountSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &MountSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 15 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&containersctrl.MountController{}))
+			},
+		},
+	})
+}
+
+func (suite *MountSuite) requestID() string {
+	return (&containersctrl.MountController{}).Name() + "-" + testVolumeID
+}
+
+// createSpec creates a ContainerSpec mounting testVolumeID.
+func (suite *MountSuite) createSpec(name string) {
+	spec := containers.NewContainerSpec(containers.NamespaceName, name)
+	spec.TypedSpec().Image = containers.ContainerImageSpec{Ref: testImageRef}
+	spec.TypedSpec().Mounts = []containers.ContainerMountSpec{
+		{
+			Kind:        containers.MountKindTmpfs,
+			Destination: "/tmp",
+		},
+		{
+			Kind:        containers.MountKindUserVolume,
+			VolumeID:    testVolumeID,
+			Destination: "/usr/share/nginx/html",
+		},
+	}
+
+	suite.Create(spec)
+}
+
+// mountVolume fakes the block controllers fulfilling the volume mount request.
+func (suite *MountSuite) mountVolume() *block.VolumeMountStatus {
+	mountStatus := block.NewVolumeMountStatus(block.NamespaceName, suite.requestID())
+	mountStatus.TypedSpec().VolumeID = testVolumeID
+	mountStatus.TypedSpec().Requester = (&containersctrl.MountController{}).Name()
+	mountStatus.TypedSpec().Target = testVolumeTarget
+
+	suite.Create(mountStatus)
+
+	return mountStatus
+}
+
+// createInstance fakes an instance of the container binding from the volume.
+func (suite *MountSuite) createInstance(name string) *containers.ContainerInstanceSpec {
+	instance := containers.NewContainerInstanceSpec(containers.NamespaceName, containers.InstanceID(name, 0))
+	instance.TypedSpec().ContainerID = name
+	instance.TypedSpec().Mounts = []containers.ResolvedMountSpec{
+		{
+			Kind:        containers.MountKindUserVolume,
+			Source:      testVolumeTarget,
+			Destination: "/usr/share/nginx/html",
+		},
+	}
+
+	suite.Create(instance)
+
+	return instance
+}
+
+func (suite *MountSuite) assertMountStatus(name string, expected ...containers.ContainerVolumeMountSpec) {
+	ctest.AssertResource(suite, name, func(status *containers.ContainerMountStatus, asrt *assert.Assertions) {
+		asrt.Equal(expected, status.TypedSpec().Volumes)
+	})
+}
+
+func (suite *MountSuite) assertHeld(held bool) {
+	ctest.AssertResource(suite, suite.requestID(), func(mountStatus *block.VolumeMountStatus, asrt *assert.Assertions) {
+		asrt.Equal(held, mountStatus.Metadata().Finalizers().Has((&containersctrl.MountController{}).Name()))
+	})
+}
+
+func (suite *MountSuite) TestNoRequestWithoutUserVolume() {
+	spec := containers.NewContainerSpec(containers.NamespaceName, testContainer)
+	spec.TypedSpec().Image = containers.ContainerImageSpec{Ref: testImageRef}
+	suite.Create(spec)
+
+	// A second container that does mount a volume, to know a pass has run.
+	suite.createSpec("web")
+
+	ctest.AssertResource(suite, suite.requestID(), func(*block.VolumeMountRequest, *assert.Assertions) {})
+
+	ctest.AssertNoResource[*containers.ContainerMountStatus](suite, testContainer)
+}
+
+// TestRequestsSharedVolume covers two containers mounting the same volume: they share one request,
+// and each reports the volume once it is mounted.
+func (suite *MountSuite) TestRequestsSharedVolume() {
+	suite.createSpec(testContainer)
+	suite.createSpec("web")
+
+	ctest.AssertResource(suite, suite.requestID(), func(request *block.VolumeMountRequest, asrt *assert.Assertions) {
+		asrt.Equal(testVolumeID, request.TypedSpec().VolumeID)
+		asrt.Equal((&containersctrl.MountController{}).Name(), request.TypedSpec().Requester)
+		asrt.False(request.TypedSpec().ReadOnly)
+	})
+
+	// Nothing is mounted yet.
+	suite.assertMountStatus(testContainer)
+	suite.assertMountStatus("web")
+
+	suite.mountVolume()
+
+	suite.assertHeld(true)
+
+	volume := containers.ContainerVolumeMountSpec{VolumeID: testVolumeID, Target: testVolumeTarget}
+
+	suite.assertMountStatus(testContainer, volume)
+	suite.assertMountStatus("web", volume)
+}
+
+// TestClosingVolumeWaitsForInstances covers the teardown ordering: a closing volume is dropped
+// from the mount status straight away, but only released once no instance binds from it.
+func (suite *MountSuite) TestClosingVolumeWaitsForInstances() {
+	suite.createSpec(testContainer)
+
+	mountStatus := suite.mountVolume()
+	instance := suite.createInstance(testContainer)
+
+	suite.assertHeld(true)
+	suite.assertMountStatus(testContainer, containers.ContainerVolumeMountSpec{VolumeID: testVolumeID, Target: testVolumeTarget})
+
+	_, err := suite.State().Teardown(suite.Ctx(), mountStatus.Metadata())
+	suite.Require().NoError(err)
+
+	// The pass that drops the volume from the mount status is the one which saw it closing, and kept
+	// the finalizer because of the instance.
+	suite.assertMountStatus(testContainer)
+	suite.assertHeld(true)
+
+	suite.Destroy(instance)
+
+	suite.assertHeld(false)
+
+	// The request stays: the container still wants the volume once it is back.
+	ctest.AssertResource(suite, suite.requestID(), func(*block.VolumeMountRequest, *assert.Assertions) {})
+}
+
+func (suite *MountSuite) TestReleasesVolumeWhenSpecGoesAway() {
+	suite.createSpec(testContainer)
+	suite.mountVolume()
+
+	suite.assertHeld(true)
+
+	suite.Destroy(containers.NewContainerSpec(containers.NamespaceName, testContainer))
+
+	suite.assertHeld(false)
+
+	ctest.AssertNoResource[*block.VolumeMountRequest](suite, suite.requestID())
+	ctest.AssertNoResource[*containers.ContainerMountStatus](suite, testContainer)
+}
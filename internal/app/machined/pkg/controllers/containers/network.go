@@ -0,0 +1,396 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// NfTables chains rendered for the containers.
+const (
+	NATPreroutingChainName  = "containers-nat-prerouting"
+	NATPostroutingChainName = "containers-nat-postrouting"
+)
+
+// NetworkController allocates the addresses of the containers attached to a host link, and publishes
+// their ports.
+//
+// Addresses come from the subnet each container declares, and are sticky: a container keeps its
+// address for as long as its link and subnet stay the same, so a restarted instance comes back on the
+// same address. The first free address is handed out, skipping the gateway, the node's own addresses
+// and those already given to other containers.
+//
+// Published ports and the masquerading of the bridge subnets are NfTablesChain resources, rendered by
+// the network controllers like any other chain.
+type NetworkController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NetworkController) Name() string {
+	return "containers.NetworkController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NetworkController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        optional.Some(network.NodeAddressCurrentID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NetworkController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: containers.ContainerNetworkStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.NfTablesChainType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NetworkController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			logger.Error("failed to reconcile container networks", zap.Error(err))
+
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *NetworkController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	specs, err := safe.ReaderListAll[*containers.ContainerSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list container specs: %w", err)
+	}
+
+	links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list links: %w", err)
+	}
+
+	nodeAddresses, err := safe.ReaderGetByID[*network.NodeAddress](ctx, r, network.NodeAddressCurrentID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("failed to get node addresses: %w", err)
+	}
+
+	statuses, err := safe.ReaderListAll[*containers.ContainerNetworkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list network statuses: %w", err)
+	}
+
+	// The containers attached to a host link, in ID order so that allocation is deterministic.
+	var attached []*containers.ContainerSpec
+
+	for spec := range specs.All() {
+		if spec.TypedSpec().Network.Kind != "" {
+			attached = append(attached, spec)
+		}
+	}
+
+	slices.SortFunc(attached, func(a, b *containers.ContainerSpec) int {
+		return cmp.Compare(a.Metadata().ID(), b.Metadata().ID())
+	})
+
+	taken := map[netip.Addr]struct{}{}
+
+	if nodeAddresses != nil {
+		for _, addr := range nodeAddresses.TypedSpec().Addresses {
+			taken[addr.Addr()] = struct{}{}
+		}
+	}
+
+	addresses := make(map[string]netip.Prefix, len(attached))
+
+	// Existing allocations are kept first, so that a new container never takes over the address of
+	// one which is already running.
+	for _, spec := range attached {
+		status, found := statuses.Find(func(status *containers.ContainerNetworkStatus) bool {
+			return status.Metadata().ID() == spec.Metadata().ID()
+		})
+		if !found {
+			continue
+		}
+
+		address, ok := status.TypedSpec().AddressOn(spec.TypedSpec().Network)
+		if !ok || address.Addr() == spec.TypedSpec().Network.Gateway {
+			continue
+		}
+
+		if _, conflict := taken[address.Addr()]; conflict {
+			continue
+		}
+
+		addresses[spec.Metadata().ID()] = address
+		taken[address.Addr()] = struct{}{}
+	}
+
+	for _, spec := range attached {
+		if _, ok := addresses[spec.Metadata().ID()]; ok {
+			continue
+		}
+
+		subnet := spec.TypedSpec().Network.Subnet
+
+		addr, ok := allocateAddress(subnet, spec.TypedSpec().Network.Gateway, taken)
+		if !ok {
+			logger.Warn("no free address left in the container subnet",
+				zap.String("container", spec.Metadata().ID()),
+				zap.Stringer("subnet", subnet),
+			)
+
+			continue
+		}
+
+		addresses[spec.Metadata().ID()] = netip.PrefixFrom(addr, subnet.Bits())
+		taken[addr] = struct{}{}
+
+		logger.Debug("allocated container address",
+			zap.String("container", spec.Metadata().ID()),
+			zap.Stringer("address", addr),
+		)
+	}
+
+	r.StartTrackingOutputs()
+
+	for _, spec := range attached {
+		networkSpec := spec.TypedSpec().Network
+
+		if err = safe.WriterModify(ctx, r,
+			containers.NewContainerNetworkStatus(containers.NamespaceName, spec.Metadata().ID()),
+			func(status *containers.ContainerNetworkStatus) error {
+				status.TypedSpec().Address = addresses[spec.Metadata().ID()]
+				status.TypedSpec().Link = networkSpec.Link
+				status.TypedSpec().LinkUp = linkUp(links, networkSpec)
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("failed to write network status %q: %w", spec.Metadata().ID(), err)
+		}
+	}
+
+	preroutingRules := portRules(attached, addresses, nodeAddresses)
+	if preroutingRules != nil {
+		if err = safe.WriterModify(ctx, r,
+			network.NewNfTablesChain(network.NamespaceName, NATPreroutingChainName),
+			func(chain *network.NfTablesChain) error {
+				spec := chain.TypedSpec()
+
+				spec.Type = nethelpers.ChainTypeNAT
+				spec.Hook = nethelpers.ChainHookPrerouting
+				spec.Priority = nethelpers.ChainPriorityNATDest
+				spec.Policy = nethelpers.VerdictAccept
+				spec.Rules = preroutingRules
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("failed to write chain %q: %w", NATPreroutingChainName, err)
+		}
+	}
+
+	postroutingRules := masqueradeRules(attached)
+	if postroutingRules != nil {
+		if err = safe.WriterModify(ctx, r,
+			network.NewNfTablesChain(network.NamespaceName, NATPostroutingChainName),
+			func(chain *network.NfTablesChain) error {
+				spec := chain.TypedSpec()
+
+				spec.Type = nethelpers.ChainTypeNAT
+				spec.Hook = nethelpers.ChainHookPostrouting
+				spec.Priority = nethelpers.ChainPriorityNATSource
+				spec.Policy = nethelpers.VerdictAccept
+				spec.Rules = postroutingRules
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("failed to write chain %q: %w", NATPostroutingChainName, err)
+		}
+	}
+
+	if err = safe.CleanupOutputs[*containers.ContainerNetworkStatus](ctx, r); err != nil {
+		return err
+	}
+
+	return safe.CleanupOutputs[*network.NfTablesChain](ctx, r)
+}
+
+// allocateAddress returns the first address of the subnet which is neither taken nor the gateway.
+//
+// The subnet address itself is never handed out, nor is the IPv4 broadcast address.
+func allocateAddress(subnet netip.Prefix, gateway netip.Addr, taken map[netip.Addr]struct{}) (netip.Addr, bool) {
+	for addr := subnet.Addr().Next(); addr.IsValid() && subnet.Contains(addr); addr = addr.Next() {
+		if addr.Is4() && !subnet.Contains(addr.Next()) {
+			break
+		}
+
+		if addr == gateway {
+			continue
+		}
+
+		if _, ok := taken[addr]; ok {
+			continue
+		}
+
+		return addr, true
+	}
+
+	return netip.Addr{}, false
+}
+
+// linkUp reports whether the link a container attaches to exists and is up.
+//
+// A bridge is only ready if it is a bridge: the CNI bridge plugin would otherwise create one by the
+// same name, out of the control of the machine configuration.
+func linkUp(links safe.List[*network.LinkStatus], networkSpec containers.ContainerNetworkSpec) bool {
+	link, ok := links.Find(func(link *network.LinkStatus) bool {
+		return link.Metadata().ID() == networkSpec.Link
+	})
+	if !ok {
+		return false
+	}
+
+	if networkSpec.Kind == containers.NetworkKindBridge && link.TypedSpec().Kind != "bridge" {
+		return false
+	}
+
+	return link.TypedSpec().Flags&unix.IFF_UP == unix.IFF_UP
+}
+
+// portRules builds the destination NAT rules forwarding the published ports to the containers.
+//
+// Only traffic addressed to the node itself is forwarded, transit traffic is left untouched.
+func portRules(attached []*containers.ContainerSpec, addresses map[string]netip.Prefix, nodeAddresses *network.NodeAddress) []network.NfTablesRule {
+	if nodeAddresses == nil {
+		return nil
+	}
+
+	nodePrefixes := make([]netip.Prefix, 0, len(nodeAddresses.TypedSpec().Addresses))
+
+	for _, addr := range nodeAddresses.TypedSpec().Addresses {
+		nodePrefixes = append(nodePrefixes, netip.PrefixFrom(addr.Addr(), addr.Addr().BitLen()))
+	}
+
+	var rules []network.NfTablesRule
+
+	for _, spec := range attached {
+		address, ok := addresses[spec.Metadata().ID()]
+		if !ok {
+			continue
+		}
+
+		for _, port := range spec.TypedSpec().Network.Ports {
+			rules = append(rules, network.NfTablesRule{
+				MatchDestinationAddress: &network.NfTablesAddressMatch{
+					IncludeSubnets: nodePrefixes,
+				},
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: port.Protocol,
+					MatchDestinationPort: &network.NfTablesPortMatch{
+						Ranges: []network.PortRange{{Lo: port.HostPort, Hi: port.HostPort}},
+					},
+				},
+				AnonCounter: true,
+				DestinationNAT: &network.NfTablesNAT{
+					Address: address.Addr(),
+					Port:    port.ContainerPort,
+				},
+			})
+		}
+	}
+
+	return rules
+}
+
+// masqueradeRules builds the source NAT rules for the traffic of the bridge subnets leaving the node.
+//
+// A bridge subnet without a gateway has no way out, and a macvlan container sits directly on the
+// network of its parent link, so neither of them is masqueraded.
+func masqueradeRules(attached []*containers.ContainerSpec) []network.NfTablesRule {
+	type bridgeSubnet struct {
+		link   string
+		subnet netip.Prefix
+	}
+
+	var (
+		rules []network.NfTablesRule
+		seen  = map[bridgeSubnet]struct{}{}
+	)
+
+	for _, spec := range attached {
+		networkSpec := spec.TypedSpec().Network
+
+		if networkSpec.Kind != containers.NetworkKindBridge || !networkSpec.Gateway.IsValid() {
+			continue
+		}
+
+		key := bridgeSubnet{link: networkSpec.Link, subnet: networkSpec.Subnet}
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+
+		rules = append(rules, network.NfTablesRule{
+			MatchOIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{networkSpec.Link},
+				Operator:       nethelpers.OperatorNotEqual,
+			},
+			MatchSourceAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{networkSpec.Subnet},
+			},
+			AnonCounter: true,
+			// no address means masquerade
+			SourceNAT: &network.NfTablesNAT{},
+		})
+	}
+
+	return rules
+}
@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	containersctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/containers"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// testBridge is the bridge the test containers attach to.
+const testBridge = "br-containers"
+
+type NetworkSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestNetworkSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &NetworkSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 15 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&containersctrl.NetworkController{}))
+			},
+		},
+	})
+}
+
+// createSpec creates a ContainerSpec attached to testBridge.
+func (suite *NetworkSuite) createSpec(name string, ports ...containers.ContainerPortSpec) *containers.ContainerSpec {
+	spec := containers.NewContainerSpec(containers.NamespaceName, name)
+	spec.TypedSpec().Image = containers.ContainerImageSpec{Ref: testImageRef}
+	spec.TypedSpec().Network = containers.ContainerNetworkSpec{
+		Kind:    containers.NetworkKindBridge,
+		Link:    testBridge,
+		Subnet:  netip.MustParsePrefix("10.88.0.0/24"),
+		Gateway: netip.MustParseAddr("10.88.0.1"),
+		Ports:   ports,
+	}
+
+	suite.Create(spec)
+
+	return spec
+}
+
+func (suite *NetworkSuite) assertAddress(name, expected string) {
+	ctest.AssertResource(suite, name, func(status *containers.ContainerNetworkStatus, asrt *assert.Assertions) {
+		asrt.Equal(netip.MustParsePrefix(expected), status.TypedSpec().Address)
+		asrt.Equal(testBridge, status.TypedSpec().Link)
+	})
+}
+
+func (suite *NetworkSuite) createNodeAddresses(addresses ...string) {
+	nodeAddresses := network.NewNodeAddress(network.NamespaceName, network.NodeAddressCurrentID)
+
+	for _, address := range addresses {
+		nodeAddresses.TypedSpec().Addresses = append(nodeAddresses.TypedSpec().Addresses, netip.MustParsePrefix(address))
+	}
+
+	suite.Create(nodeAddresses)
+}
+
+// TestAllocatesStickyAddresses covers the allocation order: the gateway and the node's own addresses
+// are skipped, and a container keeps its address when one sorting before it shows up.
+func (suite *NetworkSuite) TestAllocatesStickyAddresses() {
+	suite.createNodeAddresses("10.88.0.2/24", "192.168.1.10/24")
+
+	suite.createSpec("web")
+
+	suite.assertAddress("web", "10.88.0.3/24")
+
+	suite.createSpec("api")
+
+	suite.assertAddress("api", "10.88.0.4/24")
+	suite.assertAddress("web", "10.88.0.3/24")
+
+	// Host and isolated containers get no status.
+	spec := containers.NewContainerSpec(containers.NamespaceName, "host")
+	spec.TypedSpec().Network = containers.ContainerNetworkSpec{HostNetwork: true}
+	suite.Create(spec)
+
+	ctest.AssertNoResource[*containers.ContainerNetworkStatus](suite, "host")
+}
+
+func (suite *NetworkSuite) TestLinkUp() {
+	suite.createSpec(testContainer)
+
+	ctest.AssertResource(suite, testContainer, func(status *containers.ContainerNetworkStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().LinkUp)
+	})
+
+	// A link by the right name which is not a bridge does not do.
+	link := network.NewLinkStatus(network.NamespaceName, testBridge)
+	link.TypedSpec().Flags = unix.IFF_UP
+	suite.Create(link)
+
+	ctest.AssertResource(suite, testContainer, func(status *containers.ContainerNetworkStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().LinkUp)
+	})
+
+	link.TypedSpec().Kind = "bridge"
+	suite.Update(link)
+
+	ctest.AssertResource(suite, testContainer, func(status *containers.ContainerNetworkStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().LinkUp)
+	})
+}
+
+func (suite *NetworkSuite) TestPublishesPorts() {
+	suite.createNodeAddresses("192.168.1.10/24")
+
+	spec := suite.createSpec("web", containers.ContainerPortSpec{
+		Protocol:      nethelpers.ProtocolTCP,
+		HostPort:      8080,
+		ContainerPort: 80,
+	})
+
+	ctest.AssertResource(suite, containersctrl.NATPreroutingChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.ChainTypeNAT, chain.TypedSpec().Type)
+		asrt.Equal(nethelpers.ChainHookPrerouting, chain.TypedSpec().Hook)
+
+		if !asrt.Len(chain.TypedSpec().Rules, 1) {
+			return
+		}
+
+		rule := chain.TypedSpec().Rules[0]
+
+		asrt.Equal([]netip.Prefix{netip.MustParsePrefix("192.168.1.10/32")}, rule.MatchDestinationAddress.IncludeSubnets)
+		asrt.Equal(nethelpers.ProtocolTCP, rule.MatchLayer4.Protocol)
+		asrt.Equal([]network.PortRange{{Lo: 8080, Hi: 8080}}, rule.MatchLayer4.MatchDestinationPort.Ranges)
+		asrt.Equal(&network.NfTablesNAT{Address: netip.MustParseAddr("10.88.0.2"), Port: 80}, rule.DestinationNAT)
+	})
+
+	ctest.AssertResource(suite, containersctrl.NATPostroutingChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		asrt.Equal(nethelpers.ChainHookPostrouting, chain.TypedSpec().Hook)
+
+		if !asrt.Len(chain.TypedSpec().Rules, 1) {
+			return
+		}
+
+		rule := chain.TypedSpec().Rules[0]
+
+		asrt.Equal(&network.NfTablesIfNameMatch{
+			InterfaceNames: []string{testBridge},
+			Operator:       nethelpers.OperatorNotEqual,
+		}, rule.MatchOIfName)
+		asrt.Equal([]netip.Prefix{netip.MustParsePrefix("10.88.0.0/24")}, rule.MatchSourceAddress.IncludeSubnets)
+		asrt.Equal(&network.NfTablesNAT{}, rule.SourceNAT)
+	})
+
+	suite.Destroy(spec)
+
+	ctest.AssertNoResource[*network.NfTablesChain](suite, containersctrl.NATPreroutingChainName)
+	ctest.AssertNoResource[*network.NfTablesChain](suite, containersctrl.NATPostroutingChainName)
+	ctest.AssertNoResource[*containers.ContainerNetworkStatus](suite, "web")
+}
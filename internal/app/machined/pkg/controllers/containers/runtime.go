@@ -0,0 +1,693 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/panicsafe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/health"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// defaultStopTimeout is how long a task gets to exit after SIGTERM before it is killed.
+const defaultStopTimeout = 10 * time.Second
+
+// Runtime runs container instances in the taloscontainers namespace.
+//
+// Like Puller, this is the seam that keeps the controller testable: the default implementation
+// talks to containerd, tests substitute a fake.
+type Runtime interface {
+	// Start creates the instance's container and starts its task, with the task's stdout and stderr
+	// going to logW.
+	Start(ctx context.Context, logger *zap.Logger, id string, spec *containers.ContainerInstanceSpecSpec, logW io.Writer) (Task, error)
+	// Close releases the underlying client.
+	Close() error
+}
+
+// Task is a started container task.
+type Task interface {
+	// PID returns the host PID of the task's init process.
+	PID() uint32
+	// Wait blocks until the task exits and returns its exit status.
+	//
+	// An error means the task could not be tracked, not that it failed.
+	Wait(ctx context.Context) (uint32, error)
+	// Kill sends the signal to every process of the task.
+	Kill(ctx context.Context, signal syscall.Signal) error
+	// Exec runs a command inside the task's container and returns its exit status.
+	//
+	// The command is killed once ctx is done.
+	Exec(ctx context.Context, args []string) (uint32, error)
+	// Delete removes the task along with its container and snapshot.
+	Delete(ctx context.Context) error
+}
+
+// RuntimeController runs the tasks for ContainerInstanceSpecs.
+//
+// It is the only part of the chain with side effects on the node: each instance gets a supervising
+// goroutine which creates the container, starts its task and waits for it, and a finalizer which
+// holds the instance until the task is gone. An instance runs at most once. A task which exits is
+// not restarted here; the instance is left in place, and InstanceController replaces it with the
+// next generation.
+//
+// The task output goes to the machined log for the container name (see containers.LogID), so
+// `talosctl logs container-<name>` works the same as for a Talos service, across generations. A container declaring a health check is
+// probed while its task runs, and the outcome is reported on the instance status.
+type RuntimeController struct {
+	// V1Alpha1Logging provides the log the container output is written to.
+	V1Alpha1Logging runtime.LoggingManager
+
+	// RuntimeProvider is overridable for testing.
+	RuntimeProvider func() (Runtime, error)
+
+	// StopTimeout is overridable for testing.
+	StopTimeout time.Duration
+
+	// tasks tracks the supervised tasks, keyed by instance ID.
+	tasks map[string]*taskState
+
+	// restarts tracks the restart counts, keyed by container ID.
+	restarts map[string]*restartCount
+}
+
+// restartCount counts the instances of a container which terminated on their own.
+type restartCount struct {
+	count uint64
+	// pending is set when an instance has terminated, and is consumed by the next instance started.
+	pending bool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *RuntimeController) Name() string {
+	return "containers.RuntimeController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RuntimeController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerInstanceSpecType,
+			Kind:      controller.InputStrong,
+		},
+		// Needed to forget the restart count of a container which is gone.
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some(criServiceID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RuntimeController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: containers.ContainerInstanceStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// taskState tracks one supervised task.
+type taskState struct {
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+
+	// counted is set once a task which terminated on its own was added to the restart count.
+	counted bool
+
+	// health is the outcome of the health check, while one runs.
+	health       health.State
+	checksHealth bool
+
+	mu      sync.Mutex
+	status  containers.ContainerInstanceStatusSpec
+	stopped bool
+	done    bool
+}
+
+func (state *taskState) snapshot() (status containers.ContainerInstanceStatusSpec, stopped, done bool) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	status = state.status
+
+	if state.checksHealth && status.Phase == containers.ContainerInstancePhaseRunning {
+		healthStatus := state.health.Get()
+
+		status.Health = containers.ContainerHealthSpec{
+			Unknown:     healthStatus.Healthy == nil,
+			Healthy:     healthStatus.Healthy != nil && *healthStatus.Healthy,
+			LastMessage: healthStatus.LastMessage,
+			LastChange:  healthStatus.LastChange,
+		}
+	}
+
+	return status, state.stopped, state.done
+}
+
+func (state *taskState) update(f func(*containers.ContainerInstanceStatusSpec)) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	f(&state.status)
+}
+
+func (state *taskState) finish(exitCode uint32, err error) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.done = true
+	state.status.FinishedAt = time.Now()
+
+	if err != nil {
+		state.status.LastError = err.Error()
+	}
+
+	if state.status.StartedAt.IsZero() {
+		state.status.Phase = containers.ContainerInstancePhaseFailed
+
+		return
+	}
+
+	state.status.Phase = containers.ContainerInstancePhaseExited
+	state.status.ExitCode = exitCode
+}
+
+// stop asks the task to stop, without waiting for it.
+func (state *taskState) stop() {
+	state.stopOnce.Do(func() {
+		state.mu.Lock()
+		state.stopped = true
+		state.mu.Unlock()
+
+		close(state.stopCh)
+	})
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *RuntimeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.RuntimeProvider == nil {
+		ctrl.RuntimeProvider = defaultRuntimeProvider
+	}
+
+	if ctrl.StopTimeout == 0 {
+		ctrl.StopTimeout = defaultStopTimeout
+	}
+
+	ctrl.tasks = map[string]*taskState{}
+	ctrl.restarts = map[string]*restartCount{}
+
+	notifyCh := make(chan struct{}, 1)
+
+	var rt Runtime
+
+	// Registered before the task-stopping defer so that it runs after it: the tasks use the
+	// runtime's client, so they have to be joined before it is closed.
+	defer func() {
+		if rt != nil {
+			rt.Close() //nolint:errcheck
+		}
+	}()
+
+	// Tasks are stopped rather than abandoned when the controller goes away: a container nobody
+	// supervises would keep running with no status and no way to stop it short of a reboot.
+	defer func() {
+		for _, task := range ctrl.tasks {
+			task.stop()
+			defer task.wg.Wait()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-notifyCh:
+		}
+
+		criUp, err := criIsUp(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		if criUp && rt == nil {
+			if rt, err = ctrl.RuntimeProvider(); err != nil {
+				logger.Error("failed to create container runtime", zap.Error(err))
+
+				return fmt.Errorf("failed to create container runtime: %w", err)
+			}
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger, rt, notifyCh); err != nil {
+			logger.Error("failed to reconcile container tasks", zap.Error(err))
+
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *RuntimeController) reconcile(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	rt Runtime,
+	notifyCh chan struct{},
+) error {
+	instances, err := safe.ReaderListAll[*containers.ContainerInstanceSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list container instances: %w", err)
+	}
+
+	specs, err := safe.ReaderListAll[*containers.ContainerSpec](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list container specs: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	existing := map[string]struct{}{}
+
+	for instance := range instances.All() {
+		instanceID := instance.Metadata().ID()
+		existing[instanceID] = struct{}{}
+
+		switch instance.Metadata().Phase() {
+		case resource.PhaseRunning:
+			if !instance.Metadata().Finalizers().Has(ctrl.Name()) {
+				if err := r.AddFinalizer(ctx, instance.Metadata(), ctrl.Name()); err != nil {
+					return fmt.Errorf("failed to add finalizer to instance %q: %w", instanceID, err)
+				}
+			}
+
+			task, exists := ctrl.tasks[instanceID]
+			if !exists {
+				if rt == nil {
+					// Waiting for the CRI service; report pending so the operator can see why.
+					if err := ctrl.writeStatus(ctx, r, instanceID, containers.ContainerInstanceStatusSpec{
+						ContainerID: instance.TypedSpec().ContainerID,
+						Generation:  instance.TypedSpec().Generation,
+						Phase:       containers.ContainerInstancePhasePending,
+					}); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				task = ctrl.startTask(ctx, logger, rt, instance, notifyCh)
+				ctrl.tasks[instanceID] = task
+			}
+
+			status, _, _ := task.snapshot()
+
+			if err := ctrl.writeStatus(ctx, r, instanceID, status); err != nil {
+				return err
+			}
+		case resource.PhaseTearingDown:
+			stopped, err := ctrl.stopTask(ctx, r, logger, instance)
+			if err != nil {
+				return err
+			}
+
+			if stopped {
+				// The status goes away with the instance, via the output cleanup below.
+				continue
+			}
+
+			status, _, _ := ctrl.tasks[instanceID].snapshot()
+
+			if err := ctrl.writeStatus(ctx, r, instanceID, status); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctrl.countRestarts()
+	ctrl.pruneTasks(logger, existing)
+	ctrl.pruneRestarts(specs)
+
+	return safe.CleanupOutputs[*containers.ContainerInstanceStatus](ctx, r)
+}
+
+// stopTask stops the task of an instance being torn down, and releases the instance once it is gone.
+//
+// It reports whether the instance was released.
+func (ctrl *RuntimeController) stopTask(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	instance *containers.ContainerInstanceSpec,
+) (bool, error) {
+	instanceID := instance.Metadata().ID()
+
+	if task, exists := ctrl.tasks[instanceID]; exists {
+		if _, _, done := task.snapshot(); !done {
+			logger.Debug("stopping container instance", zap.String("instance", instanceID))
+
+			// The supervisor notifies once the task is gone, which re-runs this pass.
+			task.stop()
+
+			return false, nil
+		}
+
+		task.wg.Wait()
+		delete(ctrl.tasks, instanceID)
+	}
+
+	if instance.Metadata().Finalizers().Has(ctrl.Name()) {
+		if err := r.RemoveFinalizer(ctx, instance.Metadata(), ctrl.Name()); err != nil {
+			return false, fmt.Errorf("failed to remove finalizer from instance %q: %w", instanceID, err)
+		}
+	}
+
+	return true, nil
+}
+
+// countRestarts adds the tasks which terminated on their own to the restart count of their container.
+func (ctrl *RuntimeController) countRestarts() {
+	for _, task := range ctrl.tasks {
+		status, stopped, done := task.snapshot()
+
+		if !done || stopped || task.counted {
+			continue
+		}
+
+		task.counted = true
+
+		count, exists := ctrl.restarts[status.ContainerID]
+		if !exists {
+			count = &restartCount{}
+			ctrl.restarts[status.ContainerID] = count
+		}
+
+		count.pending = true
+	}
+}
+
+// pruneTasks stops and forgets the tasks of instances which were destroyed without being torn down.
+func (ctrl *RuntimeController) pruneTasks(logger *zap.Logger, existing map[string]struct{}) {
+	for instanceID, task := range ctrl.tasks {
+		if _, exists := existing[instanceID]; exists {
+			continue
+		}
+
+		logger.Warn("container instance is gone, stopping its task", zap.String("instance", instanceID))
+
+		task.stop()
+		task.wg.Wait()
+		delete(ctrl.tasks, instanceID)
+	}
+}
+
+// pruneRestarts forgets the restart counts of containers which are no longer declared.
+func (ctrl *RuntimeController) pruneRestarts(specs safe.List[*containers.ContainerSpec]) {
+	for containerID := range ctrl.restarts {
+		if _, found := specs.Find(func(spec *containers.ContainerSpec) bool {
+			return spec.Metadata().ID() == containerID
+		}); !found {
+			delete(ctrl.restarts, containerID)
+		}
+	}
+}
+
+func (ctrl *RuntimeController) writeStatus(
+	ctx context.Context,
+	r controller.Runtime,
+	instanceID string,
+	status containers.ContainerInstanceStatusSpec,
+) error {
+	if err := safe.WriterModify(
+		ctx, r,
+		containers.NewContainerInstanceStatus(containers.NamespaceName, instanceID),
+		func(res *containers.ContainerInstanceStatus) error {
+			*res.TypedSpec() = status
+
+			return nil
+		},
+	); err != nil {
+		return fmt.Errorf("failed to write instance status %q: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+// startTask launches the supervisor for one instance.
+func (ctrl *RuntimeController) startTask(
+	ctx context.Context,
+	logger *zap.Logger,
+	rt Runtime,
+	instance *containers.ContainerInstanceSpec,
+	notifyCh chan struct{},
+) *taskState {
+	instanceID := instance.Metadata().ID()
+	spec := instance.TypedSpec().DeepCopy()
+
+	task := &taskState{
+		stopCh:       make(chan struct{}),
+		checksHealth: spec.HealthCheck.Kind != "",
+		status: containers.ContainerInstanceStatusSpec{
+			ContainerID: spec.ContainerID,
+			Generation:  spec.Generation,
+			Phase:       containers.ContainerInstancePhaseStarting,
+		},
+	}
+
+	if count, exists := ctrl.restarts[spec.ContainerID]; exists {
+		if count.pending {
+			count.count++
+			count.pending = false
+		}
+
+		task.status.Restarts = count.count
+	}
+
+	logger = logger.With(zap.String("container", spec.ContainerID), zap.String("instance", instanceID))
+
+	task.wg.Go(func() {
+		defer notify(notifyCh)
+
+		var exitCode uint32
+
+		// A panic in a supervisor must not take down machined.
+		err := panicsafe.RunErr(func() error {
+			var superviseErr error
+
+			exitCode, superviseErr = ctrl.supervise(ctx, logger, rt, instanceID, &spec, task, notifyCh)
+
+			return superviseErr
+		})
+
+		task.finish(exitCode, err)
+
+		switch {
+		case panicsafe.IsPanic(err):
+			logger.Error("container supervisor panicked", zap.Error(err))
+		case err != nil:
+			logger.Error("container task failed", zap.Error(err))
+		default:
+			logger.Info("container task exited", zap.Uint32("exitCode", exitCode))
+		}
+	})
+
+	return task
+}
+
+// supervise runs a single task to completion, stopping it if asked to.
+func (ctrl *RuntimeController) supervise(
+	ctx context.Context,
+	logger *zap.Logger,
+	rt Runtime,
+	instanceID string,
+	spec *containers.ContainerInstanceSpecSpec,
+	task *taskState,
+	notifyCh chan struct{},
+) (uint32, error) {
+	// The containerd calls outlive the controller context: a task being stopped because the
+	// controller is going away still has to be waited for and deleted.
+	taskCtx := context.WithoutCancel(ctx)
+
+	logW, err := ctrl.V1Alpha1Logging.ServiceLog(containers.LogID(spec.ContainerID)).Writer()
+	if err != nil {
+		return 0, fmt.Errorf("failed to open the container log: %w", err)
+	}
+
+	defer logW.Close() //nolint:errcheck
+
+	logger.Info("starting container task", zap.String("image", spec.Image))
+
+	running, err := rt.Start(taskCtx, logger, instanceID, spec, logW)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start task: %w", err)
+	}
+
+	defer func() {
+		if err := running.Delete(taskCtx); err != nil {
+			logger.Error("failed to delete container task", zap.Error(err))
+		}
+	}()
+
+	if spec.HealthCheck.Kind != "" {
+		// Initialized before the task is reported running, so that it never shows a stale outcome.
+		task.health.Init()
+	}
+
+	task.update(func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseRunning
+		status.PID = running.PID()
+		status.StartedAt = time.Now()
+	})
+
+	notify(notifyCh)
+
+	logger.Info("container task started", zap.Uint32("pid", running.PID()))
+
+	if spec.HealthCheck.Kind != "" {
+		// Registered after the task delete, so that it runs before it: the check uses the task.
+		defer ctrl.runHealthCheck(ctx, logger, spec.HealthCheck, checkHost(spec), running, task, notifyCh)()
+	}
+
+	waitCtx, waitCancel := context.WithCancel(taskCtx)
+	defer waitCancel()
+
+	type taskExit struct {
+		code uint32
+		err  error
+	}
+
+	exitCh := make(chan taskExit, 1)
+
+	task.wg.Go(func() {
+		code, err := running.Wait(waitCtx)
+		exitCh <- taskExit{code: code, err: err}
+	})
+
+	select {
+	case exit := <-exitCh:
+		return exit.code, exit.err
+	case <-task.stopCh:
+	}
+
+	task.update(func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseStopping
+	})
+
+	notify(notifyCh)
+
+	logger.Info("stopping container task", zap.Uint32("pid", running.PID()))
+
+	if err := running.Kill(taskCtx, syscall.SIGTERM); err != nil {
+		return 0, fmt.Errorf("failed to send SIGTERM: %w", err)
+	}
+
+	timer := time.NewTimer(ctrl.StopTimeout)
+	defer timer.Stop()
+
+	select {
+	case exit := <-exitCh:
+		return exit.code, exit.err
+	case <-timer.C:
+	}
+
+	logger.Warn("container task did not stop in time, killing it", zap.Duration("timeout", ctrl.StopTimeout))
+
+	if err := running.Kill(taskCtx, syscall.SIGKILL); err != nil {
+		return 0, fmt.Errorf("failed to send SIGKILL: %w", err)
+	}
+
+	exit := <-exitCh
+
+	return exit.code, exit.err
+}
+
+// runHealthCheck probes a running task until the returned function is called, which also waits for
+// the check to finish.
+//
+// A failing check is only reported: whether the container is restarted depends on its task exiting,
+// not on its health.
+func (ctrl *RuntimeController) runHealthCheck(
+	ctx context.Context,
+	logger *zap.Logger,
+	spec containers.ContainerHealthCheckSpec,
+	host netip.Addr,
+	running Task,
+	task *taskState,
+	notifyCh chan struct{},
+) func() {
+	healthCtx, healthCancel := context.WithCancel(ctx)
+
+	var wg sync.WaitGroup
+
+	changeCh := make(chan health.StateChange, 1)
+	task.health.Subscribe(changeCh)
+
+	wg.Go(func() {
+		for {
+			select {
+			case <-healthCtx.Done():
+				return
+			case change := <-changeCh:
+				if change.New.Healthy != nil && *change.New.Healthy {
+					logger.Info("container is healthy")
+				} else {
+					logger.Warn("container is unhealthy", zap.String("message", change.New.LastMessage))
+				}
+
+				notify(notifyCh)
+			}
+		}
+	})
+
+	wg.Go(func() {
+		health.Run(healthCtx, healthSettings(spec), &task.health, healthCheck(spec, host, running)) //nolint:errcheck
+	})
+
+	return func() {
+		healthCancel()
+		wg.Wait()
+
+		task.health.Unsubscribe(changeCh)
+	}
+}
+
+// notify wakes the controller up without blocking.
+//
+// The controller joins finished supervisors from its own goroutine, so a supervisor blocked on a
+// full notifyCh would deadlock it; a wakeup already pending covers this one anyway.
+func notify(notifyCh chan<- struct{}) {
+	select {
+	case notifyCh <- struct{}{}:
+	default:
+	}
+}
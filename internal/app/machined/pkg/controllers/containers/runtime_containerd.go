@@ -0,0 +1,539 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	containerdapi "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/contrib/seccomp"
+	containerdcontainers "github.com/containerd/containerd/v2/core/containers"
+	"github.com/containerd/containerd/v2/core/content"
+	"github.com/containerd/containerd/v2/pkg/cio"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/containerd/containerd/v2/pkg/oci"
+	"github.com/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	containerdrunner "github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/siderolabs/talos/internal/pkg/capability"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	"github.com/siderolabs/talos/internal/pkg/selinux"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+)
+
+const (
+	// taskWaitRetryInterval is how long to wait before waiting on a task again after containerd went away.
+	taskWaitRetryInterval = time.Second
+
+	// cpuPeriod is the cgroup CPU period the CPU limit is expressed against, in microseconds.
+	cpuPeriod = 100_000
+
+	// capabilityAll drops every capability when listed in the drop list.
+	capabilityAll = "ALL"
+)
+
+// defaultRuntimeProvider dials the CRI containerd instance.
+func defaultRuntimeProvider() (Runtime, error) {
+	client, err := containerdapi.New(constants.CRIContainerdAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to containerd: %w", err)
+	}
+
+	return &containerdRuntime{client: client}, nil
+}
+
+type containerdRuntime struct {
+	client *containerdapi.Client
+}
+
+func (rt *containerdRuntime) Start(
+	ctx context.Context,
+	logger *zap.Logger,
+	id string,
+	spec *containers.ContainerInstanceSpecSpec,
+	logW io.Writer,
+) (Task, error) {
+	ctx = namespaces.WithNamespace(ctx, constants.TalosContainersContainerdNamespace)
+
+	img, err := rt.resolveImage(ctx, spec.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	// A container of the same ID is left behind when machined goes away without stopping its
+	// tasks, and would make the create fail.
+	if err = rt.removeStale(ctx, logger, id); err != nil {
+		return nil, err
+	}
+
+	container, err := rt.client.NewContainer(
+		ctx,
+		id,
+		containerdapi.WithImage(img),
+		containerdapi.WithNewSnapshot(id, img),
+		containerdapi.WithNewSpec(instanceSpecOpts(img, id, spec)...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container %q: %w", id, err)
+	}
+
+	task, err := container.NewTask(ctx, cio.NewCreator(cio.WithStreams(nil, logW, logW)))
+	if err != nil {
+		container.Delete(ctx, containerdapi.WithSnapshotCleanup) //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to create task %q: %w", id, err)
+	}
+
+	// The network namespace exists once the task is created, and the process only runs once it is
+	// started: attaching in between means the container never sees itself without its network.
+	var network *cniNetwork
+
+	if spec.Network.Kind != "" {
+		if network, err = attachNetwork(ctx, id, spec, task.Pid()); err != nil {
+			task.Delete(ctx, containerdapi.WithProcessKill)          //nolint:errcheck
+			container.Delete(ctx, containerdapi.WithSnapshotCleanup) //nolint:errcheck
+
+			return nil, err
+		}
+	}
+
+	if err = task.Start(ctx); err != nil {
+		network.detach(ctx)                                      //nolint:errcheck
+		task.Delete(ctx, containerdapi.WithProcessKill)          //nolint:errcheck
+		container.Delete(ctx, containerdapi.WithSnapshotCleanup) //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to start task %q: %w", id, err)
+	}
+
+	return &containerdTask{container: container, task: task, network: network}, nil
+}
+
+// resolveImage finds the image an instance runs.
+//
+// InstanceController records the digest the image was resolved to rather than its name, so the
+// image is looked up by its target.
+func (rt *containerdRuntime) resolveImage(ctx context.Context, ref string) (containerdapi.Image, error) {
+	if _, err := digest.Parse(ref); err != nil {
+		img, err := rt.client.GetImage(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image %q: %w", ref, err)
+		}
+
+		return img, nil
+	}
+
+	imgs, err := rt.client.ListImages(ctx, "target.digest=="+ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	if len(imgs) == 0 {
+		return nil, fmt.Errorf("image %q not found", ref)
+	}
+
+	return imgs[0], nil
+}
+
+func (rt *containerdRuntime) removeStale(ctx context.Context, logger *zap.Logger, id string) error {
+	container, err := rt.client.LoadContainer(ctx, id)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to load container %q: %w", id, err)
+	}
+
+	logger.Info("removing stale container")
+
+	if task, err := container.Task(ctx, nil); err == nil {
+		if _, err = task.Delete(ctx, containerdapi.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("failed to delete stale task %q: %w", id, err)
+		}
+	}
+
+	if err = container.Delete(ctx, containerdapi.WithSnapshotCleanup); err != nil && !errdefs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete stale container %q: %w", id, err)
+	}
+
+	return nil
+}
+
+func (rt *containerdRuntime) Close() error {
+	return rt.client.Close()
+}
+
+type containerdTask struct {
+	container containerdapi.Container
+	task      containerdapi.Task
+	network   *cniNetwork
+}
+
+func (t *containerdTask) PID() uint32 {
+	return t.task.Pid()
+}
+
+func (t *containerdTask) Wait(ctx context.Context) (uint32, error) {
+	ctx = namespaces.WithNamespace(ctx, constants.TalosContainersContainerdNamespace)
+
+	for {
+		statusCh, err := t.task.Wait(ctx)
+		if err == nil {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case exitStatus := <-statusCh:
+				code, _, statusErr := exitStatus.Result()
+				if statusErr == nil {
+					return code, nil
+				}
+
+				err = statusErr
+			}
+		}
+
+		// The wait stream breaks when containerd restarts, while the task lives on in its shim.
+		if !isContainerdUnavailable(err) {
+			return 0, fmt.Errorf("failed to wait for task: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(taskWaitRetryInterval):
+		}
+	}
+}
+
+func isContainerdUnavailable(err error) bool {
+	return errdefs.IsUnavailable(err) || status.Code(err) == codes.Unavailable
+}
+
+func (t *containerdTask) Kill(ctx context.Context, signal syscall.Signal) error {
+	ctx = namespaces.WithNamespace(ctx, constants.TalosContainersContainerdNamespace)
+
+	if err := t.task.Kill(ctx, signal, containerdapi.WithKillAll); err != nil && !errdefs.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (t *containerdTask) Exec(ctx context.Context, args []string) (uint32, error) {
+	ctx = namespaces.WithNamespace(ctx, constants.TalosContainersContainerdNamespace)
+
+	ociSpec, err := t.container.Spec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get container spec: %w", err)
+	}
+
+	// The command runs as the container's own process does: same user, environment and working
+	// directory, only the arguments differ.
+	processSpec := *ociSpec.Process
+	processSpec.Args = args
+	processSpec.Terminal = false
+
+	execID := "exec-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	process, err := t.task.Exec(ctx, execID, &processSpec, cio.NullIO)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create exec process: %w", err)
+	}
+
+	// The process is deleted even if ctx has expired, so a timed out command doesn't linger.
+	defer process.Delete(context.WithoutCancel(ctx), containerdapi.WithProcessKill) //nolint:errcheck
+
+	statusCh, err := process.Wait(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to wait for exec process: %w", err)
+	}
+
+	if err = process.Start(ctx); err != nil {
+		return 0, fmt.Errorf("failed to start exec process: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case exitStatus := <-statusCh:
+		code, _, err := exitStatus.Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get exec process status: %w", err)
+		}
+
+		return code, nil
+	}
+}
+
+func (t *containerdTask) Delete(ctx context.Context) error {
+	ctx = namespaces.WithNamespace(ctx, constants.TalosContainersContainerdNamespace)
+
+	var errs error
+
+	// Detached while the network namespace is still there, which is as long as the task is.
+	if err := t.network.detach(ctx); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if _, err := t.task.Delete(ctx, containerdapi.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+		errs = errors.Join(errs, fmt.Errorf("failed to delete task: %w", err))
+	}
+
+	if err := t.container.Delete(ctx, containerdapi.WithSnapshotCleanup); err != nil && !errdefs.IsNotFound(err) {
+		errs = errors.Join(errs, fmt.Errorf("failed to delete container: %w", err))
+	}
+
+	return errs
+}
+
+// instanceSpecOpts builds the OCI runtime spec for an instance.
+//
+//nolint:gocyclo
+func instanceSpecOpts(img containerdapi.Image, id string, spec *containers.ContainerInstanceSpecSpec) []oci.SpecOpts {
+	specOpts := []oci.SpecOpts{
+		// The environment has to be set before the image config, which merges the image's ENV under it.
+		oci.WithEnv(spec.Environment),
+		containerdrunner.WithImageConfigStripped(img),
+		withProcessArgs(img, spec.Entrypoint, spec.Args),
+		withUser(img, spec.RunAs),
+		oci.WithHostHostsFile,
+		withResolvConf(spec.Network),
+		oci.WithMounts(instanceMounts(spec.Mounts)),
+		oci.WithCgroup(cgroup.Path(filepath.Join(constants.CgroupTalosContainers, id))),
+		oci.WithCapabilities(instanceCapabilities(spec.Security)),
+	}
+
+	if spec.WorkingDir != "" {
+		specOpts = append(specOpts, oci.WithProcessCwd(spec.WorkingDir))
+	}
+
+	if spec.Network.HostNetwork {
+		specOpts = append(specOpts, oci.WithHostNamespace(specs.NetworkNamespace))
+	}
+
+	if spec.Resources.MemoryLimit > 0 {
+		specOpts = append(specOpts, oci.WithMemoryLimit(spec.Resources.MemoryLimit))
+	}
+
+	if spec.Resources.CPULimit > 0 {
+		specOpts = append(specOpts, oci.WithCPUCFS(int64(spec.Resources.CPULimit*cpuPeriod/1000), cpuPeriod))
+	}
+
+	if spec.Security.Privileged {
+		specOpts = append(specOpts,
+			oci.WithAllDevicesAllowed,
+			oci.WithWriteableSysfs,
+		)
+	} else {
+		specOpts = append(specOpts,
+			oci.WithRootFSReadonly(),
+			oci.WithNoNewPrivileges,
+			seccomp.WithDefaultProfile(), // add seccomp profile last, as it depends on process capabilities
+		)
+	}
+
+	if selinux.IsEnabled() {
+		specOpts = append(specOpts, oci.WithSelinuxLabel(constants.SelinuxLabelUnconfinedSysContainer))
+	}
+
+	return specOpts
+}
+
+// withResolvConf mounts the resolv.conf matching the container's network namespace.
+//
+// The host resolv.conf points at the host DNS listening on the loopback, which is not reachable
+// from a container attached to a host link: such a container gets the resolv.conf written for the
+// pods instead, pointing at the host DNS service address or at the upstream resolvers.
+func withResolvConf(network containers.ContainerNetworkSpec) oci.SpecOpts {
+	if network.Kind == "" {
+		return oci.WithHostResolvconf
+	}
+
+	return oci.WithMounts([]specs.Mount{
+		{
+			Type:        "bind",
+			Source:      constants.PodResolvConfPath,
+			Destination: "/etc/resolv.conf",
+			Options:     []string{"rbind", "ro"},
+		},
+	})
+}
+
+// withProcessArgs applies the entrypoint and args overrides the way Kubernetes applies command and
+// args: an entrypoint replaces the image's ENTRYPOINT and CMD, args alone replace only the CMD.
+func withProcessArgs(img oci.Image, entrypoint, args []string) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, _ *containerdcontainers.Container, s *specs.Spec) error {
+		switch {
+		case len(entrypoint) > 0:
+			s.Process.Args = slices.Concat(entrypoint, args)
+		case len(args) > 0:
+			config, err := readImageConfig(ctx, img)
+			if err != nil {
+				return err
+			}
+
+			s.Process.Args = slices.Concat(config.Entrypoint, args)
+		}
+
+		return nil
+	}
+}
+
+// withUser sets the user from the image's USER, with the runAs overrides applied on top.
+func withUser(img oci.Image, runAs containers.ContainerRunAsSpec) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, _ *containerdcontainers.Container, s *specs.Spec) error {
+		var uid, gid uint32
+
+		if runAs.UID == nil || runAs.GID == nil {
+			config, err := readImageConfig(ctx, img)
+			if err != nil {
+				return err
+			}
+
+			if uid, gid, err = parseImageUser(config.User); err != nil {
+				return err
+			}
+		}
+
+		if runAs.UID != nil {
+			uid = uint32(*runAs.UID)
+		}
+
+		if runAs.GID != nil {
+			gid = uint32(*runAs.GID)
+		}
+
+		s.Process.User.UID = uid
+		s.Process.User.GID = gid
+
+		return nil
+	}
+}
+
+// parseImageUser parses a numeric image USER, in the uid or uid:gid form.
+//
+// A user name would have to be looked up in the image's /etc/passwd, which is not done: such an
+// image needs runAs to say who to run as.
+func parseImageUser(user string) (uid, gid uint32, err error) {
+	if user == "" {
+		return 0, 0, nil
+	}
+
+	uidPart, gidPart, hasGID := strings.Cut(user, ":")
+
+	parsedUID, err := strconv.ParseUint(uidPart, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("image user %q is not numeric, set runAs to run the container as a specific uid and gid", user)
+	}
+
+	if !hasGID {
+		return uint32(parsedUID), 0, nil
+	}
+
+	parsedGID, err := strconv.ParseUint(gidPart, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("image group %q is not numeric, set runAs to run the container as a specific uid and gid", gidPart)
+	}
+
+	return uint32(parsedUID), uint32(parsedGID), nil
+}
+
+func readImageConfig(ctx context.Context, img oci.Image) (v1.ImageConfig, error) {
+	configDescriptor, err := img.Config(ctx)
+	if err != nil {
+		return v1.ImageConfig{}, err
+	}
+
+	imageConfigBytes, err := content.ReadBlob(ctx, img.ContentStore(), configDescriptor)
+	if err != nil {
+		return v1.ImageConfig{}, err
+	}
+
+	var ociImage v1.Image
+
+	if err = json.Unmarshal(imageConfigBytes, &ociImage); err != nil {
+		return v1.ImageConfig{}, err
+	}
+
+	return ociImage.Config, nil
+}
+
+// instanceMounts converts the resolved mounts into OCI mounts.
+func instanceMounts(mounts []containers.ResolvedMountSpec) []specs.Mount {
+	result := make([]specs.Mount, 0, len(mounts))
+
+	for _, mount := range mounts {
+		switch mount.Kind {
+		case containers.MountKindTmpfs:
+			options := append([]string{"nosuid", "nodev"}, mount.Options...)
+
+			if mount.Size > 0 {
+				options = append(options, "size="+strconv.FormatUint(mount.Size, 10))
+			}
+
+			result = append(result, specs.Mount{
+				Type:        "tmpfs",
+				Source:      "tmpfs",
+				Destination: mount.Destination,
+				Options:     options,
+			})
+		default:
+			// Every other kind resolves to a host path.
+			result = append(result, specs.Mount{
+				Type:        "bind",
+				Source:      mount.Source,
+				Destination: mount.Destination,
+				Options:     append([]string{"rbind"}, mount.Options...),
+			})
+		}
+	}
+
+	return result
+}
+
+// instanceCapabilities computes the capability set: the profile's base set, with the drops and then
+// the adds applied.
+//
+// The restricted profile starts from no capabilities at all, so for it only the adds matter.
+func instanceCapabilities(security containers.ContainerSecuritySpec) []string {
+	var capabilities []string
+
+	if security.Privileged {
+		capabilities = capability.AllGrantableCapabilities()
+	}
+
+	if slices.Contains(security.CapabilitiesDrop, capabilityAll) {
+		capabilities = nil
+	} else {
+		capabilities = slices.DeleteFunc(capabilities, func(name string) bool {
+			return slices.Contains(security.CapabilitiesDrop, strings.TrimPrefix(name, "CAP_"))
+		})
+	}
+
+	for _, name := range security.CapabilitiesAdd {
+		capabilities = append(capabilities, "CAP_"+name)
+	}
+
+	slices.Sort(capabilities)
+
+	return slices.Compact(capabilities)
+}
@@ -0,0 +1,454 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	containersctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/containers"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// fakeRuntime stands in for containerd. Every started task gets the next PID, writes a line to its
+// log, and then runs until the test exits it or a signal does.
+type fakeRuntime struct {
+	mu       sync.Mutex
+	tasks    map[string]*fakeTask
+	startErr error
+	nextPID  uint32
+	// ignoreSIGTERM makes the tasks started from now on survive SIGTERM.
+	ignoreSIGTERM bool
+}
+
+func newFakeRuntime() *fakeRuntime {
+	return &fakeRuntime{
+		tasks:   map[string]*fakeTask{},
+		nextPID: 1000,
+	}
+}
+
+func (rt *fakeRuntime) setStartErr(err error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.startErr = err
+}
+
+func (rt *fakeRuntime) setIgnoreSIGTERM(ignore bool) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.ignoreSIGTERM = ignore
+}
+
+func (rt *fakeRuntime) task(id string) *fakeTask {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	return rt.tasks[id]
+}
+
+func (rt *fakeRuntime) Start(_ context.Context, _ *zap.Logger, id string, spec *containers.ContainerInstanceSpecSpec, logW io.Writer) (containersctrl.Task, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.startErr != nil {
+		return nil, rt.startErr
+	}
+
+	rt.nextPID++
+
+	task := &fakeTask{
+		pid:           rt.nextPID,
+		ignoreSIGTERM: rt.ignoreSIGTERM,
+		exitCh:        make(chan uint32, 1),
+	}
+
+	rt.tasks[id] = task
+
+	fmt.Fprintf(logW, "hello from %s\n", spec.Image) //nolint:errcheck
+
+	return task, nil
+}
+
+func (rt *fakeRuntime) Close() error {
+	return nil
+}
+
+type fakeTask struct {
+	mu            sync.Mutex
+	pid           uint32
+	ignoreSIGTERM bool
+	signals       []syscall.Signal
+	deleted       bool
+	execExitCode  uint32
+	execArgs      [][]string
+
+	exitCh chan uint32
+}
+
+// setExecExitCode sets the exit status of the commands run from now on.
+func (task *fakeTask) setExecExitCode(code uint32) {
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	task.execExitCode = code
+}
+
+func (task *fakeTask) execs() [][]string {
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	return slices.Clone(task.execArgs)
+}
+
+// exit makes the task exit with the given code; only the first exit counts.
+func (task *fakeTask) exit(code uint32) {
+	select {
+	case task.exitCh <- code:
+	default:
+	}
+}
+
+func (task *fakeTask) receivedSignals() []syscall.Signal {
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	return slices.Clone(task.signals)
+}
+
+func (task *fakeTask) isDeleted() bool {
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	return task.deleted
+}
+
+func (task *fakeTask) PID() uint32 {
+	return task.pid
+}
+
+func (task *fakeTask) Wait(ctx context.Context) (uint32, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case code := <-task.exitCh:
+		return code, nil
+	}
+}
+
+func (task *fakeTask) Kill(_ context.Context, signal syscall.Signal) error {
+	task.mu.Lock()
+	task.signals = append(task.signals, signal)
+	task.mu.Unlock()
+
+	if signal == syscall.SIGTERM && task.ignoreSIGTERM {
+		return nil
+	}
+
+	task.exit(128 + uint32(signal))
+
+	return nil
+}
+
+func (task *fakeTask) Exec(_ context.Context, args []string) (uint32, error) {
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	task.execArgs = append(task.execArgs, args)
+
+	return task.execExitCode, nil
+}
+
+func (task *fakeTask) Delete(context.Context) error {
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
+	task.deleted = true
+
+	return nil
+}
+
+type RuntimeSuite struct {
+	ctest.DefaultSuite
+
+	rt      *fakeRuntime
+	logging runtime.LoggingManager
+}
+
+func TestRuntimeSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &RuntimeSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 15 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			// A fresh runtime and log per test, so the PIDs and log contents start over.
+			s.rt = newFakeRuntime()
+			s.logging = logging.NewFileLoggingManager(suite.T().TempDir())
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&containersctrl.RuntimeController{
+				V1Alpha1Logging: s.logging,
+				RuntimeProvider: func() (containersctrl.Runtime, error) { return s.rt, nil },
+				StopTimeout:     200 * time.Millisecond,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *RuntimeSuite) criUp() {
+	service := v1alpha1.NewService("cri")
+	service.TypedSpec().Running = true
+	service.TypedSpec().Healthy = true
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), service))
+}
+
+// createInstance creates the given generation of testContainer's instance, along with the
+// ContainerSpec that keeps the container's restart count alive, applying any mutators.
+func (suite *RuntimeSuite) createInstance(generation uint64, mutate ...func(*containers.ContainerInstanceSpecSpec)) string {
+	if generation == 0 {
+		suite.Create(containers.NewContainerSpec(containers.NamespaceName, testContainer))
+	}
+
+	instanceID := containers.InstanceID(testContainer, generation)
+
+	instance := containers.NewContainerInstanceSpec(containers.NamespaceName, instanceID)
+	instance.TypedSpec().ContainerID = testContainer
+	instance.TypedSpec().Generation = generation
+	instance.TypedSpec().Image = testDigest
+
+	for _, m := range mutate {
+		m(instance.TypedSpec())
+	}
+
+	suite.Create(instance)
+
+	return instanceID
+}
+
+// teardown tears down an instance the way InstanceController does, destroying it once released.
+func (suite *RuntimeSuite) teardown(instanceID string) {
+	ptr := containers.NewContainerInstanceSpec(containers.NamespaceName, instanceID).Metadata()
+
+	_, err := suite.State().Teardown(suite.Ctx(), ptr)
+	suite.Require().NoError(err)
+
+	ctest.AssertResource(suite, instanceID, func(instance *containers.ContainerInstanceSpec, asrt *assert.Assertions) {
+		asrt.Equal(resource.PhaseTearingDown, instance.Metadata().Phase())
+		asrt.True(instance.Metadata().Finalizers().Empty())
+	})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), ptr))
+}
+
+func (suite *RuntimeSuite) assertStatus(instanceID string, check func(*containers.ContainerInstanceStatusSpec, *assert.Assertions)) {
+	ctest.AssertResource(suite, instanceID, func(status *containers.ContainerInstanceStatus, asrt *assert.Assertions) {
+		check(status.TypedSpec(), asrt)
+	})
+}
+
+func (suite *RuntimeSuite) TestPendingUntilCRIIsUp() {
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhasePending, status.Phase)
+		asrt.Equal(testContainer, status.ContainerID)
+	})
+
+	suite.Assert().Nil(suite.rt.task(instanceID))
+}
+
+func (suite *RuntimeSuite) TestStartsTask() {
+	suite.criUp()
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+		asrt.EqualValues(1001, status.PID)
+		asrt.False(status.StartedAt.IsZero())
+		asrt.Zero(status.Restarts)
+	})
+
+	ctest.AssertResource(suite, instanceID, func(instance *containers.ContainerInstanceSpec, asrt *assert.Assertions) {
+		asrt.True(instance.Metadata().Finalizers().Has((&containersctrl.RuntimeController{}).Name()))
+	})
+
+	// The output lands in the log named after the container, not the instance.
+	r, err := suite.logging.ServiceLog("container-" + testContainer).Reader()
+	suite.Require().NoError(err)
+
+	defer r.Close() //nolint:errcheck
+
+	contents, err := io.ReadAll(r)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("hello from "+testDigest+"\n", string(contents))
+}
+
+func (suite *RuntimeSuite) TestRecordsExit() {
+	suite.criUp()
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+	})
+
+	suite.rt.task(instanceID).exit(3)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseExited, status.Phase)
+		asrt.EqualValues(3, status.ExitCode)
+		asrt.False(status.FinishedAt.IsZero())
+		asrt.Empty(status.LastError)
+	})
+
+	suite.Assert().True(suite.rt.task(instanceID).isDeleted())
+}
+
+func (suite *RuntimeSuite) TestRecordsStartFailure() {
+	suite.rt.setStartErr(errors.New("image not found"))
+	suite.criUp()
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseFailed, status.Phase)
+		asrt.Contains(status.LastError, "image not found")
+		asrt.Zero(status.PID)
+	})
+}
+
+func (suite *RuntimeSuite) TestTeardownStopsTask() {
+	suite.criUp()
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+	})
+
+	suite.teardown(instanceID)
+
+	ctest.AssertNoResource[*containers.ContainerInstanceStatus](suite, instanceID)
+
+	task := suite.rt.task(instanceID)
+	suite.Assert().Equal([]syscall.Signal{syscall.SIGTERM}, task.receivedSignals())
+	suite.Assert().True(task.isDeleted())
+}
+
+func (suite *RuntimeSuite) TestTeardownKillsAfterTimeout() {
+	suite.rt.setIgnoreSIGTERM(true)
+	suite.criUp()
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+	})
+
+	suite.teardown(instanceID)
+
+	suite.Assert().Equal([]syscall.Signal{syscall.SIGTERM, syscall.SIGKILL}, suite.rt.task(instanceID).receivedSignals())
+}
+
+func (suite *RuntimeSuite) TestCountsRestarts() {
+	suite.criUp()
+	first := suite.createInstance(0)
+
+	suite.assertStatus(first, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+	})
+
+	suite.rt.task(first).exit(1)
+
+	suite.assertStatus(first, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseExited, status.Phase)
+	})
+
+	suite.teardown(first)
+	second := suite.createInstance(1)
+
+	suite.assertStatus(second, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+		asrt.EqualValues(1, status.Restarts)
+	})
+
+	// Stopping an instance to replace it is not a restart.
+	suite.teardown(second)
+	third := suite.createInstance(2)
+
+	suite.assertStatus(third, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+		asrt.EqualValues(1, status.Restarts)
+	})
+}
+
+func (suite *RuntimeSuite) TestReportsHealth() {
+	suite.criUp()
+	instanceID := suite.createInstance(0, func(spec *containers.ContainerInstanceSpecSpec) {
+		spec.HealthCheck = containers.ContainerHealthCheckSpec{
+			Kind:    containers.HealthCheckKindExec,
+			Command: []string{"pg_isready"},
+			Period:  50 * time.Millisecond,
+			Timeout: time.Second,
+		}
+	})
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+		asrt.False(status.Health.Unknown)
+		asrt.True(status.Health.Healthy)
+	})
+
+	suite.Assert().Equal([]string{"pg_isready"}, suite.rt.task(instanceID).execs()[0])
+
+	suite.rt.task(instanceID).setExecExitCode(2)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.False(status.Health.Unknown)
+		asrt.False(status.Health.Healthy)
+		asrt.Equal("command exited with code 2", status.Health.LastMessage)
+	})
+
+	// An unhealthy container is only reported, not stopped.
+	suite.Assert().Empty(suite.rt.task(instanceID).receivedSignals())
+
+	suite.rt.task(instanceID).exit(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseExited, status.Phase)
+		asrt.Equal(containers.ContainerHealthSpec{}, status.Health)
+	})
+}
+
+// TestNoHealthWithoutCheck covers a container without a health check, whose health is never probed.
+func (suite *RuntimeSuite) TestNoHealthWithoutCheck() {
+	suite.criUp()
+	instanceID := suite.createInstance(0)
+
+	suite.assertStatus(instanceID, func(status *containers.ContainerInstanceStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerInstancePhaseRunning, status.Phase)
+		asrt.Equal(containers.ContainerHealthSpec{}, status.Health)
+	})
+
+	suite.Assert().Empty(suite.rt.task(instanceID).execs())
+}
@@ -0,0 +1,306 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	timeres "github.com/siderolabs/talos/pkg/machinery/resources/time"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// StatusController aggregates the state of each container into a ContainerStatus.
+//
+// The chain of resources behind a container is spread over three controllers and changes identity
+// on every restart; ContainerStatus is keyed by the container name and outlives the instances. It
+// is also what dependsOn.containers waits on, so a container is only reported healthy while it
+// runs and passes its health check, if it declares one.
+type StatusController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *StatusController) Name() string {
+	return "containers.StatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *StatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerImageStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerInstanceSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerInstanceStatusType,
+			Kind:      controller.InputWeak,
+		},
+		// Needed to report which gates a waiting container is blocked on.
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerMountStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: containers.NamespaceName,
+			Type:      containers.ContainerNetworkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.StatusType,
+			ID:        optional.Some(network.StatusID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      timeres.StatusType,
+			ID:        optional.Some(timeres.StatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *StatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: containers.ContainerStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *StatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// Polls dependsOn.paths for a waiting container, the same way InstanceController does.
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	if !timer.Stop() {
+		<-timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-timer.C:
+		}
+
+		wakeAfter, err := ctrl.reconcile(ctx, r, logger)
+		if err != nil {
+			logger.Error("failed to reconcile container statuses", zap.Error(err))
+
+			return err
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+
+		if duration, ok := wakeAfter.Get(); ok {
+			timer.Reset(duration)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// reconcile returns how long until the controller next needs to wake up on its own, if at all.
+func (ctrl *StatusController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) (optional.Optional[time.Duration], error) {
+	containerSpecs, err := safe.ReaderListAll[*containers.ContainerSpec](ctx, r)
+	if err != nil {
+		return optional.None[time.Duration](), fmt.Errorf("failed to list container specs: %w", err)
+	}
+
+	instanceSpecs, err := safe.ReaderListAll[*containers.ContainerInstanceSpec](ctx, r)
+	if err != nil {
+		return optional.None[time.Duration](), fmt.Errorf("failed to list container instances: %w", err)
+	}
+
+	// Only the newest instance of a container describes it.
+	newestInstances := map[string]*containers.ContainerInstanceSpec{}
+
+	for instance := range instanceSpecs.All() {
+		containerID := instance.TypedSpec().ContainerID
+
+		if newest, exists := newestInstances[containerID]; !exists || instance.TypedSpec().Generation > newest.TypedSpec().Generation {
+			newestInstances[containerID] = instance
+		}
+	}
+
+	var (
+		wakeCtrlAfter optional.Optional[time.Duration]
+		healthChanged bool
+	)
+
+	r.StartTrackingOutputs()
+
+	for spec := range containerSpecs.All() {
+		containerID := spec.Metadata().ID()
+
+		status, wakeAfter, err := ctrl.buildStatus(ctx, r, spec, newestInstances[containerID])
+		if err != nil {
+			return optional.None[time.Duration](), err
+		}
+
+		wakeCtrlAfter = minOptionalDuration(wakeCtrlAfter, wakeAfter)
+
+		if err = safe.WriterModify(
+			ctx, r,
+			containers.NewContainerStatus(containers.NamespaceName, containerID),
+			func(res *containers.ContainerStatus) error {
+				if res.TypedSpec().Healthy != status.Healthy {
+					healthChanged = true
+
+					logger.Debug("container health changed", zap.String("container", containerID), zap.Bool("healthy", status.Healthy))
+				}
+
+				*res.TypedSpec() = status
+
+				return nil
+			},
+		); err != nil {
+			return optional.None[time.Duration](), fmt.Errorf("failed to write container status %q: %w", containerID, err)
+		}
+	}
+
+	if err = safe.CleanupOutputs[*containers.ContainerStatus](ctx, r); err != nil {
+		return optional.None[time.Duration](), err
+	}
+
+	if healthChanged {
+		// WaitingFor of a container depending on another is computed from the statuses written
+		// here, which produce no input event: run another pass to see them.
+		r.QueueReconcile()
+	}
+
+	return wakeCtrlAfter, nil
+}
+
+// buildStatus aggregates one container, given its newest instance if any.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *StatusController) buildStatus(
+	ctx context.Context,
+	r controller.Reader,
+	spec *containers.ContainerSpec,
+	instance *containers.ContainerInstanceSpec,
+) (containers.ContainerStatusSpec, optional.Optional[time.Duration], error) {
+	containerID := spec.Metadata().ID()
+
+	status := containers.ContainerStatusSpec{
+		Image: spec.TypedSpec().Image.Ref,
+	}
+
+	imageStatus, err := safe.ReaderGetByID[*containers.ContainerImageStatus](ctx, r, containerID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return status, optional.None[time.Duration](), fmt.Errorf("failed to get image status %q: %w", containerID, err)
+	}
+
+	if imageStatus != nil && imageStatus.TypedSpec().Phase == containers.ContainerImagePhaseFailed {
+		status.LastError = imageStatus.TypedSpec().Error
+	}
+
+	if instance == nil {
+		waitingFor, wakeAfter, err := spec.TypedSpec().Ready(ctx, r, containerID)
+		if err != nil {
+			return status, optional.None[time.Duration](), err
+		}
+
+		status.WaitingFor = waitingFor
+
+		if len(waitingFor) > 0 {
+			status.Phase = containers.ContainerPhaseWaiting
+		} else {
+			status.Phase = containers.ContainerPhaseStarting
+		}
+
+		return status, wakeAfter, nil
+	}
+
+	status.Generation = instance.TypedSpec().Generation
+
+	instanceStatus, err := safe.ReaderGetByID[*containers.ContainerInstanceStatus](ctx, r, instance.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return status, optional.None[time.Duration](), fmt.Errorf("failed to get instance status %q: %w", instance.Metadata().ID(), err)
+	}
+
+	if instanceStatus == nil {
+		status.Phase = containers.ContainerPhaseStarting
+
+		if instance.Metadata().Phase() == resource.PhaseTearingDown {
+			status.Phase = containers.ContainerPhaseStopping
+		}
+
+		return status, optional.None[time.Duration](), nil
+	}
+
+	taskStatus := instanceStatus.TypedSpec()
+
+	status.Restarts = taskStatus.Restarts
+
+	if taskStatus.LastError != "" {
+		status.LastError = taskStatus.LastError
+	}
+
+	switch {
+	case instance.Metadata().Phase() == resource.PhaseTearingDown && !taskStatus.Terminated():
+		status.Phase = containers.ContainerPhaseStopping
+	case taskStatus.Terminated():
+		status.ExitCode = taskStatus.ExitCode
+
+		switch {
+		case instance.Metadata().Phase() == resource.PhaseTearingDown || spec.TypedSpec().Restart.ShouldRestart(*taskStatus):
+			status.Phase = containers.ContainerPhaseRestarting
+		case taskStatus.Phase == containers.ContainerInstancePhaseExited && taskStatus.ExitCode == 0:
+			status.Phase = containers.ContainerPhaseExited
+		default:
+			status.Phase = containers.ContainerPhaseFailed
+		}
+	case taskStatus.Phase == containers.ContainerInstancePhaseRunning:
+		status.Phase = containers.ContainerPhaseRunning
+		status.PID = taskStatus.PID
+
+		if instance.TypedSpec().HealthCheck.Kind == "" {
+			status.Healthy = true
+		} else {
+			status.Healthy = !taskStatus.Health.Unknown && taskStatus.Health.Healthy
+			status.HealthMessage = taskStatus.Health.LastMessage
+		}
+	case taskStatus.Phase == containers.ContainerInstancePhaseStopping:
+		status.Phase = containers.ContainerPhaseStopping
+	default:
+		status.Phase = containers.ContainerPhaseStarting
+	}
+
+	return status, optional.None[time.Duration](), nil
+}
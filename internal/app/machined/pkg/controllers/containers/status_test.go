@@ -0,0 +1,263 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	containersctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/containers"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
+)
+
+type StatusSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &StatusSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 15 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&containersctrl.StatusController{}))
+			},
+		},
+	})
+}
+
+// createSpec creates a ContainerSpec with the default restart policy, applying any mutators.
+func (suite *StatusSuite) createSpec(name string, mutate ...func(*containers.ContainerSpecSpec)) {
+	spec := containers.NewContainerSpec(containers.NamespaceName, name)
+	spec.TypedSpec().Image = containers.ContainerImageSpec{Ref: testImageRef}
+	spec.TypedSpec().Restart = containers.ContainerRestartSpec{
+		Mode:         containers.RestartModeAlways,
+		InitialDelay: 5 * time.Second,
+		MaxDelay:     5 * time.Minute,
+	}
+
+	for _, m := range mutate {
+		m(spec.TypedSpec())
+	}
+
+	suite.Create(spec)
+}
+
+// markImageReady fakes the image controller's output.
+func (suite *StatusSuite) markImageReady(name string) {
+	status := containers.NewContainerImageStatus(containers.NamespaceName, name)
+	status.TypedSpec().Phase = containers.ContainerImagePhaseReady
+	status.TypedSpec().Image = testImageRef
+	status.TypedSpec().Digest = testDigest
+
+	suite.Create(status)
+}
+
+// createInstance fakes the instance controller's output, applying any mutators.
+func (suite *StatusSuite) createInstance(name string, generation uint64, mutate ...func(*containers.ContainerInstanceSpecSpec)) {
+	instance := containers.NewContainerInstanceSpec(containers.NamespaceName, containers.InstanceID(name, generation))
+	instance.TypedSpec().ContainerID = name
+	instance.TypedSpec().Generation = generation
+	instance.TypedSpec().Image = testDigest
+
+	for _, m := range mutate {
+		m(instance.TypedSpec())
+	}
+
+	suite.Create(instance)
+}
+
+// setInstanceStatus fakes the runtime controller's output, creating or replacing it.
+func (suite *StatusSuite) setInstanceStatus(name string, generation uint64, mutate func(*containers.ContainerInstanceStatusSpec)) {
+	status := containers.NewContainerInstanceStatus(containers.NamespaceName, containers.InstanceID(name, generation))
+	status.TypedSpec().ContainerID = name
+	status.TypedSpec().Generation = generation
+
+	mutate(status.TypedSpec())
+
+	if _, err := suite.State().Get(suite.Ctx(), status.Metadata()); err != nil {
+		suite.Create(status)
+
+		return
+	}
+
+	ctest.UpdateWithConflicts(suite, status, func(res *containers.ContainerInstanceStatus) error {
+		*res.TypedSpec() = *status.TypedSpec()
+
+		return nil
+	})
+}
+
+func (suite *StatusSuite) assertStatus(name string, check func(*containers.ContainerStatusSpec, *assert.Assertions)) {
+	ctest.AssertResource(suite, name, func(status *containers.ContainerStatus, asrt *assert.Assertions) {
+		check(status.TypedSpec(), asrt)
+	})
+}
+
+func (suite *StatusSuite) TestWaitingOnImage() {
+	suite.createSpec(testContainer)
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseWaiting, status.Phase)
+		asrt.Equal([]string{"image"}, status.WaitingFor)
+		asrt.Equal(testImageRef, status.Image)
+		asrt.False(status.Healthy)
+	})
+
+	status := containers.NewContainerImageStatus(containers.NamespaceName, testContainer)
+	status.TypedSpec().Phase = containers.ContainerImagePhaseFailed
+	status.TypedSpec().Image = testImageRef
+	status.TypedSpec().Error = "manifest unknown"
+	suite.Create(status)
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseWaiting, status.Phase)
+		asrt.Equal("manifest unknown", status.LastError)
+	})
+}
+
+func (suite *StatusSuite) TestStartingOnceReady() {
+	suite.createSpec(testContainer)
+	suite.markImageReady(testContainer)
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseStarting, status.Phase)
+		asrt.Empty(status.WaitingFor)
+	})
+}
+
+func (suite *StatusSuite) TestRunningWithoutHealthCheckIsHealthy() {
+	suite.createSpec(testContainer)
+	suite.markImageReady(testContainer)
+	suite.createInstance(testContainer, 2)
+	suite.setInstanceStatus(testContainer, 2, func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseRunning
+		status.PID = 1234
+		status.Restarts = 2
+	})
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseRunning, status.Phase)
+		asrt.True(status.Healthy)
+		asrt.EqualValues(2, status.Generation)
+		asrt.EqualValues(1234, status.PID)
+		asrt.EqualValues(2, status.Restarts)
+	})
+}
+
+func (suite *StatusSuite) TestRunningFollowsHealthCheck() {
+	suite.createSpec(testContainer)
+	suite.markImageReady(testContainer)
+	suite.createInstance(testContainer, 0, func(spec *containers.ContainerInstanceSpecSpec) {
+		spec.HealthCheck = containers.ContainerHealthCheckSpec{Kind: containers.HealthCheckKindTCP, Port: 5000}
+	})
+
+	suite.setInstanceStatus(testContainer, 0, func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseRunning
+		status.Health = containers.ContainerHealthSpec{Unknown: true, LastMessage: "Unknown"}
+	})
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseRunning, status.Phase)
+		asrt.False(status.Healthy)
+		asrt.Equal("Unknown", status.HealthMessage)
+	})
+
+	suite.setInstanceStatus(testContainer, 0, func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseRunning
+		status.Health = containers.ContainerHealthSpec{Healthy: true}
+	})
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.True(status.Healthy)
+		asrt.Empty(status.HealthMessage)
+	})
+
+	suite.setInstanceStatus(testContainer, 0, func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseRunning
+		status.Health = containers.ContainerHealthSpec{LastMessage: "connection refused"}
+	})
+
+	suite.assertStatus(testContainer, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.False(status.Healthy)
+		asrt.Equal("connection refused", status.HealthMessage)
+	})
+}
+
+// TestTerminatedFollowsRestartPolicy covers the terminated phases: whether a container is
+// restarting or has stopped for good depends on its restart policy.
+func (suite *StatusSuite) TestTerminatedFollowsRestartPolicy() {
+	for _, test := range []struct {
+		name     string
+		mode     string
+		phase    containers.ContainerInstancePhase
+		exitCode uint32
+		expected containers.ContainerPhase
+	}{
+		{"always-clean", containers.RestartModeAlways, containers.ContainerInstancePhaseExited, 0, containers.ContainerPhaseRestarting},
+		{"on-failure-clean", containers.RestartModeOnFailure, containers.ContainerInstancePhaseExited, 0, containers.ContainerPhaseExited},
+		{"on-failure-code", containers.RestartModeOnFailure, containers.ContainerInstancePhaseExited, 1, containers.ContainerPhaseRestarting},
+		{"never-code", containers.RestartModeNever, containers.ContainerInstancePhaseExited, 1, containers.ContainerPhaseFailed},
+		{"never-start", containers.RestartModeNever, containers.ContainerInstancePhaseFailed, 0, containers.ContainerPhaseFailed},
+	} {
+		suite.createSpec(test.name, func(spec *containers.ContainerSpecSpec) {
+			spec.Restart.Mode = test.mode
+		})
+		suite.createInstance(test.name, 0)
+		suite.setInstanceStatus(test.name, 0, func(status *containers.ContainerInstanceStatusSpec) {
+			status.Phase = test.phase
+			status.ExitCode = test.exitCode
+			status.FinishedAt = time.Now()
+		})
+
+		suite.assertStatus(test.name, func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+			asrt.Equal(test.expected, status.Phase, test.name)
+			asrt.Equal(test.exitCode, status.ExitCode, test.name)
+			asrt.False(status.Healthy, test.name)
+		})
+	}
+}
+
+// TestWaitingOnPeer covers dependsOn.containers: the dependent sorts before its peer, so it is
+// evaluated against the peer's previous status and only catches up on the next pass.
+func (suite *StatusSuite) TestWaitingOnPeer() {
+	suite.createSpec("registry")
+	suite.markImageReady("registry")
+
+	suite.createSpec("app", func(spec *containers.ContainerSpecSpec) {
+		spec.DependsOn.Containers = []string{"registry"}
+	})
+	suite.markImageReady("app")
+
+	suite.assertStatus("app", func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseWaiting, status.Phase)
+		asrt.Equal([]string{"container: registry"}, status.WaitingFor)
+	})
+
+	suite.createInstance("registry", 0)
+	suite.setInstanceStatus("registry", 0, func(status *containers.ContainerInstanceStatusSpec) {
+		status.Phase = containers.ContainerInstancePhaseRunning
+	})
+
+	suite.assertStatus("app", func(status *containers.ContainerStatusSpec, asrt *assert.Assertions) {
+		asrt.Equal(containers.ContainerPhaseStarting, status.Phase)
+		asrt.Empty(status.WaitingFor)
+	})
+}
+
+func (suite *StatusSuite) TestRemovesStatusWhenSpecGoesAway() {
+	suite.createSpec(testContainer)
+
+	suite.assertStatus(testContainer, func(*containers.ContainerStatusSpec, *assert.Assertions) {})
+
+	suite.Destroy(containers.NewContainerSpec(containers.NamespaceName, testContainer))
+
+	ctest.AssertNoResource[*containers.ContainerStatus](suite, testContainer)
+}
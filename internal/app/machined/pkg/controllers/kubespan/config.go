@@ -53,6 +53,9 @@ func NewConfigController() *ConfigController {
 						res.TypedSpec().AdvertiseKubernetesNetworks = c.NetworkKubeSpanConfig().AdvertiseKubernetesNetworks()
 						res.TypedSpec().HarvestExtraEndpoints = c.NetworkKubeSpanConfig().HarvestExtraEndpoints()
 						res.TypedSpec().MTU = c.NetworkKubeSpanConfig().MTU()
+						res.TypedSpec().Relay = c.NetworkKubeSpanConfig().Relay()
+						res.TypedSpec().KeyRotationInterval = c.NetworkKubeSpanConfig().KeyRotationInterval()
+						res.TypedSpec().PerPeerPresharedKeys = c.NetworkKubeSpanConfig().PerPeerPresharedKeys()
 
 						if c.NetworkKubeSpanConfig().Filters() != nil {
 							res.TypedSpec().EndpointFilters = c.NetworkKubeSpanConfig().Filters().Endpoints()
@@ -97,6 +97,8 @@ func (suite *ConfigSuite) TestReconcileMultiDoc() {
 	kubeSpanCfg := network.NewKubeSpanV1Alpha1()
 	kubeSpanCfg.ConfigEnabled = new(true)
 	kubeSpanCfg.ConfigMTU = new(uint32(1380))
+	kubeSpanCfg.ConfigKeyRotationInterval = 24 * time.Hour
+	kubeSpanCfg.ConfigPerPeerPresharedKeys = new(true)
 	kubeSpanCfg.ConfigFilters = &network.KubeSpanFiltersConfig{
 		ConfigEndpoints:                 []string{"0.0.0.0/0", "::/0"},
 		ConfigExcludeAdvertisedNetworks: []meta.Prefix{{Prefix: netip.MustParsePrefix("10.0.0.0/8")}},
@@ -128,6 +130,8 @@ func (suite *ConfigSuite) TestReconcileMultiDoc() {
 			asrt.Equal(uint32(1380), spec.MTU)
 			asrt.Equal([]string{"0.0.0.0/0", "::/0"}, spec.EndpointFilters)
 			asrt.Equal([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, spec.ExcludeAdvertisedNetworks)
+			asrt.Equal(24*time.Hour, spec.KeyRotationInterval)
+			asrt.True(spec.PerPeerPresharedKeys)
 		},
 	)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -21,10 +22,12 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/automaton/blockautomaton"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/machinery/resources/block"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/xfs"
 )
 
@@ -53,6 +56,12 @@ func (ctrl *IdentityController) Inputs() []controller.Input {
 			ID:        optional.Some(network.FirstHardwareAddr),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.KubeSpanKeyRotation)),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: block.NamespaceName,
 			Type:      block.VolumeMountStatusType,
@@ -84,11 +93,31 @@ func (ctrl *IdentityController) Outputs() []controller.Output {
 //
 //nolint:gocyclo,cyclop
 func (ctrl *IdentityController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var rotationTimer *time.Timer
+
+	defer func() {
+		if rotationTimer != nil {
+			rotationTimer.Stop()
+		}
+	}()
+
 	for {
+		var rotationCh <-chan time.Time
+
+		if rotationTimer != nil {
+			rotationCh = rotationTimer.C
+		}
+
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
+		case <-rotationCh:
+		}
+
+		if rotationTimer != nil {
+			rotationTimer.Stop()
+			rotationTimer = nil
 		}
 
 		cfg, err := safe.ReaderGetByID[*kubespan.Config](ctx, r, kubespan.ConfigID)
@@ -101,15 +130,33 @@ func (ctrl *IdentityController) Run(ctx context.Context, r controller.Runtime, l
 			return fmt.Errorf("error getting first MAC address: %w", err)
 		}
 
-		_, err = safe.ReaderGetByID[*kubespan.Identity](ctx, r, kubespan.LocalIdentity)
+		identity, err := safe.ReaderGetByID[*kubespan.Identity](ctx, r, kubespan.LocalIdentity)
 		alreadyHasIdentity := err == nil
 
 		if cfg != nil && firstMAC != nil && cfg.TypedSpec().Enabled {
-			if ctrl.stateMachine == nil && !alreadyHasIdentity {
+			rotationRequested, err := ctrl.rotationRequested(ctx, r)
+			if err != nil {
+				return err
+			}
+
+			// check if the key rotation is due, the identity is updated in the STATE partition
+			var rotationDue bool
+
+			if alreadyHasIdentity {
+				if at, ok := kubespanadapter.IdentitySpec(identity.TypedSpec()).NextKeyRotationEvent(cfg.TypedSpec().KeyRotationInterval, rotationRequested); ok {
+					if wait := time.Until(at); wait > 0 {
+						rotationTimer = time.NewTimer(wait)
+					} else {
+						rotationDue = true
+					}
+				}
+			}
+
+			if ctrl.stateMachine == nil && (!alreadyHasIdentity || rotationDue) {
 				ctrl.stateMachine = blockautomaton.NewVolumeMounter(
 					ctrl.Name(),
 					constants.StatePartitionLabel,
-					ctrl.establishIdentity(cfg, firstMAC),
+					ctrl.establishIdentity(cfg, firstMAC, rotationRequested),
 					blockautomaton.WithDetached(true),
 				)
 			}
@@ -136,8 +183,28 @@ func (ctrl *IdentityController) Run(ctx context.Context, r controller.Runtime, l
 	}
 }
 
+// rotationRequested returns the time of the last key rotation request.
+func (ctrl *IdentityController) rotationRequested(ctx context.Context, r controller.Reader) (time.Time, error) {
+	metaKey, err := safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.KubeSpanKeyRotation))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, fmt.Errorf("error getting KubeSpan key rotation request: %w", err)
+	}
+
+	requested, err := time.Parse(time.RFC3339, metaKey.TypedSpec().Value)
+	if err != nil {
+		// ignore malformed requests
+		return time.Time{}, nil //nolint:nilerr
+	}
+
+	return requested, nil
+}
+
 func (ctrl *IdentityController) establishIdentity(
-	cfg *kubespan.Config, firstMAC *network.HardwareAddr,
+	cfg *kubespan.Config, firstMAC *network.HardwareAddr, rotationRequested time.Time,
 ) func(
 	ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, mountStatus *block.VolumeMountStatus,
 ) error {
@@ -154,6 +221,17 @@ func (ctrl *IdentityController) establishIdentity(
 			kubespanCfg := cfg.TypedSpec()
 			mac := firstMAC.TypedSpec()
 
+			changed, err := kubespanadapter.IdentitySpec(&localIdentity).RotateKey(logger, time.Now(), kubespanCfg.KeyRotationInterval, rotationRequested)
+			if err != nil {
+				return fmt.Errorf("error rotating KubeSpan key: %w", err)
+			}
+
+			if changed {
+				if err = controllers.SaveToFile(root, constants.KubeSpanIdentityFilename, &localIdentity); err != nil {
+					return fmt.Errorf("error saving kubespan identity: %w", err)
+				}
+			}
+
 			if err := kubespanadapter.IdentitySpec(&localIdentity).UpdateAddress(kubespanCfg.ClusterID, net.HardwareAddr(mac.HardwareAddr)); err != nil {
 				return fmt.Errorf("error updating KubeSpan address: %w", err)
 			}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubespan
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+)
+
+// presharedKeyInfo is the HKDF info prefix for the per-peer preshared keys.
+const presharedKeyInfo = "talos kubespan preshared key"
+
+// peerPresharedKey returns the Wireguard preshared key for the peer.
+//
+// If per-peer preshared keys are enabled, the key is derived from the shared secret
+// and the public keys of both peers, so that both ends of the connection derive the same key.
+func peerPresharedKey(cfgSpec *kubespan.ConfigSpec, localPublicKey, peerPublicKey string) (string, error) {
+	if !cfgSpec.PerPeerPresharedKeys {
+		return cfgSpec.SharedSecret, nil
+	}
+
+	publicKeys := []string{localPublicKey, peerPublicKey}
+	slices.Sort(publicKeys)
+
+	key, err := hkdf.Key(sha256.New, []byte(cfgSpec.SharedSecret), nil, presharedKeyInfo+" "+publicKeys[0]+" "+publicKeys[1], wgtypes.KeyLen)
+	if err != nil {
+		return "", fmt.Errorf("error deriving preshared key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// alternatePublicKeys returns the alternate public keys announced by the peers during the key rotation.
+//
+// The returned map is keyed by the alternate public key, the value is the peer (primary) public key.
+// Alternate keys which conflict with the keys of other peers are ignored.
+func alternatePublicKeys(peerSpecs map[string]*kubespan.PeerSpecSpec) map[string]string {
+	alternateKeys := map[string]string{}
+
+	for _, pubKey := range slices.Sorted(maps.Keys(peerSpecs)) {
+		alternateKey := peerSpecs[pubKey].AlternatePublicKey
+
+		if alternateKey == "" {
+			continue
+		}
+
+		if _, conflict := peerSpecs[alternateKey]; conflict {
+			continue
+		}
+
+		if _, conflict := alternateKeys[alternateKey]; conflict {
+			continue
+		}
+
+		alternateKeys[alternateKey] = pubKey
+	}
+
+	return alternateKeys
+}
+
+// activeAlternatePublicKeys returns the alternate public keys which the peers currently use.
+//
+// The peer is considered to use the alternate key if the handshake with the alternate key
+// is more recent than the handshake with the primary key.
+// The returned map is keyed by the peer (primary) public key, the value is the alternate public key.
+func activeAlternatePublicKeys(alternateKeys map[string]string, wgDevice *wgtypes.Device) map[string]string {
+	if wgDevice == nil {
+		return nil
+	}
+
+	handshakes := make(map[string]time.Time, len(wgDevice.Peers))
+
+	for _, peerInfo := range wgDevice.Peers {
+		handshakes[peerInfo.PublicKey.String()] = peerInfo.LastHandshakeTime
+	}
+
+	var active map[string]string
+
+	for alternateKey, pubKey := range alternateKeys {
+		if handshakes[alternateKey].After(handshakes[pubKey]) {
+			if active == nil {
+				active = map[string]string{}
+			}
+
+			active[pubKey] = alternateKey
+		}
+	}
+
+	return active
+}
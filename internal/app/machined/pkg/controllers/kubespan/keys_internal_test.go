@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubespan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+)
+
+const (
+	testKey1 = "3FxU7UuwektMjbyuJBs7i1hDj2rQA6tHnbNB6WrQxww="
+	testKey2 = "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs="
+	testKey3 = "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY="
+)
+
+func TestPeerPresharedKey(t *testing.T) {
+	t.Parallel()
+
+	cfgSpec := &kubespan.ConfigSpec{
+		SharedSecret: "TPbGXrYlvuXgAl8dERpwjlA5I/RIplZXAJhthbQVKNk=",
+	}
+
+	psk, err := peerPresharedKey(cfgSpec, testKey1, testKey2)
+	require.NoError(t, err)
+	assert.Equal(t, cfgSpec.SharedSecret, psk)
+
+	cfgSpec.PerPeerPresharedKeys = true
+
+	psk12, err := peerPresharedKey(cfgSpec, testKey1, testKey2)
+	require.NoError(t, err)
+
+	_, err = wgtypes.ParseKey(psk12)
+	require.NoError(t, err)
+
+	// both ends derive the same key
+	psk21, err := peerPresharedKey(cfgSpec, testKey2, testKey1)
+	require.NoError(t, err)
+	assert.Equal(t, psk12, psk21)
+
+	psk13, err := peerPresharedKey(cfgSpec, testKey1, testKey3)
+	require.NoError(t, err)
+	assert.NotEqual(t, psk12, psk13)
+	assert.NotEqual(t, cfgSpec.SharedSecret, psk12)
+}
+
+func TestAlternatePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	key1, err := wgtypes.ParseKey(testKey1)
+	require.NoError(t, err)
+
+	key3, err := wgtypes.ParseKey(testKey3)
+	require.NoError(t, err)
+
+	peerSpecs := map[string]*kubespan.PeerSpecSpec{
+		testKey1: {
+			AlternatePublicKey: testKey3,
+		},
+		testKey2: {
+			// conflicts with another peer
+			AlternatePublicKey: testKey1,
+		},
+	}
+
+	alternateKeys := alternatePublicKeys(peerSpecs)
+	assert.Equal(t, map[string]string{testKey3: testKey1}, alternateKeys)
+
+	assert.Nil(t, activeAlternatePublicKeys(alternateKeys, nil))
+
+	now := time.Now()
+
+	assert.Empty(t, activeAlternatePublicKeys(alternateKeys, &wgtypes.Device{
+		Peers: []wgtypes.Peer{
+			{PublicKey: key1, LastHandshakeTime: now},
+			{PublicKey: key3},
+		},
+	}))
+
+	assert.Equal(t, map[string]string{testKey1: testKey3}, activeAlternatePublicKeys(alternateKeys, &wgtypes.Device{
+		Peers: []wgtypes.Peer{
+			{PublicKey: key1, LastHandshakeTime: now.Add(-time.Minute)},
+			{PublicKey: key3, LastHandshakeTime: now},
+		},
+	}))
+}
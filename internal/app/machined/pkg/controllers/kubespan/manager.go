@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/netip"
 	"os"
 	"slices"
@@ -117,6 +118,9 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 	var (
 		tickerC <-chan time.Time
 		ticker  *time.Ticker
+
+		// peers which completed the handshake with the alternate public key during the key rotation
+		activeAlternateKeys map[string]string
 	)
 
 	defer func() {
@@ -247,9 +251,34 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 			return fmt.Errorf("error fetching wireguard link status: %w", err)
 		}
 
+		alternateKeys := alternatePublicKeys(peerSpecs)
+		newActiveAlternateKeys := activeAlternatePublicKeys(alternateKeys, wgDevice)
+
+		if !maps.Equal(activeAlternateKeys, newActiveAlternateKeys) {
+			for pubKey, alternateKey := range newActiveAlternateKeys {
+				logger.Info("peer switched to the alternate public key", zap.String("peer", pubKey), zap.String("label", peerSpecs[pubKey].Label), zap.String("alternate_public_key", alternateKey))
+			}
+
+			activeAlternateKeys = newActiveAlternateKeys
+			updateSpecs = true
+		}
+
 		if wgDevice != nil { // wgDevice might be nil if the link is not created yet
 			for _, peerInfo := range wgDevice.Peers {
-				if peerStatus, ok := peerStatuses[peerInfo.PublicKey.String()]; ok {
+				pubKey := peerInfo.PublicKey.String()
+
+				if primaryKey, ok := alternateKeys[pubKey]; ok {
+					// peer status tracks the alternate key if the peer uses it
+					if activeAlternateKeys[primaryKey] != pubKey {
+						continue
+					}
+
+					pubKey = primaryKey
+				} else if _, ok = activeAlternateKeys[pubKey]; ok {
+					continue
+				}
+
+				if peerStatus, ok := peerStatuses[pubKey]; ok {
 					kubespanadapter.PeerStatusSpec(peerStatus).UpdateFromWireguard(peerInfo)
 				}
 			}
@@ -260,6 +289,19 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 			kubespanadapter.PeerStatusSpec(peerStatus).CalculateState()
 		}
 
+		// route the traffic to the peers which are down via the relays
+		relays := assignRelays(peerSpecs, peerStatuses)
+
+		if relays.changed {
+			for pubKey, peerStatus := range peerStatuses {
+				if peerStatus.RelayPublicKey != "" {
+					logger.Debug("routing peer traffic via the relay", zap.String("peer", pubKey), zap.String("label", peerStatus.Label), zap.String("relay", peerStatus.RelayedVia))
+				}
+			}
+
+			updateSpecs = true
+		}
+
 		// build wireguard peer configuration
 		wgPeers := make([]network.WireguardPeer, 0, len(peerSpecs))
 
@@ -291,13 +333,53 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 				updateSpecs = true
 			}
 
-			wgPeers = append(wgPeers, network.WireguardPeer{
+			allowedIPs := slices.Clone(peerSpec.AllowedIPs)
+
+			if peerStatus.RelayPublicKey != "" {
+				// the traffic goes via the relay, keep only KubeSpan address to keep trying the direct connection
+				allowedIPs = directPrefixes(peerSpec.AllowedIPs)
+			}
+
+			allowedIPs = append(allowedIPs, relays.relayedIPs[pubKey]...)
+
+			presharedKey, err := peerPresharedKey(cfgSpec, localSpec.PublicKey, pubKey)
+			if err != nil {
+				return err
+			}
+
+			wgPeer := network.WireguardPeer{
 				PublicKey:                   pubKey,
-				PresharedKey:                cfgSpec.SharedSecret,
+				PresharedKey:                presharedKey,
 				Endpoint:                    endpoint,
 				PersistentKeepaliveInterval: constants.KubeSpanDefaultPeerKeepalive,
-				AllowedIPs:                  slices.Clone(peerSpec.AllowedIPs),
-			})
+				AllowedIPs:                  allowedIPs,
+			}
+
+			if alternateKeys[peerSpec.AlternatePublicKey] != pubKey {
+				wgPeers = append(wgPeers, wgPeer)
+
+				continue
+			}
+
+			// during the key rotation, the peer is configured with both public keys, so that the handshake
+			// with either of them is accepted, but the traffic is routed via the key which the peer uses
+			alternatePeer := wgPeer
+			alternatePeer.PublicKey = peerSpec.AlternatePublicKey
+			alternatePeer.AllowedIPs = nil
+
+			if !value.IsZero(peerStatus.LastUsedEndpoint) {
+				alternatePeer.Endpoint = peerStatus.LastUsedEndpoint.String()
+			}
+
+			if alternatePeer.PresharedKey, err = peerPresharedKey(cfgSpec, localSpec.PublicKey, alternatePeer.PublicKey); err != nil {
+				return err
+			}
+
+			if _, ok := activeAlternateKeys[pubKey]; ok {
+				wgPeer.AllowedIPs, alternatePeer.AllowedIPs = alternatePeer.AllowedIPs, wgPeer.AllowedIPs
+			}
+
+			wgPeers = append(wgPeers, wgPeer, alternatePeer)
 		}
 
 		// build a full set of routed over KubeSpan IPs,
@@ -309,8 +391,8 @@ func (ctrl *ManagerController) Run(ctx context.Context, r controller.Runtime, lo
 			peerStatus := peerStatuses[pubKey]
 
 			// add allowedIPs to the nftables set if either routing is forced (for any peer state)
-			// or if the peer connection state is up.
-			if cfgSpec.ForceRouting || peerStatus.State == kubespan.PeerStateUp {
+			// or if the peer connection state is up, or the peer is reachable via the relay.
+			if cfgSpec.ForceRouting || peerStatus.State == kubespan.PeerStateUp || peerStatus.RelayPublicKey != "" {
 				for _, prefix := range peerSpec.AllowedIPs {
 					if !network.IsULA(prefix.Addr(), network.ULAKubeSpan) {
 						routedIPsBuilder.AddPrefix(prefix)
@@ -166,10 +166,12 @@ func (ctrl *PeerSpecController) Run(ctx context.Context, r controller.Runtime, l
 
 				if err = safe.WriterModify(ctx, r, kubespan.NewPeerSpec(kubespan.NamespaceName, spec.KubeSpan.PublicKey), func(res *kubespan.PeerSpec) error {
 					*res.TypedSpec() = kubespan.PeerSpecSpec{
-						Address:    spec.KubeSpan.Address,
-						AllowedIPs: ipSet.Prefixes(),
-						Endpoints:  slices.Clone(spec.KubeSpan.Endpoints),
-						Label:      spec.Nodename,
+						Address:            spec.KubeSpan.Address,
+						AllowedIPs:         ipSet.Prefixes(),
+						Endpoints:          slices.Clone(spec.KubeSpan.Endpoints),
+						Label:              spec.Nodename,
+						Relay:              spec.KubeSpan.Relay,
+						AlternatePublicKey: spec.KubeSpan.AlternatePublicKey,
 					}
 
 					return nil
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubespan
+
+import (
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// relayAssignment describes the traffic routed via the relay peers.
+type relayAssignment struct {
+	// relayedIPs are the IPs of the relayed peers, keyed by the relay public key.
+	relayedIPs map[string][]netip.Prefix
+	// changed is set if any of the peers switched the relay.
+	changed bool
+}
+
+// assignRelays picks a relay for each peer which can't be reached directly, and updates the peer statuses.
+//
+// The relay is the peer with the lowest public key among the peers which offer to relay
+// and which are up. The choice is deterministic, so that both ends of the broken connection
+// (which see the same set of relays) pick the same relay: the relay forwards the traffic
+// only if it is routed via the relay on both ends, as WireGuard checks the source address
+// of the packets against the allowed IPs of the peer the packet came from.
+//
+// A peer which is relayed stays relayed until the direct connection is up again,
+// even if the state is unknown while another endpoint is being tried.
+func assignRelays(peerSpecs map[string]*kubespan.PeerSpecSpec, peerStatuses map[string]*kubespan.PeerStatusSpec) relayAssignment {
+	result := relayAssignment{
+		relayedIPs: map[string][]netip.Prefix{},
+	}
+
+	var relays []string
+
+	for pubKey, peerSpec := range peerSpecs {
+		if peerSpec.Relay && peerStatuses[pubKey].State == kubespan.PeerStateUp {
+			relays = append(relays, pubKey)
+		}
+	}
+
+	slices.Sort(relays)
+
+	for _, pubKey := range slices.Sorted(maps.Keys(peerSpecs)) {
+		peerSpec := peerSpecs[pubKey]
+		peerStatus := peerStatuses[pubKey]
+
+		var relay string
+
+		needsRelay := peerStatus.State == kubespan.PeerStateDown ||
+			(peerStatus.State == kubespan.PeerStateUnknown && peerStatus.RelayPublicKey != "")
+
+		if needsRelay && len(relays) > 0 {
+			relay = relays[0]
+		}
+
+		if relay != peerStatus.RelayPublicKey {
+			result.changed = true
+		}
+
+		peerStatus.RelayPublicKey = relay
+		peerStatus.RelayedVia = ""
+
+		if relay == "" {
+			continue
+		}
+
+		peerStatus.RelayedVia = peerSpecs[relay].Label
+
+		result.relayedIPs[relay] = append(result.relayedIPs[relay], relayedPrefixes(peerSpec.AllowedIPs)...)
+	}
+
+	return result
+}
+
+// relayedPrefixes returns the allowed IPs of the peer which are routed via the relay.
+//
+// KubeSpan address of the peer is never relayed, it stays with the peer itself, so that
+// WireGuard keeps trying to establish the direct connection.
+func relayedPrefixes(allowedIPs []netip.Prefix) []netip.Prefix {
+	return slices.DeleteFunc(slices.Clone(allowedIPs), func(prefix netip.Prefix) bool {
+		return network.IsULA(prefix.Addr(), network.ULAKubeSpan)
+	})
+}
+
+// directPrefixes returns the allowed IPs of the relayed peer which are kept with the peer itself.
+func directPrefixes(allowedIPs []netip.Prefix) []netip.Prefix {
+	return slices.DeleteFunc(slices.Clone(allowedIPs), func(prefix netip.Prefix) bool {
+		return !network.IsULA(prefix.Addr(), network.ULAKubeSpan)
+	})
+}
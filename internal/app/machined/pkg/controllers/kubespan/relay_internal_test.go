@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kubespan
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+)
+
+func TestAssignRelays(t *testing.T) {
+	t.Parallel()
+
+	peerSpecs := map[string]*kubespan.PeerSpecSpec{
+		"a-relay": {
+			Label: "relay-1",
+			Relay: true,
+		},
+		"b-relay": {
+			Label: "relay-2",
+			Relay: true,
+		},
+		"c-peer": {
+			Label: "worker-1",
+			AllowedIPs: []netip.Prefix{
+				netip.MustParsePrefix("fd8a:4396:731e:e702:145e:c4ff:fe41:1ef9/128"),
+				netip.MustParsePrefix("10.244.1.0/24"),
+			},
+		},
+		"d-peer": {
+			Label: "worker-2",
+			AllowedIPs: []netip.Prefix{
+				netip.MustParsePrefix("10.244.2.0/24"),
+			},
+		},
+	}
+
+	peerStatuses := map[string]*kubespan.PeerStatusSpec{
+		"a-relay": {State: kubespan.PeerStateDown},
+		"b-relay": {State: kubespan.PeerStateUp},
+		"c-peer":  {State: kubespan.PeerStateDown},
+		"d-peer":  {State: kubespan.PeerStateUp},
+	}
+
+	relays := assignRelays(peerSpecs, peerStatuses)
+
+	assert.True(t, relays.changed)
+	assert.Equal(t, map[string][]netip.Prefix{
+		"b-relay": {netip.MustParsePrefix("10.244.1.0/24")},
+	}, relays.relayedIPs)
+
+	assert.Equal(t, "b-relay", peerStatuses["c-peer"].RelayPublicKey)
+	assert.Equal(t, "relay-2", peerStatuses["c-peer"].RelayedVia)
+	assert.Equal(t, "b-relay", peerStatuses["a-relay"].RelayPublicKey)
+	assert.Empty(t, peerStatuses["d-peer"].RelayPublicKey)
+
+	assert.Equal(t,
+		[]netip.Prefix{netip.MustParsePrefix("fd8a:4396:731e:e702:145e:c4ff:fe41:1ef9/128")},
+		directPrefixes(peerSpecs["c-peer"].AllowedIPs),
+	)
+
+	// the relay with the lowest public key is preferred, relayed peer stays relayed while the new endpoint is being tried
+	peerStatuses["a-relay"].State = kubespan.PeerStateUp
+	peerStatuses["c-peer"].State = kubespan.PeerStateUnknown
+
+	relays = assignRelays(peerSpecs, peerStatuses)
+
+	assert.True(t, relays.changed)
+	assert.Equal(t, "a-relay", peerStatuses["c-peer"].RelayPublicKey)
+	assert.Equal(t, "relay-1", peerStatuses["c-peer"].RelayedVia)
+	assert.Empty(t, peerStatuses["a-relay"].RelayPublicKey, "relay is not relayed to itself")
+
+	// no changes
+	relays = assignRelays(peerSpecs, peerStatuses)
+
+	assert.False(t, relays.changed)
+
+	// direct connection is up again
+	peerStatuses["c-peer"].State = kubespan.PeerStateUp
+
+	relays = assignRelays(peerSpecs, peerStatuses)
+
+	assert.True(t, relays.changed)
+	assert.Empty(t, relays.relayedIPs)
+	assert.Empty(t, peerStatuses["c-peer"].RelayPublicKey)
+	assert.Empty(t, peerStatuses["c-peer"].RelayedVia)
+}
@@ -9,10 +9,13 @@ import (
 	"fmt"
 	"net/netip"
 	"slices"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
 
@@ -20,15 +23,20 @@ import (
 	internalbgp "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/bgp"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/watch"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // BGPController runs embedded GoBGP routing instances driven by projected BGPInstanceConfig resources.
 //
 // It originates the addresses of the configured links as connected networks using their configured
-// prefix lengths, installs the routes it learns from its neighbors as network.RouteSpec resources,
-// and exposes peer state as network.BGPPeerStatus resources.
+// prefix lengths (unless withdrawn by a failing probe), installs the routes it learns from its neighbors
+// as network.RouteSpec resources, and exposes peer state as network.BGPPeerStatus resources.
+//
+// When the node was upgraded with graceful restart enabled, the peers are re-established
+// with the Restart State bit set until the configured restart time elapses.
 type BGPController struct {
 	// ListenPort overrides the default BGP port when non-zero. Negative values disable listeners.
 	// It is used by focused controller tests to avoid binding a host port.
@@ -62,6 +70,17 @@ func (ctrl *BGPController) Inputs() []controller.Input {
 			Type:      network.LinkStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.BGPGracefulRestart)),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -170,6 +189,16 @@ func (ctrl *BGPController) reconcile(ctx context.Context, r controller.Runtime,
 		return fmt.Errorf("error listing address statuses: %w", err)
 	}
 
+	restartStarted, err := ctrl.gracefulRestartStarted(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	failed, err := failedProbes(ctx, r)
+	if err != nil {
+		return err
+	}
+
 	runtimeState := internalbgp.NewRuntimeStateFromResources(linkStatuses.All(), addressStatuses.All())
 	neighborResolver := internalbgp.NewNeighborResolver()
 
@@ -195,6 +224,8 @@ func (ctrl *BGPController) reconcile(ctx context.Context, r controller.Runtime,
 
 		instanceLogger := logger.With(zap.String("instance", name))
 
+		runtimeState.WithdrawFailed(&resolved, failed)
+
 		if !resolved.RouterID.IsValid() {
 			instanceLogger.Warn("BGP router-id could not be determined, preserving the running instance")
 
@@ -203,6 +234,12 @@ func (ctrl *BGPController) reconcile(ctx context.Context, r controller.Runtime,
 
 		peers, peerIfaces := neighborResolver.ResolvePeers(resolved.Spec, runtimeState, instanceLogger)
 
+		instance.SetLocalRestarting(
+			resolved.Spec.GracefulRestart != nil &&
+				!restartStarted.IsZero() &&
+				time.Since(restartStarted) < resolved.Spec.GracefulRestart.RestartTime,
+		)
+
 		if err = instance.EnsureServer(
 			ctx,
 			instanceLogger,
@@ -295,6 +332,26 @@ func (ctrl *BGPController) reconcile(ctx context.Context, r controller.Runtime,
 	return ctrl.writeOutputs(ctx, r, outputs)
 }
 
+// gracefulRestartStarted returns the time the node initiated a BGP graceful restart, if any.
+func (ctrl *BGPController) gracefulRestartStarted(ctx context.Context, r controller.Reader) (time.Time, error) {
+	metaKey, err := safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.BGPGracefulRestart))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, fmt.Errorf("error getting BGP graceful restart meta key: %w", err)
+	}
+
+	started, err := time.Parse(time.RFC3339, metaKey.TypedSpec().Value)
+	if err != nil {
+		// a malformed value is ignored, the peers are established as after a regular boot
+		return time.Time{}, nil //nolint:nilerr
+	}
+
+	return started, nil
+}
+
 func (ctrl *BGPController) effectiveListenPort() int32 {
 	if ctrl.ListenPort != 0 {
 		return ctrl.ListenPort
@@ -269,6 +269,20 @@ func buildBGPInstanceConfigSpec(cfg talosconfig.NetworkBGPInstanceConfig) networ
 		spec.AdvertiseLinks[i] = link
 	}
 
+	for _, probe := range cfg.AdvertiseProbes() {
+		spec.AdvertiseProbes = append(spec.AdvertiseProbes, network.BGPAdvertiseProbeSpec{
+			Link:  probe.Link(),
+			Probe: probe.Probe(),
+		})
+	}
+
+	if gr := cfg.GracefulRestart(); gr != nil {
+		spec.GracefulRestart = &network.BGPGracefulRestartSpec{
+			RestartTime:        gr.RestartTime(),
+			LongLivedStaleTime: gr.LongLivedStaleTime(),
+		}
+	}
+
 	for _, routeImport := range cfg.ImportRoutes() {
 		spec.ImportRoutes = append(spec.ImportRoutes, network.BGPImportRouteSpec{
 			BGPInstance: routeImport.BGPInstance(),
@@ -284,6 +298,10 @@ func buildBGPInstanceConfigSpec(cfg talosconfig.NetworkBGPInstanceConfig) networ
 			LocalASN: neighbor.LocalASN(),
 			Passive:  neighbor.Passive(),
 			HoldTime: neighbor.HoldTime(),
+			Password: neighbor.Password(),
+
+			ImportPolicy: buildBGPRoutePolicySpec(neighbor.ImportPolicy()),
+			ExportPolicy: buildBGPRoutePolicySpec(neighbor.ExportPolicy()),
 		}
 
 		if bfd := neighbor.BFD(); bfd != nil {
@@ -299,3 +317,25 @@ func buildBGPInstanceConfigSpec(cfg talosconfig.NetworkBGPInstanceConfig) networ
 
 	return spec
 }
+
+func buildBGPRoutePolicySpec(policy talosconfig.NetworkBGPRoutePolicy) *network.BGPRoutePolicySpec {
+	if policy == nil {
+		return nil
+	}
+
+	spec := &network.BGPRoutePolicySpec{
+		MatchCommunities:    slices.Clone(policy.MatchCommunities()),
+		AddCommunities:      slices.Clone(policy.AddCommunities()),
+		AddLargeCommunities: slices.Clone(policy.AddLargeCommunities()),
+		ASPathPrepend:       policy.ASPathPrepend(),
+	}
+
+	for _, filter := range policy.Prefixes() {
+		spec.Prefixes = append(spec.Prefixes, network.BGPPrefixFilterSpec{
+			Prefix:    filter.Prefix(),
+			MaxLength: filter.MaxLength(),
+		})
+	}
+
+	return spec
+}
@@ -35,6 +35,7 @@ func newFabricConfig(holdTime time.Duration) *networkcfg.BGPInstanceConfigV1Alph
 	instance.BGPRouterID = meta.Addr{Addr: netip.MustParseAddr("10.0.0.1")}
 	instance.BGPRouteSource = meta.Addr{Addr: netip.MustParseAddr("10.0.0.2")}
 	instance.BGPAdvertise = []string{"node-ip"}
+	instance.BGPAdvertiseProbes = []networkcfg.BGPAdvertiseProbe{{AdvertiseLink: "node-ip", AdvertiseProbe: "service-check"}}
 	instance.BGPMultipath = new(true)
 	instance.BGPMaxPaths = 4
 	instance.BGPNeighborConfigs = []networkcfg.BGPNeighborConfig{
@@ -54,8 +55,24 @@ func newFabricConfig(holdTime time.Duration) *networkcfg.BGPInstanceConfigV1Alph
 			NeighborLocalASN:      65004,
 			NeighborPassive:       true,
 			NeighborHoldTime:      15 * time.Second,
+			NeighborPassword:      "s3cr3t",
+			NeighborImportPolicy: &networkcfg.BGPRoutePolicy{
+				PolicyPrefixes: []networkcfg.BGPPrefixFilter{
+					{FilterPrefix: meta.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")}, FilterMaxLength: 24},
+					{FilterPrefix: meta.Prefix{Prefix: netip.MustParsePrefix("2001:db8::/32")}},
+				},
+				PolicyMatchCommunities: []string{"65003:100"},
+			},
+			NeighborExportPolicy: &networkcfg.BGPRoutePolicy{
+				PolicyAddCommunities:      []string{"65001:200"},
+				PolicyAddLargeCommunities: []string{"65001:1:2"},
+				PolicyASPathPrepend:       2,
+			},
 		},
 	}
+	instance.BGPGracefulRestart = &networkcfg.BGPGracefulRestartConfig{
+		GracefulRestartLongLivedStaleTime: time.Hour,
+	}
 
 	return instance
 }
@@ -74,8 +91,13 @@ func (suite *BGPInstanceConfigSuite) TestRenderInlineBehaviorAndPreserveConfigur
 		assertions.Equal(netip.MustParseAddr("10.0.0.1"), spec.RouterID)
 		assertions.Equal(netip.MustParseAddr("10.0.0.2"), spec.RouteSource)
 		assertions.Equal([]string{"node-ip"}, spec.AdvertiseLinks)
+		assertions.Equal([]network.BGPAdvertiseProbeSpec{{Link: "node-ip", Probe: "service-check"}}, spec.AdvertiseProbes)
 		assertions.True(spec.InstallRoutes)
 		assertions.Equal(nethelpers.TableMain, spec.VRFTable)
+		assertions.Equal(&network.BGPGracefulRestartSpec{
+			RestartTime:        120 * time.Second,
+			LongLivedStaleTime: time.Hour,
+		}, spec.GracefulRestart)
 		assertions.Len(spec.Neighbors, 2)
 		assertions.Equal("fabric0", spec.Neighbors[0].Link)
 		assertions.Equal(9*time.Second, spec.Neighbors[0].HoldTime)
@@ -87,6 +109,22 @@ func (suite *BGPInstanceConfigSuite) TestRenderInlineBehaviorAndPreserveConfigur
 		assertions.True(spec.Neighbors[1].Passive)
 		assertions.Equal(15*time.Second, spec.Neighbors[1].HoldTime)
 		assertions.Nil(spec.Neighbors[1].BFD)
+		assertions.Empty(spec.Neighbors[0].Password)
+		assertions.Nil(spec.Neighbors[0].ImportPolicy)
+		assertions.Nil(spec.Neighbors[0].ExportPolicy)
+		assertions.Equal("s3cr3t", spec.Neighbors[1].Password)
+		assertions.Equal(&network.BGPRoutePolicySpec{
+			Prefixes: []network.BGPPrefixFilterSpec{
+				{Prefix: netip.MustParsePrefix("10.0.0.0/8"), MaxLength: 24},
+				{Prefix: netip.MustParsePrefix("2001:db8::/32"), MaxLength: 32},
+			},
+			MatchCommunities: []string{"65003:100"},
+		}, spec.Neighbors[1].ImportPolicy)
+		assertions.Equal(&network.BGPRoutePolicySpec{
+			AddCommunities:      []string{"65001:200"},
+			AddLargeCommunities: []string{"65001:1:2"},
+			ASPathPrepend:       2,
+		}, spec.Neighbors[1].ExportPolicy)
 	}, rtestutils.WithNamespace(network.NamespaceName))
 
 	updatedInstance := newFabricConfig(12 * time.Second)
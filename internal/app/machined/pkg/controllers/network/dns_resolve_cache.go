@@ -11,13 +11,19 @@ import (
 	"iter"
 	"net/netip"
 	"slices"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/coredns/coredns/plugin/pkg/proxy"
+	"github.com/coredns/coredns/request"
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	dnssrv "github.com/miekg/dns"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xiter"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/thejerf/suture/v4"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -31,6 +37,8 @@ import (
 type DNSResolveCacheController struct {
 	State  state.State
 	Logger *zap.Logger
+	// QueryLogger receives the query log when it is enabled.
+	QueryLogger *zap.Logger
 
 	mx        sync.Mutex
 	manager   *dns.Manager
@@ -112,6 +120,20 @@ func (ctrl *DNSResolveCacheController) run(ctx context.Context, r controller.Run
 
 	ctrl.manager.AllowNodeResolving(cfg.TypedSpec().ResolveMemberNames)
 
+	if cfg.TypedSpec().Enabled && cfg.TypedSpec().QueryLog && ctrl.QueryLogger != nil {
+		ctrl.manager.SetQueryLogger(ctrl.QueryLogger)
+	} else {
+		ctrl.manager.SetQueryLogger(nil)
+	}
+
+	if ctrl.manager.SetNegativeCache(cfg.TypedSpec().NegativeCacheSize, cfg.TypedSpec().NegativeCacheMinTTL, cfg.TypedSpec().NegativeCacheMaxTTL) {
+		ctrl.Logger.Info("updated dns negative cache",
+			zap.Int("size", cfg.TypedSpec().NegativeCacheSize),
+			zap.Duration("min_ttl", cfg.TypedSpec().NegativeCacheMinTTL),
+			zap.Duration("max_ttl", cfg.TypedSpec().NegativeCacheMaxTTL),
+		)
+	}
+
 	if !cfg.TypedSpec().Enabled {
 		return ctrl.manager.ClearAll(false)
 	}
@@ -143,19 +165,81 @@ func (ctrl *DNSResolveCacheController) run(ctx context.Context, r controller.Run
 
 	prxs := xiter.Map(
 		// We are using iterator here to preserve finalizer on
-		func(upstream *network.DNSUpstream) dns.Upstream {
-			return upstream.TypedSpec().Value.Conn.Proxy().(dns.Upstream)
-		},
-		upstreams.All(),
+		dnsUpstreamProxy,
+		xiter.Filter(func(upstream *network.DNSUpstream) bool {
+			return len(upstream.TypedSpec().Value.Domains) == 0
+		}, upstreams.All()),
 	)
 
 	if ctrl.manager.SetUpstreams(prxs) {
 		ctrl.Logger.Info("updated dns server nameservers", zap.Array("addrs", addrsArr(upstreams)))
 	}
 
+	forwarders := dnsForwarders(upstreams)
+
+	if ctrl.manager.SetForwarders(forwarders) {
+		ctrl.Logger.Info("updated dns server forwarders", zap.Strings("domains", xslices.Map(forwarders, func(forwarder dns.Forwarder) string {
+			return strings.Join(forwarder.Domains, ",")
+		})))
+	}
+
 	return nil
 }
 
+func dnsUpstreamProxy(upstream *network.DNSUpstream) dns.Upstream {
+	conn := upstream.TypedSpec().Value.Conn
+
+	return observedUpstream{Upstream: conn.Proxy().(dns.Upstream), conn: conn}
+}
+
+// observedUpstream records the query statistics of the upstream in its DNSConn.
+type observedUpstream struct {
+	dns.Upstream
+
+	conn *network.DNSConn
+}
+
+// Connect implements dns.Upstream.
+func (u observedUpstream) Connect(ctx context.Context, state request.Request, opts proxy.Options) (*dnssrv.Msg, error) {
+	start := time.Now()
+
+	resp, err := u.Upstream.Connect(ctx, state, opts)
+
+	u.conn.ObserveQuery(
+		time.Since(start),
+		err != nil || (resp != nil && (resp.Rcode == dnssrv.RcodeServerFailure || resp.Rcode == dnssrv.RcodeRefused)),
+	)
+
+	return resp, err
+}
+
+// dnsForwarders groups the upstreams of the per-domain forwarders by their domains.
+func dnsForwarders(upstreams safe.List[*network.DNSUpstream]) []dns.Forwarder {
+	var forwarders []dns.Forwarder
+
+	for upstream := range upstreams.All() {
+		domains := upstream.TypedSpec().Value.Domains
+
+		if len(domains) == 0 || slices.ContainsFunc(forwarders, func(forwarder dns.Forwarder) bool {
+			return slices.Equal(forwarder.Domains, domains)
+		}) {
+			continue
+		}
+
+		forwarders = append(forwarders, dns.Forwarder{
+			Domains: domains,
+			Upstreams: xiter.Map(
+				dnsUpstreamProxy,
+				xiter.Filter(func(upstream *network.DNSUpstream) bool {
+					return slices.Equal(upstream.TypedSpec().Value.Domains, domains)
+				}, upstreams.All()),
+			),
+		})
+	}
+
+	return forwarders
+}
+
 func cleanupOutputs(ctx context.Context, r controller.Runtime, resErr *error) {
 	if err := safe.CleanupOutputs[*network.DNSResolveCache](ctx, r); err != nil {
 		*resErr = cmp.Or(*resErr, fmt.Errorf("error cleaning up dns resolve cache: %w", err))
@@ -407,6 +407,48 @@ func (suite *DNSUpstreams) TestOrder() {
 	}
 }
 
+func (suite *DNSUpstreams) TestForwarders() {
+	port := getDynamicPort(suite.T())
+
+	cfg := network.NewHostDNSConfig(network.HostDNSConfigID)
+	cfg.TypedSpec().Enabled = true
+	cfg.TypedSpec().ListenAddresses = makeAddrs(port)
+	cfg.TypedSpec().Forwarders = []network.HostDNSForwarderSpec{
+		{
+			Domains: []string{"corp.example"},
+			NameServers: []network.NameServerSpec{
+				{Addr: netip.MustParseAddr("10.0.0.53")},
+				{Addr: netip.MustParseAddr("10.0.1.53")},
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	resolverSpec := network.NewResolverStatus(network.NamespaceName, network.ResolverID)
+	resolverSpec.TypedSpec().NameServers = []network.NameServerSpec{{Addr: netip.MustParseAddr("1.1.1.1")}}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), resolverSpec))
+
+	rtestutils.AssertLength[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), 3)
+
+	upstreams, err := safe.ReaderListAll[*network.DNSUpstream](suite.Ctx(), suite.State())
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(
+		[]string{"1.1.1.1:53 ", "10.0.0.53:53 corp.example", "10.0.1.53:53 corp.example"},
+		safe.ToSlice(upstreams, func(u *network.DNSUpstream) string {
+			return u.TypedSpec().Value.Conn.Addr() + " " + strings.Join(u.TypedSpec().Value.Domains, ",")
+		}),
+	)
+
+	// forwarders are removed with the config
+	cfg.TypedSpec().Forwarders = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertLength[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), 1)
+}
+
 func TestDNSUpstreams(t *testing.T) {
 	t.Parallel()
 
@@ -98,6 +98,32 @@ func (ctrl *DNSUpstreamController) run(ctx context.Context, r controller.Runtime
 		return nil
 	}
 
+	initConn, err := existingConnections(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	// per-domain forwarders don't depend on the resolver status
+	for i, forwarder := range cfg.TypedSpec().Forwarders {
+		for j, srv := range forwarder.NameServers {
+			if err = safe.WriterModify[*network.DNSUpstream](
+				ctx,
+				r,
+				network.NewDNSUpstream(fmt.Sprintf("forwarder-%03d #%03d %s %s", i, j, srv.Protocol, srv.Addr)),
+				func(u *network.DNSUpstream) error {
+					touchedIDs[u.Metadata().ID()] = struct{}{}
+
+					initConn(&u.TypedSpec().Value, srv.Protocol, srv.Addr.String(), srv.TLSServerName, l)
+					u.TypedSpec().Value.Domains = forwarder.Domains
+
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
 	rs, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
 	if err != nil {
 		if state.IsNotFoundError(err) {
@@ -107,11 +133,6 @@ func (ctrl *DNSUpstreamController) run(ctx context.Context, r controller.Runtime
 		return err
 	}
 
-	initConn, err := existingConnections(ctx, r)
-	if err != nil {
-		return err
-	}
-
 	for i, srv := range rs.TypedSpec().NameServers {
 		if err = safe.WriterModify[*network.DNSUpstream](
 			ctx,
@@ -10,3 +10,10 @@ var (
 	MultipathEqualForTest     = multipathEqual
 	ResolveBondPrimaryForTest = resolveBondPrimary
 )
+
+// Test exports for the nftables chain status (consumed by the external network_test package).
+var (
+	NfTablesChainCountersForTest = nfTablesChainCounters
+	NfTablesUserDataRuleIndex    = nfTablesUserDataRuleIndex
+	NfTablesUserDataPolicy       = nfTablesUserDataPolicy
+)
@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
@@ -96,6 +97,11 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 			// machine configuration can disable it once it is loaded.
 			res.TypedSpec().Enabled = cfg == nil
 			res.TypedSpec().ResolveMemberNames = false
+			res.TypedSpec().Forwarders = nil
+			res.TypedSpec().QueryLog = false
+			res.TypedSpec().NegativeCacheSize = constants.HostDNSNegativeCacheSize
+			res.TypedSpec().NegativeCacheMinTTL = constants.HostDNSNegativeCacheMinTTL
+			res.TypedSpec().NegativeCacheMaxTTL = constants.HostDNSNegativeCacheMaxTTL
 
 			res.TypedSpec().ServiceHostDNSAddress = netip.Addr{}
 			res.TypedSpec().ServiceHostDNSAddressV6 = netip.Addr{}
@@ -106,6 +112,25 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 
 			res.TypedSpec().Enabled = hostDNSConfig.HostDNSEnabled()
 			res.TypedSpec().ResolveMemberNames = hostDNSConfig.ResolveMemberNames()
+			res.TypedSpec().Forwarders = xslices.Map(hostDNSConfig.Forwarders(), func(forwarder cfgcfg.NetworkHostDNSForwarder) network.HostDNSForwarderSpec {
+				return network.HostDNSForwarderSpec{
+					Domains: forwarder.Domains,
+					NameServers: xslices.Map(forwarder.Resolvers, func(resolver cfgcfg.NetworkResolver) network.NameServerSpec {
+						return network.NameServerSpec{
+							Addr:          resolver.Addr,
+							Protocol:      resolver.Protocol,
+							TLSServerName: resolver.TLSServerName,
+						}
+					}),
+				}
+			})
+
+			res.TypedSpec().QueryLog = hostDNSConfig.QueryLog()
+
+			negativeCache := hostDNSConfig.NegativeCache()
+			res.TypedSpec().NegativeCacheSize = negativeCache.Size
+			res.TypedSpec().NegativeCacheMinTTL = negativeCache.MinTTL
+			res.TypedSpec().NegativeCacheMaxTTL = negativeCache.MaxTTL
 
 			if !hostDNSConfig.ForwardKubeDNSToHost() {
 				return nil
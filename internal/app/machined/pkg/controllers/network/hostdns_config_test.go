@@ -38,6 +38,10 @@ func (suite *HostDNSConfigSuite) TestNoConfig() {
 		)
 		asrt.Equal(netip.Addr{}, r.TypedSpec().ServiceHostDNSAddress)
 		asrt.False(r.TypedSpec().ResolveMemberNames)
+		asrt.False(r.TypedSpec().QueryLog)
+		asrt.Equal(constants.HostDNSNegativeCacheSize, r.TypedSpec().NegativeCacheSize)
+		asrt.Equal(constants.HostDNSNegativeCacheMinTTL, r.TypedSpec().NegativeCacheMinTTL)
+		asrt.Equal(constants.HostDNSNegativeCacheMaxTTL, r.TypedSpec().NegativeCacheMaxTTL)
 	})
 }
 
@@ -252,6 +256,11 @@ func (suite *HostDNSConfigSuite) TestResolverConfigDocument() {
 		HostDNSEnabled:              new(true),
 		HostDNSForwardKubeDNSToHost: new(true),
 		HostDNSResolveMemberNames:   new(true),
+		HostDNSQueryLog:             new(true),
+		HostDNSNegativeCache: networkcfg.HostDNSNegativeCacheConfig{
+			NegativeCacheSize:   new(100),
+			NegativeCacheMaxTTL: time.Minute,
+		},
 	}
 
 	v1 := &v1alpha1.Config{
@@ -282,6 +291,10 @@ func (suite *HostDNSConfigSuite) TestResolverConfigDocument() {
 			r.TypedSpec().ListenAddresses,
 		)
 		asrt.Equal(hostDNSAddr, r.TypedSpec().ServiceHostDNSAddress)
+		asrt.True(r.TypedSpec().QueryLog)
+		asrt.Equal(100, r.TypedSpec().NegativeCacheSize)
+		asrt.Equal(constants.HostDNSNegativeCacheMinTTL, r.TypedSpec().NegativeCacheMinTTL)
+		asrt.Equal(time.Minute, r.TypedSpec().NegativeCacheMaxTTL)
 	})
 
 	addrPrefix := netip.PrefixFrom(hostDNSAddr, hostDNSAddr.BitLen())
@@ -31,15 +31,18 @@ type Peer struct {
 }
 
 // BuildPeer translates a resolved peer into a GoBGP peer.
-func BuildPeer(peer Peer, multipath bool) *gobgpapi.Peer {
+//
+// When graceful restart is configured, localRestarting sets the Restart State bit in the advertised capability.
+func BuildPeer(peer Peer, multipath bool, gracefulRestart *network.BGPGracefulRestartSpec, localRestarting bool) *gobgpapi.Peer {
 	result := &gobgpapi.Peer{
 		Conf: &gobgpapi.PeerConf{
 			PeerAsn:         peer.Config.PeerASN,
 			NeighborAddress: peer.Address,
+			AuthPassword:    peer.Config.Password,
 		},
 		AfiSafis: []*gobgpapi.AfiSafi{
-			afiSafi(gobgpapi.Family_AFI_IP, multipath),
-			afiSafi(gobgpapi.Family_AFI_IP6, multipath),
+			afiSafi(gobgpapi.Family_AFI_IP, multipath, gracefulRestart),
+			afiSafi(gobgpapi.Family_AFI_IP6, multipath, gracefulRestart),
 		},
 	}
 
@@ -66,6 +69,18 @@ func BuildPeer(peer Peer, multipath bool) *gobgpapi.Peer {
 		}
 	}
 
+	if gracefulRestart != nil {
+		result.GracefulRestart = &gobgpapi.GracefulRestart{
+			Enabled:     true,
+			RestartTime: uint32(gracefulRestart.RestartTime.Seconds()),
+			// RFC 8538: the peers retain the routes when the session is closed with a NOTIFICATION,
+			// e.g. when the speaker is stopped on reboot
+			NotificationEnabled: true,
+			LonglivedEnabled:    gracefulRestart.LongLivedStaleTime > 0,
+			LocalRestarting:     localRestarting,
+		}
+	}
+
 	if peer.Config.BFD != nil {
 		result.Bfd = &gobgpapi.BfdPeerConfig{
 			Enabled:                  true,
@@ -78,7 +93,7 @@ func BuildPeer(peer Peer, multipath bool) *gobgpapi.Peer {
 	return result
 }
 
-func afiSafi(afi gobgpapi.Family_Afi, multipath bool) *gobgpapi.AfiSafi {
+func afiSafi(afi gobgpapi.Family_Afi, multipath bool, gracefulRestart *network.BGPGracefulRestartSpec) *gobgpapi.AfiSafi {
 	as := &gobgpapi.AfiSafi{
 		Config: &gobgpapi.AfiSafiConfig{
 			Family:  &gobgpapi.Family{Afi: afi, Safi: gobgpapi.Family_SAFI_UNICAST},
@@ -92,6 +107,21 @@ func afiSafi(afi gobgpapi.Family_Afi, multipath bool) *gobgpapi.AfiSafi {
 		}
 	}
 
+	if gracefulRestart != nil {
+		as.MpGracefulRestart = &gobgpapi.MpGracefulRestart{
+			Config: &gobgpapi.MpGracefulRestartConfig{Enabled: true},
+		}
+
+		if gracefulRestart.LongLivedStaleTime > 0 {
+			as.LongLivedGracefulRestart = &gobgpapi.LongLivedGracefulRestart{
+				Config: &gobgpapi.LongLivedGracefulRestartConfig{
+					Enabled:     true,
+					RestartTime: uint32(gracefulRestart.LongLivedStaleTime.Seconds()),
+				},
+			}
+		}
+	}
+
 	return as
 }
 
@@ -326,6 +356,20 @@ func PeerStatus(peer *gobgpapi.Peer, localASN uint32) network.BGPPeerStatusSpec
 		spec.Received += uint32(family.GetState().GetReceived())
 		spec.Accepted += uint32(family.GetState().GetAccepted())
 		spec.Advertised += uint32(family.GetState().GetAdvertised())
+
+		// a capability is negotiated when it is both advertised and received
+		if gr := family.GetMpGracefulRestart().GetState(); gr.GetAdvertised() && gr.GetReceived() {
+			spec.GracefulRestart = true
+		}
+
+		if llgr := family.GetLongLivedGracefulRestart().GetState(); llgr.GetAdvertised() && llgr.GetReceived() {
+			spec.LongLivedGracefulRestart = true
+		}
+	}
+
+	// GoBGP counts the received routes which passed the import policy as accepted.
+	if spec.Received > spec.Accepted {
+		spec.Rejected = spec.Received - spec.Accepted
 	}
 
 	if bfd := state.GetBfdState(); bfd != nil && bfd.GetSessionState() != gobgpapi.BfdSessionState_BFD_SESSION_STATE_UNSPECIFIED {
@@ -366,8 +410,23 @@ func addrFamily(addr netip.Addr) nethelpers.Family {
 }
 
 // ServerKey returns a deterministic representation of server-level configuration.
-func ServerKey(localASN uint32, routerID netip.Addr, multipath bool, maxPaths uint8, vrf string, table nethelpers.RoutingTable, listenPort int32) string {
-	return fmt.Sprintf("asn=%d;router=%s;multipath=%t;maxpaths=%d;vrf=%s;table=%s;listen=%d;", localASN, routerID, multipath, maxPaths, vrf, table, listenPort)
+func ServerKey(
+	localASN uint32,
+	routerID netip.Addr,
+	multipath bool,
+	maxPaths uint8,
+	vrf string,
+	table nethelpers.RoutingTable,
+	listenPort int32,
+	gracefulRestart *network.BGPGracefulRestartSpec,
+) string {
+	key := fmt.Sprintf("asn=%d;router=%s;multipath=%t;maxpaths=%d;vrf=%s;table=%s;listen=%d;", localASN, routerID, multipath, maxPaths, vrf, table, listenPort)
+
+	if gracefulRestart != nil {
+		key += fmt.Sprintf("gr=%s/%s;", gracefulRestart.RestartTime, gracefulRestart.LongLivedStaleTime)
+	}
+
+	return key
 }
 
 // PeerKey returns a deterministic representation of a peer's configuration.
@@ -395,5 +454,17 @@ func PeerKey(peer Peer) string {
 		)
 	}
 
+	if peer.Config.Password != "" {
+		fmt.Fprintf(&builder, "auth[%s]", peer.Config.Password)
+	}
+
+	if peer.Config.ImportPolicy != nil {
+		fmt.Fprintf(&builder, "import[%v]", *peer.Config.ImportPolicy)
+	}
+
+	if peer.Config.ExportPolicy != nil {
+		fmt.Fprintf(&builder, "export[%v]", *peer.Config.ExportPolicy)
+	}
+
 	return builder.String()
 }
@@ -34,18 +34,23 @@ func TestBuildPeer(t *testing.T) {
 			LocalASN: 65003,
 			Passive:  true,
 			HoldTime: 90 * time.Second,
+			Password: "s3cr3t",
 			BFD: &network.BGPBFDConfigSpec{
 				TransmitInterval: 300 * time.Millisecond,
 				ReceiveInterval:  400 * time.Millisecond,
 				DetectMultiplier: 3,
 			},
 		},
+	}, true, &network.BGPGracefulRestartSpec{
+		RestartTime:        120 * time.Second,
+		LongLivedStaleTime: time.Hour,
 	}, true)
 
 	assert.Equal(t, "fe80::1%eth0", peer.GetConf().GetNeighborAddress())
 	assert.Equal(t, uint32(65002), peer.GetConf().GetPeerAsn())
 	assert.Equal(t, uint32(65003), peer.GetConf().GetLocalAsn())
 	assert.True(t, peer.GetConf().GetReplacePeerAsn())
+	assert.Equal(t, "s3cr3t", peer.GetConf().GetAuthPassword())
 	assert.True(t, peer.GetTransport().GetPassiveMode())
 	assert.Equal(t, "vrf-blue", peer.GetTransport().GetBindInterface())
 	assert.Equal(t, uint64(90), peer.GetTimers().GetConfig().GetHoldTime())
@@ -56,6 +61,33 @@ func TestBuildPeer(t *testing.T) {
 	require.Len(t, peer.GetAfiSafis(), 2)
 	assert.True(t, peer.GetAfiSafis()[0].GetUseMultiplePaths().GetConfig().GetEnabled())
 	assert.True(t, peer.GetAfiSafis()[1].GetUseMultiplePaths().GetConfig().GetEnabled())
+	assert.True(t, peer.GetGracefulRestart().GetEnabled())
+	assert.Equal(t, uint32(120), peer.GetGracefulRestart().GetRestartTime())
+	assert.True(t, peer.GetGracefulRestart().GetNotificationEnabled())
+	assert.True(t, peer.GetGracefulRestart().GetLonglivedEnabled())
+	assert.True(t, peer.GetGracefulRestart().GetLocalRestarting())
+
+	for _, family := range peer.GetAfiSafis() {
+		assert.True(t, family.GetMpGracefulRestart().GetConfig().GetEnabled())
+		assert.True(t, family.GetLongLivedGracefulRestart().GetConfig().GetEnabled())
+		assert.Equal(t, uint32(3600), family.GetLongLivedGracefulRestart().GetConfig().GetRestartTime())
+	}
+}
+
+func TestBuildPeerWithoutGracefulRestart(t *testing.T) {
+	t.Parallel()
+
+	peer := internalbgp.BuildPeer(internalbgp.Peer{
+		Address: "192.0.2.1",
+		Config:  network.BGPNeighborConfigSpec{PeerASN: 65002},
+	}, false, nil, true)
+
+	assert.Nil(t, peer.GetGracefulRestart())
+
+	for _, family := range peer.GetAfiSafis() {
+		assert.Nil(t, family.GetMpGracefulRestart())
+		assert.Nil(t, family.GetLongLivedGracefulRestart())
+	}
 }
 
 func TestBuildOriginatedPath(t *testing.T) {
@@ -233,7 +265,15 @@ func TestPeerStatus(t *testing.T) {
 			},
 		},
 		AfiSafis: []*gobgpapi.AfiSafi{
-			{State: &gobgpapi.AfiSafiState{Received: 5, Accepted: 4, Advertised: 3}},
+			{
+				State: &gobgpapi.AfiSafiState{Received: 5, Accepted: 4, Advertised: 3},
+				MpGracefulRestart: &gobgpapi.MpGracefulRestart{
+					State: &gobgpapi.MpGracefulRestartState{Enabled: true, Advertised: true, Received: true},
+				},
+				LongLivedGracefulRestart: &gobgpapi.LongLivedGracefulRestart{
+					State: &gobgpapi.LongLivedGracefulRestartState{Enabled: true, Advertised: true},
+				},
+			},
 			{State: &gobgpapi.AfiSafiState{Received: 7, Accepted: 6, Advertised: 2}},
 		},
 	}, 65001)
@@ -245,8 +285,11 @@ func TestPeerStatus(t *testing.T) {
 	assert.Equal(t, netip.MustParseAddr("192.0.2.2"), status.RouterID)
 	assert.Equal(t, uint32(12), status.Received)
 	assert.Equal(t, uint32(10), status.Accepted)
+	assert.Equal(t, uint32(2), status.Rejected)
 	assert.Equal(t, uint32(5), status.Advertised)
 	assert.Equal(t, "up", status.BFDState)
+	assert.True(t, status.GracefulRestart)
+	assert.False(t, status.LongLivedGracefulRestart, "long-lived graceful restart was not received from the peer")
 }
 
 func TestKeys(t *testing.T) {
@@ -255,7 +298,12 @@ func TestKeys(t *testing.T) {
 	assert.Equal(
 		t,
 		"asn=65001;router=10.0.0.1;multipath=true;maxpaths=8;vrf=vrf-blue;table=88;listen=179;",
-		internalbgp.ServerKey(65001, netip.MustParseAddr("10.0.0.1"), true, 8, "vrf-blue", 88, 179),
+		internalbgp.ServerKey(65001, netip.MustParseAddr("10.0.0.1"), true, 8, "vrf-blue", 88, 179, nil),
+	)
+	assert.Equal(
+		t,
+		"asn=65001;router=10.0.0.1;multipath=false;maxpaths=0;vrf=;table=unspec;listen=179;gr=2m0s/0s;",
+		internalbgp.ServerKey(65001, netip.MustParseAddr("10.0.0.1"), false, 0, "", 0, 179, &network.BGPGracefulRestartSpec{RestartTime: 2 * time.Minute}),
 	)
 
 	peer := internalbgp.Peer{
@@ -270,4 +318,12 @@ func TestKeys(t *testing.T) {
 			BFD:      &network.BGPBFDConfigSpec{DetectMultiplier: 3},
 		},
 	}))
+	assert.NotEqual(t, internalbgp.PeerKey(peer), internalbgp.PeerKey(internalbgp.Peer{
+		Address: peer.Address,
+		Config: network.BGPNeighborConfigSpec{
+			PeerASN:      peer.Config.PeerASN,
+			HoldTime:     peer.Config.HoldTime,
+			ExportPolicy: &network.BGPRoutePolicySpec{ASPathPrepend: 2},
+		},
+	}))
 }
@@ -35,7 +35,9 @@ type Instance struct {
 	source        netip.Addr
 	localASN      uint32
 	installRoutes bool
+	restarting    bool
 	peers         map[string]string
+	policies      map[string]PeerPolicies
 	peerIfaces    map[netip.Addr]string
 }
 
@@ -45,6 +47,7 @@ func NewInstance() *Instance {
 		originated: map[netip.Prefix]struct{}{},
 		imported:   map[netip.Prefix]importedPath{},
 		peers:      map[string]string{},
+		policies:   map[string]PeerPolicies{},
 		peerIfaces: map[netip.Addr]string{},
 	}
 }
@@ -81,6 +84,7 @@ func (instance *Instance) clearState() {
 	instance.localASN = 0
 	instance.installRoutes = false
 	instance.peers = map[string]string{}
+	instance.policies = map[string]PeerPolicies{}
 	instance.peerIfaces = map[netip.Addr]string{}
 }
 
@@ -96,7 +100,7 @@ func (instance *Instance) EnsureServer(
 	listenPort int32,
 	signal func(),
 ) error {
-	key := ServerKey(config.LocalASN, routerID, config.Multipath, config.MaxPaths, config.VRF, config.VRFTable, listenPort)
+	key := ServerKey(config.LocalASN, routerID, config.Multipath, config.MaxPaths, config.VRF, config.VRFTable, listenPort, config.GracefulRestart)
 
 	if instance.server == nil || instance.serverKey != key {
 		instance.Stop()
@@ -145,6 +149,7 @@ func (instance *Instance) EnsureServer(
 		instance.watchCancel = watchCancel
 		instance.originated = map[netip.Prefix]struct{}{}
 		instance.peers = map[string]string{}
+		instance.policies = map[string]PeerPolicies{}
 
 		logger.Info("started embedded BGP speaker", zap.Uint32("asn", config.LocalASN), zap.Stringer("router_id", routerID))
 	}
@@ -154,6 +159,14 @@ func (instance *Instance) EnsureServer(
 	return instance.reconcilePeers(ctx, config, resolvedPeers)
 }
 
+// SetLocalRestarting marks the local speaker as restarting after a graceful restart of the node.
+//
+// Peers added while restarting advertise the Restart State bit, so that the neighbors keep
+// the stale routes of this node until the initial routing updates are exchanged.
+func (instance *Instance) SetLocalRestarting(restarting bool) {
+	instance.restarting = restarting
+}
+
 // ReconcileOriginated diffs the desired advertised prefixes against what is currently originated.
 func (instance *Instance) ReconcileOriginated(advertised []netip.Prefix) error {
 	desired := make(map[netip.Prefix]struct{}, len(advertised))
@@ -234,6 +247,10 @@ func (instance *Instance) reconcilePeers(
 			return fmt.Errorf("error deleting BGP peer: %w", err)
 		}
 
+		if err := instance.deletePeerPolicies(ctx, address); err != nil {
+			return err
+		}
+
 		delete(instance.peers, address)
 	}
 
@@ -242,7 +259,17 @@ func (instance *Instance) reconcilePeers(
 			continue
 		}
 
-		if err := instance.server.AddPeer(ctx, &gobgpapi.AddPeerRequest{Peer: BuildPeer(peer, config.Multipath)}); err != nil {
+		// policies are installed before the peer, so that no routes are exchanged unfiltered
+		policies, err := BuildPeerPolicies(peer, config.LocalASN)
+		if err != nil {
+			return fmt.Errorf("error building BGP peer policies: %w", err)
+		}
+
+		if err = instance.addPeerPolicies(ctx, peer.Address, policies); err != nil {
+			return err
+		}
+
+		if err = instance.server.AddPeer(ctx, &gobgpapi.AddPeerRequest{Peer: BuildPeer(peer, config.Multipath, config.GracefulRestart, instance.restarting)}); err != nil {
 			return fmt.Errorf("error adding BGP peer: %w", err)
 		}
 
@@ -0,0 +1,304 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	gobgpapi "github.com/osrg/gobgp/v4/api"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// globalPolicyAssignment is the GoBGP policy assignment applied to the global RIB.
+const globalPolicyAssignment = "global"
+
+// PeerPolicies is the GoBGP representation of a peer's import and export route policies.
+//
+// GoBGP only supports import and export policies on the global RIB, so each policy is scoped
+// to the peer by a neighbor set condition; routes of other peers fall through to the default accept.
+type PeerPolicies struct {
+	DefinedSets []*gobgpapi.DefinedSet
+	Import      *gobgpapi.Policy
+	Export      *gobgpapi.Policy
+}
+
+// Empty reports whether the peer has no route policies.
+func (policies PeerPolicies) Empty() bool {
+	return policies.Import == nil && policies.Export == nil
+}
+
+// BuildPeerPolicies translates the route policies of a resolved peer into GoBGP defined sets and policies.
+//
+// The local ASN is used for AS path prepending when the peer does not override it.
+func BuildPeerPolicies(peer Peer, localASN uint32) (PeerPolicies, error) {
+	var policies PeerPolicies
+
+	if peer.Config.ImportPolicy == nil && peer.Config.ExportPolicy == nil {
+		return policies, nil
+	}
+
+	neighbor, err := peerPolicyAddress(peer)
+	if err != nil {
+		return policies, err
+	}
+
+	neighborSet := &gobgpapi.DefinedSet{
+		DefinedType: gobgpapi.DefinedType_DEFINED_TYPE_NEIGHBOR,
+		Name:        policyName("neighbor", peer.Address),
+		List:        []string{netip.PrefixFrom(neighbor, neighbor.BitLen()).String()},
+	}
+
+	policies.DefinedSets = append(policies.DefinedSets, neighborSet)
+
+	if peer.Config.LocalASN != 0 {
+		localASN = peer.Config.LocalASN
+	}
+
+	if peer.Config.ImportPolicy != nil {
+		var sets []*gobgpapi.DefinedSet
+
+		sets, policies.Import = buildPolicy("import", peer.Address, neighborSet.Name, *peer.Config.ImportPolicy, localASN)
+		policies.DefinedSets = append(policies.DefinedSets, sets...)
+	}
+
+	if peer.Config.ExportPolicy != nil {
+		var sets []*gobgpapi.DefinedSet
+
+		sets, policies.Export = buildPolicy("export", peer.Address, neighborSet.Name, *peer.Config.ExportPolicy, localASN)
+		policies.DefinedSets = append(policies.DefinedSets, sets...)
+	}
+
+	return policies, nil
+}
+
+// buildPolicy builds a policy which accepts (and modifies) the peer's routes matching the filters,
+// and rejects the remaining routes of the peer when any filter is configured.
+func buildPolicy(
+	direction, address, neighborSet string,
+	spec network.BGPRoutePolicySpec,
+	localASN uint32,
+) ([]*gobgpapi.DefinedSet, *gobgpapi.Policy) {
+	name := policyName(direction, address)
+
+	var sets []*gobgpapi.DefinedSet
+
+	var communitySet *gobgpapi.MatchSet
+
+	if len(spec.MatchCommunities) > 0 {
+		set := &gobgpapi.DefinedSet{
+			DefinedType: gobgpapi.DefinedType_DEFINED_TYPE_COMMUNITY,
+			Name:        name + "/communities",
+			List:        spec.MatchCommunities,
+		}
+
+		sets = append(sets, set)
+		communitySet = &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_TYPE_ANY, Name: set.Name}
+	}
+
+	// GoBGP prefix sets are single-family, so the prefix list is split per address family,
+	// and the accepting statement is repeated for each of them.
+	var prefixSets []*gobgpapi.MatchSet
+
+	for _, family := range []struct {
+		suffix string
+		is4    bool
+	}{
+		{suffix: "/prefixes-v4", is4: true},
+		{suffix: "/prefixes-v6", is4: false},
+	} {
+		set := &gobgpapi.DefinedSet{
+			DefinedType: gobgpapi.DefinedType_DEFINED_TYPE_PREFIX,
+			Name:        name + family.suffix,
+		}
+
+		for _, filter := range spec.Prefixes {
+			if filter.Prefix.Addr().Is4() != family.is4 {
+				continue
+			}
+
+			set.Prefixes = append(set.Prefixes, &gobgpapi.Prefix{
+				IpPrefix:      filter.Prefix.String(),
+				MaskLengthMin: uint32(filter.Prefix.Bits()),
+				MaskLengthMax: uint32(max(int(filter.MaxLength), filter.Prefix.Bits())),
+			})
+		}
+
+		if len(set.Prefixes) == 0 {
+			continue
+		}
+
+		sets = append(sets, set)
+		prefixSets = append(prefixSets, &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_TYPE_ANY, Name: set.Name})
+	}
+
+	if len(prefixSets) == 0 {
+		prefixSets = []*gobgpapi.MatchSet{nil}
+	}
+
+	actions := &gobgpapi.Actions{
+		RouteAction: gobgpapi.RouteAction_ROUTE_ACTION_ACCEPT,
+	}
+
+	if len(spec.AddCommunities) > 0 {
+		actions.Community = &gobgpapi.CommunityAction{
+			Type:        gobgpapi.CommunityAction_TYPE_ADD,
+			Communities: spec.AddCommunities,
+		}
+	}
+
+	if len(spec.AddLargeCommunities) > 0 {
+		actions.LargeCommunity = &gobgpapi.CommunityAction{
+			Type:        gobgpapi.CommunityAction_TYPE_ADD,
+			Communities: spec.AddLargeCommunities,
+		}
+	}
+
+	if spec.ASPathPrepend > 0 {
+		actions.AsPrepend = &gobgpapi.AsPrependAction{
+			Asn:    localASN,
+			Repeat: uint32(spec.ASPathPrepend),
+		}
+	}
+
+	policy := &gobgpapi.Policy{Name: name}
+
+	for i, prefixSet := range prefixSets {
+		policy.Statements = append(policy.Statements, &gobgpapi.Statement{
+			Name: name + "/accept-" + strconv.Itoa(i),
+			Conditions: &gobgpapi.Conditions{
+				NeighborSet:  &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_TYPE_ANY, Name: neighborSet},
+				PrefixSet:    prefixSet,
+				CommunitySet: communitySet,
+			},
+			Actions: actions,
+		})
+	}
+
+	if len(spec.Prefixes) > 0 || len(spec.MatchCommunities) > 0 {
+		policy.Statements = append(policy.Statements, &gobgpapi.Statement{
+			Name: name + "/reject",
+			Conditions: &gobgpapi.Conditions{
+				NeighborSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_TYPE_ANY, Name: neighborSet},
+			},
+			Actions: &gobgpapi.Actions{
+				RouteAction: gobgpapi.RouteAction_ROUTE_ACTION_REJECT,
+			},
+		})
+	}
+
+	return sets, policy
+}
+
+// peerPolicyAddress returns the address matched by the peer's neighbor set, without the zone.
+func peerPolicyAddress(peer Peer) (netip.Addr, error) {
+	if peer.LinkLocal.IsValid() {
+		return peer.LinkLocal.WithZone(""), nil
+	}
+
+	addr, err := netip.ParseAddr(peer.Address)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("error parsing peer address %q: %w", peer.Address, err)
+	}
+
+	return addr.WithZone(""), nil
+}
+
+func policyName(kind, address string) string {
+	return "talos/" + kind + "/" + address
+}
+
+// addPeerPolicies installs the peer's defined sets and policies, and assigns the policies to the global RIB.
+func (instance *Instance) addPeerPolicies(ctx context.Context, address string, policies PeerPolicies) error {
+	if policies.Empty() {
+		return nil
+	}
+
+	for _, set := range policies.DefinedSets {
+		if err := instance.server.AddDefinedSet(ctx, &gobgpapi.AddDefinedSetRequest{DefinedSet: set}); err != nil {
+			return fmt.Errorf("error adding BGP defined set %q: %w", set.Name, err)
+		}
+	}
+
+	for _, assignment := range []struct {
+		policy    *gobgpapi.Policy
+		direction gobgpapi.PolicyDirection
+	}{
+		{policy: policies.Import, direction: gobgpapi.PolicyDirection_POLICY_DIRECTION_IMPORT},
+		{policy: policies.Export, direction: gobgpapi.PolicyDirection_POLICY_DIRECTION_EXPORT},
+	} {
+		if assignment.policy == nil {
+			continue
+		}
+
+		if err := instance.server.AddPolicy(ctx, &gobgpapi.AddPolicyRequest{Policy: assignment.policy}); err != nil {
+			return fmt.Errorf("error adding BGP policy %q: %w", assignment.policy.Name, err)
+		}
+
+		if err := instance.server.AddPolicyAssignment(ctx, &gobgpapi.AddPolicyAssignmentRequest{
+			Assignment: &gobgpapi.PolicyAssignment{
+				Name:          globalPolicyAssignment,
+				Direction:     assignment.direction,
+				Policies:      []*gobgpapi.Policy{{Name: assignment.policy.Name}},
+				DefaultAction: gobgpapi.RouteAction_ROUTE_ACTION_ACCEPT,
+			},
+		}); err != nil {
+			return fmt.Errorf("error assigning BGP policy %q: %w", assignment.policy.Name, err)
+		}
+	}
+
+	instance.policies[address] = policies
+
+	return nil
+}
+
+// deletePeerPolicies removes the policies and defined sets previously installed for the peer.
+func (instance *Instance) deletePeerPolicies(ctx context.Context, address string) error {
+	policies, ok := instance.policies[address]
+	if !ok {
+		return nil
+	}
+
+	for _, assignment := range []struct {
+		policy    *gobgpapi.Policy
+		direction gobgpapi.PolicyDirection
+	}{
+		{policy: policies.Import, direction: gobgpapi.PolicyDirection_POLICY_DIRECTION_IMPORT},
+		{policy: policies.Export, direction: gobgpapi.PolicyDirection_POLICY_DIRECTION_EXPORT},
+	} {
+		if assignment.policy == nil {
+			continue
+		}
+
+		if err := instance.server.DeletePolicyAssignment(ctx, &gobgpapi.DeletePolicyAssignmentRequest{
+			Assignment: &gobgpapi.PolicyAssignment{
+				Name:      globalPolicyAssignment,
+				Direction: assignment.direction,
+				Policies:  []*gobgpapi.Policy{{Name: assignment.policy.Name}},
+			},
+		}); err != nil {
+			return fmt.Errorf("error unassigning BGP policy %q: %w", assignment.policy.Name, err)
+		}
+
+		if err := instance.server.DeletePolicy(ctx, &gobgpapi.DeletePolicyRequest{Policy: &gobgpapi.Policy{Name: assignment.policy.Name}}); err != nil {
+			return fmt.Errorf("error deleting BGP policy %q: %w", assignment.policy.Name, err)
+		}
+	}
+
+	for _, set := range policies.DefinedSets {
+		if err := instance.server.DeleteDefinedSet(ctx, &gobgpapi.DeleteDefinedSetRequest{
+			DefinedSet: &gobgpapi.DefinedSet{DefinedType: set.DefinedType, Name: set.Name},
+		}); err != nil {
+			return fmt.Errorf("error deleting BGP defined set %q: %w", set.Name, err)
+		}
+	}
+
+	delete(instance.policies, address)
+
+	return nil
+}
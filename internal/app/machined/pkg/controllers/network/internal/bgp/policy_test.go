@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"net/netip"
+	"testing"
+
+	gobgpapi "github.com/osrg/gobgp/v4/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internalbgp "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/bgp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestBuildPeerPoliciesEmpty(t *testing.T) {
+	t.Parallel()
+
+	policies, err := internalbgp.BuildPeerPolicies(internalbgp.Peer{
+		Address: "192.0.2.1",
+		Config:  network.BGPNeighborConfigSpec{PeerASN: 65002},
+	}, 65001)
+	require.NoError(t, err)
+
+	assert.True(t, policies.Empty())
+	assert.Empty(t, policies.DefinedSets)
+}
+
+func TestBuildPeerPolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := internalbgp.BuildPeerPolicies(internalbgp.Peer{
+		Address:   "fe80::1%eth0",
+		LinkLocal: netip.MustParseAddr("fe80::1"),
+		Link:      "eth0",
+		Config: network.BGPNeighborConfigSpec{
+			PeerASN: 65002,
+			ImportPolicy: &network.BGPRoutePolicySpec{
+				Prefixes: []network.BGPPrefixFilterSpec{
+					{Prefix: netip.MustParsePrefix("10.0.0.0/8"), MaxLength: 24},
+					{Prefix: netip.MustParsePrefix("2001:db8::/32"), MaxLength: 32},
+				},
+				MatchCommunities: []string{"65002:100"},
+			},
+			ExportPolicy: &network.BGPRoutePolicySpec{
+				AddCommunities:      []string{"65001:200"},
+				AddLargeCommunities: []string{"65001:1:2"},
+				ASPathPrepend:       2,
+			},
+		},
+	}, 65001)
+	require.NoError(t, err)
+
+	require.False(t, policies.Empty())
+	require.Len(t, policies.DefinedSets, 4)
+
+	neighborSet := policies.DefinedSets[0]
+	assert.Equal(t, gobgpapi.DefinedType_DEFINED_TYPE_NEIGHBOR, neighborSet.GetDefinedType())
+	assert.Equal(t, []string{"fe80::1/128"}, neighborSet.GetList())
+
+	assert.Equal(t, gobgpapi.DefinedType_DEFINED_TYPE_COMMUNITY, policies.DefinedSets[1].GetDefinedType())
+	assert.Equal(t, []string{"65002:100"}, policies.DefinedSets[1].GetList())
+
+	v4 := policies.DefinedSets[2]
+	assert.Equal(t, gobgpapi.DefinedType_DEFINED_TYPE_PREFIX, v4.GetDefinedType())
+	require.Len(t, v4.GetPrefixes(), 1)
+	assert.Equal(t, "10.0.0.0/8", v4.GetPrefixes()[0].GetIpPrefix())
+	assert.Equal(t, uint32(8), v4.GetPrefixes()[0].GetMaskLengthMin())
+	assert.Equal(t, uint32(24), v4.GetPrefixes()[0].GetMaskLengthMax())
+
+	v6 := policies.DefinedSets[3]
+	require.Len(t, v6.GetPrefixes(), 1)
+	assert.Equal(t, "2001:db8::/32", v6.GetPrefixes()[0].GetIpPrefix())
+	assert.Equal(t, uint32(32), v6.GetPrefixes()[0].GetMaskLengthMax())
+
+	// one accepting statement per prefix family, followed by the catch-all reject of the neighbor's routes
+	importStatements := policies.Import.GetStatements()
+	require.Len(t, importStatements, 3)
+
+	for _, statement := range importStatements[:2] {
+		assert.Equal(t, neighborSet.GetName(), statement.GetConditions().GetNeighborSet().GetName())
+		assert.Equal(t, policies.DefinedSets[1].GetName(), statement.GetConditions().GetCommunitySet().GetName())
+		assert.Equal(t, gobgpapi.RouteAction_ROUTE_ACTION_ACCEPT, statement.GetActions().GetRouteAction())
+	}
+
+	assert.Equal(t, v4.GetName(), importStatements[0].GetConditions().GetPrefixSet().GetName())
+	assert.Equal(t, v6.GetName(), importStatements[1].GetConditions().GetPrefixSet().GetName())
+	assert.Nil(t, importStatements[2].GetConditions().GetPrefixSet())
+	assert.Equal(t, gobgpapi.RouteAction_ROUTE_ACTION_REJECT, importStatements[2].GetActions().GetRouteAction())
+
+	// without filters, the export policy only modifies the neighbor's routes
+	exportStatements := policies.Export.GetStatements()
+	require.Len(t, exportStatements, 1)
+
+	actions := exportStatements[0].GetActions()
+	assert.Equal(t, gobgpapi.RouteAction_ROUTE_ACTION_ACCEPT, actions.GetRouteAction())
+	assert.Equal(t, []string{"65001:200"}, actions.GetCommunity().GetCommunities())
+	assert.Equal(t, []string{"65001:1:2"}, actions.GetLargeCommunity().GetCommunities())
+	assert.Equal(t, uint32(65001), actions.GetAsPrepend().GetAsn())
+	assert.Equal(t, uint32(2), actions.GetAsPrepend().GetRepeat())
+}
@@ -106,6 +106,7 @@ func (state *RuntimeState) Resolve(spec network.BGPInstanceConfigSpec) (Resolved
 	resolved := spec
 	resolved.VRF = state.resolveLinkName(spec.VRF)
 	resolved.AdvertiseLinks = slices.Clone(spec.AdvertiseLinks)
+	resolved.AdvertiseProbes = slices.Clone(spec.AdvertiseProbes)
 	resolved.Neighbors = slices.Clone(spec.Neighbors)
 
 	if resolved.VRF != "" {
@@ -138,6 +139,31 @@ func (state *RuntimeState) Resolve(spec network.BGPInstanceConfigSpec) (Resolved
 	}, nil
 }
 
+// WithdrawFailed drops the prefixes of the advertised links whose probe is failing.
+//
+// The router-id is still derived from all advertised links, so that it doesn't change on a probe failure.
+func (state *RuntimeState) WithdrawFailed(resolved *ResolvedConfig, failedProbes map[string]struct{}) {
+	withdrawn := map[string]struct{}{}
+
+	for _, probe := range resolved.Spec.AdvertiseProbes {
+		if _, failed := failedProbes[probe.Probe]; failed {
+			withdrawn[probe.Link] = struct{}{}
+		}
+	}
+
+	if len(withdrawn) == 0 {
+		return
+	}
+
+	links := slices.DeleteFunc(slices.Clone(resolved.Spec.AdvertiseLinks), func(link string) bool {
+		_, ok := withdrawn[link]
+
+		return ok
+	})
+
+	resolved.AdvertisedPrefixes = connectedPrefixes(state.advertisedAddresses(links))
+}
+
 // LinkIndexByName returns a resolved link's kernel index.
 func (state *RuntimeState) LinkIndexByName(name string) (uint32, bool) {
 	spec, ok := state.linksByName[name]
@@ -167,6 +193,10 @@ func (state *RuntimeState) resolveLinks(resolved *network.BGPInstanceConfigSpec)
 		}
 	}
 
+	for i := range resolved.AdvertiseProbes {
+		resolved.AdvertiseProbes[i].Link = state.resolveLinkName(resolved.AdvertiseProbes[i].Link)
+	}
+
 	for i := range resolved.Neighbors {
 		if resolved.Neighbors[i].Link == "" {
 			continue
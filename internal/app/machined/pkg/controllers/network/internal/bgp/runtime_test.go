@@ -167,3 +167,36 @@ func TestRuntimeStateRouterID(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, missing.RouterID.IsValid())
 }
+
+func TestRuntimeStateWithdrawFailed(t *testing.T) {
+	t.Parallel()
+
+	state := internalbgp.NewRuntimeState(
+		map[string]network.LinkStatusSpec{
+			"dummy0": {Index: 1, Alias: "service"},
+			"dummy1": {Index: 2},
+		},
+		[]network.AddressStatusSpec{
+			{Address: netip.MustParsePrefix("10.0.0.2/32"), LinkName: "dummy0", LinkIndex: 1},
+			{Address: netip.MustParsePrefix("10.0.1.2/32"), LinkName: "dummy1", LinkIndex: 2},
+		},
+	)
+
+	resolved, err := state.Resolve(network.BGPInstanceConfigSpec{
+		AdvertiseLinks:  []string{"service", "dummy1"},
+		AdvertiseProbes: []network.BGPAdvertiseProbeSpec{{Link: "service", Probe: "service-check"}},
+	})
+	require.NoError(t, err)
+
+	state.WithdrawFailed(&resolved, map[string]struct{}{"other-check": {}})
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.2/32"),
+		netip.MustParsePrefix("10.0.1.2/32"),
+	}, resolved.AdvertisedPrefixes)
+
+	state.WithdrawFailed(&resolved, map[string]struct{}{"service-check": {}})
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.1.2/32")}, resolved.AdvertisedPrefixes)
+
+	// the router-id is not affected by the withdrawal
+	assert.Equal(t, netip.MustParseAddr("10.0.0.2"), resolved.RouterID)
+}
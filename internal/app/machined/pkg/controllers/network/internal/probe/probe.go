@@ -92,6 +92,7 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 				ID: runner.ID,
 				Status: network.ProbeStatusSpec{
 					Success: true,
+					Name:    runner.Spec.Name,
 				},
 			}) {
 				return
@@ -115,6 +116,7 @@ func (runner *Runner) run(ctx context.Context, notifyCh chan<- Notification, log
 			Status: network.ProbeStatusSpec{
 				Success:   false,
 				LastError: err.Error(),
+				Name:      runner.Spec.Name,
 			},
 		}) {
 			return
@@ -476,6 +476,16 @@ func (ctrl *LinkConfigController) processLinkConfigs(linkMap map[string]*network
 		case talosconfig.NetworkVLANConfig:
 			parentLink := linkNameResolver.Resolve(specificLinkConfig.ParentLink())
 			vlanLink(linkMap[linkName], linkName, parentLink, networkVLANConfigToVlaner{specificLinkConfig})
+		case talosconfig.NetworkMACVLANConfig:
+			macvlanLink(linkMap[linkName], linkNameResolver.Resolve(specificLinkConfig.ParentLink()), specificLinkConfig)
+		case talosconfig.NetworkIPVLANConfig:
+			ipvlanLink(linkMap[linkName], linkNameResolver.Resolve(specificLinkConfig.ParentLink()), specificLinkConfig)
+		case talosconfig.NetworkVXLANConfig:
+			vxlanLink(linkMap[linkName], linkNameResolver.Resolve(specificLinkConfig.ParentLink()), specificLinkConfig)
+		case talosconfig.NetworkGeneveConfig:
+			geneveLink(linkMap[linkName], specificLinkConfig)
+		case talosconfig.NetworkGREConfig:
+			greLink(linkMap[linkName], linkNameResolver.Resolve(specificLinkConfig.ParentLink()), specificLinkConfig)
 		case talosconfig.NetworkBondConfig:
 			SendBondMaster(linkMap[linkName], specificLinkConfig, linkNameResolver.Resolve)
 
@@ -580,6 +590,26 @@ func vlanLink(link *network.LinkSpecSpec, vlanName, linkName string, vlan vlaner
 	}
 }
 
+func macvlanLink(link *network.LinkSpecSpec, parentLink string, config talosconfig.NetworkMACVLANConfig) {
+	link.Logical = true
+	link.Kind = network.LinkKindMACVLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = parentLink
+	link.MACVLAN = network.MACVLANSpec{
+		Mode: config.MACVLANMode().ValueOr(nethelpers.MACVLANModeBridge),
+	}
+}
+
+func ipvlanLink(link *network.LinkSpecSpec, parentLink string, config talosconfig.NetworkIPVLANConfig) {
+	link.Logical = true
+	link.Kind = network.LinkKindIPVLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = parentLink
+	link.IPVLAN = network.IPVLANSpec{
+		Mode: config.IPVLANMode().ValueOr(nethelpers.IPVLANModeL2),
+	}
+}
+
 func wireguardLinkLegacy(link *network.LinkSpecSpec, config talosconfig.WireguardConfig) error {
 	link.Logical = true
 	link.Kind = network.LinkKindWireguard
@@ -634,6 +664,65 @@ func wireguardLink(link *network.LinkSpecSpec, config talosconfig.NetworkWiregua
 	}
 }
 
+// Default (IANA-assigned) destination ports for UDP tunnels.
+const (
+	defaultVXLANPort  = 4789
+	defaultGenevePort = 6081
+)
+
+func vxlanLink(link *network.LinkSpecSpec, parentLink string, config talosconfig.NetworkVXLANConfig) {
+	link.Logical = true
+	link.Kind = network.LinkKindVXLAN
+	link.Type = nethelpers.LinkEther
+	link.ParentName = parentLink
+	link.VXLAN = network.VXLANSpec{
+		VNI:    config.VNI(),
+		Local:  config.Local().ValueOr(netip.Addr{}),
+		Remote: config.Remote().ValueOr(netip.Addr{}),
+		Port:   config.Port().ValueOr(defaultVXLANPort),
+	}
+}
+
+func geneveLink(link *network.LinkSpecSpec, config talosconfig.NetworkGeneveConfig) {
+	link.Logical = true
+	link.Kind = network.LinkKindGeneve
+	link.Type = nethelpers.LinkEther
+	link.Geneve = network.GeneveSpec{
+		VNI:    config.VNI(),
+		Remote: config.Remote(),
+		Port:   config.Port().ValueOr(defaultGenevePort),
+	}
+}
+
+func greLink(link *network.LinkSpecSpec, parentLink string, config talosconfig.NetworkGREConfig) {
+	link.Logical = true
+	link.ParentName = parentLink
+
+	// the link kind is picked by the address family of the tunnel endpoints
+	ipv6 := config.Remote().Is6()
+
+	switch {
+	case config.TAP() && ipv6:
+		link.Kind = network.LinkKindIP6GRETAP
+		link.Type = nethelpers.LinkEther
+	case config.TAP():
+		link.Kind = network.LinkKindGRETAP
+		link.Type = nethelpers.LinkEther
+	case ipv6:
+		link.Kind = network.LinkKindIP6GRE
+		link.Type = nethelpers.LinkIP6gre
+	default:
+		link.Kind = network.LinkKindGRE
+		link.Type = nethelpers.LinkIpgre
+	}
+
+	link.GRE = network.GRESpec{
+		Local:  config.Local().ValueOr(netip.Addr{}),
+		Remote: config.Remote(),
+		Key:    config.Key().ValueOr(0),
+	}
+}
+
 func dummyLink(link *network.LinkSpecSpec) {
 	link.Logical = true
 	link.Kind = "dummy"
@@ -683,6 +683,144 @@ func (suite *LinkConfigSuite) TestMachineConfigurationNewStyleVRF() {
 	)
 }
 
+func (suite *LinkConfigSuite) TestMachineConfigurationNewStyleTunnels() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.LinkConfigController{}))
+
+	vxlan := networkcfg.NewVXLANConfigV1Alpha1("vxlan42")
+	vxlan.VXLANVNI = 42
+	vxlan.VXLANParentLink = "enp0s2"
+	vxlan.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+
+	geneve := networkcfg.NewGeneveConfigV1Alpha1("geneve7")
+	geneve.GeneveVNI = 7
+	geneve.GeneveRemote = meta.Addr{Addr: netip.MustParseAddr("2001:db8::2")}
+	geneve.GenevePort = 6082
+
+	gre := networkcfg.NewGREConfigV1Alpha1("gre0")
+	gre.GRELocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	gre.GRERemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+
+	gretap := networkcfg.NewGRETAPConfigV1Alpha1("gretap0")
+	gretap.GREParentLink = "enp0s2"
+	gretap.GRERemote = meta.Addr{Addr: netip.MustParseAddr("2001:db8::2")}
+	gretap.GREKey = new(uint32(100))
+
+	ctr, err := container.New(vxlan, geneve, gre, gretap)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	status := network.NewLinkStatus(network.NamespaceName, "eth0")
+	status.TypedSpec().AltNames = []string{"enp0s2"}
+	suite.Create(status)
+
+	suite.assertLinks(
+		[]string{
+			"configuration/vxlan42",
+			"configuration/geneve7",
+			"configuration/gre0",
+			"configuration/gretap0",
+		}, func(r *network.LinkSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+			asrt.True(r.TypedSpec().Up)
+			asrt.True(r.TypedSpec().Logical)
+
+			switch r.TypedSpec().Name {
+			case "vxlan42":
+				asrt.Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+				asrt.Equal(network.LinkKindVXLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth0", r.TypedSpec().ParentName)
+				asrt.Equal(network.VXLANSpec{
+					VNI:    42,
+					Remote: netip.MustParseAddr("239.1.1.1"),
+					Port:   4789,
+				}, r.TypedSpec().VXLAN)
+			case "geneve7":
+				asrt.Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+				asrt.Equal(network.LinkKindGeneve, r.TypedSpec().Kind)
+				asrt.Empty(r.TypedSpec().ParentName)
+				asrt.Equal(network.GeneveSpec{
+					VNI:    7,
+					Remote: netip.MustParseAddr("2001:db8::2"),
+					Port:   6082,
+				}, r.TypedSpec().Geneve)
+			case "gre0":
+				asrt.Equal(nethelpers.LinkIpgre, r.TypedSpec().Type)
+				asrt.Equal(network.LinkKindGRE, r.TypedSpec().Kind)
+				asrt.Empty(r.TypedSpec().ParentName)
+				asrt.Equal(network.GRESpec{
+					Local:  netip.MustParseAddr("192.168.1.10"),
+					Remote: netip.MustParseAddr("192.168.2.10"),
+				}, r.TypedSpec().GRE)
+			case "gretap0":
+				asrt.Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+				asrt.Equal(network.LinkKindIP6GRETAP, r.TypedSpec().Kind)
+				asrt.Equal("eth0", r.TypedSpec().ParentName)
+				asrt.Equal(network.GRESpec{
+					Remote: netip.MustParseAddr("2001:db8::2"),
+					Key:    100,
+				}, r.TypedSpec().GRE)
+			}
+		},
+	)
+}
+
+func (suite *LinkConfigSuite) TestMachineConfigurationNewStyleMACVLAN() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.LinkConfigController{}))
+
+	macvlan := networkcfg.NewMACVLANConfigV1Alpha1("macvlan0")
+	macvlan.ParentLinkConfig = "enp0s2"
+	macvlan.HardwareAddressConfig = nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+
+	macvlanPrivate := networkcfg.NewMACVLANConfigV1Alpha1("macvlan1")
+	macvlanPrivate.ParentLinkConfig = "eth1"
+	macvlanPrivate.MACVLANModeConfig = new(nethelpers.MACVLANModePrivate)
+
+	ipvlan := networkcfg.NewIPVLANConfigV1Alpha1("ipvlan0")
+	ipvlan.ParentLinkConfig = "enp0s2"
+	ipvlan.IPVLANModeConfig = new(nethelpers.IPVLANModeL3S)
+
+	ctr, err := container.New(macvlan, macvlanPrivate, ipvlan)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Create(cfg)
+
+	status := network.NewLinkStatus(network.NamespaceName, "eth0")
+	status.TypedSpec().AltNames = []string{"enp0s2"}
+	suite.Create(status)
+
+	suite.assertLinks(
+		[]string{
+			"configuration/macvlan0",
+			"configuration/macvlan1",
+			"configuration/ipvlan0",
+		}, func(r *network.LinkSpec, asrt *assert.Assertions) {
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+			asrt.True(r.TypedSpec().Up)
+			asrt.True(r.TypedSpec().Logical)
+			asrt.Equal(nethelpers.LinkEther, r.TypedSpec().Type)
+
+			switch r.TypedSpec().Name {
+			case "macvlan0":
+				asrt.Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth0", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.MACVLANModeBridge, r.TypedSpec().MACVLAN.Mode)
+				asrt.Equal(nethelpers.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}, r.TypedSpec().HardwareAddress)
+			case "macvlan1":
+				asrt.Equal(network.LinkKindMACVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth1", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.MACVLANModePrivate, r.TypedSpec().MACVLAN.Mode)
+			case "ipvlan0":
+				asrt.Equal(network.LinkKindIPVLAN, r.TypedSpec().Kind)
+				asrt.Equal("eth0", r.TypedSpec().ParentName)
+				asrt.Equal(nethelpers.IPVLANModeL3S, r.TypedSpec().IPVLAN.Mode)
+			}
+		},
+	)
+}
+
 func (suite *LinkConfigSuite) TestMachineConfigurationNewStyleVethVRF() {
 	suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.LinkConfigController{}))
 
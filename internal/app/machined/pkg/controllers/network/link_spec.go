@@ -274,6 +274,33 @@ func rawLinkData(link *rtnetlink.LinkMessage) []byte {
 	return linkData.Data
 }
 
+// isGREKind returns true for all flavors of GRE tunnel links.
+func isGREKind(kind string) bool {
+	switch kind {
+	case network.LinkKindGRE, network.LinkKindGRETAP, network.LinkKindIP6GRE, network.LinkKindIP6GRETAP:
+		return true
+	default:
+		return false
+	}
+}
+
+// tunnelParentIndex resolves the index of the tunnel underlay link.
+//
+// If the underlay link is not found, the current index is returned, so that a missing
+// parent doesn't trigger the tunnel link replacement.
+func tunnelParentIndex(links []rtnetlink.LinkMessage, parentName string, current uint32) uint32 {
+	if parentName == "" {
+		return 0
+	}
+
+	parent := findLink(links, parentName, true)
+	if parent == nil {
+		return current
+	}
+
+	return parent.Index
+}
+
 func verifyVethPeers(links []rtnetlink.LinkMessage, name, peerName string) error {
 	nameLink := findLink(links, name, false)
 	if nameLink == nil {
@@ -321,7 +348,7 @@ func verifyVethEndpoint(link *rtnetlink.LinkMessage, name string) error {
 //
 // If the logical link kind or type got changed (for example, "link0" was a bond, and now it's wireguard interface), the link
 // is dropped and replaced with the new one.
-// Same replace flow is used for VLAN links, as VLAN settings can't be changed on the fly.
+// Same replace flow is used for VLAN, MACVLAN and IPVLAN links, as their settings can't be changed on the fly.
 //
 // For bonded links, there are two sync steps applied:
 //
@@ -435,6 +462,124 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			// sync MACVLAN/IPVLAN modes, links are re-created to apply the new mode
+			if !replace && link.TypedSpec().Kind == network.LinkKindMACVLAN {
+				var existingMACVLAN network.MACVLANSpec
+
+				if existingRawLinkData == nil {
+					return fmt.Errorf("existing link %q has no data, can't decode MACVLAN settings", link.TypedSpec().Name)
+				}
+
+				if err := networkadapter.MACVLANSpec(&existingMACVLAN).Decode(existingRawLinkData); err != nil {
+					return fmt.Errorf("error decoding MACVLAN properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				if existingMACVLAN != link.TypedSpec().MACVLAN {
+					logger.Info(
+						"replacing MACVLAN link",
+						zap.Stringer("old_mode", existingMACVLAN.Mode),
+						zap.Stringer("new_mode", link.TypedSpec().MACVLAN.Mode),
+					)
+
+					replace = true
+				}
+			}
+
+			if !replace && link.TypedSpec().Kind == network.LinkKindIPVLAN {
+				var existingIPVLAN network.IPVLANSpec
+
+				if existingRawLinkData == nil {
+					return fmt.Errorf("existing link %q has no data, can't decode IPVLAN settings", link.TypedSpec().Name)
+				}
+
+				if err := networkadapter.IPVLANSpec(&existingIPVLAN).Decode(existingRawLinkData); err != nil {
+					return fmt.Errorf("error decoding IPVLAN properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				if existingIPVLAN != link.TypedSpec().IPVLAN {
+					logger.Info(
+						"replacing IPVLAN link",
+						zap.Stringer("old_mode", existingIPVLAN.Mode),
+						zap.Stringer("new_mode", link.TypedSpec().IPVLAN.Mode),
+					)
+
+					replace = true
+				}
+			}
+
+			// sync tunnel specs, as they can't be modified on the fly
+			if !replace && link.TypedSpec().Kind == network.LinkKindVXLAN {
+				var existingVXLAN network.VXLANSpec
+
+				if existingRawLinkData == nil {
+					return fmt.Errorf("existing link %q has no data, can't decode VXLAN settings", link.TypedSpec().Name)
+				}
+
+				if err := networkadapter.VXLANSpec(&existingVXLAN).Decode(existingRawLinkData); err != nil {
+					return fmt.Errorf("error decoding VXLAN properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				expectedVXLAN := link.TypedSpec().VXLAN
+				expectedVXLAN.LinkIndex = tunnelParentIndex(*links, link.TypedSpec().ParentName, existingVXLAN.LinkIndex)
+
+				if existingVXLAN != expectedVXLAN {
+					logger.Info(
+						"replacing VXLAN link",
+						zap.String("old", fmt.Sprintf("%+v", existingVXLAN)),
+						zap.String("new", fmt.Sprintf("%+v", expectedVXLAN)),
+					)
+
+					replace = true
+				}
+			}
+
+			if !replace && link.TypedSpec().Kind == network.LinkKindGeneve {
+				var existingGeneve network.GeneveSpec
+
+				if existingRawLinkData == nil {
+					return fmt.Errorf("existing link %q has no data, can't decode Geneve settings", link.TypedSpec().Name)
+				}
+
+				if err := networkadapter.GeneveSpec(&existingGeneve).Decode(existingRawLinkData); err != nil {
+					return fmt.Errorf("error decoding Geneve properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				if existingGeneve != link.TypedSpec().Geneve {
+					logger.Info(
+						"replacing Geneve link",
+						zap.String("old", fmt.Sprintf("%+v", existingGeneve)),
+						zap.String("new", fmt.Sprintf("%+v", link.TypedSpec().Geneve)),
+					)
+
+					replace = true
+				}
+			}
+
+			if !replace && isGREKind(link.TypedSpec().Kind) {
+				var existingGRE network.GRESpec
+
+				if existingRawLinkData == nil {
+					return fmt.Errorf("existing link %q has no data, can't decode GRE settings", link.TypedSpec().Name)
+				}
+
+				if err := networkadapter.GRESpec(&existingGRE).Decode(existingRawLinkData); err != nil {
+					return fmt.Errorf("error decoding GRE properties on %q: %w", link.TypedSpec().Name, err)
+				}
+
+				expectedGRE := link.TypedSpec().GRE
+				expectedGRE.LinkIndex = tunnelParentIndex(*links, link.TypedSpec().ParentName, existingGRE.LinkIndex)
+
+				if existingGRE != expectedGRE {
+					logger.Info(
+						"replacing GRE link",
+						zap.String("old", fmt.Sprintf("%+v", existingGRE)),
+						zap.String("new", fmt.Sprintf("%+v", expectedGRE)),
+					)
+
+					replace = true
+				}
+			}
+
 			// sync VRF spec, as it can't be modified on the fly
 			if !replace && link.TypedSpec().Kind == network.LinkKindVRF {
 				var existingVRF network.VRFMasterSpec
@@ -483,7 +628,7 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				err         error
 			)
 
-			// VLAN settings should be set on interface creation (parent + VLAN settings)
+			// VLAN, MACVLAN and IPVLAN settings should be set on interface creation (parent + link settings)
 			if link.TypedSpec().ParentName != "" {
 				parent := findLink(*links, link.TypedSpec().ParentName, true) // allow aliases for physical links/parents
 				if parent == nil {
@@ -501,6 +646,20 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			if link.TypedSpec().Kind == network.LinkKindMACVLAN {
+				data, err = networkadapter.MACVLANSpec(&link.TypedSpec().MACVLAN).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding MACVLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			}
+
+			if link.TypedSpec().Kind == network.LinkKindIPVLAN {
+				data, err = networkadapter.IPVLANSpec(&link.TypedSpec().IPVLAN).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding IPVLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			}
+
 			if link.TypedSpec().Kind == network.LinkKindBond {
 				bondMaster, _ := resolveBondPrimary(link.TypedSpec().BondMaster, *links)
 
@@ -517,6 +676,33 @@ func (ctrl *LinkSpecController) syncLink(ctx context.Context, r controller.Runti
 				}
 			}
 
+			if link.TypedSpec().Kind == network.LinkKindVXLAN {
+				vxlan := link.TypedSpec().VXLAN
+				vxlan.LinkIndex = parentIndex
+
+				data, err = networkadapter.VXLANSpec(&vxlan).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding VXLAN attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			}
+
+			if link.TypedSpec().Kind == network.LinkKindGeneve {
+				data, err = networkadapter.GeneveSpec(&link.TypedSpec().Geneve).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding Geneve attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			}
+
+			if isGREKind(link.TypedSpec().Kind) {
+				gre := link.TypedSpec().GRE
+				gre.LinkIndex = parentIndex
+
+				data, err = networkadapter.GRESpec(&gre).Encode()
+				if err != nil {
+					return fmt.Errorf("error encoding GRE attributes for link %q: %w", link.TypedSpec().Name, err)
+				}
+			}
+
 			// vrf settings should be set on interface creation (parent + vrf settings)
 			if link.TypedSpec().VRFSlave.MasterName != "" {
 				master := findLink(*links, link.TypedSpec().VRFSlave.MasterName, false)
@@ -245,6 +245,12 @@ func (ctrl *LinkStatusController) reconcile(
 			status.MTU = link.Attributes.MTU
 
 			status.Veth = network.VethSpec{}
+			status.MACVLAN = network.MACVLANSpec{}
+			status.IPVLAN = network.IPVLANSpec{}
+			status.VXLAN = network.VXLANSpec{}
+			status.Geneve = network.GeneveSpec{}
+			status.GRE = network.GRESpec{}
+
 			if link.Attributes.Master != nil {
 				status.MasterIndex = *link.Attributes.Master
 			} else {
@@ -343,6 +349,36 @@ func (ctrl *LinkStatusController) reconcile(
 				} else if err = networkadapter.VLANSpec(&status.VLAN).Decode(rawLinkData); err != nil {
 					logger.Warn("failure decoding VLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
 				}
+			case network.LinkKindMACVLAN:
+				if rawLinkData == nil {
+					logger.Warn("MACVLAN link data is nil", zap.String("link", link.Attributes.Name))
+				} else if err = networkadapter.MACVLANSpec(&status.MACVLAN).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding MACVLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindIPVLAN:
+				if rawLinkData == nil {
+					logger.Warn("IPVLAN link data is nil", zap.String("link", link.Attributes.Name))
+				} else if err = networkadapter.IPVLANSpec(&status.IPVLAN).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding IPVLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindVXLAN:
+				if rawLinkData == nil {
+					logger.Warn("VXLAN link data is nil", zap.String("link", link.Attributes.Name))
+				} else if err = networkadapter.VXLANSpec(&status.VXLAN).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding VXLAN attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindGeneve:
+				if rawLinkData == nil {
+					logger.Warn("Geneve link data is nil", zap.String("link", link.Attributes.Name))
+				} else if err = networkadapter.GeneveSpec(&status.Geneve).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding Geneve attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
+			case network.LinkKindGRE, network.LinkKindGRETAP, network.LinkKindIP6GRE, network.LinkKindIP6GRETAP:
+				if rawLinkData == nil {
+					logger.Warn("GRE link data is nil", zap.String("link", link.Attributes.Name))
+				} else if err = networkadapter.GRESpec(&status.GRE).Decode(rawLinkData); err != nil {
+					logger.Warn("failure decoding GRE attributes", zap.Error(err), zap.String("link", link.Attributes.Name))
+				}
 			case network.LinkKindBond:
 				if rawLinkData == nil {
 					logger.Warn("bond link data is nil", zap.String("link", link.Attributes.Name))
@@ -9,16 +9,19 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/google/nftables"
 	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
 	"github.com/mdlayher/netlink"
 	"go.uber.org/zap"
 
 	networkadapter "github.com/siderolabs/talos/internal/app/machined/pkg/adapters/network"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -37,6 +40,19 @@ import (
 // `truesize` floor inflates the small messages the ruleset is made of.
 const nfTablesSocketBufferSize = 8 * 1024 * 1024
 
+// nfTablesUserDataRuleIndex is the userdata TLV type carrying the index of the rule in the NfTablesChain spec.
+//
+// It maps the counters back to the spec rules, and it is ignored by the nft CLI.
+const nfTablesUserDataRuleIndex = userdata.TypesCount
+
+// nfTablesUserDataPolicy is the userdata TLV type marking the trailing rule which implements the drop policy of the chain.
+//
+// The policy itself has no counter, so the rule makes the packets dropped by the policy visible in the status.
+const nfTablesUserDataPolicy = userdata.TypesCount + 1
+
+// nfTablesStatusInterval is the interval to refresh the rule counters in the NfTablesChainStatus.
+const nfTablesStatusInterval = 30 * time.Second
+
 // NfTablesChainController applies network.NfTablesChain to the Linux nftables interface.
 type NfTablesChainController struct {
 	TableName string
@@ -60,7 +76,12 @@ func (ctrl *NfTablesChainController) Inputs() []controller.Input {
 
 // Outputs implements controller.Controller interface.
 func (ctrl *NfTablesChainController) Outputs() []controller.Output {
-	return nil
+	return []controller.Output{
+		{
+			Type: network.NfTablesChainStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
 }
 
 // Run implements controller.Controller interface.
@@ -88,10 +109,19 @@ func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runti
 
 	defer conn.CloseLasting() //nolint:errcheck
 
+	ticker := time.NewTicker(nfTablesStatusInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
+		case <-ticker.C:
+			if err := ctrl.updateStatus(ctx, r, conn); err != nil {
+				return fmt.Errorf("error updating nftables chain status: %w", err)
+			}
+
+			continue
 		case <-r.EventCh():
 		}
 
@@ -109,10 +139,18 @@ func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runti
 			return fmt.Errorf("error listing existing nftables tables: %w", err)
 		}
 
-		var talosTable *nftables.Table
+		var (
+			talosTable     *nftables.Table
+			existingMeters []*nftables.Set
+		)
 
 		if idx := slices.IndexFunc(existingTables, func(t *nftables.Table) bool { return t.Name == ctrl.TableName }); idx != -1 {
 			talosTable = existingTables[idx]
+
+			existingMeters, err = conn.GetSets(talosTable)
+			if err != nil {
+				return fmt.Errorf("error listing existing nftables sets: %w", err)
+			}
 		}
 
 		if talosTable == nil {
@@ -138,6 +176,15 @@ func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runti
 			conn.DelChain(chain)
 		}
 
+		// drop all named sets (meters), anonymous sets are dropped with the rules
+		for _, set := range existingMeters {
+			if set.Anonymous {
+				continue
+			}
+
+			conn.DelSet(set)
+		}
+
 		setID := uint32(0)
 
 		for chain := range list.All() {
@@ -150,7 +197,7 @@ func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runti
 				Policy:   new(nftables.ChainPolicy(chain.TypedSpec().Policy)),
 			})
 
-			for _, rule := range chain.TypedSpec().Rules {
+			for ruleIdx, rule := range chain.TypedSpec().Rules {
 				compiled, err := networkadapter.NfTablesRule(&rule).Compile()
 				if err != nil {
 					return fmt.Errorf("error compiling nftables rule for chain %s: %w", nfChain.Name, err)
@@ -187,15 +234,59 @@ func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runti
 
 							setID++
 						}
+
+						// meters are named dynamic sets updated from the packet path
+						if dynset, ok := compiledRule[i].(*expr.Dynset); ok {
+							if dynset.SetID >= uint32(len(compiled.Sets)) {
+								return fmt.Errorf("invalid set ID %d in dynset", dynset.SetID)
+							}
+
+							set := compiled.Sets[dynset.SetID]
+							setName := "__meter" + strconv.Itoa(int(setID))
+
+							if err = conn.AddSet(&nftables.Set{
+								Table:      talosTable,
+								ID:         setID,
+								Name:       setName,
+								Dynamic:    true,
+								HasTimeout: set.Timeout != 0,
+								Timeout:    set.Timeout,
+								Size:       networkadapter.MeterSetSize,
+								KeyType:    set.KeyType(),
+							}, nil); err != nil {
+								return fmt.Errorf("error adding nftables meter for chain %s: %w", nfChain.Name, err)
+							}
+
+							dynsetOp := *dynset
+							dynsetOp.SetID = setID
+							dynsetOp.SetName = setName
+
+							compiledRule[i] = &dynsetOp
+
+							setID++
+						}
 					}
 
 					conn.AddRule(&nftables.Rule{
-						Table: talosTable,
-						Chain: nfChain,
-						Exprs: compiledRule,
+						Table:    talosTable,
+						Chain:    nfChain,
+						Exprs:    compiledRule,
+						UserData: userdata.AppendUint32(nil, nfTablesUserDataRuleIndex, uint32(ruleIdx)),
 					})
 				}
 			}
+
+			if chain.TypedSpec().Policy == nethelpers.VerdictDrop {
+				conn.AddRule(&nftables.Rule{
+					Table: talosTable,
+					Chain: nfChain,
+					Exprs: []expr.Any{
+						&expr.Counter{},
+						&expr.Verdict{Kind: expr.VerdictDrop},
+					},
+					UserData: userdata.AppendUint32(nil, nfTablesUserDataPolicy, 1),
+				})
+			}
 		}
 
 		if err := conn.Flush(); err != nil {
@@ -205,10 +296,119 @@ func (ctrl *NfTablesChainController) Run(ctx context.Context, r controller.Runti
 		chainNames := safe.ToSlice(list, func(chain *network.NfTablesChain) string { return chain.Metadata().ID() })
 		logger.Info("nftables chains updated", zap.Strings("chains", chainNames))
 
+		if err := ctrl.updateStatus(ctx, r, conn); err != nil {
+			return fmt.Errorf("error updating nftables chain status: %w", err)
+		}
+
 		r.ResetRestartBackoff()
 	}
 }
 
+// updateStatus reads back the rule counters from the kernel and publishes them as NfTablesChainStatus.
+func (ctrl *NfTablesChainController) updateStatus(ctx context.Context, r controller.Runtime, conn *nftables.Conn) error {
+	list, err := safe.ReaderListAll[*network.NfTablesChain](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing nftables chains: %w", err)
+	}
+
+	existingChains, err := conn.ListChainsOfTableFamily(nftables.TableFamilyINet)
+	if err != nil {
+		return fmt.Errorf("error listing existing nftables chains: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	for chain := range list.All() {
+		idx := slices.IndexFunc(existingChains, func(c *nftables.Chain) bool {
+			return c.Table.Name == ctrl.TableName && c.Name == chain.Metadata().ID()
+		})
+		if idx == -1 {
+			continue
+		}
+
+		rules, err := conn.GetRules(existingChains[idx].Table, existingChains[idx])
+		if err != nil {
+			return fmt.Errorf("error listing nftables rules for chain %s: %w", chain.Metadata().ID(), err)
+		}
+
+		ruleCounters, droppedPackets, droppedBytes := nfTablesChainCounters(chain.TypedSpec().Rules, rules)
+
+		if err = safe.WriterModify(ctx, r, network.NewNfTablesChainStatus(network.NamespaceName, chain.Metadata().ID()),
+			func(status *network.NfTablesChainStatus) error {
+				spec := status.TypedSpec()
+
+				spec.DroppedPackets = droppedPackets
+				spec.DroppedBytes = droppedBytes
+				spec.Rules = ruleCounters
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("error updating nftables chain status: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*network.NfTablesChainStatus](ctx, r)
+}
+
+// nfTablesChainCounters maps the counters of the nftables rules back to the spec rules.
+//
+// The dropped packets are the packets counted by the rules with the drop verdict and by the drop policy of the chain.
+func nfTablesChainCounters(specRules []network.NfTablesRule, rules []*nftables.Rule) (ruleCounters []network.NfTablesRuleCounter, droppedPackets, droppedBytes uint64) {
+	// a single spec rule might be compiled into multiple nftables rules (e.g. per IP family)
+	counters := map[int]*network.NfTablesRuleCounter{}
+
+	for _, rule := range rules {
+		if _, ok := userdata.GetUint32(rule.UserData, nfTablesUserDataPolicy); ok {
+			for _, e := range rule.Exprs {
+				if counter, ok := e.(*expr.Counter); ok {
+					droppedPackets += counter.Packets
+					droppedBytes += counter.Bytes
+				}
+			}
+
+			continue
+		}
+
+		idx, ok := userdata.GetUint32(rule.UserData, nfTablesUserDataRuleIndex)
+		if !ok || int(idx) >= len(specRules) {
+			continue
+		}
+
+		ruleIdx := int(idx)
+
+		for _, e := range rule.Exprs {
+			counter, ok := e.(*expr.Counter)
+			if !ok {
+				continue
+			}
+
+			if counters[ruleIdx] == nil {
+				counters[ruleIdx] = &network.NfTablesRuleCounter{Index: ruleIdx}
+			}
+
+			counters[ruleIdx].Packets += counter.Packets
+			counters[ruleIdx].Bytes += counter.Bytes
+		}
+	}
+
+	for ruleIdx := range specRules {
+		counter := counters[ruleIdx]
+		if counter == nil {
+			continue
+		}
+
+		ruleCounters = append(ruleCounters, *counter)
+
+		if verdict := specRules[ruleIdx].Verdict; verdict != nil && *verdict == nethelpers.VerdictDrop {
+			droppedPackets += counter.Packets
+			droppedBytes += counter.Bytes
+		}
+	}
+
+	return ruleCounters, droppedPackets, droppedBytes
+}
+
 func (ctrl *NfTablesChainController) preCreateIptablesNFTable(logger *zap.Logger, conn *nftables.Conn) error {
 	// Pre-create the iptables-nft table, if it doesn't exist.
 	// This is required to ensure that the iptables universal binary prefers iptables-nft over
@@ -28,8 +28,11 @@ import (
 
 // Chain names.
 const (
-	IngressChainName    = "ingress"
-	PreroutingChainName = "prerouting"
+	IngressChainName        = "ingress"
+	PreroutingChainName     = "prerouting"
+	EgressChainName         = "egress"
+	NATPreroutingChainName  = "nat-prerouting"
+	NATPostroutingChainName = "nat-postrouting"
 )
 
 // NfTablesChainConfigController generates nftables rules based on machine configuration.
@@ -111,6 +114,24 @@ func (ctrl *NfTablesChainConfigController) Run(ctx context.Context, r controller
 			}
 		}
 
+		if cfg != nil && !(cfg.Config().NetworkRules().EgressDefaultAction() == nethelpers.DefaultActionAccept && cfg.Config().NetworkRules().EgressRules() == nil) {
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, EgressChainName), ctrl.buildEgressChain(cfg)); err != nil {
+				return err
+			}
+		}
+
+		if cfg != nil && cfg.Config().NetworkRules().SourceNATRules() != nil {
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, NATPostroutingChainName), ctrl.buildNATPostroutingChain(cfg)); err != nil {
+				return err
+			}
+		}
+
+		if cfg != nil && cfg.Config().NetworkRules().DestinationNATRules() != nil && nodeAddresses != nil {
+			if err = safe.WriterModify(ctx, r, network.NewNfTablesChain(network.NamespaceName, NATPreroutingChainName), ctrl.buildNATPreroutingChain(cfg, nodeAddresses)); err != nil {
+				return err
+			}
+		}
+
 		if err = safe.CleanupOutputs[*network.NfTablesChain](ctx, r); err != nil {
 			return err
 		}
@@ -283,6 +304,10 @@ func (ctrl *NfTablesChainConfigController) buildIngressChain(cfg *config.Machine
 				verdict = nethelpers.VerdictAccept
 			}
 
+			if limits := rule.Limits(); limits != nil {
+				spec.Rules = append(spec.Rules, limitRules(rule, limits, portRanges)...)
+			}
+
 			spec.Rules = append(
 				spec.Rules,
 				network.NfTablesRule{
@@ -310,13 +335,7 @@ func (ctrl *NfTablesChainConfigController) buildIngressChain(cfg *config.Machine
 }
 
 func (ctrl *NfTablesChainConfigController) buildPreroutingChain(cfg *config.MachineConfig, nodeAddresses *network.NodeAddress) func(*network.NfTablesChain) error {
-	// convert CIDRs to /32 (/128) prefixes matching only the address itself
-	myAddresses := xslices.Map(
-		nodeAddresses.TypedSpec().Addresses,
-		func(addr netip.Prefix) netip.Prefix {
-			return netip.PrefixFrom(addr.Addr(), addr.Addr().BitLen())
-		},
-	)
+	myAddresses := hostPrefixes(nodeAddresses)
 
 	return func(chain *network.NfTablesChain) error {
 		spec := chain.TypedSpec()
@@ -401,6 +420,28 @@ func (ctrl *NfTablesChainConfigController) buildPreroutingChain(cfg *config.Mach
 		}
 
 		if defaultAction == nethelpers.DefaultActionBlock {
+			// accept new connections to the ports forwarded with destination NAT
+			for _, rule := range cfg.Config().NetworkRules().DestinationNATRules() {
+				spec.Rules = append(
+					spec.Rules,
+					network.NfTablesRule{
+						MatchIIfName: matchIfName(rule.InLinkName()),
+						MatchConntrackState: &network.NfTablesConntrackStateMatch{
+							States: []nethelpers.ConntrackState{
+								nethelpers.ConntrackStateNew,
+							},
+						},
+						MatchSourceAddress: matchSubnets(rule.Subnets(), rule.ExceptSubnets()),
+						MatchLayer4: &network.NfTablesLayer4Match{
+							Protocol:             rule.Protocol(),
+							MatchDestinationPort: matchPorts(rule.PortRanges()),
+						},
+						AnonCounter: true,
+						Verdict:     new(nethelpers.VerdictAccept),
+					},
+				)
+			}
+
 			// drop any TCP/UDP new connections
 			spec.Rules = append(
 				spec.Rules,
@@ -435,6 +476,303 @@ func (ctrl *NfTablesChainConfigController) buildPreroutingChain(cfg *config.Mach
 	}
 }
 
+func (ctrl *NfTablesChainConfigController) buildEgressChain(cfg *config.MachineConfig) func(*network.NfTablesChain) error {
+	return func(chain *network.NfTablesChain) error {
+		spec := chain.TypedSpec()
+
+		spec.Type = nethelpers.ChainTypeFilter
+		spec.Hook = nethelpers.ChainHookOutput
+		spec.Priority = nethelpers.ChainPriorityMangle + 10
+		spec.Policy = nethelpers.VerdictAccept
+
+		// preamble
+		spec.Rules = []network.NfTablesRule{
+			// trusted interfaces: loopback, siderolink and kubespan
+			{
+				MatchOIfName: &network.NfTablesIfNameMatch{
+					InterfaceNames: []string{
+						"lo",
+						constants.SideroLinkName,
+						constants.KubeSpanLinkName,
+					},
+					Operator: nethelpers.OperatorEqual,
+				},
+				AnonCounter: true,
+				Verdict:     new(nethelpers.VerdictAccept),
+			},
+			// conntrack: replies to the accepted inbound connections are always allowed
+			{
+				MatchConntrackState: &network.NfTablesConntrackStateMatch{
+					States: []nethelpers.ConntrackState{
+						nethelpers.ConntrackStateEstablished,
+						nethelpers.ConntrackStateRelated,
+					},
+				},
+				AnonCounter: true,
+				Verdict:     new(nethelpers.VerdictAccept),
+			},
+			{
+				MatchConntrackState: &network.NfTablesConntrackStateMatch{
+					States: []nethelpers.ConntrackState{
+						nethelpers.ConntrackStateInvalid,
+					},
+				},
+				AnonCounter: true,
+				Verdict:     new(nethelpers.VerdictDrop),
+			},
+		}
+
+		defaultAction := cfg.Config().NetworkRules().EgressDefaultAction()
+
+		if defaultAction == nethelpers.DefaultActionBlock {
+			spec.Policy = nethelpers.VerdictDrop
+
+			spec.Rules = append(
+				spec.Rules,
+				// allow ICMP and ICMPv6 explicitly, as ICMPv6 is required for neighbor discovery
+				network.NfTablesRule{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMP,
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				network.NfTablesRule{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMPv6,
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				// allow DHCP and DHCPv6 clients
+				network.NfTablesRule{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolUDP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{{Lo: 67, Hi: 67}, {Lo: 547, Hi: 547}},
+						},
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+			)
+
+			if k8sNetwork := cfg.Config().K8sNetworkConfig(); k8sNetwork != nil {
+				spec.Rules = append(
+					spec.Rules,
+					// allow Kubernetes pod/service traffic
+					network.NfTablesRule{
+						MatchDestinationAddress: &network.NfTablesAddressMatch{
+							IncludeSubnets: slices.Concat(
+								k8sNetwork.PodCIDRs(),
+								k8sNetwork.ServiceCIDRs(),
+							),
+						},
+						AnonCounter: true,
+						Verdict:     new(nethelpers.VerdictAccept),
+					},
+				)
+			}
+		}
+
+		for _, rule := range cfg.Config().NetworkRules().EgressRules() {
+			// if default accept, drop anything that doesn't match the rule
+			verdict := nethelpers.VerdictDrop
+
+			if defaultAction == nethelpers.DefaultActionBlock {
+				verdict = nethelpers.VerdictAccept
+			}
+
+			spec.Rules = append(
+				spec.Rules,
+				network.NfTablesRule{
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: rule.Subnets(),
+						ExcludeSubnets: rule.ExceptSubnets(),
+						Invert:         defaultAction == nethelpers.DefaultActionAccept,
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol:             rule.Protocol(),
+						MatchDestinationPort: matchPorts(rule.PortRanges()),
+					},
+					AnonCounter: true,
+					Verdict:     new(verdict),
+				},
+			)
+		}
+
+		return nil
+	}
+}
+
+func (ctrl *NfTablesChainConfigController) buildNATPostroutingChain(cfg *config.MachineConfig) func(*network.NfTablesChain) error {
+	return func(chain *network.NfTablesChain) error {
+		spec := chain.TypedSpec()
+
+		spec.Type = nethelpers.ChainTypeNAT
+		spec.Hook = nethelpers.ChainHookPostrouting
+		spec.Priority = nethelpers.ChainPriorityNATSource
+		spec.Policy = nethelpers.VerdictAccept
+
+		spec.Rules = nil
+
+		for _, rule := range cfg.Config().NetworkRules().SourceNATRules() {
+			spec.Rules = append(
+				spec.Rules,
+				network.NfTablesRule{
+					MatchOIfName: matchIfName(rule.OutLinkName()),
+					MatchSourceAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: rule.SourceSubnets(),
+					},
+					AnonCounter: true,
+					// no address means masquerade
+					SourceNAT: &network.NfTablesNAT{
+						Address: rule.ToAddress().ValueOrZero(),
+					},
+				},
+			)
+		}
+
+		return nil
+	}
+}
+
+func (ctrl *NfTablesChainConfigController) buildNATPreroutingChain(cfg *config.MachineConfig, nodeAddresses *network.NodeAddress) func(*network.NfTablesChain) error {
+	myAddresses := hostPrefixes(nodeAddresses)
+
+	return func(chain *network.NfTablesChain) error {
+		spec := chain.TypedSpec()
+
+		spec.Type = nethelpers.ChainTypeNAT
+		spec.Hook = nethelpers.ChainHookPrerouting
+		spec.Priority = nethelpers.ChainPriorityNATDest
+		spec.Policy = nethelpers.VerdictAccept
+
+		spec.Rules = nil
+
+		for _, rule := range cfg.Config().NetworkRules().DestinationNATRules() {
+			spec.Rules = append(
+				spec.Rules,
+				network.NfTablesRule{
+					MatchIIfName:       matchIfName(rule.InLinkName()),
+					MatchSourceAddress: matchSubnets(rule.Subnets(), rule.ExceptSubnets()),
+					// only traffic addressed to the machine itself is forwarded, transit traffic is left untouched
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: myAddresses,
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol:             rule.Protocol(),
+						MatchDestinationPort: matchPorts(rule.PortRanges()),
+					},
+					AnonCounter: true,
+					DestinationNAT: &network.NfTablesNAT{
+						Address: rule.ToAddress(),
+						Port:    rule.ToPort().ValueOrZero(),
+					},
+				},
+			)
+		}
+
+		return nil
+	}
+}
+
+// limitRules drops new connections from the sources exceeding the rule limits.
+func limitRules(rule cfg.NetworkRule, limits cfg.NetworkRuleLimits, portRanges [][2]uint16) []network.NfTablesRule {
+	var rules []network.NfTablesRule
+
+	limitRule := func() network.NfTablesRule {
+		return network.NfTablesRule{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{
+					nethelpers.ConntrackStateNew,
+				},
+			},
+			MatchSourceAddress: matchSubnets(rule.Subnets(), rule.ExceptSubnets()),
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol:             rule.Protocol(),
+				MatchDestinationPort: matchPorts(portRanges),
+			},
+			AnonCounter: true,
+			Verdict:     new(nethelpers.VerdictDrop),
+		}
+	}
+
+	if limits.NewConnectionsPerSecond() > 0 {
+		r := limitRule()
+		r.MatchSourceRateLimit = &network.NfTablesSourceRateLimitMatch{
+			PacketRatePerSecond: uint64(limits.NewConnectionsPerSecond()),
+			Burst:               limits.NewConnectionsBurst(),
+			SourcePrefixLength4: limits.SourcePrefixLengthIPv4(),
+			SourcePrefixLength6: limits.SourcePrefixLengthIPv6(),
+		}
+
+		rules = append(rules, r)
+	}
+
+	if limits.MaxConnections() > 0 {
+		r := limitRule()
+		r.MatchSourceConnectionLimit = &network.NfTablesSourceConnectionLimitMatch{
+			MaxConnections:      limits.MaxConnections(),
+			SourcePrefixLength4: limits.SourcePrefixLengthIPv4(),
+			SourcePrefixLength6: limits.SourcePrefixLengthIPv6(),
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// hostPrefixes converts node address CIDRs to /32 (/128) prefixes matching only the address itself.
+func hostPrefixes(nodeAddresses *network.NodeAddress) []netip.Prefix {
+	return xslices.Map(
+		nodeAddresses.TypedSpec().Addresses,
+		func(addr netip.Prefix) netip.Prefix {
+			return netip.PrefixFrom(addr.Addr(), addr.Addr().BitLen())
+		},
+	)
+}
+
+func matchIfName(linkName string) *network.NfTablesIfNameMatch {
+	if linkName == "" {
+		return nil
+	}
+
+	return &network.NfTablesIfNameMatch{
+		InterfaceNames: []string{linkName},
+		Operator:       nethelpers.OperatorEqual,
+	}
+}
+
+func matchSubnets(include, exclude []netip.Prefix) *network.NfTablesAddressMatch {
+	if len(include) == 0 {
+		return nil
+	}
+
+	return &network.NfTablesAddressMatch{
+		IncludeSubnets: include,
+		ExcludeSubnets: exclude,
+	}
+}
+
+func matchPorts(portRanges [][2]uint16) *network.NfTablesPortMatch {
+	if len(portRanges) == 0 {
+		return nil
+	}
+
+	// sort port ranges, machine config validation ensures that there are no overlaps
+	slices.SortFunc(portRanges, func(a, b [2]uint16) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	return &network.NfTablesPortMatch{
+		Ranges: xslices.Map(portRanges, func(pr [2]uint16) network.PortRange {
+			return network.PortRange{Lo: pr[0], Hi: pr[1]}
+		}),
+	}
+}
+
 func hostDNSSubnets(k8sNetwork cfg.K8sNetworkConfig) []netip.Prefix {
 	result := []netip.Addr{hostDNSIPv4}
 
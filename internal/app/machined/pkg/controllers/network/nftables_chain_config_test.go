@@ -519,6 +519,325 @@ func (suite *NfTablesChainConfigTestSuite) TestDefaultBlock() {
 	})
 }
 
+func (suite *NfTablesChainConfigTestSuite) TestLimits() {
+	apidIngressCfg := networkcfg.NewRuleConfigV1Alpha1()
+	apidIngressCfg.MetaName = "apid-ingress"
+	apidIngressCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 50000,
+			Hi: 50000,
+		},
+	}
+	apidIngressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	apidIngressCfg.Ingress = []networkcfg.IngressRule{
+		{
+			Subnet: netip.MustParsePrefix("0.0.0.0/0"),
+		},
+	}
+	apidIngressCfg.ConnectionLimits = networkcfg.RuleLimits{
+		NewConnectionsPerSecondLimit: 10,
+		NewConnectionsBurstLimit:     20,
+		MaxConnectionsLimit:          100,
+		SourcePrefixLengthIPv4Config: 24,
+	}
+
+	cfg, err := container.New(apidIngressCfg)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	limitRule := func() network.NfTablesRule {
+		return network.NfTablesRule{
+			MatchConntrackState: &network.NfTablesConntrackStateMatch{
+				States: []nethelpers.ConntrackState{
+					nethelpers.ConntrackStateNew,
+				},
+			},
+			MatchSourceAddress: &network.NfTablesAddressMatch{
+				IncludeSubnets: []netip.Prefix{
+					netip.MustParsePrefix("0.0.0.0/0"),
+				},
+			},
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+				MatchDestinationPort: &network.NfTablesPortMatch{
+					Ranges: []network.PortRange{
+						{
+							Lo: 50000,
+							Hi: 50000,
+						},
+					},
+				},
+			},
+			AnonCounter: true,
+			Verdict:     new(nethelpers.VerdictDrop),
+		}
+	}
+
+	rateLimitRule := limitRule()
+	rateLimitRule.MatchSourceRateLimit = &network.NfTablesSourceRateLimitMatch{
+		PacketRatePerSecond: 10,
+		Burst:               20,
+		SourcePrefixLength4: 24,
+		SourcePrefixLength6: 64,
+	}
+
+	connectionLimitRule := limitRule()
+	connectionLimitRule.MatchSourceConnectionLimit = &network.NfTablesSourceConnectionLimitMatch{
+		MaxConnections:      100,
+		SourcePrefixLength4: 24,
+		SourcePrefixLength6: 64,
+	}
+
+	ctest.AssertResource(suite, netctrl.IngressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		if !asrt.Len(spec.Rules, 6) {
+			return
+		}
+
+		// preamble (3 rules), limits, and the rule itself
+		asrt.Equal(rateLimitRule, spec.Rules[3])
+		asrt.Equal(connectionLimitRule, spec.Rules[4])
+		asrt.Equal(
+			network.NfTablesRule{
+				MatchSourceAddress: &network.NfTablesAddressMatch{
+					IncludeSubnets: []netip.Prefix{
+						netip.MustParsePrefix("0.0.0.0/0"),
+					},
+					Invert: true,
+				},
+				MatchLayer4: &network.NfTablesLayer4Match{
+					Protocol: nethelpers.ProtocolTCP,
+					MatchDestinationPort: &network.NfTablesPortMatch{
+						Ranges: []network.PortRange{
+							{
+								Lo: 50000,
+								Hi: 50000,
+							},
+						},
+					},
+				},
+				AnonCounter: true,
+				Verdict:     new(nethelpers.VerdictDrop),
+			},
+			spec.Rules[5],
+		)
+	})
+}
+
+func (suite *NfTablesChainConfigTestSuite) TestEgressAndNAT() {
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.EgressChainName)
+
+	egressCfg := networkcfg.NewEgressRuleConfigV1Alpha1()
+	egressCfg.MetaName = "registry"
+	egressCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	egressCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 443,
+			Hi: 443,
+		},
+	}
+	egressCfg.Egress = []networkcfg.EgressRule{
+		{
+			Subnet: netip.MustParsePrefix("10.10.0.0/16"),
+		},
+	}
+
+	defaultActionCfg := networkcfg.NewDefaultActionConfigV1Alpha1()
+	defaultActionCfg.Ingress = nethelpers.DefaultActionAccept
+	defaultActionCfg.Egress = nethelpers.DefaultActionBlock
+
+	snatCfg := networkcfg.NewSNATConfigV1Alpha1()
+	snatCfg.MetaName = "downstream"
+	snatCfg.SNATSourceSubnets = []meta.Prefix{{Prefix: netip.MustParsePrefix("10.5.0.0/24")}}
+	snatCfg.SNATOutLinkName = "eth0"
+
+	dnatCfg := networkcfg.NewDNATConfigV1Alpha1()
+	dnatCfg.MetaName = "web"
+	dnatCfg.DNATInLinkName = "eth0"
+	dnatCfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	dnatCfg.PortSelector.Ports = []networkcfg.PortRange{
+		{
+			Lo: 8443,
+			Hi: 8443,
+		},
+	}
+	dnatCfg.DNATToAddress = meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")}
+	dnatCfg.DNATToPort = 443
+
+	cfg, err := container.New(egressCfg, defaultActionCfg, snatCfg, dnatCfg)
+	suite.Require().NoError(err)
+
+	suite.Create(config.NewMachineConfig(cfg))
+
+	nodeAddresses := network.NewNodeAddress(network.NamespaceName, network.NodeAddressRoutedID)
+	nodeAddresses.TypedSpec().Addresses = []netip.Prefix{netip.MustParsePrefix("10.3.4.5/24")}
+	suite.Create(nodeAddresses)
+
+	ctest.AssertResource(suite, netctrl.EgressChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeFilter, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityMangle+10, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookOutput, spec.Hook)
+		asrt.Equal(nethelpers.VerdictDrop, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchOIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{
+							"lo",
+							constants.SideroLinkName,
+							constants.KubeSpanLinkName,
+						},
+						Operator: nethelpers.OperatorEqual,
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateEstablished,
+							nethelpers.ConntrackStateRelated,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				{
+					MatchConntrackState: &network.NfTablesConntrackStateMatch{
+						States: []nethelpers.ConntrackState{
+							nethelpers.ConntrackStateInvalid,
+						},
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictDrop),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMP,
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolICMPv6,
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				{
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolUDP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{{Lo: 67, Hi: 67}, {Lo: 547, Hi: 547}},
+						},
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+				{
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.10.0.0/16"),
+						},
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolTCP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{
+								{
+									Lo: 443,
+									Hi: 443,
+								},
+							},
+						},
+					},
+					AnonCounter: true,
+					Verdict:     new(nethelpers.VerdictAccept),
+				},
+			},
+			spec.Rules,
+		)
+	})
+
+	ctest.AssertResource(suite, netctrl.NATPostroutingChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeNAT, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityNATSource, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookPostrouting, spec.Hook)
+		asrt.Equal(nethelpers.VerdictAccept, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchOIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{"eth0"},
+						Operator:       nethelpers.OperatorEqual,
+					},
+					MatchSourceAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.5.0.0/24"),
+						},
+					},
+					AnonCounter: true,
+					SourceNAT:   &network.NfTablesNAT{},
+				},
+			},
+			spec.Rules,
+		)
+	})
+
+	ctest.AssertResource(suite, netctrl.NATPreroutingChainName, func(chain *network.NfTablesChain, asrt *assert.Assertions) {
+		spec := chain.TypedSpec()
+
+		asrt.Equal(nethelpers.ChainTypeNAT, spec.Type)
+		asrt.Equal(nethelpers.ChainPriorityNATDest, spec.Priority)
+		asrt.Equal(nethelpers.ChainHookPrerouting, spec.Hook)
+		asrt.Equal(nethelpers.VerdictAccept, spec.Policy)
+
+		asrt.Equal(
+			[]network.NfTablesRule{
+				{
+					MatchIIfName: &network.NfTablesIfNameMatch{
+						InterfaceNames: []string{"eth0"},
+						Operator:       nethelpers.OperatorEqual,
+					},
+					MatchDestinationAddress: &network.NfTablesAddressMatch{
+						IncludeSubnets: []netip.Prefix{
+							netip.MustParsePrefix("10.3.4.5/32"),
+						},
+					},
+					MatchLayer4: &network.NfTablesLayer4Match{
+						Protocol: nethelpers.ProtocolTCP,
+						MatchDestinationPort: &network.NfTablesPortMatch{
+							Ranges: []network.PortRange{
+								{
+									Lo: 8443,
+									Hi: 8443,
+								},
+							},
+						},
+					},
+					AnonCounter: true,
+					DestinationNAT: &network.NfTablesNAT{
+						Address: netip.MustParseAddr("10.5.0.10"),
+						Port:    443,
+					},
+				},
+			},
+			spec.Rules,
+		)
+	})
+
+	ctest.AssertNoResource[*network.NfTablesChain](suite, netctrl.IngressChainName)
+}
+
 func TestNfTablesChainConfig(t *testing.T) {
 	t.Parallel()
 
@@ -13,6 +13,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/google/nftables/userdata"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
@@ -137,6 +141,7 @@ func (s *NfTablesChainSuite) TestPolicyDrop() {
 	chain test1 {
 		type filter hook input priority security; policy drop;
 		accept
+		counter packets 0 bytes 0 drop
 	}
 }`)
 }
@@ -207,6 +212,55 @@ func (s *NfTablesChainSuite) TestConntrackCounter() {
 }`)
 }
 
+func (s *NfTablesChainSuite) TestStatus() {
+	chain := network.NewNfTablesChain(network.NamespaceName, "test1")
+	chain.TypedSpec().Type = nethelpers.ChainTypeFilter
+	chain.TypedSpec().Hook = nethelpers.ChainHookInput
+	chain.TypedSpec().Priority = nethelpers.ChainPrioritySecurity
+	chain.TypedSpec().Policy = nethelpers.VerdictAccept
+	chain.TypedSpec().Rules = []network.NfTablesRule{
+		{
+			MatchIIfName: &network.NfTablesIfNameMatch{
+				InterfaceNames: []string{"lo"},
+				Operator:       nethelpers.OperatorEqual,
+			},
+			AnonCounter: true,
+			Verdict:     new(nethelpers.VerdictAccept),
+		},
+		{
+			MatchLayer4: &network.NfTablesLayer4Match{
+				Protocol: nethelpers.ProtocolTCP,
+			},
+			MatchSourceRateLimit: &network.NfTablesSourceRateLimitMatch{
+				PacketRatePerSecond: 10,
+				SourcePrefixLength4: 32,
+				SourcePrefixLength6: 64,
+			},
+			AnonCounter: true,
+			Verdict:     new(nethelpers.VerdictDrop),
+		},
+	}
+
+	s.Require().NoError(s.State().Create(s.Ctx(), chain))
+
+	ctest.AssertResource(s, "test1", func(status *network.NfTablesChainStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		// IPv4 and IPv6 rules compiled from the second rule are accounted under the same index
+		if !asrt.Len(spec.Rules, 2) {
+			return
+		}
+
+		asrt.Equal(0, spec.Rules[0].Index)
+		asrt.Equal(1, spec.Rules[1].Index)
+		asrt.Equal(spec.Rules[1].Packets, spec.DroppedPackets)
+	})
+
+	s.Require().NoError(s.State().Destroy(s.Ctx(), chain.Metadata()))
+
+	ctest.AssertNoResource[*network.NfTablesChainStatus](s, "test1")
+}
+
 func (s *NfTablesChainSuite) TestMatchMarksSubnets() {
 	chain1 := network.NewNfTablesChain(network.NamespaceName, "test1")
 	chain1.TypedSpec().Type = nethelpers.ChainTypeFilter
@@ -699,3 +753,52 @@ func TestNftablesChainSuite(t *testing.T) {
 		},
 	})
 }
+
+func TestNfTablesChainCountersPolicyDrop(t *testing.T) {
+	t.Parallel()
+
+	specRules := []network.NfTablesRule{
+		{
+			AnonCounter: true,
+			Verdict:     new(nethelpers.VerdictAccept),
+		},
+		{
+			AnonCounter: true,
+			Verdict:     new(nethelpers.VerdictDrop),
+		},
+	}
+
+	ruleIndex := func(idx uint32) []byte {
+		return userdata.AppendUint32(nil, netctrl.NfTablesUserDataRuleIndex, idx)
+	}
+
+	rules := []*nftables.Rule{
+		{
+			Exprs:    []expr.Any{&expr.Counter{Packets: 100, Bytes: 10000}, &expr.Verdict{Kind: expr.VerdictAccept}},
+			UserData: ruleIndex(0),
+		},
+		// the drop rule compiled per IP family
+		{
+			Exprs:    []expr.Any{&expr.Counter{Packets: 3, Bytes: 300}, &expr.Verdict{Kind: expr.VerdictDrop}},
+			UserData: ruleIndex(1),
+		},
+		{
+			Exprs:    []expr.Any{&expr.Counter{Packets: 2, Bytes: 200}, &expr.Verdict{Kind: expr.VerdictDrop}},
+			UserData: ruleIndex(1),
+		},
+		// the drop policy of the chain
+		{
+			Exprs:    []expr.Any{&expr.Counter{Packets: 7, Bytes: 700}, &expr.Verdict{Kind: expr.VerdictDrop}},
+			UserData: userdata.AppendUint32(nil, netctrl.NfTablesUserDataPolicy, 1),
+		},
+	}
+
+	ruleCounters, droppedPackets, droppedBytes := netctrl.NfTablesChainCountersForTest(specRules, rules)
+
+	assert.Equal(t, []network.NfTablesRuleCounter{
+		{Index: 0, Packets: 100, Bytes: 10000},
+		{Index: 1, Packets: 5, Bytes: 500},
+	}, ruleCounters)
+	assert.EqualValues(t, 12, droppedPackets)
+	assert.EqualValues(t, 1200, droppedBytes)
+}
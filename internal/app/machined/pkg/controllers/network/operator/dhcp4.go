@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -37,6 +38,9 @@ type DHCP4 struct {
 	skipHostnameRequest bool
 	skipRoutes          bool
 	requestMTU          bool
+	requestOptions      []uint32
+	vendorClass         string
+	userClass           []string
 
 	lease *nclient4.Lease
 
@@ -47,6 +51,7 @@ type DHCP4 struct {
 	hostname    []network.HostnameSpecSpec
 	resolvers   []network.ResolverSpecSpec
 	timeservers []network.TimeServerSpecSpec
+	options     []network.DHCPOption
 }
 
 // NewDHCP4 creates DHCPv4 operator.
@@ -59,6 +64,9 @@ func NewDHCP4(logger *zap.Logger, linkName string, config network.DHCP4OperatorS
 		skipHostnameRequest: config.SkipHostnameRequest,
 		skipRoutes:          config.SkipRoutes,
 		clientIdentifier:    config.ClientIdentifier,
+		requestOptions:      config.RequestOptions,
+		vendorClass:         config.VendorClass,
+		userClass:           config.UserClass,
 		// <3 azure
 		// When including dhcp.OptionInterfaceMTU we don't get a dhcp offer back on azure.
 		// So we'll need to explicitly exclude adding this option for azure.
@@ -306,8 +314,24 @@ func (d *DHCP4) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DHCPOptions implements Operator interface.
+func (d *DHCP4) DHCPOptions() []network.DHCPOption {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.options
+}
+
+// DelegatedPrefixes implements Operator interface.
+func (d *DHCP4) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	return nil
+}
+
 func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool) {
-	specs := dhcpparse.ParseDHCP4Ack(ack, d.linkName, d.routeMetric, useHostname, !d.skipRoutes)
+	specs := dhcpparse.ParseDHCP4Ack(
+		ack, d.linkName, d.routeMetric, useHostname, !d.skipRoutes,
+		slices.Contains(d.requestOptions, uint32(dhcpparse.OptionMicrosoftClasslessStaticRoute)),
+	)
 
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -318,6 +342,7 @@ func (d *DHCP4) parseNetworkConfigFromAck(ack *dhcpv4.DHCPv4, useHostname bool)
 	d.hostname = specs.Hostname
 	d.resolvers = specs.Resolvers
 	d.timeservers = specs.TimeServers
+	d.options = specs.Options
 }
 
 func (d *DHCP4) newClient() (*nclient4.Client, error) {
@@ -360,6 +385,10 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 		opts = append(opts, dhcpv4.OptionInterfaceMTU)
 	}
 
+	for _, code := range d.requestOptions {
+		opts = append(opts, dhcpv4.GenericOptionCode(code))
+	}
+
 	sendHostnameRequest := !d.skipHostnameRequest
 	if hostname.Hostname != "" && !d.knownHostname(hostname) {
 		// If we are supposed to publish a hostname, don't request one from the DHCP server.
@@ -386,6 +415,16 @@ func (d *DHCP4) requestRenew(ctx context.Context, hostname network.HostnameStatu
 
 	mods := []dhcpv4.Modifier{dhcpv4.WithRequestedOptions(opts...), WithNumSeconds(secs)}
 
+	if d.vendorClass != "" {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptClassIdentifier(d.vendorClass)))
+	}
+
+	if len(d.userClass) > 0 {
+		mods = append(mods, dhcpv4.WithOption(dhcpv4.OptRFC3004UserClass(xslices.Map(d.userClass, func(class string) []byte {
+			return []byte(class)
+		}))))
+	}
+
 	if !sendHostnameRequest {
 		// If the node has a hostname, always send it to the DHCP
 		// server with option 12 during lease acquisition and renewal
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"go4.org/netipx"
 	"golang.org/x/sys/unix"
 
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator/internal/dhcpparse"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
@@ -34,24 +36,39 @@ type DHCP6 struct {
 	state  state.State
 
 	linkName            string
+	routeMetric         uint32
 	clientIdentifier    network.ClientIdentifierSpec
 	skipHostnameRequest bool
 
+	prefixDelegation  bool
+	prefixLength      uint32
+	prefixDownstreams []network.DHCP6PrefixDownstreamSpec
+
 	mu          sync.Mutex
 	addresses   []network.AddressSpecSpec
 	hostname    []network.HostnameSpecSpec
 	resolvers   []network.ResolverSpecSpec
 	timeservers []network.TimeServerSpecSpec
+	pd          dhcpparse.DHCP6PDSpecs
 }
 
+// prefixDelegationIAID is the IAID of the IA_PD requested by the operator.
+//
+// There is a single IA_PD per link, so the IAID doesn't need to be unique.
+var prefixDelegationIAID = [4]byte{0, 0, 0, 1}
+
 // NewDHCP6 creates DHCPv6 operator.
 func NewDHCP6(logger *zap.Logger, linkName string, config network.DHCP6OperatorSpec, state state.State) *DHCP6 {
 	return &DHCP6{
 		logger:              logger,
 		state:               state,
 		linkName:            linkName,
+		routeMetric:         config.RouteMetric,
 		clientIdentifier:    config.ClientIdentifier,
 		skipHostnameRequest: config.SkipHostnameRequest,
+		prefixDelegation:    config.PrefixDelegation,
+		prefixLength:        config.PrefixLength,
+		prefixDownstreams:   config.PrefixDownstreams,
 	}
 }
 
@@ -81,7 +98,8 @@ func (d *DHCP6) Run(ctx context.Context, notifyCh chan<- struct{}) {
 			d.logger.Warn("renew failed", zap.Error(err), zap.String("link", d.linkName))
 		}
 
-		if err == nil {
+		// on failure, the delegated prefix is kept until it expires
+		if err == nil || d.expireDelegatedPrefix(time.Now()) {
 			select {
 			case notifyCh <- struct{}{}:
 			case <-ctx.Done():
@@ -95,6 +113,10 @@ func (d *DHCP6) Run(ctx context.Context, notifyCh chan<- struct{}) {
 			renewInterval /= 2
 		}
 
+		if validUntil := d.delegatedPrefixValidUntil(); !validUntil.IsZero() {
+			renewInterval = min(renewInterval, time.Until(validUntil))
+		}
+
 		renewInterval = max(renewInterval, minRenewDuration)
 
 		select {
@@ -110,7 +132,7 @@ func (d *DHCP6) AddressSpecs() []network.AddressSpecSpec {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	return d.addresses
+	return slices.Concat(d.addresses, d.pd.Addresses)
 }
 
 // LinkSpecs implements Operator interface.
@@ -120,7 +142,10 @@ func (d *DHCP6) LinkSpecs() []network.LinkSpecSpec {
 
 // RouteSpecs implements Operator interface.
 func (d *DHCP6) RouteSpecs() []network.RouteSpecSpec {
-	return nil
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.pd.Routes
 }
 
 // HostnameSpecs implements Operator interface.
@@ -147,6 +172,44 @@ func (d *DHCP6) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return d.timeservers
 }
 
+// DHCPOptions implements Operator interface.
+func (d *DHCP6) DHCPOptions() []network.DHCPOption {
+	return nil
+}
+
+// DelegatedPrefixes implements Operator interface.
+func (d *DHCP6) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.pd.DelegatedPrefixes
+}
+
+func (d *DHCP6) delegatedPrefixValidUntil() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.pd.ValidUntil
+}
+
+// expireDelegatedPrefix drops the delegated prefix if it is no longer valid.
+//
+// It returns true if the prefix was dropped.
+func (d *DHCP6) expireDelegatedPrefix(now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pd.ValidUntil.IsZero() || now.Before(d.pd.ValidUntil) {
+		return false
+	}
+
+	d.logger.Warn("delegated prefix expired", zap.String("link", d.linkName))
+
+	d.pd = dhcpparse.DHCP6PDSpecs{}
+
+	return true
+}
+
 func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -221,6 +284,20 @@ func (d *DHCP6) parseReply(reply *dhcpv6.Message) (leaseTime time.Duration) {
 		d.timeservers = nil
 	}
 
+	if d.prefixDelegation {
+		now := time.Now()
+
+		d.pd = dhcpparse.ParseDHCP6PrefixDelegation(reply.Options.OneIAPD(), d.linkName, d.routeMetric, d.prefixDownstreams, now)
+
+		if !d.pd.ValidUntil.IsZero() {
+			if pdLeaseTime := d.pd.ValidUntil.Sub(now); leaseTime == 0 || pdLeaseTime < leaseTime {
+				leaseTime = pdLeaseTime
+			}
+		} else {
+			d.logger.Warn("no prefix delegated by the DHCPv6 server", zap.String("link", d.linkName))
+		}
+	}
+
 	return leaseTime
 }
 
@@ -237,7 +314,24 @@ func (d *DHCP6) renew(ctx context.Context) (time.Duration, error) {
 		return 0, fmt.Errorf("error getting DHCPv6 client identifier: %w", err)
 	}
 
-	reply, err := cli.RapidSolicit(ctx, clientIdentifierModifiers...)
+	modifiers := clientIdentifierModifiers
+
+	if d.prefixDelegation {
+		var hints []*dhcpv6.OptIAPrefix
+
+		if d.prefixLength > 0 {
+			hints = append(hints, &dhcpv6.OptIAPrefix{
+				Prefix: &net.IPNet{
+					IP:   net.IPv6zero,
+					Mask: net.CIDRMask(int(d.prefixLength), 128),
+				},
+			})
+		}
+
+		modifiers = append(slices.Clone(modifiers), dhcpv6.WithIAPD(prefixDelegationIAID, hints...))
+	}
+
+	reply, err := cli.RapidSolicit(ctx, modifiers...)
 	if err != nil {
 		return 0, err
 	}
@@ -10,6 +10,7 @@
 package dhcpparse
 
 import (
+	"maps"
 	"net"
 	"net/netip"
 	"slices"
@@ -34,18 +35,25 @@ type DHCP4AckSpecs struct {
 	Hostname    []network.HostnameSpecSpec
 	Resolvers   []network.ResolverSpecSpec
 	TimeServers []network.TimeServerSpecSpec
+	Options     []network.DHCPOption
 }
 
+// OptionMicrosoftClasslessStaticRoute is the pre-RFC3442 variant of the
+// classless static route option used by Microsoft DHCP servers.
+const OptionMicrosoftClasslessStaticRoute = dhcpv4.GenericOptionCode(249)
+
 // ParseDHCP4Ack converts a DHCPv4 ACK packet into network configuration specs.
 //
 // It is a pure function (no I/O, no shared state) — `linkName` and
 // `routeMetric` come from the operator's configuration, `useHostname`
 // controls whether the ACK's hostname/domain options are honored, and
 // `useRoutes` controls whether the routes carried by the ACK (the default
-// gateway and classless static routes) are installed at all.
+// gateway and classless static routes) are installed at all, and
+// `useMicrosoftRoutes` controls whether option 249 is used as a fallback
+// for option 121 (it should only be set if option 249 was requested).
 //
 //nolint:gocyclo
-func ParseDHCP4Ack(ack *dhcpv4.DHCPv4, linkName string, routeMetric uint32, useHostname bool, useRoutes bool) DHCP4AckSpecs {
+func ParseDHCP4Ack(ack *dhcpv4.DHCPv4, linkName string, routeMetric uint32, useHostname, useRoutes, useMicrosoftRoutes bool) DHCP4AckSpecs {
 	var specs DHCP4AckSpecs
 
 	addr, _ := netipx.FromStdIPNet(&net.IPNet{
@@ -79,12 +87,12 @@ func ParseDHCP4Ack(ack *dhcpv4.DHCPv4, linkName string, routeMetric uint32, useH
 		// rfc3442:
 		//   If the DHCP server returns both a Classless Static Routes option and
 		//   a Router option, the DHCP client MUST ignore the Router option.
-		if len(ack.ClasslessStaticRoute()) > 0 {
+		if classlessRoutes := dhcpClasslessStaticRoutes(ack, useMicrosoftRoutes); len(classlessRoutes) > 0 {
 			// Track gateways for which we've already emitted an on-link route so
 			// we don't add duplicates when several classless routes share a gateway.
 			onLinkGateways := map[netip.Addr]struct{}{}
 
-			for _, route := range ack.ClasslessStaticRoute() {
+			for _, route := range classlessRoutes {
 				gw, _ := netipx.FromStdIP(route.Router)
 				dst, _ := netipx.FromStdIPNet(route.Dest)
 
@@ -223,9 +231,50 @@ func ParseDHCP4Ack(ack *dhcpv4.DHCPv4, linkName string, routeMetric uint32, useH
 		}
 	}
 
+	specs.Options = DHCP4Options(ack)
+
 	return specs
 }
 
+// DHCP4Options returns raw options of the DHCPv4 packet sorted by the option code.
+func DHCP4Options(ack *dhcpv4.DHCPv4) []network.DHCPOption {
+	codes := slices.Sorted(maps.Keys(ack.Options))
+
+	if len(codes) == 0 {
+		return nil
+	}
+
+	return xslices.Map(codes, func(code uint8) network.DHCPOption {
+		return network.NewDHCPOption(uint32(code), ack.Options[code])
+	})
+}
+
+// dhcpClasslessStaticRoutes returns classless static routes of the ACK.
+//
+// Option 249 is used as a fallback if useMicrosoftRoutes is set and the server doesn't send option 121.
+func dhcpClasslessStaticRoutes(ack *dhcpv4.DHCPv4, useMicrosoftRoutes bool) dhcpv4.Routes {
+	if routes := ack.ClasslessStaticRoute(); len(routes) > 0 {
+		return routes
+	}
+
+	if !useMicrosoftRoutes {
+		return nil
+	}
+
+	data := ack.Options.Get(OptionMicrosoftClasslessStaticRoute)
+	if len(data) == 0 {
+		return nil
+	}
+
+	var routes dhcpv4.Routes
+
+	if err := routes.FromBytes(data); err != nil {
+		return nil
+	}
+
+	return routes
+}
+
 func dhcpSearchDomains(ack *dhcpv4.DHCPv4) []string {
 	var searchDomains []string
 
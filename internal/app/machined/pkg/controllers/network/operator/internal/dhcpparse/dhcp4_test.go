@@ -33,7 +33,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 1))),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Addresses, 1)
 		assert.Equal(t, must.Value(netip.ParsePrefix("10.0.0.5/24"))(t), specs.Addresses[0].Address)
@@ -63,7 +63,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 1))),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Routes, 2)
 		assert.Equal(t, must.Value(netip.ParseAddr("10.0.0.1"))(t), specs.Routes[0].Gateway)
@@ -86,7 +86,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			})),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		// Classless routes win, router option ignored — only the classless
 		// route is present (gateway is in the subnet, no helper needed).
@@ -118,7 +118,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			)),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		// 3 classless routes + 1 on-link helper for the shared gateway (deduped).
 		require.Len(t, specs.Routes, 4)
@@ -138,6 +138,33 @@ func TestParseDHCP4Ack(t *testing.T) {
 		assert.Equal(t, gw, specs.Routes[3].Gateway)
 	})
 
+	t.Run("microsoft classless static routes used as a fallback", func(t *testing.T) {
+		ack := must.Value(dhcpv4.New(
+			dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+			dhcpv4.WithYourIP(net.IPv4(10, 0, 0, 5)),
+			dhcpv4.WithNetmask(net.CIDRMask(24, 32)),
+			dhcpv4.WithOption(dhcpv4.OptRouter(net.IPv4(10, 0, 0, 1))),
+			dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpparse.OptionMicrosoftClasslessStaticRoute, dhcpv4.Routes{
+				&dhcpv4.Route{
+					Dest:   &net.IPNet{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(0, 32)},
+					Router: net.IPv4(10, 0, 0, 254),
+				},
+			}.ToBytes())),
+		))(t)
+
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, true)
+
+		// Option 249 is handled the same way as option 121, router option is ignored.
+		require.Len(t, specs.Routes, 1)
+		assert.Equal(t, must.Value(netip.ParseAddr("10.0.0.254"))(t), specs.Routes[0].Gateway)
+
+		// Option 249 is ignored if it wasn't requested, router option is used.
+		specs = dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
+
+		require.Len(t, specs.Routes, 1)
+		assert.Equal(t, must.Value(netip.ParseAddr("10.0.0.1"))(t), specs.Routes[0].Gateway)
+	})
+
 	t.Run("classless route with on-link router (router=0.0.0.0)", func(t *testing.T) {
 		// RFC 3442: router=0.0.0.0 means destination is on-link.
 		ack := must.Value(dhcpv4.New(
@@ -150,7 +177,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			})),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Routes, 1, "no helper for unspecified gateway")
 		assert.Equal(t, must.Value(netip.ParsePrefix("192.168.1.0/24"))(t), specs.Routes[0].Destination)
@@ -170,7 +197,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionInterfaceMTU, []byte{0x05, 0xdc})), // 1500
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, true, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, true, true, false)
 
 		require.Len(t, specs.Links, 1)
 		assert.Equal(t, uint32(1500), specs.Links[0].MTU)
@@ -206,7 +233,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithDomainSearchList("corp.example.com", "example.com"),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Resolvers, 1)
 		assert.Equal(t, []string{"corp.example.com", "example.com"}, specs.Resolvers[0].SearchDomains)
@@ -222,7 +249,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptDomainName("example.com")),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Resolvers, 1)
 		assert.Equal(t, []string{"corp.example.com", "example.com"}, specs.Resolvers[0].SearchDomains)
@@ -237,7 +264,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptDomainName("example.com")),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Resolvers, 1)
 		assert.Equal(t, []string{"example.com", "corp.example.com"}, specs.Resolvers[0].SearchDomains)
@@ -253,7 +280,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptDomainName("example.com")),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Resolvers, 1)
 		assert.Empty(t, specs.Resolvers[0].NameServers)
@@ -271,7 +298,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionDomainName, []byte("\x00\x00"))),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		require.Len(t, specs.Resolvers, 1)
 		assert.Empty(t, specs.Resolvers[0].SearchDomains)
@@ -285,7 +312,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptHostName("myhost")),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
 
 		assert.Empty(t, specs.Hostname)
 	})
@@ -299,7 +326,7 @@ func TestParseDHCP4Ack(t *testing.T) {
 			dhcpv4.WithOption(dhcpv4.OptDNS(net.IPv4(8, 8, 8, 8))),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, false)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, false, false)
 
 		assert.Empty(t, specs.Routes, "useRoutes=false drops the router-option route")
 		require.Len(t, specs.Addresses, 1, "the leased address is still configured")
@@ -317,9 +344,30 @@ func TestParseDHCP4Ack(t *testing.T) {
 			})),
 		))(t)
 
-		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, false)
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, false, false)
 
 		assert.Empty(t, specs.Routes, "useRoutes=false drops classless static routes too")
 		require.Len(t, specs.Addresses, 1, "the leased address is still configured")
 	})
+	t.Run("raw options are published", func(t *testing.T) {
+		ack := must.Value(dhcpv4.New(
+			dhcpv4.WithMessageType(dhcpv4.MessageTypeAck),
+			dhcpv4.WithYourIP(net.IPv4(10, 0, 0, 5)),
+			dhcpv4.WithNetmask(net.CIDRMask(24, 32)),
+			dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionVendorSpecificInformation, []byte{0x01, 0x02, 0xca, 0xfe})),
+			dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.GenericOptionCode(224), []byte("rack=a12"))),
+		))(t)
+
+		specs := dhcpparse.ParseDHCP4Ack(ack, linkName, routeMetric, false, true, false)
+
+		assert.Equal(t,
+			[]network.DHCPOption{
+				{Code: 1, Value: "ffffff00"},
+				{Code: 43, Value: "0102cafe"},
+				{Code: 53, Value: "05"},
+				{Code: 224, Value: "7261636b3d613132", Text: "rack=a12"},
+			},
+			specs.Options,
+		)
+	})
 }
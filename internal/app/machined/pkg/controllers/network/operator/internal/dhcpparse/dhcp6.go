@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dhcpparse
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"go4.org/netipx"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// DHCP6PDSpecs holds the network configuration derived from a DHCPv6 delegated prefix.
+//
+// All slices are nil when no usable prefix was delegated, so assigning
+// the whole struct fully replaces previous delegation state.
+type DHCP6PDSpecs struct {
+	Addresses         []network.AddressSpecSpec
+	Routes            []network.RouteSpecSpec
+	DelegatedPrefixes []network.DelegatedPrefixSpec
+
+	// ValidUntil is the moment the delegated prefix expires, zero if nothing was delegated.
+	ValidUntil time.Time
+}
+
+// ParseDHCP6PrefixDelegation converts the IA_PD option of a DHCPv6 reply into network configuration specs.
+//
+// The first prefix which can hold /64 subnets is used: each downstream link gets
+// the /64 subnet selected by its subnet ID, with the router address (::1) assigned
+// to the link. The whole delegated prefix is routed as unreachable, so that traffic
+// to the subnets which are not assigned doesn't loop back to the upstream router.
+//
+// Downstreams with a subnet ID which doesn't fit into the delegated prefix are skipped.
+func ParseDHCP6PrefixDelegation(
+	iapd *dhcpv6.OptIAPD,
+	upstreamLinkName string,
+	routeMetric uint32,
+	downstreams []network.DHCP6PrefixDownstreamSpec,
+	now time.Time,
+) DHCP6PDSpecs {
+	var specs DHCP6PDSpecs
+
+	if iapd == nil {
+		return specs
+	}
+
+	for _, iaPrefix := range iapd.Options.Prefixes() {
+		if iaPrefix.Prefix == nil || iaPrefix.ValidLifetime == 0 {
+			continue
+		}
+
+		delegated, ok := netipx.FromStdIPNet(iaPrefix.Prefix)
+		if !ok || !delegated.Addr().Is6() || delegated.Bits() > 64 {
+			continue
+		}
+
+		delegated = delegated.Masked()
+
+		preferredUntil := now.Add(iaPrefix.PreferredLifetime)
+		specs.ValidUntil = now.Add(iaPrefix.ValidLifetime)
+
+		specs.Routes = []network.RouteSpecSpec{
+			{
+				Family:      nethelpers.FamilyInet6,
+				Destination: delegated,
+				Table:       nethelpers.TableMain,
+				Priority:    routeMetric,
+				Scope:       nethelpers.ScopeGlobal,
+				Type:        nethelpers.TypeUnreachable,
+				Protocol:    nethelpers.ProtocolBoot,
+				ConfigLayer: network.ConfigOperator,
+			},
+		}
+
+		for i := range specs.Routes {
+			specs.Routes[i].Normalize()
+		}
+
+		for _, downstream := range downstreams {
+			subnet, ok := DelegatedPrefixSubnet(delegated, downstream.SubnetID)
+			if !ok {
+				continue
+			}
+
+			specs.Addresses = append(specs.Addresses, network.AddressSpecSpec{
+				Address:     netip.PrefixFrom(subnet.Addr().Next(), subnet.Bits()),
+				LinkName:    downstream.LinkName,
+				Family:      nethelpers.FamilyInet6,
+				Scope:       nethelpers.ScopeGlobal,
+				Flags:       nethelpers.AddressFlags(nethelpers.AddressPermanent),
+				Priority:    routeMetric,
+				ConfigLayer: network.ConfigOperator,
+			})
+
+			specs.DelegatedPrefixes = append(specs.DelegatedPrefixes, network.DelegatedPrefixSpec{
+				DelegatedPrefix:  delegated,
+				Prefix:           subnet,
+				LinkName:         downstream.LinkName,
+				UpstreamLinkName: upstreamLinkName,
+				PreferredUntil:   preferredUntil,
+				ValidUntil:       specs.ValidUntil,
+			})
+		}
+
+		break
+	}
+
+	return specs
+}
+
+// DelegatedPrefixSubnet returns the /64 subnet with the specified ID out of the delegated prefix.
+//
+// It returns false if the delegated prefix is longer than /64, or the subnet ID doesn't fit into it.
+func DelegatedPrefixSubnet(delegated netip.Prefix, subnetID uint32) (netip.Prefix, bool) {
+	if !delegated.Addr().Is6() || delegated.Bits() < 0 || delegated.Bits() > 64 {
+		return netip.Prefix{}, false
+	}
+
+	if subnetBits := 64 - delegated.Bits(); subnetBits < 32 && uint64(subnetID) >= 1<<subnetBits {
+		return netip.Prefix{}, false
+	}
+
+	addr := delegated.Masked().Addr().As16()
+
+	binary.BigEndian.PutUint64(addr[:8], binary.BigEndian.Uint64(addr[:8])|uint64(subnetID))
+
+	return netip.PrefixFrom(netip.AddrFrom16(addr), 64), true
+}
@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dhcpparse_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/operator/internal/dhcpparse"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func TestDelegatedPrefixSubnet(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		delegated string
+		subnetID  uint32
+
+		expected string
+	}{
+		{
+			name:      "first subnet",
+			delegated: "2001:db8:1200::/56",
+			subnetID:  0,
+			expected:  "2001:db8:1200::/64",
+		},
+		{
+			name:      "last subnet",
+			delegated: "2001:db8:1200::/56",
+			subnetID:  255,
+			expected:  "2001:db8:1200:ff::/64",
+		},
+		{
+			name:      "out of range",
+			delegated: "2001:db8:1200::/56",
+			subnetID:  256,
+		},
+		{
+			name:      "single subnet",
+			delegated: "2001:db8:1200:3400::/64",
+			subnetID:  0,
+			expected:  "2001:db8:1200:3400::/64",
+		},
+		{
+			name:      "too long",
+			delegated: "2001:db8:1200:3400::/80",
+			subnetID:  0,
+		},
+		{
+			name:      "short prefix",
+			delegated: "2001:db8::/16",
+			subnetID:  0xffffffff,
+			expected:  "2001:db8:ffff:ffff::/64",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			subnet, ok := dhcpparse.DelegatedPrefixSubnet(netip.MustParsePrefix(test.delegated), test.subnetID)
+
+			if test.expected == "" {
+				assert.False(t, ok)
+
+				return
+			}
+
+			require.True(t, ok)
+			assert.Equal(t, netip.MustParsePrefix(test.expected), subnet)
+		})
+	}
+}
+
+func TestParseDHCP6PrefixDelegation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	downstreams := []network.DHCP6PrefixDownstreamSpec{
+		{LinkName: "br0", SubnetID: 1},
+		{LinkName: "br1", SubnetID: 300},
+	}
+
+	t.Run("no IA_PD", func(t *testing.T) {
+		t.Parallel()
+
+		specs := dhcpparse.ParseDHCP6PrefixDelegation(nil, "eth0", 1024, downstreams, now)
+
+		assert.Empty(t, specs.Addresses)
+		assert.Empty(t, specs.Routes)
+		assert.Empty(t, specs.DelegatedPrefixes)
+		assert.True(t, specs.ValidUntil.IsZero())
+	})
+
+	t.Run("delegated /56", func(t *testing.T) {
+		t.Parallel()
+
+		iapd := &dhcpv6.OptIAPD{
+			IaId: [4]byte{0, 0, 0, 1},
+		}
+
+		iapd.Options.Add(&dhcpv6.OptIAPrefix{
+			PreferredLifetime: time.Hour,
+			ValidLifetime:     2 * time.Hour,
+			Prefix: &net.IPNet{
+				IP:   net.ParseIP("2001:db8:1200::"),
+				Mask: net.CIDRMask(56, 128),
+			},
+		})
+
+		specs := dhcpparse.ParseDHCP6PrefixDelegation(iapd, "eth0", 1024, downstreams, now)
+
+		assert.Equal(t, now.Add(2*time.Hour), specs.ValidUntil)
+
+		require.Len(t, specs.Routes, 1)
+		assert.Equal(t, netip.MustParsePrefix("2001:db8:1200::/56"), specs.Routes[0].Destination)
+		assert.Equal(t, nethelpers.TypeUnreachable, specs.Routes[0].Type)
+		assert.Equal(t, nethelpers.FamilyInet6, specs.Routes[0].Family)
+
+		// br1 subnet ID doesn't fit into /56
+		require.Len(t, specs.Addresses, 1)
+		assert.Equal(t, netip.MustParsePrefix("2001:db8:1200:1::1/64"), specs.Addresses[0].Address)
+		assert.Equal(t, "br0", specs.Addresses[0].LinkName)
+
+		require.Len(t, specs.DelegatedPrefixes, 1)
+		assert.Equal(t, network.DelegatedPrefixSpec{
+			DelegatedPrefix:  netip.MustParsePrefix("2001:db8:1200::/56"),
+			Prefix:           netip.MustParsePrefix("2001:db8:1200:1::/64"),
+			LinkName:         "br0",
+			UpstreamLinkName: "eth0",
+			PreferredUntil:   now.Add(time.Hour),
+			ValidUntil:       now.Add(2 * time.Hour),
+		}, specs.DelegatedPrefixes[0])
+	})
+}
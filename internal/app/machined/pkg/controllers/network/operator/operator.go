@@ -24,4 +24,7 @@ type Operator interface {
 	HostnameSpecs() []network.HostnameSpecSpec
 	ResolverSpecs() []network.ResolverSpecSpec
 	TimeServerSpecs() []network.TimeServerSpecSpec
+
+	DHCPOptions() []network.DHCPOption
+	DelegatedPrefixes() []network.DelegatedPrefixSpec
 }
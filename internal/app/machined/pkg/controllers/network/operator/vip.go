@@ -37,6 +37,7 @@ type VIP struct {
 	linkName      string
 	sharedIP      netip.Addr
 	gratuitousARP bool
+	probe         string
 
 	state state.State
 
@@ -64,6 +65,7 @@ func NewVIP(logger *zap.Logger, linkName string, spec network.VIPOperatorSpec, s
 		linkName:      linkName,
 		sharedIP:      spec.IP,
 		gratuitousARP: spec.GratuitousARP,
+		probe:         spec.Probe,
 		state:         state,
 		handler:       handler,
 	}
@@ -147,6 +149,16 @@ func (vip *VIP) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return nil
 }
 
+// DHCPOptions implements Operator interface.
+func (vip *VIP) DHCPOptions() []network.DHCPOption {
+	return nil
+}
+
+// DelegatedPrefixes implements Operator interface.
+func (vip *VIP) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	return nil
+}
+
 func (vip *VIP) etcdElectionKey() string {
 	return fmt.Sprintf("%s:vip:election:%s", constants.EtcdRootTalosKey, vip.sharedIP.String())
 }
@@ -184,9 +196,80 @@ func (vip *VIP) waitForPreconditions(ctx context.Context) error {
 		return fmt.Errorf("kubelet lifecycle wait failure: %w", err)
 	}
 
+	// wait for the probe gating the VIP to be healthy
+	if vip.probe != "" {
+		if err = vip.waitForProbe(ctx); err != nil {
+			return fmt.Errorf("probe wait failure: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// waitForProbe waits until none of the probe statuses matching the VIP probe report a failure.
+func (vip *VIP) waitForProbe(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watchCh := make(chan state.Event)
+
+	if err := vip.state.WatchKind(
+		ctx,
+		resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, "", resource.VersionUndefined),
+		watchCh,
+		state.WithBootstrapContents(true),
+	); err != nil {
+		return err
+	}
+
+	failed := map[resource.ID]struct{}{}
+	bootstrapped := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-watchCh:
+			switch event.Type {
+			case state.Created, state.Updated:
+				if vip.probeFailed(event) {
+					failed[event.Resource.Metadata().ID()] = struct{}{}
+				} else {
+					delete(failed, event.Resource.Metadata().ID())
+				}
+			case state.Destroyed:
+				delete(failed, event.Resource.Metadata().ID())
+			case state.Bootstrapped:
+				bootstrapped = true
+			case state.Errored:
+				return event.Error
+			case state.Noop:
+			}
+		}
+
+		if !bootstrapped {
+			continue
+		}
+
+		if len(failed) == 0 {
+			return nil
+		}
+
+		vip.logger.Debug("waiting for the probe to recover", zap.String("probe", vip.probe), zap.Stringer("ip", vip.sharedIP))
+	}
+}
+
+// probeFailed reports whether the event is a failure of the VIP probe.
+func (vip *VIP) probeFailed(event state.Event) bool {
+	if event.Type != state.Created && event.Type != state.Updated {
+		return false
+	}
+
+	status, ok := event.Resource.(*network.ProbeStatus)
+
+	return ok && status.TypedSpec().Name == vip.probe && !status.TypedSpec().Success
+}
+
 //nolint:gocyclo,cyclop
 func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -278,6 +361,12 @@ func (vip *VIP) campaign(ctx context.Context, notifyCh chan<- struct{}) error {
 		return fmt.Errorf("kube-apiserver health wait failure: %w", err)
 	}
 
+	if vip.probe != "" {
+		if err = vip.state.WatchKind(ctx, resource.NewMetadata(network.NamespaceName, network.ProbeStatusType, "", resource.VersionUndefined), watchCh); err != nil {
+			return fmt.Errorf("error setting up probe watch: %w", err)
+		}
+	}
+
 	// wait for the etcd election campaign to be complete
 	// while waiting, also observe the kubelet lifecycle object (if the node is shutting down) and etcd status
 campaignLoop:
@@ -303,6 +392,13 @@ campaignLoop:
 				return nil
 			}
 
+			// leave the election when the probe fails
+			if vip.probeFailed(event) {
+				vip.logger.Info("probe failed, leaving the election", zap.String("probe", vip.probe), zap.Stringer("ip", vip.sharedIP))
+
+				return nil
+			}
+
 			// break the loop if the kubelet lifecycle is entering teardown phase
 			if event.Resource != nil {
 				if event.Resource.Metadata().Type() == kubeletLifecycle.Type() && event.Resource.Metadata().ID() == kubeletLifecycle.ID() && event.Resource.Metadata().Phase() == resource.PhaseTearingDown {
@@ -363,6 +459,13 @@ observeLoop:
 				}
 			}
 
+			// release the shared IP when the probe fails
+			if vip.probeFailed(event) {
+				vip.logger.Info("probe failed, releasing shared IP", zap.String("probe", vip.probe), zap.Stringer("ip", vip.sharedIP))
+
+				break observeLoop
+			}
+
 			// break the loop if the kubelet lifecycle is entering teardown phase
 			if event.Resource != nil {
 				if event.Resource.Metadata().Type() == kubeletLifecycle.Type() && event.Resource.Metadata().ID() == kubeletLifecycle.ID() && event.Resource.Metadata().Phase() == resource.PhaseTearingDown {
@@ -262,13 +262,18 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 							ClientIdentifier: dhcp4.ClientIdentifier(),
 							DUIDRawHex:       hex.EncodeToString(dhcp4.DUIDRaw().ValueOrZero()),
 						},
+						RequestOptions: xslices.Map(dhcp4.RequestOptions(), func(code uint8) uint32 {
+							return uint32(code)
+						}),
+						VendorClass: dhcp4.VendorClass().ValueOrZero(),
+						UserClass:   dhcp4.UserClass(),
 					},
 					ConfigLayer: network.ConfigMachineConfiguration,
 				})
 			}
 
 			for _, dhcp6 := range cfg.Config().NetworkDHCPv6Configs() {
-				specs = append(specs, network.OperatorSpecSpec{
+				spec := network.OperatorSpecSpec{
 					Operator:  network.OperatorDHCP6,
 					LinkName:  linkNameResolver.Resolve(dhcp6.Name()),
 					RequireUp: true,
@@ -281,7 +286,22 @@ func (ctrl *OperatorConfigController) Run(ctx context.Context, r controller.Runt
 						},
 					},
 					ConfigLayer: network.ConfigMachineConfiguration,
-				})
+				}
+
+				if pd := dhcp6.PrefixDelegation(); pd != nil {
+					spec.DHCP6.PrefixDelegation = true
+					spec.DHCP6.PrefixLength = uint32(pd.PrefixLength())
+					spec.DHCP6.PrefixDownstreams = xslices.Map(pd.Downstreams(),
+						func(downstream talosconfig.NetworkDHCPv6PrefixDelegationDownstream) network.DHCP6PrefixDownstreamSpec {
+							return network.DHCP6PrefixDownstreamSpec{
+								LinkName: linkNameResolver.Resolve(downstream.Link()),
+								SubnetID: downstream.SubnetID(),
+							}
+						},
+					)
+				}
+
+				specs = append(specs, spec)
 			}
 		}
 
@@ -392,8 +392,17 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationNewStyle() {
 	dhcp2.ConfigRouteMetric = 512
 	dhcp2.ConfigClientIdentifier = new(nethelpers.ClientIdentifierDUID)
 	dhcp2.ConfigDUIDRaw = nethelpers.HardwareAddr{0x00, 0x01, 0x00, 0x01, 0x23, 0x45, 0x67, 0x89, 0xAB, 0xCD, 0xEF, 0x01}
+	dhcp2.ConfigPrefixDelegation = &networkcfg.DHCPv6PrefixDelegationConfig{
+		ConfigPrefixLength: 56,
+		ConfigDownstreams: []networkcfg.DHCPv6PrefixDelegationDownstream{
+			{ConfigLink: "br0", ConfigSubnetID: 1},
+		},
+	}
 
 	dhcp3 := networkcfg.NewDHCPv4ConfigV1Alpha1("eth23")
+	dhcp3.ConfigRequestOptions = []int{43, 224}
+	dhcp3.ConfigVendorClass = "PXEClient"
+	dhcp3.ConfigUserClass = []string{"provisioning"}
 
 	dhcp4 := networkcfg.NewDHCPv4ConfigV1Alpha1("eth4")
 
@@ -427,6 +436,9 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationNewStyle() {
 				asrt.False(r.TypedSpec().DHCP4.SkipHostnameRequest)
 				asrt.False(r.TypedSpec().DHCP4.SkipRoutes)
 				asrt.Equal(nethelpers.ClientIdentifierMAC, r.TypedSpec().DHCP4.ClientIdentifier.ClientIdentifier)
+				asrt.Equal([]uint32{43, 224}, r.TypedSpec().DHCP4.RequestOptions)
+				asrt.Equal("PXEClient", r.TypedSpec().DHCP4.VendorClass)
+				asrt.Equal([]string{"provisioning"}, r.TypedSpec().DHCP4.UserClass)
 			case "configuration/dhcp4/eth2":
 				asrt.Equal(network.OperatorDHCP4, r.TypedSpec().Operator)
 				asrt.Equal("eth2", r.TypedSpec().LinkName)
@@ -440,6 +452,9 @@ func (suite *OperatorConfigSuite) TestMachineConfigurationNewStyle() {
 				asrt.False(r.TypedSpec().DHCP6.SkipHostnameRequest)
 				asrt.Equal(nethelpers.ClientIdentifierDUID, r.TypedSpec().DHCP6.ClientIdentifier.ClientIdentifier)
 				asrt.NotEmpty(r.TypedSpec().DHCP6.ClientIdentifier.DUIDRawHex)
+				asrt.True(r.TypedSpec().DHCP6.PrefixDelegation)
+				asrt.EqualValues(56, r.TypedSpec().DHCP6.PrefixLength)
+				asrt.Equal([]network.DHCP6PrefixDownstreamSpec{{LinkName: "br0", SubnetID: 1}}, r.TypedSpec().DHCP6.PrefixDownstreams)
 			}
 		},
 	)
@@ -83,6 +83,14 @@ func (ctrl *OperatorSpecController) Outputs() []controller.Output {
 			Type: network.TimeServerSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: network.DHCPOptionsType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: network.DelegatedPrefixType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -369,21 +377,58 @@ func (ctrl *OperatorSpecController) reconcileOperatorOutputs(ctx context.Context
 				return fmt.Errorf("error applying spec: %w", err)
 			}
 		}
+
+		if options := op.Operator.DHCPOptions(); len(options) > 0 {
+			if err := safe.WriterModify(
+				ctx, r,
+				network.NewDHCPOptions(network.NamespaceName, op.Operator.Prefix()),
+				func(r *network.DHCPOptions) error {
+					r.TypedSpec().LinkName = op.Spec.LinkName
+					r.TypedSpec().Operator = op.Spec.Operator
+					r.TypedSpec().Options = options
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error applying DHCP options: %w", err)
+			}
+		}
+
+		for _, delegatedPrefix := range op.Operator.DelegatedPrefixes() {
+			if err := safe.WriterModify(
+				ctx, r,
+				network.NewDelegatedPrefix(
+					network.NamespaceName,
+					fmt.Sprintf("%s/%s", op.Operator.Prefix(), delegatedPrefix.LinkName),
+				),
+				func(r *network.DelegatedPrefix) error {
+					*r.TypedSpec() = delegatedPrefix
+
+					return nil
+				},
+			); err != nil {
+				return fmt.Errorf("error applying delegated prefix: %w", err)
+			}
+		}
 	}
 
 	// clean up not touched specs
 	if err := r.CleanupOutputs(
 		ctx,
-		xslices.Map([]resource.Type{
-			network.AddressSpecType,
-			network.LinkSpecType,
-			network.RouteSpecType,
-			network.HostnameSpecType,
-			network.ResolverSpecType,
-			network.TimeServerSpecType,
-		}, func(t resource.Type) resource.Kind {
-			return resource.NewMetadata(network.ConfigNamespaceName, t, "", resource.VersionUndefined)
-		})...,
+		append(
+			xslices.Map([]resource.Type{
+				network.AddressSpecType,
+				network.LinkSpecType,
+				network.RouteSpecType,
+				network.HostnameSpecType,
+				network.ResolverSpecType,
+				network.TimeServerSpecType,
+			}, func(t resource.Type) resource.Kind {
+				return resource.NewMetadata(network.ConfigNamespaceName, t, "", resource.VersionUndefined)
+			}),
+			resource.NewMetadata(network.NamespaceName, network.DHCPOptionsType, "", resource.VersionUndefined),
+			resource.NewMetadata(network.NamespaceName, network.DelegatedPrefixType, "", resource.VersionUndefined),
+		)...,
 	); err != nil {
 		return fmt.Errorf("error during outputs cleanup: %w", err)
 	}
@@ -43,6 +43,8 @@ type mockOperator struct {
 	hostname    []network.HostnameSpecSpec
 	resolvers   []network.ResolverSpecSpec
 	timeservers []network.TimeServerSpecSpec
+	options     []network.DHCPOption
+	prefixes    []network.DelegatedPrefixSpec
 }
 
 var (
@@ -129,6 +131,20 @@ func (mock *mockOperator) TimeServerSpecs() []network.TimeServerSpecSpec {
 	return mock.timeservers
 }
 
+func (mock *mockOperator) DHCPOptions() []network.DHCPOption {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.options
+}
+
+func (mock *mockOperator) DelegatedPrefixes() []network.DelegatedPrefixSpec {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.prefixes
+}
+
 func (suite *OperatorSpecSuite) newOperator(_ *zap.Logger, spec *network.OperatorSpecSpec) operator.Operator {
 	return &mockOperator{
 		spec: *spec,
@@ -413,6 +429,17 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 			ConfigLayer: network.ConfigOperator,
 		},
 	}
+	dhcpMock.options = []network.DHCPOption{
+		network.NewDHCPOption(224, []byte("rack=a12")),
+	}
+	dhcpMock.prefixes = []network.DelegatedPrefixSpec{
+		{
+			DelegatedPrefix:  netip.MustParsePrefix("2001:db8:1200::/56"),
+			Prefix:           netip.MustParsePrefix("2001:db8:1200:1::/64"),
+			LinkName:         "br0",
+			UpstreamLinkName: "eth0",
+		},
+	}
 	dhcpMock.mu.Unlock()
 
 	dhcpMock.notify()
@@ -435,6 +462,23 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 		func(*network.HostnameSpec, *assert.Assertions) {},
 		rtestutils.WithNamespace(network.ConfigNamespaceName),
 	)
+	ctest.AssertResource(
+		suite,
+		"dhcp4/eth0",
+		func(r *network.DHCPOptions, asrt *assert.Assertions) {
+			asrt.Equal("eth0", r.TypedSpec().LinkName)
+			asrt.Equal(network.OperatorDHCP4, r.TypedSpec().Operator)
+			asrt.Equal([]network.DHCPOption{{Code: 224, Value: "7261636b3d613132", Text: "rack=a12"}}, r.TypedSpec().Options)
+		},
+	)
+	ctest.AssertResource(
+		suite,
+		"dhcp4/eth0/br0",
+		func(r *network.DelegatedPrefix, asrt *assert.Assertions) {
+			asrt.Equal(netip.MustParsePrefix("2001:db8:1200:1::/64"), r.TypedSpec().Prefix)
+			asrt.Equal("eth0", r.TypedSpec().UpstreamLinkName)
+		},
+	)
 
 	// update specs
 	dhcpMock.mu.Lock()
@@ -448,6 +492,8 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 			ConfigLayer: network.ConfigOperator,
 		},
 	}
+	dhcpMock.options = nil
+	dhcpMock.prefixes = nil
 	dhcpMock.mu.Unlock()
 
 	dhcpMock.notify()
@@ -458,6 +504,8 @@ func (suite *OperatorSpecSuite) TestOperatorOutputs() {
 		func(*network.AddressSpec, *assert.Assertions) {},
 		rtestutils.WithNamespace(network.ConfigNamespaceName),
 	)
+	ctest.AssertNoResource[*network.DHCPOptions](suite, "dhcp4/eth0")
+	ctest.AssertNoResource[*network.DelegatedPrefix](suite, "dhcp4/eth0/br0")
 }
 
 func TestOperatorSpecSuite(t *testing.T) {
@@ -250,6 +250,7 @@ func (ctrl *OperatorVIPConfigController) handleVIPConfigDoc(ctx context.Context,
 		VIP: network.VIPOperatorSpec{
 			IP:            cfg.VIP(),
 			GratuitousARP: true,
+			Probe:         cfg.Probe(),
 		},
 		ConfigLayer: network.ConfigMachineConfiguration,
 	}
@@ -166,6 +166,7 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 
 	vip1 := networkcfg.NewLayer2VIPConfigV1Alpha1("2.3.4.5")
 	vip1.LinkName = "eth33"
+	vip1.VIPProbe = "uplink-check"
 
 	vip2 := networkcfg.NewLayer2VIPConfigV1Alpha1("fd7a:115c:a1e0:ab12:4843:cd96:6277:2302")
 	vip2.LinkName = "enxa"
@@ -188,6 +189,7 @@ func (suite *OperatorVIPConfigSuite) TestMachineConfigurationVIP() {
 			case "configuration/vip/eth33/2.3.4.5":
 				asrt.Equal("eth33", r.TypedSpec().LinkName)
 				asrt.EqualValues(netip.MustParseAddr("2.3.4.5"), r.TypedSpec().VIP.IP)
+				asrt.Equal("uplink-check", r.TypedSpec().VIP.Probe)
 			case "configuration/vip/eth5/fd7a:115c:a1e0:ab12:4843:cd96:6277:2302":
 				asrt.Equal("eth5", r.TypedSpec().LinkName)
 				asrt.EqualValues(
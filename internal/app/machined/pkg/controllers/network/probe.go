@@ -152,3 +152,23 @@ func (ctrl *ProbeController) reconcileOutputs(ctx context.Context, r controller.
 			return nil
 		})
 }
+
+// failedProbes returns the names of the probes which currently report a failure.
+//
+// Probes which haven't reported a result yet are not considered failed.
+func failedProbes(ctx context.Context, r controller.Reader) (map[string]struct{}, error) {
+	statuses, err := safe.ReaderListAll[*network.ProbeStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing probe statuses: %w", err)
+	}
+
+	failed := map[string]struct{}{}
+
+	for status := range statuses.All() {
+		if status.TypedSpec().Name != "" && !status.TypedSpec().Success {
+			failed[status.TypedSpec().Name] = struct{}{}
+		}
+	}
+
+	return failed, nil
+}
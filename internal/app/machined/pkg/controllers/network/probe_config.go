@@ -121,6 +121,7 @@ func (ctrl *ProbeConfigController) parseMachineConfiguration(cfg *config.Machine
 
 	for _, probeConfig := range probeConfigs {
 		spec := network.ProbeSpecSpec{
+			Name:             probeConfig.Name(),
 			Interval:         probeConfig.Interval(),
 			FailureThreshold: probeConfig.FailureThreshold(),
 			ConfigLayer:      network.ConfigMachineConfiguration,
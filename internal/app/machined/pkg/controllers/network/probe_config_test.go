@@ -50,6 +50,7 @@ func (suite *ProbeConfigSuite) TestSingleProbe() { //nolint:dupl
 		[]string{
 			"configuration/tcp:proxy.example.com:3128",
 		}, func(r *network.ProbeSpec, asrt *assert.Assertions) {
+			asrt.Equal("proxy-check", r.TypedSpec().Name)
 			asrt.Equal(time.Second, r.TypedSpec().Interval)
 			asrt.Equal(3, r.TypedSpec().FailureThreshold)
 			asrt.Equal("proxy.example.com:3128", r.TypedSpec().TCP.Endpoint)
@@ -24,6 +24,7 @@ type ProbeSuite struct {
 
 func (suite *ProbeSuite) TestReconcile() {
 	googleProbeSpec := network.ProbeSpecSpec{
+		Name:     "google",
 		Interval: 100 * time.Millisecond,
 		TCP: network.TCPProbeSpec{
 			Endpoint: "google.com:80",
@@ -40,6 +41,7 @@ func (suite *ProbeSuite) TestReconcile() {
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{googleProbeSpecID}, func(r *network.ProbeStatus, assert *assert.Assertions) {
 		assert.Equal(network.ProbeStatusSpec{
 			Success: true,
+			Name:    "google",
 		}, *r.TypedSpec())
 	})
 
@@ -332,6 +332,7 @@ func (ctrl *RouteConfigController) processMachineConfig(linkConfigs []cfg.Networ
 			route.Protocol = nethelpers.ProtocolStatic
 			route.OutLinkName = linkConfig.Name()
 			route.ConfigLayer = network.ConfigMachineConfiguration
+			route.Probe = spec.Probe()
 
 			route.Type = nethelpers.TypeUnicast
 
@@ -238,6 +238,7 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 		{
 			RouteDestination: meta.Prefix{Prefix: netip.MustParsePrefix("10.12.3.0/24")},
 			RouteGateway:     meta.Addr{Addr: netip.MustParseAddr("10.12.3.1")},
+			RouteProbe:       "uplink-check",
 		},
 	}
 
@@ -277,6 +278,7 @@ func (suite *RouteConfigSuite) TestMachineConfiguration() {
 				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
 				asrt.EqualValues(network.DefaultRouteMetric, r.TypedSpec().Priority)
 				asrt.EqualValues(nethelpers.TableMain, r.TypedSpec().Table)
+				asrt.Equal("uplink-check", r.TypedSpec().Probe)
 			case "configuration/inet4//10.1.3.4/32/300":
 				asrt.Empty(r.TypedSpec().OutLinkName)
 				asrt.Equal(nethelpers.FamilyInet4, r.TypedSpec().Family)
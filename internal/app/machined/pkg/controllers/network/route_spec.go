@@ -40,6 +40,11 @@ func (ctrl *RouteSpecController) Inputs() []controller.Input {
 			Type:      network.RouteSpecType,
 			Kind:      controller.InputStrong,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.ProbeStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -91,6 +96,12 @@ func (ctrl *RouteSpecController) Run(ctx context.Context, r controller.Runtime,
 			}
 		}
 
+		// routes gated by a failing probe are withdrawn
+		failed, err := failedProbes(ctx, r)
+		if err != nil {
+			return err
+		}
+
 		// list rtnetlink links (interfaces)
 		links, err := conn.Link.List()
 		if err != nil {
@@ -107,7 +118,7 @@ func (ctrl *RouteSpecController) Run(ctx context.Context, r controller.Runtime,
 
 		// loop over routes and make reconcile decision
 		for route := range list.All() {
-			if err = ctrl.syncRoute(ctx, r, logger, conn, links, routes, route); err != nil {
+			if err = ctrl.syncRoute(ctx, r, logger, conn, links, routes, route, failed); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 		}
@@ -283,7 +294,7 @@ func multipathEqual(existing, expected []rtnetlink.NextHop) bool {
 
 //nolint:gocyclo,cyclop
 func (ctrl *RouteSpecController) syncRoute(ctx context.Context, r controller.Runtime, logger *zap.Logger, conn *rtnetlink.Conn,
-	links []rtnetlink.LinkMessage, routes []rtnetlink.RouteMessage, route *network.RouteSpec,
+	links []rtnetlink.LinkMessage, routes []rtnetlink.RouteMessage, route *network.RouteSpec, failed map[string]struct{},
 ) error {
 	linkIndex := resolveLinkName(links, route.TypedSpec().OutLinkName)
 
@@ -329,6 +340,25 @@ func (ctrl *RouteSpecController) syncRoute(ctx context.Context, r controller.Run
 			return fmt.Errorf("error removing finalizer: %w", err)
 		}
 	case resource.PhaseRunning:
+		if _, withdrawn := failed[route.TypedSpec().Probe]; withdrawn {
+			for _, existing := range findMatchingRoutes(routes, route.TypedSpec()) {
+				if err := conn.Route.Delete(existing); err != nil {
+					return fmt.Errorf("error withdrawing route: %w", err)
+				}
+
+				logger.Info(
+					"withdrew route, probe failed",
+					zap.String("destination", destinationStr),
+					zap.String("gateway", gatewayStr),
+					zap.Stringer("table", route.TypedSpec().Table),
+					zap.String("link", route.TypedSpec().OutLinkName),
+					zap.String("probe", route.TypedSpec().Probe),
+				)
+			}
+
+			return nil
+		}
+
 		if linkIndex == 0 && route.TypedSpec().OutLinkName != "" {
 			// route can't be created as link doesn't exist (yet), skip it
 			return nil
@@ -144,6 +144,65 @@ func (suite *RouteSpecSuite) TestLoopback() {
 	)
 }
 
+func (suite *RouteSpecSuite) TestProbeWithdraw() {
+	probeStatus := network.NewProbeStatus(network.NamespaceName, "tcp:127.0.12.1:80")
+	*probeStatus.TypedSpec() = network.ProbeStatusSpec{
+		Name:    "uplink-check",
+		Success: true,
+	}
+
+	suite.Create(probeStatus)
+
+	route := network.NewRouteSpec(network.NamespaceName, "probed")
+	*route.TypedSpec() = network.RouteSpecSpec{
+		Family:      nethelpers.FamilyInet4,
+		Destination: netip.MustParsePrefix("127.0.12.0/24"),
+		Gateway:     netip.MustParseAddr("127.0.12.1"),
+		OutLinkName: "lo",
+		Scope:       nethelpers.ScopeGlobal,
+		Table:       nethelpers.TableMain,
+		Protocol:    nethelpers.ProtocolStatic,
+		Type:        nethelpers.TypeUnicast,
+		ConfigLayer: network.ConfigMachineConfiguration,
+		Probe:       "uplink-check",
+	}
+
+	suite.Create(route)
+
+	assertRoute := func() error {
+		return suite.assertRoute(
+			netip.MustParsePrefix("127.0.12.0/24"),
+			netip.MustParseAddr("127.0.12.1"),
+			func(rtnetlink.RouteMessage) error { return nil },
+		)
+	}
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(assertRoute))
+
+	// the probe fails, the route should be withdrawn
+	probeStatus.TypedSpec().Success = false
+	suite.Update(probeStatus)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoRoute(
+					netip.MustParsePrefix("127.0.12.0/24"),
+					netip.MustParseAddr("127.0.12.1"),
+				)
+			},
+		),
+	)
+
+	// the probe recovers, the route should be restored
+	probeStatus.TypedSpec().Success = true
+	suite.Update(probeStatus)
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(assertRoute))
+
+	suite.Require().NoError(suite.State().TeardownAndDestroy(suite.Ctx(), route.Metadata()))
+}
+
 func (suite *RouteSpecSuite) TestDefaultRoute() {
 	// adding default route with high metric to avoid messing up with the actual default route
 	def := network.NewRouteSpec(network.NamespaceName, "default")
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 	"sort"
 	"time"
 
@@ -26,6 +27,9 @@ import (
 // raInterval is how often Router Advertisements are emitted on each unnumbered link.
 const raInterval = 10 * time.Second
 
+// raRouterLifetime is the router lifetime advertised on links with delegated prefixes.
+const raRouterLifetime = 30 * time.Minute
+
 var allNodesMulticast = netip.MustParseAddr("ff02::1")
 
 // RouterAdvertisementController sends IPv6 Router Advertisements on links used for unnumbered
@@ -34,6 +38,9 @@ var allNodesMulticast = netip.MustParseAddr("ff02::1")
 //
 // It also enables net.ipv6.conf.<iface>.accept_ra=2 on those links (accept RAs while forwarding)
 // so this node learns the neighbor's link-local in return.
+//
+// On links with a subnet of a DHCPv6 delegated prefix, the subnet is advertised for SLAAC,
+// and this node is advertised as a default router.
 type RouterAdvertisementController struct {
 	senders map[string]raSender
 }
@@ -41,6 +48,8 @@ type RouterAdvertisementController struct {
 type raSender struct {
 	cancel context.CancelFunc
 	done   chan struct{}
+
+	prefixes []network.DelegatedPrefixSpec
 }
 
 func (sender raSender) stop() {
@@ -61,6 +70,11 @@ func (ctrl *RouterAdvertisementController) Inputs() []controller.Input {
 			Type:      network.BGPInstanceConfigType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DelegatedPrefixType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: network.NamespaceName,
 			Type:      network.LinkStatusType,
@@ -119,6 +133,11 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 		return fmt.Errorf("error listing BGP instance configs: %w", err)
 	}
 
+	delegatedPrefixes, err := safe.ReaderListAll[*network.DelegatedPrefix](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing delegated prefixes: %w", err)
+	}
+
 	linkStatuses, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
 	if err != nil {
 		return fmt.Errorf("error listing link statuses: %w", err)
@@ -131,34 +150,51 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 		readyLinks[linkStatus.Metadata().ID()] = struct{}{}
 	}
 
-	interfaces := map[string]struct{}{}
+	// unnumbered BGP interfaces
+	bgpInterfaces := map[string]struct{}{}
 
 	for configResource := range configResources.All() {
 		for _, neighbor := range configResource.TypedSpec().Neighbors {
 			link := linkResolver.Resolve(neighbor.Link)
 			if _, ready := readyLinks[link]; ready {
-				interfaces[link] = struct{}{}
+				bgpInterfaces[link] = struct{}{}
 			}
 		}
 	}
 
-	// reconcile RA-sender goroutines: start for new interfaces, stop for removed ones.
+	// all interfaces with the delegated prefixes to advertise
+	interfaces := map[string][]network.DelegatedPrefixSpec{}
+
+	for iface := range bgpInterfaces {
+		interfaces[iface] = nil
+	}
+
+	for delegatedPrefix := range delegatedPrefixes.All() {
+		link := delegatedPrefix.TypedSpec().LinkName
+		if _, ready := readyLinks[link]; ready {
+			interfaces[link] = append(interfaces[link], *delegatedPrefix.TypedSpec())
+		}
+	}
+
+	// reconcile RA-sender goroutines: start for new interfaces, stop for removed ones,
+	// restart if the advertised prefixes changed.
 	for iface, sender := range ctrl.senders {
-		if _, ok := interfaces[iface]; !ok {
+		if prefixes, ok := interfaces[iface]; !ok || !slices.EqualFunc(prefixes, sender.prefixes, delegatedPrefixEqual) {
 			sender.stop()
 			delete(ctrl.senders, iface)
 		}
 	}
 
-	for iface := range interfaces {
+	for iface, prefixes := range interfaces {
 		if _, ok := ctrl.senders[iface]; ok {
 			continue
 		}
 
 		senderCtx, cancel := context.WithCancel(ctx)
 		sender := raSender{
-			cancel: cancel,
-			done:   make(chan struct{}),
+			cancel:   cancel,
+			done:     make(chan struct{}),
+			prefixes: prefixes,
 		}
 
 		ctrl.senders[iface] = sender
@@ -167,7 +203,7 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 			defer close(sender.done)
 
 			if err := panicsafe.Run(func() {
-				ctrl.runSender(senderCtx, iface, logger)
+				ctrl.runSender(senderCtx, iface, prefixes, logger)
 			}); err != nil {
 				logger.Error("router advertisement sender panicked", zap.String("interface", iface), zap.Error(err))
 			}
@@ -177,7 +213,7 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 	// enable accept_ra=2 on each unnumbered interface so we learn the neighbor's link-local.
 	r.StartTrackingOutputs()
 
-	for _, iface := range sortedKeys(interfaces) {
+	for _, iface := range sortedKeys(bgpInterfaces) {
 		id := kernel.Sysctl + "." + fmt.Sprintf("net/ipv6/conf/%s/accept_ra", iface)
 
 		if err = safe.WriterModify(ctx, r, runtimeres.NewKernelParamDefaultSpec(runtimeres.NamespaceName, id), func(spec *runtimeres.KernelParamDefaultSpec) error {
@@ -194,12 +230,12 @@ func (ctrl *RouterAdvertisementController) reconcile(ctx context.Context, r cont
 }
 
 // runSender periodically emits Router Advertisements on the given interface until the context is done.
-func (ctrl *RouterAdvertisementController) runSender(ctx context.Context, iface string, logger *zap.Logger) {
+func (ctrl *RouterAdvertisementController) runSender(ctx context.Context, iface string, prefixes []network.DelegatedPrefixSpec, logger *zap.Logger) {
 	ticker := time.NewTicker(raInterval)
 	defer ticker.Stop()
 
 	for {
-		if err := ctrl.sendOnce(iface); err != nil {
+		if err := ctrl.sendOnce(iface, prefixes, time.Now()); err != nil {
 			logger.Debug("failed to send router advertisement", zap.String("interface", iface), zap.Error(err))
 		}
 
@@ -211,7 +247,7 @@ func (ctrl *RouterAdvertisementController) runSender(ctx context.Context, iface
 	}
 }
 
-func (ctrl *RouterAdvertisementController) sendOnce(iface string) error {
+func (ctrl *RouterAdvertisementController) sendOnce(iface string, prefixes []network.DelegatedPrefixSpec, now time.Time) error {
 	ifi, err := net.InterfaceByName(iface)
 	if err != nil {
 		return err
@@ -238,9 +274,47 @@ func (ctrl *RouterAdvertisementController) sendOnce(iface string) error {
 		},
 	}
 
+	// on the downstream links of the delegated prefix, advertise the subnet for SLAAC and act as a default router
+	if prefixOptions := prefixInformationOptions(prefixes, now); len(prefixOptions) > 0 {
+		ra.RouterLifetime = raRouterLifetime
+		ra.Options = append(ra.Options, prefixOptions...)
+	}
+
 	return conn.WriteTo(ra, nil, allNodesMulticast)
 }
 
+// prefixInformationOptions builds SLAAC Prefix Information options for the delegated prefixes which are still valid.
+func prefixInformationOptions(prefixes []network.DelegatedPrefixSpec, now time.Time) []ndp.Option {
+	var options []ndp.Option
+
+	for _, prefix := range prefixes {
+		validLifetime := prefix.ValidUntil.Sub(now)
+		if validLifetime <= 0 {
+			continue
+		}
+
+		options = append(options, &ndp.PrefixInformation{
+			PrefixLength:                   uint8(prefix.Prefix.Bits()),
+			OnLink:                         true,
+			AutonomousAddressConfiguration: true,
+			ValidLifetime:                  validLifetime.Truncate(time.Second),
+			PreferredLifetime:              max(prefix.PreferredUntil.Sub(now), 0).Truncate(time.Second),
+			Prefix:                         prefix.Prefix.Masked().Addr(),
+		})
+	}
+
+	return options
+}
+
+func delegatedPrefixEqual(a, b network.DelegatedPrefixSpec) bool {
+	return a.Prefix == b.Prefix &&
+		a.DelegatedPrefix == b.DelegatedPrefix &&
+		a.LinkName == b.LinkName &&
+		a.UpstreamLinkName == b.UpstreamLinkName &&
+		a.PreferredUntil.Equal(b.PreferredUntil) &&
+		a.ValidUntil.Equal(b.ValidUntil)
+}
+
 func sortedKeys(m map[string]struct{}) []string {
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -5,6 +5,7 @@
 package network_test
 
 import (
+	"net/netip"
 	"testing"
 	"time"
 
@@ -49,6 +50,40 @@ func (suite *RouterAdvertisementControllerSuite) TestResolvesLinkAliasAndCleansU
 	)
 }
 
+func (suite *RouterAdvertisementControllerSuite) TestDelegatedPrefixDoesNotAcceptRA() {
+	link := network.NewLinkStatus(network.NamespaceName, "lo")
+	link.TypedSpec().Index = 1
+	suite.Create(link)
+
+	delegatedPrefix := network.NewDelegatedPrefix(network.NamespaceName, "dhcp6/eth0/lo")
+	delegatedPrefix.TypedSpec().DelegatedPrefix = netip.MustParsePrefix("2001:db8:1200::/56")
+	delegatedPrefix.TypedSpec().Prefix = netip.MustParsePrefix("2001:db8:1200:1::/64")
+	delegatedPrefix.TypedSpec().LinkName = "lo"
+	delegatedPrefix.TypedSpec().UpstreamLinkName = "eth0"
+	delegatedPrefix.TypedSpec().PreferredUntil = time.Now().Add(time.Hour)
+	delegatedPrefix.TypedSpec().ValidUntil = time.Now().Add(2 * time.Hour)
+	suite.Create(delegatedPrefix)
+
+	// accept_ra is only enabled for unnumbered BGP links
+	config := network.NewBGPInstanceConfig("fabric")
+	config.TypedSpec().Neighbors = []network.BGPNeighborConfigSpec{{Link: "lo"}}
+	suite.Create(config)
+
+	id := kernel.Sysctl + ".net/ipv6/conf/lo/accept_ra"
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), id, func(res *runtimeres.KernelParamDefaultSpec, assertions *assert.Assertions) {
+		assertions.Equal("2", res.TypedSpec().Value)
+	}, rtestutils.WithNamespace(runtimeres.NamespaceName))
+
+	suite.Destroy(config)
+	rtestutils.AssertNoResource[*runtimeres.KernelParamDefaultSpec](
+		suite.Ctx(),
+		suite.T(),
+		suite.State(),
+		id,
+		rtestutils.WithNamespace(runtimeres.NamespaceName),
+	)
+}
+
 func TestRouterAdvertisementControllerSuite(t *testing.T) {
 	t.Parallel()
 
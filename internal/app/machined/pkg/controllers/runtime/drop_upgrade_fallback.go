@@ -24,7 +24,7 @@ type MetaProvider interface {
 	Meta() machineruntime.Meta
 }
 
-// DropUpgradeFallbackController removes upgrade fallback key once machine reaches ready & running.
+// DropUpgradeFallbackController removes upgrade fallback and BGP graceful restart keys once machine reaches ready & running.
 type DropUpgradeFallbackController struct {
 	MetaProvider MetaProvider
 }
@@ -82,7 +82,15 @@ func (ctrl *DropUpgradeFallbackController) Run(ctx context.Context, r controller
 
 		if ok {
 			logger.Info("removing fallback entry")
+		}
+
+		// BGP graceful restart is complete once the machine is ready
+		restarted, err := ctrl.MetaProvider.Meta().DeleteTag(ctx, meta.BGPGracefulRestart)
+		if err != nil {
+			return err
+		}
 
+		if ok || restarted {
 			if err = ctrl.MetaProvider.Meta().Flush(); err != nil {
 				return err
 			}
@@ -70,6 +70,9 @@ func (suite *DropUpgradeFallbackControllerSuite) TestDropUpgradeFallback() {
 	_, err := suite.meta.SetTag(suite.Ctx(), metaconsts.Upgrade, "A")
 	suite.Require().NoError(err)
 
+	_, err = suite.meta.SetTag(suite.Ctx(), metaconsts.BGPGracefulRestart, "2026-10-17T10:00:00Z")
+	suite.Require().NoError(err)
+
 	machineStatus := runtimeres.NewMachineStatus()
 	machineStatus.TypedSpec().Stage = runtimeres.MachineStageBooting
 	machineStatus.TypedSpec().Status.Ready = false
@@ -93,6 +96,11 @@ func (suite *DropUpgradeFallbackControllerSuite) TestDropUpgradeFallback() {
 			return retry.ExpectedErrorf("tag is still present")
 		}
 
+		_, ok = suite.meta.ReadTag(metaconsts.BGPGracefulRestart)
+		if ok {
+			return retry.ExpectedErrorf("BGP graceful restart tag is still present")
+		}
+
 		return nil
 	})
 }
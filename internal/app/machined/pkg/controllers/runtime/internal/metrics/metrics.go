@@ -0,0 +1,311 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package metrics exports Talos resources as Prometheus metrics.
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+	"github.com/siderolabs/talos/pkg/machinery/resources/perf"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const namespace = "talos"
+
+// collectTimeout bounds resource reads done on each scrape.
+const collectTimeout = 5 * time.Second
+
+// NewRegistry builds a Prometheus registry with the Talos resource collector and
+// controller runtime counters.
+func NewRegistry(st state.State) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+
+	registry.MustRegister(
+		NewCollector(st),
+		collectors.NewExpvarCollector(map[string]*prometheus.Desc{
+			"controller_wakeups": prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "controller", "reconciles_total"),
+				"Number of controller reconcile loop wakeups.",
+				[]string{"controller"}, nil,
+			),
+			"controller_crashes": prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "controller", "crashes_total"),
+				"Number of controller crashes (failed reconcile loops).",
+				[]string{"controller"}, nil,
+			),
+			"qcontroller_processed": prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "qcontroller", "reconciles_total"),
+				"Number of reconcile events processed by queue-based controllers.",
+				[]string{"controller"}, nil,
+			),
+			"qcontroller_crashes": prometheus.NewDesc(
+				prometheus.BuildFQName(namespace, "qcontroller", "crashes_total"),
+				"Number of queue-based controller crashes.",
+				[]string{"controller"}, nil,
+			),
+		}),
+	)
+
+	return registry
+}
+
+// Collector reads Talos resources on each scrape and exports them as metrics.
+type Collector struct {
+	state state.State
+
+	cpuSeconds      *prometheus.Desc
+	memoryBytes     *prometheus.Desc
+	serviceRunning  *prometheus.Desc
+	serviceHealthy  *prometheus.Desc
+	volumePhase     *prometheus.Desc
+	kubespanPeer    *prometheus.Desc
+	kubespanRxBytes *prometheus.Desc
+	kubespanTxBytes *prometheus.Desc
+	etcdHealthy     *prometheus.Desc
+}
+
+// NewCollector creates a new Collector reading resources from the given state.
+func NewCollector(st state.State) *Collector {
+	return &Collector{
+		state: st,
+
+		cpuSeconds: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cpu", "seconds_total"),
+			"Seconds the CPUs spent in each mode.",
+			[]string{"cpu", "mode"}, nil,
+		),
+		memoryBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "memory", "bytes"),
+			"Memory usage by kind, as reported by /proc/meminfo.",
+			[]string{"kind"}, nil,
+		),
+		serviceRunning: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "service", "running"),
+			"Whether the Talos service is running.",
+			[]string{"service"}, nil,
+		),
+		serviceHealthy: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "service", "healthy"),
+			"Whether the Talos service health check passes.",
+			[]string{"service"}, nil,
+		),
+		volumePhase: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "volume", "phase"),
+			"Current phase of the volume (1 for the active phase).",
+			[]string{"volume", "phase"}, nil,
+		),
+		kubespanPeer: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kubespan", "peer_state"),
+			"Current state of the KubeSpan peer (1 for the active state).",
+			[]string{"peer", "label", "state"}, nil,
+		),
+		kubespanRxBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kubespan", "peer_receive_bytes_total"),
+			"Bytes received from the KubeSpan peer.",
+			[]string{"peer", "label"}, nil,
+		),
+		kubespanTxBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kubespan", "peer_transmit_bytes_total"),
+			"Bytes transmitted to the KubeSpan peer.",
+			[]string{"peer", "label"}, nil,
+		),
+		etcdHealthy: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "etcd", "member_healthy"),
+			"Whether the local etcd member is healthy.",
+			[]string{"member_id"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cpuSeconds
+	ch <- c.memoryBytes
+	ch <- c.serviceRunning
+	ch <- c.serviceHealthy
+	ch <- c.volumePhase
+	ch <- c.kubespanPeer
+	ch <- c.kubespanRxBytes
+	ch <- c.kubespanTxBytes
+	ch <- c.etcdHealthy
+}
+
+// Collect implements prometheus.Collector interface.
+//
+// Resources which are missing (e.g. KubeSpan is disabled, or the node is not a control plane node)
+// are skipped, and read errors are reported as invalid metrics.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	for _, collect := range []func(context.Context, chan<- prometheus.Metric) error{
+		c.collectCPU,
+		c.collectMemory,
+		c.collectServices,
+		c.collectVolumes,
+		c.collectKubeSpanPeers,
+		c.collectEtcd,
+	} {
+		if err := collect(ctx, ch); err != nil {
+			ch <- prometheus.NewInvalidMetric(prometheus.NewInvalidDesc(err), err)
+		}
+	}
+}
+
+func (c *Collector) collectCPU(ctx context.Context, ch chan<- prometheus.Metric) error {
+	cpu, err := safe.StateGetByID[*perf.CPU](ctx, c.state, perf.CPUID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	for i, stat := range cpu.TypedSpec().CPU {
+		id := strconv.Itoa(i)
+
+		for _, mode := range []struct {
+			name  string
+			value float64
+		}{
+			{"user", stat.User},
+			{"nice", stat.Nice},
+			{"system", stat.System},
+			{"idle", stat.Idle},
+			{"iowait", stat.Iowait},
+			{"irq", stat.Irq},
+			{"softirq", stat.SoftIrq},
+			{"steal", stat.Steal},
+		} {
+			ch <- prometheus.MustNewConstMetric(c.cpuSeconds, prometheus.CounterValue, mode.value, id, mode.name)
+		}
+	}
+
+	return nil
+}
+
+func (c *Collector) collectMemory(ctx context.Context, ch chan<- prometheus.Metric) error {
+	memory, err := safe.StateGetByID[*perf.Memory](ctx, c.state, perf.MemoryID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	spec := memory.TypedSpec()
+
+	// /proc/meminfo reports values in KiB
+	for _, kind := range []struct {
+		name  string
+		value uint64
+	}{
+		{"total", spec.MemTotal},
+		{"used", spec.MemUsed},
+		{"available", spec.MemAvailable},
+		{"buffers", spec.Buffers},
+		{"cached", spec.Cached},
+		{"swap_total", spec.SwapTotal},
+		{"swap_free", spec.SwapFree},
+	} {
+		ch <- prometheus.MustNewConstMetric(c.memoryBytes, prometheus.GaugeValue, float64(kind.value*1024), kind.name)
+	}
+
+	return nil
+}
+
+func (c *Collector) collectServices(ctx context.Context, ch chan<- prometheus.Metric) error {
+	services, err := safe.StateListAll[*v1alpha1.Service](ctx, c.state)
+	if err != nil {
+		return err
+	}
+
+	for service := range services.All() {
+		ch <- prometheus.MustNewConstMetric(c.serviceRunning, prometheus.GaugeValue, boolToFloat(service.TypedSpec().Running), service.Metadata().ID())
+		ch <- prometheus.MustNewConstMetric(c.serviceHealthy, prometheus.GaugeValue, boolToFloat(service.TypedSpec().Healthy), service.Metadata().ID())
+	}
+
+	return nil
+}
+
+func (c *Collector) collectVolumes(ctx context.Context, ch chan<- prometheus.Metric) error {
+	volumes, err := safe.StateListAll[*block.VolumeStatus](ctx, c.state)
+	if err != nil {
+		return err
+	}
+
+	for volume := range volumes.All() {
+		for _, phase := range block.VolumePhaseValues() {
+			ch <- prometheus.MustNewConstMetric(c.volumePhase, prometheus.GaugeValue,
+				boolToFloat(volume.TypedSpec().Phase == phase), volume.Metadata().ID(), phase.String())
+		}
+	}
+
+	return nil
+}
+
+func (c *Collector) collectKubeSpanPeers(ctx context.Context, ch chan<- prometheus.Metric) error {
+	peers, err := safe.StateListAll[*kubespan.PeerStatus](ctx, c.state)
+	if err != nil {
+		return err
+	}
+
+	for peer := range peers.All() {
+		spec := peer.TypedSpec()
+		id := peer.Metadata().ID()
+
+		for _, state := range []kubespan.PeerState{kubespan.PeerStateUnknown, kubespan.PeerStateUp, kubespan.PeerStateDown} {
+			ch <- prometheus.MustNewConstMetric(c.kubespanPeer, prometheus.GaugeValue, boolToFloat(spec.State == state), id, spec.Label, state.String())
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.kubespanRxBytes, prometheus.CounterValue, float64(spec.ReceiveBytes), id, spec.Label)
+		ch <- prometheus.MustNewConstMetric(c.kubespanTxBytes, prometheus.CounterValue, float64(spec.TransmitBytes), id, spec.Label)
+	}
+
+	return nil
+}
+
+// collectEtcd reports the local etcd member health as seen by the etcd service health check.
+func (c *Collector) collectEtcd(ctx context.Context, ch chan<- prometheus.Metric) error {
+	member, err := safe.StateGetByID[*etcd.Member](ctx, c.state, etcd.LocalMemberID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	service, err := safe.StateGetByID[*v1alpha1.Service](ctx, c.state, "etcd")
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	healthy := service != nil && service.TypedSpec().Running && service.TypedSpec().Healthy
+
+	ch <- prometheus.MustNewConstMetric(c.etcdHealthy, prometheus.GaugeValue, boolToFloat(healthy), member.TypedSpec().MemberID)
+
+	return nil
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime/internal/metrics"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
+	"github.com/siderolabs/talos/pkg/machinery/resources/perf"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	memory := perf.NewMemory()
+	memory.TypedSpec().MemTotal = 2048
+	memory.TypedSpec().MemAvailable = 1024
+	require.NoError(t, st.Create(ctx, memory))
+
+	apid := v1alpha1.NewService("apid")
+	apid.TypedSpec().Running = true
+	apid.TypedSpec().Healthy = true
+	require.NoError(t, st.Create(ctx, apid))
+
+	etcdService := v1alpha1.NewService("etcd")
+	etcdService.TypedSpec().Running = true
+	require.NoError(t, st.Create(ctx, etcdService))
+
+	member := etcd.NewMember(etcd.NamespaceName, etcd.LocalMemberID)
+	member.TypedSpec().MemberID = "abcdef"
+	require.NoError(t, st.Create(ctx, member))
+
+	volume := block.NewVolumeStatus(block.NamespaceName, "EPHEMERAL")
+	volume.TypedSpec().Phase = block.VolumePhaseReady
+	require.NoError(t, st.Create(ctx, volume))
+
+	peer := kubespan.NewPeerStatus(kubespan.NamespaceName, "peer1")
+	peer.TypedSpec().Label = "worker-1"
+	peer.TypedSpec().State = kubespan.PeerStateUp
+	peer.TypedSpec().ReceiveBytes = 100
+	peer.TypedSpec().TransmitBytes = 200
+	require.NoError(t, st.Create(ctx, peer))
+
+	collector := metrics.NewCollector(st)
+
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP talos_memory_bytes Memory usage by kind, as reported by /proc/meminfo.
+# TYPE talos_memory_bytes gauge
+talos_memory_bytes{kind="available"} 1.048576e+06
+talos_memory_bytes{kind="buffers"} 0
+talos_memory_bytes{kind="cached"} 0
+talos_memory_bytes{kind="swap_free"} 0
+talos_memory_bytes{kind="swap_total"} 0
+talos_memory_bytes{kind="total"} 2.097152e+06
+talos_memory_bytes{kind="used"} 0
+# HELP talos_service_healthy Whether the Talos service health check passes.
+# TYPE talos_service_healthy gauge
+talos_service_healthy{service="apid"} 1
+talos_service_healthy{service="etcd"} 0
+# HELP talos_etcd_member_healthy Whether the local etcd member is healthy.
+# TYPE talos_etcd_member_healthy gauge
+talos_etcd_member_healthy{member_id="abcdef"} 0
+# HELP talos_kubespan_peer_state Current state of the KubeSpan peer (1 for the active state).
+# TYPE talos_kubespan_peer_state gauge
+talos_kubespan_peer_state{label="worker-1",peer="peer1",state="down"} 0
+talos_kubespan_peer_state{label="worker-1",peer="peer1",state="unknown"} 0
+talos_kubespan_peer_state{label="worker-1",peer="peer1",state="up"} 1
+# HELP talos_kubespan_peer_receive_bytes_total Bytes received from the KubeSpan peer.
+# TYPE talos_kubespan_peer_receive_bytes_total counter
+talos_kubespan_peer_receive_bytes_total{label="worker-1",peer="peer1"} 100
+`),
+		"talos_memory_bytes",
+		"talos_service_healthy",
+		"talos_etcd_member_healthy",
+		"talos_kubespan_peer_state",
+		"talos_kubespan_peer_receive_bytes_total",
+	))
+
+	require.Equal(t, len(block.VolumePhaseValues()), testutil.CollectAndCount(collector, "talos_volume_phase"))
+}
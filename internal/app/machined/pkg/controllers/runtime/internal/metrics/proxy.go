@@ -0,0 +1,34 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+)
+
+// NewSocketProxy returns a handler which proxies scrapes to the metrics a Talos service
+// (e.g. apid) serves over a unix socket.
+//
+// If the service is not running, scrapes fail with 502 Bad Gateway.
+func NewSocketProxy(socketPath string, errorLog *log.Logger) http.Handler {
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.Out.URL.Scheme = "http"
+			r.Out.URL.Host = "localhost"
+			r.Out.URL.Path = "/metrics"
+			r.Out.URL.RawPath = ""
+		},
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+		ErrorLog: errorLog,
+	}
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metrics_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime/internal/metrics"
+)
+
+func TestSocketProxy(t *testing.T) {
+	t.Parallel()
+
+	socketPath := filepath.Join(t.TempDir(), "metrics.sock")
+
+	listener, err := (&net.ListenConfig{}).Listen(t.Context(), "unix", socketPath)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, "talos_apid_grpc_requests_total 1\n") //nolint:errcheck
+	})
+
+	srv := &httptest.Server{
+		Listener: listener,
+		Config:   &http.Server{Handler: mux},
+	}
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	proxy := metrics.NewSocketProxy(socketPath, nil)
+
+	rec := httptest.NewRecorder()
+	proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics/apid", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "talos_apid_grpc_requests_total 1\n", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	metrics.NewSocketProxy(filepath.Join(t.TempDir(), "missing.sock"), nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics/apid", nil))
+
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+}
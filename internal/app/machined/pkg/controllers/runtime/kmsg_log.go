@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-kmsg"
@@ -25,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
@@ -73,6 +75,25 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 				ID:        optional.Some(runtime.KmsgLogConfigID),
 				Kind:      controller.InputWeak,
 			},
+			// OTLP resource attributes
+			{
+				Namespace: network.NamespaceName,
+				Type:      network.HostnameStatusType,
+				ID:        optional.Some(network.HostnameID),
+				Kind:      controller.InputWeak,
+			},
+			{
+				Namespace: configres.NamespaceName,
+				Type:      configres.MachineTypeType,
+				ID:        optional.Some(configres.MachineTypeID),
+				Kind:      controller.InputWeak,
+			},
+			{
+				Namespace: runtime.NamespaceName,
+				Type:      runtime.BootIDType,
+				ID:        optional.Some(runtime.BootIDID),
+				Kind:      controller.InputWeak,
+			},
 		},
 	); err != nil {
 		return fmt.Errorf("error waiting for network: %w", err)
@@ -105,7 +126,7 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 			continue
 		}
 
-		if err = ctrl.deliverLogs(ctx, r, logger, kmsgCh, cfg.TypedSpec().Destinations); err != nil {
+		if err = ctrl.deliverLogs(ctx, r, logger, kmsgCh, cfg.TypedSpec()); err != nil {
 			return fmt.Errorf("error delivering logs: %w", err)
 		}
 
@@ -115,10 +136,21 @@ func (ctrl *KmsgLogDeliveryController) Run(ctx context.Context, r controller.Run
 
 type logConfig struct {
 	endpoint *url.URL
+	syslog   bool
+	tls      logTLSConfig
 }
 
 func (c logConfig) Format() string {
-	return constants.LoggingFormatJSONLines
+	if c.syslog {
+		return constants.LoggingFormatSyslog
+	}
+
+	switch c.endpoint.Scheme {
+	case "http", "https", "grpc", "grpcs":
+		return constants.LoggingFormatOTLP
+	default:
+		return constants.LoggingFormatJSONLines
+	}
 }
 
 func (c logConfig) Endpoint() *url.URL {
@@ -129,17 +161,72 @@ func (c logConfig) ExtraTags() map[string]string {
 	return nil
 }
 
+func (c logConfig) TLS() config.LoggingTLSConfig {
+	return c.tls
+}
+
+type logTLSConfig struct {
+	ca         []byte
+	clientCert []byte
+	clientKey  []byte
+}
+
+func (c logTLSConfig) CA() []byte {
+	return c.ca
+}
+
+func (c logTLSConfig) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	if len(c.clientCert) == 0 {
+		return nil
+	}
+
+	return &x509.PEMEncodedCertificateAndKey{
+		Crt: c.clientCert,
+		Key: c.clientKey,
+	}
+}
+
 //nolint:gocyclo
-func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r controller.Runtime, logger *zap.Logger, kmsgCh <-chan kmsg.Packet, destURLs []*url.URL) error {
+func (ctrl *KmsgLogDeliveryController) deliverLogs(ctx context.Context, r controller.Runtime, logger *zap.Logger, kmsgCh <-chan kmsg.Packet, spec *runtime.KmsgLogConfigSpec) error {
 	if ctrl.drainSub == nil {
 		ctrl.drainSub = ctrl.Drainer.Subscribe()
 	}
 
 	// initialize all log senders
-	destLogConfigs := xslices.Map(destURLs, func(u *url.URL) config.LoggingDestination {
+	destLogConfigs := xslices.Map(spec.Destinations, func(u *url.URL) config.LoggingDestination {
 		return logConfig{endpoint: u}
 	})
-	senders := xslices.Map(destLogConfigs, logging.NewJSONLines)
+
+	for _, dest := range spec.SyslogDestinations {
+		destLogConfigs = append(destLogConfigs, logConfig{
+			endpoint: dest.Endpoint,
+			syslog:   true,
+			tls: logTLSConfig{
+				ca:         dest.CA,
+				clientCert: dest.ClientCert,
+				clientKey:  dest.ClientKey,
+			},
+		})
+	}
+
+	resourceAttributes := logging.OTLPResourceAttributes(ctx, r)
+
+	senders := xslices.Map(destLogConfigs, func(dest config.LoggingDestination) machinedruntime.LogSender {
+		switch dest.Format() {
+		case constants.LoggingFormatOTLP:
+			return logging.NewOTLP(dest, logging.OTLPOptions{
+				BufferDir: logging.OTLPBufferDir("kernel", dest.Endpoint()),
+				ResourceAttributes: func() map[string]string {
+					return resourceAttributes
+				},
+				Logger: logger,
+			})
+		case constants.LoggingFormatSyslog:
+			return logging.NewSyslog(dest, logging.SyslogOptions{AppName: "kernel"})
+		default:
+			return logging.NewJSONLines(dest)
+		}
+	})
 
 	defer func() {
 		closeCtx, closeCtxCancel := context.WithTimeout(context.Background(), logCloseTimeout)
@@ -18,6 +18,7 @@ import (
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -66,7 +67,10 @@ func (ctrl *KmsgLogConfigController) Run(ctx context.Context, r controller.Runti
 		case <-r.EventCh():
 		}
 
-		var destinations []*url.URL
+		var (
+			destinations       []*url.URL
+			syslogDestinations []runtime.KmsgLogSyslogDestination
+		)
 
 		if ctrl.Cmdline != nil {
 			if val := ctrl.Cmdline.Get(constants.KernelParamLoggingKernel).First(); val != nil {
@@ -85,8 +89,20 @@ func (ctrl *KmsgLogConfigController) Run(ctx context.Context, r controller.Runti
 		}
 
 		if cfg != nil {
+			var urls []*url.URL
+
+			for _, dest := range cfg.Config().Runtime().KmsgLogDestinations() {
+				if dest.Format() == constants.LoggingFormatSyslog {
+					syslogDestinations = append(syslogDestinations, kmsgLogSyslogDestination(dest))
+
+					continue
+				}
+
+				urls = append(urls, dest.Endpoint())
+			}
+
 			// remove duplicate URLs in case same destination is specified in both machine config and kernel args
-			destinations = append(destinations, xslices.Filter(cfg.Config().Runtime().KmsgLogURLs(),
+			destinations = append(destinations, xslices.Filter(urls,
 				func(u *url.URL) bool {
 					return !slices.ContainsFunc(destinations, func(v *url.URL) bool {
 						return v.String() == u.String()
@@ -96,9 +112,10 @@ func (ctrl *KmsgLogConfigController) Run(ctx context.Context, r controller.Runti
 
 		r.StartTrackingOutputs()
 
-		if len(destinations) > 0 {
+		if len(destinations) > 0 || len(syslogDestinations) > 0 {
 			if err = safe.WriterModify(ctx, r, runtime.NewKmsgLogConfig(), func(cfg *runtime.KmsgLogConfig) error {
 				cfg.TypedSpec().Destinations = destinations
+				cfg.TypedSpec().SyslogDestinations = syslogDestinations
 
 				return nil
 			}); err != nil {
@@ -111,3 +128,20 @@ func (ctrl *KmsgLogConfigController) Run(ctx context.Context, r controller.Runti
 		}
 	}
 }
+
+func kmsgLogSyslogDestination(dest talosconfig.LoggingDestination) runtime.KmsgLogSyslogDestination {
+	result := runtime.KmsgLogSyslogDestination{
+		Endpoint: dest.Endpoint(),
+	}
+
+	if tlsConfig := dest.TLS(); tlsConfig != nil {
+		result.CA = tlsConfig.CA()
+
+		if identity := tlsConfig.ClientIdentity(); identity != nil {
+			result.ClientCert = identity.Crt
+			result.ClientKey = identity.Key
+		}
+	}
+
+	return result
+}
@@ -95,6 +95,53 @@ func (suite *KmsgLogConfigSuite) TestKmsgLogConfigCmdline() {
 		})
 }
 
+func (suite *KmsgLogConfigSuite) TestKmsgLogConfigSyslog() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.KmsgLogConfigController{}))
+
+	kmsgLogConfig1 := &runtimecfg.KmsgLogV1Alpha1{
+		MetaName: "json",
+		KmsgLogURL: meta.URL{
+			URL: must(url.Parse("tcp://10.0.0.2:4444")),
+		},
+	}
+
+	kmsgLogConfig2 := &runtimecfg.KmsgLogV1Alpha1{
+		MetaName: "syslog",
+		KmsgLogURL: meta.URL{
+			URL: must(url.Parse("tls://10.0.0.3:6514")),
+		},
+		KmsgLogFormat: constants.LoggingFormatSyslog,
+		KmsgLogTLS: &runtimecfg.KmsgLogTLSConfig{
+			TLSClientIdentity: &meta.CertificateAndKey{
+				Cert: "cert",
+				Key:  "key",
+			},
+		},
+	}
+
+	cfg, err := container.New(kmsgLogConfig1, kmsgLogConfig2)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	rtestutils.AssertResources[*runtime.KmsgLogConfig](suite.Ctx(), suite.T(), suite.State(), []resource.ID{runtime.KmsgLogConfigID},
+		func(cfg *runtime.KmsgLogConfig, asrt *assert.Assertions) {
+			asrt.Equal(
+				[]string{"tcp://10.0.0.2:4444"},
+				xslices.Map(cfg.TypedSpec().Destinations, func(u *url.URL) string { return u.String() }),
+			)
+
+			if asrt.Len(cfg.TypedSpec().SyslogDestinations, 1) {
+				dest := cfg.TypedSpec().SyslogDestinations[0]
+
+				asrt.Equal("tls://10.0.0.3:6514", dest.Endpoint.String())
+				asrt.Nil(dest.CA)
+				asrt.Equal([]byte("cert"), dest.ClientCert)
+				asrt.Equal([]byte("key"), dest.ClientKey)
+			}
+		})
+}
+
 func must[T any](t T, err error) T {
 	if err != nil {
 		panic(err)
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// MetricsConfigController generates configuration for the Prometheus metrics endpoint.
+type MetricsConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *MetricsConfigController) Name() string {
+	return "runtime.MetricsConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MetricsConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MetricsConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.MetricsConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MetricsConfigController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) (err error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil {
+			if metricsConfig := cfg.Config().MetricsConfig(); metricsConfig != nil {
+				if err = safe.WriterModify(ctx, r, runtime.NewMetricsConfig(), func(cfg *runtime.MetricsConfig) error {
+					cfg.TypedSpec().ListenAddress = metricsConfig.ListenAddress()
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating metrics config: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtime.MetricsConfig](ctx, r); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type MetricsConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestMetricsConfigSuite(t *testing.T) {
+	suite.Run(t, new(MetricsConfigSuite))
+}
+
+func (suite *MetricsConfigSuite) TestMetricsConfigNone() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.MetricsConfigController{}))
+
+	rtestutils.AssertNoResource[*runtime.MetricsConfig](suite.Ctx(), suite.T(), suite.State(), runtime.MetricsConfigID)
+}
+
+func (suite *MetricsConfigSuite) TestMetricsConfigMachineConfig() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.MetricsConfigController{}))
+
+	metricsConfig := runtimecfg.NewMetricsConfigV1Alpha1()
+
+	cfg, err := container.New(metricsConfig)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	rtestutils.AssertResources[*runtime.MetricsConfig](suite.Ctx(), suite.T(), suite.State(), []resource.ID{runtime.MetricsConfigID},
+		func(cfg *runtime.MetricsConfig, asrt *assert.Assertions) {
+			asrt.Equal(":50002", cfg.TypedSpec().ListenAddress)
+		})
+}
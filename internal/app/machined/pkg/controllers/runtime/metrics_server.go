@@ -0,0 +1,265 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime/internal/metrics"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// metricsRoles are the roles allowed to scrape metrics.
+var metricsRoles = role.MakeSet(role.Admin, role.Operator, role.Reader)
+
+// MetricsServerController serves Prometheus metrics over mutual TLS.
+//
+// The endpoint uses the Talos API certificates: the server certificate is the apid one,
+// and clients should present a certificate issued by the Talos machine CA with a role
+// allowed to read the node state.
+type MetricsServerController struct {
+	State state.State
+
+	certificates atomic.Pointer[metricsCertificates]
+}
+
+type metricsCertificates struct {
+	server    *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+type metricsServer struct {
+	address string
+	server  *http.Server
+	errCh   chan error
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MetricsServerController) Name() string {
+	return "runtime.MetricsServerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MetricsServerController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetricsConfigType,
+			ID:        optional.Some(runtime.MetricsConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.APIType,
+			ID:        optional.Some(secrets.APIID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MetricsServerController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *MetricsServerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.NewRegistry(ctrl.State), promhttp.HandlerOpts{
+		ErrorLog:          zap.NewStdLog(logger),
+		ErrorHandling:     promhttp.ContinueOnError,
+		EnableOpenMetrics: true,
+	}))
+	// apid runs as a separate process, its gRPC request metrics are scraped as a separate target
+	mux.Handle("/metrics/apid", metrics.NewSocketProxy(constants.APIMetricsSocketPath, zap.NewStdLog(logger)))
+
+	handler := ctrl.authorize(mux, logger)
+
+	var srv *metricsServer
+
+	stopServer := func() {
+		if srv == nil {
+			return
+		}
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+
+		if err := srv.server.Shutdown(shutdownCtx); err != nil {
+			logger.Error("error shutting down metrics server", zap.Error(err))
+		}
+
+		logger.Info("metrics server stopped", zap.String("address", srv.address))
+
+		srv = nil
+	}
+
+	defer stopServer()
+
+	for {
+		var serverErrCh <-chan error
+
+		if srv != nil {
+			serverErrCh = srv.errCh
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case err := <-serverErrCh:
+			srv = nil
+
+			return fmt.Errorf("metrics server failed: %w", err)
+		}
+
+		cfg, err := safe.ReaderGetByID[*runtime.MetricsConfig](ctx, r, runtime.MetricsConfigID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting metrics config: %w", err)
+		}
+
+		apiCerts, err := safe.ReaderGetByID[*secrets.API](ctx, r, secrets.APIID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting API certificates: %w", err)
+		}
+
+		if cfg == nil || apiCerts == nil {
+			stopServer()
+
+			continue
+		}
+
+		if err = ctrl.updateCertificates(apiCerts); err != nil {
+			return err
+		}
+
+		if srv != nil && srv.address != cfg.TypedSpec().ListenAddress {
+			stopServer()
+		}
+
+		if srv == nil {
+			if srv, err = ctrl.startServer(ctx, cfg.TypedSpec().ListenAddress, handler); err != nil {
+				return err
+			}
+
+			logger.Info("metrics server started", zap.String("address", srv.address))
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *MetricsServerController) updateCertificates(apiCerts *secrets.API) error {
+	spec := apiCerts.TypedSpec()
+
+	if spec.Server == nil {
+		return errors.New("API server certificate is missing")
+	}
+
+	serverCert, err := tls.X509KeyPair(spec.Server.Crt, spec.Server.Key)
+	if err != nil {
+		return fmt.Errorf("failed to parse server cert and key into a TLS Certificate: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+
+	for _, ca := range spec.AcceptedCAs {
+		if !clientCAs.AppendCertsFromPEM(ca.Crt) {
+			return errors.New("failed to parse CA certs into a CertPool")
+		}
+	}
+
+	ctrl.certificates.Store(&metricsCertificates{
+		server:    &serverCert,
+		clientCAs: clientCAs,
+	})
+
+	return nil
+}
+
+func (ctrl *MetricsServerController) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		// certificates are picked up for each new connection, so that rotated certificates
+		// take effect without restarting the listener
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certs := ctrl.certificates.Load()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS13,
+				Certificates: []tls.Certificate{*certs.server},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    certs.clientCAs,
+			}, nil
+		},
+	}
+}
+
+func (ctrl *MetricsServerController) startServer(ctx context.Context, address string, handler http.Handler) (*metricsServer, error) {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("error listening for metrics on %q: %w", address, err)
+	}
+
+	srv := &metricsServer{
+		address: address,
+		server: &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		errCh: make(chan error, 1),
+	}
+
+	go func() {
+		if err := srv.server.Serve(tls.NewListener(listener, ctrl.tlsConfig())); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			srv.errCh <- err
+		}
+	}()
+
+	return srv, nil
+}
+
+// authorize only allows scrapes from clients whose certificate carries a role which can read the node state.
+func (ctrl *MetricsServerController) authorize(next http.Handler, logger *zap.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+			return
+		}
+
+		roles, _ := role.Parse(req.TLS.PeerCertificates[0].Subject.Organization)
+
+		if !roles.IncludesAny(metricsRoles) {
+			logger.Debug("denied metrics scrape", zap.String("remote_addr", req.RemoteAddr), zap.Strings("roles", roles.Strings()))
+
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
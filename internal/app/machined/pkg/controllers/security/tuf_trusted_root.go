@@ -8,17 +8,13 @@ package security
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
-	"github.com/hashicorp/go-cleanhttp"
-	"github.com/sigstore/sigstore-go/pkg/tuf"
-	"github.com/theupdateframework/go-tuf/v2/metadata/fetcher"
 	"go.uber.org/zap"
 
-	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/internal/pkg/containers/image/verify"
 	"github.com/siderolabs/talos/pkg/machinery/resources/security"
 )
 
@@ -101,7 +97,7 @@ func (ctrl *TUFTrustedRootController) Run(ctx context.Context, r controller.Runt
 		r.StartTrackingOutputs()
 
 		if needsTUF {
-			tufData, err := ctrl.getTrustedRootTarget(security.TrustedRootID)
+			tufData, err := verify.FetchTUFTarget(security.TrustedRootID)
 			if err != nil {
 				return fmt.Errorf("failed to get TUF trusted root: %w", err)
 			}
@@ -132,28 +128,3 @@ func (ctrl *TUFTrustedRootController) Run(ctx context.Context, r controller.Runt
 		}
 	}
 }
-
-func (ctrl *TUFTrustedRootController) getTrustedRootTarget(id string) ([]byte, error) {
-	transport := httpdefaults.PatchTransport(cleanhttp.DefaultTransport())
-	httpClient := &http.Client{
-		Transport: transport,
-	}
-
-	fetcher := fetcher.NewDefaultFetcher()
-	fetcher.SetHTTPClient(httpClient)
-	fetcher.SetHTTPUserAgent(httpdefaults.UserAgent())
-
-	opts := tuf.Options{
-		Root:              tuf.DefaultRoot(),
-		RepositoryBaseURL: tuf.DefaultMirror,
-		DisableLocalCache: true,
-		Fetcher:           fetcher,
-	}
-
-	client, err := tuf.New(&opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create TUF client: %w", err)
-	}
-
-	return client.GetTarget(id)
-}
@@ -45,8 +45,10 @@ type LVMLogicalVolumeProvisioner interface {
 // LVMLogicalVolumeReconcileController creates logical volumes from
 // LVMLogicalVolumeSpec.
 //
-// Additive only: existing LVs are left alone, none are resized or removed.
-// Destructive ops go through the LVMService LV remove RPC.
+// Additive only: existing LVs are grown towards the desired size, but never
+// shrunk or removed. Destructive ops go through the LVMService LV remove RPC.
+//
+// Thin LVs are created once their thin pool shows up in the LV status scan.
 type LVMLogicalVolumeReconcileController struct {
 	V1Alpha1Mode machineruntime.Mode
 	LVM          LVMLogicalVolumeProvisioner
@@ -246,6 +248,13 @@ func (ctrl *LVMLogicalVolumeReconcileController) reconcileLV(
 		return ctrl.maybeResizeLV(ctx, logger, spec, vgSizeBytes[spec.VGName], observed)
 	}
 
+	if spec.Type == storage.LVMLogicalVolumeTypeThin {
+		if _, ok := lvObservedSize[lvID(spec.VGName+"/"+spec.ThinPool)]; !ok {
+			// thin pool not created yet; wait for a later event.
+			return "", nil
+		}
+	}
+
 	pvCount := pvCountByVG[spec.VGName]
 
 	mirrors, stripes, ok := resolveRAIDParams(spec, pvCount)
@@ -268,6 +277,7 @@ func (ctrl *LVMLogicalVolumeReconcileController) reconcileLV(
 		zap.Stringer("type", spec.Type),
 		zap.Uint32("mirrors", mirrors),
 		zap.Uint32("stripes", stripes),
+		zap.String("thin_pool", spec.ThinPool),
 	)
 
 	if err := ctrl.LVM.LVCreate(ctx, spec.VGName, spec.Name, lvm.LVCreateOptions{
@@ -276,6 +286,7 @@ func (ctrl *LVMLogicalVolumeReconcileController) reconcileLV(
 		Stripes:       stripes,
 		SizeBytes:     spec.SizeBytes,
 		SizePercentVG: spec.SizePercentVG,
+		ThinPool:      spec.ThinPool,
 	}); err != nil {
 		if errors.Is(err, exec.ErrNotFound) {
 			logger.Warn("lvm binary not found; skipping LVM provisioning")
@@ -302,7 +313,7 @@ func (ctrl *LVMLogicalVolumeReconcileController) reconcileLV(
 //nolint:gocyclo
 func resolveRAIDParams(spec *storage.LVMLogicalVolumeSpecSpec, pvCount int) (mirrors, stripes uint32, ok bool) {
 	switch spec.Type {
-	case storage.LVMLogicalVolumeTypeLinear:
+	case storage.LVMLogicalVolumeTypeLinear, storage.LVMLogicalVolumeTypeThinPool, storage.LVMLogicalVolumeTypeThin:
 		return 0, 0, true
 	case storage.LVMLogicalVolumeTypeRAID0:
 		stripes = spec.Stripes
@@ -348,7 +359,8 @@ const resizeSlackPercent = 1
 // caller can surface an LVMValidationError. Shrinking is never performed:
 // lvextend cannot shrink and doing so risks data loss.
 //
-// For absolute LVs the target is spec.SizeBytes. For percentage-sized LVs the
+// For absolute LVs the target is spec.SizeBytes (the virtual size for thin
+// LVs, so growing one doesn't allocate from the pool). For percentage-sized LVs the
 // target is derived from the current VG size (pct% of the VG; halved for raid1,
 // which stores two copies), so a shrink is also detected when the VG was
 // extended and the percentage subsequently lowered. The grow lvextend is issued
@@ -304,6 +304,36 @@ func (suite *LVMLogicalVolumeReconcileSuite) TestRaid0SkipsWhenTooFewPVs() {
 	suite.Assert().Zero(suite.provisioner.count())
 }
 
+func (suite *LVMLogicalVolumeReconcileSuite) TestThinWaitsForPool() {
+	suite.createVGStatus("vg-pool")
+
+	thin := storageres.NewLVMLogicalVolumeSpec(storageres.NamespaceName, "vg-pool-lv-thin")
+	thin.TypedSpec().VGName = "vg-pool"
+	thin.TypedSpec().Name = "lv-thin"
+	thin.TypedSpec().Type = storageres.LVMLogicalVolumeTypeThin
+	thin.TypedSpec().ThinPool = "lv-pool"
+	thin.TypedSpec().SizeBytes = 100 << 30
+	suite.Create(thin)
+
+	time.Sleep(250 * time.Millisecond)
+
+	suite.Assert().Zero(suite.provisioner.count())
+
+	// once the pool shows up, the thin LV is created from it.
+	suite.createLVStatus("vg-pool", "lv-pool")
+
+	suite.eventually(func() bool {
+		_, ok := suite.provisioner.get("vg-pool/lv-thin")
+
+		return ok
+	})
+
+	opts, _ := suite.provisioner.get("vg-pool/lv-thin")
+	suite.Assert().Equal("thin", opts.Type)
+	suite.Assert().Equal("lv-pool", opts.ThinPool)
+	suite.Assert().Equal(uint64(100<<30), opts.SizeBytes)
+}
+
 func (suite *LVMLogicalVolumeReconcileSuite) TestGrowsByBytes() {
 	suite.createVGStatus("vg-pool")
 	suite.createLVStatusSize("vg-pool", "lv-data", 1<<30) // observed 1GiB
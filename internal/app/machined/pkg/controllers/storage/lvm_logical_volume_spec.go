@@ -76,6 +76,7 @@ func (ctrl *LVMLogicalVolumeSpecController) Run(ctx context.Context, r controlle
 				sizePercentVG := doc.MaxSizePercentVG()
 				mirrors := doc.Mirrors()
 				stripes := doc.Stripes()
+				thinPool := doc.ThinPool()
 
 				if err := safe.WriterModify(
 					ctx, r,
@@ -89,6 +90,7 @@ func (ctrl *LVMLogicalVolumeSpecController) Run(ctx context.Context, r controlle
 						spec.SizePercentVG = sizePercentVG
 						spec.Mirrors = mirrors
 						spec.Stripes = stripes
+						spec.ThinPool = thinPool
 
 						return nil
 					},
@@ -46,6 +46,27 @@ func (suite *LVMLogicalVolumeSpecSuite) TestResolvesPercentSize() {
 	})
 }
 
+func (suite *LVMLogicalVolumeSpecSuite) TestThinPool() {
+	thin := newLVDoc("lv-thin", "vg-pool", storageres.LVMLogicalVolumeTypeThin, "200GiB")
+	thin.LVThinPool = "lv-pool"
+
+	applyMachineConfigDocs(&suite.DefaultSuite, newLVDoc("lv-pool", "vg-pool", storageres.LVMLogicalVolumeTypeThinPool, "90%"), thin)
+
+	ctest.AssertResource(suite, "vg-pool-lv-pool", func(lv *storageres.LVMLogicalVolumeSpec, asrt *assert.Assertions) {
+		spec := lv.TypedSpec()
+		asrt.Equal(storageres.LVMLogicalVolumeTypeThinPool, spec.Type)
+		asrt.Equal(uint32(90), spec.SizePercentVG)
+		asrt.Empty(spec.ThinPool)
+	})
+
+	ctest.AssertResource(suite, "vg-pool-lv-thin", func(lv *storageres.LVMLogicalVolumeSpec, asrt *assert.Assertions) {
+		spec := lv.TypedSpec()
+		asrt.Equal(storageres.LVMLogicalVolumeTypeThin, spec.Type)
+		asrt.Equal(uint64(200*1024*1024*1024), spec.SizeBytes)
+		asrt.Equal("lv-pool", spec.ThinPool)
+	})
+}
+
 func (suite *LVMLogicalVolumeSpecSuite) TestRemovingDocCleansSpec() {
 	cfg := applyMachineConfigDocs(&suite.DefaultSuite, newLVDoc("lv-data", "vg-pool", storageres.LVMLogicalVolumeTypeLinear, "50GiB"))
 
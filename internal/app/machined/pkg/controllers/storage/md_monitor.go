@@ -8,11 +8,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
 
 	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -28,9 +31,15 @@ const mdMonitorRestartBackoff = 30 * time.Second
 // MDMonitor is the mdadm monitor subset used by MDMonitorController.
 type MDMonitor interface {
 	Monitor(ctx context.Context, onEvent func(string)) error
+	Arrays() ([]string, error)
+	SyncStatusForDevice(device string) (md.SyncStatus, error)
 }
 
 // MDMonitorController runs mdadm monitor and emits MD refresh requests for each event.
+//
+// It also refreshes MDArraySyncStatus for every array known to the kernel on
+// each event, so sync progress follows mdadm's RebuildNN events, and mismatch
+// counts are picked up when a check or repair finishes.
 type MDMonitorController struct {
 	V1Alpha1Mode machineruntime.Mode
 	MD           MDMonitor
@@ -64,6 +73,10 @@ func (ctrl *MDMonitorController) Outputs() []controller.Output {
 			Type: storage.MDRefreshRequestType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: storage.MDArraySyncStatusType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -86,6 +99,10 @@ func (ctrl *MDMonitorController) Run(ctx context.Context, r controller.Runtime,
 		case <-time.After(mdMonitorRestartBackoff):
 		}
 
+		if err := ctrl.refreshSyncStatus(ctx, r); err != nil {
+			logger.Warn("failed to refresh MD sync status", zap.Error(err))
+		}
+
 		switch err := ctrl.runMonitor(ctx, r, logger); {
 		case err == nil:
 		case errors.Is(err, context.Canceled):
@@ -98,7 +115,7 @@ func (ctrl *MDMonitorController) Run(ctx context.Context, r controller.Runtime,
 	}
 }
 
-func (ctrl *MDMonitorController) runMonitor(ctx context.Context, r controller.Writer, logger *zap.Logger) error {
+func (ctrl *MDMonitorController) runMonitor(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger) error {
 	return ctrl.MD.Monitor(ctx, func(event string) {
 		if !strings.Contains(event, " event detected ") {
 			logger.Info("MD monitor message", zap.String("message", event))
@@ -111,9 +128,77 @@ func (ctrl *MDMonitorController) runMonitor(ctx context.Context, r controller.Wr
 		if err := bumpMDRefreshRequest(ctx, r); err != nil {
 			logger.Warn("failed to bump MD refresh request", zap.Error(err))
 		}
+
+		if err := ctrl.refreshSyncStatus(ctx, r); err != nil {
+			logger.Warn("failed to refresh MD sync status", zap.Error(err))
+		}
 	})
 }
 
+// refreshSyncStatus writes an MDArraySyncStatus for every array known to the
+// kernel and drops the ones for arrays which are gone.
+func (ctrl *MDMonitorController) refreshSyncStatus(ctx context.Context, r controller.ReaderWriter) error {
+	arrays, err := ctrl.MD.Arrays()
+	if err != nil {
+		return fmt.Errorf("list MD arrays: %w", err)
+	}
+
+	touched := make(map[resource.ID]struct{}, len(arrays))
+
+	for _, device := range arrays {
+		syncStatus, err := ctrl.MD.SyncStatusForDevice(device)
+		if err != nil {
+			if errors.Is(err, md.ErrNotFound) {
+				// array was stopped since the listing
+				continue
+			}
+
+			return fmt.Errorf("read MD sync status of %q: %w", device, err)
+		}
+
+		id := filepath.Base(device)
+
+		if err = safe.WriterModify(ctx, r, storage.NewMDArraySyncStatus(storage.NamespaceName, id), func(s *storage.MDArraySyncStatus) error {
+			spec := s.TypedSpec()
+
+			spec.Device = device
+			spec.Level = syncStatus.Level
+			spec.ArrayState = syncStatus.ArrayState
+			spec.SyncAction = string(syncStatus.SyncAction)
+			spec.SyncCompletedSectors = syncStatus.SyncCompleted
+			spec.SyncTotalSectors = syncStatus.SyncTotal
+			spec.SyncProgress = syncStatus.SyncProgress()
+			spec.MismatchCount = syncStatus.MismatchCount
+			spec.RaidDevices = syncStatus.RaidDevices
+			spec.DegradedDevices = syncStatus.DegradedDevices
+			spec.Degraded = syncStatus.DegradedDevices > 0
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("modify MDArraySyncStatus %q: %w", id, err)
+		}
+
+		touched[id] = struct{}{}
+	}
+
+	existing, err := safe.ReaderListAll[*storage.MDArraySyncStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("list MDArraySyncStatus: %w", err)
+	}
+
+	for res := range existing.All() {
+		if _, ok := touched[res.Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("destroy MDArraySyncStatus %q: %w", res.Metadata().ID(), err)
+		}
+	}
+
+	return nil
+}
+
 func bumpMDRefreshRequest(ctx context.Context, r controller.Writer) error {
 	if err := safe.WriterModify(
 		ctx,
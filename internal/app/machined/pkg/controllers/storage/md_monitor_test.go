@@ -14,6 +14,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	storagectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/storage"
+	"github.com/siderolabs/talos/internal/pkg/md"
 	storageres "github.com/siderolabs/talos/pkg/machinery/resources/storage"
 )
 
@@ -21,6 +22,7 @@ import (
 // context is canceled so the controller does not busy-loop.
 type fakeMDMonitor struct {
 	events []string
+	arrays map[string]md.SyncStatus
 }
 
 func (f *fakeMDMonitor) Monitor(ctx context.Context, onEvent func(string)) error {
@@ -33,6 +35,25 @@ func (f *fakeMDMonitor) Monitor(ctx context.Context, onEvent func(string)) error
 	return ctx.Err()
 }
 
+func (f *fakeMDMonitor) Arrays() ([]string, error) {
+	arrays := make([]string, 0, len(f.arrays))
+
+	for device := range f.arrays {
+		arrays = append(arrays, device)
+	}
+
+	return arrays, nil
+}
+
+func (f *fakeMDMonitor) SyncStatusForDevice(device string) (md.SyncStatus, error) {
+	status, ok := f.arrays[device]
+	if !ok {
+		return md.SyncStatus{}, md.ErrNotFound
+	}
+
+	return status, nil
+}
+
 type MDMonitorSuite struct {
 	ctest.DefaultSuite
 }
@@ -44,6 +65,18 @@ func (suite *MDMonitorSuite) TestBumpsRefreshOnDetectedEvent() {
 	})
 }
 
+func (suite *MDMonitorSuite) TestSyncStatus() {
+	ctest.AssertResource(suite, "md0", func(s *storageres.MDArraySyncStatus, asrt *assert.Assertions) {
+		asrt.Equal("/dev/md0", s.TypedSpec().Device)
+		asrt.Equal("raid1", s.TypedSpec().Level)
+		asrt.Equal("check", s.TypedSpec().SyncAction)
+		asrt.InDelta(25.0, s.TypedSpec().SyncProgress, 0.001)
+		asrt.Equal(uint64(128), s.TypedSpec().MismatchCount)
+		asrt.Equal(1, s.TypedSpec().DegradedDevices)
+		asrt.True(s.TypedSpec().Degraded)
+	})
+}
+
 func TestMDMonitorSuite(t *testing.T) {
 	t.Parallel()
 
@@ -52,6 +85,18 @@ func TestMDMonitorSuite(t *testing.T) {
 			"mdadm: NewArray event detected on md device /dev/md0",
 			"mdadm: monitoring started",
 		},
+		arrays: map[string]md.SyncStatus{
+			"/dev/md0": {
+				Level:           "raid1",
+				ArrayState:      "clean",
+				SyncAction:      md.SyncActionCheck,
+				SyncCompleted:   1024,
+				SyncTotal:       4096,
+				MismatchCount:   128,
+				RaidDevices:     2,
+				DegradedDevices: 1,
+			},
+		},
 	}
 
 	s := &MDMonitorSuite{}
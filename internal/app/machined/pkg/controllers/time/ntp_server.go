@@ -0,0 +1,233 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package time
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+
+	beevikntp "github.com/beevik/ntp"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/time"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// NTPServerController runs the built-in NTP server (and optionally the NTS-KE server).
+//
+// The state of the time source announced to the clients (stratum, leap indicator, etc.)
+// comes from the NTP client via time.NTPStatus; until the clock is synchronized, the server
+// announces the clock as unsynchronized.
+type NTPServerController struct {
+	certificate atomic.Pointer[tls.Certificate]
+}
+
+type ntpServer struct {
+	address    string
+	ntsAddress string
+
+	server   *ntp.Server
+	conn     net.PacketConn
+	listener net.Listener
+	errCh    chan error
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NTPServerController) Name() string {
+	return "time.NTPServerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NTPServerController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      time.NTPServerConfigType,
+			ID:        optional.Some(time.NTPServerConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      time.NTPStatusType,
+			ID:        optional.Some(time.NTPStatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NTPServerController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NTPServerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var srv *ntpServer
+
+	stopServer := func() {
+		if srv == nil {
+			return
+		}
+
+		srv.conn.Close() //nolint:errcheck
+
+		if srv.listener != nil {
+			srv.listener.Close() //nolint:errcheck
+		}
+
+		logger.Info("NTP server stopped", zap.String("address", srv.address), zap.String("nts_address", srv.ntsAddress))
+
+		srv = nil
+	}
+
+	defer stopServer()
+
+	for {
+		var serverErrCh <-chan error
+
+		if srv != nil {
+			serverErrCh = srv.errCh
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case err := <-serverErrCh:
+			stopServer()
+
+			return fmt.Errorf("NTP server failed: %w", err)
+		}
+
+		cfg, err := safe.ReaderGetByID[*time.NTPServerConfig](ctx, r, time.NTPServerConfigID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting NTP server config: %w", err)
+		}
+
+		ntpStatus, err := safe.ReaderGetByID[*time.NTPStatus](ctx, r, time.NTPStatusID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting NTP status: %w", err)
+		}
+
+		if cfg == nil {
+			stopServer()
+
+			continue
+		}
+
+		spec := cfg.TypedSpec()
+
+		if spec.NTSListenAddress != "" {
+			certificate, err := tls.X509KeyPair(spec.NTSCertificate, spec.NTSKey)
+			if err != nil {
+				return fmt.Errorf("failed to parse NTS server certificate and key: %w", err)
+			}
+
+			ctrl.certificate.Store(&certificate)
+		}
+
+		if srv != nil && (srv.address != spec.ListenAddress || srv.ntsAddress != spec.NTSListenAddress) {
+			stopServer()
+		}
+
+		if srv == nil {
+			if srv, err = ctrl.startServer(ctx, logger, spec.ListenAddress, spec.NTSListenAddress); err != nil {
+				return err
+			}
+
+			logger.Info("NTP server started", zap.String("address", srv.address), zap.String("nts_address", srv.ntsAddress))
+		}
+
+		var source ntp.SourceStatus
+
+		if ntpStatus != nil {
+			status := ntpStatus.TypedSpec()
+
+			source = ntp.SourceStatus{
+				Stratum:        uint8(status.Stratum),
+				Leap:           beevikntp.LeapIndicator(status.Leap),
+				ReferenceID:    status.ReferenceID,
+				ReferenceTime:  status.ReferenceTime,
+				RootDelay:      status.RootDelay,
+				RootDispersion: status.RootDispersion,
+			}
+		}
+
+		srv.server.SetSource(source)
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *NTPServerController) startServer(ctx context.Context, logger *zap.Logger, address, ntsAddress string) (*ntpServer, error) {
+	conn, err := (&net.ListenConfig{}).ListenPacket(ctx, "udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("error listening for NTP on %q: %w", address, err)
+	}
+
+	srv := &ntpServer{
+		address:    address,
+		ntsAddress: ntsAddress,
+		conn:       conn,
+		errCh:      make(chan error, 2),
+	}
+
+	var cookies *ntp.NTSCookieKeys
+
+	if ntsAddress != "" {
+		if cookies, err = ntp.NewNTSCookieKeys(); err != nil {
+			conn.Close() //nolint:errcheck
+
+			return nil, err
+		}
+
+		listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", ntsAddress)
+		if err != nil {
+			conn.Close() //nolint:errcheck
+
+			return nil, fmt.Errorf("error listening for NTS-KE on %q: %w", ntsAddress, err)
+		}
+
+		// certificate is picked up for each new connection, so that the updated certificate
+		// takes effect without restarting the listener
+		srv.listener = tls.NewListener(listener, ntp.TLSConfig(func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			certificate := ctrl.certificate.Load()
+			if certificate == nil {
+				return nil, errors.New("NTS server certificate is not available")
+			}
+
+			return certificate, nil
+		}))
+
+		ke := ntp.NewNTSKEServer(logger, cookies, conn.LocalAddr().(*net.UDPAddr).Port)
+
+		go func() {
+			if err := ke.Serve(srv.listener); err != nil {
+				srv.errCh <- err
+			}
+		}()
+	}
+
+	srv.server = ntp.NewServer(logger, cookies)
+
+	go func() {
+		if err := srv.server.Serve(conn); err != nil {
+			srv.errCh <- err
+		}
+	}()
+
+	return srv, nil
+}
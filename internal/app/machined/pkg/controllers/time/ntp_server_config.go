@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package time
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/time"
+)
+
+// NTPServerConfigController generates configuration for the built-in NTP server.
+type NTPServerConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NTPServerConfigController) Name() string {
+	return "time.NTPServerConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NTPServerConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NTPServerConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: time.NTPServerConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NTPServerConfigController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) (err error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil {
+			if ntpServerConfig := cfg.Config().NetworkNTPServerConfig(); ntpServerConfig != nil {
+				if err = safe.WriterModify(ctx, r, time.NewNTPServerConfig(), func(res *time.NTPServerConfig) error {
+					spec := res.TypedSpec()
+
+					*spec = time.NTPServerConfigSpec{
+						ListenAddress: ntpServerConfig.ListenAddress(),
+					}
+
+					if nts := ntpServerConfig.NTS(); nts != nil {
+						spec.NTSListenAddress = nts.ListenAddress()
+
+						if identity := nts.ServerIdentity(); identity != nil {
+							spec.NTSCertificate = identity.Crt
+							spec.NTSKey = identity.Key
+						}
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating NTP server config: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*time.NTPServerConfig](ctx, r); err != nil {
+			return err
+		}
+	}
+}
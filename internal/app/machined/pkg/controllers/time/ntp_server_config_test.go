@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package time_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	timectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/time"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
+)
+
+type NTPServerConfigSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestNTPServerConfigSuite(t *testing.T) {
+	suite.Run(t, new(NTPServerConfigSuite))
+}
+
+func (suite *NTPServerConfigSuite) TestNone() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&timectrl.NTPServerConfigController{}))
+
+	rtestutils.AssertNoResource[*timeresource.NTPServerConfig](suite.Ctx(), suite.T(), suite.State(), timeresource.NTPServerConfigID)
+}
+
+func (suite *NTPServerConfigSuite) TestMachineConfig() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&timectrl.NTPServerConfigController{}))
+
+	ctr, err := container.New(networkcfg.NewNTPServerConfigV1Alpha1())
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{timeresource.NTPServerConfigID},
+		func(res *timeresource.NTPServerConfig, asrt *assert.Assertions) {
+			asrt.Equal(timeresource.NTPServerConfigSpec{
+				ListenAddress: ":123",
+			}, *res.TypedSpec())
+		})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	rtestutils.AssertNoResource[*timeresource.NTPServerConfig](suite.Ctx(), suite.T(), suite.State(), timeresource.NTPServerConfigID)
+}
+
+func (suite *NTPServerConfigSuite) TestMachineConfigNTS() {
+	suite.Require().NoError(suite.Runtime().RegisterController(&timectrl.NTPServerConfigController{}))
+
+	ntpServerConfig := networkcfg.NewNTPServerConfigV1Alpha1()
+	ntpServerConfig.NTPListen = "10.0.0.1:123"
+	ntpServerConfig.NTPNTS = &networkcfg.NTPServerNTSConfig{
+		NTSServerIdentity: &meta.CertificateAndKey{
+			Cert: "cert",
+			Key:  "key",
+		},
+	}
+
+	ctr, err := container.New(ntpServerConfig)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(ctr)))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{timeresource.NTPServerConfigID},
+		func(res *timeresource.NTPServerConfig, asrt *assert.Assertions) {
+			asrt.Equal(timeresource.NTPServerConfigSpec{
+				ListenAddress:    "10.0.0.1:123",
+				NTSListenAddress: ":4460",
+				NTSCertificate:   []byte("cert"),
+				NTSKey:           []byte("key"),
+			}, *res.TypedSpec())
+		})
+}
@@ -66,6 +66,8 @@ type NTPSyncer interface {
 	EpochChange() <-chan struct{}
 	SpikeStatusChange() <-chan struct{}
 	SpikeStatus() ntp.SpikeStatus
+	SourceStatusChange() <-chan struct{}
+	SourceStatus() ntp.SourceStatus
 	SetTimeServers([]string)
 }
 
@@ -125,15 +127,17 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 		syncCtxCancel context.CancelFunc
 		syncWg        sync.WaitGroup
 
-		syncCh  <-chan struct{}
-		epochCh <-chan struct{}
-		spikeCh <-chan struct{}
-		syncer  NTPSyncer
+		syncCh   <-chan struct{}
+		epochCh  <-chan struct{}
+		spikeCh  <-chan struct{}
+		sourceCh <-chan struct{}
+		syncer   NTPSyncer
 
-		timeSynced  bool
-		epoch       int
-		useNTS      bool
-		spikeStatus ntp.SpikeStatus
+		timeSynced   bool
+		epoch        int
+		useNTS       bool
+		spikeStatus  ntp.SpikeStatus
+		sourceStatus ntp.SourceStatus
 
 		timeSyncTimeoutTimer *stdtime.Timer
 		timeSyncTimeoutCh    <-chan stdtime.Time
@@ -168,6 +172,8 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			epoch++
 		case <-spikeCh:
 			spikeStatus = syncer.SpikeStatus()
+		case <-sourceCh:
+			sourceStatus = syncer.SourceStatus()
 		case <-timeSyncTimeoutCh:
 			timeSynced = true
 			timeSyncTimeoutTimer = nil
@@ -259,7 +265,9 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			syncCh = nil
 			epochCh = nil
 			spikeCh = nil
+			sourceCh = nil
 			spikeStatus = ntp.SpikeStatus{}
+			sourceStatus = ntp.SourceStatus{}
 		case !syncDisabled && syncer != nil && newUseNTS != useNTS:
 			// NTS setting changed, restart the syncer
 			logger.Info("NTS setting changed, restarting syncer", zap.Bool("useNTS", newUseNTS))
@@ -274,9 +282,11 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			syncCh = syncer.Synced()
 			epochCh = syncer.EpochChange()
 			spikeCh = syncer.SpikeStatusChange()
+			sourceCh = syncer.SourceStatusChange()
 
 			timeSynced = false
 			spikeStatus = ntp.SpikeStatus{}
+			sourceStatus = ntp.SourceStatus{}
 
 			syncCtx, syncCtxCancel = context.WithCancel(ctx) //nolint:govet,fatcontext
 
@@ -291,9 +301,11 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			syncCh = syncer.Synced()
 			epochCh = syncer.EpochChange()
 			spikeCh = syncer.SpikeStatusChange()
+			sourceCh = syncer.SourceStatusChange()
 
 			timeSynced = false
 			spikeStatus = ntp.SpikeStatus{}
+			sourceStatus = ntp.SourceStatus{}
 
 			syncCtx, syncCtxCancel = context.WithCancel(ctx) //nolint:govet,fatcontext
 
@@ -317,6 +329,12 @@ func (ctrl *SyncController) Run(ctx context.Context, r controller.Runtime, logge
 			*r.TypedSpec() = time.NTPStatusSpec{
 				SpikeDetected:     spikeStatus.Detected,
 				ConsecutiveSpikes: spikeStatus.Consecutive,
+				Stratum:           int(sourceStatus.Stratum),
+				Leap:              int(sourceStatus.Leap),
+				ReferenceID:       sourceStatus.ReferenceID,
+				ReferenceTime:     sourceStatus.ReferenceTime,
+				RootDelay:         sourceStatus.RootDelay,
+				RootDispersion:    sourceStatus.RootDispersion,
 			}
 
 			return nil
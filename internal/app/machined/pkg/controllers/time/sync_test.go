@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	beevikntp "github.com/beevik/ntp"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/stretchr/testify/assert"
@@ -218,6 +219,27 @@ func (suite *SyncSuite) TestReconcileSyncChangeConfig() {
 
 	suite.assertNTPStatus(timeresource.NTPStatusSpec{})
 
+	// the time source state is reported via NTPStatus as well, for the NTP server
+	referenceTime := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	mockSyncer.reportSourceStatus(ntp.SourceStatus{
+		Stratum:        3,
+		Leap:           beevikntp.LeapAddSecond,
+		ReferenceID:    0x7f000001,
+		ReferenceTime:  referenceTime,
+		RootDelay:      20 * time.Millisecond,
+		RootDispersion: 10 * time.Millisecond,
+	})
+
+	suite.assertNTPStatus(timeresource.NTPStatusSpec{
+		Stratum:        3,
+		Leap:           int(beevikntp.LeapAddSecond),
+		ReferenceID:    0x7f000001,
+		ReferenceTime:  referenceTime,
+		RootDelay:      20 * time.Millisecond,
+		RootDispersion: 10 * time.Millisecond,
+	})
+
 	// bump the epoch to get a synchronization point: TimeStatus is guaranteed to be updated,
 	// so exactly one new version means the spike transitions above produced none of their own
 	mockSyncer.epochCh <- struct{}{}
@@ -360,6 +382,9 @@ type mockSyncer struct {
 	epochCh     chan struct{}
 	spikeCh     chan struct{}
 	spikeStatus ntp.SpikeStatus
+
+	sourceCh     chan struct{}
+	sourceStatus ntp.SourceStatus
 }
 
 func (mock *mockSyncer) Run(ctx context.Context) {
@@ -393,6 +418,25 @@ func (mock *mockSyncer) reportSpikeStatus(status ntp.SpikeStatus) {
 	mock.spikeCh <- struct{}{}
 }
 
+func (mock *mockSyncer) SourceStatusChange() <-chan struct{} {
+	return mock.sourceCh
+}
+
+func (mock *mockSyncer) SourceStatus() ntp.SourceStatus {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.sourceStatus
+}
+
+func (mock *mockSyncer) reportSourceStatus(status ntp.SourceStatus) {
+	mock.mu.Lock()
+	mock.sourceStatus = status
+	mock.mu.Unlock()
+
+	mock.sourceCh <- struct{}{}
+}
+
 func (mock *mockSyncer) getTimeServers() (servers []string) {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
@@ -421,5 +465,6 @@ func newMockSyncer(_ *zap.Logger, servers []string, useNTS bool) *mockSyncer {
 		syncedCh:    make(chan struct{}, 1),
 		epochCh:     make(chan struct{}, 1),
 		spikeCh:     make(chan struct{}, 1),
+		sourceCh:    make(chan struct{}, 1),
 	}
 }
@@ -64,3 +64,23 @@ func LoadOrNewFromFile[T any](root xfs.Root, path string, empty T, generate func
 
 	return f.Close()
 }
+
+// SaveToFile atomically replaces the contents of file.yaml with the value.
+func SaveToFile[T any](root xfs.Root, path string, value T) error {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error marshaling %q: %w", path, err)
+	}
+
+	tmpPath := path + ".tmp"
+
+	if err = xfs.WriteFile(root, tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("error writing state file %q: %w", tmpPath, err)
+	}
+
+	if err = xfs.Rename(root, tmpPath, path); err != nil {
+		return fmt.Errorf("error replacing state file %q: %w", path, err)
+	}
+
+	return nil
+}
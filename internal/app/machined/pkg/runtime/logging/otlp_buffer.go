@@ -0,0 +1,246 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// otlpBuffer is a queue of encoded OTLP export requests waiting for delivery.
+//
+// Up to memoryLimit batches are kept in memory, batches above that are spilled to files in dir,
+// dropping the oldest ones once the files exceed diskLimit bytes. Batches are persisted to disk
+// on shutdown as well, and picked up when the buffer is created again with the same dir.
+//
+// If dir is empty or not writable, the buffer is memory only, and the oldest batches are dropped on overflow.
+type otlpBuffer struct {
+	dir         string
+	memoryLimit int
+	diskLimit   int64
+
+	mu       sync.Mutex
+	memory   [][]byte
+	files    []otlpBufferFile
+	head     []byte // contents of files[0], if read by Peek
+	diskSize int64
+	nextSeq  uint64
+	dropped  int
+}
+
+type otlpBufferFile struct {
+	seq  uint64
+	size int64
+}
+
+func newOTLPBuffer(dir string, memoryLimit int, diskLimit int64) *otlpBuffer {
+	b := &otlpBuffer{
+		dir:         dir,
+		memoryLimit: memoryLimit,
+		diskLimit:   diskLimit,
+	}
+
+	b.load()
+
+	return b
+}
+
+// load picks up batches left over from the previous run.
+func (b *otlpBuffer) load() {
+	if b.dir == "" {
+		return
+	}
+
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		seq, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		b.files = append(b.files, otlpBufferFile{seq: seq, size: info.Size()})
+		b.diskSize += info.Size()
+		b.nextSeq = max(b.nextSeq, seq+1)
+	}
+
+	slices.SortFunc(b.files, func(a, b otlpBufferFile) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	b.trimDisk()
+}
+
+// Push appends a batch to the end of the queue.
+func (b *otlpBuffer) Push(batch []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// keep the order: once anything is spilled to disk, new batches go to disk as well
+	if len(b.files) == 0 && len(b.memory) < b.memoryLimit {
+		b.memory = append(b.memory, batch)
+
+		return
+	}
+
+	if b.spill(batch) == nil {
+		return
+	}
+
+	if len(b.memory) >= b.memoryLimit {
+		b.memory = b.memory[1:]
+		b.dropped++
+	}
+
+	b.memory = append(b.memory, batch)
+}
+
+// Peek returns the oldest batch in the queue.
+//
+// A batch read from disk stays there until it is removed with Pop, so that it survives a restart
+// if it couldn't be delivered.
+func (b *otlpBuffer) Peek() ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.memory) > 0 {
+		return b.memory[0], true
+	}
+
+	for b.head == nil && len(b.files) > 0 {
+		batch, err := os.ReadFile(b.path(b.files[0].seq))
+		if err != nil {
+			b.removeHeadFile()
+
+			continue
+		}
+
+		b.head = batch
+	}
+
+	if b.head == nil {
+		return nil, false
+	}
+
+	return b.head, true
+}
+
+// Pop removes the oldest batch from the queue.
+func (b *otlpBuffer) Pop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case len(b.memory) > 0:
+		b.memory = b.memory[1:]
+	case b.head != nil:
+		b.removeHeadFile()
+	}
+}
+
+// Len returns the number of queued batches.
+func (b *otlpBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.memory) + len(b.files)
+}
+
+// Dropped returns the number of batches dropped due to buffer overflow since the last call.
+func (b *otlpBuffer) Dropped() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	dropped := b.dropped
+	b.dropped = 0
+
+	return dropped
+}
+
+// Persist moves all in-memory batches to disk.
+//
+// Persisted batches are delivered after the batches already on disk,
+// log records carry timestamps, so the receiver can restore the order.
+func (b *otlpBuffer) Persist() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.dir == "" {
+		return nil
+	}
+
+	for len(b.memory) > 0 {
+		if err := b.spill(b.memory[0]); err != nil {
+			return err
+		}
+
+		b.memory = b.memory[1:]
+	}
+
+	return nil
+}
+
+func (b *otlpBuffer) spill(batch []byte) error {
+	if b.dir == "" {
+		return errors.New("disk buffer is disabled")
+	}
+
+	if err := os.MkdirAll(b.dir, 0o700); err != nil {
+		return err
+	}
+
+	seq := b.nextSeq
+	path := b.path(seq)
+
+	if err := os.WriteFile(path+".tmp", batch, 0o600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+
+	b.nextSeq++
+	b.files = append(b.files, otlpBufferFile{seq: seq, size: int64(len(batch))})
+	b.diskSize += int64(len(batch))
+
+	b.trimDisk()
+
+	return nil
+}
+
+func (b *otlpBuffer) trimDisk() {
+	for b.diskSize > b.diskLimit && len(b.files) > 1 {
+		b.removeHeadFile()
+		b.dropped++
+	}
+}
+
+// removeHeadFile removes the oldest batch on disk.
+func (b *otlpBuffer) removeHeadFile() {
+	os.Remove(b.path(b.files[0].seq)) //nolint:errcheck
+
+	b.diskSize -= b.files[0].size
+	b.files = b.files[1:]
+	b.head = nil
+}
+
+func (b *otlpBuffer) path(seq uint64) string {
+	return filepath.Join(b.dir, fmt.Sprintf("%020d", seq))
+}
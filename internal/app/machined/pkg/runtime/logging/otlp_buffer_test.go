@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOTLPBufferPeekKeepsSpilledBatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	b := newOTLPBuffer(dir, 1, 1<<20)
+	b.Push([]byte("first"))
+	b.Push([]byte("second")) // spilled to disk
+
+	batch, ok := b.Peek()
+	require.True(t, ok)
+	assert.Equal(t, []byte("first"), batch)
+
+	b.Pop()
+
+	// read from disk, but the export fails: no Pop
+	batch, ok = b.Peek()
+	require.True(t, ok)
+	assert.Equal(t, []byte("second"), batch)
+
+	// machined restarts
+	b = newOTLPBuffer(dir, 1, 1<<20)
+	assert.Equal(t, 1, b.Len())
+
+	batch, ok = b.Peek()
+	require.True(t, ok)
+	assert.Equal(t, []byte("second"), batch)
+
+	b.Pop()
+	assert.Equal(t, 0, b.Len())
+
+	_, ok = b.Peek()
+	assert.False(t, ok)
+
+	// the batch is gone from disk once popped
+	b = newOTLPBuffer(dir, 1, 1<<20)
+	assert.Equal(t, 0, b.Len())
+}
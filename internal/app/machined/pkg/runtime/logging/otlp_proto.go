@@ -0,0 +1,209 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// This file implements a minimal encoder for the OTLP logs export request
+// (opentelemetry/proto/collector/logs/v1/logs_service.proto).
+//
+// Only the subset of the schema Talos produces is covered, so that OTLP
+// doesn't pull in the whole OpenTelemetry SDK.
+
+// Field numbers of the OTLP messages.
+const (
+	otlpExportRequestResourceLogs = 1 // ExportLogsServiceRequest.resource_logs
+
+	otlpResourceLogsResource  = 1 // ResourceLogs.resource
+	otlpResourceLogsScopeLogs = 2 // ResourceLogs.scope_logs
+
+	otlpResourceAttributes = 1 // Resource.attributes
+
+	otlpScopeLogsScope      = 1 // ScopeLogs.scope
+	otlpScopeLogsLogRecords = 2 // ScopeLogs.log_records
+
+	otlpScopeName    = 1 // InstrumentationScope.name
+	otlpScopeVersion = 2 // InstrumentationScope.version
+
+	otlpLogRecordTimeUnixNano         = 1  // LogRecord.time_unix_nano
+	otlpLogRecordSeverityNumber       = 2  // LogRecord.severity_number
+	otlpLogRecordSeverityText         = 3  // LogRecord.severity_text
+	otlpLogRecordBody                 = 5  // LogRecord.body
+	otlpLogRecordAttributes           = 6  // LogRecord.attributes
+	otlpLogRecordObservedTimeUnixNano = 11 // LogRecord.observed_time_unix_nano
+
+	otlpKeyValueKey   = 1 // KeyValue.key
+	otlpKeyValueValue = 2 // KeyValue.value
+
+	otlpAnyValueString = 1 // AnyValue.string_value
+	otlpAnyValueBool   = 2 // AnyValue.bool_value
+	otlpAnyValueInt    = 3 // AnyValue.int_value
+	otlpAnyValueDouble = 4 // AnyValue.double_value
+	otlpAnyValueArray  = 5 // AnyValue.array_value
+	otlpAnyValueKVList = 6 // AnyValue.kvlist_value
+
+	otlpArrayValueValues  = 1 // ArrayValue.values
+	otlpKVListValueValues = 1 // KeyValueList.values
+)
+
+// otlpSeverityNumber maps zap levels to OTLP severity numbers.
+//
+// Panic and fatal levels are all mapped to the FATAL range, as they stop the process.
+func otlpSeverityNumber(level zapcore.Level) uint64 {
+	switch level {
+	case zapcore.DebugLevel:
+		return 5 // SEVERITY_NUMBER_DEBUG
+	case zapcore.InfoLevel:
+		return 9 // SEVERITY_NUMBER_INFO
+	case zapcore.WarnLevel:
+		return 13 // SEVERITY_NUMBER_WARN
+	case zapcore.ErrorLevel:
+		return 17 // SEVERITY_NUMBER_ERROR
+	case zapcore.DPanicLevel:
+		return 21 // SEVERITY_NUMBER_FATAL
+	case zapcore.PanicLevel:
+		return 22 // SEVERITY_NUMBER_FATAL2
+	case zapcore.FatalLevel:
+		return 23 // SEVERITY_NUMBER_FATAL3
+	default:
+		return 0 // SEVERITY_NUMBER_UNSPECIFIED
+	}
+}
+
+// otlpScope identifies the producer of the log records.
+type otlpScope struct {
+	Name    string
+	Version string
+}
+
+// encodeOTLPLogs encodes log events as a single ExportLogsServiceRequest.
+func encodeOTLPLogs(resource map[string]string, scope otlpScope, events []*runtime.LogEvent) []byte {
+	var resourceMsg []byte
+
+	for _, k := range slices.Sorted(maps.Keys(resource)) {
+		resourceMsg = appendMessage(resourceMsg, otlpResourceAttributes, appendKeyValue(nil, k, resource[k]))
+	}
+
+	var scopeMsg []byte
+
+	scopeMsg = appendString(scopeMsg, otlpScopeName, scope.Name)
+	scopeMsg = appendString(scopeMsg, otlpScopeVersion, scope.Version)
+
+	scopeLogs := appendMessage(nil, otlpScopeLogsScope, scopeMsg)
+
+	for _, e := range events {
+		scopeLogs = appendMessage(scopeLogs, otlpScopeLogsLogRecords, encodeOTLPLogRecord(e))
+	}
+
+	resourceLogs := appendMessage(nil, otlpResourceLogsResource, resourceMsg)
+	resourceLogs = appendMessage(resourceLogs, otlpResourceLogsScopeLogs, scopeLogs)
+
+	return appendMessage(nil, otlpExportRequestResourceLogs, resourceLogs)
+}
+
+func encodeOTLPLogRecord(e *runtime.LogEvent) []byte {
+	var b []byte
+
+	b = protowire.AppendTag(b, otlpLogRecordTimeUnixNano, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, uint64(e.Time.UnixNano()))
+
+	b = protowire.AppendTag(b, otlpLogRecordObservedTimeUnixNano, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, uint64(e.Time.UnixNano()))
+
+	b = protowire.AppendTag(b, otlpLogRecordSeverityNumber, protowire.VarintType)
+	b = protowire.AppendVarint(b, otlpSeverityNumber(e.Level))
+
+	b = appendString(b, otlpLogRecordSeverityText, e.Level.CapitalString())
+	b = appendMessage(b, otlpLogRecordBody, appendAnyValue(nil, e.Msg))
+
+	for _, k := range slices.Sorted(maps.Keys(e.Fields)) {
+		b = appendMessage(b, otlpLogRecordAttributes, appendKeyValue(nil, k, e.Fields[k]))
+	}
+
+	return b
+}
+
+func appendKeyValue(b []byte, key string, value any) []byte {
+	b = appendString(b, otlpKeyValueKey, key)
+
+	return appendMessage(b, otlpKeyValueValue, appendAnyValue(nil, value))
+}
+
+// appendAnyValue encodes values as produced by encoding/json, falling back to strings for other types.
+func appendAnyValue(b []byte, value any) []byte {
+	switch v := value.(type) {
+	case string:
+		b = protowire.AppendTag(b, otlpAnyValueString, protowire.BytesType)
+
+		return protowire.AppendString(b, v)
+	case bool:
+		b = protowire.AppendTag(b, otlpAnyValueBool, protowire.VarintType)
+
+		return protowire.AppendVarint(b, protowire.EncodeBool(v))
+	case int:
+		return appendInt(b, int64(v))
+	case int64:
+		return appendInt(b, v)
+	case uint64:
+		return appendInt(b, int64(v))
+	case float64:
+		b = protowire.AppendTag(b, otlpAnyValueDouble, protowire.Fixed64Type)
+
+		return protowire.AppendFixed64(b, math.Float64bits(v))
+	case []any:
+		var arr []byte
+
+		for _, item := range v {
+			arr = appendMessage(arr, otlpArrayValueValues, appendAnyValue(nil, item))
+		}
+
+		return appendMessage(b, otlpAnyValueArray, arr)
+	case map[string]any:
+		var kvs []byte
+
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			kvs = appendMessage(kvs, otlpKVListValueValues, appendKeyValue(nil, k, v[k]))
+		}
+
+		return appendMessage(b, otlpAnyValueKVList, kvs)
+	case nil:
+		// empty AnyValue
+		return b
+	default:
+		return appendString(b, otlpAnyValueString, fmt.Sprint(v))
+	}
+}
+
+func appendInt(b []byte, v int64) []byte {
+	b = protowire.AppendTag(b, otlpAnyValueInt, protowire.VarintType)
+
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+
+	return protowire.AppendString(b, s)
+}
+
+func appendMessage(b []byte, num protowire.Number, msg []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+
+	return protowire.AppendBytes(b, msg)
+}
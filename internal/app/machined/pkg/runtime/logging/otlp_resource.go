@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// OTLP resource attributes describing the node.
+const (
+	OTLPAttributeHostName    = "host.name"
+	OTLPAttributeMachineType = "talos.machine.type"
+	OTLPAttributeBootID      = "talos.boot.id"
+)
+
+// OTLPResourceAttributes reads the node identity attributes from the resources.
+//
+// Attributes for the resources which don't exist yet are omitted.
+func OTLPResourceAttributes(ctx context.Context, r controller.Reader) map[string]string {
+	attributes := map[string]string{}
+
+	if hostname, err := safe.ReaderGetByID[*network.HostnameStatus](ctx, r, network.HostnameID); err == nil {
+		attributes[OTLPAttributeHostName] = hostname.TypedSpec().Hostname
+	}
+
+	if machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID); err == nil {
+		attributes[OTLPAttributeMachineType] = machineType.MachineType().String()
+	}
+
+	if bootID, err := safe.ReaderGetByID[*runtime.BootID](ctx, r, runtime.BootIDID); err == nil {
+		attributes[OTLPAttributeBootID] = bootID.TypedSpec().BootID
+	}
+
+	return attributes
+}
+
+// OTLPBufferDir returns the directory to buffer undelivered logs from the source for the endpoint.
+func OTLPBufferDir(source string, endpoint *url.URL) string {
+	hash := sha256.Sum256([]byte(endpoint.String()))
+
+	return filepath.Join(constants.OTLPLogBufferPath, source+"-"+hex.EncodeToString(hash[:8]))
+}
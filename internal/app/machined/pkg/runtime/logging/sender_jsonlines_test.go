@@ -21,6 +21,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
@@ -117,6 +118,10 @@ func (l *loggingDestination) Format() string {
 	return constants.LoggingFormatJSONLines
 }
 
+func (l *loggingDestination) TLS() config.LoggingTLSConfig {
+	return nil
+}
+
 func TestSenderJSONLines(t *testing.T) { //nolint:tparallel
 	t.Parallel()
 
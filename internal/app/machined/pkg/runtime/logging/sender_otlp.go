@@ -0,0 +1,431 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+const (
+	otlpMaxBatchSize     = 512
+	otlpFlushInterval    = time.Second
+	otlpExportTimeout    = 10 * time.Second
+	otlpMinRetryInterval = time.Second
+	otlpMaxRetryInterval = 30 * time.Second
+
+	otlpMemoryBufferBatches = 64
+	otlpDiskBufferSize      = 32 * 1024 * 1024
+
+	otlpDefaultHTTPPath = "/v1/logs"
+	otlpGRPCExportPath  = "/opentelemetry.proto.collector.logs.v1.LogsService/Export"
+)
+
+// OTLPOptions configures the OTLP log sender.
+type OTLPOptions struct {
+	// ResourceAttributes returns the attributes describing the node (hostname, machine type, etc.).
+	//
+	// It is called for each batch, so that changes are picked up without re-creating the sender.
+	ResourceAttributes func() map[string]string
+
+	// BufferDir is the directory to keep undelivered batches in.
+	//
+	// If empty, batches are buffered in memory only.
+	BufferDir string
+
+	// Logger reports the batches which were dropped.
+	//
+	// If nil, dropped batches are not reported.
+	Logger *zap.Logger
+}
+
+type otlpSender struct {
+	exporter  otlpExporter
+	options   OTLPOptions
+	extraTags map[string]string
+	buffer    *otlpBuffer
+
+	mu      sync.Mutex
+	pending []*runtime.LogEvent
+	closed  bool
+
+	flushCh chan struct{}
+	doneCh  chan struct{}
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+
+	retryInterval time.Duration
+	nextAttempt   time.Time
+}
+
+// NewOTLP returns log sender that sends logs in batches to the OpenTelemetry collector
+// over OTLP/gRPC (grpc:// and grpcs:// endpoints) or OTLP/HTTP (http:// and https:// endpoints).
+//
+// Send never blocks on the network: events are batched and delivered in the background,
+// undelivered batches are retried with an exponential backoff.
+func NewOTLP(cfg config.LoggingDestination, opts OTLPOptions) runtime.LogSender {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	s := &otlpSender{
+		exporter:  newOTLPExporter(cfg.Endpoint()),
+		options:   opts,
+		extraTags: cfg.ExtraTags(),
+		buffer:    newOTLPBuffer(opts.BufferDir, otlpMemoryBufferBatches, otlpDiskBufferSize),
+
+		flushCh: make(chan struct{}, 1),
+		doneCh:  make(chan struct{}),
+
+		ctx:    ctx,
+		cancel: cancel,
+
+		retryInterval: otlpMinRetryInterval,
+	}
+
+	go s.run()
+
+	return s
+}
+
+// Send implements LogSender interface.
+func (s *otlpSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: sender is closed", runtime.ErrDontRetry)
+	}
+
+	s.pending = append(s.pending, e)
+
+	if len(s.pending) >= otlpMaxBatchSize {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+//
+// Close makes a last attempt to deliver the buffered batches,
+// what is left undelivered is persisted to the buffer directory.
+func (s *otlpSender) Close(ctx context.Context) error {
+	s.mu.Lock()
+
+	if s.closed {
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	s.closed = true
+
+	s.mu.Unlock()
+
+	// abort the in-flight export, the batch stays queued and is retried below
+	s.cancel()
+
+	select {
+	case <-s.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	s.batch()
+
+	for {
+		if err := s.export(ctx); err != nil || s.buffer.Len() == 0 {
+			break
+		}
+	}
+
+	return errors.Join(s.buffer.Persist(), s.exporter.Close())
+}
+
+func (s *otlpSender) run() {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.flushCh:
+		}
+
+		s.batch()
+
+		if time.Now().Before(s.nextAttempt) {
+			continue
+		}
+
+		s.deliver()
+
+		if dropped := s.buffer.Dropped(); dropped > 0 {
+			s.options.Logger.Warn("dropped OTLP log batches due to buffer overflow", zap.Int("batches", dropped))
+		}
+	}
+}
+
+// batch encodes pending events into export requests and queues them for delivery.
+func (s *otlpSender) batch() {
+	s.mu.Lock()
+	events := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	resource := maps.Clone(s.extraTags)
+	if resource == nil {
+		resource = map[string]string{}
+	}
+
+	if s.options.ResourceAttributes != nil {
+		maps.Copy(resource, s.options.ResourceAttributes())
+	}
+
+	scope := otlpScope{
+		Name:    version.Name,
+		Version: version.Tag,
+	}
+
+	for len(events) > 0 {
+		n := min(len(events), otlpMaxBatchSize)
+
+		s.buffer.Push(encodeOTLPLogs(resource, scope, events[:n]))
+
+		events = events[n:]
+	}
+}
+
+// deliver exports queued batches until the queue is empty or delivery fails.
+func (s *otlpSender) deliver() {
+	for s.buffer.Len() > 0 && s.ctx.Err() == nil {
+		ctx, cancel := context.WithTimeout(s.ctx, otlpExportTimeout)
+		err := s.export(ctx)
+
+		cancel()
+
+		if err != nil {
+			s.nextAttempt = time.Now().Add(s.retryInterval)
+			s.retryInterval = min(s.retryInterval*2, otlpMaxRetryInterval)
+
+			return
+		}
+
+		s.retryInterval = otlpMinRetryInterval
+	}
+}
+
+// export sends the oldest queued batch.
+//
+// The batch is removed from the queue on success or on a permanent failure.
+func (s *otlpSender) export(ctx context.Context) error {
+	batch, ok := s.buffer.Peek()
+	if !ok {
+		return nil
+	}
+
+	err := s.exporter.Export(ctx, batch)
+	if err == nil || errors.Is(err, runtime.ErrDontRetry) {
+		s.buffer.Pop()
+
+		if err != nil {
+			s.options.Logger.Warn("dropped OTLP log batch", zap.Error(err))
+		}
+
+		return nil
+	}
+
+	return err
+}
+
+// otlpExporter sends encoded ExportLogsServiceRequest messages.
+//
+// Errors which should not be retried are wrapped with runtime.ErrDontRetry.
+type otlpExporter interface {
+	Export(ctx context.Context, request []byte) error
+	Close() error
+}
+
+func newOTLPExporter(endpoint *url.URL) otlpExporter {
+	switch endpoint.Scheme {
+	case "grpc", "grpcs":
+		return &otlpGRPCExporter{endpoint: endpoint}
+	default:
+		return newOTLPHTTPExporter(endpoint)
+	}
+}
+
+type otlpHTTPExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newOTLPHTTPExporter(endpoint *url.URL) *otlpHTTPExporter {
+	u := *endpoint
+
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpDefaultHTTPPath
+	}
+
+	return &otlpHTTPExporter{
+		endpoint: u.String(),
+		client: &http.Client{
+			Transport: httpdefaults.PatchTransport(cleanhttp.DefaultPooledTransport()),
+		},
+	}
+}
+
+// Export implements otlpExporter interface.
+func (e *otlpHTTPExporter) Export(ctx context.Context, request []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(request))
+	if err != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", httpdefaults.UserAgent())
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		return fmt.Errorf("unexpected status %q", resp.Status)
+	default:
+		return fmt.Errorf("%w: unexpected status %q", runtime.ErrDontRetry, resp.Status)
+	}
+}
+
+// Close implements otlpExporter interface.
+func (e *otlpHTTPExporter) Close() error {
+	e.client.CloseIdleConnections()
+
+	return nil
+}
+
+type otlpGRPCExporter struct {
+	endpoint *url.URL
+	conn     *grpc.ClientConn
+}
+
+// Export implements otlpExporter interface.
+func (e *otlpGRPCExporter) Export(ctx context.Context, request []byte) error {
+	if e.conn == nil {
+		creds := insecure.NewCredentials()
+
+		if e.endpoint.Scheme == "grpcs" {
+			creds = credentials.NewTLS(httpdefaults.RootCAsTLSConfig())
+		}
+
+		conn, err := grpc.NewClient(e.endpoint.Host, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+		}
+
+		e.conn = conn
+	}
+
+	var response []byte
+
+	err := e.conn.Invoke(ctx, otlpGRPCExportPath, &request, &response, grpc.ForceCodec(otlpRawCodec{}))
+
+	switch status.Code(err) { //nolint:exhaustive
+	case codes.OK:
+		return nil
+	case codes.Canceled,
+		codes.DeadlineExceeded,
+		codes.Aborted,
+		codes.OutOfRange,
+		codes.Unavailable,
+		codes.DataLoss,
+		codes.ResourceExhausted:
+		return err
+	default:
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+	}
+}
+
+// Close implements otlpExporter interface.
+func (e *otlpGRPCExporter) Close() error {
+	if e.conn == nil {
+		return nil
+	}
+
+	return e.conn.Close()
+}
+
+// otlpRawCodec passes already encoded protobuf messages through.
+type otlpRawCodec struct{}
+
+func (otlpRawCodec) Marshal(v any) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+
+	return *b, nil
+}
+
+func (otlpRawCodec) Unmarshal(data []byte, v any) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+
+	*b = append((*b)[:0], data...)
+
+	return nil
+}
+
+func (otlpRawCodec) Name() string {
+	return "proto"
+}
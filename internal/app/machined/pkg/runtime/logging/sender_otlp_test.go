@@ -0,0 +1,325 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+type otlpRecord struct {
+	resource map[string]string
+	body     string
+	severity uint64
+}
+
+// protoFields returns the raw values of the length-delimited fields with the given number.
+func protoFields(t *testing.T, b []byte, num protowire.Number) [][]byte {
+	t.Helper()
+
+	var result [][]byte
+
+	for len(b) > 0 {
+		n, typ, l := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, l, 0)
+
+		b = b[l:]
+
+		if n == num && typ == protowire.BytesType {
+			v, vl := protowire.ConsumeBytes(b)
+			require.GreaterOrEqual(t, vl, 0)
+
+			result = append(result, v)
+		}
+
+		l = protowire.ConsumeFieldValue(n, typ, b)
+		require.GreaterOrEqual(t, l, 0)
+
+		b = b[l:]
+	}
+
+	return result
+}
+
+func protoVarint(t *testing.T, b []byte, num protowire.Number) uint64 {
+	t.Helper()
+
+	for len(b) > 0 {
+		n, typ, l := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, l, 0)
+
+		b = b[l:]
+
+		if n == num && typ == protowire.VarintType {
+			v, _ := protowire.ConsumeVarint(b)
+
+			return v
+		}
+
+		l = protowire.ConsumeFieldValue(n, typ, b)
+		require.GreaterOrEqual(t, l, 0)
+
+		b = b[l:]
+	}
+
+	return 0
+}
+
+// decodeOTLPRequest extracts log records from the ExportLogsServiceRequest.
+func decodeOTLPRequest(t *testing.T, b []byte) []otlpRecord {
+	t.Helper()
+
+	var records []otlpRecord
+
+	for _, resourceLogs := range protoFields(t, b, 1) {
+		resource := map[string]string{}
+
+		for _, res := range protoFields(t, resourceLogs, 1) {
+			for _, kv := range protoFields(t, res, 1) {
+				key := string(protoFields(t, kv, 1)[0])
+				value := string(protoFields(t, protoFields(t, kv, 2)[0], 1)[0])
+
+				resource[key] = value
+			}
+		}
+
+		for _, scopeLogs := range protoFields(t, resourceLogs, 2) {
+			for _, record := range protoFields(t, scopeLogs, 2) {
+				records = append(records, otlpRecord{
+					resource: resource,
+					body:     string(protoFields(t, protoFields(t, record, 5)[0], 1)[0]),
+					severity: protoVarint(t, record, 2),
+				})
+			}
+		}
+	}
+
+	return records
+}
+
+type otlpCollector struct {
+	mu       sync.Mutex
+	bodies   [][]byte
+	requests []*http.Request
+
+	unavailable atomic.Bool
+}
+
+func (c *otlpCollector) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if c.unavailable.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+
+		return
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests = append(c.requests, req)
+	c.bodies = append(c.bodies, body)
+}
+
+func (c *otlpCollector) Records(t *testing.T) []otlpRecord {
+	t.Helper()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var records []otlpRecord
+
+	for _, body := range c.bodies {
+		records = append(records, decodeOTLPRequest(t, body)...)
+	}
+
+	return records
+}
+
+func TestSenderOTLPHTTP(t *testing.T) {
+	t.Parallel()
+
+	collector := &otlpCollector{}
+
+	srv := httptest.NewServer(collector)
+	t.Cleanup(srv.Close)
+
+	sender := logging.NewOTLP(
+		&loggingDestination{
+			endpoint:  ensure.Value(url.Parse(srv.URL)),
+			extraTags: map[string]string{"cluster": "test"},
+		},
+		logging.OTLPOptions{
+			ResourceAttributes: func() map[string]string {
+				return map[string]string{"host.name": "node-1"}
+			},
+		},
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:    "msg1",
+		Time:   time.Now(),
+		Level:  zapcore.InfoLevel,
+		Fields: map[string]any{"service": "apid"},
+	}))
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "msg2",
+		Time:  time.Now(),
+		Level: zapcore.ErrorLevel,
+	}))
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Len(collect, collector.Records(t), 2)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, sender.Close(ctx))
+
+	resource := map[string]string{"cluster": "test", "host.name": "node-1"}
+
+	assert.Equal(t, []otlpRecord{
+		{resource: resource, body: "msg1", severity: 9},
+		{resource: resource, body: "msg2", severity: 17},
+	}, collector.Records(t))
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	assert.Equal(t, "/v1/logs", collector.requests[0].URL.Path)
+	assert.Equal(t, "application/x-protobuf", collector.requests[0].Header.Get("Content-Type"))
+
+	require.ErrorIs(t, sender.Send(ctx, &runtime.LogEvent{Msg: "msg3"}), runtime.ErrDontRetry)
+}
+
+func TestSenderOTLPBuffer(t *testing.T) {
+	t.Parallel()
+
+	collector := &otlpCollector{}
+	collector.unavailable.Store(true)
+
+	srv := httptest.NewServer(collector)
+	t.Cleanup(srv.Close)
+
+	bufferDir := t.TempDir()
+
+	dest := &loggingDestination{
+		endpoint: ensure.Value(url.Parse(srv.URL + "/custom/logs")),
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	sender := logging.NewOTLP(dest, logging.OTLPOptions{BufferDir: bufferDir})
+
+	for _, msg := range []string{"msg1", "msg2"} {
+		require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+			Msg:   msg,
+			Time:  time.Now(),
+			Level: zapcore.WarnLevel,
+		}))
+	}
+
+	// collector is unavailable, so the batch should be persisted on close
+	require.NoError(t, sender.Close(ctx))
+
+	entries, err := os.ReadDir(bufferDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	collector.unavailable.Store(false)
+
+	sender = logging.NewOTLP(dest, logging.OTLPOptions{BufferDir: bufferDir})
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "msg3",
+		Time:  time.Now(),
+		Level: zapcore.WarnLevel,
+	}))
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Len(collect, collector.Records(t), 3)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, sender.Close(ctx))
+
+	bodies := make([]string, 0, 3)
+
+	for _, record := range collector.Records(t) {
+		bodies = append(bodies, record.body)
+		assert.EqualValues(t, 13, record.severity)
+	}
+
+	assert.Equal(t, []string{"msg1", "msg2", "msg3"}, bodies)
+
+	entries, err = os.ReadDir(bufferDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	assert.Equal(t, "/custom/logs", collector.requests[0].URL.Path)
+}
+
+func TestSenderOTLPDropped(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(srv.Close)
+
+	core, logs := observer.New(zapcore.WarnLevel)
+
+	sender := logging.NewOTLP(
+		&loggingDestination{
+			endpoint: ensure.Value(url.Parse(srv.URL)),
+		},
+		logging.OTLPOptions{
+			Logger: zap.New(core),
+		},
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "msg1",
+		Time:  time.Now(),
+		Level: zapcore.InfoLevel,
+	}))
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, 1, logs.FilterMessage("dropped OTLP log batch").Len())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, sender.Close(ctx))
+}
@@ -0,0 +1,384 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+const (
+	// syslogSDID is the SD-ID of the structured data element carrying log fields and extra tags.
+	//
+	// Enterprise number 32473 is the one reserved for documentation (RFC 5612).
+	syslogSDID = "talos@32473"
+
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	syslogFacilityDaemon = 3
+)
+
+// syslogFacilities maps facility names (as reported by the kernel) to syslog facility codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SyslogOptions configures the syslog sender.
+type SyslogOptions struct {
+	// AppName is the APP-NAME for the events which don't come from a Talos service.
+	AppName string
+}
+
+type syslogSender struct {
+	endpoint  *url.URL
+	extraTags map[string]string
+	options   SyslogOptions
+
+	tlsConfig *tls.Config
+	tlsErr    error
+
+	sema     chan struct{}
+	conn     net.Conn
+	backoff  *backoff.ExponentialBackOff
+	nextDial time.Time
+}
+
+// NewSyslog returns log sender that sends logs as RFC 5424 syslog messages
+// over TCP (tcp:// endpoints) or TLS (tls:// endpoints), using octet-counted framing (RFC 6587).
+//
+// Talos service name is used as the APP-NAME, log fields and extra tags are sent as structured data.
+func NewSyslog(cfg config.LoggingDestination, opts SyslogOptions) runtime.LogSender {
+	sema := make(chan struct{}, 1)
+	sema <- struct{}{}
+
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = time.Second
+	bo.MaxInterval = 30 * time.Second
+
+	// disable number of retries limit
+	bo.MaxElapsedTime = 0
+
+	s := &syslogSender{
+		endpoint:  cfg.Endpoint(),
+		extraTags: cfg.ExtraTags(),
+		options:   opts,
+
+		sema:    sema,
+		backoff: bo,
+	}
+
+	if s.endpoint.Scheme == "tls" {
+		s.tlsConfig, s.tlsErr = syslogTLSConfig(cfg.TLS())
+	}
+
+	return s
+}
+
+func syslogTLSConfig(cfg config.LoggingTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		RootCAs:    httpdefaults.RootCAs(),
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg == nil {
+		return tlsConfig, nil
+	}
+
+	if ca := cfg.CA(); len(ca) > 0 {
+		tlsConfig.RootCAs = stdx509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed to parse CA certificate")
+		}
+	}
+
+	if identity := cfg.ClientIdentity(); identity != nil {
+		cert, err := tls.X509KeyPair(identity.Crt, identity.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (s *syslogSender) tryLock(ctx context.Context) (unlock func()) {
+	select {
+	case <-s.sema:
+		unlock = func() { s.sema <- struct{}{} }
+	case <-ctx.Done():
+		unlock = nil
+	}
+
+	return unlock
+}
+
+// Send implements LogSender interface.
+func (s *syslogSender) Send(ctx context.Context, e *runtime.LogEvent) error {
+	if s.tlsErr != nil {
+		return fmt.Errorf("%w: %s", runtime.ErrDontRetry, s.tlsErr)
+	}
+
+	hostname, _ := os.Hostname() //nolint:errcheck
+
+	msg := s.format(e, hostname)
+
+	frame := make([]byte, 0, len(msg)+8)
+	frame = strconv.AppendInt(frame, int64(len(msg)), 10)
+	frame = append(frame, ' ')
+	frame = append(frame, msg...)
+
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	d, _ := ctx.Deadline()
+	s.conn.SetWriteDeadline(d) //nolint:errcheck
+
+	// Close connection on send error.
+	if n, err := s.conn.Write(frame); err != nil {
+		s.conn.Close() //nolint:errcheck
+		s.conn = nil
+
+		// skip partially sent events, as the receiver drops the broken frame anyways
+		if n > 0 {
+			err = fmt.Errorf("%w: %s", runtime.ErrDontRetry, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Close implements LogSender interface.
+func (s *syslogSender) Close(ctx context.Context) error {
+	unlock := s.tryLock(ctx)
+	if unlock == nil {
+		return ctx.Err()
+	}
+
+	defer unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	conn := s.conn
+	s.conn = nil
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- conn.Close()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-closed:
+		return err
+	}
+}
+
+// connect establishes a connection, backing off after failed attempts.
+func (s *syslogSender) connect(ctx context.Context) error {
+	if wait := time.Until(s.nextDial); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if s.tlsConfig != nil {
+		conn, err = (&tls.Dialer{Config: s.tlsConfig}).DialContext(ctx, "tcp", s.endpoint.Host)
+	} else {
+		conn, err = new(net.Dialer).DialContext(ctx, "tcp", s.endpoint.Host)
+	}
+
+	if err != nil {
+		s.nextDial = time.Now().Add(s.backoff.NextBackOff())
+
+		return err
+	}
+
+	s.backoff.Reset()
+	s.conn = conn
+
+	return nil
+}
+
+// format builds RFC 5424 message for the event.
+func (s *syslogSender) format(e *runtime.LogEvent, hostname string) []byte {
+	var buf bytes.Buffer
+
+	facility := syslogFacilityDaemon
+
+	if name, ok := e.Fields["facility"].(string); ok {
+		if code, known := syslogFacilities[strings.ToLower(name)]; known {
+			facility = code
+		}
+	}
+
+	appName := s.options.AppName
+
+	if service, ok := e.Fields["talos-service"].(string); ok {
+		appName = service
+	}
+
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s - - ",
+		facility*8+syslogSeverity(e.Level),
+		e.Time.UTC().Format(syslogTimestampFormat),
+		syslogHeaderField(hostname, 255),
+		syslogHeaderField(appName, 48),
+	)
+
+	params := make(map[string]string, len(e.Fields)+len(s.extraTags))
+
+	for k, v := range e.Fields {
+		if k == "talos-service" {
+			continue
+		}
+
+		params[k] = fmt.Sprint(v)
+	}
+
+	maps.Copy(params, s.extraTags)
+
+	if len(params) == 0 {
+		buf.WriteByte('-')
+	} else {
+		buf.WriteString("[" + syslogSDID)
+
+		for _, k := range slices.Sorted(maps.Keys(params)) {
+			buf.WriteByte(' ')
+			buf.WriteString(syslogParamName(k))
+			buf.WriteString(`="`)
+			buf.WriteString(syslogParamValueEscaper.Replace(params[k]))
+			buf.WriteByte('"')
+		}
+
+		buf.WriteByte(']')
+	}
+
+	if e.Msg != "" {
+		buf.WriteByte(' ')
+		buf.WriteString(e.Msg)
+	}
+
+	return buf.Bytes()
+}
+
+// syslogSeverity maps zap levels to syslog severities.
+func syslogSeverity(level zapcore.Level) int {
+	switch level {
+	case zapcore.DebugLevel:
+		return 7
+	case zapcore.InfoLevel:
+		return 6
+	case zapcore.WarnLevel:
+		return 4
+	case zapcore.ErrorLevel:
+		return 3
+	case zapcore.DPanicLevel:
+		return 2
+	case zapcore.PanicLevel:
+		return 1
+	case zapcore.FatalLevel:
+		return 0
+	default:
+		return 5
+	}
+}
+
+var syslogParamValueEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`, `]`, `\]`)
+
+// syslogHeaderField sanitizes header field value: only printable US-ASCII is allowed, and NILVALUE is used for empty values.
+func syslogHeaderField(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	if s == "" {
+		return "-"
+	}
+
+	return s[:min(len(s), maxLen)]
+}
+
+// syslogParamName sanitizes SD PARAM-NAME.
+func syslogParamName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+
+		return r
+	}, s)
+
+	return s[:min(len(s), 32)]
+}
@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+)
+
+// readSyslogFrames reads octet-counted frames from the connections accepted on the listener.
+func readSyslogFrames(t *testing.T, lis net.Listener, frameCh chan<- string) {
+	t.Helper()
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close() //nolint:errcheck
+
+			r := bufio.NewReader(conn)
+
+			for {
+				length, err := r.ReadString(' ')
+				if err != nil {
+					return
+				}
+
+				n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+				if err != nil {
+					t.Logf("invalid frame length %q", length)
+
+					return
+				}
+
+				frame := make([]byte, n)
+
+				if _, err = io.ReadFull(r, frame); err != nil {
+					return
+				}
+
+				frameCh <- string(frame)
+			}
+		}()
+	}
+}
+
+func TestSenderSyslog(t *testing.T) {
+	t.Parallel()
+
+	lis, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, lis.Close())
+	})
+
+	frameCh := make(chan string, 10)
+
+	go readSyslogFrames(t, lis, frameCh)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	sender := logging.NewSyslog(
+		&loggingDestination{
+			endpoint:  ensure.Value(url.Parse("tcp://" + lis.Addr().String())),
+			extraTags: map[string]string{"cluster": "test"},
+		},
+		logging.SyslogOptions{AppName: "kernel"},
+	)
+
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "service started",
+		Time:  ts,
+		Level: zapcore.WarnLevel,
+		Fields: map[string]any{
+			"talos-service": "apid",
+			"pid":           42,
+			"quote":         `a "b" [c]`,
+		},
+	}))
+	require.NoError(t, sender.Send(ctx, &runtime.LogEvent{
+		Msg:   "link up",
+		Time:  ts,
+		Level: zapcore.InfoLevel,
+		Fields: map[string]any{
+			"facility": "kern",
+		},
+	}))
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	for _, expected := range []string{
+		`<28>1 2025-01-02T03:04:05.123456Z ` + hostname + ` apid - - [talos@32473 cluster="test" pid="42" quote="a \"b\" [c\]"] service started`,
+		`<6>1 2025-01-02T03:04:05.123456Z ` + hostname + ` kernel - - [talos@32473 cluster="test" facility="kern"] link up`,
+	} {
+		select {
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for message")
+		case frame := <-frameCh:
+			assert.Equal(t, expected, frame)
+		}
+	}
+
+	require.NoError(t, sender.Close(ctx))
+}
+
+func TestSenderSyslogReconnect(t *testing.T) {
+	t.Parallel()
+
+	// reserve a port and release it, so that the first attempt fails
+	lis, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := lis.Addr().String()
+
+	require.NoError(t, lis.Close())
+
+	sender := logging.NewSyslog(
+		&loggingDestination{
+			endpoint: ensure.Value(url.Parse("tcp://" + addr)),
+		},
+		logging.SyslogOptions{AppName: "talos"},
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	event := &runtime.LogEvent{
+		Msg:   "hello",
+		Time:  time.Now(),
+		Level: zapcore.InfoLevel,
+	}
+
+	require.Error(t, sender.Send(ctx, event))
+
+	lis, err = (&net.ListenConfig{}).Listen(t.Context(), "tcp", addr)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, lis.Close())
+	})
+
+	frameCh := make(chan string, 10)
+
+	go readSyslogFrames(t, lis, frameCh)
+
+	// next attempt waits for the backoff interval
+	start := time.Now()
+
+	require.NoError(t, sender.Send(ctx, event))
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for message")
+	case frame := <-frameCh:
+		assert.True(t, strings.HasSuffix(frame, " talos - - - hello"), frame)
+	}
+
+	require.NoError(t, sender.Close(ctx))
+}
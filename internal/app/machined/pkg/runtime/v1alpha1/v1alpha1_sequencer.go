@@ -254,7 +254,10 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 
 // Reboot is the reboot sequence.
 func (*Sequencer) Reboot(r runtime.Runtime, in *machineapi.RebootRequest) []runtime.Phase {
-	phases := PhaseList{}
+	phases := PhaseList{}.Append(
+		"bgpGracefulRestart",
+		PrepareBGPGracefulRestart,
+	)
 
 	if in.GetMode() != machineapi.RebootRequest_FORCE {
 		phases = phases.
@@ -395,6 +398,9 @@ func (*Sequencer) StageUpgrade(r runtime.Runtime, in *machineapi.UpgradeRequest)
 		return nil
 	default:
 		phases = phases.Append(
+			"bgpGracefulRestart",
+			PrepareBGPGracefulRestart,
+		).Append(
 			"cleanup",
 			StopAllPods,
 		).Append(
@@ -488,6 +494,9 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 		).Append(
 			"meta",
 			ReloadMeta,
+		).Append(
+			"bgpGracefulRestart",
+			PrepareBGPGracefulRestart,
 		).AppendWhen(
 			in.GetRebootMode() == machineapi.UpgradeRequest_DEFAULT,
 			"kexec",
@@ -1806,6 +1806,33 @@ func ReloadMeta(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	}, "reloadMeta"
 }
 
+// PrepareBGPGracefulRestart records the start of a BGP graceful restart in META.
+//
+// On the next boot BGP peers are re-established with the Restart State bit set, so that the neighbors
+// preserve the forwarding state of the node through the reboot or the upgrade.
+// The tag is not set on shutdown, as the node is not expected to come back.
+func PrepareBGPGracefulRestart(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		if r.Config() == nil {
+			return nil
+		}
+
+		if !slices.ContainsFunc(r.Config().NetworkBGPInstanceConfigs(), func(cfg configconfig.NetworkBGPInstanceConfig) bool {
+			return cfg.GracefulRestart() != nil
+		}) {
+			return nil
+		}
+
+		if _, err := r.State().Machine().Meta().SetTag(ctx, metamachinery.BGPGracefulRestart, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			return fmt.Errorf("failed to set BGP graceful restart META tag: %w", err)
+		}
+
+		logger.Printf("prepared BGP graceful restart")
+
+		return r.State().Machine().Meta().Flush()
+	}, "prepareBGPGracefulRestart"
+}
+
 // FlushMeta flushes META partition after install run.
 func FlushMeta(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
@@ -5,6 +5,7 @@
 package v1alpha2
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/url"
@@ -99,6 +100,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		return err
 	}
 
+	dnsQueryLogWriter, err := ctrl.loggingManager.ServiceLog(constants.HostDNSQueryLogServiceName).Writer()
+	if err != nil {
+		return err
+	}
+
 	etcFSOpts := []fsopen.Option{
 		fsopen.WithStringParameter("mode", "0755"),
 		fsopen.WithStringParameter("size", "8M"),
@@ -257,6 +263,12 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			State: ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
 		},
 		&containerctrls.InstanceController{},
+		&containerctrls.MountController{},
+		&containerctrls.NetworkController{},
+		&containerctrls.RuntimeController{
+			V1Alpha1Logging: ctrl.v1alpha1Runtime.Logging(),
+		},
+		&containerctrls.StatusController{},
 		&cri.CustomizationConfigController{},
 		cri.NewImageGCController("containerd", false),
 		cri.NewImageGCController("cri", true),
@@ -372,8 +384,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.AddressStatusController{},
 		&network.DeviceConfigController{},
 		&network.DNSResolveCacheController{
-			State:  ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
-			Logger: dnsCacheLogger,
+			State:       ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
+			Logger:      dnsCacheLogger,
+			QueryLogger: logging.Wrap(dnsQueryLogWriter),
 		},
 		&network.DNSUpstreamController{},
 		&network.EtcFileController{
@@ -531,6 +544,10 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&runtimecontrollers.MachineStatusPublisherController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
+		&runtimecontrollers.MetricsConfigController{},
+		&runtimecontrollers.MetricsServerController{
+			State: ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
+		},
 		&runtimecontrollers.MountStatusController{},
 		&runtimecontrollers.SBOMItemController{},
 		&runtimecontrollers.SecurityStateController{
@@ -576,6 +593,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&timecontrollers.AdjtimeStatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&timecontrollers.NTPServerConfigController{},
+		&timecontrollers.NTPServerController{},
 		&timecontrollers.SyncController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -600,6 +619,7 @@ type loggingDestination struct {
 	Format    string
 	Endpoint  *url.URL
 	ExtraTags map[string]string
+	TLS       talosconfig.LoggingTLSConfig
 }
 
 func (a *loggingDestination) Equal(b *loggingDestination) bool {
@@ -611,6 +631,10 @@ func (a *loggingDestination) Equal(b *loggingDestination) bool {
 		return false
 	}
 
+	if !loggingTLSEqual(a.TLS, b.TLS) {
+		return false
+	}
+
 	if len(a.ExtraTags) != len(b.ExtraTags) {
 		return false
 	}
@@ -624,6 +648,24 @@ func (a *loggingDestination) Equal(b *loggingDestination) bool {
 	return true
 }
 
+func loggingTLSEqual(a, b talosconfig.LoggingTLSConfig) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if !bytes.Equal(a.CA(), b.CA()) {
+		return false
+	}
+
+	identityA, identityB := a.ClientIdentity(), b.ClientIdentity()
+
+	if identityA == nil || identityB == nil {
+		return identityA == nil && identityB == nil
+	}
+
+	return bytes.Equal(identityA.Crt, identityB.Crt) && bytes.Equal(identityA.Key, identityB.Key)
+}
+
 func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 	watchCh := make(chan state.Event)
 
@@ -681,11 +723,12 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosco
 
 	for i, dest := range dests {
 		switch f := dest.Format(); f {
-		case constants.LoggingFormatJSONLines:
+		case constants.LoggingFormatJSONLines, constants.LoggingFormatOTLP, constants.LoggingFormatSyslog:
 			loggingDestinations[i] = loggingDestination{
 				Format:    f,
 				Endpoint:  dest.Endpoint(),
 				ExtraTags: dest.ExtraTags(),
+				TLS:       dest.TLS(),
 			}
 		default:
 			// should not be possible due to validation
@@ -713,12 +756,12 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosco
 	var prevSenders []runtime.LogSender
 
 	if len(loggingDestinations) > 0 {
-		senders := xslices.Map(dests, runtimelogging.NewJSONLines)
+		senders := xslices.Map(dests, ctrl.newLogSender)
 
-		ctrl.logger.Info("enabling JSON logging")
+		ctrl.logger.Info("enabling log delivery")
 		prevSenders = ctrl.loggingManager.SetSenders(senders)
 	} else {
-		ctrl.logger.Info("disabling JSON logging")
+		ctrl.logger.Info("disabling log delivery")
 		prevSenders = ctrl.loggingManager.SetSenders(nil)
 	}
 
@@ -737,6 +780,26 @@ func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosco
 	wg.Wait()
 }
 
+func (ctrl *Controller) newLogSender(dest talosconfig.LoggingDestination) runtime.LogSender {
+	switch dest.Format() {
+	case constants.LoggingFormatOTLP:
+		return runtimelogging.NewOTLP(dest, runtimelogging.OTLPOptions{
+			BufferDir: runtimelogging.OTLPBufferDir("services", dest.Endpoint()),
+			ResourceAttributes: func() map[string]string {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+
+				return runtimelogging.OTLPResourceAttributes(ctx, ctrl.v1alpha1Runtime.State().V1Alpha2().Resources())
+			},
+			Logger: ctrl.logger,
+		})
+	case constants.LoggingFormatSyslog:
+		return runtimelogging.NewSyslog(dest, runtimelogging.SyslogOptions{AppName: "talos"})
+	default:
+		return runtimelogging.NewJSONLines(dest)
+	}
+}
+
 // MakeLogger creates a logger for a service.
 func (ctrl *Controller) MakeLogger(serviceName string) (*zap.Logger, error) {
 	logWriter, err := ctrl.loggingManager.ServiceLog(serviceName).Writer()
@@ -123,6 +123,10 @@ func NewState() (*State, error) {
 		&containers.ContainerSpec{},
 		&containers.ContainerImageStatus{},
 		&containers.ContainerInstanceSpec{},
+		&containers.ContainerMountStatus{},
+		&containers.ContainerNetworkStatus{},
+		&containers.ContainerInstanceStatus{},
+		&containers.ContainerStatus{},
 		&block.FSScrubSchedule{},
 		&block.FSScrubStatus{},
 		&cluster.Affiliate{},
@@ -193,6 +197,8 @@ func NewState() (*State, error) {
 		&network.BGPInstanceConfig{},
 		&network.BGPPeerStatus{},
 		&network.DeviceConfigSpec{},
+		&network.DHCPOptions{},
+		&network.DelegatedPrefix{},
 		&network.DNSResolveCache{},
 		&network.DNSUpstream{},
 		&network.EthernetSpec{},
@@ -206,6 +212,7 @@ func NewState() (*State, error) {
 		&network.LinkStatus{},
 		&network.LinkSpec{},
 		&network.NfTablesChain{},
+		&network.NfTablesChainStatus{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.NodeAddressSortAlgorithm{},
@@ -254,6 +261,7 @@ func NewState() (*State, error) {
 		&runtime.MachineStatus{},
 		&runtime.MetaKey{},
 		&runtime.MetaLoaded{},
+		&runtime.MetricsConfig{},
 		&runtime.MountStatus{},
 		&runtime.OOMAction{},
 		&runtime.PlatformMetadata{},
@@ -292,8 +300,10 @@ func NewState() (*State, error) {
 		&storage.LVMVolumeGroupStatus{},
 		&storage.MDArraySpec{},
 		&storage.MDArrayStatus{},
+		&storage.MDArraySyncStatus{},
 		&storage.MDRefreshRequest{},
 		&time.AdjtimeStatus{},
+		&time.NTPServerConfig{},
 		&time.NTPStatus{},
 		&time.Status{},
 		&v1alpha1.AcquireConfigSpec{},
@@ -95,6 +95,15 @@ func (o *APID) PreFunc(ctx context.Context, r runtime.Runtime) error {
 		return err
 	}
 
+	// apid creates the metrics socket itself, so give it a directory it can write to
+	if err := os.MkdirAll(filepath.Dir(constants.APIMetricsSocketPath), 0o700); err != nil {
+		return err
+	}
+
+	if err := os.Chown(filepath.Dir(constants.APIMetricsSocketPath), constants.ApidUserID, constants.ApidUserID); err != nil {
+		return err
+	}
+
 	// clean up the socket if it already exists (important for Talos in a container)
 	if err := os.RemoveAll(constants.APIRuntimeSocketPath); err != nil {
 		return err
@@ -142,11 +142,42 @@ var rules = map[string]role.Set{
 		// for maintenance only, verified in the handler
 		role.Reader,
 	),
+	"/machine.LVMService/LogicalVolumeSnapshotCreate": role.MakeSet(
+		role.Admin,
+		// for maintenance only, verified in the handler
+		role.Reader,
+	),
+	"/machine.LVMService/LogicalVolumeSnapshotList": role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.LVMService/LogicalVolumeSnapshotRollback": role.MakeSet(
+		role.Admin,
+		// for maintenance only, verified in the handler
+		role.Reader,
+	),
 	"/machine.MDService/Destroy": role.MakeSet(
 		role.Admin,
 		// for maintenance only, verified in the handler
 		role.Reader,
 	),
+	"/machine.MDService/AddSpare": role.MakeSet(
+		role.Admin,
+		// for maintenance only, verified in the handler
+		role.Reader,
+	),
+	"/machine.MDService/ReplaceMember": role.MakeSet(
+		role.Admin,
+		// for maintenance only, verified in the handler
+		role.Reader,
+	),
+	"/machine.MDService/FailMember": role.MakeSet(
+		role.Admin,
+		// for maintenance only, verified in the handler
+		role.Reader,
+	),
+	"/machine.MDService/SetSyncAction": role.MakeSet(
+		role.Admin,
+		// for maintenance only, verified in the handler
+		role.Reader,
+	),
 
 	"/time.TimeService/Time":      role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/time.TimeService/TimeCheck": role.MakeSet(role.Admin, role.Operator, role.Reader),
@@ -4,14 +4,15 @@
 
 // Package mdd implements machine.MDService.
 //
-// Only Destroy is wired up (exposed via "talosctl wipe md"); Create/Extend/
-// Shrink are left to the embedded UnimplementedMDServiceServer until the
-// bootable-mirror provisioning path lands.
+// Destroy is exposed via "talosctl wipe md", member maintenance and sync
+// actions via "talosctl md". Arrays themselves are created declaratively from
+// RAIDArrayConfig.
 package mdd
 
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"strings"
 	"sync"
 
@@ -85,6 +86,8 @@ func mdStatus(err error) error {
 		return status.Error(codes.AlreadyExists, md.ErrExists.Error())
 	case errors.Is(err, md.ErrInvalidArgument):
 		return status.Error(codes.InvalidArgument, md.ErrInvalidArgument.Error())
+	case errors.Is(err, md.ErrResync):
+		return status.Error(codes.FailedPrecondition, md.ErrResync.Error())
 	default:
 		return status.Error(codes.Internal, "md operation failed")
 	}
@@ -111,19 +114,29 @@ func (svc *Service) logFailure(op string, fields []zap.Field, err error) {
 	svc.logger.Error("md operation failed", all...)
 }
 
-// Destroy stops the array and clears member superblocks.
-func (svc *Service) Destroy(ctx context.Context, req *machine.MDDestroyRequest) (*emptypb.Empty, error) {
-	if err := svc.authorize(ctx); err != nil {
-		return nil, err
-	}
-
-	device := req.GetDevice()
+// validateDevice checks that the named device argument is a full /dev path.
+func validateDevice(name, device string) error {
 	if device == "" {
-		return nil, status.Error(codes.InvalidArgument, "device must be set")
+		return status.Errorf(codes.InvalidArgument, "%s must be set", name)
 	}
 
 	if !strings.HasPrefix(device, "/dev/") {
-		return nil, status.Error(codes.InvalidArgument, "device must be a full /dev path")
+		return status.Errorf(codes.InvalidArgument, "%s must be a full /dev path", name)
+	}
+
+	return nil
+}
+
+// invoke is the shared skeleton for the MDService RPCs: authorize, validate,
+// lazily init the MD instance, then run the per-RPC action with structured
+// logging and error normalization.
+func (svc *Service) invoke(ctx context.Context, op, msg string, fields []zap.Field, validate func() error, action func(*md.MD) error) (*emptypb.Empty, error) {
+	if err := svc.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := validate(); err != nil {
+		return nil, err
 	}
 
 	mdInst, err := svc.mdInstance()
@@ -131,15 +144,138 @@ func (svc *Service) Destroy(ctx context.Context, req *machine.MDDestroyRequest)
 		return nil, status.Errorf(codes.Internal, "failed to initialize mdadm: %v", err)
 	}
 
-	fields := []zap.Field{zap.String("device", device)}
-
-	svc.logger.Info("destroying MD array", fields...)
+	svc.logger.Info(msg, fields...)
 
-	if err := mdInst.Destroy(ctx, device); err != nil {
-		svc.logFailure("destroy", fields, err)
+	if err := action(mdInst); err != nil {
+		svc.logFailure(op, fields, err)
 
 		return nil, mdStatus(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// Destroy stops the array and clears member superblocks.
+func (svc *Service) Destroy(ctx context.Context, req *machine.MDDestroyRequest) (*emptypb.Empty, error) {
+	device := req.GetDevice()
+
+	return svc.invoke(
+		ctx, "destroy", "destroying MD array",
+		[]zap.Field{zap.String("device", device)},
+		func() error { return validateDevice("device", device) },
+		func(m *md.MD) error { return m.Destroy(ctx, device) },
+	)
+}
+
+// AddSpare adds a device to the array as a spare.
+func (svc *Service) AddSpare(ctx context.Context, req *machine.MDAddSpareRequest) (*emptypb.Empty, error) {
+	device, member := req.GetDevice(), req.GetMember()
+
+	return svc.invoke(
+		ctx, "add", "adding spare to MD array",
+		[]zap.Field{zap.String("device", device), zap.String("member", member)},
+		func() error {
+			if err := validateDevice("device", device); err != nil {
+				return err
+			}
+
+			return validateDevice("member", member)
+		},
+		func(m *md.MD) error { return m.Add(ctx, device, member) },
+	)
+}
+
+// ReplaceMember hot-replaces an active member with a new device.
+func (svc *Service) ReplaceMember(ctx context.Context, req *machine.MDReplaceMemberRequest) (*emptypb.Empty, error) {
+	device, member, replacement := req.GetDevice(), req.GetMember(), req.GetReplacement()
+
+	return svc.invoke(
+		ctx, "replace", "replacing MD array member",
+		[]zap.Field{zap.String("device", device), zap.String("member", member), zap.String("replacement", replacement)},
+		func() error {
+			if err := validateDevice("device", device); err != nil {
+				return err
+			}
+
+			if err := validateDevice("member", member); err != nil {
+				return err
+			}
+
+			if err := validateDevice("replacement", replacement); err != nil {
+				return err
+			}
+
+			if member == replacement {
+				return status.Error(codes.InvalidArgument, "member and replacement must differ")
+			}
+
+			return nil
+		},
+		func(m *md.MD) error { return m.Replace(ctx, device, member, replacement) },
+	)
+}
+
+// FailMember marks a member faulty, optionally removing it from the array.
+func (svc *Service) FailMember(ctx context.Context, req *machine.MDFailMemberRequest) (*emptypb.Empty, error) {
+	device, member, remove := req.GetDevice(), req.GetMember(), req.GetRemove()
+
+	return svc.invoke(
+		ctx, "fail", "failing MD array member",
+		[]zap.Field{zap.String("device", device), zap.String("member", member), zap.Bool("remove", remove)},
+		func() error {
+			if err := validateDevice("device", device); err != nil {
+				return err
+			}
+
+			return validateDevice("member", member)
+		},
+		func(m *md.MD) error {
+			if err := m.Fail(ctx, device, member); err != nil {
+				return err
+			}
+
+			if !remove {
+				return nil
+			}
+
+			return m.Remove(ctx, device, member)
+		},
+	)
+}
+
+// SetSyncAction starts or aborts a check/repair of the array.
+func (svc *Service) SetSyncAction(ctx context.Context, req *machine.MDSetSyncActionRequest) (*emptypb.Empty, error) {
+	device := req.GetDevice()
+
+	var action md.SyncAction
+
+	switch req.GetAction() {
+	case machine.MDSetSyncActionRequest_CHECK:
+		action = md.SyncActionCheck
+	case machine.MDSetSyncActionRequest_REPAIR:
+		action = md.SyncActionRepair
+	case machine.MDSetSyncActionRequest_IDLE:
+		action = md.SyncActionIdle
+	}
+
+	return svc.invoke(
+		ctx, "sync_action", "setting MD array sync action",
+		[]zap.Field{zap.String("device", device), zap.String("action", string(action))},
+		func() error {
+			if action == "" {
+				return status.Errorf(codes.InvalidArgument, "unsupported sync action %v", req.GetAction())
+			}
+
+			return validateDevice("device", device)
+		},
+		func(m *md.MD) error {
+			// sysfs is keyed by the kernel name, resolve by-id and similar links first
+			node, err := filepath.EvalSymlinks(device)
+			if err != nil {
+				return md.ErrNotFound
+			}
+
+			return m.SetSyncAction(node, action)
+		},
+	)
+}
@@ -25,6 +25,7 @@ type PullOption func(*PullOptions)
 // PullOptions configure Pull function.
 type PullOptions struct {
 	SkipIfAlreadyPulled bool
+	VerifyAlreadyPulled bool
 	MaxNotFoundRetries  int
 	NewProgressReporter NewProgressReporter
 	LogWriter           io.Writer
@@ -46,6 +47,16 @@ func WithSkipIfAlreadyPulled() PullOption {
 	}
 }
 
+// WithVerifyAlreadyPulled checks an image skipped by WithSkipIfAlreadyPulled against the image verification rules.
+//
+// Without it, an image already in the store is used as is, even if the rules have changed since it was pulled,
+// or it never went through them at all (e.g. it was imported).
+func WithVerifyAlreadyPulled() PullOption {
+	return func(opts *PullOptions) {
+		opts.VerifyAlreadyPulled = true
+	}
+}
+
 // WithMaxNotFoundRetries sets the maximum number of retries for not found errors.
 //
 // This option is only honored in the PullWithRetriesAndTimeout function,
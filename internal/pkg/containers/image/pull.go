@@ -8,9 +8,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 
 	containerd "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/core/images"
+	"github.com/containerd/containerd/v2/core/remotes"
 	"github.com/containerd/containerd/v2/pkg/snapshotters"
 	"github.com/containerd/errdefs"
 	"github.com/containerd/log"
@@ -141,6 +145,12 @@ func pullInternal(
 
 			unpacked, err = img.IsUnpacked(ctx, "")
 			if err == nil && unpacked {
+				if opts.VerifyAlreadyPulled {
+					if err = VerifyPulled(ctx, registryBuilder, resources, img); err != nil {
+						return nil, err
+					}
+				}
+
 				if err = manageAliases(ctx, client, namedRef, img); err == nil {
 					return img, nil
 				}
@@ -166,18 +176,9 @@ func pullInternal(
 	resolver := NewResolver(registriesConfig)
 	tagFetcher := NewTagFetcher(registriesConfig)
 
-	verifyResult, err := verify.ImageSignature(ctx, zap.NewNop(), resources, resolver, tagFetcher, ref)
+	verifyResult, err := verifyImage(ctx, resources, resolver, tagFetcher, ref)
 	if err != nil {
-		switch status.Code(err) { //nolint:exhaustive
-		case codes.PermissionDenied:
-			// verification denied by matched rule, no need to retry
-			return nil, xerrors.NewTagged[imageSignatureVerificationFailedTag](errors.New(status.Convert(err).Message()))
-		case codes.NotFound:
-			// verification failed because image not found
-			return nil, xerrors.NewTagged[imageNotFoundTag](err)
-		default:
-			return nil, err
-		}
+		return nil, err
 	}
 
 	containerdRemoteOpts := []containerd.RemoteOpt{
@@ -191,7 +192,7 @@ func pullInternal(
 	if verifyResult.Verified {
 		containerdRemoteOpts = append(
 			containerdRemoteOpts,
-			containerd.WithPullLabel(constants.ImageLabelVerified, verifyResult.Message),
+			containerd.WithPullLabels(verificationLabels(verifyResult)),
 		)
 
 		pullRef = verifyResult.DigestedImageRef
@@ -249,3 +250,94 @@ func pullInternal(
 
 	return img, nil
 }
+
+// VerifyPulled checks an image which is already in the containerd store against the image verification rules.
+//
+// The digest in the store is what gets verified, not whatever the reference resolves to now. The
+// verification labels of the image are replaced to match the outcome.
+func VerifyPulled(ctx context.Context, registryBuilder RegistriesBuilder, resources state.State, img containerd.Image) error {
+	namedRef, err := reference.ParseNormalizedNamed(img.Name())
+	if err != nil {
+		return xerrors.NewTaggedf[imageTerminalErrorTag]("failed to parse image name %q: %w", img.Name(), err)
+	}
+
+	registriesConfig, err := registryBuilder(ctx)
+	if err != nil {
+		return xerrors.NewTaggedf[imageTerminalErrorTag]("failed to get configured registries: %w", err)
+	}
+
+	digestRef := reference.TrimNamed(namedRef).String() + "@" + img.Target().Digest.String()
+
+	verifyResult, err := verifyImage(ctx, resources, NewResolver(registriesConfig), NewTagFetcher(registriesConfig), digestRef)
+	if err != nil {
+		return err
+	}
+
+	labels := map[string]string{}
+
+	for key, value := range img.Labels() {
+		if !slices.Contains(verificationLabelKeys, key) {
+			labels[key] = value
+		}
+	}
+
+	if verifyResult.Verified {
+		maps.Copy(labels, verificationLabels(verifyResult))
+	}
+
+	if _, err = img.SetLabels(ctx, labels); err != nil {
+		return fmt.Errorf("failed to update labels of image %q: %w", img.Name(), err)
+	}
+
+	return nil
+}
+
+// verifyImage checks the reference against the image verification rules, tagging the errors for
+// PullWithRetriesAndTimeout.
+func verifyImage(ctx context.Context, resources state.State, resolver remotes.Resolver, tagFetcher verify.TagFetcher, ref string) (*verify.Result, error) {
+	verifyResult, err := verify.ImageSignature(ctx, zap.NewNop(), resources, resolver, tagFetcher, ref)
+	if err != nil {
+		switch status.Code(err) { //nolint:exhaustive
+		case codes.PermissionDenied:
+			// verification denied by matched rule, no need to retry
+			return nil, xerrors.NewTagged[imageSignatureVerificationFailedTag](errors.New(status.Convert(err).Message()))
+		case codes.NotFound:
+			// verification failed because image not found
+			return nil, xerrors.NewTagged[imageNotFoundTag](err)
+		default:
+			return nil, err
+		}
+	}
+
+	return verifyResult, nil
+}
+
+// verificationLabelKeys are the image labels recording the verification of an image.
+var verificationLabelKeys = []string{
+	constants.ImageLabelVerified,
+	constants.ImageLabelVerifiedRule,
+	constants.ImageLabelVerifiedSigner,
+	constants.ImageLabelVerifiedIssuer,
+	constants.ImageLabelVerifiedRekorLogID,
+	constants.ImageLabelVerifiedRekorLogIndex,
+}
+
+// verificationLabels returns the image labels recording a successful verification.
+func verificationLabels(verifyResult *verify.Result) map[string]string {
+	labels := map[string]string{
+		constants.ImageLabelVerified:     verifyResult.Message,
+		constants.ImageLabelVerifiedRule: verifyResult.Rule,
+	}
+
+	if verifyResult.Signer != "" {
+		labels[constants.ImageLabelVerifiedSigner] = verifyResult.Signer
+		labels[constants.ImageLabelVerifiedIssuer] = verifyResult.Issuer
+	}
+
+	if verifyResult.RekorLogID != "" {
+		labels[constants.ImageLabelVerifiedRekorLogID] = verifyResult.RekorLogID
+		labels[constants.ImageLabelVerifiedRekorLogIndex] = strconv.FormatInt(verifyResult.RekorLogIndex, 10)
+	}
+
+	return labels
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/sigstore/cosign/v3/pkg/oci"
 	"github.com/sigstore/cosign/v3/pkg/oci/static"
 	sgbundle "github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
 	"github.com/sigstore/sigstore-go/pkg/verify"
 	"go.uber.org/zap"
 )
@@ -42,6 +43,16 @@ const (
 // VerifyResult represents the result of a signature verification attempt.
 type VerifyResult struct {
 	Message string
+
+	// Signer is the identity (certificate SAN) the image was signed with, empty for public key signatures.
+	Signer string
+	// Issuer is the OIDC issuer which vouched for the signer.
+	Issuer string
+
+	// RekorLogID and RekorLogIndex locate the verified transparency log entry, RekorLogID is empty if
+	// the transparency log was not checked.
+	RekorLogID    string
+	RekorLogIndex int64
 }
 
 // TagFetcher fetches a manifest by its tag URL through the registry hosts that
@@ -154,7 +165,8 @@ func verifyBundleReferrers(
 
 		co.NewBundleFormat = true
 
-		if _, err := cosign.VerifyNewBundle(ctx, &co, artifactPolicyOption, b); err != nil {
+		verification, err := cosign.VerifyNewBundle(ctx, &co, artifactPolicyOption, b)
+		if err != nil {
 			logger.Debug("bundle referrer verification failed", zap.String("digest", referrer.Digest.String()), zap.Error(err))
 			lastErr = err
 
@@ -163,9 +175,7 @@ func verifyBundleReferrers(
 
 		logger.Debug("bundle referrer verified", zap.String("digest", referrer.Digest.String()))
 
-		return &VerifyResult{
-			Message: fmt.Sprintf("verified via bundle referrer with digest %s", referrer.Digest.String()),
-		}, nil
+		return bundleResult(fmt.Sprintf("verified via bundle referrer with digest %s", referrer.Digest.String()), b, verification, co), nil
 	}
 
 	if lastErr != nil {
@@ -324,7 +334,8 @@ func verifyBundleLayers(
 
 		co.NewBundleFormat = true
 
-		if _, err := cosign.VerifyNewBundle(ctx, &co, artifactPolicyOption, b); err != nil {
+		verification, err := cosign.VerifyNewBundle(ctx, &co, artifactPolicyOption, b)
+		if err != nil {
 			logger.Debug("bundle layer verification failed", zap.Int("layer", i), zap.Error(err))
 			lastErr = err
 
@@ -333,9 +344,7 @@ func verifyBundleLayers(
 
 		logger.Debug("bundle layer verified", zap.Int("layer", i))
 
-		return &VerifyResult{
-			Message: "verified via bundle",
-		}, nil
+		return bundleResult("verified via bundle", b, verification, co), nil
 	}
 
 	if lastErr != nil {
@@ -370,9 +379,7 @@ func verifyLegacyLayers(ctx context.Context, logger *zap.Logger, fetcher remotes
 
 		logger.Debug("legacy signature verified", zap.Int("layer", i), zap.Bool("bundle_verified", bundleVerified))
 
-		return &VerifyResult{
-			Message: fmt.Sprintf("verified via legacy signature (bundle verified %v)", bundleVerified),
-		}, nil
+		return legacyResult(fmt.Sprintf("verified via legacy signature (bundle verified %v)", bundleVerified), sig, bundleVerified)
 	}
 
 	if lastErr != nil {
@@ -382,6 +389,71 @@ func verifyLegacyLayers(ctx context.Context, logger *zap.Logger, fetcher remotes
 	return nil, errors.New("no legacy signatures found")
 }
 
+// bundleResult builds the result of a verified sigstore bundle.
+//
+// The transparency log entries are only reported if they were checked as part of the verification.
+func bundleResult(message string, b *sgbundle.Bundle, verification *verify.VerificationResult, co cosign.CheckOpts) *VerifyResult {
+	result := &VerifyResult{
+		Message: message,
+	}
+
+	if verification.Signature != nil && verification.Signature.Certificate != nil {
+		result.Signer = verification.Signature.Certificate.SubjectAlternativeName
+		result.Issuer = verification.Signature.Certificate.Issuer
+	}
+
+	if co.IgnoreTlog {
+		return result
+	}
+
+	if entries, err := b.TlogEntries(); err == nil && len(entries) > 0 {
+		result.RekorLogID = entries[0].LogKeyID()
+		result.RekorLogIndex = entries[0].LogIndex()
+	}
+
+	return result
+}
+
+// legacyResult builds the result of a verified legacy cosign signature.
+//
+// The Rekor bundle is only reported if it was verified.
+func legacyResult(message string, sig oci.Signature, bundleVerified bool) (*VerifyResult, error) {
+	result := &VerifyResult{
+		Message: message,
+	}
+
+	cert, err := sig.Cert()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signature certificate: %w", err)
+	}
+
+	if cert != nil {
+		summary, err := certificate.SummarizeCertificate(cert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to summarize signature certificate: %w", err)
+		}
+
+		result.Signer = summary.SubjectAlternativeName
+		result.Issuer = summary.Issuer
+	}
+
+	if !bundleVerified {
+		return result, nil
+	}
+
+	rekorBundle, err := sig.Bundle()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Rekor bundle: %w", err)
+	}
+
+	if rekorBundle != nil {
+		result.RekorLogID = rekorBundle.Payload.LogID
+		result.RekorLogIndex = rekorBundle.Payload.LogIndex
+	}
+
+	return result, nil
+}
+
 // fetchManifest fetches and decodes an OCI image manifest descriptor.
 //
 //nolint:dupl // not a duplicate!
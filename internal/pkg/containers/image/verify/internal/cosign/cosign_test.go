@@ -60,6 +60,8 @@ func TestVerifyImage(t *testing.T) {
 		checkOpts cosign.CheckOpts
 
 		expectedResultMessage string
+		expectedSigner        string
+		expectedRekorEntry    bool
 		expectedError         string
 	}{
 		{
@@ -75,6 +77,8 @@ func TestVerifyImage(t *testing.T) {
 			},
 
 			expectedResultMessage: "verified via legacy signature (bundle verified true)",
+			expectedSigner:        "krel-trust@k8s-releng-prod.iam.gserviceaccount.com",
+			expectedRekorEntry:    true,
 		},
 		{
 			// Regression for siderolabs/talos#13342: registry.k8s.io's CDN serves the .sig
@@ -94,6 +98,8 @@ func TestVerifyImage(t *testing.T) {
 			},
 
 			expectedResultMessage: "verified via legacy signature (bundle verified true)",
+			expectedSigner:        "krel-trust@k8s-releng-prod.iam.gserviceaccount.com",
+			expectedRekorEntry:    true,
 		},
 		{
 			imageRef: "ghcr.io/siderolabs/talos:v1.13.0-alpha.2@sha256:9361de6684b441da62298998ab89166efccca35772afc24fee3ae53c569ec44c",
@@ -122,6 +128,8 @@ func TestVerifyImage(t *testing.T) {
 			},
 
 			expectedResultMessage: "verified via bundle",
+			expectedSigner:        "releasemgr-svc@talos-production.iam.gserviceaccount.com",
+			expectedRekorEntry:    true,
 		},
 		{
 			// Regression for siderolabs/talos#13639: cilium stores its signature as an OCI
@@ -202,6 +210,15 @@ func TestVerifyImage(t *testing.T) {
 			require.NotNil(t, result)
 
 			assert.Equal(t, test.expectedResultMessage, result.Message)
+
+			if test.expectedSigner != "" {
+				assert.Equal(t, test.expectedSigner, result.Signer)
+				assert.Equal(t, "https://accounts.google.com", result.Issuer)
+			}
+
+			if test.expectedRekorEntry {
+				assert.NotEmpty(t, result.RekorLogID)
+			}
 		})
 	}
 }
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package verify
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/sigstore/sigstore-go/pkg/tuf"
+	"github.com/theupdateframework/go-tuf/v2/metadata/fetcher"
+
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+)
+
+// FetchTUFTarget fetches the target from the Sigstore public-good TUF repository.
+//
+// The trusted root for keyless verification is the target security.TrustedRootID.
+func FetchTUFTarget(id string) ([]byte, error) {
+	transport := httpdefaults.PatchTransport(cleanhttp.DefaultTransport())
+	httpClient := &http.Client{
+		Transport: transport,
+	}
+
+	fetcher := fetcher.NewDefaultFetcher()
+	fetcher.SetHTTPClient(httpClient)
+	fetcher.SetHTTPUserAgent(httpdefaults.UserAgent())
+
+	opts := tuf.Options{
+		Root:              tuf.DefaultRoot(),
+		RepositoryBaseURL: tuf.DefaultMirror,
+		DisableLocalCache: true,
+		Fetcher:           fetcher,
+	}
+
+	client, err := tuf.New(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TUF client: %w", err)
+	}
+
+	return client.GetTarget(id)
+}
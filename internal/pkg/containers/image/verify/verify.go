@@ -32,6 +32,35 @@ import (
 // returns NotFound; see [ourcosign.TagFetcher].
 type TagFetcher = ourcosign.TagFetcher
 
+// Result is the outcome of checking an image against the image verification rules.
+type Result struct {
+	// Verified is set if the image matched a rule and its signature was verified.
+	Verified bool
+	// Message describes how the image was verified, or why it was not.
+	Message string
+	// DigestedImageRef is the verified reference pinned to its digest, only set if Verified.
+	DigestedImageRef string
+	// Rule is the ID of the matched rule, empty if no rule matched.
+	Rule string
+
+	// Signer and Issuer identify a keyless signature, see [ourcosign.VerifyResult].
+	Signer string
+	Issuer string
+
+	// RekorLogID and RekorLogIndex locate the verified transparency log entry, if any.
+	RekorLogID    string
+	RekorLogIndex int64
+}
+
+// Response converts the result to the ImageService.Verify API response.
+func (result *Result) Response() *machine.ImageServiceVerifyResponse {
+	return &machine.ImageServiceVerifyResponse{
+		Verified:         result.Verified,
+		Message:          result.Message,
+		DigestedImageRef: result.DigestedImageRef,
+	}
+}
+
 // ImageSignature verifies image signature within Talos source code.
 //
 // tagFetcher (optional) is invoked when the resolver's digest-based manifest
@@ -40,7 +69,7 @@ type TagFetcher = ourcosign.TagFetcher
 //nolint:gocyclo
 func ImageSignature(
 	ctx context.Context, logger *zap.Logger, resources state.State, resolver remotes.Resolver, tagFetcher TagFetcher, imageRef string,
-) (*machine.ImageServiceVerifyResponse, error) {
+) (*Result, error) {
 	logger = logger.With(zap.String("image_ref", imageRef))
 
 	inRef, err := name.ParseReference(imageRef)
@@ -59,7 +88,7 @@ func ImageSignature(
 	if matchedRule == nil {
 		logger.Info("no matched image verification rule, allowing by default", zap.Stringer("image_ref_context", inRef.Context()))
 
-		return &machine.ImageServiceVerifyResponse{
+		return &Result{
 			Verified: false,
 			Message:  "no matched rule",
 		}, nil
@@ -74,9 +103,10 @@ func ImageSignature(
 	if matchedRule.TypedSpec().Skip {
 		logger.Info("verification skipped by matched rule", zap.String("rule_id", matchedRule.Metadata().ID()))
 
-		return &machine.ImageServiceVerifyResponse{
+		return &Result{
 			Verified: false,
 			Message:  fmt.Sprintf("verification skipped by matched rule (%s)", matchedRule.Metadata().ID()),
+			Rule:     matchedRule.Metadata().ID(),
 		}, nil
 	}
 
@@ -115,10 +145,15 @@ func ImageSignature(
 		return nil, status.Errorf(codes.PermissionDenied, "image verification failed: %s", err)
 	}
 
-	return &machine.ImageServiceVerifyResponse{
+	return &Result{
 		Verified:         true,
 		Message:          result.Message,
 		DigestedImageRef: digestRef.String(),
+		Rule:             matchedRule.Metadata().ID(),
+		Signer:           result.Signer,
+		Issuer:           result.Issuer,
+		RekorLogID:       result.RekorLogID,
+		RekorLogIndex:    result.RekorLogIndex,
 	}, nil
 }
 
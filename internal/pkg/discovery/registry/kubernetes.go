@@ -60,6 +60,12 @@ func AnnotationsFromAffiliate(affiliate *cluster.Affiliate) map[string]string {
 		apiServerPort = strconv.Itoa(affiliate.TypedSpec().ControlPlane.APIServerPort)
 	}
 
+	var kubeSpanRelay string
+
+	if affiliate.TypedSpec().KubeSpan.Relay {
+		kubeSpanRelay = strconv.FormatBool(true)
+	}
+
 	return map[string]string{
 		constants.ClusterNodeIDAnnotation:                     affiliate.Metadata().ID(),
 		constants.NetworkSelfIPsAnnotation:                    ipsToString(affiliate.TypedSpec().Addresses),
@@ -69,6 +75,8 @@ func AnnotationsFromAffiliate(affiliate *cluster.Affiliate) map[string]string {
 		constants.KubeSpanAssignedPrefixesAnnotation:          ipPrefixesToString(affiliate.TypedSpec().KubeSpan.AdditionalAddresses),
 		constants.KubeSpanKnownEndpointsAnnotation:            ipPortsToString(affiliate.TypedSpec().KubeSpan.Endpoints),
 		constants.KubeSpanExcludeAdvertisedNetworksAnnotation: ipPrefixesToString(affiliate.TypedSpec().KubeSpan.ExcludeAdvertisedNetworks),
+		constants.KubeSpanRelayAnnotation:                     kubeSpanRelay,
+		constants.KubeSpanAlternatePublicKeyAnnotation:        affiliate.TypedSpec().KubeSpan.AlternatePublicKey,
 	}
 }
 
@@ -143,6 +151,14 @@ func AffiliateFromNode(node *corev1.Node) *cluster.AffiliateSpec {
 		affiliate.KubeSpan.ExcludeAdvertisedNetworks = parseIPPrefixes(advertisedFilters)
 	}
 
+	if relay, ok := node.Annotations[constants.KubeSpanRelayAnnotation]; ok {
+		affiliate.KubeSpan.Relay, _ = strconv.ParseBool(relay) //nolint:errcheck
+	}
+
+	if alternatePublicKey, ok := node.Annotations[constants.KubeSpanAlternatePublicKeyAnnotation]; ok {
+		affiliate.KubeSpan.AlternatePublicKey = alternatePublicKey
+	}
+
 	return affiliate
 }
 
@@ -34,6 +34,8 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 				"networking.talos.dev/kubespan-ip":                          "",
 				"networking.talos.dev/kubespan-public-key":                  "",
 				"networking.talos.dev/kubespan-exclude-advertised-networks": "",
+				"networking.talos.dev/kubespan-relay":                       "",
+				"networking.talos.dev/kubespan-alternate-public-key":        "",
 				"networking.talos.dev/self-ips":                             "",
 			},
 		},
@@ -51,6 +53,8 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 					AdditionalAddresses:       []netip.Prefix{netip.MustParsePrefix("10.244.3.1/24")},
 					Endpoints:                 []netip.AddrPort{netip.MustParseAddrPort("10.0.0.2:51820"), netip.MustParseAddrPort("192.168.3.4:51820")},
 					ExcludeAdvertisedNetworks: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")},
+					Relay:                     true,
+					AlternatePublicKey:        "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs=",
 				},
 			},
 			expected: map[string]string{
@@ -61,6 +65,8 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 				"networking.talos.dev/kubespan-ip":                          "fd50:8d60:4238:6302:f857:23ff:fe21:d1e0",
 				"networking.talos.dev/kubespan-public-key":                  "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
 				"networking.talos.dev/kubespan-exclude-advertised-networks": "0.0.0.0/0,::/0",
+				"networking.talos.dev/kubespan-relay":                       "true",
+				"networking.talos.dev/kubespan-alternate-public-key":        "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs=",
 				"networking.talos.dev/self-ips":                             "10.0.0.2,192.168.3.4",
 			},
 		},
@@ -84,6 +90,8 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 				"networking.talos.dev/kubespan-ip":                          "",
 				"networking.talos.dev/kubespan-public-key":                  "",
 				"networking.talos.dev/kubespan-exclude-advertised-networks": "",
+				"networking.talos.dev/kubespan-relay":                       "",
+				"networking.talos.dev/kubespan-alternate-public-key":        "",
 				"networking.talos.dev/self-ips":                             "10.0.0.2,192.168.3.4",
 			},
 		},
@@ -126,6 +134,8 @@ func TestAffiliateFromNode(t *testing.T) {
 						"networking.talos.dev/kubespan-ip":                          "fd50:8d60:4238:6302:f857:23ff:fe21:d1e0",
 						"networking.talos.dev/kubespan-public-key":                  "PLPNBddmTgHJhtw0vxltq1ZBdPP9RNOEUd5JjJZzBRY=",
 						"networking.talos.dev/kubespan-exclude-advertised-networks": "0.0.0.0/0,::/0",
+						"networking.talos.dev/kubespan-relay":                       "true",
+						"networking.talos.dev/kubespan-alternate-public-key":        "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs=",
 						"networking.talos.dev/self-ips":                             "10.0.0.2,192.168.3.4",
 					},
 					Labels: map[string]string{
@@ -158,6 +168,8 @@ func TestAffiliateFromNode(t *testing.T) {
 					AdditionalAddresses:       []netip.Prefix{netip.MustParsePrefix("10.244.3.1/24")},
 					Endpoints:                 []netip.AddrPort{netip.MustParseAddrPort("10.0.0.2:51820"), netip.MustParseAddrPort("192.168.3.4:51820")},
 					ExcludeAdvertisedNetworks: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")},
+					Relay:                     true,
+					AlternatePublicKey:        "tQuicRD0tqCu48M+zrySTe4slT15JxWhWIboZOB4tWs=",
 				},
 			},
 		},
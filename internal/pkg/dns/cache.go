@@ -6,11 +6,12 @@ package dns
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/coredns/coredns/plugin"
 	"github.com/coredns/coredns/plugin/cache"
-	"github.com/coredns/coredns/plugin/pkg/dnsutil"
+	"github.com/coredns/coredns/plugin/pkg/dnstest"
 	"github.com/coredns/coredns/request"
 	"github.com/miekg/dns"
 	"go.uber.org/zap"
@@ -20,20 +21,25 @@ const requestTimeout = 4500 * time.Millisecond
 
 // Cache is a [dns.Handler] to [plugin.Handler] adapter.
 type Cache struct {
-	cache  *cache.Cache
-	logger *zap.Logger
+	cache       *cache.Cache
+	negative    *NegativeCache
+	logger      *zap.Logger
+	queryLogger atomic.Pointer[zap.Logger]
 }
 
 // NewCache creates a new Cache.
 func NewCache(next plugin.Handler, l *zap.Logger) *Cache {
+	negative := NewNegativeCache(next)
+
 	c := cache.NewCache(
 		"zones",
 		"view",
-		cache.WithNegativeTTL(10*time.Second, dnsutil.MinimalDefaultTTL),
+		// negative responses are cached by the bounded NegativeCache instead
+		cache.WithNegativeTTL(0, 0),
 	)
-	c.Next = next
+	c.Next = negative
 
-	return &Cache{cache: c, logger: l}
+	return &Cache{cache: c, negative: negative, logger: l}
 }
 
 // ServeDNS implements [dns.Handler].
@@ -43,8 +49,19 @@ func (c *Cache) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	defer cancel()
 
+	ctx, info := withQueryInfo(ctx)
+
+	if queryLogger := c.queryLogger.Load(); queryLogger != nil {
+		rec := dnstest.NewRecorder(wr)
+		wr = rec
+
+		defer logQuery(queryLogger, rec, msg, info, time.Now())
+	}
+
 	code, err := c.cache.ServeDNS(ctx, wr, msg)
 	if err != nil {
+		info.err = err
+
 		// we should probably call newProxy.Healthcheck() if there are too many errors
 		c.logger.Warn("error serving dns request", zap.Error(err))
 	}
@@ -67,7 +84,18 @@ func (c *Cache) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
 }
 
 // Clear clears the cache.
-func (c *Cache) Clear() { c.cache.Clear() }
+func (c *Cache) Clear() {
+	c.cache.Clear()
+	c.negative.Clear()
+}
+
+// SetNegativeCache sets the negative cache limits. It returns true if the limits were changed.
+func (c *Cache) SetNegativeCache(size int, minTTL, maxTTL time.Duration) bool {
+	return c.negative.Configure(size, minTTL, maxTTL)
+}
+
+// SetQueryLogger sets the logger for the query log, nil disables the query log.
+func (c *Cache) SetQueryLogger(l *zap.Logger) { c.queryLogger.Store(l) }
 
 // clientWrite returns true if the response has been written to the client.
 func clientWrite(rcode int) bool {
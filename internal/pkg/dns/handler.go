@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"iter"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/coredns/coredns/plugin/pkg/proxy"
@@ -30,11 +32,19 @@ type Upstream interface {
 	Addr() string
 }
 
+// Forwarder forwards queries for the domains (and all their subdomains) to the dedicated upstreams.
+type Forwarder struct {
+	// Domains are lowercase, without the trailing dot.
+	Domains   []string
+	Upstreams iter.Seq[Upstream]
+}
+
 // Handler is a dns proxy selector.
 type Handler struct {
-	mx     sync.RWMutex
-	dests  iter.Seq[Upstream]
-	logger *zap.Logger
+	mx         sync.RWMutex
+	dests      iter.Seq[Upstream]
+	forwarders []Forwarder
+	logger     *zap.Logger
 }
 
 // NewHandler creates a new Handler.
@@ -72,11 +82,20 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 		err    error
 	)
 
-	for ups := range h.dests {
+	dests := h.dests
+
+	if forwarder, domain, ok := h.matchForwarder(req.Name()); ok {
+		dests = forwarder.Upstreams
+
+		logger = logger.With(zap.String("forwarder", domain))
+	}
+
+	for ups := range dests {
 		called = true
 		opts := proxy.Options{}
 
 		logger.Debug("making dns request", zap.String("upstream", ups.Addr()))
+		setQuerySource(ctx, ups.Addr())
 
 		for {
 			resp, err = ups.Connect(ctx, req, opts)
@@ -105,6 +124,8 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 	}
 
 	if !called {
+		setQuerySource(ctx, querySourceNoUpstreams)
+
 		return dns.RcodeServerFailure, errors.New("no destination available")
 	}
 
@@ -145,5 +166,48 @@ func (h *Handler) SetProxy(prxs iter.Seq[Upstream]) bool {
 	return true
 }
 
+// SetForwarders sets per-domain forwarders.
+func (h *Handler) SetForwarders(forwarders []Forwarder) bool {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if slices.EqualFunc(h.forwarders, forwarders, func(a, b Forwarder) bool {
+		return slices.Equal(a.Domains, b.Domains) && xiter.Equal(a.Upstreams, b.Upstreams)
+	}) {
+		return false
+	}
+
+	h.forwarders = forwarders
+
+	return true
+}
+
+// matchForwarder returns the forwarder with the longest domain matching the name.
+func (h *Handler) matchForwarder(name string) (Forwarder, string, bool) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	var (
+		matched       Forwarder
+		matchedDomain string
+	)
+
+	for _, forwarder := range h.forwarders {
+		for _, domain := range forwarder.Domains {
+			if len(domain) <= len(matchedDomain) {
+				continue
+			}
+
+			if name == domain || strings.HasSuffix(name, "."+domain) {
+				matched, matchedDomain = forwarder, domain
+			}
+		}
+	}
+
+	return matched, matchedDomain, matchedDomain != ""
+}
+
 // Stop stops and clears dns proxy selector.
-func (h *Handler) Stop() { h.SetProxy(xiter.Empty[Upstream]) }
+func (h *Handler) Stop() {
+	h.SetProxy(xiter.Empty[Upstream])
+	h.SetForwarders(nil)
+}
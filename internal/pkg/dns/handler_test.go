@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/coredns/coredns/plugin/pkg/dnstest"
+	"github.com/coredns/coredns/plugin/pkg/proxy"
+	"github.com/coredns/coredns/plugin/test"
+	"github.com/coredns/coredns/request"
+	dnssrv "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+type fakeUpstream struct {
+	addr string
+	fail bool
+
+	calls int
+}
+
+func (u *fakeUpstream) Connect(_ context.Context, state request.Request, _ proxy.Options) (*dnssrv.Msg, error) {
+	u.calls++
+
+	if u.fail {
+		return nil, errors.New("upstream is down")
+	}
+
+	return new(dnssrv.Msg).SetReply(state.Req), nil
+}
+
+func (u *fakeUpstream) Addr() string { return u.addr }
+
+func TestHandlerForwarders(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "default",
+			query:    "example.com",
+			expected: "default",
+		},
+		{
+			name:     "exact domain",
+			query:    "corp.example",
+			expected: "corp",
+		},
+		{
+			name:     "subdomain",
+			query:    "host.Corp.Example",
+			expected: "corp",
+		},
+		{
+			name:     "longest match",
+			query:    "host.lab.corp.example",
+			expected: "lab",
+		},
+		{
+			name:     "no partial label match",
+			query:    "notcorp.example",
+			expected: "default",
+		},
+		{
+			name:     "failover",
+			query:    "kubernetes.default.svc.cluster.local",
+			expected: "cluster-backup",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			upstreams := map[string]*fakeUpstream{
+				"default":        {addr: "default"},
+				"corp":           {addr: "corp"},
+				"lab":            {addr: "lab"},
+				"cluster":        {addr: "cluster", fail: true},
+				"cluster-backup": {addr: "cluster-backup"},
+			}
+
+			seq := func(names ...string) func(func(dns.Upstream) bool) {
+				return func(yield func(dns.Upstream) bool) {
+					for _, name := range names {
+						if !yield(upstreams[name]) {
+							return
+						}
+					}
+				}
+			}
+
+			handler := dns.NewHandler(zaptest.NewLogger(t))
+			handler.SetProxy(seq("default"))
+
+			require.True(t, handler.SetForwarders([]dns.Forwarder{
+				{Domains: []string{"corp.example"}, Upstreams: seq("corp")},
+				{Domains: []string{"lab.corp.example"}, Upstreams: seq("lab")},
+				{Domains: []string{"cluster.local"}, Upstreams: seq("cluster", "cluster-backup")},
+			}))
+
+			rec := dnstest.NewRecorder(&test.ResponseWriter{})
+
+			code, err := handler.ServeDNS(t.Context(), rec, createQuery(tc.query))
+			require.NoError(t, err)
+			assert.Equal(t, dnssrv.RcodeSuccess, code)
+
+			for name, upstream := range upstreams {
+				switch {
+				case name == tc.expected:
+					assert.Equal(t, 1, upstream.calls, name)
+				case name == "cluster" && tc.expected == "cluster-backup":
+					assert.Equal(t, 1, upstream.calls, name)
+				default:
+					assert.Zero(t, upstream.calls, name)
+				}
+			}
+		})
+	}
+}
+
+func TestHandlerSetForwardersUnchanged(t *testing.T) {
+	t.Parallel()
+
+	upstream := dns.Upstream(&fakeUpstream{addr: "corp"})
+
+	handler := dns.NewHandler(zaptest.NewLogger(t))
+
+	forwarders := func() []dns.Forwarder {
+		return []dns.Forwarder{
+			{Domains: []string{"corp.example"}, Upstreams: slices.Values([]dns.Upstream{upstream})},
+		}
+	}
+
+	assert.True(t, handler.SetForwarders(forwarders()))
+	assert.False(t, handler.SetForwarders(forwarders()))
+	assert.True(t, handler.SetForwarders(nil))
+}
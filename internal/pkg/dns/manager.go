@@ -160,6 +160,26 @@ func (m *Manager) SetUpstreams(prxs iter.Seq[Upstream]) bool {
 	return true
 }
 
+// SetForwarders sets the per-domain forwarders for the DNS handler. It returns true if the forwarders were updated, false otherwise.
+func (m *Manager) SetForwarders(forwarders []Forwarder) bool {
+	if !m.upstreamHandler.SetForwarders(forwarders) {
+		return false
+	}
+
+	// Forwarders updated, clear cache to prevent DNS poisoning.
+	m.cacheHandler.Clear()
+
+	return true
+}
+
+// SetNegativeCache sets the negative cache limits. It returns true if the limits were changed.
+func (m *Manager) SetNegativeCache(size int, minTTL, maxTTL time.Duration) bool {
+	return m.cacheHandler.SetNegativeCache(size, minTTL, maxTTL)
+}
+
+// SetQueryLogger sets the logger for the query log, nil disables the query log.
+func (m *Manager) SetQueryLogger(l *zap.Logger) { m.cacheHandler.SetQueryLogger(l) }
+
 // ClearAll stops and removes all runners. Returns all errors if any runner failed to properly stop.
 func (m *Manager) ClearAll(dry bool) error {
 	if dry {
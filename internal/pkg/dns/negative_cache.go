@@ -0,0 +1,266 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"container/heap"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coredns/coredns/plugin"
+	"github.com/coredns/coredns/plugin/pkg/dnsutil"
+	"github.com/coredns/coredns/plugin/pkg/response"
+	"github.com/coredns/coredns/request"
+	"github.com/miekg/dns"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// NegativeCache is a [plugin.Handler] which caches negative (NXDOMAIN and NODATA) responses.
+//
+// The TTL of the cached response is derived from the SOA record and clamped to [minTTL, maxTTL].
+// The cache is bounded: when it is full, the entries which expire first are evicted.
+type NegativeCache struct {
+	next plugin.Handler
+
+	mx      sync.Mutex
+	entries map[negativeCacheKey]*negativeCacheEntry
+	queue   negativeCacheQueue
+	size    int
+	minTTL  time.Duration
+	maxTTL  time.Duration
+}
+
+type negativeCacheKey struct {
+	name   string
+	qType  uint16
+	qClass uint16
+	do     bool
+	cd     bool
+}
+
+type negativeCacheEntry struct {
+	key     negativeCacheKey
+	msg     *dns.Msg
+	expires time.Time
+	index   int
+}
+
+// negativeCacheQueue is a min-heap of the cache entries ordered by the expiration time.
+type negativeCacheQueue []*negativeCacheEntry
+
+func (q negativeCacheQueue) Len() int { return len(q) }
+
+func (q negativeCacheQueue) Less(i, j int) bool { return q[i].expires.Before(q[j].expires) }
+
+func (q negativeCacheQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *negativeCacheQueue) Push(x any) {
+	entry := x.(*negativeCacheEntry) //nolint:forcetypeassert
+	entry.index = len(*q)
+	*q = append(*q, entry)
+}
+
+func (q *negativeCacheQueue) Pop() any {
+	old := *q
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+
+	return entry
+}
+
+// NewNegativeCache creates a new NegativeCache with the default limits.
+func NewNegativeCache(next plugin.Handler) *NegativeCache {
+	return &NegativeCache{
+		next:    next,
+		entries: map[negativeCacheKey]*negativeCacheEntry{},
+		size:    constants.HostDNSNegativeCacheSize,
+		minTTL:  constants.HostDNSNegativeCacheMinTTL,
+		maxTTL:  constants.HostDNSNegativeCacheMaxTTL,
+	}
+}
+
+// Name implements plugin.Handler.
+func (c *NegativeCache) Name() string {
+	return "NegativeCache"
+}
+
+// ServeDNS implements plugin.Handler.
+func (c *NegativeCache) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns.Msg) (int, error) {
+	if len(msg.Question) != 1 {
+		return c.next.ServeDNS(ctx, wrt, msg)
+	}
+
+	req := request.Request{W: wrt, Req: msg}
+	key := negativeCacheKey{
+		name:   strings.ToLower(req.Name()),
+		qType:  req.QType(),
+		qClass: req.QClass(),
+		do:     req.Do(),
+		cd:     msg.CheckingDisabled,
+	}
+
+	if resp, ok := c.get(key, msg, time.Now()); ok {
+		setQuerySource(ctx, querySourceNegativeCache)
+
+		return dns.RcodeSuccess, wrt.WriteMsg(resp)
+	}
+
+	return c.next.ServeDNS(ctx, &negativeCacheWriter{ResponseWriter: wrt, cache: c, key: key}, msg)
+}
+
+// Configure sets the cache limits. It returns true if the limits were changed, in which case the cache is cleared.
+//
+// Size of zero disables the cache.
+func (c *NegativeCache) Configure(size int, minTTL, maxTTL time.Duration) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.size == size && c.minTTL == minTTL && c.maxTTL == maxTTL {
+		return false
+	}
+
+	c.size, c.minTTL, c.maxTTL = size, minTTL, maxTTL
+
+	c.clear()
+
+	return true
+}
+
+// Clear clears the cache.
+func (c *NegativeCache) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.clear()
+}
+
+func (c *NegativeCache) clear() {
+	clear(c.entries)
+	c.queue = nil
+}
+
+// Len returns the number of cached responses.
+func (c *NegativeCache) Len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *NegativeCache) get(key negativeCacheKey, msg *dns.Msg, now time.Time) (*dns.Msg, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !now.Before(entry.expires) {
+		c.remove(entry)
+
+		return nil, false
+	}
+
+	resp := entry.msg.Copy()
+	resp.Id = msg.Id
+	resp.Question = msg.Question
+
+	ttl := uint32(entry.expires.Sub(now).Seconds())
+
+	for _, rrs := range [][]dns.RR{resp.Answer, resp.Ns, resp.Extra} {
+		for _, rr := range rrs {
+			if rr.Header().Rrtype != dns.TypeOPT {
+				rr.Header().Ttl = ttl
+			}
+		}
+	}
+
+	return resp, true
+}
+
+func (c *NegativeCache) set(key negativeCacheKey, resp *dns.Msg, now time.Time) {
+	if resp.Truncated {
+		return
+	}
+
+	mt, _ := response.Typify(resp, now.UTC())
+	if mt != response.NameError && mt != response.NoData {
+		return
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+
+	ttl := min(max(dnsutil.MinimalTTL(resp, mt), c.minTTL), c.maxTTL)
+	if ttl <= 0 {
+		return
+	}
+
+	if entry, ok := c.entries[key]; ok {
+		entry.msg = resp.Copy()
+		entry.expires = now.Add(ttl)
+		heap.Fix(&c.queue, entry.index)
+
+		return
+	}
+
+	if len(c.entries) >= c.size {
+		c.evict(now)
+	}
+
+	entry := &negativeCacheEntry{
+		key:     key,
+		msg:     resp.Copy(),
+		expires: now.Add(ttl),
+	}
+
+	c.entries[key] = entry
+	heap.Push(&c.queue, entry)
+}
+
+func (c *NegativeCache) remove(entry *negativeCacheEntry) {
+	heap.Remove(&c.queue, entry.index)
+	delete(c.entries, entry.key)
+}
+
+// evict removes expired entries, and if there are none, the entry which expires first.
+func (c *NegativeCache) evict(now time.Time) {
+	for len(c.queue) > 0 && !now.Before(c.queue[0].expires) {
+		c.remove(c.queue[0])
+	}
+
+	if len(c.entries) >= c.size && len(c.queue) > 0 {
+		c.remove(c.queue[0])
+	}
+}
+
+// negativeCacheWriter stores negative responses in the cache on their way to the client.
+type negativeCacheWriter struct {
+	dns.ResponseWriter
+
+	cache *NegativeCache
+	key   negativeCacheKey
+}
+
+// WriteMsg implements dns.ResponseWriter.
+func (w *negativeCacheWriter) WriteMsg(resp *dns.Msg) error {
+	w.cache.set(w.key, resp, time.Now())
+
+	return w.ResponseWriter.WriteMsg(resp)
+}
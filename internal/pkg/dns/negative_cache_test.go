@@ -0,0 +1,203 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coredns/coredns/plugin/pkg/dnstest"
+	"github.com/coredns/coredns/plugin/pkg/proxy"
+	"github.com/coredns/coredns/plugin/test"
+	"github.com/coredns/coredns/request"
+	dnssrv "github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/siderolabs/talos/internal/pkg/dns"
+)
+
+// negativeUpstream answers NXDOMAIN for names starting with "missing", and a positive answer otherwise.
+type negativeUpstream struct {
+	calls int
+}
+
+func (u *negativeUpstream) Connect(_ context.Context, state request.Request, _ proxy.Options) (*dnssrv.Msg, error) {
+	u.calls++
+
+	resp := new(dnssrv.Msg).SetReply(state.Req)
+
+	if strings.HasPrefix(state.Name(), "missing") {
+		resp.Rcode = dnssrv.RcodeNameError
+		resp.Ns = []dnssrv.RR{test.SOA("example. 3600 IN SOA ns.example. admin.example. 1 7200 3600 1209600 60")}
+	} else {
+		resp.Answer = []dnssrv.RR{test.A(state.Name() + " 3600 IN A 10.0.0.1")}
+	}
+
+	return resp, nil
+}
+
+func (u *negativeUpstream) Addr() string { return "10.0.0.53:53" }
+
+func (u *negativeUpstream) handler(t *testing.T) *dns.Handler {
+	handler := dns.NewHandler(zaptest.NewLogger(t))
+	handler.SetProxy(slices.Values([]dns.Upstream{u}))
+
+	return handler
+}
+
+func serveNegativeCache(t *testing.T, c *dns.NegativeCache, name string) *dnssrv.Msg {
+	t.Helper()
+
+	rec := dnstest.NewRecorder(&test.ResponseWriter{})
+
+	_, err := c.ServeDNS(t.Context(), rec, createQuery(name))
+	require.NoError(t, err)
+	require.NotNil(t, rec.Msg)
+
+	return rec.Msg
+}
+
+func TestNegativeCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("caches negative responses", func(t *testing.T) {
+		t.Parallel()
+
+		upstream := &negativeUpstream{}
+		c := dns.NewNegativeCache(upstream.handler(t))
+
+		first := serveNegativeCache(t, c, "missing.example.")
+		assert.Equal(t, dnssrv.RcodeNameError, first.Rcode)
+
+		second := serveNegativeCache(t, c, "Missing.Example.")
+		assert.Equal(t, dnssrv.RcodeNameError, second.Rcode)
+		assert.Equal(t, "Missing.Example.", second.Question[0].Name)
+
+		// SOA minimum TTL (60s) is clamped to the default max TTL
+		require.Len(t, second.Ns, 1)
+		assert.LessOrEqual(t, second.Ns[0].Header().Ttl, uint32(10))
+
+		assert.Equal(t, 1, upstream.calls)
+		assert.Equal(t, 1, c.Len())
+
+		c.Clear()
+
+		serveNegativeCache(t, c, "missing.example.")
+		assert.Equal(t, 2, upstream.calls)
+	})
+
+	t.Run("positive responses are not cached", func(t *testing.T) {
+		t.Parallel()
+
+		upstream := &negativeUpstream{}
+		c := dns.NewNegativeCache(upstream.handler(t))
+
+		serveNegativeCache(t, c, "exists.example.")
+		serveNegativeCache(t, c, "exists.example.")
+
+		assert.Equal(t, 2, upstream.calls)
+		assert.Zero(t, c.Len())
+	})
+
+	t.Run("min TTL", func(t *testing.T) {
+		t.Parallel()
+
+		upstream := &negativeUpstream{}
+		c := dns.NewNegativeCache(upstream.handler(t))
+
+		require.True(t, c.Configure(10, 5*time.Minute, time.Hour))
+		require.False(t, c.Configure(10, 5*time.Minute, time.Hour))
+
+		serveNegativeCache(t, c, "missing.example.")
+		resp := serveNegativeCache(t, c, "missing.example.")
+
+		require.Len(t, resp.Ns, 1)
+		assert.Greater(t, resp.Ns[0].Header().Ttl, uint32(60))
+		assert.Equal(t, 1, upstream.calls)
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		t.Parallel()
+
+		upstream := &negativeUpstream{}
+		c := dns.NewNegativeCache(upstream.handler(t))
+
+		require.True(t, c.Configure(2, time.Second, time.Minute))
+
+		serveNegativeCache(t, c, "missing1.example.")
+		serveNegativeCache(t, c, "missing2.example.")
+		serveNegativeCache(t, c, "missing3.example.")
+
+		assert.Equal(t, 2, c.Len())
+		assert.Equal(t, 3, upstream.calls)
+
+		// the entry which expires first was evicted
+		serveNegativeCache(t, c, "missing1.example.")
+		assert.Equal(t, 4, upstream.calls)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		upstream := &negativeUpstream{}
+		c := dns.NewNegativeCache(upstream.handler(t))
+
+		require.True(t, c.Configure(0, time.Second, time.Minute))
+
+		serveNegativeCache(t, c, "missing.example.")
+		serveNegativeCache(t, c, "missing.example.")
+
+		assert.Equal(t, 2, upstream.calls)
+		assert.Zero(t, c.Len())
+	})
+}
+
+func TestCacheQueryLog(t *testing.T) {
+	t.Parallel()
+
+	upstream := &negativeUpstream{}
+	c := dns.NewCache(upstream.handler(t), zaptest.NewLogger(t))
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	c.SetQueryLogger(zap.New(core))
+
+	for _, name := range []string{"missing.example.", "missing.example.", "exists.example.", "exists.example."} {
+		c.ServeDNS(&test.ResponseWriter{}, createQuery(name))
+	}
+
+	c.SetQueryLogger(nil)
+	c.ServeDNS(&test.ResponseWriter{}, createQuery("other.example."))
+
+	entries := logs.All()
+	require.Len(t, entries, 4)
+
+	for i, expected := range []struct {
+		name   string
+		rcode  string
+		source string
+	}{
+		{name: "missing.example.", rcode: "NXDOMAIN", source: "10.0.0.53:53"},
+		{name: "missing.example.", rcode: "NXDOMAIN", source: "negative-cache"},
+		{name: "exists.example.", rcode: "NOERROR", source: "10.0.0.53:53"},
+		{name: "exists.example.", rcode: "NOERROR", source: "cache"},
+	} {
+		fields := entries[i].ContextMap()
+
+		assert.Equal(t, expected.name, fields["name"], i)
+		assert.Equal(t, expected.rcode, fields["rcode"], i)
+		assert.Equal(t, expected.source, fields["source"], i)
+		assert.Equal(t, "A", fields["type"], i)
+	}
+
+	assert.Equal(t, 2, upstream.calls)
+}
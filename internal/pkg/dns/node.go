@@ -67,6 +67,8 @@ func (h *NodeHandler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg
 		return h.next.ServeDNS(ctx, wrt, msg)
 	}
 
+	setQuerySource(ctx, querySourceMembers)
+
 	resp := new(dns.Msg).SetReply(req.Req)
 	resp.Authoritative = true
 	resp.Answer = answers
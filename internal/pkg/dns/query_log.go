@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/coredns/coredns/plugin/pkg/dnstest"
+	"github.com/coredns/coredns/request"
+	"github.com/miekg/dns"
+	"go.uber.org/zap"
+)
+
+// Sources of the query answers reported in the query log.
+const (
+	querySourceCache         = "cache"
+	querySourceNegativeCache = "negative-cache"
+	querySourceStaticHosts   = "static-hosts"
+	querySourceMembers       = "members"
+	querySourceNoUpstreams   = "no-upstreams"
+)
+
+type queryInfoKey struct{}
+
+// queryInfo is filled by the handlers in the chain while the query is processed.
+type queryInfo struct {
+	source string
+	err    error
+}
+
+func withQueryInfo(ctx context.Context) (context.Context, *queryInfo) {
+	info := &queryInfo{}
+
+	return context.WithValue(ctx, queryInfoKey{}, info), info
+}
+
+// setQuerySource records the source of the answer (upstream address or the local handler name).
+func setQuerySource(ctx context.Context, source string) {
+	if info, ok := ctx.Value(queryInfoKey{}).(*queryInfo); ok {
+		info.source = source
+	}
+}
+
+func logQuery(logger *zap.Logger, rec *dnstest.Recorder, msg *dns.Msg, info *queryInfo, start time.Time) {
+	req := request.Request{W: rec, Req: msg}
+
+	rcode := "-"
+	if rec.Msg != nil {
+		rcode = dns.RcodeToString[rec.Rcode]
+	}
+
+	fields := []zap.Field{
+		zap.String("client", req.IP()),
+		zap.String("proto", req.Proto()),
+		zap.String("name", req.Name()),
+		zap.String("type", req.Type()),
+		zap.String("rcode", rcode),
+		zap.String("source", cmp.Or(info.source, querySourceCache)),
+		zap.Duration("duration", time.Since(start)),
+	}
+
+	if info.err != nil {
+		fields = append(fields, zap.Error(info.err))
+	}
+
+	logger.Info("dns query", fields...)
+}
@@ -55,6 +55,8 @@ func (h *StaticHostHandler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter
 		return h.next.ServeDNS(ctx, wrt, msg)
 	}
 
+	setQuerySource(ctx, querySourceStaticHosts)
+
 	resp := new(dns.Msg).SetReply(req.Req)
 	resp.Authoritative = true
 	resp.Answer = answers
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package iscsi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+)
+
+// Sentinel errors returned by the package.
+var (
+	// ErrNotFound is returned when the session, node record or LUN does not exist.
+	ErrNotFound = errors.New("iscsi: not found")
+	// ErrSessionExists is returned when logging into a target with an active session.
+	ErrSessionExists = errors.New("iscsi: session already exists")
+	// ErrLogin is returned when the target rejected the login (authentication or authorization failure).
+	ErrLogin = errors.New("iscsi: login failed")
+	// ErrTransport is returned when the target portal could not be reached.
+	ErrTransport = errors.New("iscsi: transport error")
+	// ErrNotInstalled is returned when the iscsiadm binary is not available.
+	ErrNotInstalled = errors.New("iscsi: iscsiadm is not installed")
+	// ErrCommand is returned for any non-zero exit that does not match a more
+	// specific sentinel.
+	ErrCommand = errors.New("iscsi: command failed")
+)
+
+// iscsiadm exit codes, see include/iscsi_err.h in open-iscsi.
+const (
+	exitSessionNotFound = 2
+	exitTransport       = 8
+	exitSessionExists   = 15
+	exitLogin           = 19
+	exitNoObjectsFound  = 21
+	exitLoginAuthFailed = 24
+	exitLoginAuthzFail  = 25
+)
+
+// ExecError carries the classified sentinel together with the raw exit code
+// and stderr from a failed iscsiadm invocation.
+//
+// Error() only renders the sentinel, so raw iscsiadm output is never leaked
+// through err.Error().
+type ExecError struct {
+	Sentinel error
+	ExitCode int
+	Stderr   []byte
+}
+
+// Error implements the error interface.
+func (e *ExecError) Error() string {
+	return e.Sentinel.Error()
+}
+
+// Unwrap returns the sentinel so errors.Is/As routes through it.
+func (e *ExecError) Unwrap() error {
+	return e.Sentinel
+}
+
+// classifyError maps a *cmd.ExitError to an *ExecError carrying the matching
+// sentinel. Non-ExitError inputs are returned untouched.
+func classifyError(err error) error {
+	var exit *cmd.ExitError
+
+	if !errors.As(err, &exit) {
+		return err
+	}
+
+	return &ExecError{
+		Sentinel: sentinelFor(exit),
+		ExitCode: exit.ExitCode,
+		Stderr:   exit.Output,
+	}
+}
+
+// sentinelFor picks the sentinel for an iscsiadm ExitError. Unlike mdadm,
+// iscsiadm reports stable exit codes, so no stderr matching is needed.
+func sentinelFor(exit *cmd.ExitError) error {
+	switch exit.ExitCode {
+	case exitSessionNotFound, exitNoObjectsFound:
+		return ErrNotFound
+	case exitSessionExists:
+		return ErrSessionExists
+	case exitLogin, exitLoginAuthFailed, exitLoginAuthzFail:
+		return ErrLogin
+	case exitTransport:
+		return ErrTransport
+	}
+
+	return fmt.Errorf("%w: %s", ErrCommand, exit.Output)
+}
@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package iscsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		exitCode int
+		expected error
+	}{
+		{name: "no objects", exitCode: 21, expected: ErrNotFound},
+		{name: "session exists", exitCode: 15, expected: ErrSessionExists},
+		{name: "auth failed", exitCode: 24, expected: ErrLogin},
+		{name: "transport", exitCode: 8, expected: ErrTransport},
+		{name: "other", exitCode: 1, expected: ErrCommand},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := classifyError(fmt.Errorf("wrapped: %w", &cmd.ExitError{ExitCode: test.exitCode, Output: []byte("iscsiadm: some error")}))
+
+			require.ErrorIs(t, err, test.expected)
+
+			var execErr *ExecError
+
+			require.True(t, errors.As(err, &execErr))
+			assert.Equal(t, test.exitCode, execErr.ExitCode)
+		})
+	}
+
+	assert.Equal(t, context.Canceled, classifyError(context.Canceled))
+}
+
+func TestNewNotInstalled(t *testing.T) {
+	_, err := New(WithIscsiadmPath(filepath.Join(t.TempDir(), "iscsiadm")))
+	require.ErrorIs(t, err, ErrNotInstalled)
+}
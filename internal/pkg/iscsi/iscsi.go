@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package iscsi provides a Go interface to the Linux iSCSI initiator via
+// the iscsiadm(8) utility.
+package iscsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+)
+
+// defaultIscsiadmPath is where the iscsi-tools system extension installs iscsiadm.
+const defaultIscsiadmPath = "/usr/local/sbin/iscsiadm"
+
+// ISCSI provides methods for managing iSCSI sessions.
+type ISCSI struct {
+	iscsiadm      string
+	initiatorName string
+}
+
+// New creates a new ISCSI instance.
+//
+// It returns ErrNotInstalled if the iscsiadm binary is missing.
+func New(opts ...Option) (*ISCSI, error) {
+	iscsi := &ISCSI{
+		iscsiadm: defaultIscsiadmPath,
+	}
+
+	for _, opt := range opts {
+		opt(iscsi)
+	}
+
+	if _, err := os.Stat(iscsi.iscsiadm); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s is missing, the iscsi-tools system extension is required", ErrNotInstalled, iscsi.iscsiadm)
+		}
+
+		return nil, fmt.Errorf("failed to stat %s: %w", iscsi.iscsiadm, err)
+	}
+
+	return iscsi, nil
+}
+
+// Option is a functional option for configuring the ISCSI instance.
+type Option func(*ISCSI)
+
+// WithIscsiadmPath sets an explicit path to the iscsiadm binary.
+func WithIscsiadmPath(path string) Option {
+	return func(iscsi *ISCSI) {
+		iscsi.iscsiadm = path
+	}
+}
+
+// WithInitiatorName sets the initiator name (IQN) the targets are logged into with.
+//
+// If not set, iscsiadm falls back to the default initiator name of open-iscsi.
+func WithInitiatorName(name string) Option {
+	return func(iscsi *ISCSI) {
+		iscsi.initiatorName = name
+	}
+}
+
+// Target describes an iSCSI target.
+type Target struct {
+	Portal string
+	Port   uint16
+	Name   string
+}
+
+// portal returns the target portal in the iscsiadm format.
+func (t Target) portal() string {
+	return net.JoinHostPort(t.Portal, strconv.FormatUint(uint64(t.Port), 10))
+}
+
+// run executes `iscsiadm <args...>` and returns stdout.
+func (iscsi *ISCSI) run(ctx context.Context, args ...string) (string, error) {
+	out, err := cmd.RunWithOptions(ctx, iscsi.iscsiadm, args, cmd.WithFullStdoutCapture())
+	if err != nil {
+		return "", fmt.Errorf("iscsiadm failed: %w", classifyError(err))
+	}
+
+	return out, nil
+}
+
+// Login creates the node record for the target and logs into it.
+//
+// Logging into a target which already has a session is not an error.
+func (iscsi *ISCSI) Login(ctx context.Context, target Target) error {
+	if _, err := iscsi.run(ctx, "-m", "node", "-T", target.Name, "-p", target.portal(), "-o", "new"); err != nil {
+		return fmt.Errorf("failed to create node record for %s: %w", target.Name, err)
+	}
+
+	if iscsi.initiatorName != "" {
+		if _, err := iscsi.run(ctx, "-m", "node", "-T", target.Name, "-p", target.portal(), "-o", "update", "-n", "iface.initiatorname", "-v", iscsi.initiatorName); err != nil {
+			return fmt.Errorf("failed to set the initiator name for %s: %w", target.Name, err)
+		}
+	}
+
+	if _, err := iscsi.run(ctx, "-m", "node", "-T", target.Name, "-p", target.portal(), "--login"); err != nil && !errors.Is(err, ErrSessionExists) {
+		return fmt.Errorf("failed to log into %s: %w", target.Name, err)
+	}
+
+	return nil
+}
+
+// Logout logs out of the target and removes the node record.
+//
+// Logging out of a target without a session is not an error.
+func (iscsi *ISCSI) Logout(ctx context.Context, target Target) error {
+	if _, err := iscsi.run(ctx, "-m", "node", "-T", target.Name, "-p", target.portal(), "--logout"); err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to log out of %s: %w", target.Name, err)
+	}
+
+	if _, err := iscsi.run(ctx, "-m", "node", "-T", target.Name, "-p", target.portal(), "-o", "delete"); err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to delete node record for %s: %w", target.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package iscsi
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Overridden in tests.
+var (
+	sysClassSessionDir    = "/sys/class/iscsi_session"
+	sysClassConnectionDir = "/sys/class/iscsi_connection"
+)
+
+// FindSession returns the name of the session (e.g. session1) logged into the target.
+func FindSession(target Target) (string, error) {
+	entries, err := os.ReadDir(sysClassSessionDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrNotFound
+		}
+
+		return "", fmt.Errorf("failed to read %s: %w", sysClassSessionDir, err)
+	}
+
+	port := strconv.FormatUint(uint64(target.Port), 10)
+
+	for _, entry := range entries {
+		session := entry.Name()
+
+		if readAttribute(filepath.Join(sysClassSessionDir, session, "targetname")) != target.Name {
+			continue
+		}
+
+		// the leading connection of the session is always connection<N>:0
+		connection := "connection" + strings.TrimPrefix(session, "session") + ":0"
+
+		if readAttribute(filepath.Join(sysClassConnectionDir, connection, "persistent_address")) != target.Portal {
+			continue
+		}
+
+		if readAttribute(filepath.Join(sysClassConnectionDir, connection, "persistent_port")) != port {
+			continue
+		}
+
+		return session, nil
+	}
+
+	return "", ErrNotFound
+}
+
+// FindLUN returns the block device path of the LUN exposed over the session.
+func FindLUN(session string, lun uint32) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(sysClassSessionDir, session, "device", "target*", "*:*:*:"+strconv.FormatUint(uint64(lun), 10), "block", "*"))
+	if err != nil {
+		return "", err
+	}
+
+	if len(matches) == 0 {
+		return "", ErrNotFound
+	}
+
+	return filepath.Join("/dev", filepath.Base(matches[0])), nil
+}
+
+func readAttribute(path string) string {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(contents))
+}
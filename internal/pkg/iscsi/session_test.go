@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package iscsi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSysfsHelpers(t *testing.T) {
+	oldSessionDir, oldConnectionDir := sysClassSessionDir, sysClassConnectionDir
+	sysClassSessionDir, sysClassConnectionDir = t.TempDir(), t.TempDir()
+
+	t.Cleanup(func() { sysClassSessionDir, sysClassConnectionDir = oldSessionDir, oldConnectionDir })
+
+	target := Target{
+		Portal: "10.0.0.1",
+		Port:   3260,
+		Name:   "iqn.2003-01.org.linux-iscsi.target:storage",
+	}
+
+	_, err := FindSession(target)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	for path, value := range map[string]string{
+		filepath.Join(sysClassSessionDir, "session1", "targetname"):                 "iqn.2003-01.org.linux-iscsi.target:other",
+		filepath.Join(sysClassConnectionDir, "connection1:0", "persistent_address"): "10.0.0.1",
+		filepath.Join(sysClassConnectionDir, "connection1:0", "persistent_port"):    "3260",
+		filepath.Join(sysClassSessionDir, "session2", "targetname"):                 target.Name,
+		filepath.Join(sysClassConnectionDir, "connection2:0", "persistent_address"): "10.0.0.1",
+		filepath.Join(sysClassConnectionDir, "connection2:0", "persistent_port"):    "3260",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(value+"\n"), 0o644))
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(sysClassSessionDir, "session2", "device", "target3:0:0", "3:0:0:0", "block", "sdb"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysClassSessionDir, "session2", "device", "target3:0:0", "3:0:0:1", "block", "sdc"), 0o755))
+
+	session, err := FindSession(target)
+	require.NoError(t, err)
+	assert.Equal(t, "session2", session)
+
+	dev, err := FindLUN(session, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/sdc", dev)
+
+	_, err = FindLUN(session, 2)
+	require.ErrorIs(t, err, ErrNotFound)
+}
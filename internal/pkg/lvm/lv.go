@@ -50,9 +50,18 @@ type LV struct {
 	MovePV            string `json:"move_pv"`
 	ConvertLV         string `json:"convert_lv"`
 	WhenFull          string `json:"lv_when_full"`
+	Time              string `json:"lv_time"`
+	DataPercent       string `json:"data_percent"`
+	SnapPercent       string `json:"snap_percent"`
+	SnapshotInvalid   string `json:"lv_snapshot_invalid"`
 	Tags              Tags   `json:"lv_tags"`
 }
 
+// IsSnapshot reports whether the LV is a snapshot (thick or thin) of another LV.
+func (lv LV) IsSnapshot() bool {
+	return lv.Origin != ""
+}
+
 // LVS runs `lvm lvs -a -o +all --reportformat json --units b --nosuffix`
 // and returns the parsed records.
 func (lvm *LVM) LVS(ctx context.Context) ([]LV, error) {
@@ -88,18 +97,24 @@ type LVCreateOptions struct {
 	// SizePercentVG, when non-zero, sizes the LV as a percentage of the VG
 	// (`-l <n>%VG`) and takes precedence over SizeBytes.
 	SizePercentVG uint32
+	// ThinPool is the name of the thin pool LV (in the same VG) backing a
+	// thin LV, passed as `--thinpool`.
+	ThinPool string
 }
 
 // LVCreate runs `lvm lvcreate` to create a logical volume in the given VG.
 //
 // Supported layouts and their flags:
-//   - linear: none
-//   - raid0:  --type raid0 --stripes N
-//   - raid1:  --type raid1 --mirrors M
-//   - raid10: --type raid10 --mirrors M --stripes N
+//   - linear:    none
+//   - raid0:     --type raid0 --stripes N
+//   - raid1:     --type raid1 --mirrors M
+//   - raid10:    --type raid10 --mirrors M --stripes N
+//   - thin-pool: --type thin-pool
+//   - thin:      --type thin --thinpool P
 //
 // Size is either absolute (`-L <bytes>b`) or a percentage of the VG
-// (`-l <n>%VG`).
+// (`-l <n>%VG`). Thin LVs only have a virtual size (`-V <bytes>b`), which
+// must be absolute.
 //
 // --yes is intentionally NOT passed: the LV is freshly allocated, and we do not
 // want to silently wipe any signature lvcreate might detect. --reportformat=json
@@ -141,11 +156,25 @@ func (lvm *LVM) LVCreate(ctx context.Context, vg, lv string, opts LVCreateOption
 		}
 
 		args = append(args, "--type", "raid10", "--mirrors", fmt.Sprintf("%d", opts.Mirrors), "--stripes", fmt.Sprintf("%d", opts.Stripes))
+	case "thin-pool":
+		args = append(args, "--type", "thin-pool")
+	case "thin":
+		if opts.ThinPool == "" {
+			return fmt.Errorf("thin requires a thin pool")
+		}
+
+		if opts.SizeBytes == 0 {
+			return fmt.Errorf("thin requires SizeBytes to be set")
+		}
+
+		args = append(args, "--type", "thin", "--thinpool", opts.ThinPool, "-V", fmt.Sprintf("%db", opts.SizeBytes))
 	default:
 		return fmt.Errorf("unsupported logical volume type %q", opts.Type)
 	}
 
 	switch {
+	case opts.Type == "thin":
+		// thin LVs are allocated from the pool, not from the VG
 	case opts.SizePercentVG > 0:
 		args = append(args, "-l", fmt.Sprintf("%d%%VG", opts.SizePercentVG))
 	case opts.SizeBytes > 0:
@@ -201,6 +230,54 @@ func (lvm *LVM) LVExtend(ctx context.Context, vg, lv string, opts LVExtendOption
 	return err
 }
 
+// SnapshotCreateOptions configures SnapshotCreate.
+type SnapshotCreateOptions struct {
+	// SizeBytes is the copy-on-write space reserved for a thick snapshot,
+	// passed as `-L <n>b`. Must be zero for snapshots of thin LVs, which
+	// allocate from the thin pool instead.
+	SizeBytes uint64
+}
+
+// SnapshotCreate runs `lvm lvcreate --snapshot` to take a snapshot of the
+// origin LV.
+//
+// Thin snapshots are created with activation skipped by default; the
+// `-kn` flag is passed so the snapshot is active (and listed in /dev) right
+// away, matching thick snapshots.
+func (lvm *LVM) SnapshotCreate(ctx context.Context, vg, origin, snapshot string, opts SnapshotCreateOptions) error {
+	if vg == "" || origin == "" || snapshot == "" {
+		return fmt.Errorf("vg, origin and snapshot must be non-empty")
+	}
+
+	args := []string{"--snapshot", "-n", snapshot}
+
+	if opts.SizeBytes > 0 {
+		args = append(args, "-L", fmt.Sprintf("%db", opts.SizeBytes))
+	} else {
+		args = append(args, "-kn")
+	}
+
+	_, err := lvm.run(ctx, "lvcreate", append(args, fmt.Sprintf("%s/%s", vg, origin))...)
+
+	return err
+}
+
+// SnapshotMerge runs `lvm lvconvert --merge <vg>/<snapshot>` to roll the
+// origin LV back to the snapshot, consuming the snapshot.
+//
+// If the origin is open (e.g. mounted), LVM defers the merge until the next
+// activation of the origin, i.e. the next reboot; the returned error is nil
+// in that case as well.
+func (lvm *LVM) SnapshotMerge(ctx context.Context, vg, snapshot string) error {
+	if vg == "" || snapshot == "" {
+		return fmt.Errorf("vg and snapshot must be non-empty")
+	}
+
+	_, err := lvm.run(ctx, "lvconvert", "--merge", "--yes", fmt.Sprintf("%s/%s", vg, snapshot))
+
+	return err
+}
+
 // LVRemove runs `lvm lvremove --yes <vg>/<lv>` to remove a logical volume.
 //
 // --reportformat=json is intentionally NOT passed here: with that flag set,
@@ -98,11 +98,23 @@ func TestLVCreateRejectsEmptyVGorLV(t *testing.T) {
 func TestLVCreateRejectsUnknownType(t *testing.T) {
 	l := newLVM(t)
 
-	err := l.LVCreate(context.Background(), "vg0", "lv0", lvm.LVCreateOptions{Type: "thin", SizeBytes: 1})
+	err := l.LVCreate(context.Background(), "vg0", "lv0", lvm.LVCreateOptions{Type: "cache", SizeBytes: 1})
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unsupported logical volume type")
 }
 
+func TestLVCreateRejectsBadThinParams(t *testing.T) {
+	l := newLVM(t)
+
+	err := l.LVCreate(context.Background(), "vg0", "lv0", lvm.LVCreateOptions{Type: "thin", SizeBytes: 1})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "thin requires a thin pool")
+
+	err = l.LVCreate(context.Background(), "vg0", "lv0", lvm.LVCreateOptions{Type: "thin", ThinPool: "pool", SizePercentVG: 10})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "thin requires SizeBytes to be set")
+}
+
 func TestLVCreateRejectsNoSize(t *testing.T) {
 	l := newLVM(t)
 
@@ -127,6 +139,18 @@ func TestLVCreateRejectsBadRAIDParams(t *testing.T) {
 	require.Contains(t, err.Error(), "raid10 requires at least 2 stripes")
 }
 
+func TestSnapshotRejectsEmptyNames(t *testing.T) {
+	l := newLVM(t)
+
+	err := l.SnapshotCreate(context.Background(), "vg0", "lv0", "", lvm.SnapshotCreateOptions{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "vg, origin and snapshot must be non-empty")
+
+	err = l.SnapshotMerge(context.Background(), "", "snap0")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "vg and snapshot must be non-empty")
+}
+
 func TestLVExtendRejectsEmptyVGorLV(t *testing.T) {
 	l := newLVM(t)
 
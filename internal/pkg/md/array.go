@@ -306,6 +306,22 @@ func (md *MD) Fail(ctx context.Context, device, member string) error {
 	return err
 }
 
+// Replace hot-replaces a member device of an MD array with a new device.
+//
+// The replacement is added as a spare first, then rebuilt from the old member
+// (and the remaining members) while the old member stays active; once the
+// rebuild finishes the kernel marks the old member faulty, and it can be
+// removed with Remove.
+func (md *MD) Replace(ctx context.Context, device, member, replacement string) error {
+	if device == "" || member == "" || replacement == "" {
+		return fmt.Errorf("%w: device, member and replacement must be set", ErrInvalidArgument)
+	}
+
+	_, err := md.run(ctx, device, "--add", replacement, "--replace", member, "--with", replacement)
+
+	return err
+}
+
 // Remove detaches a member device from an MD array.
 func (md *MD) Remove(ctx context.Context, device, member string) error {
 	if device == "" || member == "" {
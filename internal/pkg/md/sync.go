@@ -0,0 +1,178 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package md
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// SyncStatus is the sync and health state of an MD array as reported by sysfs.
+type SyncStatus struct {
+	// Level is the sysfs level value, e.g. raid1.
+	Level string
+	// ArrayState is the sysfs array_state value.
+	ArrayState string
+	// SyncAction is the sync operation currently running.
+	SyncAction SyncAction
+	// SyncCompleted is the number of sectors processed by the running sync operation.
+	SyncCompleted uint64
+	// SyncTotal is the number of sectors the running sync operation covers.
+	SyncTotal uint64
+	// MismatchCount is the number of mismatched sectors found by the last check or repair.
+	MismatchCount uint64
+	// RaidDevices is the number of member slots of the array.
+	RaidDevices int
+	// DegradedDevices is the number of member slots missing a working device.
+	DegradedDevices int
+}
+
+// SyncProgress returns the progress of the running sync operation in percent,
+// or zero if no sync operation is running.
+func (s SyncStatus) SyncProgress() float64 {
+	if s.SyncTotal == 0 {
+		return 0
+	}
+
+	return float64(s.SyncCompleted) * 100 / float64(s.SyncTotal)
+}
+
+// Arrays returns the /dev/mdN nodes of all MD arrays known to the kernel.
+func (*MD) Arrays() ([]string, error) {
+	return Arrays()
+}
+
+// Arrays returns the /dev/mdN nodes of all MD arrays known to the kernel.
+func Arrays() ([]string, error) {
+	entries, err := os.ReadDir(sysBlockDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", sysBlockDir, err)
+	}
+
+	var arrays []string
+
+	for _, e := range entries {
+		name := e.Name()
+		if !strings.HasPrefix(name, "md") {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join(sysBlockDir, name, "md")); err != nil {
+			continue
+		}
+
+		arrays = append(arrays, filepath.Join("/dev", name))
+	}
+
+	return arrays, nil
+}
+
+// SyncStatusForDevice returns the sync and health state of an MD device.
+func (*MD) SyncStatusForDevice(device string) (SyncStatus, error) {
+	return SyncStatusForDevice(device)
+}
+
+// SyncStatusForDevice returns the sync and health state of an MD device.
+//
+// Attributes which only exist for redundant levels (mismatch_cnt, degraded)
+// are reported as zero for raid0 and linear arrays.
+func SyncStatusForDevice(device string) (SyncStatus, error) {
+	var (
+		status SyncStatus
+		err    error
+	)
+
+	if status.ArrayState, err = readMDAttribute(device, "array_state"); err != nil {
+		return SyncStatus{}, err
+	}
+
+	if status.Level, err = readMDAttribute(device, "level"); err != nil {
+		return SyncStatus{}, err
+	}
+
+	if action, err := readMDAttribute(device, "sync_action"); err == nil {
+		status.SyncAction = SyncAction(action)
+	}
+
+	if completed, err := readMDAttribute(device, "sync_completed"); err == nil {
+		status.SyncCompleted, status.SyncTotal = parseSyncCompleted(completed)
+	}
+
+	if raw, err := readMDAttribute(device, "mismatch_cnt"); err == nil {
+		status.MismatchCount, _ = strconv.ParseUint(raw, 10, 64) //nolint:errcheck
+	}
+
+	if raw, err := readMDAttribute(device, "raid_disks"); err == nil {
+		status.RaidDevices, _ = strconv.Atoi(raw) //nolint:errcheck
+	}
+
+	if raw, err := readMDAttribute(device, "degraded"); err == nil {
+		status.DegradedDevices, _ = strconv.Atoi(raw) //nolint:errcheck
+	}
+
+	return status, nil
+}
+
+// parseSyncCompleted parses the sysfs sync_completed value ("none" or
+// "<completed> / <total>" in sectors).
+func parseSyncCompleted(raw string) (completed, total uint64) {
+	left, right, ok := strings.Cut(raw, "/")
+	if !ok {
+		return 0, 0
+	}
+
+	completed, err := strconv.ParseUint(strings.TrimSpace(left), 10, 64)
+	if err != nil {
+		return 0, 0
+	}
+
+	total, err = strconv.ParseUint(strings.TrimSpace(right), 10, 64)
+	if err != nil {
+		return 0, 0
+	}
+
+	return completed, total
+}
+
+// SetSyncAction starts (check, repair) or aborts (idle) a sync operation on an MD device.
+func (*MD) SetSyncAction(device string, action SyncAction) error {
+	return SetSyncAction(device, action)
+}
+
+// SetSyncAction starts (check, repair) or aborts (idle) a sync operation on an MD device.
+//
+// The kernel refuses to start a check or repair while another sync operation
+// (e.g. a rebuild) is running; ErrResync is returned in that case.
+func SetSyncAction(device string, action SyncAction) error {
+	if device == "" {
+		return fmt.Errorf("%w: device must be set", ErrInvalidArgument)
+	}
+
+	switch action { //nolint:exhaustive
+	case SyncActionCheck, SyncActionRepair, SyncActionIdle:
+	default:
+		return fmt.Errorf("%w: unsupported sync action %q", ErrInvalidArgument, action)
+	}
+
+	path := filepath.Join(sysBlockDir, filepath.Base(device), "md", "sync_action")
+
+	if err := os.WriteFile(path, []byte(action), 0o644); err != nil {
+		switch {
+		case os.IsNotExist(err):
+			return ErrNotFound
+		case errors.Is(err, syscall.EBUSY):
+			return ErrResync
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package md
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncStatus(t *testing.T) {
+	oldSysBlockDir := sysBlockDir
+	sysBlockDir = t.TempDir()
+	t.Cleanup(func() { sysBlockDir = oldSysBlockDir })
+
+	mdDir := filepath.Join(sysBlockDir, "md0", "md")
+	require.NoError(t, os.MkdirAll(mdDir, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysBlockDir, "sda"), 0o755))
+
+	for attr, value := range map[string]string{
+		"array_state":    "clean",
+		"level":          "raid1",
+		"sync_action":    "check",
+		"sync_completed": "1024 / 4096",
+		"mismatch_cnt":   "128",
+		"raid_disks":     "2",
+		"degraded":       "1",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(mdDir, attr), []byte(value+"\n"), 0o644))
+	}
+
+	arrays, err := Arrays()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/dev/md0"}, arrays)
+
+	status, err := SyncStatusForDevice("/dev/md0")
+	require.NoError(t, err)
+	assert.Equal(t, SyncStatus{
+		Level:           "raid1",
+		ArrayState:      "clean",
+		SyncAction:      SyncActionCheck,
+		SyncCompleted:   1024,
+		SyncTotal:       4096,
+		MismatchCount:   128,
+		RaidDevices:     2,
+		DegradedDevices: 1,
+	}, status)
+	assert.InDelta(t, 25.0, status.SyncProgress(), 0.001)
+
+	_, err = SyncStatusForDevice("/dev/md1")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, SetSyncAction("/dev/md0", SyncActionRepair))
+
+	action, err := SyncActionForDevice("/dev/md0")
+	require.NoError(t, err)
+	assert.Equal(t, SyncActionRepair, action)
+
+	require.ErrorIs(t, SetSyncAction("/dev/md0", SyncActionReshape), ErrInvalidArgument)
+	require.ErrorIs(t, SetSyncAction("/dev/md1", SyncActionCheck), ErrNotFound)
+}
+
+func TestParseSyncCompleted(t *testing.T) {
+	for _, test := range []struct {
+		raw       string
+		completed uint64
+		total     uint64
+	}{
+		{raw: "none"},
+		{raw: "delayed"},
+		{raw: "0 / 209715200", total: 209715200},
+		{raw: "104857600 / 209715200", completed: 104857600, total: 209715200},
+	} {
+		completed, total := parseSyncCompleted(test.raw)
+
+		assert.Equal(t, test.completed, completed, test.raw)
+		assert.Equal(t, test.total, total, test.raw)
+	}
+}
@@ -93,9 +93,9 @@ func (p *Point) Mount(opts Options) error {
 
 // FilterSelinuxLabelErrors filters out certain errors when setting the SELinux label on the mount point.
 //   - ENOTSUP is ignored for all filesystems, as it indicates that the filesystem does not support extended attributes.
-//   - EROFS is ignored for virtiofs, as it indicates that the underlying filesystem is read-only and does not support setting labels.
-//   - EPERM is ignored for virtiofs, as setting security.selinux xattrs may be blocked by the host or virtiofsd
-//     even though the mount is otherwise functional.
+//   - EROFS is ignored for virtiofs and nfs, as it indicates that the underlying filesystem is read-only and does not support setting labels.
+//   - EPERM is ignored for virtiofs and nfs, as setting security.selinux xattrs may be blocked by the host, virtiofsd
+//     or the NFS server (e.g. root squashing) even though the mount is otherwise functional.
 func FilterSelinuxLabelErrors(target, fstype string, err error) error {
 	if err == nil {
 		return nil
@@ -105,7 +105,7 @@ func FilterSelinuxLabelErrors(target, fstype string, err error) error {
 		return nil
 	}
 
-	if fstype == "virtiofs" {
+	if fstype == "virtiofs" || fstype == "nfs" {
 		switch {
 		case errors.Is(err, unix.EROFS):
 			return nil
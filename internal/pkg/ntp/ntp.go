@@ -8,11 +8,14 @@ package ntp
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"crypto/x509"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/bits"
 	"net"
+	"net/netip"
 	"os"
 	"slices"
 	"strings"
@@ -55,6 +58,10 @@ type Syncer struct {
 	spikeStatus   SpikeStatus
 	spikeStatusCh chan struct{}
 
+	sourceStatusMu sync.Mutex
+	sourceStatus   SourceStatus
+	sourceStatusCh chan struct{}
+
 	MinPoll, MaxPoll, RetryPoll time.Duration
 
 	// these functions are overridden in tests for mocking support
@@ -80,6 +87,7 @@ type Measurement struct {
 	ClockOffset time.Duration
 	Leap        ntp.LeapIndicator
 	Spike       bool
+	Source      SourceStatus
 }
 
 // SpikeStatus describes the state of the spike filter.
@@ -94,6 +102,51 @@ type SpikeStatus struct {
 	Consecutive int
 }
 
+// SourceStatus describes the time source the clock is synchronized to.
+//
+// It is announced to the clients of the NTP server, so that they can judge the quality of the time
+// they receive: the zero value describes a clock which is not synchronized.
+type SourceStatus struct {
+	// Stratum is the stratum of the local clock, i.e. the stratum of the time source plus one.
+	Stratum uint8
+	// Leap is the leap second indicator announced by the time source.
+	Leap ntp.LeapIndicator
+	// ReferenceID identifies the time source.
+	ReferenceID uint32
+	// ReferenceTime is the time the clock was last synchronized.
+	ReferenceTime time.Time
+	// RootDelay is the total round-trip delay to the reference clock.
+	RootDelay time.Duration
+	// RootDispersion is the total dispersion to the reference clock at the reference time.
+	RootDispersion time.Duration
+}
+
+// ptpReferenceID is the reference ID announced when the clock is synchronized to a PTP device.
+const ptpReferenceID = uint32('P')<<24 | uint32('H')<<16 | uint32('C')<<8
+
+// referenceID returns the NTP reference ID identifying an upstream server (RFC 5905, section 7.3):
+// the IPv4 address, or the first four octets of the MD5 hash of the address (or of the hostname with NTS).
+func referenceID(server string) uint32 {
+	if addr, err := netip.ParseAddr(server); err == nil && addr.Is4() {
+		return binary.BigEndian.Uint32(addr.AsSlice())
+	}
+
+	sum := md5.Sum([]byte(server)) //nolint:gosec // mandated by RFC 5905, not used for security
+
+	return binary.BigEndian.Uint32(sum[:4])
+}
+
+// sourceStatusFromResponse builds the state of the local clock synchronized to the NTP server.
+func sourceStatusFromResponse(server string, resp *ntp.Response) SourceStatus {
+	return SourceStatus{
+		Stratum:        resp.Stratum + 1,
+		Leap:           resp.Leap,
+		ReferenceID:    referenceID(server),
+		RootDelay:      resp.RootDelay + resp.RTT,
+		RootDispersion: resp.RootDispersion + resp.Precision,
+	}
+}
+
 // NewSyncer creates new Syncer with default configuration.
 func NewSyncer(logger *zap.Logger, timeServers []string, useNTS bool) *Syncer {
 	syncer := &Syncer{
@@ -106,6 +159,8 @@ func NewSyncer(logger *zap.Logger, timeServers []string, useNTS bool) *Syncer {
 		epochChangeCh: make(chan struct{}, 1),
 		spikeStatusCh: make(chan struct{}, 1),
 
+		sourceStatusCh: make(chan struct{}, 1),
+
 		firstSync: true,
 
 		spikeDetector: spike.Detector{},
@@ -167,6 +222,31 @@ func (syncer *Syncer) setSpikeStatus(status SpikeStatus) {
 	}
 }
 
+// SourceStatusChange returns a channel which receives a value each time the clock is synchronized
+// to the time source.
+func (syncer *Syncer) SourceStatusChange() <-chan struct{} {
+	return syncer.sourceStatusCh
+}
+
+// SourceStatus returns the state of the time source the clock was last synchronized to.
+func (syncer *Syncer) SourceStatus() SourceStatus {
+	syncer.sourceStatusMu.Lock()
+	defer syncer.sourceStatusMu.Unlock()
+
+	return syncer.sourceStatus
+}
+
+func (syncer *Syncer) setSourceStatus(status SourceStatus) {
+	syncer.sourceStatusMu.Lock()
+	syncer.sourceStatus = status
+	syncer.sourceStatusMu.Unlock()
+
+	select {
+	case syncer.sourceStatusCh <- struct{}{}:
+	default:
+	}
+}
+
 func (syncer *Syncer) getTimeServers() []string {
 	syncer.timeServersMu.Lock()
 	defer syncer.timeServersMu.Unlock()
@@ -352,6 +432,11 @@ func (syncer *Syncer) Run(ctx context.Context) {
 		if resp != nil && !spikeDetected {
 			err = syncer.adjustTime(resp.ClockOffset, resp.Leap, lastSyncServer, pollInterval, rtcClock)
 			if err == nil {
+				source := resp.Source
+				source.ReferenceTime = syncer.CurrentTime()
+
+				syncer.setSourceStatus(source)
+
 				if !syncer.timeSyncNotified {
 					// successful first time sync, notify about it
 					close(syncer.timeSynced)
@@ -516,6 +601,11 @@ func (syncer *Syncer) queryPTP(device string) (*Measurement, bool, error) {
 		ClockOffset: offset,
 		Leap:        0,
 		Spike:       false,
+		// PTP hardware clocks are reference clocks (stratum 0)
+		Source: SourceStatus{
+			Stratum:     1,
+			ReferenceID: ptpReferenceID,
+		},
 	}
 
 	return meas, false, err
@@ -577,6 +667,7 @@ func (syncer *Syncer) queryNTP(server string) (*Measurement, bool, error) {
 		ClockOffset: resp.ClockOffset,
 		Leap:        resp.Leap,
 		Spike:       syncer.isSpike(server, resp),
+		Source:      sourceStatusFromResponse(server, resp),
 	}, false, nil
 }
 
@@ -629,6 +720,7 @@ func (syncer *Syncer) queryNTS(server string) (*Measurement, bool, error) {
 		ClockOffset: resp.ClockOffset,
 		Leap:        resp.Leap,
 		Spike:       syncer.isSpike(server, resp),
+		Source:      sourceStatusFromResponse(server, resp),
 	}, false, nil
 }
 
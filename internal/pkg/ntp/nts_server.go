@@ -0,0 +1,618 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/beevik/ntp"
+	"github.com/secure-io/siv-go"
+	"go.uber.org/zap"
+)
+
+// NTS constants (RFC 8915).
+const (
+	// NTSKEALPN is the ALPN protocol ID of NTS key establishment.
+	NTSKEALPN = "ntske/1"
+
+	ntsKERecordEndOfMessage      = 0
+	ntsKERecordNextProtocol      = 1
+	ntsKERecordError             = 2
+	ntsKERecordWarning           = 3
+	ntsKERecordAEADAlgorithm     = 4
+	ntsKERecordNewCookie         = 5
+	ntsKERecordServerNegotiation = 6
+	ntsKERecordPortNegotiation   = 7
+
+	ntsKECriticalBit = 0x8000
+
+	ntsKEErrorUnrecognizedCritical = 0
+	ntsKEErrorBadRequest           = 1
+
+	ntsProtocolNTPv4 = 0
+
+	ntsAEADAESSIVCMAC256 = 15
+	ntsAEADKeyLen        = 32
+
+	ntsExporterLabel = "EXPORTER-network-time-security"
+
+	// ntsKECookies is the number of cookies handed out in the key establishment response.
+	ntsKECookies = 8
+	// ntsKEMaxRequestLen limits the size of the key establishment request.
+	ntsKEMaxRequestLen = 1024
+	// ntsKETimeout is the deadline for the whole key establishment exchange.
+	ntsKETimeout = 10 * time.Second
+
+	ntsExtensionUniqueIdentifier  = 0x0104
+	ntsExtensionCookie            = 0x0204
+	ntsExtensionCookiePlaceholder = 0x0304
+	ntsExtensionAuthenticator     = 0x0404
+
+	ntsExtensionFieldHeaderLen = 4
+	ntsAuthenticatorHeaderLen  = 4
+	ntsUniqueIdentifierMinLen  = 32
+	ntsNonceLen                = 16
+
+	// ntsKissCodeNAK is the kiss code of the NTS negative acknowledgment.
+	ntsKissCodeNAK = uint32('N')<<24 | uint32('T')<<16 | uint32('S')<<8 | uint32('N')
+
+	// ntsMaxCookiesPerResponse limits the number of cookies sent back in a single NTP response.
+	ntsMaxCookiesPerResponse = 8
+
+	ntsCookieKeyIDLen            = 4
+	ntsCookieHeaderLen           = 4
+	ntsCookiePlaintextLen        = ntsCookieHeaderLen + 2*ntsAEADKeyLen
+	ntsCookieKeyRotationInterval = 24 * time.Hour
+
+	ntsDefaultNTPPort = 123
+)
+
+// ntsKeys is a pair of NTS session keys exported from the key establishment TLS session.
+type ntsKeys struct {
+	c2s []byte
+	s2c []byte
+}
+
+type ntsCookieKey struct {
+	id      uint32
+	key     []byte
+	created time.Time
+}
+
+// NTSCookieKeys holds the server keys used to encrypt the NTS session keys into cookies.
+//
+// Cookies are opaque to the clients: the server doesn't keep any per-client state, and
+// recovers the session keys from the cookie carried by each request. The keys are rotated
+// daily, cookies encrypted with the previous key are still accepted.
+type NTSCookieKeys struct {
+	mu       sync.Mutex
+	current  ntsCookieKey
+	previous ntsCookieKey
+
+	// CurrentTime is overridden in tests for mocking support.
+	CurrentTime CurrentTimeFunc
+}
+
+// NewNTSCookieKeys creates a new set of cookie keys.
+func NewNTSCookieKeys() (*NTSCookieKeys, error) {
+	keys := &NTSCookieKeys{
+		CurrentTime: time.Now,
+	}
+
+	if err := keys.rotate(0); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (keys *NTSCookieKeys) rotate(id uint32) error {
+	key := make([]byte, ntsAEADKeyLen)
+
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("error generating NTS cookie key: %w", err)
+	}
+
+	keys.previous = keys.current
+	keys.current = ntsCookieKey{
+		id:      id,
+		key:     key,
+		created: keys.CurrentTime(),
+	}
+
+	return nil
+}
+
+// seal encrypts the session keys into a new cookie.
+func (keys *NTSCookieKeys) seal(session ntsKeys) ([]byte, error) {
+	keys.mu.Lock()
+
+	if keys.CurrentTime().Sub(keys.current.created) > ntsCookieKeyRotationInterval {
+		if err := keys.rotate(keys.current.id + 1); err != nil {
+			keys.mu.Unlock()
+
+			return nil, err
+		}
+	}
+
+	current := keys.current
+
+	keys.mu.Unlock()
+
+	aead, err := siv.NewCMAC(current.key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := binary.BigEndian.AppendUint32(nil, current.id)
+
+	nonce := make([]byte, ntsNonceLen)
+
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	// the AEAD algorithm ID is padded, so that the cookie length is a multiple of 4 (RFC 7822)
+	plaintext := binary.BigEndian.AppendUint32(nil, ntsAEADAESSIVCMAC256)
+	plaintext = append(plaintext, session.c2s...)
+	plaintext = append(plaintext, session.s2c...)
+
+	cookie := append(slices.Clone(keyID), nonce...)
+
+	return aead.Seal(cookie, nonce, plaintext, keyID), nil
+}
+
+// open recovers the session keys from the cookie.
+func (keys *NTSCookieKeys) open(cookie []byte) (ntsKeys, error) {
+	if len(cookie) < ntsCookieKeyIDLen+ntsNonceLen {
+		return ntsKeys{}, errors.New("cookie is too short")
+	}
+
+	id := binary.BigEndian.Uint32(cookie)
+
+	keys.mu.Lock()
+
+	var key []byte
+
+	switch id {
+	case keys.current.id:
+		key = keys.current.key
+	case keys.previous.id:
+		key = keys.previous.key
+	}
+
+	keys.mu.Unlock()
+
+	if key == nil {
+		return ntsKeys{}, fmt.Errorf("unknown cookie key %d", id)
+	}
+
+	aead, err := siv.NewCMAC(key)
+	if err != nil {
+		return ntsKeys{}, err
+	}
+
+	nonce := cookie[ntsCookieKeyIDLen : ntsCookieKeyIDLen+ntsNonceLen]
+
+	plaintext, err := aead.Open(nil, nonce, cookie[ntsCookieKeyIDLen+ntsNonceLen:], cookie[:ntsCookieKeyIDLen])
+	if err != nil {
+		return ntsKeys{}, fmt.Errorf("error decrypting cookie: %w", err)
+	}
+
+	if len(plaintext) != ntsCookiePlaintextLen || binary.BigEndian.Uint32(plaintext) != ntsAEADAESSIVCMAC256 {
+		return ntsKeys{}, errors.New("invalid cookie contents")
+	}
+
+	return ntsKeys{
+		c2s: plaintext[ntsCookieHeaderLen : ntsCookieHeaderLen+ntsAEADKeyLen],
+		s2c: plaintext[ntsCookieHeaderLen+ntsAEADKeyLen:],
+	}, nil
+}
+
+// ntsRequest is an NTP request carrying NTS extension fields.
+type ntsRequest struct {
+	request          []byte
+	uniqueIdentifier []byte
+	cookie           []byte
+	placeholders     int
+
+	// authenticatorOffset is the offset of the authenticator extension field in the request.
+	authenticatorOffset int
+	nonce               []byte
+	ciphertext          []byte
+}
+
+// parseNTSRequest parses the extension fields of the request.
+//
+// It returns nil if the request doesn't carry NTS extension fields.
+//
+//nolint:gocyclo
+func parseNTSRequest(req []byte) (*ntsRequest, error) {
+	var (
+		isNTS    bool
+		nCookies int
+	)
+
+	ntsReq := ntsRequest{
+		request: req,
+	}
+
+	for offset := ntpHeaderLen; offset < len(req); {
+		if len(req)-offset < ntsExtensionFieldHeaderLen {
+			return nil, errors.New("truncated extension field")
+		}
+
+		fieldType := binary.BigEndian.Uint16(req[offset:])
+		fieldLen := int(binary.BigEndian.Uint16(req[offset+2:]))
+
+		if fieldLen < ntsExtensionFieldHeaderLen || fieldLen%4 != 0 || offset+fieldLen > len(req) {
+			return nil, fmt.Errorf("invalid extension field length %d", fieldLen)
+		}
+
+		body := req[offset+ntsExtensionFieldHeaderLen : offset+fieldLen]
+
+		switch fieldType {
+		case ntsExtensionUniqueIdentifier:
+			isNTS = true
+			ntsReq.uniqueIdentifier = body
+		case ntsExtensionCookie:
+			isNTS = true
+			nCookies++
+			ntsReq.cookie = body
+		case ntsExtensionCookiePlaceholder:
+			isNTS = true
+			ntsReq.placeholders++
+		case ntsExtensionAuthenticator:
+			isNTS = true
+			ntsReq.authenticatorOffset = offset
+
+			if err := ntsReq.parseAuthenticator(body); err != nil {
+				return nil, err
+			}
+		}
+
+		if fieldType == ntsExtensionAuthenticator {
+			// anything following the authenticator is not authenticated, so it is ignored
+			break
+		}
+
+		offset += fieldLen
+	}
+
+	if !isNTS {
+		return nil, nil //nolint:nilnil
+	}
+
+	switch {
+	case len(ntsReq.uniqueIdentifier) < ntsUniqueIdentifierMinLen:
+		return nil, errors.New("missing or short unique identifier")
+	case nCookies != 1:
+		return nil, fmt.Errorf("expected exactly one cookie, got %d", nCookies)
+	case ntsReq.authenticatorOffset == 0:
+		return nil, errors.New("missing authenticator")
+	}
+
+	return &ntsReq, nil
+}
+
+func (ntsReq *ntsRequest) parseAuthenticator(body []byte) error {
+	if len(body) < ntsAuthenticatorHeaderLen {
+		return errors.New("truncated authenticator")
+	}
+
+	nonceLen := int(binary.BigEndian.Uint16(body))
+	ciphertextLen := int(binary.BigEndian.Uint16(body[2:]))
+
+	nonceStart := ntsAuthenticatorHeaderLen
+	ciphertextStart := nonceStart + padTo4(nonceLen)
+
+	if nonceLen != ntsNonceLen || ciphertextStart+ciphertextLen > len(body) {
+		return errors.New("invalid authenticator")
+	}
+
+	ntsReq.nonce = body[nonceStart : nonceStart+nonceLen]
+	ntsReq.ciphertext = body[ciphertextStart : ciphertextStart+ciphertextLen]
+
+	return nil
+}
+
+// authenticate recovers the session keys from the cookie and verifies the request.
+func (ntsReq *ntsRequest) authenticate(cookies *NTSCookieKeys) (ntsKeys, error) {
+	keys, err := cookies.open(ntsReq.cookie)
+	if err != nil {
+		return ntsKeys{}, err
+	}
+
+	aead, err := siv.NewCMAC(keys.c2s)
+	if err != nil {
+		return ntsKeys{}, err
+	}
+
+	if _, err = aead.Open(nil, ntsReq.nonce, ntsReq.ciphertext, ntsReq.associatedData()); err != nil {
+		return ntsKeys{}, fmt.Errorf("error verifying authenticator: %w", err)
+	}
+
+	return keys, nil
+}
+
+// associatedData is the part of the request covered by the authenticator.
+func (ntsReq *ntsRequest) associatedData() []byte {
+	return ntsReq.request[:ntsReq.authenticatorOffset]
+}
+
+// seal appends the NTS extension fields to the response header: the unique identifier,
+// and the authenticator encrypting fresh cookies for the client.
+func (ntsReq *ntsRequest) seal(resp []byte, keys ntsKeys, cookies *NTSCookieKeys) ([]byte, error) {
+	resp = appendExtensionField(resp, ntsExtensionUniqueIdentifier, ntsReq.uniqueIdentifier)
+
+	var plaintext []byte
+
+	for range min(1+ntsReq.placeholders, ntsMaxCookiesPerResponse) {
+		cookie, err := cookies.seal(keys)
+		if err != nil {
+			return nil, err
+		}
+
+		plaintext = appendExtensionField(plaintext, ntsExtensionCookie, cookie)
+	}
+
+	aead, err := siv.NewCMAC(keys.s2c)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, ntsNonceLen)
+
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	ciphertext := aead.Seal(nil, nonce, plaintext, resp)
+
+	body := binary.BigEndian.AppendUint16(nil, uint16(len(nonce)))
+	body = binary.BigEndian.AppendUint16(body, uint16(len(ciphertext)))
+	body = append(body, nonce...)
+	body = append(body, ciphertext...)
+
+	return appendExtensionField(resp, ntsExtensionAuthenticator, body), nil
+}
+
+// nak builds the NTS negative acknowledgment (kiss code NTSN) for the request which failed
+// the authentication, so that the client restarts the key establishment.
+func (ntsReq *ntsRequest) nak(req []byte) []byte {
+	resp := make([]byte, ntpHeaderLen, ntpMaxPacketLen)
+
+	resp[0] = byte(ntp.LeapNotInSync)<<6 | ntpMaxVersion<<3 | ntpModeServer
+	binary.BigEndian.PutUint32(resp[12:], ntsKissCodeNAK)
+	copy(resp[24:32], req[40:48])
+
+	return appendExtensionField(resp, ntsExtensionUniqueIdentifier, ntsReq.uniqueIdentifier)
+}
+
+func appendExtensionField(b []byte, fieldType uint16, body []byte) []byte {
+	fieldLen := ntsExtensionFieldHeaderLen + padTo4(len(body))
+
+	b = binary.BigEndian.AppendUint16(b, fieldType)
+	b = binary.BigEndian.AppendUint16(b, uint16(fieldLen))
+	b = append(b, body...)
+
+	return append(b, make([]byte, fieldLen-ntsExtensionFieldHeaderLen-len(body))...)
+}
+
+func padTo4(n int) int {
+	return (n + 3) &^ 3
+}
+
+// NTSKEServer is the NTS key establishment server (RFC 8915, section 4).
+//
+// The clients establish the session keys over TLS, and receive the cookies to present
+// to the NTP server sharing the same cookie keys.
+type NTSKEServer struct {
+	logger  *zap.Logger
+	cookies *NTSCookieKeys
+	ntpPort int
+}
+
+// NewNTSKEServer creates a new NTS-KE server handing out cookies for the NTP server on the given port.
+func NewNTSKEServer(logger *zap.Logger, cookies *NTSCookieKeys, ntpPort int) *NTSKEServer {
+	return &NTSKEServer{
+		logger:  logger,
+		cookies: cookies,
+		ntpPort: ntpPort,
+	}
+}
+
+// TLSConfig returns the TLS configuration of the NTS-KE listener.
+//
+// getCertificate is called for each connection, so that the certificate can be updated
+// without restarting the listener.
+func TLSConfig(getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)) *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS13,
+		NextProtos:     []string{NTSKEALPN},
+		GetCertificate: getCertificate,
+	}
+}
+
+// Serve handles the key establishment connections accepted on the TLS listener until it is closed.
+func (ke *NTSKEServer) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		go func() {
+			defer conn.Close() //nolint:errcheck
+
+			tlsConn, ok := conn.(*tls.Conn)
+			if !ok {
+				return
+			}
+
+			if err := ke.handle(tlsConn); err != nil {
+				ke.logger.Debug("NTS key establishment failed", zap.Stringer("client", conn.RemoteAddr()), zap.Error(err))
+			}
+		}()
+	}
+}
+
+func (ke *NTSKEServer) handle(conn *tls.Conn) error {
+	if err := conn.SetDeadline(time.Now().Add(ntsKETimeout)); err != nil {
+		return err
+	}
+
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+
+	state := conn.ConnectionState()
+
+	if state.NegotiatedProtocol != NTSKEALPN {
+		return fmt.Errorf("unexpected ALPN protocol %q", state.NegotiatedProtocol)
+	}
+
+	resp, keyErr := ke.respond(io.LimitReader(conn, ntsKEMaxRequestLen), state)
+
+	if _, err := conn.Write(resp); err != nil {
+		return err
+	}
+
+	return keyErr
+}
+
+// respond reads the key establishment request, and builds the response.
+//
+// If the request is invalid, the response carries the error record, and the error is returned as well.
+//
+//nolint:gocyclo,cyclop
+func (ke *NTSKEServer) respond(r io.Reader, state tls.ConnectionState) ([]byte, error) {
+	var (
+		protocolOK, aeadOK bool
+		header             [4]byte
+	)
+
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return ntsKEError(ntsKEErrorBadRequest), fmt.Errorf("error reading record: %w", err)
+		}
+
+		recordType := binary.BigEndian.Uint16(header[:]) &^ ntsKECriticalBit
+		critical := binary.BigEndian.Uint16(header[:])&ntsKECriticalBit != 0
+
+		body := make([]byte, binary.BigEndian.Uint16(header[2:]))
+
+		if _, err := io.ReadFull(r, body); err != nil {
+			return ntsKEError(ntsKEErrorBadRequest), fmt.Errorf("error reading record: %w", err)
+		}
+
+		switch recordType {
+		case ntsKERecordEndOfMessage:
+		case ntsKERecordNextProtocol:
+			protocolOK = containsUint16(body, ntsProtocolNTPv4)
+		case ntsKERecordAEADAlgorithm:
+			aeadOK = containsUint16(body, ntsAEADAESSIVCMAC256)
+		case ntsKERecordServerNegotiation, ntsKERecordPortNegotiation, ntsKERecordWarning:
+			// the client preferences are ignored, the server and the port are fixed
+		case ntsKERecordError:
+			return nil, errors.New("client sent an error record")
+		default:
+			if critical {
+				return ntsKEError(ntsKEErrorUnrecognizedCritical), fmt.Errorf("unrecognized critical record %d", recordType)
+			}
+		}
+
+		if recordType == ntsKERecordEndOfMessage {
+			break
+		}
+	}
+
+	if !protocolOK || !aeadOK {
+		return ntsKEError(ntsKEErrorBadRequest), errors.New("NTPv4 with AEAD_AES_SIV_CMAC_256 was not offered")
+	}
+
+	var keys ntsKeys
+
+	for _, key := range []struct {
+		dst       *[]byte
+		direction byte
+	}{
+		{dst: &keys.c2s, direction: 0},
+		{dst: &keys.s2c, direction: 1},
+	} {
+		// context is the protocol ID, the AEAD algorithm ID and the direction (RFC 8915, section 5.1)
+		context := []byte{0, ntsProtocolNTPv4, 0, ntsAEADAESSIVCMAC256, key.direction}
+
+		material, err := state.ExportKeyingMaterial(ntsExporterLabel, context, ntsAEADKeyLen)
+		if err != nil {
+			return ntsKEError(ntsKEErrorBadRequest), fmt.Errorf("error exporting keys: %w", err)
+		}
+
+		*key.dst = material
+	}
+
+	var resp []byte
+
+	resp = appendKERecord(resp, true, ntsKERecordNextProtocol, binary.BigEndian.AppendUint16(nil, ntsProtocolNTPv4))
+	resp = appendKERecord(resp, true, ntsKERecordAEADAlgorithm, binary.BigEndian.AppendUint16(nil, ntsAEADAESSIVCMAC256))
+
+	if ke.ntpPort != ntsDefaultNTPPort {
+		resp = appendKERecord(resp, true, ntsKERecordPortNegotiation, binary.BigEndian.AppendUint16(nil, uint16(ke.ntpPort)))
+	}
+
+	for range ntsKECookies {
+		cookie, err := ke.cookies.seal(keys)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = appendKERecord(resp, false, ntsKERecordNewCookie, cookie)
+	}
+
+	return appendKERecord(resp, true, ntsKERecordEndOfMessage, nil), nil
+}
+
+func ntsKEError(code uint16) []byte {
+	resp := appendKERecord(nil, true, ntsKERecordError, binary.BigEndian.AppendUint16(nil, code))
+
+	return appendKERecord(resp, true, ntsKERecordEndOfMessage, nil)
+}
+
+func appendKERecord(b []byte, critical bool, recordType uint16, body []byte) []byte {
+	if critical {
+		recordType |= ntsKECriticalBit
+	}
+
+	b = binary.BigEndian.AppendUint16(b, recordType)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(body)))
+
+	return append(b, body...)
+}
+
+func containsUint16(body []byte, value uint16) bool {
+	needle := binary.BigEndian.AppendUint16(nil, value)
+
+	for i := 0; i+1 < len(body); i += 2 {
+		if bytes.Equal(body[i:i+2], needle) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,232 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/beevik/ntp"
+	"go.uber.org/zap"
+)
+
+// NTP packet constants (RFC 5905).
+const (
+	ntpHeaderLen = 48
+
+	ntpModeClient = 3
+	ntpModeServer = 4
+
+	ntpMaxVersion = 4
+
+	// ntpUnsynchronizedStratum is the stratum announced while the clock is not synchronized.
+	ntpUnsynchronizedStratum = 16
+
+	// ntpPrecision is the announced precision of the system clock (log2 seconds, ~1µs).
+	ntpPrecision = -20
+
+	// ntpEraOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch (1970).
+	ntpEraOffset = 2208988800
+
+	// ntpMaxPacketLen limits the size of the requests, including the NTS extension fields.
+	ntpMaxPacketLen = 1280
+)
+
+// clockTolerance is the frequency tolerance of the local clock (15 ppm, RFC 5905),
+// which the root dispersion grows by since the last synchronization.
+const clockTolerance = 15e-6
+
+// Server answers NTP client requests with the state of the local clock.
+//
+// Server is running in the server mode of RFC 5905: it never adjusts the local clock
+// itself, the time source state is provided via SetSource by the syncer.
+type Server struct {
+	logger *zap.Logger
+
+	source atomic.Pointer[SourceStatus]
+
+	// cookies is used to authenticate NTS requests, nil if NTS is disabled.
+	cookies *NTSCookieKeys
+
+	// CurrentTime is overridden in tests for mocking support.
+	CurrentTime CurrentTimeFunc
+}
+
+// NewServer creates a new NTP server.
+//
+// If cookies is not nil, requests carrying NTS extension fields (RFC 8915) are authenticated
+// with the keys established by the NTS-KE server sharing the same cookie keys.
+func NewServer(logger *zap.Logger, cookies *NTSCookieKeys) *Server {
+	srv := &Server{
+		logger:      logger,
+		cookies:     cookies,
+		CurrentTime: time.Now,
+	}
+
+	srv.source.Store(&SourceStatus{})
+
+	return srv
+}
+
+// SetSource updates the state of the time source announced to the clients.
+//
+// The zero value announces the clock as unsynchronized.
+func (srv *Server) SetSource(source SourceStatus) {
+	srv.source.Store(&source)
+}
+
+// Serve answers the requests received on conn until it is closed.
+func (srv *Server) Serve(conn net.PacketConn) error {
+	buf := make([]byte, ntpMaxPacketLen)
+
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		received := srv.CurrentTime()
+
+		resp := srv.Respond(buf[:n], received)
+		if resp == nil {
+			continue
+		}
+
+		if _, err = conn.WriteTo(resp, addr); err != nil {
+			srv.logger.Debug("error sending NTP response", zap.Stringer("client", addr), zap.Error(err))
+		}
+	}
+}
+
+// Respond builds the response to the request received at the given time.
+//
+// Invalid requests are dropped by returning nil.
+func (srv *Server) Respond(req []byte, received time.Time) []byte {
+	if len(req) < ntpHeaderLen {
+		return nil
+	}
+
+	version := (req[0] >> 3) & 0x7
+	mode := req[0] & 0x7
+
+	if mode != ntpModeClient || version < 1 || version > ntpMaxVersion {
+		return nil
+	}
+
+	var ntsReq *ntsRequest
+
+	if len(req) > ntpHeaderLen && version == ntpMaxVersion {
+		var err error
+
+		ntsReq, err = parseNTSRequest(req)
+		if err != nil {
+			srv.logger.Debug("dropping malformed NTP request", zap.Error(err))
+
+			return nil
+		}
+	}
+
+	if ntsReq == nil {
+		return srv.header(req, received)
+	}
+
+	if srv.cookies == nil {
+		// NTS is not enabled, so the request can't be authenticated
+		return nil
+	}
+
+	keys, err := ntsReq.authenticate(srv.cookies)
+	if err != nil {
+		srv.logger.Debug("NTS authentication failed", zap.Error(err))
+
+		return ntsReq.nak(req)
+	}
+
+	resp, err := ntsReq.seal(srv.header(req, received), keys, srv.cookies)
+	if err != nil {
+		srv.logger.Warn("error building NTS response", zap.Error(err))
+
+		return nil
+	}
+
+	return resp
+}
+
+// header builds the response header announcing the state of the local clock.
+func (srv *Server) header(req []byte, received time.Time) []byte {
+	source := srv.source.Load()
+
+	resp := make([]byte, ntpHeaderLen, ntpMaxPacketLen)
+
+	leap := source.Leap
+	stratum := source.Stratum
+
+	if stratum == 0 {
+		leap = ntp.LeapNotInSync
+		stratum = ntpUnsynchronizedStratum
+	}
+
+	version := (req[0] >> 3) & 0x7
+
+	resp[0] = byte(leap)<<6 | version<<3 | ntpModeServer
+	resp[1] = stratum
+	resp[2] = req[2] // poll interval is echoed back
+
+	precision := int8(ntpPrecision)
+	resp[3] = byte(precision)
+
+	rootDispersion := source.RootDispersion
+
+	if !source.ReferenceTime.IsZero() {
+		rootDispersion += time.Duration(float64(received.Sub(source.ReferenceTime)) * clockTolerance)
+	}
+
+	binary.BigEndian.PutUint32(resp[4:], toNTPShort(source.RootDelay))
+	binary.BigEndian.PutUint32(resp[8:], toNTPShort(rootDispersion))
+	binary.BigEndian.PutUint32(resp[12:], source.ReferenceID)
+
+	if !source.ReferenceTime.IsZero() {
+		binary.BigEndian.PutUint64(resp[16:], toNTPTime(source.ReferenceTime))
+	}
+
+	// origin timestamp is the transmit timestamp of the request
+	copy(resp[24:32], req[40:48])
+
+	binary.BigEndian.PutUint64(resp[32:], toNTPTime(received))
+	binary.BigEndian.PutUint64(resp[40:], toNTPTime(srv.CurrentTime()))
+
+	return resp
+}
+
+// toNTPTime converts the time to the NTP timestamp format (32.32 fixed point seconds since 1900).
+func toNTPTime(t time.Time) uint64 {
+	seconds := uint64(t.Unix() + ntpEraOffset)
+	fraction := (uint64(t.Nanosecond()) << 32) / uint64(time.Second)
+
+	return seconds<<32 | fraction
+}
+
+// toNTPShort converts the duration to the NTP short format (16.16 fixed point seconds).
+func toNTPShort(d time.Duration) uint32 {
+	if d <= 0 {
+		return 0
+	}
+
+	seconds := uint64(d / time.Second)
+	if seconds > 0xffff {
+		return 0xffffffff
+	}
+
+	fraction := (uint64(d%time.Second) << 16) / uint64(time.Second)
+
+	return uint32(seconds<<16 | fraction)
+}
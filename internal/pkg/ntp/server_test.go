@@ -0,0 +1,196 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ntp_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	beevikntp "github.com/beevik/ntp"
+	"github.com/beevik/nts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/ntp"
+)
+
+func clientRequest(version byte) []byte {
+	req := make([]byte, 48)
+	req[0] = version<<3 | 3
+	req[2] = 6
+
+	binary.BigEndian.PutUint64(req[40:], 0x0102030405060708)
+
+	return req
+}
+
+func TestServerUnsynchronized(t *testing.T) {
+	t.Parallel()
+
+	srv := ntp.NewServer(zaptest.NewLogger(t), nil)
+
+	resp := srv.Respond(clientRequest(4), time.Now())
+	require.Len(t, resp, 48)
+
+	assert.EqualValues(t, beevikntp.LeapNotInSync, resp[0]>>6)
+	assert.EqualValues(t, 4, (resp[0]>>3)&0x7)
+	assert.EqualValues(t, 4, resp[0]&0x7)
+	assert.EqualValues(t, 16, resp[1])
+	assert.EqualValues(t, 6, resp[2])
+	assert.Equal(t, uint64(0x0102030405060708), binary.BigEndian.Uint64(resp[24:]))
+}
+
+func TestServerSynchronized(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	srv := ntp.NewServer(zaptest.NewLogger(t), nil)
+	srv.CurrentTime = func() time.Time { return now.Add(time.Millisecond) }
+
+	srv.SetSource(ntp.SourceStatus{
+		Stratum:        2,
+		Leap:           beevikntp.LeapAddSecond,
+		ReferenceID:    0x0a000001,
+		ReferenceTime:  now.Add(-time.Minute),
+		RootDelay:      10 * time.Millisecond,
+		RootDispersion: 5 * time.Millisecond,
+	})
+
+	resp := srv.Respond(clientRequest(3), now)
+	require.Len(t, resp, 48)
+
+	assert.EqualValues(t, beevikntp.LeapAddSecond, resp[0]>>6)
+	assert.EqualValues(t, 3, (resp[0]>>3)&0x7)
+	assert.EqualValues(t, 3, resp[1])
+	assert.Equal(t, uint32(0x0a000001), binary.BigEndian.Uint32(resp[12:]))
+
+	// receive and transmit timestamps
+	assert.Equal(t, uint64(now.Unix()+2208988800), binary.BigEndian.Uint64(resp[32:])>>32)
+	assert.Greater(t, binary.BigEndian.Uint64(resp[40:]), binary.BigEndian.Uint64(resp[32:]))
+
+	// root dispersion grows with the time since the last synchronization
+	assert.Greater(t, binary.BigEndian.Uint32(resp[8:]), uint32(5*65536/1000))
+}
+
+func TestServerDropsInvalid(t *testing.T) {
+	t.Parallel()
+
+	srv := ntp.NewServer(zaptest.NewLogger(t), nil)
+
+	assert.Nil(t, srv.Respond([]byte{0x23}, time.Now()))
+
+	// server mode packet
+	req := clientRequest(4)
+	req[0] = 4<<3 | 4
+
+	assert.Nil(t, srv.Respond(req, time.Now()))
+
+	// NTS request with NTS disabled
+	req = clientRequest(4)
+	req = binary.BigEndian.AppendUint16(req, 0x0104)
+	req = binary.BigEndian.AppendUint16(req, 36)
+	req = append(req, make([]byte, 32)...)
+
+	assert.Nil(t, srv.Respond(req, time.Now()))
+}
+
+func selfSignedCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ntp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        cert,
+	}, pool
+}
+
+func TestServerNTS(t *testing.T) {
+	t.Parallel()
+
+	logger := zaptest.NewLogger(t)
+
+	cookies, err := ntp.NewNTSCookieKeys()
+	require.NoError(t, err)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := ntp.NewServer(logger, cookies)
+	srv.SetSource(ntp.SourceStatus{
+		Stratum:       1,
+		ReferenceID:   0x50484300,
+		ReferenceTime: time.Now(),
+	})
+
+	go srv.Serve(conn) //nolint:errcheck
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	cert, pool := selfSignedCertificate(t)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", ntp.TLSConfig(func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return &cert, nil
+	}))
+	require.NoError(t, err)
+
+	ke := ntp.NewNTSKEServer(logger, cookies, conn.LocalAddr().(*net.UDPAddr).Port)
+
+	go ke.Serve(listener) //nolint:errcheck
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	session, err := nts.NewSessionWithOptions(listener.Addr().String(), &nts.SessionOptions{
+		TLSConfig: &tls.Config{
+			RootCAs: pool,
+		},
+	})
+	require.NoError(t, err)
+
+	// several queries to consume the cookies handed out by both NTS-KE and the NTP responses
+	for range 10 {
+		resp, err := session.Query()
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 1, resp.Stratum)
+		assert.Equal(t, beevikntp.LeapNoWarning, resp.Leap)
+		assert.Less(t, resp.ClockOffset.Abs(), time.Second)
+	}
+}
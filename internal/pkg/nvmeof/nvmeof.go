@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package nvmeof provides a minimal NVMe over Fabrics (NVMe-oF) host
+// implementation on top of the Linux /dev/nvme-fabrics interface and sysfs.
+package nvmeof
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Overridden in tests.
+var (
+	fabricsDevice   = "/dev/nvme-fabrics"
+	sysClassNVMeDir = "/sys/class/nvme"
+	sysBlockDir     = "/sys/block"
+)
+
+// ErrNotFound is returned when the controller or namespace does not exist.
+var ErrNotFound = errors.New("nvmeof: not found")
+
+// TransportTCP is the NVMe/TCP transport name.
+const TransportTCP = "tcp"
+
+// Target describes an NVMe-oF subsystem to connect to.
+type Target struct {
+	Transport    string
+	Address      string
+	Port         uint16
+	SubsystemNQN string
+}
+
+// Host describes the local host identity presented to the target.
+type Host struct {
+	NQN string
+	ID  string
+}
+
+func (t Target) options(host Host) string {
+	opts := []string{
+		"nqn=" + t.SubsystemNQN,
+		"transport=" + t.Transport,
+		"traddr=" + t.Address,
+		"trsvcid=" + strconv.FormatUint(uint64(t.Port), 10),
+	}
+
+	if host.NQN != "" {
+		opts = append(opts, "hostnqn="+host.NQN)
+	}
+
+	if host.ID != "" {
+		opts = append(opts, "hostid="+host.ID)
+	}
+
+	return strings.Join(opts, ",")
+}
+
+// Connect connects to the target and returns the controller name (e.g. nvme0).
+//
+// If a live controller for the target already exists, it is returned as is.
+func Connect(target Target, host Host) (string, error) {
+	if controller, err := FindController(target); err == nil {
+		return controller, nil
+	} else if !errors.Is(err, ErrNotFound) {
+		return "", err
+	}
+
+	f, err := os.OpenFile(fabricsDevice, os.O_RDWR, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", fabricsDevice, err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if _, err = f.WriteString(target.options(host)); err != nil {
+		return "", fmt.Errorf("failed to connect to %s: %w", target.SubsystemNQN, err)
+	}
+
+	buf := make([]byte, 256)
+
+	n, err := f.ReadAt(buf, 0)
+	if n == 0 && err != nil {
+		return "", fmt.Errorf("failed to read connect response: %w", err)
+	}
+
+	return parseConnectResponse(string(buf[:n]))
+}
+
+// parseConnectResponse parses the "instance=N,cntlid=M" response of the fabrics device.
+func parseConnectResponse(resp string) (string, error) {
+	for opt := range strings.SplitSeq(strings.TrimSpace(resp), ",") {
+		if instance, ok := strings.CutPrefix(opt, "instance="); ok {
+			if _, err := strconv.Atoi(instance); err != nil {
+				return "", fmt.Errorf("invalid controller instance %q", instance)
+			}
+
+			return "nvme" + instance, nil
+		}
+	}
+
+	return "", fmt.Errorf("unexpected connect response %q", resp)
+}
+
+// Disconnect deletes the fabrics controller.
+func Disconnect(controller string) error {
+	path := filepath.Join(sysClassNVMeDir, controller, "delete_controller")
+
+	if err := os.WriteFile(path, []byte("1"), 0); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to disconnect %s: %w", controller, err)
+	}
+
+	return nil
+}
+
+// FindController returns the name of a live controller connected to the target.
+func FindController(target Target) (string, error) {
+	entries, err := os.ReadDir(sysClassNVMeDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", ErrNotFound
+		}
+
+		return "", fmt.Errorf("failed to read %s: %w", sysClassNVMeDir, err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if readAttribute(filepath.Join(sysClassNVMeDir, name, "transport")) != target.Transport {
+			continue
+		}
+
+		if readAttribute(filepath.Join(sysClassNVMeDir, name, "subsysnqn")) != target.SubsystemNQN {
+			continue
+		}
+
+		switch readAttribute(filepath.Join(sysClassNVMeDir, name, "state")) {
+		case "deleting", "dead":
+			continue
+		}
+
+		addr := parseAddress(readAttribute(filepath.Join(sysClassNVMeDir, name, "address")))
+
+		if addr["traddr"] == target.Address && addr["trsvcid"] == strconv.FormatUint(uint64(target.Port), 10) {
+			return name, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+// parseAddress parses the controller address attribute, e.g. "traddr=10.0.0.1,trsvcid=4420,src_addr=10.0.0.2".
+func parseAddress(s string) map[string]string {
+	result := map[string]string{}
+
+	for opt := range strings.SplitSeq(s, ",") {
+		if k, v, ok := strings.Cut(opt, "="); ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+var namespaceRE = regexp.MustCompile(`^nvme\d+n\d+$`)
+
+// FindNamespace returns the block device path of the namespace with the given ID in the subsystem.
+func FindNamespace(subsystemNQN string, namespaceID uint32) (string, error) {
+	entries, err := os.ReadDir(sysBlockDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", sysBlockDir, err)
+	}
+
+	nsid := strconv.FormatUint(uint64(namespaceID), 10)
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if !namespaceRE.MatchString(name) {
+			continue
+		}
+
+		if readAttribute(filepath.Join(sysBlockDir, name, "nsid")) != nsid {
+			continue
+		}
+
+		if readAttribute(filepath.Join(sysBlockDir, name, "device", "subsysnqn")) != subsystemNQN {
+			continue
+		}
+
+		return filepath.Join("/dev", name), nil
+	}
+
+	return "", ErrNotFound
+}
+
+func readAttribute(path string) string {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(contents))
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:testpackage
+package nvmeof
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAttributes(t *testing.T, dir string, attrs map[string]string) {
+	t.Helper()
+
+	for name, value := range attrs {
+		path := filepath.Join(dir, name)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(value+"\n"), 0o644))
+	}
+}
+
+func TestTargetOptions(t *testing.T) {
+	target := Target{
+		Transport:    TransportTCP,
+		Address:      "10.0.0.1",
+		Port:         4420,
+		SubsystemNQN: "nqn.2016-06.io.spdk:cnode1",
+	}
+
+	assert.Equal(t,
+		"nqn=nqn.2016-06.io.spdk:cnode1,transport=tcp,traddr=10.0.0.1,trsvcid=4420,hostnqn=nqn.2017-11.dev.talos:uuid:1234,hostid=1234",
+		target.options(Host{NQN: "nqn.2017-11.dev.talos:uuid:1234", ID: "1234"}),
+	)
+	assert.Equal(t,
+		"nqn=nqn.2016-06.io.spdk:cnode1,transport=tcp,traddr=10.0.0.1,trsvcid=4420",
+		target.options(Host{}),
+	)
+}
+
+func TestParseConnectResponse(t *testing.T) {
+	controller, err := parseConnectResponse("instance=3,cntlid=1\n")
+	require.NoError(t, err)
+	assert.Equal(t, "nvme3", controller)
+
+	_, err = parseConnectResponse("cntlid=1")
+	require.Error(t, err)
+}
+
+func TestSysfsHelpers(t *testing.T) {
+	oldSysClassNVMeDir, oldSysBlockDir := sysClassNVMeDir, sysBlockDir
+	sysClassNVMeDir, sysBlockDir = t.TempDir(), t.TempDir()
+
+	t.Cleanup(func() { sysClassNVMeDir, sysBlockDir = oldSysClassNVMeDir, oldSysBlockDir })
+
+	target := Target{
+		Transport:    TransportTCP,
+		Address:      "10.0.0.1",
+		Port:         4420,
+		SubsystemNQN: "nqn.2016-06.io.spdk:cnode1",
+	}
+
+	_, err := FindController(target)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	writeAttributes(t, filepath.Join(sysClassNVMeDir, "nvme0"), map[string]string{
+		"transport": "pcie",
+		"subsysnqn": "nqn.2014.08.org.nvmexpress:local",
+		"state":     "live",
+	})
+	writeAttributes(t, filepath.Join(sysClassNVMeDir, "nvme1"), map[string]string{
+		"transport": "tcp",
+		"subsysnqn": target.SubsystemNQN,
+		"state":     "deleting",
+		"address":   "traddr=10.0.0.1,trsvcid=4420",
+	})
+	writeAttributes(t, filepath.Join(sysClassNVMeDir, "nvme2"), map[string]string{
+		"transport": "tcp",
+		"subsysnqn": target.SubsystemNQN,
+		"state":     "live",
+		"address":   "traddr=10.0.0.1,trsvcid=4420,src_addr=10.0.0.2",
+	})
+
+	controller, err := FindController(target)
+	require.NoError(t, err)
+	assert.Equal(t, "nvme2", controller)
+
+	writeAttributes(t, filepath.Join(sysBlockDir, "nvme0n1"), map[string]string{
+		"nsid":             "1",
+		"device/subsysnqn": "nqn.2014.08.org.nvmexpress:local",
+	})
+	writeAttributes(t, filepath.Join(sysBlockDir, "nvme2n1"), map[string]string{
+		"nsid":             "1",
+		"device/subsysnqn": target.SubsystemNQN,
+	})
+	writeAttributes(t, filepath.Join(sysBlockDir, "nvme2n2"), map[string]string{
+		"nsid":             "2",
+		"device/subsysnqn": target.SubsystemNQN,
+	})
+
+	dev, err := FindNamespace(target.SubsystemNQN, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/nvme2n2", dev)
+
+	_, err = FindNamespace(target.SubsystemNQN, 3)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, Disconnect("nvme2"))
+	assert.Equal(t, "1", readAttribute(filepath.Join(sysClassNVMeDir, "nvme2", "delete_controller")))
+
+	require.NoError(t, Disconnect("nvme9"))
+}
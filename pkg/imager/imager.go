@@ -328,6 +328,23 @@ func (i *Imager) buildInitramfs(ctx context.Context, report *reporter.Reporter)
 
 	i.initramfsPath = tempInitramfsPath
 
+	if i.prof.Input.ImageVerificationConfig != "" {
+		verifier, err := profile.NewImageVerifier(ctx, i.prof.Input.ImageVerificationConfig)
+		if err != nil {
+			if xerrors.TagIs[profile.InvalidInputTag](err) {
+				return err
+			}
+
+			return xerrors.NewTagged[DependencyTag](err)
+		}
+
+		for j := range i.prof.Input.SystemExtensions {
+			if err = verifier.Verify(ctx, &i.prof.Input.SystemExtensions[j], printf); err != nil {
+				return xerrors.NewTagged[DependencyTag](err)
+			}
+		}
+	}
+
 	extensionsCheckoutDir := filepath.Join(i.tempDir, "extensions")
 
 	// pull every extension to a temporary location
@@ -38,6 +38,13 @@ const (
 	amd64 = "amd64"
 )
 
+// keychain provides the registry credentials to pull the container assets.
+var keychain = authn.NewMultiKeychain(
+	authn.DefaultKeychain,
+	github.Keychain,
+	google.Keychain,
+)
+
 // Input describes inputs for image generation.
 type Input struct {
 	// Kernel is a vmlinuz file.
@@ -60,6 +67,10 @@ type Input struct {
 	SecureBoot *SecureBootAssets `yaml:"secureboot,omitempty"`
 	// SystemExtensions is a list of system extensions to install.
 	SystemExtensions []ContainerAsset `yaml:"systemExtensions,omitempty"`
+	// ImageVerificationConfig is an ImageVerificationConfig document the system extension images are verified against.
+	//
+	// If not set, system extension images are not verified.
+	ImageVerificationConfig string `yaml:"imageVerificationConfig,omitempty"`
 }
 
 // FileAsset describes a file asset.
@@ -285,13 +296,7 @@ func (c *ContainerAsset) Pull(ctx context.Context, arch string, printf func(stri
 			OS:           "linux",
 		}),
 		crane.WithContext(ctx),
-		crane.WithAuthFromKeychain(
-			authn.NewMultiKeychain(
-				authn.DefaultKeychain,
-				github.Keychain,
-				google.Keychain,
-			),
-		),
+		crane.WithAuthFromKeychain(keychain),
 	}
 
 	if c.ForceInsecure {
@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/containerd/containerd/v2/core/remotes"
+	"github.com/containerd/containerd/v2/core/remotes/docker"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image/verify"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/resources/security"
+)
+
+// ImageVerifier verifies container assets against the rules of an ImageVerificationConfig document.
+//
+// The rules are the same as on the node, and they are checked the same way.
+type ImageVerifier struct {
+	resources state.State
+}
+
+// NewImageVerifier creates an ImageVerifier for the ImageVerificationConfig document.
+func NewImageVerifier(ctx context.Context, document string) (*ImageVerifier, error) {
+	cfg, err := configloader.NewFromBytes([]byte(document))
+	if err != nil {
+		return nil, xerrors.NewTaggedf[InvalidInputTag]("failed to parse image verification config: %w", err)
+	}
+
+	verificationCfg := cfg.ImageVerificationConfig()
+	if verificationCfg == nil {
+		return nil, xerrors.NewTagged[InvalidInputTag](errors.New("image verification config has no ImageVerificationConfig document"))
+	}
+
+	resources := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var needsTUF bool
+
+	for idx, rule := range verificationCfg.Rules() {
+		r := security.NewImageVerificationRule(fmt.Sprintf("%04d", idx))
+		r.TypedSpec().ImagePattern = rule.ImagePattern()
+		r.TypedSpec().Skip = rule.Skip()
+		r.TypedSpec().Deny = rule.Deny()
+
+		if kv := rule.VerifierKeyless(); kv != nil {
+			r.TypedSpec().KeylessVerifier = &security.ImageKeylessVerifierSpec{
+				Issuer:       kv.Issuer(),
+				Subject:      kv.Subject(),
+				SubjectRegex: kv.SubjectRegex(),
+			}
+
+			needsTUF = true
+		}
+
+		if cv := rule.VerifierPublicKey(); cv != nil {
+			r.TypedSpec().PublicKeyVerifier = &security.ImagePublicKeyVerifierSpec{
+				Certificate: cv.Certificate(),
+			}
+		}
+
+		if err = resources.Create(ctx, r); err != nil {
+			return nil, fmt.Errorf("failed to create image verification rule: %w", err)
+		}
+	}
+
+	if needsTUF {
+		tufData, err := verify.FetchTUFTarget(security.TrustedRootID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TUF trusted root: %w", err)
+		}
+
+		trustedRoot := security.NewTUFTrustedRoot(security.TrustedRootID)
+		trustedRoot.TypedSpec().JSONData = string(tufData)
+
+		if err = resources.Create(ctx, trustedRoot); err != nil {
+			return nil, fmt.Errorf("failed to create TUF trusted root: %w", err)
+		}
+	}
+
+	return &ImageVerifier{
+		resources: resources,
+	}, nil
+}
+
+// Verify the container asset against the image verification rules.
+//
+// A verified asset is pinned to the verified digest, so that the image pulled later is the one
+// which was verified. Assets loaded from a tarball or an OCI layout have no registry reference to
+// match the rules against, so they are not verified.
+func (v *ImageVerifier) Verify(ctx context.Context, c *ContainerAsset, printf func(string, ...any)) error {
+	if c.TarballPath != "" || c.OCIPath != "" {
+		return nil
+	}
+
+	result, err := verify.ImageSignature(ctx, zap.NewNop(), v.resources, c.resolver(), nil, c.ImageRef)
+	if err != nil {
+		return fmt.Errorf("image %s failed verification: %s", c.ImageRef, status.Convert(err).Message())
+	}
+
+	printf("verifying %s: %s", c.ImageRef, result.Message)
+
+	if result.Verified {
+		c.ImageRef = result.DigestedImageRef
+	}
+
+	return nil
+}
+
+// resolver builds the registry resolver for the container asset, authenticated with the same keychain as Pull.
+func (c *ContainerAsset) resolver() remotes.Resolver {
+	scheme := "https"
+
+	if c.ForceInsecure {
+		scheme = "http"
+	}
+
+	client := &http.Client{
+		Transport: httpdefaults.PatchTransport(cleanhttp.DefaultTransport()),
+	}
+
+	return docker.NewResolver(docker.ResolverOptions{
+		Hosts: func(host string) ([]docker.RegistryHost, error) {
+			defaultHost, err := docker.DefaultHost(host)
+			if err != nil {
+				return nil, fmt.Errorf("error getting default host for %q: %w", host, err)
+			}
+
+			return []docker.RegistryHost{
+				{
+					Client: client,
+					Authorizer: docker.NewDockerAuthorizer(
+						docker.WithAuthClient(client),
+						docker.WithAuthCreds(keychainCreds),
+					),
+					Host:         defaultHost,
+					Scheme:       scheme,
+					Path:         "/v2",
+					Capabilities: docker.HostCapabilityResolve | docker.HostCapabilityPull | docker.HostCapabilityReferrers,
+				},
+			}, nil
+		},
+	})
+}
+
+// keychainCreds returns the credentials for the registry host in the format expected by containerd.
+func keychainCreds(host string) (string, string, error) {
+	// containerd talks to Docker Hub via its API host, while the keychain knows it by the registry name
+	if host == "registry-1.docker.io" {
+		host = name.DefaultRegistry
+	}
+
+	registry, err := name.NewRegistry(host)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing registry %q: %w", host, err)
+	}
+
+	authenticator, err := keychain.Resolve(registry)
+	if err != nil {
+		return "", "", fmt.Errorf("error resolving credentials for %q: %w", host, err)
+	}
+
+	auth, err := authenticator.Authorization()
+	if err != nil {
+		return "", "", fmt.Errorf("error getting credentials for %q: %w", host, err)
+	}
+
+	if auth.IdentityToken != "" {
+		return "", auth.IdentityToken, nil
+	}
+
+	return auth.Username, auth.Password, nil
+}
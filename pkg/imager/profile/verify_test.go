@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/imager/profile"
+)
+
+const imageVerificationConfig = `apiVersion: v1alpha1
+kind: ImageVerificationConfig
+rules:
+  - image: ghcr.io/siderolabs/*
+    skip: true
+  - image: ghcr.io/example/*
+    deny: true
+`
+
+func TestImageVerifier(t *testing.T) {
+	t.Parallel()
+
+	verifier, err := profile.NewImageVerifier(t.Context(), imageVerificationConfig)
+	require.NoError(t, err)
+
+	printf := func(string, ...any) {}
+
+	for _, test := range []struct {
+		name  string
+		asset profile.ContainerAsset
+
+		expectedErr string
+	}{
+		{
+			name:  "skipped by rule",
+			asset: profile.ContainerAsset{ImageRef: "ghcr.io/siderolabs/gvisor:20231214.0-v1.15.0"},
+		},
+		{
+			name:  "no matched rule",
+			asset: profile.ContainerAsset{ImageRef: "docker.io/example/extension:v1.0.0"},
+		},
+		{
+			name:        "denied by rule",
+			asset:       profile.ContainerAsset{ImageRef: "ghcr.io/example/extension:v1.0.0"},
+			expectedErr: "image ghcr.io/example/extension:v1.0.0 failed verification: verification denied by matched rule (0001)",
+		},
+		{
+			name:  "OCI layout",
+			asset: profile.ContainerAsset{OCIPath: "/tmp/extension"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			asset := test.asset
+
+			err := verifier.Verify(t.Context(), &asset, printf)
+
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.asset, asset)
+		})
+	}
+}
+
+func TestImageVerifierInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := profile.NewImageVerifier(t.Context(), "apiVersion: v1alpha1\nkind: KmsgLogConfig\nname: remote\nurl: tcp://10.0.0.1:3000\n")
+	require.Error(t, err)
+	assert.True(t, xerrors.TagIs[profile.InvalidInputTag](err))
+}
@@ -14,6 +14,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+
+	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 )
 
 const (
@@ -170,23 +172,453 @@ func (x *LVMServicePhysicalVolumeRemoveRequest) GetDevice() string {
 	return ""
 }
 
+// LVMServiceLogicalVolumeSnapshotCreateRequest describes a snapshot to take.
+type LVMServiceLogicalVolumeSnapshotCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VolumeGroup is the name of the parent VG (e.g. "vg0").
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	// LogicalVolume is the name of the origin LV to snapshot (e.g. "lv0").
+	LogicalVolume string `protobuf:"bytes,2,opt,name=logical_volume,json=logicalVolume,proto3" json:"logical_volume,omitempty"`
+	// Snapshot is the name of the snapshot LV to create (e.g. "lv0-pre-upgrade").
+	Snapshot string `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// SizeBytes is the copy-on-write space reserved for a thick snapshot.
+	//
+	// Must be zero for snapshots of thin LVs.
+	SizeBytes     uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) Reset() {
+	*x = LVMServiceLogicalVolumeSnapshotCreateRequest{}
+	mi := &file_machine_lvm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LVMServiceLogicalVolumeSnapshotCreateRequest) ProtoMessage() {}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_lvm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LVMServiceLogicalVolumeSnapshotCreateRequest.ProtoReflect.Descriptor instead.
+func (*LVMServiceLogicalVolumeSnapshotCreateRequest) Descriptor() ([]byte, []int) {
+	return file_machine_lvm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) GetVolumeGroup() string {
+	if x != nil {
+		return x.VolumeGroup
+	}
+	return ""
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) GetLogicalVolume() string {
+	if x != nil {
+		return x.LogicalVolume
+	}
+	return ""
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotCreateRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// LVMServiceLogicalVolumeSnapshotListRequest filters the snapshots to list.
+type LVMServiceLogicalVolumeSnapshotListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VolumeGroup, if set, limits the list to snapshots in this VG.
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	// LogicalVolume, if set, limits the list to snapshots of this origin LV.
+	LogicalVolume string `protobuf:"bytes,2,opt,name=logical_volume,json=logicalVolume,proto3" json:"logical_volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListRequest) Reset() {
+	*x = LVMServiceLogicalVolumeSnapshotListRequest{}
+	mi := &file_machine_lvm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LVMServiceLogicalVolumeSnapshotListRequest) ProtoMessage() {}
+
+func (x *LVMServiceLogicalVolumeSnapshotListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_lvm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LVMServiceLogicalVolumeSnapshotListRequest.ProtoReflect.Descriptor instead.
+func (*LVMServiceLogicalVolumeSnapshotListRequest) Descriptor() ([]byte, []int) {
+	return file_machine_lvm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListRequest) GetVolumeGroup() string {
+	if x != nil {
+		return x.VolumeGroup
+	}
+	return ""
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListRequest) GetLogicalVolume() string {
+	if x != nil {
+		return x.LogicalVolume
+	}
+	return ""
+}
+
+// LVMLogicalVolumeSnapshot describes a single LVM snapshot.
+type LVMLogicalVolumeSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VolumeGroup is the name of the parent VG.
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	// Name is the name of the snapshot LV.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Origin is the name of the LV the snapshot was taken of.
+	Origin string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Size is the size of the snapshot LV in bytes, as reported by LVM.
+	Size string `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Created is the snapshot creation time, as reported by LVM.
+	Created string `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	// Thin is set for snapshots allocated from a thin pool.
+	Thin bool `protobuf:"varint,6,opt,name=thin,proto3" json:"thin,omitempty"`
+	// DataPercent is the usage of the snapshot (copy-on-write space for thick
+	// snapshots, thin pool allocation for thin ones), as reported by LVM.
+	DataPercent string `protobuf:"bytes,7,opt,name=data_percent,json=dataPercent,proto3" json:"data_percent,omitempty"`
+	// Invalid is set when a thick snapshot ran out of copy-on-write space.
+	Invalid bool `protobuf:"varint,8,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	// Merging is set when a rollback to this snapshot is pending.
+	Merging       bool `protobuf:"varint,9,opt,name=merging,proto3" json:"merging,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LVMLogicalVolumeSnapshot) Reset() {
+	*x = LVMLogicalVolumeSnapshot{}
+	mi := &file_machine_lvm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LVMLogicalVolumeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LVMLogicalVolumeSnapshot) ProtoMessage() {}
+
+func (x *LVMLogicalVolumeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_lvm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LVMLogicalVolumeSnapshot.ProtoReflect.Descriptor instead.
+func (*LVMLogicalVolumeSnapshot) Descriptor() ([]byte, []int) {
+	return file_machine_lvm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetVolumeGroup() string {
+	if x != nil {
+		return x.VolumeGroup
+	}
+	return ""
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetThin() bool {
+	if x != nil {
+		return x.Thin
+	}
+	return false
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetDataPercent() string {
+	if x != nil {
+		return x.DataPercent
+	}
+	return ""
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+func (x *LVMLogicalVolumeSnapshot) GetMerging() bool {
+	if x != nil {
+		return x.Merging
+	}
+	return false
+}
+
+type LVMServiceLogicalVolumeSnapshotList struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Metadata      *common.Metadata            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Snapshots     []*LVMLogicalVolumeSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotList) Reset() {
+	*x = LVMServiceLogicalVolumeSnapshotList{}
+	mi := &file_machine_lvm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LVMServiceLogicalVolumeSnapshotList) ProtoMessage() {}
+
+func (x *LVMServiceLogicalVolumeSnapshotList) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_lvm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LVMServiceLogicalVolumeSnapshotList.ProtoReflect.Descriptor instead.
+func (*LVMServiceLogicalVolumeSnapshotList) Descriptor() ([]byte, []int) {
+	return file_machine_lvm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotList) GetMetadata() *common.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotList) GetSnapshots() []*LVMLogicalVolumeSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type LVMServiceLogicalVolumeSnapshotListResponse struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Messages      []*LVMServiceLogicalVolumeSnapshotList `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListResponse) Reset() {
+	*x = LVMServiceLogicalVolumeSnapshotListResponse{}
+	mi := &file_machine_lvm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LVMServiceLogicalVolumeSnapshotListResponse) ProtoMessage() {}
+
+func (x *LVMServiceLogicalVolumeSnapshotListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_lvm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LVMServiceLogicalVolumeSnapshotListResponse.ProtoReflect.Descriptor instead.
+func (*LVMServiceLogicalVolumeSnapshotListResponse) Descriptor() ([]byte, []int) {
+	return file_machine_lvm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotListResponse) GetMessages() []*LVMServiceLogicalVolumeSnapshotList {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// LVMServiceLogicalVolumeSnapshotRollbackRequest identifies the snapshot to roll back to.
+type LVMServiceLogicalVolumeSnapshotRollbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VolumeGroup is the name of the parent VG (e.g. "vg0").
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	// Snapshot is the name of the snapshot LV to merge into its origin.
+	Snapshot      string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotRollbackRequest) Reset() {
+	*x = LVMServiceLogicalVolumeSnapshotRollbackRequest{}
+	mi := &file_machine_lvm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotRollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LVMServiceLogicalVolumeSnapshotRollbackRequest) ProtoMessage() {}
+
+func (x *LVMServiceLogicalVolumeSnapshotRollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_lvm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LVMServiceLogicalVolumeSnapshotRollbackRequest.ProtoReflect.Descriptor instead.
+func (*LVMServiceLogicalVolumeSnapshotRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_machine_lvm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotRollbackRequest) GetVolumeGroup() string {
+	if x != nil {
+		return x.VolumeGroup
+	}
+	return ""
+}
+
+func (x *LVMServiceLogicalVolumeSnapshotRollbackRequest) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
 var File_machine_lvm_proto protoreflect.FileDescriptor
 
 const file_machine_lvm_proto_rawDesc = "" +
 	"\n" +
-	"\x11machine/lvm.proto\x12\amachine\x1a\x1bgoogle/protobuf/empty.proto\"p\n" +
+	"\x11machine/lvm.proto\x12\amachine\x1a\x13common/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"p\n" +
 	"$LVMServiceLogicalVolumeRemoveRequest\x12!\n" +
 	"\fvolume_group\x18\x01 \x01(\tR\vvolumeGroup\x12%\n" +
 	"\x0elogical_volume\x18\x02 \x01(\tR\rlogicalVolume\"G\n" +
 	"\"LVMServiceVolumeGroupRemoveRequest\x12!\n" +
 	"\fvolume_group\x18\x01 \x01(\tR\vvolumeGroup\"?\n" +
 	"%LVMServicePhysicalVolumeRemoveRequest\x12\x16\n" +
-	"\x06device\x18\x01 \x01(\tR\x06device2\xa4\x02\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\"\xb3\x01\n" +
+	",LVMServiceLogicalVolumeSnapshotCreateRequest\x12!\n" +
+	"\fvolume_group\x18\x01 \x01(\tR\vvolumeGroup\x12%\n" +
+	"\x0elogical_volume\x18\x02 \x01(\tR\rlogicalVolume\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\tR\bsnapshot\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x04R\tsizeBytes\"v\n" +
+	"*LVMServiceLogicalVolumeSnapshotListRequest\x12!\n" +
+	"\fvolume_group\x18\x01 \x01(\tR\vvolumeGroup\x12%\n" +
+	"\x0elogical_volume\x18\x02 \x01(\tR\rlogicalVolume\"\x82\x02\n" +
+	"\x18LVMLogicalVolumeSnapshot\x12!\n" +
+	"\fvolume_group\x18\x01 \x01(\tR\vvolumeGroup\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06origin\x18\x03 \x01(\tR\x06origin\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\tR\acreated\x12\x12\n" +
+	"\x04thin\x18\x06 \x01(\bR\x04thin\x12!\n" +
+	"\fdata_percent\x18\a \x01(\tR\vdataPercent\x12\x18\n" +
+	"\ainvalid\x18\b \x01(\bR\ainvalid\x12\x18\n" +
+	"\amerging\x18\t \x01(\bR\amerging\"\x94\x01\n" +
+	"#LVMServiceLogicalVolumeSnapshotList\x12,\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x10.common.MetadataR\bmetadata\x12?\n" +
+	"\tsnapshots\x18\x02 \x03(\v2!.machine.LVMLogicalVolumeSnapshotR\tsnapshots\"w\n" +
+	"+LVMServiceLogicalVolumeSnapshotListResponse\x12H\n" +
+	"\bmessages\x18\x01 \x03(\v2,.machine.LVMServiceLogicalVolumeSnapshotListR\bmessages\"o\n" +
+	".LVMServiceLogicalVolumeSnapshotRollbackRequest\x12!\n" +
+	"\fvolume_group\x18\x01 \x01(\tR\vvolumeGroup\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot2\x8d\x05\n" +
 	"\n" +
 	"LVMService\x12\\\n" +
 	"\x13LogicalVolumeRemove\x12-.machine.LVMServiceLogicalVolumeRemoveRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
 	"\x11VolumeGroupRemove\x12+.machine.LVMServiceVolumeGroupRemoveRequest\x1a\x16.google.protobuf.Empty\x12^\n" +
-	"\x14PhysicalVolumeRemove\x12..machine.LVMServicePhysicalVolumeRemoveRequest\x1a\x16.google.protobuf.EmptyBN\n" +
+	"\x14PhysicalVolumeRemove\x12..machine.LVMServicePhysicalVolumeRemoveRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
+	"\x1bLogicalVolumeSnapshotCreate\x125.machine.LVMServiceLogicalVolumeSnapshotCreateRequest\x1a\x16.google.protobuf.Empty\x12\x86\x01\n" +
+	"\x19LogicalVolumeSnapshotList\x123.machine.LVMServiceLogicalVolumeSnapshotListRequest\x1a4.machine.LVMServiceLogicalVolumeSnapshotListResponse\x12p\n" +
+	"\x1dLogicalVolumeSnapshotRollback\x127.machine.LVMServiceLogicalVolumeSnapshotRollbackRequest\x1a\x16.google.protobuf.EmptyBN\n" +
 	"\x15dev.talos.api.machineZ5github.com/siderolabs/talos/pkg/machinery/api/machineb\x06proto3"
 
 var (
@@ -201,25 +633,41 @@ func file_machine_lvm_proto_rawDescGZIP() []byte {
 	return file_machine_lvm_proto_rawDescData
 }
 
-var file_machine_lvm_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_machine_lvm_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_machine_lvm_proto_goTypes = []any{
-	(*LVMServiceLogicalVolumeRemoveRequest)(nil),  // 0: machine.LVMServiceLogicalVolumeRemoveRequest
-	(*LVMServiceVolumeGroupRemoveRequest)(nil),    // 1: machine.LVMServiceVolumeGroupRemoveRequest
-	(*LVMServicePhysicalVolumeRemoveRequest)(nil), // 2: machine.LVMServicePhysicalVolumeRemoveRequest
-	(*emptypb.Empty)(nil),                         // 3: google.protobuf.Empty
+	(*LVMServiceLogicalVolumeRemoveRequest)(nil),           // 0: machine.LVMServiceLogicalVolumeRemoveRequest
+	(*LVMServiceVolumeGroupRemoveRequest)(nil),             // 1: machine.LVMServiceVolumeGroupRemoveRequest
+	(*LVMServicePhysicalVolumeRemoveRequest)(nil),          // 2: machine.LVMServicePhysicalVolumeRemoveRequest
+	(*LVMServiceLogicalVolumeSnapshotCreateRequest)(nil),   // 3: machine.LVMServiceLogicalVolumeSnapshotCreateRequest
+	(*LVMServiceLogicalVolumeSnapshotListRequest)(nil),     // 4: machine.LVMServiceLogicalVolumeSnapshotListRequest
+	(*LVMLogicalVolumeSnapshot)(nil),                       // 5: machine.LVMLogicalVolumeSnapshot
+	(*LVMServiceLogicalVolumeSnapshotList)(nil),            // 6: machine.LVMServiceLogicalVolumeSnapshotList
+	(*LVMServiceLogicalVolumeSnapshotListResponse)(nil),    // 7: machine.LVMServiceLogicalVolumeSnapshotListResponse
+	(*LVMServiceLogicalVolumeSnapshotRollbackRequest)(nil), // 8: machine.LVMServiceLogicalVolumeSnapshotRollbackRequest
+	(*common.Metadata)(nil),                                // 9: common.Metadata
+	(*emptypb.Empty)(nil),                                  // 10: google.protobuf.Empty
 }
 var file_machine_lvm_proto_depIdxs = []int32{
-	0, // 0: machine.LVMService.LogicalVolumeRemove:input_type -> machine.LVMServiceLogicalVolumeRemoveRequest
-	1, // 1: machine.LVMService.VolumeGroupRemove:input_type -> machine.LVMServiceVolumeGroupRemoveRequest
-	2, // 2: machine.LVMService.PhysicalVolumeRemove:input_type -> machine.LVMServicePhysicalVolumeRemoveRequest
-	3, // 3: machine.LVMService.LogicalVolumeRemove:output_type -> google.protobuf.Empty
-	3, // 4: machine.LVMService.VolumeGroupRemove:output_type -> google.protobuf.Empty
-	3, // 5: machine.LVMService.PhysicalVolumeRemove:output_type -> google.protobuf.Empty
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9,  // 0: machine.LVMServiceLogicalVolumeSnapshotList.metadata:type_name -> common.Metadata
+	5,  // 1: machine.LVMServiceLogicalVolumeSnapshotList.snapshots:type_name -> machine.LVMLogicalVolumeSnapshot
+	6,  // 2: machine.LVMServiceLogicalVolumeSnapshotListResponse.messages:type_name -> machine.LVMServiceLogicalVolumeSnapshotList
+	0,  // 3: machine.LVMService.LogicalVolumeRemove:input_type -> machine.LVMServiceLogicalVolumeRemoveRequest
+	1,  // 4: machine.LVMService.VolumeGroupRemove:input_type -> machine.LVMServiceVolumeGroupRemoveRequest
+	2,  // 5: machine.LVMService.PhysicalVolumeRemove:input_type -> machine.LVMServicePhysicalVolumeRemoveRequest
+	3,  // 6: machine.LVMService.LogicalVolumeSnapshotCreate:input_type -> machine.LVMServiceLogicalVolumeSnapshotCreateRequest
+	4,  // 7: machine.LVMService.LogicalVolumeSnapshotList:input_type -> machine.LVMServiceLogicalVolumeSnapshotListRequest
+	8,  // 8: machine.LVMService.LogicalVolumeSnapshotRollback:input_type -> machine.LVMServiceLogicalVolumeSnapshotRollbackRequest
+	10, // 9: machine.LVMService.LogicalVolumeRemove:output_type -> google.protobuf.Empty
+	10, // 10: machine.LVMService.VolumeGroupRemove:output_type -> google.protobuf.Empty
+	10, // 11: machine.LVMService.PhysicalVolumeRemove:output_type -> google.protobuf.Empty
+	10, // 12: machine.LVMService.LogicalVolumeSnapshotCreate:output_type -> google.protobuf.Empty
+	7,  // 13: machine.LVMService.LogicalVolumeSnapshotList:output_type -> machine.LVMServiceLogicalVolumeSnapshotListResponse
+	10, // 14: machine.LVMService.LogicalVolumeSnapshotRollback:output_type -> google.protobuf.Empty
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_machine_lvm_proto_init() }
@@ -233,7 +681,7 @@ func file_machine_lvm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_machine_lvm_proto_rawDesc), len(file_machine_lvm_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
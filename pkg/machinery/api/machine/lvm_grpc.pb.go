@@ -21,9 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LVMService_LogicalVolumeRemove_FullMethodName  = "/machine.LVMService/LogicalVolumeRemove"
-	LVMService_VolumeGroupRemove_FullMethodName    = "/machine.LVMService/VolumeGroupRemove"
-	LVMService_PhysicalVolumeRemove_FullMethodName = "/machine.LVMService/PhysicalVolumeRemove"
+	LVMService_LogicalVolumeRemove_FullMethodName           = "/machine.LVMService/LogicalVolumeRemove"
+	LVMService_VolumeGroupRemove_FullMethodName             = "/machine.LVMService/VolumeGroupRemove"
+	LVMService_PhysicalVolumeRemove_FullMethodName          = "/machine.LVMService/PhysicalVolumeRemove"
+	LVMService_LogicalVolumeSnapshotCreate_FullMethodName   = "/machine.LVMService/LogicalVolumeSnapshotCreate"
+	LVMService_LogicalVolumeSnapshotList_FullMethodName     = "/machine.LVMService/LogicalVolumeSnapshotList"
+	LVMService_LogicalVolumeSnapshotRollback_FullMethodName = "/machine.LVMService/LogicalVolumeSnapshotRollback"
 )
 
 // LVMServiceClient is the client API for LVMService service.
@@ -49,6 +52,20 @@ type LVMServiceClient interface {
 	// The PV must not be part of an active volume group; remove the VG first
 	// with VolumeGroupRemove.
 	PhysicalVolumeRemove(ctx context.Context, in *LVMServicePhysicalVolumeRemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// LogicalVolumeSnapshotCreate takes a snapshot of an LVM logical volume.
+	//
+	// Snapshots of thin LVs are allocated from the thin pool and must not set
+	// size_bytes; snapshots of thick LVs require size_bytes to reserve
+	// copy-on-write space in the volume group.
+	LogicalVolumeSnapshotCreate(ctx context.Context, in *LVMServiceLogicalVolumeSnapshotCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// LogicalVolumeSnapshotList lists the snapshots of LVM logical volumes.
+	LogicalVolumeSnapshotList(ctx context.Context, in *LVMServiceLogicalVolumeSnapshotListRequest, opts ...grpc.CallOption) (*LVMServiceLogicalVolumeSnapshotListResponse, error)
+	// LogicalVolumeSnapshotRollback rolls a logical volume back to a snapshot.
+	//
+	// The snapshot is merged into its origin and consumed. If the origin is in
+	// use (e.g. mounted), the merge is deferred until the next activation of
+	// the origin, i.e. the next reboot.
+	LogicalVolumeSnapshotRollback(ctx context.Context, in *LVMServiceLogicalVolumeSnapshotRollbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type lVMServiceClient struct {
@@ -89,6 +106,36 @@ func (c *lVMServiceClient) PhysicalVolumeRemove(ctx context.Context, in *LVMServ
 	return out, nil
 }
 
+func (c *lVMServiceClient) LogicalVolumeSnapshotCreate(ctx context.Context, in *LVMServiceLogicalVolumeSnapshotCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LVMService_LogicalVolumeSnapshotCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMServiceClient) LogicalVolumeSnapshotList(ctx context.Context, in *LVMServiceLogicalVolumeSnapshotListRequest, opts ...grpc.CallOption) (*LVMServiceLogicalVolumeSnapshotListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LVMServiceLogicalVolumeSnapshotListResponse)
+	err := c.cc.Invoke(ctx, LVMService_LogicalVolumeSnapshotList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMServiceClient) LogicalVolumeSnapshotRollback(ctx context.Context, in *LVMServiceLogicalVolumeSnapshotRollbackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LVMService_LogicalVolumeSnapshotRollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LVMServiceServer is the server API for LVMService service.
 // All implementations must embed UnimplementedLVMServiceServer
 // for forward compatibility.
@@ -112,6 +159,20 @@ type LVMServiceServer interface {
 	// The PV must not be part of an active volume group; remove the VG first
 	// with VolumeGroupRemove.
 	PhysicalVolumeRemove(context.Context, *LVMServicePhysicalVolumeRemoveRequest) (*emptypb.Empty, error)
+	// LogicalVolumeSnapshotCreate takes a snapshot of an LVM logical volume.
+	//
+	// Snapshots of thin LVs are allocated from the thin pool and must not set
+	// size_bytes; snapshots of thick LVs require size_bytes to reserve
+	// copy-on-write space in the volume group.
+	LogicalVolumeSnapshotCreate(context.Context, *LVMServiceLogicalVolumeSnapshotCreateRequest) (*emptypb.Empty, error)
+	// LogicalVolumeSnapshotList lists the snapshots of LVM logical volumes.
+	LogicalVolumeSnapshotList(context.Context, *LVMServiceLogicalVolumeSnapshotListRequest) (*LVMServiceLogicalVolumeSnapshotListResponse, error)
+	// LogicalVolumeSnapshotRollback rolls a logical volume back to a snapshot.
+	//
+	// The snapshot is merged into its origin and consumed. If the origin is in
+	// use (e.g. mounted), the merge is deferred until the next activation of
+	// the origin, i.e. the next reboot.
+	LogicalVolumeSnapshotRollback(context.Context, *LVMServiceLogicalVolumeSnapshotRollbackRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedLVMServiceServer()
 }
 
@@ -131,6 +192,15 @@ func (UnimplementedLVMServiceServer) VolumeGroupRemove(context.Context, *LVMServ
 func (UnimplementedLVMServiceServer) PhysicalVolumeRemove(context.Context, *LVMServicePhysicalVolumeRemoveRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method PhysicalVolumeRemove not implemented")
 }
+func (UnimplementedLVMServiceServer) LogicalVolumeSnapshotCreate(context.Context, *LVMServiceLogicalVolumeSnapshotCreateRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogicalVolumeSnapshotCreate not implemented")
+}
+func (UnimplementedLVMServiceServer) LogicalVolumeSnapshotList(context.Context, *LVMServiceLogicalVolumeSnapshotListRequest) (*LVMServiceLogicalVolumeSnapshotListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogicalVolumeSnapshotList not implemented")
+}
+func (UnimplementedLVMServiceServer) LogicalVolumeSnapshotRollback(context.Context, *LVMServiceLogicalVolumeSnapshotRollbackRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogicalVolumeSnapshotRollback not implemented")
+}
 func (UnimplementedLVMServiceServer) mustEmbedUnimplementedLVMServiceServer() {}
 func (UnimplementedLVMServiceServer) testEmbeddedByValue()                    {}
 
@@ -206,6 +276,60 @@ func _LVMService_PhysicalVolumeRemove_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LVMService_LogicalVolumeSnapshotCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LVMServiceLogicalVolumeSnapshotCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServiceServer).LogicalVolumeSnapshotCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LVMService_LogicalVolumeSnapshotCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServiceServer).LogicalVolumeSnapshotCreate(ctx, req.(*LVMServiceLogicalVolumeSnapshotCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVMService_LogicalVolumeSnapshotList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LVMServiceLogicalVolumeSnapshotListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServiceServer).LogicalVolumeSnapshotList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LVMService_LogicalVolumeSnapshotList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServiceServer).LogicalVolumeSnapshotList(ctx, req.(*LVMServiceLogicalVolumeSnapshotListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVMService_LogicalVolumeSnapshotRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LVMServiceLogicalVolumeSnapshotRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServiceServer).LogicalVolumeSnapshotRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LVMService_LogicalVolumeSnapshotRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServiceServer).LogicalVolumeSnapshotRollback(ctx, req.(*LVMServiceLogicalVolumeSnapshotRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LVMService_ServiceDesc is the grpc.ServiceDesc for LVMService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +349,18 @@ var LVMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PhysicalVolumeRemove",
 			Handler:    _LVMService_PhysicalVolumeRemove_Handler,
 		},
+		{
+			MethodName: "LogicalVolumeSnapshotCreate",
+			Handler:    _LVMService_LogicalVolumeSnapshotCreate_Handler,
+		},
+		{
+			MethodName: "LogicalVolumeSnapshotList",
+			Handler:    _LVMService_LogicalVolumeSnapshotList_Handler,
+		},
+		{
+			MethodName: "LogicalVolumeSnapshotRollback",
+			Handler:    _LVMService_LogicalVolumeSnapshotRollback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "machine/lvm.proto",
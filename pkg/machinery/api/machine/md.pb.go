@@ -23,6 +23,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MDSetSyncActionRequest_Action int32
+
+const (
+	// CHECK reads all members and counts mismatches without correcting them.
+	MDSetSyncActionRequest_CHECK MDSetSyncActionRequest_Action = 0
+	// REPAIR reads all members and rewrites mismatched blocks.
+	MDSetSyncActionRequest_REPAIR MDSetSyncActionRequest_Action = 1
+	// IDLE aborts the running check or repair.
+	MDSetSyncActionRequest_IDLE MDSetSyncActionRequest_Action = 2
+)
+
+// Enum value maps for MDSetSyncActionRequest_Action.
+var (
+	MDSetSyncActionRequest_Action_name = map[int32]string{
+		0: "CHECK",
+		1: "REPAIR",
+		2: "IDLE",
+	}
+	MDSetSyncActionRequest_Action_value = map[string]int32{
+		"CHECK":  0,
+		"REPAIR": 1,
+		"IDLE":   2,
+	}
+)
+
+func (x MDSetSyncActionRequest_Action) Enum() *MDSetSyncActionRequest_Action {
+	p := new(MDSetSyncActionRequest_Action)
+	*p = x
+	return p
+}
+
+func (x MDSetSyncActionRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MDSetSyncActionRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_md_proto_enumTypes[0].Descriptor()
+}
+
+func (MDSetSyncActionRequest_Action) Type() protoreflect.EnumType {
+	return &file_machine_md_proto_enumTypes[0]
+}
+
+func (x MDSetSyncActionRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MDSetSyncActionRequest_Action.Descriptor instead.
+func (MDSetSyncActionRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_machine_md_proto_rawDescGZIP(), []int{4, 0}
+}
+
 // MDDestroyRequest identifies the array device to tear down.
 type MDDestroyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -69,15 +121,276 @@ func (x *MDDestroyRequest) GetDevice() string {
 	return ""
 }
 
+// MDAddSpareRequest identifies the array and the device to add as a spare.
+type MDAddSpareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Device is the full array device path (e.g. "/dev/disk/by-id/md-name-data").
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Member is the full path of the device to add (e.g. "/dev/sdc").
+	Member        string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDAddSpareRequest) Reset() {
+	*x = MDAddSpareRequest{}
+	mi := &file_machine_md_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDAddSpareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDAddSpareRequest) ProtoMessage() {}
+
+func (x *MDAddSpareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_md_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDAddSpareRequest.ProtoReflect.Descriptor instead.
+func (*MDAddSpareRequest) Descriptor() ([]byte, []int) {
+	return file_machine_md_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MDAddSpareRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *MDAddSpareRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+// MDReplaceMemberRequest identifies the member to replace and its replacement.
+type MDReplaceMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Device is the full array device path (e.g. "/dev/disk/by-id/md-name-data").
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Member is the full path of the member to replace (e.g. "/dev/sdb").
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// Replacement is the full path of the new device (e.g. "/dev/sdc").
+	Replacement   string `protobuf:"bytes,3,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDReplaceMemberRequest) Reset() {
+	*x = MDReplaceMemberRequest{}
+	mi := &file_machine_md_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDReplaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDReplaceMemberRequest) ProtoMessage() {}
+
+func (x *MDReplaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_md_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDReplaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*MDReplaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_machine_md_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MDReplaceMemberRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *MDReplaceMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *MDReplaceMemberRequest) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+// MDFailMemberRequest identifies the member to mark faulty.
+type MDFailMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Device is the full array device path (e.g. "/dev/disk/by-id/md-name-data").
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Member is the full path of the member to fail (e.g. "/dev/sdb").
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// Remove detaches the member from the array after marking it faulty.
+	Remove        bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDFailMemberRequest) Reset() {
+	*x = MDFailMemberRequest{}
+	mi := &file_machine_md_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDFailMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDFailMemberRequest) ProtoMessage() {}
+
+func (x *MDFailMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_md_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDFailMemberRequest.ProtoReflect.Descriptor instead.
+func (*MDFailMemberRequest) Descriptor() ([]byte, []int) {
+	return file_machine_md_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MDFailMemberRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *MDFailMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *MDFailMemberRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+// MDSetSyncActionRequest identifies the array and the sync action to run.
+type MDSetSyncActionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Device is the full array device path (e.g. "/dev/disk/by-id/md-name-data").
+	Device        string                        `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Action        MDSetSyncActionRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=machine.MDSetSyncActionRequest_Action" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDSetSyncActionRequest) Reset() {
+	*x = MDSetSyncActionRequest{}
+	mi := &file_machine_md_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDSetSyncActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDSetSyncActionRequest) ProtoMessage() {}
+
+func (x *MDSetSyncActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_md_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDSetSyncActionRequest.ProtoReflect.Descriptor instead.
+func (*MDSetSyncActionRequest) Descriptor() ([]byte, []int) {
+	return file_machine_md_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MDSetSyncActionRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *MDSetSyncActionRequest) GetAction() MDSetSyncActionRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return MDSetSyncActionRequest_CHECK
+}
+
 var File_machine_md_proto protoreflect.FileDescriptor
 
 const file_machine_md_proto_rawDesc = "" +
 	"\n" +
 	"\x10machine/md.proto\x12\amachine\x1a\x1bgoogle/protobuf/empty.proto\"*\n" +
 	"\x10MDDestroyRequest\x12\x16\n" +
-	"\x06device\x18\x01 \x01(\tR\x06device2I\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\"C\n" +
+	"\x11MDAddSpareRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\"j\n" +
+	"\x16MDReplaceMemberRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\x12 \n" +
+	"\vreplacement\x18\x03 \x01(\tR\vreplacement\"]\n" +
+	"\x13MDFailMemberRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\tR\x06member\x12\x16\n" +
+	"\x06remove\x18\x03 \x01(\bR\x06remove\"\x9b\x01\n" +
+	"\x16MDSetSyncActionRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12>\n" +
+	"\x06action\x18\x02 \x01(\x0e2&.machine.MDSetSyncActionRequest.ActionR\x06action\")\n" +
+	"\x06Action\x12\t\n" +
+	"\x05CHECK\x10\x00\x12\n" +
+	"\n" +
+	"\x06REPAIR\x10\x01\x12\b\n" +
+	"\x04IDLE\x10\x022\xe1\x02\n" +
 	"\tMDService\x12<\n" +
-	"\aDestroy\x12\x19.machine.MDDestroyRequest\x1a\x16.google.protobuf.EmptyBN\n" +
+	"\aDestroy\x12\x19.machine.MDDestroyRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\bAddSpare\x12\x1a.machine.MDAddSpareRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\rReplaceMember\x12\x1f.machine.MDReplaceMemberRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\n" +
+	"FailMember\x12\x1c.machine.MDFailMemberRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\rSetSyncAction\x12\x1f.machine.MDSetSyncActionRequest\x1a\x16.google.protobuf.EmptyBN\n" +
 	"\x15dev.talos.api.machineZ5github.com/siderolabs/talos/pkg/machinery/api/machineb\x06proto3"
 
 var (
@@ -92,19 +405,34 @@ func file_machine_md_proto_rawDescGZIP() []byte {
 	return file_machine_md_proto_rawDescData
 }
 
-var file_machine_md_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_machine_md_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_machine_md_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_machine_md_proto_goTypes = []any{
-	(*MDDestroyRequest)(nil), // 0: machine.MDDestroyRequest
-	(*emptypb.Empty)(nil),    // 1: google.protobuf.Empty
+	(MDSetSyncActionRequest_Action)(0), // 0: machine.MDSetSyncActionRequest.Action
+	(*MDDestroyRequest)(nil),           // 1: machine.MDDestroyRequest
+	(*MDAddSpareRequest)(nil),          // 2: machine.MDAddSpareRequest
+	(*MDReplaceMemberRequest)(nil),     // 3: machine.MDReplaceMemberRequest
+	(*MDFailMemberRequest)(nil),        // 4: machine.MDFailMemberRequest
+	(*MDSetSyncActionRequest)(nil),     // 5: machine.MDSetSyncActionRequest
+	(*emptypb.Empty)(nil),              // 6: google.protobuf.Empty
 }
 var file_machine_md_proto_depIdxs = []int32{
-	0, // 0: machine.MDService.Destroy:input_type -> machine.MDDestroyRequest
-	1, // 1: machine.MDService.Destroy:output_type -> google.protobuf.Empty
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: machine.MDSetSyncActionRequest.action:type_name -> machine.MDSetSyncActionRequest.Action
+	1, // 1: machine.MDService.Destroy:input_type -> machine.MDDestroyRequest
+	2, // 2: machine.MDService.AddSpare:input_type -> machine.MDAddSpareRequest
+	3, // 3: machine.MDService.ReplaceMember:input_type -> machine.MDReplaceMemberRequest
+	4, // 4: machine.MDService.FailMember:input_type -> machine.MDFailMemberRequest
+	5, // 5: machine.MDService.SetSyncAction:input_type -> machine.MDSetSyncActionRequest
+	6, // 6: machine.MDService.Destroy:output_type -> google.protobuf.Empty
+	6, // 7: machine.MDService.AddSpare:output_type -> google.protobuf.Empty
+	6, // 8: machine.MDService.ReplaceMember:output_type -> google.protobuf.Empty
+	6, // 9: machine.MDService.FailMember:output_type -> google.protobuf.Empty
+	6, // 10: machine.MDService.SetSyncAction:output_type -> google.protobuf.Empty
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_machine_md_proto_init() }
@@ -117,13 +445,14 @@ func file_machine_md_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_machine_md_proto_rawDesc), len(file_machine_md_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_machine_md_proto_goTypes,
 		DependencyIndexes: file_machine_md_proto_depIdxs,
+		EnumInfos:         file_machine_md_proto_enumTypes,
 		MessageInfos:      file_machine_md_proto_msgTypes,
 	}.Build()
 	File_machine_md_proto = out.File
@@ -21,7 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MDService_Destroy_FullMethodName = "/machine.MDService/Destroy"
+	MDService_Destroy_FullMethodName       = "/machine.MDService/Destroy"
+	MDService_AddSpare_FullMethodName      = "/machine.MDService/AddSpare"
+	MDService_ReplaceMember_FullMethodName = "/machine.MDService/ReplaceMember"
+	MDService_FailMember_FullMethodName    = "/machine.MDService/FailMember"
+	MDService_SetSyncAction_FullMethodName = "/machine.MDService/SetSyncAction"
 )
 
 // MDServiceClient is the client API for MDService service.
@@ -31,12 +35,33 @@ const (
 // MDService maintains MD (Multiple Device, software RAID) arrays.
 //
 //   - Destroy: stop the array and clear member superblocks.
+//   - AddSpare, ReplaceMember, FailMember: member maintenance.
+//   - SetSyncAction: start or abort a check/repair of the array.
 type MDServiceClient interface {
 	// Destroy stops the array and clears the superblock on every member.
 	//
 	// The array must not be in use (e.g. mounted or claimed by another
 	// device) at the time of the call.
 	Destroy(ctx context.Context, in *MDDestroyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AddSpare adds a device to the array as a spare.
+	//
+	// If the array is degraded, the kernel starts rebuilding onto the spare
+	// right away.
+	AddSpare(ctx context.Context, in *MDAddSpareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReplaceMember hot-replaces an active member with a new device.
+	//
+	// The replacement is rebuilt while the old member stays active, so the
+	// array keeps its redundancy; once the rebuild finishes, the old member is
+	// marked faulty and can be removed with FailMember.
+	ReplaceMember(ctx context.Context, in *MDReplaceMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// FailMember marks a member faulty, optionally removing it from the array.
+	FailMember(ctx context.Context, in *MDFailMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetSyncAction starts a check or repair of the array, or aborts the one
+	// which is running.
+	//
+	// Progress and mismatch counts are reported in the MDArraySyncStatus
+	// resource.
+	SetSyncAction(ctx context.Context, in *MDSetSyncActionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type mDServiceClient struct {
@@ -57,6 +82,46 @@ func (c *mDServiceClient) Destroy(ctx context.Context, in *MDDestroyRequest, opt
 	return out, nil
 }
 
+func (c *mDServiceClient) AddSpare(ctx context.Context, in *MDAddSpareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MDService_AddSpare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mDServiceClient) ReplaceMember(ctx context.Context, in *MDReplaceMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MDService_ReplaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mDServiceClient) FailMember(ctx context.Context, in *MDFailMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MDService_FailMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mDServiceClient) SetSyncAction(ctx context.Context, in *MDSetSyncActionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MDService_SetSyncAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MDServiceServer is the server API for MDService service.
 // All implementations must embed UnimplementedMDServiceServer
 // for forward compatibility.
@@ -64,12 +129,33 @@ func (c *mDServiceClient) Destroy(ctx context.Context, in *MDDestroyRequest, opt
 // MDService maintains MD (Multiple Device, software RAID) arrays.
 //
 //   - Destroy: stop the array and clear member superblocks.
+//   - AddSpare, ReplaceMember, FailMember: member maintenance.
+//   - SetSyncAction: start or abort a check/repair of the array.
 type MDServiceServer interface {
 	// Destroy stops the array and clears the superblock on every member.
 	//
 	// The array must not be in use (e.g. mounted or claimed by another
 	// device) at the time of the call.
 	Destroy(context.Context, *MDDestroyRequest) (*emptypb.Empty, error)
+	// AddSpare adds a device to the array as a spare.
+	//
+	// If the array is degraded, the kernel starts rebuilding onto the spare
+	// right away.
+	AddSpare(context.Context, *MDAddSpareRequest) (*emptypb.Empty, error)
+	// ReplaceMember hot-replaces an active member with a new device.
+	//
+	// The replacement is rebuilt while the old member stays active, so the
+	// array keeps its redundancy; once the rebuild finishes, the old member is
+	// marked faulty and can be removed with FailMember.
+	ReplaceMember(context.Context, *MDReplaceMemberRequest) (*emptypb.Empty, error)
+	// FailMember marks a member faulty, optionally removing it from the array.
+	FailMember(context.Context, *MDFailMemberRequest) (*emptypb.Empty, error)
+	// SetSyncAction starts a check or repair of the array, or aborts the one
+	// which is running.
+	//
+	// Progress and mismatch counts are reported in the MDArraySyncStatus
+	// resource.
+	SetSyncAction(context.Context, *MDSetSyncActionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMDServiceServer()
 }
 
@@ -83,6 +169,18 @@ type UnimplementedMDServiceServer struct{}
 func (UnimplementedMDServiceServer) Destroy(context.Context, *MDDestroyRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Destroy not implemented")
 }
+func (UnimplementedMDServiceServer) AddSpare(context.Context, *MDAddSpareRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddSpare not implemented")
+}
+func (UnimplementedMDServiceServer) ReplaceMember(context.Context, *MDReplaceMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplaceMember not implemented")
+}
+func (UnimplementedMDServiceServer) FailMember(context.Context, *MDFailMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method FailMember not implemented")
+}
+func (UnimplementedMDServiceServer) SetSyncAction(context.Context, *MDSetSyncActionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSyncAction not implemented")
+}
 func (UnimplementedMDServiceServer) mustEmbedUnimplementedMDServiceServer() {}
 func (UnimplementedMDServiceServer) testEmbeddedByValue()                   {}
 
@@ -122,6 +220,78 @@ func _MDService_Destroy_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MDService_AddSpare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDAddSpareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MDServiceServer).AddSpare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MDService_AddSpare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MDServiceServer).AddSpare(ctx, req.(*MDAddSpareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MDService_ReplaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDReplaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MDServiceServer).ReplaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MDService_ReplaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MDServiceServer).ReplaceMember(ctx, req.(*MDReplaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MDService_FailMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDFailMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MDServiceServer).FailMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MDService_FailMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MDServiceServer).FailMember(ctx, req.(*MDFailMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MDService_SetSyncAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDSetSyncActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MDServiceServer).SetSyncAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MDService_SetSyncAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MDServiceServer).SetSyncAction(ctx, req.(*MDSetSyncActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MDService_ServiceDesc is the grpc.ServiceDesc for MDService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -133,6 +303,22 @@ var MDService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Destroy",
 			Handler:    _MDService_Destroy_Handler,
 		},
+		{
+			MethodName: "AddSpare",
+			Handler:    _MDService_AddSpare_Handler,
+		},
+		{
+			MethodName: "ReplaceMember",
+			Handler:    _MDService_ReplaceMember_Handler,
+		},
+		{
+			MethodName: "FailMember",
+			Handler:    _MDService_FailMember_Handler,
+		},
+		{
+			MethodName: "SetSyncAction",
+			Handler:    _MDService_SetSyncAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "machine/md.proto",
@@ -387,6 +387,8 @@ type KubeSpanAffiliateSpec struct {
 	AdditionalAddresses       []*common.NetIPPrefix  `protobuf:"bytes,3,rep,name=additional_addresses,json=additionalAddresses,proto3" json:"additional_addresses,omitempty"`
 	Endpoints                 []*common.NetIPPort    `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 	ExcludeAdvertisedNetworks []*common.NetIPPrefix  `protobuf:"bytes,5,rep,name=exclude_advertised_networks,json=excludeAdvertisedNetworks,proto3" json:"exclude_advertised_networks,omitempty"`
+	Relay                     bool                   `protobuf:"varint,6,opt,name=relay,proto3" json:"relay,omitempty"`
+	AlternatePublicKey        string                 `protobuf:"bytes,7,opt,name=alternate_public_key,json=alternatePublicKey,proto3" json:"alternate_public_key,omitempty"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
@@ -456,6 +458,20 @@ func (x *KubeSpanAffiliateSpec) GetExcludeAdvertisedNetworks() []*common.NetIPPr
 	return nil
 }
 
+func (x *KubeSpanAffiliateSpec) GetRelay() bool {
+	if x != nil {
+		return x.Relay
+	}
+	return false
+}
+
+func (x *KubeSpanAffiliateSpec) GetAlternatePublicKey() string {
+	if x != nil {
+		return x.AlternatePublicKey
+	}
+	return ""
+}
+
 // MemberSpec describes Member state.
 type MemberSpec struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -633,14 +649,16 @@ const file_resource_definitions_cluster_cluster_proto_rawDesc = "" +
 	"\bInfoSpec\x12\x1d\n" +
 	"\n" +
 	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
-	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\"\xad\x02\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\"\xf5\x02\n" +
 	"\x15KubeSpanAffiliateSpec\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\x12'\n" +
 	"\aaddress\x18\x02 \x01(\v2\r.common.NetIPR\aaddress\x12F\n" +
 	"\x14additional_addresses\x18\x03 \x03(\v2\x13.common.NetIPPrefixR\x13additionalAddresses\x12/\n" +
 	"\tendpoints\x18\x04 \x03(\v2\x11.common.NetIPPortR\tendpoints\x12S\n" +
-	"\x1bexclude_advertised_networks\x18\x05 \x03(\v2\x13.common.NetIPPrefixR\x19excludeAdvertisedNetworks\"\xc2\x02\n" +
+	"\x1bexclude_advertised_networks\x18\x05 \x03(\v2\x13.common.NetIPPrefixR\x19excludeAdvertisedNetworks\x12\x14\n" +
+	"\x05relay\x18\x06 \x01(\bR\x05relay\x120\n" +
+	"\x14alternate_public_key\x18\a \x01(\tR\x12alternatePublicKey\"\xc2\x02\n" +
 	"\n" +
 	"MemberSpec\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12+\n" +
@@ -13,7 +13,10 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
+	common "github.com/siderolabs/talos/pkg/machinery/api/common"
 	enums "github.com/siderolabs/talos/pkg/machinery/api/resource/definitions/enums"
 )
 
@@ -93,6 +96,175 @@ func (x *ContainerDependsOnSpec) GetContainers() []string {
 	return nil
 }
 
+// ContainerHealthCheckSpec is the resolved health check.
+//
+// Kind is empty when the container declares no health check.
+type ContainerHealthCheckSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind is one of "exec", "http" or "tcp".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Command is run inside the container by an exec check.
+	Command []string `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	// Port is the host loopback port an http or tcp check connects to.
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Path is the request path of an http check.
+	Path          string               `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	InitialDelay  *durationpb.Duration `protobuf:"bytes,5,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	Period        *durationpb.Duration `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`
+	Timeout       *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerHealthCheckSpec) Reset() {
+	*x = ContainerHealthCheckSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerHealthCheckSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerHealthCheckSpec) ProtoMessage() {}
+
+func (x *ContainerHealthCheckSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerHealthCheckSpec.ProtoReflect.Descriptor instead.
+func (*ContainerHealthCheckSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContainerHealthCheckSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ContainerHealthCheckSpec) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ContainerHealthCheckSpec) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ContainerHealthCheckSpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ContainerHealthCheckSpec) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *ContainerHealthCheckSpec) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ContainerHealthCheckSpec) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// ContainerHealthSpec is the state of a health check, as reported by the health check runner.
+type ContainerHealthSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unknown is set until the first check completes.
+	Unknown       bool                   `protobuf:"varint,1,opt,name=unknown,proto3" json:"unknown,omitempty"`
+	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	LastMessage   string                 `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	LastChange    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerHealthSpec) Reset() {
+	*x = ContainerHealthSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerHealthSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerHealthSpec) ProtoMessage() {}
+
+func (x *ContainerHealthSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerHealthSpec.ProtoReflect.Descriptor instead.
+func (*ContainerHealthSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContainerHealthSpec) GetUnknown() bool {
+	if x != nil {
+		return x.Unknown
+	}
+	return false
+}
+
+func (x *ContainerHealthSpec) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ContainerHealthSpec) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *ContainerHealthSpec) GetLastChange() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChange
+	}
+	return nil
+}
+
 // ContainerImageSpec is a resolved container image reference.
 type ContainerImageSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -103,7 +275,7 @@ type ContainerImageSpec struct {
 
 func (x *ContainerImageSpec) Reset() {
 	*x = ContainerImageSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[1]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -115,7 +287,7 @@ func (x *ContainerImageSpec) String() string {
 func (*ContainerImageSpec) ProtoMessage() {}
 
 func (x *ContainerImageSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[1]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,7 +300,7 @@ func (x *ContainerImageSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerImageSpec.ProtoReflect.Descriptor instead.
 func (*ContainerImageSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ContainerImageSpec) GetRef() string {
@@ -147,14 +319,16 @@ type ContainerImageStatusSpec struct {
 	// Digest is the resolved digest, set once the pull completes.
 	Digest string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
 	// Error is the last pull failure, verbatim.
-	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Verification records how the pulled image was checked against the image verification rules.
+	Verification  *ContainerImageVerificationSpec `protobuf:"bytes,5,opt,name=verification,proto3" json:"verification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ContainerImageStatusSpec) Reset() {
 	*x = ContainerImageStatusSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[2]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -166,7 +340,7 @@ func (x *ContainerImageStatusSpec) String() string {
 func (*ContainerImageStatusSpec) ProtoMessage() {}
 
 func (x *ContainerImageStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[2]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,7 +353,7 @@ func (x *ContainerImageStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerImageStatusSpec.ProtoReflect.Descriptor instead.
 func (*ContainerImageStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ContainerImageStatusSpec) GetPhase() enums.ContainersContainerImagePhase {
@@ -210,6 +384,114 @@ func (x *ContainerImageStatusSpec) GetError() string {
 	return ""
 }
 
+func (x *ContainerImageStatusSpec) GetVerification() *ContainerImageVerificationSpec {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// ContainerImageVerificationSpec records the outcome of checking an image against the image
+// verification rules.
+type ContainerImageVerificationSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Verified is set if the image matched a rule and its signature was verified.
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Rule is the ID of the matched rule.
+	Rule string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Message describes how the signature was found and verified.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Signer is the identity the image was signed with, empty for public key signatures.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Issuer is the OIDC issuer which vouched for Signer.
+	Issuer string `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// RekorLogID is the transparency log the signature is recorded in, empty if it was not checked.
+	RekorLogId string `protobuf:"bytes,6,opt,name=rekor_log_id,json=rekorLogId,proto3" json:"rekor_log_id,omitempty"`
+	// RekorLogIndex is the index of the signature's entry in the transparency log.
+	RekorLogIndex int64 `protobuf:"varint,7,opt,name=rekor_log_index,json=rekorLogIndex,proto3" json:"rekor_log_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerImageVerificationSpec) Reset() {
+	*x = ContainerImageVerificationSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerImageVerificationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerImageVerificationSpec) ProtoMessage() {}
+
+func (x *ContainerImageVerificationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerImageVerificationSpec.ProtoReflect.Descriptor instead.
+func (*ContainerImageVerificationSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContainerImageVerificationSpec) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ContainerImageVerificationSpec) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ContainerImageVerificationSpec) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ContainerImageVerificationSpec) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *ContainerImageVerificationSpec) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *ContainerImageVerificationSpec) GetRekorLogId() string {
+	if x != nil {
+		return x.RekorLogId
+	}
+	return ""
+}
+
+func (x *ContainerImageVerificationSpec) GetRekorLogIndex() int64 {
+	if x != nil {
+		return x.RekorLogIndex
+	}
+	return 0
+}
+
 // ContainerInstanceSpecSpec is the spec for ContainerInstanceSpec.
 //
 // It carries a resolved snapshot of everything needed to run one execution, so whatever runs it
@@ -229,17 +511,20 @@ type ContainerInstanceSpecSpec struct {
 	RunAs       *ContainerRunAsSpec `protobuf:"bytes,7,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
 	Environment []string            `protobuf:"bytes,8,rep,name=environment,proto3" json:"environment,omitempty"`
 	// Mounts are fully resolved, with host source paths filled in.
-	Mounts        []*ResolvedMountSpec    `protobuf:"bytes,9,rep,name=mounts,proto3" json:"mounts,omitempty"`
-	Security      *ContainerSecuritySpec  `protobuf:"bytes,10,opt,name=security,proto3" json:"security,omitempty"`
-	Network       *ContainerNetworkSpec   `protobuf:"bytes,11,opt,name=network,proto3" json:"network,omitempty"`
-	Resources     *ContainerResourcesSpec `protobuf:"bytes,12,opt,name=resources,proto3" json:"resources,omitempty"`
+	Mounts      []*ResolvedMountSpec      `protobuf:"bytes,9,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	Security    *ContainerSecuritySpec    `protobuf:"bytes,10,opt,name=security,proto3" json:"security,omitempty"`
+	Network     *ContainerNetworkSpec     `protobuf:"bytes,11,opt,name=network,proto3" json:"network,omitempty"`
+	Resources   *ContainerResourcesSpec   `protobuf:"bytes,12,opt,name=resources,proto3" json:"resources,omitempty"`
+	HealthCheck *ContainerHealthCheckSpec `protobuf:"bytes,13,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// Address is the container's address on its link, for a container attached to a host link.
+	Address       *common.NetIPPrefix `protobuf:"bytes,14,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ContainerInstanceSpecSpec) Reset() {
 	*x = ContainerInstanceSpecSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[3]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +536,7 @@ func (x *ContainerInstanceSpecSpec) String() string {
 func (*ContainerInstanceSpecSpec) ProtoMessage() {}
 
 func (x *ContainerInstanceSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[3]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +549,7 @@ func (x *ContainerInstanceSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerInstanceSpecSpec.ProtoReflect.Descriptor instead.
 func (*ContainerInstanceSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ContainerInstanceSpecSpec) GetContainerId() string {
@@ -351,6 +636,146 @@ func (x *ContainerInstanceSpecSpec) GetResources() *ContainerResourcesSpec {
 	return nil
 }
 
+func (x *ContainerInstanceSpecSpec) GetHealthCheck() *ContainerHealthCheckSpec {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *ContainerInstanceSpecSpec) GetAddress() *common.NetIPPrefix {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// ContainerInstanceStatusSpec is the spec for ContainerInstanceStatus.
+type ContainerInstanceStatusSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ContainerID is the name of the owning container, i.e. the ContainerSpec ID.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Generation is the instance's sequence number for that container.
+	Generation uint64                                 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	Phase      enums.ContainersContainerInstancePhase `protobuf:"varint,3,opt,name=phase,proto3,enum=talos.resource.definitions.enums.ContainersContainerInstancePhase" json:"phase,omitempty"`
+	// PID is the host PID of the task's init process, set once it has started.
+	Pid uint32 `protobuf:"varint,4,opt,name=pid,proto3" json:"pid,omitempty"`
+	// ExitCode is the task's exit status, set once it has exited.
+	ExitCode uint32 `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Restarts counts the earlier instances of this container which terminated on their own.
+	//
+	// Replacing an instance because its spec changed is not a restart.
+	Restarts   uint64                 `protobuf:"varint,6,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// LastError is the last failure to create, start or track the task, verbatim.
+	LastError string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Health is the state of the health check, if the instance declares one.
+	Health        *ContainerHealthSpec `protobuf:"bytes,10,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerInstanceStatusSpec) Reset() {
+	*x = ContainerInstanceStatusSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerInstanceStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInstanceStatusSpec) ProtoMessage() {}
+
+func (x *ContainerInstanceStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInstanceStatusSpec.ProtoReflect.Descriptor instead.
+func (*ContainerInstanceStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ContainerInstanceStatusSpec) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerInstanceStatusSpec) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ContainerInstanceStatusSpec) GetPhase() enums.ContainersContainerInstancePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return enums.ContainersContainerInstancePhase(0)
+}
+
+func (x *ContainerInstanceStatusSpec) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ContainerInstanceStatusSpec) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ContainerInstanceStatusSpec) GetRestarts() uint64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ContainerInstanceStatusSpec) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ContainerInstanceStatusSpec) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ContainerInstanceStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ContainerInstanceStatusSpec) GetHealth() *ContainerHealthSpec {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
 // ContainerMountSpec is a resolved mount.
 //
 // Exactly one of VolumeID, Tmpfs or HostPath describes the source; Kind says which.
@@ -374,19 +799,239 @@ type ContainerMountSpec struct {
 
 func (x *ContainerMountSpec) Reset() {
 	*x = ContainerMountSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[4]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerMountSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerMountSpec) ProtoMessage() {}
+
+func (x *ContainerMountSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerMountSpec.ProtoReflect.Descriptor instead.
+func (*ContainerMountSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ContainerMountSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ContainerMountSpec) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *ContainerMountSpec) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ContainerMountSpec) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *ContainerMountSpec) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ContainerMountSpec) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ContainerMountStatusSpec is the spec for ContainerMountStatus.
+type ContainerMountStatusSpec struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Volumes       []*ContainerVolumeMountSpec `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerMountStatusSpec) Reset() {
+	*x = ContainerMountStatusSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerMountStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerMountStatusSpec) ProtoMessage() {}
+
+func (x *ContainerMountStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerMountStatusSpec.ProtoReflect.Descriptor instead.
+func (*ContainerMountStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ContainerMountStatusSpec) GetVolumes() []*ContainerVolumeMountSpec {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+// ContainerNetworkSpec is the resolved network configuration.
+type ContainerNetworkSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HostNetwork shares the host network namespace instead of creating an empty one.
+	HostNetwork bool `protobuf:"varint,1,opt,name=host_network,json=hostNetwork,proto3" json:"host_network,omitempty"`
+	// Kind is "bridge" or "macvlan" for a container attached to a host link, empty otherwise.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Link is the bridge the container is attached to, or the parent link of its macvlan.
+	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	// Subnet the container address is allocated from.
+	Subnet *common.NetIPPrefix `protobuf:"bytes,4,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// Gateway is the container's default gateway; the zero value means no default route.
+	Gateway       *common.NetIP        `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Ports         []*ContainerPortSpec `protobuf:"bytes,6,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerNetworkSpec) Reset() {
+	*x = ContainerNetworkSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerNetworkSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerNetworkSpec) ProtoMessage() {}
+
+func (x *ContainerNetworkSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerNetworkSpec.ProtoReflect.Descriptor instead.
+func (*ContainerNetworkSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ContainerNetworkSpec) GetHostNetwork() bool {
+	if x != nil {
+		return x.HostNetwork
+	}
+	return false
+}
+
+func (x *ContainerNetworkSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ContainerNetworkSpec) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ContainerNetworkSpec) GetSubnet() *common.NetIPPrefix {
+	if x != nil {
+		return x.Subnet
+	}
+	return nil
+}
+
+func (x *ContainerNetworkSpec) GetGateway() *common.NetIP {
+	if x != nil {
+		return x.Gateway
+	}
+	return nil
+}
+
+func (x *ContainerNetworkSpec) GetPorts() []*ContainerPortSpec {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// ContainerNetworkStatusSpec is the spec for ContainerNetworkStatus.
+type ContainerNetworkStatusSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address allocated to the container, with the prefix length of its subnet.
+	Address *common.NetIPPrefix `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Link the address was allocated on.
+	Link string `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	// LinkUp is set while the link exists and is operationally up.
+	LinkUp        bool `protobuf:"varint,3,opt,name=link_up,json=linkUp,proto3" json:"link_up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerNetworkStatusSpec) Reset() {
+	*x = ContainerNetworkStatusSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerMountSpec) String() string {
+func (x *ContainerNetworkStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerMountSpec) ProtoMessage() {}
+func (*ContainerNetworkStatusSpec) ProtoMessage() {}
 
-func (x *ContainerMountSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[4]
+func (x *ContainerNetworkStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,77 +1042,57 @@ func (x *ContainerMountSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerMountSpec.ProtoReflect.Descriptor instead.
-func (*ContainerMountSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ContainerMountSpec) GetKind() string {
-	if x != nil {
-		return x.Kind
-	}
-	return ""
-}
-
-func (x *ContainerMountSpec) GetVolumeId() string {
-	if x != nil {
-		return x.VolumeId
-	}
-	return ""
+// Deprecated: Use ContainerNetworkStatusSpec.ProtoReflect.Descriptor instead.
+func (*ContainerNetworkStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ContainerMountSpec) GetSource() string {
+func (x *ContainerNetworkStatusSpec) GetAddress() *common.NetIPPrefix {
 	if x != nil {
-		return x.Source
+		return x.Address
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerMountSpec) GetDestination() string {
+func (x *ContainerNetworkStatusSpec) GetLink() string {
 	if x != nil {
-		return x.Destination
+		return x.Link
 	}
 	return ""
 }
 
-func (x *ContainerMountSpec) GetSize() uint64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *ContainerMountSpec) GetOptions() []string {
+func (x *ContainerNetworkStatusSpec) GetLinkUp() bool {
 	if x != nil {
-		return x.Options
+		return x.LinkUp
 	}
-	return nil
+	return false
 }
 
-// ContainerNetworkSpec is the resolved network configuration.
-type ContainerNetworkSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// HostNetwork shares the host network namespace instead of creating an empty one.
-	HostNetwork   bool `protobuf:"varint,1,opt,name=host_network,json=hostNetwork,proto3" json:"host_network,omitempty"`
+// ContainerPortSpec is a container port published on the host addresses.
+type ContainerPortSpec struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Protocol      enums.NethelpersProtocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=talos.resource.definitions.enums.NethelpersProtocol" json:"protocol,omitempty"`
+	HostPort      uint32                   `protobuf:"varint,2,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
+	ContainerPort uint32                   `protobuf:"varint,3,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerNetworkSpec) Reset() {
-	*x = ContainerNetworkSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[5]
+func (x *ContainerPortSpec) Reset() {
+	*x = ContainerPortSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerNetworkSpec) String() string {
+func (x *ContainerPortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerNetworkSpec) ProtoMessage() {}
+func (*ContainerPortSpec) ProtoMessage() {}
 
-func (x *ContainerNetworkSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[5]
+func (x *ContainerPortSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,16 +1103,30 @@ func (x *ContainerNetworkSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerNetworkSpec.ProtoReflect.Descriptor instead.
-func (*ContainerNetworkSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ContainerPortSpec.ProtoReflect.Descriptor instead.
+func (*ContainerPortSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ContainerNetworkSpec) GetHostNetwork() bool {
+func (x *ContainerPortSpec) GetProtocol() enums.NethelpersProtocol {
 	if x != nil {
-		return x.HostNetwork
+		return x.Protocol
 	}
-	return false
+	return enums.NethelpersProtocol(0)
+}
+
+func (x *ContainerPortSpec) GetHostPort() uint32 {
+	if x != nil {
+		return x.HostPort
+	}
+	return 0
+}
+
+func (x *ContainerPortSpec) GetContainerPort() uint32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
 }
 
 // ContainerResourcesSpec is the resolved cgroup configuration, in bytes and millicores.
@@ -503,7 +1142,7 @@ type ContainerResourcesSpec struct {
 
 func (x *ContainerResourcesSpec) Reset() {
 	*x = ContainerResourcesSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[6]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +1154,7 @@ func (x *ContainerResourcesSpec) String() string {
 func (*ContainerResourcesSpec) ProtoMessage() {}
 
 func (x *ContainerResourcesSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[6]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +1167,7 @@ func (x *ContainerResourcesSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerResourcesSpec.ProtoReflect.Descriptor instead.
 func (*ContainerResourcesSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ContainerResourcesSpec) GetMemoryLimit() uint64 {
@@ -545,6 +1184,79 @@ func (x *ContainerResourcesSpec) GetCpuLimit() uint64 {
 	return 0
 }
 
+// ContainerRestartSpec is the resolved restart policy.
+type ContainerRestartSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mode is one of "always", "on-failure" or "never".
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// MaxRetries caps the number of restarts; zero means unlimited.
+	MaxRetries uint64 `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// InitialDelay is the delay before the first restart.
+	InitialDelay *durationpb.Duration `protobuf:"bytes,3,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	// MaxDelay caps the delay, which doubles with each restart up to it.
+	MaxDelay      *durationpb.Duration `protobuf:"bytes,4,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerRestartSpec) Reset() {
+	*x = ContainerRestartSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerRestartSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerRestartSpec) ProtoMessage() {}
+
+func (x *ContainerRestartSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerRestartSpec.ProtoReflect.Descriptor instead.
+func (*ContainerRestartSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ContainerRestartSpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ContainerRestartSpec) GetMaxRetries() uint64 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *ContainerRestartSpec) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *ContainerRestartSpec) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
 // ContainerRunAsSpec is the resolved uid/gid override.
 //
 // Nil means use the image's own USER for that half.
@@ -558,7 +1270,7 @@ type ContainerRunAsSpec struct {
 
 func (x *ContainerRunAsSpec) Reset() {
 	*x = ContainerRunAsSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[7]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -570,7 +1282,7 @@ func (x *ContainerRunAsSpec) String() string {
 func (*ContainerRunAsSpec) ProtoMessage() {}
 
 func (x *ContainerRunAsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[7]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +1295,7 @@ func (x *ContainerRunAsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerRunAsSpec.ProtoReflect.Descriptor instead.
 func (*ContainerRunAsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ContainerRunAsSpec) GetUid() int32 {
@@ -614,7 +1326,7 @@ type ContainerSecuritySpec struct {
 
 func (x *ContainerSecuritySpec) Reset() {
 	*x = ContainerSecuritySpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[8]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +1338,7 @@ func (x *ContainerSecuritySpec) String() string {
 func (*ContainerSecuritySpec) ProtoMessage() {}
 
 func (x *ContainerSecuritySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[8]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +1351,7 @@ func (x *ContainerSecuritySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerSecuritySpec.ProtoReflect.Descriptor instead.
 func (*ContainerSecuritySpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ContainerSecuritySpec) GetPrivileged() bool {
@@ -667,24 +1379,26 @@ func (x *ContainerSecuritySpec) GetCapabilitiesDrop() []string {
 type ContainerSpecSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Image is the OCI reference in canonical form, already normalized by ContainerConfigController.
-	Image         *ContainerImageSpec     `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Entrypoint    []string                `protobuf:"bytes,2,rep,name=entrypoint,proto3" json:"entrypoint,omitempty"`
-	Args          []string                `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	WorkingDir    string                  `protobuf:"bytes,4,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	RunAs         *ContainerRunAsSpec     `protobuf:"bytes,5,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
-	Environment   []string                `protobuf:"bytes,6,rep,name=environment,proto3" json:"environment,omitempty"`
-	Mounts        []*ContainerMountSpec   `protobuf:"bytes,7,rep,name=mounts,proto3" json:"mounts,omitempty"`
-	Security      *ContainerSecuritySpec  `protobuf:"bytes,8,opt,name=security,proto3" json:"security,omitempty"`
-	Network       *ContainerNetworkSpec   `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
-	Resources     *ContainerResourcesSpec `protobuf:"bytes,10,opt,name=resources,proto3" json:"resources,omitempty"`
-	DependsOn     *ContainerDependsOnSpec `protobuf:"bytes,11,opt,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	Image         *ContainerImageSpec       `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Entrypoint    []string                  `protobuf:"bytes,2,rep,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Args          []string                  `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	WorkingDir    string                    `protobuf:"bytes,4,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	RunAs         *ContainerRunAsSpec       `protobuf:"bytes,5,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
+	Environment   []string                  `protobuf:"bytes,6,rep,name=environment,proto3" json:"environment,omitempty"`
+	Mounts        []*ContainerMountSpec     `protobuf:"bytes,7,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	Security      *ContainerSecuritySpec    `protobuf:"bytes,8,opt,name=security,proto3" json:"security,omitempty"`
+	Network       *ContainerNetworkSpec     `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
+	Resources     *ContainerResourcesSpec   `protobuf:"bytes,10,opt,name=resources,proto3" json:"resources,omitempty"`
+	DependsOn     *ContainerDependsOnSpec   `protobuf:"bytes,11,opt,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	Restart       *ContainerRestartSpec     `protobuf:"bytes,12,opt,name=restart,proto3" json:"restart,omitempty"`
+	HealthCheck   *ContainerHealthCheckSpec `protobuf:"bytes,13,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ContainerSpecSpec) Reset() {
 	*x = ContainerSpecSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[9]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +1410,7 @@ func (x *ContainerSpecSpec) String() string {
 func (*ContainerSpecSpec) ProtoMessage() {}
 
 func (x *ContainerSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[9]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +1423,7 @@ func (x *ContainerSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerSpecSpec.ProtoReflect.Descriptor instead.
 func (*ContainerSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ContainerSpecSpec) GetImage() *ContainerImageSpec {
@@ -789,6 +1503,199 @@ func (x *ContainerSpecSpec) GetDependsOn() *ContainerDependsOnSpec {
 	return nil
 }
 
+func (x *ContainerSpecSpec) GetRestart() *ContainerRestartSpec {
+	if x != nil {
+		return x.Restart
+	}
+	return nil
+}
+
+func (x *ContainerSpecSpec) GetHealthCheck() *ContainerHealthCheckSpec {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+// ContainerStatusSpec is the spec for ContainerStatus.
+type ContainerStatusSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Image is the OCI reference in canonical form, as declared.
+	Image string                         `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Phase enums.ContainersContainerPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=talos.resource.definitions.enums.ContainersContainerPhase" json:"phase,omitempty"`
+	// Healthy is set while the container is running and passing its health check, if it declares
+	// one. It is what dependsOn.containers waits for.
+	Healthy bool `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// HealthMessage is the last health check message.
+	HealthMessage string `protobuf:"bytes,4,opt,name=health_message,json=healthMessage,proto3" json:"health_message,omitempty"`
+	// Generation is the sequence number of the current instance.
+	Generation uint64 `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
+	Pid        uint32 `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	ExitCode   uint32 `protobuf:"varint,7,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Restarts   uint64 `protobuf:"varint,8,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// WaitingFor lists the unmet dependencies while the container is waiting to start.
+	WaitingFor []string `protobuf:"bytes,9,rep,name=waiting_for,json=waitingFor,proto3" json:"waiting_for,omitempty"`
+	// LastError is the last failure to pull the image, or to create, start or track the task.
+	LastError     string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerStatusSpec) Reset() {
+	*x = ContainerStatusSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStatusSpec) ProtoMessage() {}
+
+func (x *ContainerStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStatusSpec.ProtoReflect.Descriptor instead.
+func (*ContainerStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ContainerStatusSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ContainerStatusSpec) GetPhase() enums.ContainersContainerPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return enums.ContainersContainerPhase(0)
+}
+
+func (x *ContainerStatusSpec) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ContainerStatusSpec) GetHealthMessage() string {
+	if x != nil {
+		return x.HealthMessage
+	}
+	return ""
+}
+
+func (x *ContainerStatusSpec) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ContainerStatusSpec) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ContainerStatusSpec) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ContainerStatusSpec) GetRestarts() uint64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ContainerStatusSpec) GetWaitingFor() []string {
+	if x != nil {
+		return x.WaitingFor
+	}
+	return nil
+}
+
+func (x *ContainerStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// ContainerVolumeMountSpec is a user volume mounted on the host.
+type ContainerVolumeMountSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VolumeID is the block volume ID, e.g. "u-web-content".
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Target is the host path the volume is mounted at.
+	Target        string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerVolumeMountSpec) Reset() {
+	*x = ContainerVolumeMountSpec{}
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerVolumeMountSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerVolumeMountSpec) ProtoMessage() {}
+
+func (x *ContainerVolumeMountSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerVolumeMountSpec.ProtoReflect.Descriptor instead.
+func (*ContainerVolumeMountSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ContainerVolumeMountSpec) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *ContainerVolumeMountSpec) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
 // ResolvedMountSpec is a mount with its host-side source resolved.
 type ResolvedMountSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -804,7 +1711,7 @@ type ResolvedMountSpec struct {
 
 func (x *ResolvedMountSpec) Reset() {
 	*x = ResolvedMountSpec{}
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[10]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +1723,7 @@ func (x *ResolvedMountSpec) String() string {
 func (*ResolvedMountSpec) ProtoMessage() {}
 
 func (x *ResolvedMountSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_containers_containers_proto_msgTypes[10]
+	mi := &file_resource_definitions_containers_containers_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1736,7 @@ func (x *ResolvedMountSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvedMountSpec.ProtoReflect.Descriptor instead.
 func (*ResolvedMountSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_containers_containers_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ResolvedMountSpec) GetKind() string {
@@ -871,21 +1778,45 @@ var File_resource_definitions_containers_containers_proto protoreflect.FileDescr
 
 const file_resource_definitions_containers_containers_proto_rawDesc = "" +
 	"\n" +
-	"0resource/definitions/containers/containers.proto\x12%talos.resource.definitions.containers\x1a&resource/definitions/enums/enums.proto\"~\n" +
+	"0resource/definitions/containers/containers.proto\x12%talos.resource.definitions.containers\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"~\n" +
 	"\x16ContainerDependsOnSpec\x12\x14\n" +
 	"\x05paths\x18\x01 \x03(\tR\x05paths\x12\x1a\n" +
 	"\bnetworks\x18\x02 \x03(\tR\bnetworks\x12\x12\n" +
 	"\x04time\x18\x03 \x01(\bR\x04time\x12\x1e\n" +
 	"\n" +
 	"containers\x18\x04 \x03(\tR\n" +
-	"containers\"&\n" +
+	"containers\"\x98\x02\n" +
+	"\x18ContainerHealthCheckSpec\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\acommand\x18\x02 \x03(\tR\acommand\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\rR\x04port\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12>\n" +
+	"\rinitial_delay\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\finitialDelay\x121\n" +
+	"\x06period\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06period\x123\n" +
+	"\atimeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xa9\x01\n" +
+	"\x13ContainerHealthSpec\x12\x18\n" +
+	"\aunknown\x18\x01 \x01(\bR\aunknown\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12!\n" +
+	"\flast_message\x18\x03 \x01(\tR\vlastMessage\x12;\n" +
+	"\vlast_change\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastChange\"&\n" +
 	"\x12ContainerImageSpec\x12\x10\n" +
-	"\x03ref\x18\x01 \x01(\tR\x03ref\"\xb5\x01\n" +
+	"\x03ref\x18\x01 \x01(\tR\x03ref\"\xa0\x02\n" +
 	"\x18ContainerImageStatusSpec\x12U\n" +
 	"\x05phase\x18\x01 \x01(\x0e2?.talos.resource.definitions.enums.ContainersContainerImagePhaseR\x05phase\x12\x14\n" +
 	"\x05image\x18\x02 \x01(\tR\x05image\x12\x16\n" +
 	"\x06digest\x18\x03 \x01(\tR\x06digest\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"\x9d\x05\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12i\n" +
+	"\fverification\x18\x05 \x01(\v2E.talos.resource.definitions.containers.ContainerImageVerificationSpecR\fverification\"\xe4\x01\n" +
+	"\x1eContainerImageVerificationSpec\x12\x1a\n" +
+	"\bverified\x18\x01 \x01(\bR\bverified\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06signer\x18\x04 \x01(\tR\x06signer\x12\x16\n" +
+	"\x06issuer\x18\x05 \x01(\tR\x06issuer\x12 \n" +
+	"\frekor_log_id\x18\x06 \x01(\tR\n" +
+	"rekorLogId\x12&\n" +
+	"\x0frekor_log_index\x18\a \x01(\x03R\rrekorLogIndex\"\xb0\x06\n" +
 	"\x19ContainerInstanceSpecSpec\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x1e\n" +
 	"\n" +
@@ -904,19 +1835,59 @@ const file_resource_definitions_containers_containers_proto_rawDesc = "" +
 	"\bsecurity\x18\n" +
 	" \x01(\v2<.talos.resource.definitions.containers.ContainerSecuritySpecR\bsecurity\x12U\n" +
 	"\anetwork\x18\v \x01(\v2;.talos.resource.definitions.containers.ContainerNetworkSpecR\anetwork\x12[\n" +
-	"\tresources\x18\f \x01(\v2=.talos.resource.definitions.containers.ContainerResourcesSpecR\tresources\"\xad\x01\n" +
+	"\tresources\x18\f \x01(\v2=.talos.resource.definitions.containers.ContainerResourcesSpecR\tresources\x12b\n" +
+	"\fhealth_check\x18\r \x01(\v2?.talos.resource.definitions.containers.ContainerHealthCheckSpecR\vhealthCheck\x12-\n" +
+	"\aaddress\x18\x0e \x01(\v2\x13.common.NetIPPrefixR\aaddress\"\xf0\x03\n" +
+	"\x1bContainerInstanceStatusSpec\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x02 \x01(\x04R\n" +
+	"generation\x12X\n" +
+	"\x05phase\x18\x03 \x01(\x0e2B.talos.resource.definitions.enums.ContainersContainerInstancePhaseR\x05phase\x12\x10\n" +
+	"\x03pid\x18\x04 \x01(\rR\x03pid\x12\x1b\n" +
+	"\texit_code\x18\x05 \x01(\rR\bexitCode\x12\x1a\n" +
+	"\brestarts\x18\x06 \x01(\x04R\brestarts\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12R\n" +
+	"\x06health\x18\n" +
+	" \x01(\v2:.talos.resource.definitions.containers.ContainerHealthSpecR\x06health\"\xad\x01\n" +
 	"\x12ContainerMountSpec\x12\x12\n" +
 	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
 	"\tvolume_id\x18\x02 \x01(\tR\bvolumeId\x12\x16\n" +
 	"\x06source\x18\x03 \x01(\tR\x06source\x12 \n" +
 	"\vdestination\x18\x04 \x01(\tR\vdestination\x12\x12\n" +
 	"\x04size\x18\x05 \x01(\x04R\x04size\x12\x18\n" +
-	"\aoptions\x18\x06 \x03(\tR\aoptions\"9\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\"u\n" +
+	"\x18ContainerMountStatusSpec\x12Y\n" +
+	"\avolumes\x18\x01 \x03(\v2?.talos.resource.definitions.containers.ContainerVolumeMountSpecR\avolumes\"\x87\x02\n" +
 	"\x14ContainerNetworkSpec\x12!\n" +
-	"\fhost_network\x18\x01 \x01(\bR\vhostNetwork\"X\n" +
+	"\fhost_network\x18\x01 \x01(\bR\vhostNetwork\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12+\n" +
+	"\x06subnet\x18\x04 \x01(\v2\x13.common.NetIPPrefixR\x06subnet\x12'\n" +
+	"\agateway\x18\x05 \x01(\v2\r.common.NetIPR\agateway\x12N\n" +
+	"\x05ports\x18\x06 \x03(\v28.talos.resource.definitions.containers.ContainerPortSpecR\x05ports\"x\n" +
+	"\x1aContainerNetworkStatusSpec\x12-\n" +
+	"\aaddress\x18\x01 \x01(\v2\x13.common.NetIPPrefixR\aaddress\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x17\n" +
+	"\alink_up\x18\x03 \x01(\bR\x06linkUp\"\xa9\x01\n" +
+	"\x11ContainerPortSpec\x12P\n" +
+	"\bprotocol\x18\x01 \x01(\x0e24.talos.resource.definitions.enums.NethelpersProtocolR\bprotocol\x12\x1b\n" +
+	"\thost_port\x18\x02 \x01(\rR\bhostPort\x12%\n" +
+	"\x0econtainer_port\x18\x03 \x01(\rR\rcontainerPort\"X\n" +
 	"\x16ContainerResourcesSpec\x12!\n" +
 	"\fmemory_limit\x18\x01 \x01(\x04R\vmemoryLimit\x12\x1b\n" +
-	"\tcpu_limit\x18\x02 \x01(\x04R\bcpuLimit\"8\n" +
+	"\tcpu_limit\x18\x02 \x01(\x04R\bcpuLimit\"\xc3\x01\n" +
+	"\x14ContainerRestartSpec\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vmax_retries\x18\x02 \x01(\x04R\n" +
+	"maxRetries\x12>\n" +
+	"\rinitial_delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\finitialDelay\x126\n" +
+	"\tmax_delay\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bmaxDelay\"8\n" +
 	"\x12ContainerRunAsSpec\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\x05R\x03uid\x12\x10\n" +
 	"\x03gid\x18\x02 \x01(\x05R\x03gid\"\x8f\x01\n" +
@@ -925,7 +1896,7 @@ const file_resource_definitions_containers_containers_proto_rawDesc = "" +
 	"privileged\x18\x01 \x01(\bR\n" +
 	"privileged\x12)\n" +
 	"\x10capabilities_add\x18\x02 \x03(\tR\x0fcapabilitiesAdd\x12+\n" +
-	"\x11capabilities_drop\x18\x03 \x03(\tR\x10capabilitiesDrop\"\xec\x05\n" +
+	"\x11capabilities_drop\x18\x03 \x03(\tR\x10capabilitiesDrop\"\xa7\a\n" +
 	"\x11ContainerSpecSpec\x12O\n" +
 	"\x05image\x18\x01 \x01(\v29.talos.resource.definitions.containers.ContainerImageSpecR\x05image\x12\x1e\n" +
 	"\n" +
@@ -942,7 +1913,28 @@ const file_resource_definitions_containers_containers_proto_rawDesc = "" +
 	"\tresources\x18\n" +
 	" \x01(\v2=.talos.resource.definitions.containers.ContainerResourcesSpecR\tresources\x12\\\n" +
 	"\n" +
-	"depends_on\x18\v \x01(\v2=.talos.resource.definitions.containers.ContainerDependsOnSpecR\tdependsOn\"\x8f\x01\n" +
+	"depends_on\x18\v \x01(\v2=.talos.resource.definitions.containers.ContainerDependsOnSpecR\tdependsOn\x12U\n" +
+	"\arestart\x18\f \x01(\v2;.talos.resource.definitions.containers.ContainerRestartSpecR\arestart\x12b\n" +
+	"\fhealth_check\x18\r \x01(\v2?.talos.resource.definitions.containers.ContainerHealthCheckSpecR\vhealthCheck\"\xe9\x02\n" +
+	"\x13ContainerStatusSpec\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12P\n" +
+	"\x05phase\x18\x02 \x01(\x0e2:.talos.resource.definitions.enums.ContainersContainerPhaseR\x05phase\x12\x18\n" +
+	"\ahealthy\x18\x03 \x01(\bR\ahealthy\x12%\n" +
+	"\x0ehealth_message\x18\x04 \x01(\tR\rhealthMessage\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x05 \x01(\x04R\n" +
+	"generation\x12\x10\n" +
+	"\x03pid\x18\x06 \x01(\rR\x03pid\x12\x1b\n" +
+	"\texit_code\x18\a \x01(\rR\bexitCode\x12\x1a\n" +
+	"\brestarts\x18\b \x01(\x04R\brestarts\x12\x1f\n" +
+	"\vwaiting_for\x18\t \x03(\tR\n" +
+	"waitingFor\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\"O\n" +
+	"\x18ContainerVolumeMountSpec\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\x8f\x01\n" +
 	"\x11ResolvedMountSpec\x12\x12\n" +
 	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12 \n" +
@@ -963,40 +1955,79 @@ func file_resource_definitions_containers_containers_proto_rawDescGZIP() []byte
 	return file_resource_definitions_containers_containers_proto_rawDescData
 }
 
-var file_resource_definitions_containers_containers_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_resource_definitions_containers_containers_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_resource_definitions_containers_containers_proto_goTypes = []any{
-	(*ContainerDependsOnSpec)(nil),           // 0: talos.resource.definitions.containers.ContainerDependsOnSpec
-	(*ContainerImageSpec)(nil),               // 1: talos.resource.definitions.containers.ContainerImageSpec
-	(*ContainerImageStatusSpec)(nil),         // 2: talos.resource.definitions.containers.ContainerImageStatusSpec
-	(*ContainerInstanceSpecSpec)(nil),        // 3: talos.resource.definitions.containers.ContainerInstanceSpecSpec
-	(*ContainerMountSpec)(nil),               // 4: talos.resource.definitions.containers.ContainerMountSpec
-	(*ContainerNetworkSpec)(nil),             // 5: talos.resource.definitions.containers.ContainerNetworkSpec
-	(*ContainerResourcesSpec)(nil),           // 6: talos.resource.definitions.containers.ContainerResourcesSpec
-	(*ContainerRunAsSpec)(nil),               // 7: talos.resource.definitions.containers.ContainerRunAsSpec
-	(*ContainerSecuritySpec)(nil),            // 8: talos.resource.definitions.containers.ContainerSecuritySpec
-	(*ContainerSpecSpec)(nil),                // 9: talos.resource.definitions.containers.ContainerSpecSpec
-	(*ResolvedMountSpec)(nil),                // 10: talos.resource.definitions.containers.ResolvedMountSpec
-	(enums.ContainersContainerImagePhase)(0), // 11: talos.resource.definitions.enums.ContainersContainerImagePhase
+	(*ContainerDependsOnSpec)(nil),              // 0: talos.resource.definitions.containers.ContainerDependsOnSpec
+	(*ContainerHealthCheckSpec)(nil),            // 1: talos.resource.definitions.containers.ContainerHealthCheckSpec
+	(*ContainerHealthSpec)(nil),                 // 2: talos.resource.definitions.containers.ContainerHealthSpec
+	(*ContainerImageSpec)(nil),                  // 3: talos.resource.definitions.containers.ContainerImageSpec
+	(*ContainerImageStatusSpec)(nil),            // 4: talos.resource.definitions.containers.ContainerImageStatusSpec
+	(*ContainerImageVerificationSpec)(nil),      // 5: talos.resource.definitions.containers.ContainerImageVerificationSpec
+	(*ContainerInstanceSpecSpec)(nil),           // 6: talos.resource.definitions.containers.ContainerInstanceSpecSpec
+	(*ContainerInstanceStatusSpec)(nil),         // 7: talos.resource.definitions.containers.ContainerInstanceStatusSpec
+	(*ContainerMountSpec)(nil),                  // 8: talos.resource.definitions.containers.ContainerMountSpec
+	(*ContainerMountStatusSpec)(nil),            // 9: talos.resource.definitions.containers.ContainerMountStatusSpec
+	(*ContainerNetworkSpec)(nil),                // 10: talos.resource.definitions.containers.ContainerNetworkSpec
+	(*ContainerNetworkStatusSpec)(nil),          // 11: talos.resource.definitions.containers.ContainerNetworkStatusSpec
+	(*ContainerPortSpec)(nil),                   // 12: talos.resource.definitions.containers.ContainerPortSpec
+	(*ContainerResourcesSpec)(nil),              // 13: talos.resource.definitions.containers.ContainerResourcesSpec
+	(*ContainerRestartSpec)(nil),                // 14: talos.resource.definitions.containers.ContainerRestartSpec
+	(*ContainerRunAsSpec)(nil),                  // 15: talos.resource.definitions.containers.ContainerRunAsSpec
+	(*ContainerSecuritySpec)(nil),               // 16: talos.resource.definitions.containers.ContainerSecuritySpec
+	(*ContainerSpecSpec)(nil),                   // 17: talos.resource.definitions.containers.ContainerSpecSpec
+	(*ContainerStatusSpec)(nil),                 // 18: talos.resource.definitions.containers.ContainerStatusSpec
+	(*ContainerVolumeMountSpec)(nil),            // 19: talos.resource.definitions.containers.ContainerVolumeMountSpec
+	(*ResolvedMountSpec)(nil),                   // 20: talos.resource.definitions.containers.ResolvedMountSpec
+	(*durationpb.Duration)(nil),                 // 21: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),               // 22: google.protobuf.Timestamp
+	(enums.ContainersContainerImagePhase)(0),    // 23: talos.resource.definitions.enums.ContainersContainerImagePhase
+	(*common.NetIPPrefix)(nil),                  // 24: common.NetIPPrefix
+	(enums.ContainersContainerInstancePhase)(0), // 25: talos.resource.definitions.enums.ContainersContainerInstancePhase
+	(*common.NetIP)(nil),                        // 26: common.NetIP
+	(enums.NethelpersProtocol)(0),               // 27: talos.resource.definitions.enums.NethelpersProtocol
+	(enums.ContainersContainerPhase)(0),         // 28: talos.resource.definitions.enums.ContainersContainerPhase
 }
 var file_resource_definitions_containers_containers_proto_depIdxs = []int32{
-	11, // 0: talos.resource.definitions.containers.ContainerImageStatusSpec.phase:type_name -> talos.resource.definitions.enums.ContainersContainerImagePhase
-	7,  // 1: talos.resource.definitions.containers.ContainerInstanceSpecSpec.run_as:type_name -> talos.resource.definitions.containers.ContainerRunAsSpec
-	10, // 2: talos.resource.definitions.containers.ContainerInstanceSpecSpec.mounts:type_name -> talos.resource.definitions.containers.ResolvedMountSpec
-	8,  // 3: talos.resource.definitions.containers.ContainerInstanceSpecSpec.security:type_name -> talos.resource.definitions.containers.ContainerSecuritySpec
-	5,  // 4: talos.resource.definitions.containers.ContainerInstanceSpecSpec.network:type_name -> talos.resource.definitions.containers.ContainerNetworkSpec
-	6,  // 5: talos.resource.definitions.containers.ContainerInstanceSpecSpec.resources:type_name -> talos.resource.definitions.containers.ContainerResourcesSpec
-	1,  // 6: talos.resource.definitions.containers.ContainerSpecSpec.image:type_name -> talos.resource.definitions.containers.ContainerImageSpec
-	7,  // 7: talos.resource.definitions.containers.ContainerSpecSpec.run_as:type_name -> talos.resource.definitions.containers.ContainerRunAsSpec
-	4,  // 8: talos.resource.definitions.containers.ContainerSpecSpec.mounts:type_name -> talos.resource.definitions.containers.ContainerMountSpec
-	8,  // 9: talos.resource.definitions.containers.ContainerSpecSpec.security:type_name -> talos.resource.definitions.containers.ContainerSecuritySpec
-	5,  // 10: talos.resource.definitions.containers.ContainerSpecSpec.network:type_name -> talos.resource.definitions.containers.ContainerNetworkSpec
-	6,  // 11: talos.resource.definitions.containers.ContainerSpecSpec.resources:type_name -> talos.resource.definitions.containers.ContainerResourcesSpec
-	0,  // 12: talos.resource.definitions.containers.ContainerSpecSpec.depends_on:type_name -> talos.resource.definitions.containers.ContainerDependsOnSpec
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	21, // 0: talos.resource.definitions.containers.ContainerHealthCheckSpec.initial_delay:type_name -> google.protobuf.Duration
+	21, // 1: talos.resource.definitions.containers.ContainerHealthCheckSpec.period:type_name -> google.protobuf.Duration
+	21, // 2: talos.resource.definitions.containers.ContainerHealthCheckSpec.timeout:type_name -> google.protobuf.Duration
+	22, // 3: talos.resource.definitions.containers.ContainerHealthSpec.last_change:type_name -> google.protobuf.Timestamp
+	23, // 4: talos.resource.definitions.containers.ContainerImageStatusSpec.phase:type_name -> talos.resource.definitions.enums.ContainersContainerImagePhase
+	5,  // 5: talos.resource.definitions.containers.ContainerImageStatusSpec.verification:type_name -> talos.resource.definitions.containers.ContainerImageVerificationSpec
+	15, // 6: talos.resource.definitions.containers.ContainerInstanceSpecSpec.run_as:type_name -> talos.resource.definitions.containers.ContainerRunAsSpec
+	20, // 7: talos.resource.definitions.containers.ContainerInstanceSpecSpec.mounts:type_name -> talos.resource.definitions.containers.ResolvedMountSpec
+	16, // 8: talos.resource.definitions.containers.ContainerInstanceSpecSpec.security:type_name -> talos.resource.definitions.containers.ContainerSecuritySpec
+	10, // 9: talos.resource.definitions.containers.ContainerInstanceSpecSpec.network:type_name -> talos.resource.definitions.containers.ContainerNetworkSpec
+	13, // 10: talos.resource.definitions.containers.ContainerInstanceSpecSpec.resources:type_name -> talos.resource.definitions.containers.ContainerResourcesSpec
+	1,  // 11: talos.resource.definitions.containers.ContainerInstanceSpecSpec.health_check:type_name -> talos.resource.definitions.containers.ContainerHealthCheckSpec
+	24, // 12: talos.resource.definitions.containers.ContainerInstanceSpecSpec.address:type_name -> common.NetIPPrefix
+	25, // 13: talos.resource.definitions.containers.ContainerInstanceStatusSpec.phase:type_name -> talos.resource.definitions.enums.ContainersContainerInstancePhase
+	22, // 14: talos.resource.definitions.containers.ContainerInstanceStatusSpec.started_at:type_name -> google.protobuf.Timestamp
+	22, // 15: talos.resource.definitions.containers.ContainerInstanceStatusSpec.finished_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: talos.resource.definitions.containers.ContainerInstanceStatusSpec.health:type_name -> talos.resource.definitions.containers.ContainerHealthSpec
+	19, // 17: talos.resource.definitions.containers.ContainerMountStatusSpec.volumes:type_name -> talos.resource.definitions.containers.ContainerVolumeMountSpec
+	24, // 18: talos.resource.definitions.containers.ContainerNetworkSpec.subnet:type_name -> common.NetIPPrefix
+	26, // 19: talos.resource.definitions.containers.ContainerNetworkSpec.gateway:type_name -> common.NetIP
+	12, // 20: talos.resource.definitions.containers.ContainerNetworkSpec.ports:type_name -> talos.resource.definitions.containers.ContainerPortSpec
+	24, // 21: talos.resource.definitions.containers.ContainerNetworkStatusSpec.address:type_name -> common.NetIPPrefix
+	27, // 22: talos.resource.definitions.containers.ContainerPortSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersProtocol
+	21, // 23: talos.resource.definitions.containers.ContainerRestartSpec.initial_delay:type_name -> google.protobuf.Duration
+	21, // 24: talos.resource.definitions.containers.ContainerRestartSpec.max_delay:type_name -> google.protobuf.Duration
+	3,  // 25: talos.resource.definitions.containers.ContainerSpecSpec.image:type_name -> talos.resource.definitions.containers.ContainerImageSpec
+	15, // 26: talos.resource.definitions.containers.ContainerSpecSpec.run_as:type_name -> talos.resource.definitions.containers.ContainerRunAsSpec
+	8,  // 27: talos.resource.definitions.containers.ContainerSpecSpec.mounts:type_name -> talos.resource.definitions.containers.ContainerMountSpec
+	16, // 28: talos.resource.definitions.containers.ContainerSpecSpec.security:type_name -> talos.resource.definitions.containers.ContainerSecuritySpec
+	10, // 29: talos.resource.definitions.containers.ContainerSpecSpec.network:type_name -> talos.resource.definitions.containers.ContainerNetworkSpec
+	13, // 30: talos.resource.definitions.containers.ContainerSpecSpec.resources:type_name -> talos.resource.definitions.containers.ContainerResourcesSpec
+	0,  // 31: talos.resource.definitions.containers.ContainerSpecSpec.depends_on:type_name -> talos.resource.definitions.containers.ContainerDependsOnSpec
+	14, // 32: talos.resource.definitions.containers.ContainerSpecSpec.restart:type_name -> talos.resource.definitions.containers.ContainerRestartSpec
+	1,  // 33: talos.resource.definitions.containers.ContainerSpecSpec.health_check:type_name -> talos.resource.definitions.containers.ContainerHealthCheckSpec
+	28, // 34: talos.resource.definitions.containers.ContainerStatusSpec.phase:type_name -> talos.resource.definitions.enums.ContainersContainerPhase
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_containers_containers_proto_init() }
@@ -1010,7 +2041,7 @@ func file_resource_definitions_containers_containers_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_containers_containers_proto_rawDesc), len(file_resource_definitions_containers_containers_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1334,6 +1334,56 @@ func (NethelpersICMPType) EnumDescriptor() ([]byte, []int) {
 	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{23}
 }
 
+// NethelpersIPVLANMode is an IPVLAN link mode.
+type NethelpersIPVLANMode int32
+
+const (
+	NethelpersIPVLANMode_IPVLAN_MODE_L2   NethelpersIPVLANMode = 0
+	NethelpersIPVLANMode_IPVLAN_MODE_L3   NethelpersIPVLANMode = 1
+	NethelpersIPVLANMode_IPVLAN_MODE_L3_S NethelpersIPVLANMode = 2
+)
+
+// Enum value maps for NethelpersIPVLANMode.
+var (
+	NethelpersIPVLANMode_name = map[int32]string{
+		0: "IPVLAN_MODE_L2",
+		1: "IPVLAN_MODE_L3",
+		2: "IPVLAN_MODE_L3_S",
+	}
+	NethelpersIPVLANMode_value = map[string]int32{
+		"IPVLAN_MODE_L2":   0,
+		"IPVLAN_MODE_L3":   1,
+		"IPVLAN_MODE_L3_S": 2,
+	}
+)
+
+func (x NethelpersIPVLANMode) Enum() *NethelpersIPVLANMode {
+	p := new(NethelpersIPVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersIPVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersIPVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
+}
+
+func (NethelpersIPVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
+}
+
+func (x NethelpersIPVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersIPVLANMode.Descriptor instead.
+func (NethelpersIPVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
+}
+
 // NethelpersLACPRate is a LACP rate.
 type NethelpersLACPRate int32
 
@@ -1365,11 +1415,11 @@ func (x NethelpersLACPRate) String() string {
 }
 
 func (NethelpersLACPRate) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[24].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[25].Descriptor()
 }
 
 func (NethelpersLACPRate) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[24]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[25]
 }
 
 func (x NethelpersLACPRate) Number() protoreflect.EnumNumber {
@@ -1378,7 +1428,7 @@ func (x NethelpersLACPRate) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersLACPRate.Descriptor instead.
 func (NethelpersLACPRate) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{25}
 }
 
 // NethelpersLinkType is a link type.
@@ -1637,11 +1687,11 @@ func (x NethelpersLinkType) String() string {
 }
 
 func (NethelpersLinkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[25].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[26].Descriptor()
 }
 
 func (NethelpersLinkType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[25]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[26]
 }
 
 func (x NethelpersLinkType) Number() protoreflect.EnumNumber {
@@ -1650,7 +1700,63 @@ func (x NethelpersLinkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersLinkType.Descriptor instead.
 func (NethelpersLinkType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{25}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{26}
+}
+
+// NethelpersMACVLANMode is a MACVLAN link mode.
+type NethelpersMACVLANMode int32
+
+const (
+	NethelpersMACVLANMode_NETHELPERS_MACVLANMODE_UNSPECIFIED NethelpersMACVLANMode = 0
+	NethelpersMACVLANMode_MACVLAN_MODE_PRIVATE               NethelpersMACVLANMode = 1
+	NethelpersMACVLANMode_MACVLAN_MODE_VEPA                  NethelpersMACVLANMode = 2
+	NethelpersMACVLANMode_MACVLAN_MODE_BRIDGE                NethelpersMACVLANMode = 4
+	NethelpersMACVLANMode_MACVLAN_MODE_PASSTHRU              NethelpersMACVLANMode = 8
+)
+
+// Enum value maps for NethelpersMACVLANMode.
+var (
+	NethelpersMACVLANMode_name = map[int32]string{
+		0: "NETHELPERS_MACVLANMODE_UNSPECIFIED",
+		1: "MACVLAN_MODE_PRIVATE",
+		2: "MACVLAN_MODE_VEPA",
+		4: "MACVLAN_MODE_BRIDGE",
+		8: "MACVLAN_MODE_PASSTHRU",
+	}
+	NethelpersMACVLANMode_value = map[string]int32{
+		"NETHELPERS_MACVLANMODE_UNSPECIFIED": 0,
+		"MACVLAN_MODE_PRIVATE":               1,
+		"MACVLAN_MODE_VEPA":                  2,
+		"MACVLAN_MODE_BRIDGE":                4,
+		"MACVLAN_MODE_PASSTHRU":              8,
+	}
+)
+
+func (x NethelpersMACVLANMode) Enum() *NethelpersMACVLANMode {
+	p := new(NethelpersMACVLANMode)
+	*p = x
+	return p
+}
+
+func (x NethelpersMACVLANMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NethelpersMACVLANMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[27].Descriptor()
+}
+
+func (NethelpersMACVLANMode) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[27]
+}
+
+func (x NethelpersMACVLANMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NethelpersMACVLANMode.Descriptor instead.
+func (NethelpersMACVLANMode) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{27}
 }
 
 // NethelpersMatchOperator is a netfilter match operator.
@@ -1684,11 +1790,11 @@ func (x NethelpersMatchOperator) String() string {
 }
 
 func (NethelpersMatchOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[26].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[28].Descriptor()
 }
 
 func (NethelpersMatchOperator) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[26]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[28]
 }
 
 func (x NethelpersMatchOperator) Number() protoreflect.EnumNumber {
@@ -1697,7 +1803,7 @@ func (x NethelpersMatchOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersMatchOperator.Descriptor instead.
 func (NethelpersMatchOperator) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{26}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{28}
 }
 
 // NethelpersNfTablesChainHook wraps nftables.ChainHook for YAML marshaling.
@@ -1740,11 +1846,11 @@ func (x NethelpersNfTablesChainHook) String() string {
 }
 
 func (NethelpersNfTablesChainHook) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[27].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[29].Descriptor()
 }
 
 func (NethelpersNfTablesChainHook) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[27]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[29]
 }
 
 func (x NethelpersNfTablesChainHook) Number() protoreflect.EnumNumber {
@@ -1753,7 +1859,7 @@ func (x NethelpersNfTablesChainHook) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersNfTablesChainHook.Descriptor instead.
 func (NethelpersNfTablesChainHook) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{27}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{29}
 }
 
 // NethelpersNfTablesChainPriority wraps nftables.ChainPriority for YAML marshaling.
@@ -1823,11 +1929,11 @@ func (x NethelpersNfTablesChainPriority) String() string {
 }
 
 func (NethelpersNfTablesChainPriority) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[28].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[30].Descriptor()
 }
 
 func (NethelpersNfTablesChainPriority) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[28]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[30]
 }
 
 func (x NethelpersNfTablesChainPriority) Number() protoreflect.EnumNumber {
@@ -1836,7 +1942,7 @@ func (x NethelpersNfTablesChainPriority) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersNfTablesChainPriority.Descriptor instead.
 func (NethelpersNfTablesChainPriority) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{28}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{30}
 }
 
 // NethelpersNfTablesVerdict wraps nftables.Verdict for YAML marshaling.
@@ -1870,11 +1976,11 @@ func (x NethelpersNfTablesVerdict) String() string {
 }
 
 func (NethelpersNfTablesVerdict) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[29].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[31].Descriptor()
 }
 
 func (NethelpersNfTablesVerdict) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[29]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[31]
 }
 
 func (x NethelpersNfTablesVerdict) Number() protoreflect.EnumNumber {
@@ -1883,7 +1989,7 @@ func (x NethelpersNfTablesVerdict) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersNfTablesVerdict.Descriptor instead.
 func (NethelpersNfTablesVerdict) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{29}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{31}
 }
 
 // NethelpersOperationalState wraps rtnetlink.OperationalState for YAML marshaling.
@@ -1932,11 +2038,11 @@ func (x NethelpersOperationalState) String() string {
 }
 
 func (NethelpersOperationalState) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[30].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[32].Descriptor()
 }
 
 func (NethelpersOperationalState) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[30]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[32]
 }
 
 func (x NethelpersOperationalState) Number() protoreflect.EnumNumber {
@@ -1945,7 +2051,7 @@ func (x NethelpersOperationalState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersOperationalState.Descriptor instead.
 func (NethelpersOperationalState) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{30}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{32}
 }
 
 // NethelpersPort wraps ethtool.Port for YAML marshaling.
@@ -1997,11 +2103,11 @@ func (x NethelpersPort) String() string {
 }
 
 func (NethelpersPort) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[31].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[33].Descriptor()
 }
 
 func (NethelpersPort) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[31]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[33]
 }
 
 func (x NethelpersPort) Number() protoreflect.EnumNumber {
@@ -2010,7 +2116,7 @@ func (x NethelpersPort) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersPort.Descriptor instead.
 func (NethelpersPort) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{31}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{33}
 }
 
 // NethelpersPrimaryReselect is an ARP targets mode.
@@ -2047,11 +2153,11 @@ func (x NethelpersPrimaryReselect) String() string {
 }
 
 func (NethelpersPrimaryReselect) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[32].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[34].Descriptor()
 }
 
 func (NethelpersPrimaryReselect) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[32]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[34]
 }
 
 func (x NethelpersPrimaryReselect) Number() protoreflect.EnumNumber {
@@ -2060,7 +2166,7 @@ func (x NethelpersPrimaryReselect) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersPrimaryReselect.Descriptor instead.
 func (NethelpersPrimaryReselect) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{32}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{34}
 }
 
 // NethelpersProtocol is a inet protocol.
@@ -2103,11 +2209,11 @@ func (x NethelpersProtocol) String() string {
 }
 
 func (NethelpersProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[33].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[35].Descriptor()
 }
 
 func (NethelpersProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[33]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[35]
 }
 
 func (x NethelpersProtocol) Number() protoreflect.EnumNumber {
@@ -2116,7 +2222,7 @@ func (x NethelpersProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersProtocol.Descriptor instead.
 func (NethelpersProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{33}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{35}
 }
 
 // NethelpersRouteFlag wraps RTM_F_* constants.
@@ -2171,11 +2277,11 @@ func (x NethelpersRouteFlag) String() string {
 }
 
 func (NethelpersRouteFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[34].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[36].Descriptor()
 }
 
 func (NethelpersRouteFlag) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[34]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[36]
 }
 
 func (x NethelpersRouteFlag) Number() protoreflect.EnumNumber {
@@ -2184,7 +2290,7 @@ func (x NethelpersRouteFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteFlag.Descriptor instead.
 func (NethelpersRouteFlag) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{34}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{36}
 }
 
 // NethelpersRouteProtocol is a routing protocol.
@@ -2278,11 +2384,11 @@ func (x NethelpersRouteProtocol) String() string {
 }
 
 func (NethelpersRouteProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[35].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[37].Descriptor()
 }
 
 func (NethelpersRouteProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[35]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[37]
 }
 
 func (x NethelpersRouteProtocol) Number() protoreflect.EnumNumber {
@@ -2291,7 +2397,7 @@ func (x NethelpersRouteProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteProtocol.Descriptor instead.
 func (NethelpersRouteProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{35}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{37}
 }
 
 // NethelpersRouteType is a route type.
@@ -2355,11 +2461,11 @@ func (x NethelpersRouteType) String() string {
 }
 
 func (NethelpersRouteType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[36].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[38].Descriptor()
 }
 
 func (NethelpersRouteType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[36]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[38]
 }
 
 func (x NethelpersRouteType) Number() protoreflect.EnumNumber {
@@ -2368,7 +2474,7 @@ func (x NethelpersRouteType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRouteType.Descriptor instead.
 func (NethelpersRouteType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{36}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{38}
 }
 
 // NethelpersRoutingRuleAction is a routing rule action.
@@ -2411,11 +2517,11 @@ func (x NethelpersRoutingRuleAction) String() string {
 }
 
 func (NethelpersRoutingRuleAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[37].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[39].Descriptor()
 }
 
 func (NethelpersRoutingRuleAction) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[37]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[39]
 }
 
 func (x NethelpersRoutingRuleAction) Number() protoreflect.EnumNumber {
@@ -2424,7 +2530,7 @@ func (x NethelpersRoutingRuleAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRoutingRuleAction.Descriptor instead.
 func (NethelpersRoutingRuleAction) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{37}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{39}
 }
 
 // NethelpersRoutingTable is a routing table ID.
@@ -3220,11 +3326,11 @@ func (x NethelpersRoutingTable) String() string {
 }
 
 func (NethelpersRoutingTable) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[38].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[40].Descriptor()
 }
 
 func (NethelpersRoutingTable) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[38]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[40]
 }
 
 func (x NethelpersRoutingTable) Number() protoreflect.EnumNumber {
@@ -3233,7 +3339,7 @@ func (x NethelpersRoutingTable) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersRoutingTable.Descriptor instead.
 func (NethelpersRoutingTable) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{38}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{40}
 }
 
 // NethelpersScope is an address scope.
@@ -3276,11 +3382,11 @@ func (x NethelpersScope) String() string {
 }
 
 func (NethelpersScope) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[39].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[41].Descriptor()
 }
 
 func (NethelpersScope) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[39]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[41]
 }
 
 func (x NethelpersScope) Number() protoreflect.EnumNumber {
@@ -3289,7 +3395,7 @@ func (x NethelpersScope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersScope.Descriptor instead.
 func (NethelpersScope) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{39}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{41}
 }
 
 // NethelpersVLANProtocol is a VLAN protocol.
@@ -3326,11 +3432,11 @@ func (x NethelpersVLANProtocol) String() string {
 }
 
 func (NethelpersVLANProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[40].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[42].Descriptor()
 }
 
 func (NethelpersVLANProtocol) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[40]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[42]
 }
 
 func (x NethelpersVLANProtocol) Number() protoreflect.EnumNumber {
@@ -3339,7 +3445,7 @@ func (x NethelpersVLANProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersVLANProtocol.Descriptor instead.
 func (NethelpersVLANProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{40}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{42}
 }
 
 // NethelpersWOLMode wraps ethtool.WOLMode for YAML marshaling.
@@ -3391,11 +3497,11 @@ func (x NethelpersWOLMode) String() string {
 }
 
 func (NethelpersWOLMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[41].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[43].Descriptor()
 }
 
 func (NethelpersWOLMode) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[41]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[43]
 }
 
 func (x NethelpersWOLMode) Number() protoreflect.EnumNumber {
@@ -3404,7 +3510,7 @@ func (x NethelpersWOLMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NethelpersWOLMode.Descriptor instead.
 func (NethelpersWOLMode) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{41}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{43}
 }
 
 // BlockEncryptionKeyType describes encryption key type.
@@ -3444,11 +3550,11 @@ func (x BlockEncryptionKeyType) String() string {
 }
 
 func (BlockEncryptionKeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[42].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[44].Descriptor()
 }
 
 func (BlockEncryptionKeyType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[42]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[44]
 }
 
 func (x BlockEncryptionKeyType) Number() protoreflect.EnumNumber {
@@ -3457,7 +3563,7 @@ func (x BlockEncryptionKeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockEncryptionKeyType.Descriptor instead.
 func (BlockEncryptionKeyType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{42}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{44}
 }
 
 // BlockEncryptionProviderType describes encryption provider type.
@@ -3491,11 +3597,11 @@ func (x BlockEncryptionProviderType) String() string {
 }
 
 func (BlockEncryptionProviderType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[43].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[45].Descriptor()
 }
 
 func (BlockEncryptionProviderType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[43]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[45]
 }
 
 func (x BlockEncryptionProviderType) Number() protoreflect.EnumNumber {
@@ -3504,7 +3610,7 @@ func (x BlockEncryptionProviderType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockEncryptionProviderType.Descriptor instead.
 func (BlockEncryptionProviderType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{43}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{45}
 }
 
 // BlockFilesystemType describes filesystem type.
@@ -3519,6 +3625,7 @@ const (
 	BlockFilesystemType_FILESYSTEM_TYPE_SWAP     BlockFilesystemType = 5
 	BlockFilesystemType_FILESYSTEM_TYPE_VIRTIOFS BlockFilesystemType = 6
 	BlockFilesystemType_FILESYSTEM_TYPE_BTRFS    BlockFilesystemType = 7
+	BlockFilesystemType_FILESYSTEM_TYPE_NFS      BlockFilesystemType = 8
 )
 
 // Enum value maps for BlockFilesystemType.
@@ -3532,6 +3639,7 @@ var (
 		5: "FILESYSTEM_TYPE_SWAP",
 		6: "FILESYSTEM_TYPE_VIRTIOFS",
 		7: "FILESYSTEM_TYPE_BTRFS",
+		8: "FILESYSTEM_TYPE_NFS",
 	}
 	BlockFilesystemType_value = map[string]int32{
 		"FILESYSTEM_TYPE_NONE":     0,
@@ -3542,6 +3650,7 @@ var (
 		"FILESYSTEM_TYPE_SWAP":     5,
 		"FILESYSTEM_TYPE_VIRTIOFS": 6,
 		"FILESYSTEM_TYPE_BTRFS":    7,
+		"FILESYSTEM_TYPE_NFS":      8,
 	}
 )
 
@@ -3556,11 +3665,11 @@ func (x BlockFilesystemType) String() string {
 }
 
 func (BlockFilesystemType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[44].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[46].Descriptor()
 }
 
 func (BlockFilesystemType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[44]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[46]
 }
 
 func (x BlockFilesystemType) Number() protoreflect.EnumNumber {
@@ -3569,7 +3678,7 @@ func (x BlockFilesystemType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockFilesystemType.Descriptor instead.
 func (BlockFilesystemType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{44}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{46}
 }
 
 // BlockFSParameterType describes Filesystem Parameter type.
@@ -3606,11 +3715,11 @@ func (x BlockFSParameterType) String() string {
 }
 
 func (BlockFSParameterType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[45].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[47].Descriptor()
 }
 
 func (BlockFSParameterType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[45]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[47]
 }
 
 func (x BlockFSParameterType) Number() protoreflect.EnumNumber {
@@ -3619,7 +3728,60 @@ func (x BlockFSParameterType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockFSParameterType.Descriptor instead.
 func (BlockFSParameterType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{45}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{47}
+}
+
+// BlockNetworkTargetType describes the transport used to attach an external volume.
+type BlockNetworkTargetType int32
+
+const (
+	BlockNetworkTargetType_NETWORK_TARGET_TYPE_NONE    BlockNetworkTargetType = 0
+	BlockNetworkTargetType_NETWORK_TARGET_TYPE_NFS     BlockNetworkTargetType = 1
+	BlockNetworkTargetType_NETWORK_TARGET_TYPE_ISCSI   BlockNetworkTargetType = 2
+	BlockNetworkTargetType_NETWORK_TARGET_TYPE_NVMETCP BlockNetworkTargetType = 3
+)
+
+// Enum value maps for BlockNetworkTargetType.
+var (
+	BlockNetworkTargetType_name = map[int32]string{
+		0: "NETWORK_TARGET_TYPE_NONE",
+		1: "NETWORK_TARGET_TYPE_NFS",
+		2: "NETWORK_TARGET_TYPE_ISCSI",
+		3: "NETWORK_TARGET_TYPE_NVMETCP",
+	}
+	BlockNetworkTargetType_value = map[string]int32{
+		"NETWORK_TARGET_TYPE_NONE":    0,
+		"NETWORK_TARGET_TYPE_NFS":     1,
+		"NETWORK_TARGET_TYPE_ISCSI":   2,
+		"NETWORK_TARGET_TYPE_NVMETCP": 3,
+	}
+)
+
+func (x BlockNetworkTargetType) Enum() *BlockNetworkTargetType {
+	p := new(BlockNetworkTargetType)
+	*p = x
+	return p
+}
+
+func (x BlockNetworkTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockNetworkTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[48].Descriptor()
+}
+
+func (BlockNetworkTargetType) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[48]
+}
+
+func (x BlockNetworkTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockNetworkTargetType.Descriptor instead.
+func (BlockNetworkTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{48}
 }
 
 // BlockVolumePhase describes volume phase.
@@ -3671,11 +3833,11 @@ func (x BlockVolumePhase) String() string {
 }
 
 func (BlockVolumePhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[46].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[49].Descriptor()
 }
 
 func (BlockVolumePhase) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[46]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[49]
 }
 
 func (x BlockVolumePhase) Number() protoreflect.EnumNumber {
@@ -3684,7 +3846,7 @@ func (x BlockVolumePhase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockVolumePhase.Descriptor instead.
 func (BlockVolumePhase) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{46}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{49}
 }
 
 // BlockVolumeType describes volume type.
@@ -3733,11 +3895,11 @@ func (x BlockVolumeType) String() string {
 }
 
 func (BlockVolumeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[47].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[50].Descriptor()
 }
 
 func (BlockVolumeType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[47]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[50]
 }
 
 func (x BlockVolumeType) Number() protoreflect.EnumNumber {
@@ -3746,17 +3908,19 @@ func (x BlockVolumeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BlockVolumeType.Descriptor instead.
 func (BlockVolumeType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{47}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{50}
 }
 
 // StorageLVMLogicalVolumeType describes the layout of an LVM logical volume.
 type StorageLVMLogicalVolumeType int32
 
 const (
-	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_LINEAR StorageLVMLogicalVolumeType = 0
-	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_RAID1  StorageLVMLogicalVolumeType = 1
-	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_RAID0  StorageLVMLogicalVolumeType = 2
-	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_RAID10 StorageLVMLogicalVolumeType = 3
+	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_LINEAR    StorageLVMLogicalVolumeType = 0
+	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_RAID1     StorageLVMLogicalVolumeType = 1
+	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_RAID0     StorageLVMLogicalVolumeType = 2
+	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_RAID10    StorageLVMLogicalVolumeType = 3
+	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_THIN_POOL StorageLVMLogicalVolumeType = 4
+	StorageLVMLogicalVolumeType_LVM_LOGICAL_VOLUME_TYPE_THIN      StorageLVMLogicalVolumeType = 5
 )
 
 // Enum value maps for StorageLVMLogicalVolumeType.
@@ -3766,12 +3930,16 @@ var (
 		1: "LVM_LOGICAL_VOLUME_TYPE_RAID1",
 		2: "LVM_LOGICAL_VOLUME_TYPE_RAID0",
 		3: "LVM_LOGICAL_VOLUME_TYPE_RAID10",
+		4: "LVM_LOGICAL_VOLUME_TYPE_THIN_POOL",
+		5: "LVM_LOGICAL_VOLUME_TYPE_THIN",
 	}
 	StorageLVMLogicalVolumeType_value = map[string]int32{
-		"LVM_LOGICAL_VOLUME_TYPE_LINEAR": 0,
-		"LVM_LOGICAL_VOLUME_TYPE_RAID1":  1,
-		"LVM_LOGICAL_VOLUME_TYPE_RAID0":  2,
-		"LVM_LOGICAL_VOLUME_TYPE_RAID10": 3,
+		"LVM_LOGICAL_VOLUME_TYPE_LINEAR":    0,
+		"LVM_LOGICAL_VOLUME_TYPE_RAID1":     1,
+		"LVM_LOGICAL_VOLUME_TYPE_RAID0":     2,
+		"LVM_LOGICAL_VOLUME_TYPE_RAID10":    3,
+		"LVM_LOGICAL_VOLUME_TYPE_THIN_POOL": 4,
+		"LVM_LOGICAL_VOLUME_TYPE_THIN":      5,
 	}
 )
 
@@ -3786,11 +3954,11 @@ func (x StorageLVMLogicalVolumeType) String() string {
 }
 
 func (StorageLVMLogicalVolumeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[48].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[51].Descriptor()
 }
 
 func (StorageLVMLogicalVolumeType) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[48]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[51]
 }
 
 func (x StorageLVMLogicalVolumeType) Number() protoreflect.EnumNumber {
@@ -3799,7 +3967,7 @@ func (x StorageLVMLogicalVolumeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StorageLVMLogicalVolumeType.Descriptor instead.
 func (StorageLVMLogicalVolumeType) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{48}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{51}
 }
 
 // StorageMDArrayPhase describes the provisioning/sync state of an MD array.
@@ -3842,11 +4010,11 @@ func (x StorageMDArrayPhase) String() string {
 }
 
 func (StorageMDArrayPhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[49].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[52].Descriptor()
 }
 
 func (StorageMDArrayPhase) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[49]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[52]
 }
 
 func (x StorageMDArrayPhase) Number() protoreflect.EnumNumber {
@@ -3855,7 +4023,7 @@ func (x StorageMDArrayPhase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StorageMDArrayPhase.Descriptor instead.
 func (StorageMDArrayPhase) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{49}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{52}
 }
 
 // StorageMDLevel describes the RAID level of an MD (software RAID) array.
@@ -3886,11 +4054,11 @@ func (x StorageMDLevel) String() string {
 }
 
 func (StorageMDLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[50].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[53].Descriptor()
 }
 
 func (StorageMDLevel) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[50]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[53]
 }
 
 func (x StorageMDLevel) Number() protoreflect.EnumNumber {
@@ -3899,7 +4067,7 @@ func (x StorageMDLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StorageMDLevel.Descriptor instead.
 func (StorageMDLevel) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{50}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{53}
 }
 
 // StorageMDMetadata describes the on-disk metadata format of an MD (software RAID) array.
@@ -3933,11 +4101,11 @@ func (x StorageMDMetadata) String() string {
 }
 
 func (StorageMDMetadata) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[51].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[54].Descriptor()
 }
 
 func (StorageMDMetadata) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[51]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[54]
 }
 
 func (x StorageMDMetadata) Number() protoreflect.EnumNumber {
@@ -3946,7 +4114,7 @@ func (x StorageMDMetadata) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StorageMDMetadata.Descriptor instead.
 func (StorageMDMetadata) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{51}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{54}
 }
 
 // NetworkConfigLayer describes network configuration layers, with lowest priority first.
@@ -3989,11 +4157,11 @@ func (x NetworkConfigLayer) String() string {
 }
 
 func (NetworkConfigLayer) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[52].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[55].Descriptor()
 }
 
 func (NetworkConfigLayer) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[52]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[55]
 }
 
 func (x NetworkConfigLayer) Number() protoreflect.EnumNumber {
@@ -4002,7 +4170,7 @@ func (x NetworkConfigLayer) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkConfigLayer.Descriptor instead.
 func (NetworkConfigLayer) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{52}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{55}
 }
 
 // NetworkOperator enumerates Talos network operators.
@@ -4039,11 +4207,11 @@ func (x NetworkOperator) String() string {
 }
 
 func (NetworkOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[53].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[56].Descriptor()
 }
 
 func (NetworkOperator) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[53]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[56]
 }
 
 func (x NetworkOperator) Number() protoreflect.EnumNumber {
@@ -4052,7 +4220,7 @@ func (x NetworkOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkOperator.Descriptor instead.
 func (NetworkOperator) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{53}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{56}
 }
 
 // ContainersContainerImagePhase describes the state of a container's image pull.
@@ -4092,11 +4260,11 @@ func (x ContainersContainerImagePhase) String() string {
 }
 
 func (ContainersContainerImagePhase) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[54].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[57].Descriptor()
 }
 
 func (ContainersContainerImagePhase) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[54]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[57]
 }
 
 func (x ContainersContainerImagePhase) Number() protoreflect.EnumNumber {
@@ -4105,7 +4273,128 @@ func (x ContainersContainerImagePhase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContainersContainerImagePhase.Descriptor instead.
 func (ContainersContainerImagePhase) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{54}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{57}
+}
+
+// ContainersContainerInstancePhase describes the state of a container instance's task.
+type ContainersContainerInstancePhase int32
+
+const (
+	ContainersContainerInstancePhase_CONTAINER_INSTANCE_PHASE_PENDING  ContainersContainerInstancePhase = 0
+	ContainersContainerInstancePhase_CONTAINER_INSTANCE_PHASE_STARTING ContainersContainerInstancePhase = 1
+	ContainersContainerInstancePhase_CONTAINER_INSTANCE_PHASE_RUNNING  ContainersContainerInstancePhase = 2
+	ContainersContainerInstancePhase_CONTAINER_INSTANCE_PHASE_STOPPING ContainersContainerInstancePhase = 3
+	ContainersContainerInstancePhase_CONTAINER_INSTANCE_PHASE_EXITED   ContainersContainerInstancePhase = 4
+	ContainersContainerInstancePhase_CONTAINER_INSTANCE_PHASE_FAILED   ContainersContainerInstancePhase = 5
+)
+
+// Enum value maps for ContainersContainerInstancePhase.
+var (
+	ContainersContainerInstancePhase_name = map[int32]string{
+		0: "CONTAINER_INSTANCE_PHASE_PENDING",
+		1: "CONTAINER_INSTANCE_PHASE_STARTING",
+		2: "CONTAINER_INSTANCE_PHASE_RUNNING",
+		3: "CONTAINER_INSTANCE_PHASE_STOPPING",
+		4: "CONTAINER_INSTANCE_PHASE_EXITED",
+		5: "CONTAINER_INSTANCE_PHASE_FAILED",
+	}
+	ContainersContainerInstancePhase_value = map[string]int32{
+		"CONTAINER_INSTANCE_PHASE_PENDING":  0,
+		"CONTAINER_INSTANCE_PHASE_STARTING": 1,
+		"CONTAINER_INSTANCE_PHASE_RUNNING":  2,
+		"CONTAINER_INSTANCE_PHASE_STOPPING": 3,
+		"CONTAINER_INSTANCE_PHASE_EXITED":   4,
+		"CONTAINER_INSTANCE_PHASE_FAILED":   5,
+	}
+)
+
+func (x ContainersContainerInstancePhase) Enum() *ContainersContainerInstancePhase {
+	p := new(ContainersContainerInstancePhase)
+	*p = x
+	return p
+}
+
+func (x ContainersContainerInstancePhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainersContainerInstancePhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[58].Descriptor()
+}
+
+func (ContainersContainerInstancePhase) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[58]
+}
+
+func (x ContainersContainerInstancePhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainersContainerInstancePhase.Descriptor instead.
+func (ContainersContainerInstancePhase) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{58}
+}
+
+// ContainersContainerPhase describes the aggregated state of a container.
+type ContainersContainerPhase int32
+
+const (
+	ContainersContainerPhase_CONTAINER_PHASE_WAITING    ContainersContainerPhase = 0
+	ContainersContainerPhase_CONTAINER_PHASE_STARTING   ContainersContainerPhase = 1
+	ContainersContainerPhase_CONTAINER_PHASE_RUNNING    ContainersContainerPhase = 2
+	ContainersContainerPhase_CONTAINER_PHASE_STOPPING   ContainersContainerPhase = 3
+	ContainersContainerPhase_CONTAINER_PHASE_RESTARTING ContainersContainerPhase = 4
+	ContainersContainerPhase_CONTAINER_PHASE_EXITED     ContainersContainerPhase = 5
+	ContainersContainerPhase_CONTAINER_PHASE_FAILED     ContainersContainerPhase = 6
+)
+
+// Enum value maps for ContainersContainerPhase.
+var (
+	ContainersContainerPhase_name = map[int32]string{
+		0: "CONTAINER_PHASE_WAITING",
+		1: "CONTAINER_PHASE_STARTING",
+		2: "CONTAINER_PHASE_RUNNING",
+		3: "CONTAINER_PHASE_STOPPING",
+		4: "CONTAINER_PHASE_RESTARTING",
+		5: "CONTAINER_PHASE_EXITED",
+		6: "CONTAINER_PHASE_FAILED",
+	}
+	ContainersContainerPhase_value = map[string]int32{
+		"CONTAINER_PHASE_WAITING":    0,
+		"CONTAINER_PHASE_STARTING":   1,
+		"CONTAINER_PHASE_RUNNING":    2,
+		"CONTAINER_PHASE_STOPPING":   3,
+		"CONTAINER_PHASE_RESTARTING": 4,
+		"CONTAINER_PHASE_EXITED":     5,
+		"CONTAINER_PHASE_FAILED":     6,
+	}
+)
+
+func (x ContainersContainerPhase) Enum() *ContainersContainerPhase {
+	p := new(ContainersContainerPhase)
+	*p = x
+	return p
+}
+
+func (x ContainersContainerPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainersContainerPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_resource_definitions_enums_enums_proto_enumTypes[59].Descriptor()
+}
+
+func (ContainersContainerPhase) Type() protoreflect.EnumType {
+	return &file_resource_definitions_enums_enums_proto_enumTypes[59]
+}
+
+func (x ContainersContainerPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainersContainerPhase.Descriptor instead.
+func (ContainersContainerPhase) EnumDescriptor() ([]byte, []int) {
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{59}
 }
 
 // CriImageCacheStatus describes image cache status type.
@@ -4145,11 +4434,11 @@ func (x CriImageCacheStatus) String() string {
 }
 
 func (CriImageCacheStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[55].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[60].Descriptor()
 }
 
 func (CriImageCacheStatus) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[55]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[60]
 }
 
 func (x CriImageCacheStatus) Number() protoreflect.EnumNumber {
@@ -4158,7 +4447,7 @@ func (x CriImageCacheStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CriImageCacheStatus.Descriptor instead.
 func (CriImageCacheStatus) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{55}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{60}
 }
 
 // CriImageCacheCopyStatus describes image cache copy status type.
@@ -4198,11 +4487,11 @@ func (x CriImageCacheCopyStatus) String() string {
 }
 
 func (CriImageCacheCopyStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[56].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[61].Descriptor()
 }
 
 func (CriImageCacheCopyStatus) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[56]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[61]
 }
 
 func (x CriImageCacheCopyStatus) Number() protoreflect.EnumNumber {
@@ -4211,7 +4500,7 @@ func (x CriImageCacheCopyStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CriImageCacheCopyStatus.Descriptor instead.
 func (CriImageCacheCopyStatus) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{56}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{61}
 }
 
 // KubespanPeerState is KubeSpan peer current state.
@@ -4248,11 +4537,11 @@ func (x KubespanPeerState) String() string {
 }
 
 func (KubespanPeerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_resource_definitions_enums_enums_proto_enumTypes[57].Descriptor()
+	return file_resource_definitions_enums_enums_proto_enumTypes[62].Descriptor()
 }
 
 func (KubespanPeerState) Type() protoreflect.EnumType {
-	return &file_resource_definitions_enums_enums_proto_enumTypes[57]
+	return &file_resource_definitions_enums_enums_proto_enumTypes[62]
 }
 
 func (x KubespanPeerState) Number() protoreflect.EnumNumber {
@@ -4261,7 +4550,7 @@ func (x KubespanPeerState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubespanPeerState.Descriptor instead.
 func (KubespanPeerState) EnumDescriptor() ([]byte, []int) {
-	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{57}
+	return file_resource_definitions_enums_enums_proto_rawDescGZIP(), []int{62}
 }
 
 var File_resource_definitions_enums_enums_proto protoreflect.FileDescriptor
@@ -4400,7 +4689,11 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x1bICMP_TYPE_TIMESTAMP_REQUEST\x10\r\x12\x1d\n" +
 	"\x19ICMP_TYPE_TIMESTAMP_REPLY\x10\x0e\x12\"\n" +
 	"\x1eICMP_TYPE_ADDRESS_MASK_REQUEST\x10\x11\x12 \n" +
-	"\x1cICMP_TYPE_ADDRESS_MASK_REPLY\x10\x12*<\n" +
+	"\x1cICMP_TYPE_ADDRESS_MASK_REPLY\x10\x12*T\n" +
+	"\x14NethelpersIPVLANMode\x12\x12\n" +
+	"\x0eIPVLAN_MODE_L2\x10\x00\x12\x12\n" +
+	"\x0eIPVLAN_MODE_L3\x10\x01\x12\x14\n" +
+	"\x10IPVLAN_MODE_L3_S\x10\x02*<\n" +
 	"\x12NethelpersLACPRate\x12\x12\n" +
 	"\x0eLACP_RATE_SLOW\x10\x00\x12\x12\n" +
 	"\x0eLACP_RATE_FAST\x10\x01*\x93\v\n" +
@@ -4495,7 +4788,13 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\fLINK_NETLINK\x10\xb8\x06\x12\x11\n" +
 	"\fLINK6_LOWPAN\x10\xb9\x06\x12\x0f\n" +
 	"\tLINK_VOID\x10\xff\xff\x03\x12\x0f\n" +
-	"\tLINK_NONE\x10\xfe\xff\x03\x1a\x02\x10\x01*E\n" +
+	"\tLINK_NONE\x10\xfe\xff\x03\x1a\x02\x10\x01*\xa4\x01\n" +
+	"\x15NethelpersMACVLANMode\x12&\n" +
+	"\"NETHELPERS_MACVLANMODE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14MACVLAN_MODE_PRIVATE\x10\x01\x12\x15\n" +
+	"\x11MACVLAN_MODE_VEPA\x10\x02\x12\x17\n" +
+	"\x13MACVLAN_MODE_BRIDGE\x10\x04\x12\x19\n" +
+	"\x15MACVLAN_MODE_PASSTHRU\x10\b*E\n" +
 	"\x17NethelpersMatchOperator\x12\x12\n" +
 	"\x0eOPERATOR_EQUAL\x10\x00\x12\x16\n" +
 	"\x12OPERATOR_NOT_EQUAL\x10\x01*\x99\x01\n" +
@@ -4905,7 +5204,7 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x12ENCRYPTION_KEY_TPM\x10\x03*Z\n" +
 	"\x1bBlockEncryptionProviderType\x12\x1c\n" +
 	"\x18ENCRYPTION_PROVIDER_NONE\x10\x00\x12\x1d\n" +
-	"\x19ENCRYPTION_PROVIDER_LUKS2\x10\x01*\xec\x01\n" +
+	"\x19ENCRYPTION_PROVIDER_LUKS2\x10\x01*\x85\x02\n" +
 	"\x13BlockFilesystemType\x12\x18\n" +
 	"\x14FILESYSTEM_TYPE_NONE\x10\x00\x12\x17\n" +
 	"\x13FILESYSTEM_TYPE_XFS\x10\x01\x12\x18\n" +
@@ -4914,11 +5213,17 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x17FILESYSTEM_TYPE_ISO9660\x10\x04\x12\x18\n" +
 	"\x14FILESYSTEM_TYPE_SWAP\x10\x05\x12\x1c\n" +
 	"\x18FILESYSTEM_TYPE_VIRTIOFS\x10\x06\x12\x19\n" +
-	"\x15FILESYSTEM_TYPE_BTRFS\x10\a*\x83\x01\n" +
+	"\x15FILESYSTEM_TYPE_BTRFS\x10\a\x12\x17\n" +
+	"\x13FILESYSTEM_TYPE_NFS\x10\b*\x83\x01\n" +
 	"\x14BlockFSParameterType\x12\"\n" +
 	"\x1eFS_PARAMETER_TYPE_STRING_VALUE\x10\x00\x12#\n" +
 	"\x1fFS_PARAMETER_TYPE_BOOLEAN_VALUE\x10\x01\x12\"\n" +
-	"\x1eFS_PARAMETER_TYPE_BINARY_VALUE\x10\x02*\xe3\x01\n" +
+	"\x1eFS_PARAMETER_TYPE_BINARY_VALUE\x10\x02*\x93\x01\n" +
+	"\x16BlockNetworkTargetType\x12\x1c\n" +
+	"\x18NETWORK_TARGET_TYPE_NONE\x10\x00\x12\x1b\n" +
+	"\x17NETWORK_TARGET_TYPE_NFS\x10\x01\x12\x1d\n" +
+	"\x19NETWORK_TARGET_TYPE_ISCSI\x10\x02\x12\x1f\n" +
+	"\x1bNETWORK_TARGET_TYPE_NVMETCP\x10\x03*\xe3\x01\n" +
 	"\x10BlockVolumePhase\x12\x18\n" +
 	"\x14VOLUME_PHASE_WAITING\x10\x00\x12\x17\n" +
 	"\x13VOLUME_PHASE_FAILED\x10\x01\x12\x18\n" +
@@ -4935,12 +5240,14 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x15VOLUME_TYPE_DIRECTORY\x10\x03\x12\x17\n" +
 	"\x13VOLUME_TYPE_SYMLINK\x10\x04\x12\x17\n" +
 	"\x13VOLUME_TYPE_OVERLAY\x10\x05\x12\x18\n" +
-	"\x14VOLUME_TYPE_EXTERNAL\x10\x06*\xab\x01\n" +
+	"\x14VOLUME_TYPE_EXTERNAL\x10\x06*\xf4\x01\n" +
 	"\x1bStorageLVMLogicalVolumeType\x12\"\n" +
 	"\x1eLVM_LOGICAL_VOLUME_TYPE_LINEAR\x10\x00\x12!\n" +
 	"\x1dLVM_LOGICAL_VOLUME_TYPE_RAID1\x10\x01\x12!\n" +
 	"\x1dLVM_LOGICAL_VOLUME_TYPE_RAID0\x10\x02\x12\"\n" +
-	"\x1eLVM_LOGICAL_VOLUME_TYPE_RAID10\x10\x03*\xa0\x01\n" +
+	"\x1eLVM_LOGICAL_VOLUME_TYPE_RAID10\x10\x03\x12%\n" +
+	"!LVM_LOGICAL_VOLUME_TYPE_THIN_POOL\x10\x04\x12 \n" +
+	"\x1cLVM_LOGICAL_VOLUME_TYPE_THIN\x10\x05*\xa0\x01\n" +
 	"\x13StorageMDArrayPhase\x12\x1a\n" +
 	"\x16MD_ARRAY_PHASE_UNKNOWN\x10\x00\x12\x1a\n" +
 	"\x16MD_ARRAY_PHASE_WAITING\x10\x01\x12\x1d\n" +
@@ -4966,7 +5273,22 @@ const file_resource_definitions_enums_enums_proto_rawDesc = "" +
 	"\x1dCONTAINER_IMAGE_PHASE_PENDING\x10\x00\x12!\n" +
 	"\x1dCONTAINER_IMAGE_PHASE_PULLING\x10\x01\x12\x1f\n" +
 	"\x1bCONTAINER_IMAGE_PHASE_READY\x10\x02\x12 \n" +
-	"\x1cCONTAINER_IMAGE_PHASE_FAILED\x10\x03*\x96\x01\n" +
+	"\x1cCONTAINER_IMAGE_PHASE_FAILED\x10\x03*\x86\x02\n" +
+	" ContainersContainerInstancePhase\x12$\n" +
+	" CONTAINER_INSTANCE_PHASE_PENDING\x10\x00\x12%\n" +
+	"!CONTAINER_INSTANCE_PHASE_STARTING\x10\x01\x12$\n" +
+	" CONTAINER_INSTANCE_PHASE_RUNNING\x10\x02\x12%\n" +
+	"!CONTAINER_INSTANCE_PHASE_STOPPING\x10\x03\x12#\n" +
+	"\x1fCONTAINER_INSTANCE_PHASE_EXITED\x10\x04\x12#\n" +
+	"\x1fCONTAINER_INSTANCE_PHASE_FAILED\x10\x05*\xe8\x01\n" +
+	"\x18ContainersContainerPhase\x12\x1b\n" +
+	"\x17CONTAINER_PHASE_WAITING\x10\x00\x12\x1c\n" +
+	"\x18CONTAINER_PHASE_STARTING\x10\x01\x12\x1b\n" +
+	"\x17CONTAINER_PHASE_RUNNING\x10\x02\x12\x1c\n" +
+	"\x18CONTAINER_PHASE_STOPPING\x10\x03\x12\x1e\n" +
+	"\x1aCONTAINER_PHASE_RESTARTING\x10\x04\x12\x1a\n" +
+	"\x16CONTAINER_PHASE_EXITED\x10\x05\x12\x1a\n" +
+	"\x16CONTAINER_PHASE_FAILED\x10\x06*\x96\x01\n" +
 	"\x13CriImageCacheStatus\x12\x1e\n" +
 	"\x1aIMAGE_CACHE_STATUS_UNKNOWN\x10\x00\x12\x1f\n" +
 	"\x1bIMAGE_CACHE_STATUS_DISABLED\x10\x01\x12 \n" +
@@ -4995,66 +5317,71 @@ func file_resource_definitions_enums_enums_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_enums_enums_proto_rawDescData
 }
 
-var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 58)
+var file_resource_definitions_enums_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 63)
 var file_resource_definitions_enums_enums_proto_goTypes = []any{
-	(RuntimeKernelModuleState)(0),        // 0: talos.resource.definitions.enums.RuntimeKernelModuleState
-	(RuntimeKernelModuleType)(0),         // 1: talos.resource.definitions.enums.RuntimeKernelModuleType
-	(RuntimeMachineStage)(0),             // 2: talos.resource.definitions.enums.RuntimeMachineStage
-	(RuntimeSELinuxState)(0),             // 3: talos.resource.definitions.enums.RuntimeSELinuxState
-	(RuntimeFIPSState)(0),                // 4: talos.resource.definitions.enums.RuntimeFIPSState
-	(RuntimeUnattendedInstallPhase)(0),   // 5: talos.resource.definitions.enums.RuntimeUnattendedInstallPhase
-	(MachineType)(0),                     // 6: talos.resource.definitions.enums.MachineType
-	(NethelpersAddressFlag)(0),           // 7: talos.resource.definitions.enums.NethelpersAddressFlag
-	(NethelpersAddressSortAlgorithm)(0),  // 8: talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	(NethelpersADLACPActive)(0),          // 9: talos.resource.definitions.enums.NethelpersADLACPActive
-	(NethelpersADSelect)(0),              // 10: talos.resource.definitions.enums.NethelpersADSelect
-	(NethelpersARPAllTargets)(0),         // 11: talos.resource.definitions.enums.NethelpersARPAllTargets
-	(NethelpersARPValidate)(0),           // 12: talos.resource.definitions.enums.NethelpersARPValidate
-	(NethelpersAutoHostnameKind)(0),      // 13: talos.resource.definitions.enums.NethelpersAutoHostnameKind
-	(NethelpersBGPSessionState)(0),       // 14: talos.resource.definitions.enums.NethelpersBGPSessionState
-	(NethelpersBondMode)(0),              // 15: talos.resource.definitions.enums.NethelpersBondMode
-	(NethelpersBondXmitHashPolicy)(0),    // 16: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
-	(NethelpersClientIdentifier)(0),      // 17: talos.resource.definitions.enums.NethelpersClientIdentifier
-	(NethelpersConntrackState)(0),        // 18: talos.resource.definitions.enums.NethelpersConntrackState
-	(NethelpersDNSProtocol)(0),           // 19: talos.resource.definitions.enums.NethelpersDNSProtocol
-	(NethelpersDuplex)(0),                // 20: talos.resource.definitions.enums.NethelpersDuplex
-	(NethelpersFailOverMAC)(0),           // 21: talos.resource.definitions.enums.NethelpersFailOverMAC
-	(NethelpersFamily)(0),                // 22: talos.resource.definitions.enums.NethelpersFamily
-	(NethelpersICMPType)(0),              // 23: talos.resource.definitions.enums.NethelpersICMPType
-	(NethelpersLACPRate)(0),              // 24: talos.resource.definitions.enums.NethelpersLACPRate
-	(NethelpersLinkType)(0),              // 25: talos.resource.definitions.enums.NethelpersLinkType
-	(NethelpersMatchOperator)(0),         // 26: talos.resource.definitions.enums.NethelpersMatchOperator
-	(NethelpersNfTablesChainHook)(0),     // 27: talos.resource.definitions.enums.NethelpersNfTablesChainHook
-	(NethelpersNfTablesChainPriority)(0), // 28: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
-	(NethelpersNfTablesVerdict)(0),       // 29: talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	(NethelpersOperationalState)(0),      // 30: talos.resource.definitions.enums.NethelpersOperationalState
-	(NethelpersPort)(0),                  // 31: talos.resource.definitions.enums.NethelpersPort
-	(NethelpersPrimaryReselect)(0),       // 32: talos.resource.definitions.enums.NethelpersPrimaryReselect
-	(NethelpersProtocol)(0),              // 33: talos.resource.definitions.enums.NethelpersProtocol
-	(NethelpersRouteFlag)(0),             // 34: talos.resource.definitions.enums.NethelpersRouteFlag
-	(NethelpersRouteProtocol)(0),         // 35: talos.resource.definitions.enums.NethelpersRouteProtocol
-	(NethelpersRouteType)(0),             // 36: talos.resource.definitions.enums.NethelpersRouteType
-	(NethelpersRoutingRuleAction)(0),     // 37: talos.resource.definitions.enums.NethelpersRoutingRuleAction
-	(NethelpersRoutingTable)(0),          // 38: talos.resource.definitions.enums.NethelpersRoutingTable
-	(NethelpersScope)(0),                 // 39: talos.resource.definitions.enums.NethelpersScope
-	(NethelpersVLANProtocol)(0),          // 40: talos.resource.definitions.enums.NethelpersVLANProtocol
-	(NethelpersWOLMode)(0),               // 41: talos.resource.definitions.enums.NethelpersWOLMode
-	(BlockEncryptionKeyType)(0),          // 42: talos.resource.definitions.enums.BlockEncryptionKeyType
-	(BlockEncryptionProviderType)(0),     // 43: talos.resource.definitions.enums.BlockEncryptionProviderType
-	(BlockFilesystemType)(0),             // 44: talos.resource.definitions.enums.BlockFilesystemType
-	(BlockFSParameterType)(0),            // 45: talos.resource.definitions.enums.BlockFSParameterType
-	(BlockVolumePhase)(0),                // 46: talos.resource.definitions.enums.BlockVolumePhase
-	(BlockVolumeType)(0),                 // 47: talos.resource.definitions.enums.BlockVolumeType
-	(StorageLVMLogicalVolumeType)(0),     // 48: talos.resource.definitions.enums.StorageLVMLogicalVolumeType
-	(StorageMDArrayPhase)(0),             // 49: talos.resource.definitions.enums.StorageMDArrayPhase
-	(StorageMDLevel)(0),                  // 50: talos.resource.definitions.enums.StorageMDLevel
-	(StorageMDMetadata)(0),               // 51: talos.resource.definitions.enums.StorageMDMetadata
-	(NetworkConfigLayer)(0),              // 52: talos.resource.definitions.enums.NetworkConfigLayer
-	(NetworkOperator)(0),                 // 53: talos.resource.definitions.enums.NetworkOperator
-	(ContainersContainerImagePhase)(0),   // 54: talos.resource.definitions.enums.ContainersContainerImagePhase
-	(CriImageCacheStatus)(0),             // 55: talos.resource.definitions.enums.CriImageCacheStatus
-	(CriImageCacheCopyStatus)(0),         // 56: talos.resource.definitions.enums.CriImageCacheCopyStatus
-	(KubespanPeerState)(0),               // 57: talos.resource.definitions.enums.KubespanPeerState
+	(RuntimeKernelModuleState)(0),         // 0: talos.resource.definitions.enums.RuntimeKernelModuleState
+	(RuntimeKernelModuleType)(0),          // 1: talos.resource.definitions.enums.RuntimeKernelModuleType
+	(RuntimeMachineStage)(0),              // 2: talos.resource.definitions.enums.RuntimeMachineStage
+	(RuntimeSELinuxState)(0),              // 3: talos.resource.definitions.enums.RuntimeSELinuxState
+	(RuntimeFIPSState)(0),                 // 4: talos.resource.definitions.enums.RuntimeFIPSState
+	(RuntimeUnattendedInstallPhase)(0),    // 5: talos.resource.definitions.enums.RuntimeUnattendedInstallPhase
+	(MachineType)(0),                      // 6: talos.resource.definitions.enums.MachineType
+	(NethelpersAddressFlag)(0),            // 7: talos.resource.definitions.enums.NethelpersAddressFlag
+	(NethelpersAddressSortAlgorithm)(0),   // 8: talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	(NethelpersADLACPActive)(0),           // 9: talos.resource.definitions.enums.NethelpersADLACPActive
+	(NethelpersADSelect)(0),               // 10: talos.resource.definitions.enums.NethelpersADSelect
+	(NethelpersARPAllTargets)(0),          // 11: talos.resource.definitions.enums.NethelpersARPAllTargets
+	(NethelpersARPValidate)(0),            // 12: talos.resource.definitions.enums.NethelpersARPValidate
+	(NethelpersAutoHostnameKind)(0),       // 13: talos.resource.definitions.enums.NethelpersAutoHostnameKind
+	(NethelpersBGPSessionState)(0),        // 14: talos.resource.definitions.enums.NethelpersBGPSessionState
+	(NethelpersBondMode)(0),               // 15: talos.resource.definitions.enums.NethelpersBondMode
+	(NethelpersBondXmitHashPolicy)(0),     // 16: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	(NethelpersClientIdentifier)(0),       // 17: talos.resource.definitions.enums.NethelpersClientIdentifier
+	(NethelpersConntrackState)(0),         // 18: talos.resource.definitions.enums.NethelpersConntrackState
+	(NethelpersDNSProtocol)(0),            // 19: talos.resource.definitions.enums.NethelpersDNSProtocol
+	(NethelpersDuplex)(0),                 // 20: talos.resource.definitions.enums.NethelpersDuplex
+	(NethelpersFailOverMAC)(0),            // 21: talos.resource.definitions.enums.NethelpersFailOverMAC
+	(NethelpersFamily)(0),                 // 22: talos.resource.definitions.enums.NethelpersFamily
+	(NethelpersICMPType)(0),               // 23: talos.resource.definitions.enums.NethelpersICMPType
+	(NethelpersIPVLANMode)(0),             // 24: talos.resource.definitions.enums.NethelpersIPVLANMode
+	(NethelpersLACPRate)(0),               // 25: talos.resource.definitions.enums.NethelpersLACPRate
+	(NethelpersLinkType)(0),               // 26: talos.resource.definitions.enums.NethelpersLinkType
+	(NethelpersMACVLANMode)(0),            // 27: talos.resource.definitions.enums.NethelpersMACVLANMode
+	(NethelpersMatchOperator)(0),          // 28: talos.resource.definitions.enums.NethelpersMatchOperator
+	(NethelpersNfTablesChainHook)(0),      // 29: talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	(NethelpersNfTablesChainPriority)(0),  // 30: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	(NethelpersNfTablesVerdict)(0),        // 31: talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	(NethelpersOperationalState)(0),       // 32: talos.resource.definitions.enums.NethelpersOperationalState
+	(NethelpersPort)(0),                   // 33: talos.resource.definitions.enums.NethelpersPort
+	(NethelpersPrimaryReselect)(0),        // 34: talos.resource.definitions.enums.NethelpersPrimaryReselect
+	(NethelpersProtocol)(0),               // 35: talos.resource.definitions.enums.NethelpersProtocol
+	(NethelpersRouteFlag)(0),              // 36: talos.resource.definitions.enums.NethelpersRouteFlag
+	(NethelpersRouteProtocol)(0),          // 37: talos.resource.definitions.enums.NethelpersRouteProtocol
+	(NethelpersRouteType)(0),              // 38: talos.resource.definitions.enums.NethelpersRouteType
+	(NethelpersRoutingRuleAction)(0),      // 39: talos.resource.definitions.enums.NethelpersRoutingRuleAction
+	(NethelpersRoutingTable)(0),           // 40: talos.resource.definitions.enums.NethelpersRoutingTable
+	(NethelpersScope)(0),                  // 41: talos.resource.definitions.enums.NethelpersScope
+	(NethelpersVLANProtocol)(0),           // 42: talos.resource.definitions.enums.NethelpersVLANProtocol
+	(NethelpersWOLMode)(0),                // 43: talos.resource.definitions.enums.NethelpersWOLMode
+	(BlockEncryptionKeyType)(0),           // 44: talos.resource.definitions.enums.BlockEncryptionKeyType
+	(BlockEncryptionProviderType)(0),      // 45: talos.resource.definitions.enums.BlockEncryptionProviderType
+	(BlockFilesystemType)(0),              // 46: talos.resource.definitions.enums.BlockFilesystemType
+	(BlockFSParameterType)(0),             // 47: talos.resource.definitions.enums.BlockFSParameterType
+	(BlockNetworkTargetType)(0),           // 48: talos.resource.definitions.enums.BlockNetworkTargetType
+	(BlockVolumePhase)(0),                 // 49: talos.resource.definitions.enums.BlockVolumePhase
+	(BlockVolumeType)(0),                  // 50: talos.resource.definitions.enums.BlockVolumeType
+	(StorageLVMLogicalVolumeType)(0),      // 51: talos.resource.definitions.enums.StorageLVMLogicalVolumeType
+	(StorageMDArrayPhase)(0),              // 52: talos.resource.definitions.enums.StorageMDArrayPhase
+	(StorageMDLevel)(0),                   // 53: talos.resource.definitions.enums.StorageMDLevel
+	(StorageMDMetadata)(0),                // 54: talos.resource.definitions.enums.StorageMDMetadata
+	(NetworkConfigLayer)(0),               // 55: talos.resource.definitions.enums.NetworkConfigLayer
+	(NetworkOperator)(0),                  // 56: talos.resource.definitions.enums.NetworkOperator
+	(ContainersContainerImagePhase)(0),    // 57: talos.resource.definitions.enums.ContainersContainerImagePhase
+	(ContainersContainerInstancePhase)(0), // 58: talos.resource.definitions.enums.ContainersContainerInstancePhase
+	(ContainersContainerPhase)(0),         // 59: talos.resource.definitions.enums.ContainersContainerPhase
+	(CriImageCacheStatus)(0),              // 60: talos.resource.definitions.enums.CriImageCacheStatus
+	(CriImageCacheCopyStatus)(0),          // 61: talos.resource.definitions.enums.CriImageCacheCopyStatus
+	(KubespanPeerState)(0),                // 62: talos.resource.definitions.enums.KubespanPeerState
 }
 var file_resource_definitions_enums_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -5074,7 +5401,7 @@ func file_resource_definitions_enums_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_enums_enums_proto_rawDesc), len(file_resource_definitions_enums_enums_proto_rawDesc)),
-			NumEnums:      58,
+			NumEnums:      63,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -46,8 +47,14 @@ type ConfigSpec struct {
 	ExtraEndpoints []*common.NetIPPort `protobuf:"bytes,9,rep,name=extra_endpoints,json=extraEndpoints,proto3" json:"extra_endpoints,omitempty"`
 	// If not empty, filter advertised networks using the list of CIDRs.
 	ExcludeAdvertisedNetworks []*common.NetIPPrefix `protobuf:"bytes,10,rep,name=exclude_advertised_networks,json=excludeAdvertisedNetworks,proto3" json:"exclude_advertised_networks,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	// Offer this node as a relay for the peers which can't connect directly.
+	Relay bool `protobuf:"varint,11,opt,name=relay,proto3" json:"relay,omitempty"`
+	// Rotate the identity key with the interval, zero disables the rotation.
+	KeyRotationInterval *durationpb.Duration `protobuf:"bytes,12,opt,name=key_rotation_interval,json=keyRotationInterval,proto3" json:"key_rotation_interval,omitempty"`
+	// Derive Wireguard preshared keys for each pair of peers from the shared secret.
+	PerPeerPresharedKeys bool `protobuf:"varint,13,opt,name=per_peer_preshared_keys,json=perPeerPresharedKeys,proto3" json:"per_peer_preshared_keys,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ConfigSpec) Reset() {
@@ -150,6 +157,27 @@ func (x *ConfigSpec) GetExcludeAdvertisedNetworks() []*common.NetIPPrefix {
 	return nil
 }
 
+func (x *ConfigSpec) GetRelay() bool {
+	if x != nil {
+		return x.Relay
+	}
+	return false
+}
+
+func (x *ConfigSpec) GetKeyRotationInterval() *durationpb.Duration {
+	if x != nil {
+		return x.KeyRotationInterval
+	}
+	return nil
+}
+
+func (x *ConfigSpec) GetPerPeerPresharedKeys() bool {
+	if x != nil {
+		return x.PerPeerPresharedKeys
+	}
+	return false
+}
+
 // EndpointSpec describes Endpoint state.
 type EndpointSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -213,10 +241,18 @@ type IdentitySpec struct {
 	Address *common.NetIPPrefix `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Subnet  *common.NetIPPrefix `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
 	// Public and private Wireguard keys.
-	PrivateKey    string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	PublicKey     string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey  string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Time the current key was put in use.
+	KeyCreated *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=key_created,json=keyCreated,proto3" json:"key_created,omitempty"`
+	// Next key, announced to the peers ahead of the key rotation.
+	NextPrivateKey string                 `protobuf:"bytes,6,opt,name=next_private_key,json=nextPrivateKey,proto3" json:"next_private_key,omitempty"`
+	NextPublicKey  string                 `protobuf:"bytes,7,opt,name=next_public_key,json=nextPublicKey,proto3" json:"next_public_key,omitempty"`
+	NextKeyCreated *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_key_created,json=nextKeyCreated,proto3" json:"next_key_created,omitempty"`
+	// Previous public key, still announced to the peers right after the key rotation.
+	PreviousPublicKey string `protobuf:"bytes,9,opt,name=previous_public_key,json=previousPublicKey,proto3" json:"previous_public_key,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *IdentitySpec) Reset() {
@@ -277,15 +313,53 @@ func (x *IdentitySpec) GetPublicKey() string {
 	return ""
 }
 
+func (x *IdentitySpec) GetKeyCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KeyCreated
+	}
+	return nil
+}
+
+func (x *IdentitySpec) GetNextPrivateKey() string {
+	if x != nil {
+		return x.NextPrivateKey
+	}
+	return ""
+}
+
+func (x *IdentitySpec) GetNextPublicKey() string {
+	if x != nil {
+		return x.NextPublicKey
+	}
+	return ""
+}
+
+func (x *IdentitySpec) GetNextKeyCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextKeyCreated
+	}
+	return nil
+}
+
+func (x *IdentitySpec) GetPreviousPublicKey() string {
+	if x != nil {
+		return x.PreviousPublicKey
+	}
+	return ""
+}
+
 // PeerSpecSpec describes PeerSpec state.
 type PeerSpecSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       *common.NetIP          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AllowedIps    []*common.NetIPPrefix  `protobuf:"bytes,2,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
-	Endpoints     []*common.NetIPPort    `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
-	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Address    *common.NetIP          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AllowedIps []*common.NetIPPrefix  `protobuf:"bytes,2,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	Endpoints  []*common.NetIPPort    `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	Label      string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Relay      bool                   `protobuf:"varint,5,opt,name=relay,proto3" json:"relay,omitempty"`
+	// Another public key of the peer accepted during the key rotation.
+	AlternatePublicKey string `protobuf:"bytes,6,opt,name=alternate_public_key,json=alternatePublicKey,proto3" json:"alternate_public_key,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *PeerSpecSpec) Reset() {
@@ -346,6 +420,20 @@ func (x *PeerSpecSpec) GetLabel() string {
 	return ""
 }
 
+func (x *PeerSpecSpec) GetRelay() bool {
+	if x != nil {
+		return x.Relay
+	}
+	return false
+}
+
+func (x *PeerSpecSpec) GetAlternatePublicKey() string {
+	if x != nil {
+		return x.AlternatePublicKey
+	}
+	return ""
+}
+
 // PeerStatusSpec describes PeerStatus state.
 type PeerStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -363,8 +451,12 @@ type PeerStatusSpec struct {
 	// Endpoint selection input.
 	LastUsedEndpoint   *common.NetIPPort      `protobuf:"bytes,7,opt,name=last_used_endpoint,json=lastUsedEndpoint,proto3" json:"last_used_endpoint,omitempty"`
 	LastEndpointChange *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_endpoint_change,json=lastEndpointChange,proto3" json:"last_endpoint_change,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Label of the relay peer the traffic is routed via, if the peer is not reachable directly.
+	RelayedVia string `protobuf:"bytes,9,opt,name=relayed_via,json=relayedVia,proto3" json:"relayed_via,omitempty"`
+	// Public key of the relay peer.
+	RelayPublicKey string `protobuf:"bytes,10,opt,name=relay_public_key,json=relayPublicKey,proto3" json:"relay_public_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PeerStatusSpec) Reset() {
@@ -453,11 +545,25 @@ func (x *PeerStatusSpec) GetLastEndpointChange() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PeerStatusSpec) GetRelayedVia() string {
+	if x != nil {
+		return x.RelayedVia
+	}
+	return ""
+}
+
+func (x *PeerStatusSpec) GetRelayPublicKey() string {
+	if x != nil {
+		return x.RelayPublicKey
+	}
+	return ""
+}
+
 var File_resource_definitions_kubespan_kubespan_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\n" +
-	",resource/definitions/kubespan/kubespan.proto\x12#talos.resource.definitions.kubespan\x1a\x13common/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"\xd9\x03\n" +
+	",resource/definitions/kubespan/kubespan.proto\x12#talos.resource.definitions.kubespan\x1a\x13common/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&resource/definitions/enums/enums.proto\"\xf5\x04\n" +
 	"\n" +
 	"ConfigSpec\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1d\n" +
@@ -471,23 +577,34 @@ const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\x17harvest_extra_endpoints\x18\b \x01(\bR\x15harvestExtraEndpoints\x12:\n" +
 	"\x0fextra_endpoints\x18\t \x03(\v2\x11.common.NetIPPortR\x0eextraEndpoints\x12S\n" +
 	"\x1bexclude_advertised_networks\x18\n" +
-	" \x03(\v2\x13.common.NetIPPrefixR\x19excludeAdvertisedNetworks\"`\n" +
+	" \x03(\v2\x13.common.NetIPPrefixR\x19excludeAdvertisedNetworks\x12\x14\n" +
+	"\x05relay\x18\v \x01(\bR\x05relay\x12M\n" +
+	"\x15key_rotation_interval\x18\f \x01(\v2\x19.google.protobuf.DurationR\x13keyRotationInterval\x125\n" +
+	"\x17per_peer_preshared_keys\x18\r \x01(\bR\x14perPeerPresharedKeys\"`\n" +
 	"\fEndpointSpec\x12!\n" +
 	"\faffiliate_id\x18\x01 \x01(\tR\vaffiliateId\x12-\n" +
-	"\bendpoint\x18\x02 \x01(\v2\x11.common.NetIPPortR\bendpoint\"\xaa\x01\n" +
+	"\bendpoint\x18\x02 \x01(\v2\x11.common.NetIPPortR\bendpoint\"\xaf\x03\n" +
 	"\fIdentitySpec\x12-\n" +
 	"\aaddress\x18\x01 \x01(\v2\x13.common.NetIPPrefixR\aaddress\x12+\n" +
 	"\x06subnet\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\x06subnet\x12\x1f\n" +
 	"\vprivate_key\x18\x03 \x01(\tR\n" +
 	"privateKey\x12\x1d\n" +
 	"\n" +
-	"public_key\x18\x04 \x01(\tR\tpublicKey\"\xb4\x01\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12;\n" +
+	"\vkey_created\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"keyCreated\x12(\n" +
+	"\x10next_private_key\x18\x06 \x01(\tR\x0enextPrivateKey\x12&\n" +
+	"\x0fnext_public_key\x18\a \x01(\tR\rnextPublicKey\x12D\n" +
+	"\x10next_key_created\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0enextKeyCreated\x12.\n" +
+	"\x13previous_public_key\x18\t \x01(\tR\x11previousPublicKey\"\xfc\x01\n" +
 	"\fPeerSpecSpec\x12'\n" +
 	"\aaddress\x18\x01 \x01(\v2\r.common.NetIPR\aaddress\x124\n" +
 	"\vallowed_ips\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\n" +
 	"allowedIps\x12/\n" +
 	"\tendpoints\x18\x03 \x03(\v2\x11.common.NetIPPortR\tendpoints\x12\x14\n" +
-	"\x05label\x18\x04 \x01(\tR\x05label\"\xc7\x03\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x14\n" +
+	"\x05relay\x18\x05 \x01(\bR\x05relay\x120\n" +
+	"\x14alternate_public_key\x18\x06 \x01(\tR\x12alternatePublicKey\"\x92\x04\n" +
 	"\x0ePeerStatusSpec\x12-\n" +
 	"\bendpoint\x18\x01 \x01(\v2\x11.common.NetIPPortR\bendpoint\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12I\n" +
@@ -496,7 +613,11 @@ const file_resource_definitions_kubespan_kubespan_proto_rawDesc = "" +
 	"\x0etransmit_bytes\x18\x05 \x01(\x03R\rtransmitBytes\x12J\n" +
 	"\x13last_handshake_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastHandshakeTime\x12?\n" +
 	"\x12last_used_endpoint\x18\a \x01(\v2\x11.common.NetIPPortR\x10lastUsedEndpoint\x12L\n" +
-	"\x14last_endpoint_change\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x12lastEndpointChangeBz\n" +
+	"\x14last_endpoint_change\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x12lastEndpointChange\x12\x1f\n" +
+	"\vrelayed_via\x18\t \x01(\tR\n" +
+	"relayedVia\x12(\n" +
+	"\x10relay_public_key\x18\n" +
+	" \x01(\tR\x0erelayPublicKeyBz\n" +
 	"+dev.talos.api.resource.definitions.kubespanZKgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/kubespanb\x06proto3"
 
 var (
@@ -520,29 +641,33 @@ var file_resource_definitions_kubespan_kubespan_proto_goTypes = []any{
 	(*PeerStatusSpec)(nil),        // 4: talos.resource.definitions.kubespan.PeerStatusSpec
 	(*common.NetIPPort)(nil),      // 5: common.NetIPPort
 	(*common.NetIPPrefix)(nil),    // 6: common.NetIPPrefix
-	(*common.NetIP)(nil),          // 7: common.NetIP
-	(enums.KubespanPeerState)(0),  // 8: talos.resource.definitions.enums.KubespanPeerState
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*common.NetIP)(nil),          // 9: common.NetIP
+	(enums.KubespanPeerState)(0),  // 10: talos.resource.definitions.enums.KubespanPeerState
 }
 var file_resource_definitions_kubespan_kubespan_proto_depIdxs = []int32{
 	5,  // 0: talos.resource.definitions.kubespan.ConfigSpec.extra_endpoints:type_name -> common.NetIPPort
 	6,  // 1: talos.resource.definitions.kubespan.ConfigSpec.exclude_advertised_networks:type_name -> common.NetIPPrefix
-	5,  // 2: talos.resource.definitions.kubespan.EndpointSpec.endpoint:type_name -> common.NetIPPort
-	6,  // 3: talos.resource.definitions.kubespan.IdentitySpec.address:type_name -> common.NetIPPrefix
-	6,  // 4: talos.resource.definitions.kubespan.IdentitySpec.subnet:type_name -> common.NetIPPrefix
-	7,  // 5: talos.resource.definitions.kubespan.PeerSpecSpec.address:type_name -> common.NetIP
-	6,  // 6: talos.resource.definitions.kubespan.PeerSpecSpec.allowed_ips:type_name -> common.NetIPPrefix
-	5,  // 7: talos.resource.definitions.kubespan.PeerSpecSpec.endpoints:type_name -> common.NetIPPort
-	5,  // 8: talos.resource.definitions.kubespan.PeerStatusSpec.endpoint:type_name -> common.NetIPPort
-	8,  // 9: talos.resource.definitions.kubespan.PeerStatusSpec.state:type_name -> talos.resource.definitions.enums.KubespanPeerState
-	9,  // 10: talos.resource.definitions.kubespan.PeerStatusSpec.last_handshake_time:type_name -> google.protobuf.Timestamp
-	5,  // 11: talos.resource.definitions.kubespan.PeerStatusSpec.last_used_endpoint:type_name -> common.NetIPPort
-	9,  // 12: talos.resource.definitions.kubespan.PeerStatusSpec.last_endpoint_change:type_name -> google.protobuf.Timestamp
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	7,  // 2: talos.resource.definitions.kubespan.ConfigSpec.key_rotation_interval:type_name -> google.protobuf.Duration
+	5,  // 3: talos.resource.definitions.kubespan.EndpointSpec.endpoint:type_name -> common.NetIPPort
+	6,  // 4: talos.resource.definitions.kubespan.IdentitySpec.address:type_name -> common.NetIPPrefix
+	6,  // 5: talos.resource.definitions.kubespan.IdentitySpec.subnet:type_name -> common.NetIPPrefix
+	8,  // 6: talos.resource.definitions.kubespan.IdentitySpec.key_created:type_name -> google.protobuf.Timestamp
+	8,  // 7: talos.resource.definitions.kubespan.IdentitySpec.next_key_created:type_name -> google.protobuf.Timestamp
+	9,  // 8: talos.resource.definitions.kubespan.PeerSpecSpec.address:type_name -> common.NetIP
+	6,  // 9: talos.resource.definitions.kubespan.PeerSpecSpec.allowed_ips:type_name -> common.NetIPPrefix
+	5,  // 10: talos.resource.definitions.kubespan.PeerSpecSpec.endpoints:type_name -> common.NetIPPort
+	5,  // 11: talos.resource.definitions.kubespan.PeerStatusSpec.endpoint:type_name -> common.NetIPPort
+	10, // 12: talos.resource.definitions.kubespan.PeerStatusSpec.state:type_name -> talos.resource.definitions.enums.KubespanPeerState
+	8,  // 13: talos.resource.definitions.kubespan.PeerStatusSpec.last_handshake_time:type_name -> google.protobuf.Timestamp
+	5,  // 14: talos.resource.definitions.kubespan.PeerStatusSpec.last_used_endpoint:type_name -> common.NetIPPort
+	8,  // 15: talos.resource.definitions.kubespan.PeerStatusSpec.last_endpoint_change:type_name -> google.protobuf.Timestamp
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_kubespan_kubespan_proto_init() }
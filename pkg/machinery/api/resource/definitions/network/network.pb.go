@@ -254,6 +254,59 @@ func (x *AddressStatusSpec) GetPriority() uint32 {
 	return 0
 }
 
+// BGPAdvertiseProbeSpec gates the advertisement of a link's addresses on a probe.
+type BGPAdvertiseProbeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Probe         string                 `protobuf:"bytes,2,opt,name=probe,proto3" json:"probe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPAdvertiseProbeSpec) Reset() {
+	*x = BGPAdvertiseProbeSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPAdvertiseProbeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPAdvertiseProbeSpec) ProtoMessage() {}
+
+func (x *BGPAdvertiseProbeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPAdvertiseProbeSpec.ProtoReflect.Descriptor instead.
+func (*BGPAdvertiseProbeSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BGPAdvertiseProbeSpec) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *BGPAdvertiseProbeSpec) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
 // BGPBFDConfigSpec contains BFD parameters for a BGP neighbor.
 type BGPBFDConfigSpec struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -266,7 +319,7 @@ type BGPBFDConfigSpec struct {
 
 func (x *BGPBFDConfigSpec) Reset() {
 	*x = BGPBFDConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +331,7 @@ func (x *BGPBFDConfigSpec) String() string {
 func (*BGPBFDConfigSpec) ProtoMessage() {}
 
 func (x *BGPBFDConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[2]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +344,7 @@ func (x *BGPBFDConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BGPBFDConfigSpec.ProtoReflect.Descriptor instead.
 func (*BGPBFDConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BGPBFDConfigSpec) GetTransmitInterval() *durationpb.Duration {
@@ -315,6 +368,59 @@ func (x *BGPBFDConfigSpec) GetDetectMultiplier() uint32 {
 	return 0
 }
 
+// BGPGracefulRestartSpec contains the graceful restart parameters of a BGP instance.
+type BGPGracefulRestartSpec struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RestartTime        *durationpb.Duration   `protobuf:"bytes,1,opt,name=restart_time,json=restartTime,proto3" json:"restart_time,omitempty"`
+	LongLivedStaleTime *durationpb.Duration   `protobuf:"bytes,2,opt,name=long_lived_stale_time,json=longLivedStaleTime,proto3" json:"long_lived_stale_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BGPGracefulRestartSpec) Reset() {
+	*x = BGPGracefulRestartSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPGracefulRestartSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPGracefulRestartSpec) ProtoMessage() {}
+
+func (x *BGPGracefulRestartSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPGracefulRestartSpec.ProtoReflect.Descriptor instead.
+func (*BGPGracefulRestartSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BGPGracefulRestartSpec) GetRestartTime() *durationpb.Duration {
+	if x != nil {
+		return x.RestartTime
+	}
+	return nil
+}
+
+func (x *BGPGracefulRestartSpec) GetLongLivedStaleTime() *durationpb.Duration {
+	if x != nil {
+		return x.LongLivedStaleTime
+	}
+	return nil
+}
+
 // BGPImportRouteSpec selects routes learned by another BGP instance for one-way import.
 type BGPImportRouteSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -326,7 +432,7 @@ type BGPImportRouteSpec struct {
 
 func (x *BGPImportRouteSpec) Reset() {
 	*x = BGPImportRouteSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +444,7 @@ func (x *BGPImportRouteSpec) String() string {
 func (*BGPImportRouteSpec) ProtoMessage() {}
 
 func (x *BGPImportRouteSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[3]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +457,7 @@ func (x *BGPImportRouteSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BGPImportRouteSpec.ProtoReflect.Descriptor instead.
 func (*BGPImportRouteSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{3}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BGPImportRouteSpec) GetBgpInstance() string {
@@ -370,25 +476,27 @@ func (x *BGPImportRouteSpec) GetPrefixes() []*common.NetIPPrefix {
 
 // BGPInstanceConfigSpec contains the resolved runtime configuration for a BGP routing instance.
 type BGPInstanceConfigSpec struct {
-	state          protoimpl.MessageState       `protogen:"open.v1"`
-	LocalAsn       uint32                       `protobuf:"varint,1,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
-	RouterId       *common.NetIP                `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	RouteSource    *common.NetIP                `protobuf:"bytes,3,opt,name=route_source,json=routeSource,proto3" json:"route_source,omitempty"`
-	AdvertiseLinks []string                     `protobuf:"bytes,4,rep,name=advertise_links,json=advertiseLinks,proto3" json:"advertise_links,omitempty"`
-	Multipath      bool                         `protobuf:"varint,5,opt,name=multipath,proto3" json:"multipath,omitempty"`
-	MaxPaths       uint32                       `protobuf:"varint,6,opt,name=max_paths,json=maxPaths,proto3" json:"max_paths,omitempty"`
-	Neighbors      []*BGPNeighborConfigSpec     `protobuf:"bytes,7,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
-	Vrf            string                       `protobuf:"bytes,8,opt,name=vrf,proto3" json:"vrf,omitempty"`
-	VrfTable       enums.NethelpersRoutingTable `protobuf:"varint,9,opt,name=vrf_table,json=vrfTable,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"vrf_table,omitempty"`
-	ImportRoutes   []*BGPImportRouteSpec        `protobuf:"bytes,10,rep,name=import_routes,json=importRoutes,proto3" json:"import_routes,omitempty"`
-	InstallRoutes  bool                         `protobuf:"varint,11,opt,name=install_routes,json=installRoutes,proto3" json:"install_routes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState       `protogen:"open.v1"`
+	LocalAsn        uint32                       `protobuf:"varint,1,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
+	RouterId        *common.NetIP                `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	RouteSource     *common.NetIP                `protobuf:"bytes,3,opt,name=route_source,json=routeSource,proto3" json:"route_source,omitempty"`
+	AdvertiseLinks  []string                     `protobuf:"bytes,4,rep,name=advertise_links,json=advertiseLinks,proto3" json:"advertise_links,omitempty"`
+	Multipath       bool                         `protobuf:"varint,5,opt,name=multipath,proto3" json:"multipath,omitempty"`
+	MaxPaths        uint32                       `protobuf:"varint,6,opt,name=max_paths,json=maxPaths,proto3" json:"max_paths,omitempty"`
+	Neighbors       []*BGPNeighborConfigSpec     `protobuf:"bytes,7,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+	Vrf             string                       `protobuf:"bytes,8,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	VrfTable        enums.NethelpersRoutingTable `protobuf:"varint,9,opt,name=vrf_table,json=vrfTable,proto3,enum=talos.resource.definitions.enums.NethelpersRoutingTable" json:"vrf_table,omitempty"`
+	ImportRoutes    []*BGPImportRouteSpec        `protobuf:"bytes,10,rep,name=import_routes,json=importRoutes,proto3" json:"import_routes,omitempty"`
+	InstallRoutes   bool                         `protobuf:"varint,11,opt,name=install_routes,json=installRoutes,proto3" json:"install_routes,omitempty"`
+	GracefulRestart *BGPGracefulRestartSpec      `protobuf:"bytes,12,opt,name=graceful_restart,json=gracefulRestart,proto3" json:"graceful_restart,omitempty"`
+	AdvertiseProbes []*BGPAdvertiseProbeSpec     `protobuf:"bytes,13,rep,name=advertise_probes,json=advertiseProbes,proto3" json:"advertise_probes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *BGPInstanceConfigSpec) Reset() {
 	*x = BGPInstanceConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +508,7 @@ func (x *BGPInstanceConfigSpec) String() string {
 func (*BGPInstanceConfigSpec) ProtoMessage() {}
 
 func (x *BGPInstanceConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[4]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +521,7 @@ func (x *BGPInstanceConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BGPInstanceConfigSpec.ProtoReflect.Descriptor instead.
 func (*BGPInstanceConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{4}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BGPInstanceConfigSpec) GetLocalAsn() uint32 {
@@ -493,6 +601,20 @@ func (x *BGPInstanceConfigSpec) GetInstallRoutes() bool {
 	return false
 }
 
+func (x *BGPInstanceConfigSpec) GetGracefulRestart() *BGPGracefulRestartSpec {
+	if x != nil {
+		return x.GracefulRestart
+	}
+	return nil
+}
+
+func (x *BGPInstanceConfigSpec) GetAdvertiseProbes() []*BGPAdvertiseProbeSpec {
+	if x != nil {
+		return x.AdvertiseProbes
+	}
+	return nil
+}
+
 // BGPNeighborConfigSpec contains the runtime configuration for a BGP neighbor.
 type BGPNeighborConfigSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -503,13 +625,16 @@ type BGPNeighborConfigSpec struct {
 	Bfd           *BGPBFDConfigSpec      `protobuf:"bytes,5,opt,name=bfd,proto3" json:"bfd,omitempty"`
 	LocalAsn      uint32                 `protobuf:"varint,6,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
 	Passive       bool                   `protobuf:"varint,7,opt,name=passive,proto3" json:"passive,omitempty"`
+	Password      string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	ImportPolicy  *BGPRoutePolicySpec    `protobuf:"bytes,9,opt,name=import_policy,json=importPolicy,proto3" json:"import_policy,omitempty"`
+	ExportPolicy  *BGPRoutePolicySpec    `protobuf:"bytes,10,opt,name=export_policy,json=exportPolicy,proto3" json:"export_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BGPNeighborConfigSpec) Reset() {
 	*x = BGPNeighborConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -521,7 +646,7 @@ func (x *BGPNeighborConfigSpec) String() string {
 func (*BGPNeighborConfigSpec) ProtoMessage() {}
 
 func (x *BGPNeighborConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[5]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +659,7 @@ func (x *BGPNeighborConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BGPNeighborConfigSpec.ProtoReflect.Descriptor instead.
 func (*BGPNeighborConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{5}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BGPNeighborConfigSpec) GetAddress() *common.NetIP {
@@ -586,27 +711,51 @@ func (x *BGPNeighborConfigSpec) GetPassive() bool {
 	return false
 }
 
+func (x *BGPNeighborConfigSpec) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *BGPNeighborConfigSpec) GetImportPolicy() *BGPRoutePolicySpec {
+	if x != nil {
+		return x.ImportPolicy
+	}
+	return nil
+}
+
+func (x *BGPNeighborConfigSpec) GetExportPolicy() *BGPRoutePolicySpec {
+	if x != nil {
+		return x.ExportPolicy
+	}
+	return nil
+}
+
 // BGPPeerStatusSpec describes the status of a BGP peering session.
 type BGPPeerStatusSpec struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Peer          string                          `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
-	LocalAsn      uint32                          `protobuf:"varint,2,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
-	PeerAsn       uint32                          `protobuf:"varint,3,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
-	State         enums.NethelpersBGPSessionState `protobuf:"varint,4,opt,name=state,proto3,enum=talos.resource.definitions.enums.NethelpersBGPSessionState" json:"state,omitempty"`
-	RouterId      *common.NetIP                   `protobuf:"bytes,5,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	Since         *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
-	Received      uint32                          `protobuf:"varint,7,opt,name=received,proto3" json:"received,omitempty"`
-	Advertised    uint32                          `protobuf:"varint,8,opt,name=advertised,proto3" json:"advertised,omitempty"`
-	Accepted      uint32                          `protobuf:"varint,9,opt,name=accepted,proto3" json:"accepted,omitempty"`
-	BfdState      string                          `protobuf:"bytes,10,opt,name=bfd_state,json=bfdState,proto3" json:"bfd_state,omitempty"`
-	Instance      string                          `protobuf:"bytes,11,opt,name=instance,proto3" json:"instance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                    protoimpl.MessageState          `protogen:"open.v1"`
+	Peer                     string                          `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	LocalAsn                 uint32                          `protobuf:"varint,2,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
+	PeerAsn                  uint32                          `protobuf:"varint,3,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	State                    enums.NethelpersBGPSessionState `protobuf:"varint,4,opt,name=state,proto3,enum=talos.resource.definitions.enums.NethelpersBGPSessionState" json:"state,omitempty"`
+	RouterId                 *common.NetIP                   `protobuf:"bytes,5,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Since                    *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Received                 uint32                          `protobuf:"varint,7,opt,name=received,proto3" json:"received,omitempty"`
+	Advertised               uint32                          `protobuf:"varint,8,opt,name=advertised,proto3" json:"advertised,omitempty"`
+	Accepted                 uint32                          `protobuf:"varint,9,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	BfdState                 string                          `protobuf:"bytes,10,opt,name=bfd_state,json=bfdState,proto3" json:"bfd_state,omitempty"`
+	Instance                 string                          `protobuf:"bytes,11,opt,name=instance,proto3" json:"instance,omitempty"`
+	Rejected                 uint32                          `protobuf:"varint,12,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	GracefulRestart          bool                            `protobuf:"varint,13,opt,name=graceful_restart,json=gracefulRestart,proto3" json:"graceful_restart,omitempty"`
+	LongLivedGracefulRestart bool                            `protobuf:"varint,14,opt,name=long_lived_graceful_restart,json=longLivedGracefulRestart,proto3" json:"long_lived_graceful_restart,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *BGPPeerStatusSpec) Reset() {
 	*x = BGPPeerStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[6]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +767,7 @@ func (x *BGPPeerStatusSpec) String() string {
 func (*BGPPeerStatusSpec) ProtoMessage() {}
 
 func (x *BGPPeerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[6]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +780,7 @@ func (x *BGPPeerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BGPPeerStatusSpec.ProtoReflect.Descriptor instead.
 func (*BGPPeerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{6}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BGPPeerStatusSpec) GetPeer() string {
@@ -711,6 +860,157 @@ func (x *BGPPeerStatusSpec) GetInstance() string {
 	return ""
 }
 
+func (x *BGPPeerStatusSpec) GetRejected() uint32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *BGPPeerStatusSpec) GetGracefulRestart() bool {
+	if x != nil {
+		return x.GracefulRestart
+	}
+	return false
+}
+
+func (x *BGPPeerStatusSpec) GetLongLivedGracefulRestart() bool {
+	if x != nil {
+		return x.LongLivedGracefulRestart
+	}
+	return false
+}
+
+// BGPPrefixFilterSpec is a single entry of a BGP prefix list.
+type BGPPrefixFilterSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        *common.NetIPPrefix    `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MaxLength     uint32                 `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPPrefixFilterSpec) Reset() {
+	*x = BGPPrefixFilterSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPPrefixFilterSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPrefixFilterSpec) ProtoMessage() {}
+
+func (x *BGPPrefixFilterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPrefixFilterSpec.ProtoReflect.Descriptor instead.
+func (*BGPPrefixFilterSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BGPPrefixFilterSpec) GetPrefix() *common.NetIPPrefix {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *BGPPrefixFilterSpec) GetMaxLength() uint32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+// BGPRoutePolicySpec contains the route filtering and modification policy for a BGP neighbor.
+type BGPRoutePolicySpec struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Prefixes            []*BGPPrefixFilterSpec `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	MatchCommunities    []string               `protobuf:"bytes,2,rep,name=match_communities,json=matchCommunities,proto3" json:"match_communities,omitempty"`
+	AddCommunities      []string               `protobuf:"bytes,3,rep,name=add_communities,json=addCommunities,proto3" json:"add_communities,omitempty"`
+	AddLargeCommunities []string               `protobuf:"bytes,4,rep,name=add_large_communities,json=addLargeCommunities,proto3" json:"add_large_communities,omitempty"`
+	AsPathPrepend       uint32                 `protobuf:"varint,5,opt,name=as_path_prepend,json=asPathPrepend,proto3" json:"as_path_prepend,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BGPRoutePolicySpec) Reset() {
+	*x = BGPRoutePolicySpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPRoutePolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPRoutePolicySpec) ProtoMessage() {}
+
+func (x *BGPRoutePolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPRoutePolicySpec.ProtoReflect.Descriptor instead.
+func (*BGPRoutePolicySpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BGPRoutePolicySpec) GetPrefixes() []*BGPPrefixFilterSpec {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *BGPRoutePolicySpec) GetMatchCommunities() []string {
+	if x != nil {
+		return x.MatchCommunities
+	}
+	return nil
+}
+
+func (x *BGPRoutePolicySpec) GetAddCommunities() []string {
+	if x != nil {
+		return x.AddCommunities
+	}
+	return nil
+}
+
+func (x *BGPRoutePolicySpec) GetAddLargeCommunities() []string {
+	if x != nil {
+		return x.AddLargeCommunities
+	}
+	return nil
+}
+
+func (x *BGPRoutePolicySpec) GetAsPathPrepend() uint32 {
+	if x != nil {
+		return x.AsPathPrepend
+	}
+	return 0
+}
+
 // BondMasterSpec describes bond settings if Kind == "bond".
 type BondMasterSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -789,7 +1089,7 @@ type BondMasterSpec struct {
 
 func (x *BondMasterSpec) Reset() {
 	*x = BondMasterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1101,7 @@ func (x *BondMasterSpec) String() string {
 func (*BondMasterSpec) ProtoMessage() {}
 
 func (x *BondMasterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[7]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1114,7 @@ func (x *BondMasterSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BondMasterSpec.ProtoReflect.Descriptor instead.
 func (*BondMasterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{7}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BondMasterSpec) GetMode() enums.NethelpersBondMode {
@@ -1033,7 +1333,7 @@ type BondSlave struct {
 
 func (x *BondSlave) Reset() {
 	*x = BondSlave{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1345,7 @@ func (x *BondSlave) String() string {
 func (*BondSlave) ProtoMessage() {}
 
 func (x *BondSlave) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[8]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1358,7 @@ func (x *BondSlave) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BondSlave.ProtoReflect.Descriptor instead.
 func (*BondSlave) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{8}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BondSlave) GetMasterName() string {
@@ -1086,7 +1386,7 @@ type BridgeMasterSpec struct {
 
 func (x *BridgeMasterSpec) Reset() {
 	*x = BridgeMasterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1098,7 +1398,7 @@ func (x *BridgeMasterSpec) String() string {
 func (*BridgeMasterSpec) ProtoMessage() {}
 
 func (x *BridgeMasterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[9]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1411,7 @@ func (x *BridgeMasterSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BridgeMasterSpec.ProtoReflect.Descriptor instead.
 func (*BridgeMasterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{9}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BridgeMasterSpec) GetStp() *STPSpec {
@@ -1139,7 +1439,7 @@ type BridgeSlave struct {
 
 func (x *BridgeSlave) Reset() {
 	*x = BridgeSlave{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1151,7 +1451,7 @@ func (x *BridgeSlave) String() string {
 func (*BridgeSlave) ProtoMessage() {}
 
 func (x *BridgeSlave) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[10]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1464,7 @@ func (x *BridgeSlave) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BridgeSlave.ProtoReflect.Descriptor instead.
 func (*BridgeSlave) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BridgeSlave) GetMasterName() string {
@@ -1184,7 +1484,7 @@ type BridgeVLANSpec struct {
 
 func (x *BridgeVLANSpec) Reset() {
 	*x = BridgeVLANSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1196,7 +1496,7 @@ func (x *BridgeVLANSpec) String() string {
 func (*BridgeVLANSpec) ProtoMessage() {}
 
 func (x *BridgeVLANSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[11]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1509,7 @@ func (x *BridgeVLANSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BridgeVLANSpec.ProtoReflect.Descriptor instead.
 func (*BridgeVLANSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{11}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BridgeVLANSpec) GetFilteringEnabled() bool {
@@ -1230,7 +1530,7 @@ type ClientIdentifierSpec struct {
 
 func (x *ClientIdentifierSpec) Reset() {
 	*x = ClientIdentifierSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[12]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1242,7 +1542,7 @@ func (x *ClientIdentifierSpec) String() string {
 func (*ClientIdentifierSpec) ProtoMessage() {}
 
 func (x *ClientIdentifierSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[12]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,7 +1555,7 @@ func (x *ClientIdentifierSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientIdentifierSpec.ProtoReflect.Descriptor instead.
 func (*ClientIdentifierSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{12}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ClientIdentifierSpec) GetClientIdentifier() enums.NethelpersClientIdentifier {
@@ -1279,13 +1579,16 @@ type DHCP4OperatorSpec struct {
 	SkipHostnameRequest bool                   `protobuf:"varint,2,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
 	ClientIdentifier    *ClientIdentifierSpec  `protobuf:"bytes,3,opt,name=client_identifier,json=clientIdentifier,proto3" json:"client_identifier,omitempty"`
 	SkipRoutes          bool                   `protobuf:"varint,4,opt,name=skip_routes,json=skipRoutes,proto3" json:"skip_routes,omitempty"`
+	RequestOptions      []uint32               `protobuf:"varint,5,rep,packed,name=request_options,json=requestOptions,proto3" json:"request_options,omitempty"`
+	VendorClass         string                 `protobuf:"bytes,6,opt,name=vendor_class,json=vendorClass,proto3" json:"vendor_class,omitempty"`
+	UserClass           []string               `protobuf:"bytes,7,rep,name=user_class,json=userClass,proto3" json:"user_class,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DHCP4OperatorSpec) Reset() {
 	*x = DHCP4OperatorSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[13]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1297,7 +1600,7 @@ func (x *DHCP4OperatorSpec) String() string {
 func (*DHCP4OperatorSpec) ProtoMessage() {}
 
 func (x *DHCP4OperatorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[13]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1310,7 +1613,7 @@ func (x *DHCP4OperatorSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DHCP4OperatorSpec.ProtoReflect.Descriptor instead.
 func (*DHCP4OperatorSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{13}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DHCP4OperatorSpec) GetRouteMetric() uint32 {
@@ -1341,19 +1644,43 @@ func (x *DHCP4OperatorSpec) GetSkipRoutes() bool {
 	return false
 }
 
+func (x *DHCP4OperatorSpec) GetRequestOptions() []uint32 {
+	if x != nil {
+		return x.RequestOptions
+	}
+	return nil
+}
+
+func (x *DHCP4OperatorSpec) GetVendorClass() string {
+	if x != nil {
+		return x.VendorClass
+	}
+	return ""
+}
+
+func (x *DHCP4OperatorSpec) GetUserClass() []string {
+	if x != nil {
+		return x.UserClass
+	}
+	return nil
+}
+
 // DHCP6OperatorSpec describes DHCP6 operator options.
 type DHCP6OperatorSpec struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	RouteMetric         uint32                 `protobuf:"varint,2,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
-	SkipHostnameRequest bool                   `protobuf:"varint,3,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
-	ClientIdentifier    *ClientIdentifierSpec  `protobuf:"bytes,4,opt,name=client_identifier,json=clientIdentifier,proto3" json:"client_identifier,omitempty"`
+	state               protoimpl.MessageState       `protogen:"open.v1"`
+	RouteMetric         uint32                       `protobuf:"varint,2,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
+	SkipHostnameRequest bool                         `protobuf:"varint,3,opt,name=skip_hostname_request,json=skipHostnameRequest,proto3" json:"skip_hostname_request,omitempty"`
+	ClientIdentifier    *ClientIdentifierSpec        `protobuf:"bytes,4,opt,name=client_identifier,json=clientIdentifier,proto3" json:"client_identifier,omitempty"`
+	PrefixDelegation    bool                         `protobuf:"varint,5,opt,name=prefix_delegation,json=prefixDelegation,proto3" json:"prefix_delegation,omitempty"`
+	PrefixLength        uint32                       `protobuf:"varint,6,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	PrefixDownstreams   []*DHCP6PrefixDownstreamSpec `protobuf:"bytes,7,rep,name=prefix_downstreams,json=prefixDownstreams,proto3" json:"prefix_downstreams,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DHCP6OperatorSpec) Reset() {
 	*x = DHCP6OperatorSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[14]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1365,7 +1692,7 @@ func (x *DHCP6OperatorSpec) String() string {
 func (*DHCP6OperatorSpec) ProtoMessage() {}
 
 func (x *DHCP6OperatorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[14]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1705,7 @@ func (x *DHCP6OperatorSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DHCP6OperatorSpec.ProtoReflect.Descriptor instead.
 func (*DHCP6OperatorSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{14}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DHCP6OperatorSpec) GetRouteMetric() uint32 {
@@ -1402,29 +1729,51 @@ func (x *DHCP6OperatorSpec) GetClientIdentifier() *ClientIdentifierSpec {
 	return nil
 }
 
-// DNSResolveCacheSpec describes DNS servers status.
-type DNSResolveCacheSpec struct {
+func (x *DHCP6OperatorSpec) GetPrefixDelegation() bool {
+	if x != nil {
+		return x.PrefixDelegation
+	}
+	return false
+}
+
+func (x *DHCP6OperatorSpec) GetPrefixLength() uint32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+func (x *DHCP6OperatorSpec) GetPrefixDownstreams() []*DHCP6PrefixDownstreamSpec {
+	if x != nil {
+		return x.PrefixDownstreams
+	}
+	return nil
+}
+
+// DHCP6PrefixDownstreamSpec describes a downstream link of the delegated prefix.
+type DHCP6PrefixDownstreamSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	LinkName      string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	SubnetId      uint32                 `protobuf:"varint,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DNSResolveCacheSpec) Reset() {
-	*x = DNSResolveCacheSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
+func (x *DHCP6PrefixDownstreamSpec) Reset() {
+	*x = DHCP6PrefixDownstreamSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DNSResolveCacheSpec) String() string {
+func (x *DHCP6PrefixDownstreamSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DNSResolveCacheSpec) ProtoMessage() {}
+func (*DHCP6PrefixDownstreamSpec) ProtoMessage() {}
 
-func (x *DNSResolveCacheSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[15]
+func (x *DHCP6PrefixDownstreamSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,44 +1784,50 @@ func (x *DNSResolveCacheSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DNSResolveCacheSpec.ProtoReflect.Descriptor instead.
-func (*DNSResolveCacheSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DHCP6PrefixDownstreamSpec.ProtoReflect.Descriptor instead.
+func (*DHCP6PrefixDownstreamSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DNSResolveCacheSpec) GetStatus() string {
+func (x *DHCP6PrefixDownstreamSpec) GetLinkName() string {
 	if x != nil {
-		return x.Status
+		return x.LinkName
 	}
 	return ""
 }
 
-// EthernetChannelsSpec describes config of Ethernet channels.
-type EthernetChannelsSpec struct {
+func (x *DHCP6PrefixDownstreamSpec) GetSubnetId() uint32 {
+	if x != nil {
+		return x.SubnetId
+	}
+	return 0
+}
+
+// DHCPOption describes a single raw DHCP option.
+type DHCPOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
-	Tx            uint32                 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
-	Other         uint32                 `protobuf:"varint,3,opt,name=other,proto3" json:"other,omitempty"`
-	Combined      uint32                 `protobuf:"varint,4,opt,name=combined,proto3" json:"combined,omitempty"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetChannelsSpec) Reset() {
-	*x = EthernetChannelsSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
+func (x *DHCPOption) Reset() {
+	*x = DHCPOption{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetChannelsSpec) String() string {
+func (x *DHCPOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetChannelsSpec) ProtoMessage() {}
+func (*DHCPOption) ProtoMessage() {}
 
-func (x *EthernetChannelsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[16]
+func (x *DHCPOption) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,71 +1838,57 @@ func (x *EthernetChannelsSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetChannelsSpec.ProtoReflect.Descriptor instead.
-func (*EthernetChannelsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *EthernetChannelsSpec) GetRx() uint32 {
-	if x != nil {
-		return x.Rx
-	}
-	return 0
+// Deprecated: Use DHCPOption.ProtoReflect.Descriptor instead.
+func (*DHCPOption) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EthernetChannelsSpec) GetTx() uint32 {
+func (x *DHCPOption) GetCode() uint32 {
 	if x != nil {
-		return x.Tx
+		return x.Code
 	}
 	return 0
 }
 
-func (x *EthernetChannelsSpec) GetOther() uint32 {
+func (x *DHCPOption) GetValue() string {
 	if x != nil {
-		return x.Other
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetChannelsSpec) GetCombined() uint32 {
+func (x *DHCPOption) GetText() string {
 	if x != nil {
-		return x.Combined
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-// EthernetChannelsStatus describes status of Ethernet channels.
-type EthernetChannelsStatus struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Read-only settings.
-	RxMax       uint32 `protobuf:"varint,1,opt,name=rx_max,json=rxMax,proto3" json:"rx_max,omitempty"`
-	TxMax       uint32 `protobuf:"varint,2,opt,name=tx_max,json=txMax,proto3" json:"tx_max,omitempty"`
-	OtherMax    uint32 `protobuf:"varint,3,opt,name=other_max,json=otherMax,proto3" json:"other_max,omitempty"`
-	CombinedMax uint32 `protobuf:"varint,4,opt,name=combined_max,json=combinedMax,proto3" json:"combined_max,omitempty"`
-	// Current settings (read-write).
-	Rx            uint32 `protobuf:"varint,5,opt,name=rx,proto3" json:"rx,omitempty"`
-	Tx            uint32 `protobuf:"varint,6,opt,name=tx,proto3" json:"tx,omitempty"`
-	Other         uint32 `protobuf:"varint,7,opt,name=other,proto3" json:"other,omitempty"`
-	Combined      uint32 `protobuf:"varint,8,opt,name=combined,proto3" json:"combined,omitempty"`
+// DHCPOptionsSpec describes the raw options received from the DHCP server.
+type DHCPOptionsSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkName      string                 `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Operator      enums.NetworkOperator  `protobuf:"varint,2,opt,name=operator,proto3,enum=talos.resource.definitions.enums.NetworkOperator" json:"operator,omitempty"`
+	Options       []*DHCPOption          `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetChannelsStatus) Reset() {
-	*x = EthernetChannelsStatus{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
+func (x *DHCPOptionsSpec) Reset() {
+	*x = DHCPOptionsSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetChannelsStatus) String() string {
+func (x *DHCPOptionsSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetChannelsStatus) ProtoMessage() {}
+func (*DHCPOptionsSpec) ProtoMessage() {}
 
-func (x *EthernetChannelsStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[17]
+func (x *DHCPOptionsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,91 +1899,105 @@ func (x *EthernetChannelsStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetChannelsStatus.ProtoReflect.Descriptor instead.
-func (*EthernetChannelsStatus) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DHCPOptionsSpec.ProtoReflect.Descriptor instead.
+func (*DHCPOptionsSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EthernetChannelsStatus) GetRxMax() uint32 {
+func (x *DHCPOptionsSpec) GetLinkName() string {
 	if x != nil {
-		return x.RxMax
+		return x.LinkName
 	}
-	return 0
+	return ""
 }
 
-func (x *EthernetChannelsStatus) GetTxMax() uint32 {
+func (x *DHCPOptionsSpec) GetOperator() enums.NetworkOperator {
 	if x != nil {
-		return x.TxMax
+		return x.Operator
 	}
-	return 0
+	return enums.NetworkOperator(0)
 }
 
-func (x *EthernetChannelsStatus) GetOtherMax() uint32 {
+func (x *DHCPOptionsSpec) GetOptions() []*DHCPOption {
 	if x != nil {
-		return x.OtherMax
+		return x.Options
 	}
-	return 0
+	return nil
 }
 
-func (x *EthernetChannelsStatus) GetCombinedMax() uint32 {
-	if x != nil {
-		return x.CombinedMax
-	}
-	return 0
+// DNSResolveCacheSpec describes DNS servers status.
+type DNSResolveCacheSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EthernetChannelsStatus) GetRx() uint32 {
-	if x != nil {
-		return x.Rx
-	}
-	return 0
+func (x *DNSResolveCacheSpec) Reset() {
+	*x = DNSResolveCacheSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetChannelsStatus) GetTx() uint32 {
-	if x != nil {
-		return x.Tx
-	}
-	return 0
+func (x *DNSResolveCacheSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EthernetChannelsStatus) GetOther() uint32 {
+func (*DNSResolveCacheSpec) ProtoMessage() {}
+
+func (x *DNSResolveCacheSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[22]
 	if x != nil {
-		return x.Other
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *EthernetChannelsStatus) GetCombined() uint32 {
+// Deprecated: Use DNSResolveCacheSpec.ProtoReflect.Descriptor instead.
+func (*DNSResolveCacheSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DNSResolveCacheSpec) GetStatus() string {
 	if x != nil {
-		return x.Combined
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-// EthernetFeatureStatus describes status of Ethernet features.
-type EthernetFeatureStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DelegatedPrefixSpec describes a subnet of the DHCPv6 delegated prefix assigned to a downstream link.
+type DelegatedPrefixSpec struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DelegatedPrefix  *common.NetIPPrefix    `protobuf:"bytes,1,opt,name=delegated_prefix,json=delegatedPrefix,proto3" json:"delegated_prefix,omitempty"`
+	Prefix           *common.NetIPPrefix    `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	LinkName         string                 `protobuf:"bytes,3,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	UpstreamLinkName string                 `protobuf:"bytes,4,opt,name=upstream_link_name,json=upstreamLinkName,proto3" json:"upstream_link_name,omitempty"`
+	PreferredUntil   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=preferred_until,json=preferredUntil,proto3" json:"preferred_until,omitempty"`
+	ValidUntil       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *EthernetFeatureStatus) Reset() {
-	*x = EthernetFeatureStatus{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
+func (x *DelegatedPrefixSpec) Reset() {
+	*x = DelegatedPrefixSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EthernetFeatureStatus) String() string {
+func (x *DelegatedPrefixSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetFeatureStatus) ProtoMessage() {}
+func (*DelegatedPrefixSpec) ProtoMessage() {}
 
-func (x *EthernetFeatureStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[18]
+func (x *DelegatedPrefixSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1653,27 +2008,280 @@ func (x *EthernetFeatureStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetFeatureStatus.ProtoReflect.Descriptor instead.
-func (*EthernetFeatureStatus) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DelegatedPrefixSpec.ProtoReflect.Descriptor instead.
+func (*DelegatedPrefixSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *EthernetFeatureStatus) GetName() string {
+func (x *DelegatedPrefixSpec) GetDelegatedPrefix() *common.NetIPPrefix {
 	if x != nil {
-		return x.Name
+		return x.DelegatedPrefix
 	}
-	return ""
+	return nil
 }
 
-func (x *EthernetFeatureStatus) GetStatus() string {
+func (x *DelegatedPrefixSpec) GetPrefix() *common.NetIPPrefix {
 	if x != nil {
-		return x.Status
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *DelegatedPrefixSpec) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
 	}
 	return ""
 }
 
-// EthernetRingsSpec describes config of Ethernet rings.
-type EthernetRingsSpec struct {
+func (x *DelegatedPrefixSpec) GetUpstreamLinkName() string {
+	if x != nil {
+		return x.UpstreamLinkName
+	}
+	return ""
+}
+
+func (x *DelegatedPrefixSpec) GetPreferredUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreferredUntil
+	}
+	return nil
+}
+
+func (x *DelegatedPrefixSpec) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+// EthernetChannelsSpec describes config of Ethernet channels.
+type EthernetChannelsSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
+	Tx            uint32                 `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Other         uint32                 `protobuf:"varint,3,opt,name=other,proto3" json:"other,omitempty"`
+	Combined      uint32                 `protobuf:"varint,4,opt,name=combined,proto3" json:"combined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EthernetChannelsSpec) Reset() {
+	*x = EthernetChannelsSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EthernetChannelsSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthernetChannelsSpec) ProtoMessage() {}
+
+func (x *EthernetChannelsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthernetChannelsSpec.ProtoReflect.Descriptor instead.
+func (*EthernetChannelsSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EthernetChannelsSpec) GetRx() uint32 {
+	if x != nil {
+		return x.Rx
+	}
+	return 0
+}
+
+func (x *EthernetChannelsSpec) GetTx() uint32 {
+	if x != nil {
+		return x.Tx
+	}
+	return 0
+}
+
+func (x *EthernetChannelsSpec) GetOther() uint32 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
+}
+
+func (x *EthernetChannelsSpec) GetCombined() uint32 {
+	if x != nil {
+		return x.Combined
+	}
+	return 0
+}
+
+// EthernetChannelsStatus describes status of Ethernet channels.
+type EthernetChannelsStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Read-only settings.
+	RxMax       uint32 `protobuf:"varint,1,opt,name=rx_max,json=rxMax,proto3" json:"rx_max,omitempty"`
+	TxMax       uint32 `protobuf:"varint,2,opt,name=tx_max,json=txMax,proto3" json:"tx_max,omitempty"`
+	OtherMax    uint32 `protobuf:"varint,3,opt,name=other_max,json=otherMax,proto3" json:"other_max,omitempty"`
+	CombinedMax uint32 `protobuf:"varint,4,opt,name=combined_max,json=combinedMax,proto3" json:"combined_max,omitempty"`
+	// Current settings (read-write).
+	Rx            uint32 `protobuf:"varint,5,opt,name=rx,proto3" json:"rx,omitempty"`
+	Tx            uint32 `protobuf:"varint,6,opt,name=tx,proto3" json:"tx,omitempty"`
+	Other         uint32 `protobuf:"varint,7,opt,name=other,proto3" json:"other,omitempty"`
+	Combined      uint32 `protobuf:"varint,8,opt,name=combined,proto3" json:"combined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EthernetChannelsStatus) Reset() {
+	*x = EthernetChannelsStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EthernetChannelsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthernetChannelsStatus) ProtoMessage() {}
+
+func (x *EthernetChannelsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthernetChannelsStatus.ProtoReflect.Descriptor instead.
+func (*EthernetChannelsStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EthernetChannelsStatus) GetRxMax() uint32 {
+	if x != nil {
+		return x.RxMax
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetTxMax() uint32 {
+	if x != nil {
+		return x.TxMax
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetOtherMax() uint32 {
+	if x != nil {
+		return x.OtherMax
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetCombinedMax() uint32 {
+	if x != nil {
+		return x.CombinedMax
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetRx() uint32 {
+	if x != nil {
+		return x.Rx
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetTx() uint32 {
+	if x != nil {
+		return x.Tx
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetOther() uint32 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
+}
+
+func (x *EthernetChannelsStatus) GetCombined() uint32 {
+	if x != nil {
+		return x.Combined
+	}
+	return 0
+}
+
+// EthernetFeatureStatus describes status of Ethernet features.
+type EthernetFeatureStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EthernetFeatureStatus) Reset() {
+	*x = EthernetFeatureStatus{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EthernetFeatureStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthernetFeatureStatus) ProtoMessage() {}
+
+func (x *EthernetFeatureStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthernetFeatureStatus.ProtoReflect.Descriptor instead.
+func (*EthernetFeatureStatus) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EthernetFeatureStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EthernetFeatureStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// EthernetRingsSpec describes config of Ethernet rings.
+type EthernetRingsSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rx            uint32                 `protobuf:"varint,1,opt,name=rx,proto3" json:"rx,omitempty"`
 	RxMini        uint32                 `protobuf:"varint,2,opt,name=rx_mini,json=rxMini,proto3" json:"rx_mini,omitempty"`
@@ -1691,7 +2299,7 @@ type EthernetRingsSpec struct {
 
 func (x *EthernetRingsSpec) Reset() {
 	*x = EthernetRingsSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1703,7 +2311,7 @@ func (x *EthernetRingsSpec) String() string {
 func (*EthernetRingsSpec) ProtoMessage() {}
 
 func (x *EthernetRingsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[19]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +2324,7 @@ func (x *EthernetRingsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EthernetRingsSpec.ProtoReflect.Descriptor instead.
 func (*EthernetRingsSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{19}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *EthernetRingsSpec) GetRx() uint32 {
@@ -1815,7 +2423,7 @@ type EthernetRingsStatus struct {
 
 func (x *EthernetRingsStatus) Reset() {
 	*x = EthernetRingsStatus{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[20]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +2435,7 @@ func (x *EthernetRingsStatus) String() string {
 func (*EthernetRingsStatus) ProtoMessage() {}
 
 func (x *EthernetRingsStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[20]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2448,7 @@ func (x *EthernetRingsStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EthernetRingsStatus.ProtoReflect.Descriptor instead.
 func (*EthernetRingsStatus) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{20}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *EthernetRingsStatus) GetRxMax() uint32 {
@@ -1961,7 +2569,7 @@ type EthernetSpecSpec struct {
 
 func (x *EthernetSpecSpec) Reset() {
 	*x = EthernetSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[21]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1973,7 +2581,7 @@ func (x *EthernetSpecSpec) String() string {
 func (*EthernetSpecSpec) ProtoMessage() {}
 
 func (x *EthernetSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[21]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,7 +2594,7 @@ func (x *EthernetSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EthernetSpecSpec.ProtoReflect.Descriptor instead.
 func (*EthernetSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{21}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *EthernetSpecSpec) GetRings() *EthernetRingsSpec {
@@ -2036,7 +2644,7 @@ type EthernetStatusSpec struct {
 
 func (x *EthernetStatusSpec) Reset() {
 	*x = EthernetStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[22]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2048,7 +2656,7 @@ func (x *EthernetStatusSpec) String() string {
 func (*EthernetStatusSpec) ProtoMessage() {}
 
 func (x *EthernetStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[22]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,7 +2669,7 @@ func (x *EthernetStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EthernetStatusSpec.ProtoReflect.Descriptor instead.
 func (*EthernetStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{22}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *EthernetStatusSpec) GetLinkState() bool {
@@ -2134,6 +2742,145 @@ func (x *EthernetStatusSpec) GetWakeOnLan() []enums.NethelpersWOLMode {
 	return nil
 }
 
+// GRESpec describes GRE settings if Kind is one of "gre", "gretap", "ip6gre" or "ip6gretap".
+type GRESpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Local is the source address of the tunnel, might be unset.
+	Local *common.NetIP `protobuf:"bytes,1,opt,name=local,proto3" json:"local,omitempty"`
+	// Remote is the remote tunnel endpoint.
+	Remote *common.NetIP `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	// Key is the GRE key used in both directions, zero means no key.
+	Key uint32 `protobuf:"varint,3,opt,name=key,proto3" json:"key,omitempty"`
+	// LinkIndex is the index of the underlay link.
+	//
+	// It is filled in by the link spec controller from the ParentName before the link is created.
+	LinkIndex     uint32 `protobuf:"varint,4,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GRESpec) Reset() {
+	*x = GRESpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRESpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRESpec) ProtoMessage() {}
+
+func (x *GRESpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRESpec.ProtoReflect.Descriptor instead.
+func (*GRESpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GRESpec) GetLocal() *common.NetIP {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *GRESpec) GetRemote() *common.NetIP {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+func (x *GRESpec) GetKey() uint32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *GRESpec) GetLinkIndex() uint32 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+// GeneveSpec describes Geneve settings if Kind == "geneve".
+type GeneveSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VNI is the Geneve network identifier.
+	Vni uint32 `protobuf:"varint,1,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Remote is the remote tunnel endpoint.
+	Remote *common.NetIP `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	// Port is the destination UDP port.
+	Port          uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneveSpec) Reset() {
+	*x = GeneveSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneveSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneveSpec) ProtoMessage() {}
+
+func (x *GeneveSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneveSpec.ProtoReflect.Descriptor instead.
+func (*GeneveSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GeneveSpec) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *GeneveSpec) GetRemote() *common.NetIP {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+func (x *GeneveSpec) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
 // HTTPProbeSpec describes the HTTP Probe.
 type HTTPProbeSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2147,7 +2894,7 @@ type HTTPProbeSpec struct {
 
 func (x *HTTPProbeSpec) Reset() {
 	*x = HTTPProbeSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[23]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2159,7 +2906,7 @@ func (x *HTTPProbeSpec) String() string {
 func (*HTTPProbeSpec) ProtoMessage() {}
 
 func (x *HTTPProbeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[23]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2919,7 @@ func (x *HTTPProbeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPProbeSpec.ProtoReflect.Descriptor instead.
 func (*HTTPProbeSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{23}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *HTTPProbeSpec) GetUrl() *common.URL {
@@ -2202,7 +2949,7 @@ type HardwareAddrSpec struct {
 
 func (x *HardwareAddrSpec) Reset() {
 	*x = HardwareAddrSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2214,7 +2961,7 @@ func (x *HardwareAddrSpec) String() string {
 func (*HardwareAddrSpec) ProtoMessage() {}
 
 func (x *HardwareAddrSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[24]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2227,7 +2974,7 @@ func (x *HardwareAddrSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HardwareAddrSpec.ProtoReflect.Descriptor instead.
 func (*HardwareAddrSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{24}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *HardwareAddrSpec) GetName() string {
@@ -2246,19 +2993,24 @@ func (x *HardwareAddrSpec) GetHardwareAddr() []byte {
 
 // HostDNSConfigSpec describes host DNS config.
 type HostDNSConfigSpec struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Enabled                 bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ListenAddresses         []*common.NetIPPort    `protobuf:"bytes,2,rep,name=listen_addresses,json=listenAddresses,proto3" json:"listen_addresses,omitempty"`
-	ServiceHostDnsAddress   *common.NetIP          `protobuf:"bytes,3,opt,name=service_host_dns_address,json=serviceHostDnsAddress,proto3" json:"service_host_dns_address,omitempty"`
-	ResolveMemberNames      bool                   `protobuf:"varint,4,opt,name=resolve_member_names,json=resolveMemberNames,proto3" json:"resolve_member_names,omitempty"`
-	ServiceHostDnsAddressV6 *common.NetIP          `protobuf:"bytes,5,opt,name=service_host_dns_address_v6,json=serviceHostDnsAddressV6,proto3" json:"service_host_dns_address_v6,omitempty"`
+	state                   protoimpl.MessageState  `protogen:"open.v1"`
+	Enabled                 bool                    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ListenAddresses         []*common.NetIPPort     `protobuf:"bytes,2,rep,name=listen_addresses,json=listenAddresses,proto3" json:"listen_addresses,omitempty"`
+	ServiceHostDnsAddress   *common.NetIP           `protobuf:"bytes,3,opt,name=service_host_dns_address,json=serviceHostDnsAddress,proto3" json:"service_host_dns_address,omitempty"`
+	ResolveMemberNames      bool                    `protobuf:"varint,4,opt,name=resolve_member_names,json=resolveMemberNames,proto3" json:"resolve_member_names,omitempty"`
+	ServiceHostDnsAddressV6 *common.NetIP           `protobuf:"bytes,5,opt,name=service_host_dns_address_v6,json=serviceHostDnsAddressV6,proto3" json:"service_host_dns_address_v6,omitempty"`
+	Forwarders              []*HostDNSForwarderSpec `protobuf:"bytes,6,rep,name=forwarders,proto3" json:"forwarders,omitempty"`
+	QueryLog                bool                    `protobuf:"varint,7,opt,name=query_log,json=queryLog,proto3" json:"query_log,omitempty"`
+	NegativeCacheSize       int64                   `protobuf:"varint,8,opt,name=negative_cache_size,json=negativeCacheSize,proto3" json:"negative_cache_size,omitempty"`
+	NegativeCacheMinTtl     *durationpb.Duration    `protobuf:"bytes,9,opt,name=negative_cache_min_ttl,json=negativeCacheMinTtl,proto3" json:"negative_cache_min_ttl,omitempty"`
+	NegativeCacheMaxTtl     *durationpb.Duration    `protobuf:"bytes,10,opt,name=negative_cache_max_ttl,json=negativeCacheMaxTtl,proto3" json:"negative_cache_max_ttl,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
 func (x *HostDNSConfigSpec) Reset() {
 	*x = HostDNSConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2269,8 +3021,117 @@ func (x *HostDNSConfigSpec) String() string {
 
 func (*HostDNSConfigSpec) ProtoMessage() {}
 
-func (x *HostDNSConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[25]
+func (x *HostDNSConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostDNSConfigSpec.ProtoReflect.Descriptor instead.
+func (*HostDNSConfigSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HostDNSConfigSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *HostDNSConfigSpec) GetListenAddresses() []*common.NetIPPort {
+	if x != nil {
+		return x.ListenAddresses
+	}
+	return nil
+}
+
+func (x *HostDNSConfigSpec) GetServiceHostDnsAddress() *common.NetIP {
+	if x != nil {
+		return x.ServiceHostDnsAddress
+	}
+	return nil
+}
+
+func (x *HostDNSConfigSpec) GetResolveMemberNames() bool {
+	if x != nil {
+		return x.ResolveMemberNames
+	}
+	return false
+}
+
+func (x *HostDNSConfigSpec) GetServiceHostDnsAddressV6() *common.NetIP {
+	if x != nil {
+		return x.ServiceHostDnsAddressV6
+	}
+	return nil
+}
+
+func (x *HostDNSConfigSpec) GetForwarders() []*HostDNSForwarderSpec {
+	if x != nil {
+		return x.Forwarders
+	}
+	return nil
+}
+
+func (x *HostDNSConfigSpec) GetQueryLog() bool {
+	if x != nil {
+		return x.QueryLog
+	}
+	return false
+}
+
+func (x *HostDNSConfigSpec) GetNegativeCacheSize() int64 {
+	if x != nil {
+		return x.NegativeCacheSize
+	}
+	return 0
+}
+
+func (x *HostDNSConfigSpec) GetNegativeCacheMinTtl() *durationpb.Duration {
+	if x != nil {
+		return x.NegativeCacheMinTtl
+	}
+	return nil
+}
+
+func (x *HostDNSConfigSpec) GetNegativeCacheMaxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.NegativeCacheMaxTtl
+	}
+	return nil
+}
+
+// HostDNSForwarderSpec describes a host DNS per-domain forwarder.
+type HostDNSForwarderSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []string               `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	NameServers   []*NameServerSpec      `protobuf:"bytes,2,rep,name=name_servers,json=nameServers,proto3" json:"name_servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostDNSForwarderSpec) Reset() {
+	*x = HostDNSForwarderSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostDNSForwarderSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostDNSForwarderSpec) ProtoMessage() {}
+
+func (x *HostDNSForwarderSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,42 +3142,21 @@ func (x *HostDNSConfigSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostDNSConfigSpec.ProtoReflect.Descriptor instead.
-func (*HostDNSConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *HostDNSConfigSpec) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *HostDNSConfigSpec) GetListenAddresses() []*common.NetIPPort {
-	if x != nil {
-		return x.ListenAddresses
-	}
-	return nil
+// Deprecated: Use HostDNSForwarderSpec.ProtoReflect.Descriptor instead.
+func (*HostDNSForwarderSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *HostDNSConfigSpec) GetServiceHostDnsAddress() *common.NetIP {
+func (x *HostDNSForwarderSpec) GetDomains() []string {
 	if x != nil {
-		return x.ServiceHostDnsAddress
+		return x.Domains
 	}
 	return nil
 }
 
-func (x *HostDNSConfigSpec) GetResolveMemberNames() bool {
-	if x != nil {
-		return x.ResolveMemberNames
-	}
-	return false
-}
-
-func (x *HostDNSConfigSpec) GetServiceHostDnsAddressV6() *common.NetIP {
+func (x *HostDNSForwarderSpec) GetNameServers() []*NameServerSpec {
 	if x != nil {
-		return x.ServiceHostDnsAddressV6
+		return x.NameServers
 	}
 	return nil
 }
@@ -2333,7 +3173,7 @@ type HostnameSpecSpec struct {
 
 func (x *HostnameSpecSpec) Reset() {
 	*x = HostnameSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2345,7 +3185,7 @@ func (x *HostnameSpecSpec) String() string {
 func (*HostnameSpecSpec) ProtoMessage() {}
 
 func (x *HostnameSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[26]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2358,7 +3198,7 @@ func (x *HostnameSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostnameSpecSpec.ProtoReflect.Descriptor instead.
 func (*HostnameSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{26}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *HostnameSpecSpec) GetHostname() string {
@@ -2393,7 +3233,7 @@ type HostnameStatusSpec struct {
 
 func (x *HostnameStatusSpec) Reset() {
 	*x = HostnameStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +3245,7 @@ func (x *HostnameStatusSpec) String() string {
 func (*HostnameStatusSpec) ProtoMessage() {}
 
 func (x *HostnameStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[27]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +3258,7 @@ func (x *HostnameStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostnameStatusSpec.ProtoReflect.Descriptor instead.
 func (*HostnameStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{27}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *HostnameStatusSpec) GetHostname() string {
@@ -2435,6 +3275,52 @@ func (x *HostnameStatusSpec) GetDomainname() string {
 	return ""
 }
 
+// IPVLANSpec describes IPVLAN settings if Kind == "ipvlan".
+type IPVLANSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mode is the IPVLAN mode.
+	Mode          enums.NethelpersIPVLANMode `protobuf:"varint,1,opt,name=mode,proto3,enum=talos.resource.definitions.enums.NethelpersIPVLANMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPVLANSpec) Reset() {
+	*x = IPVLANSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPVLANSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPVLANSpec) ProtoMessage() {}
+
+func (x *IPVLANSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPVLANSpec.ProtoReflect.Descriptor instead.
+func (*IPVLANSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *IPVLANSpec) GetMode() enums.NethelpersIPVLANMode {
+	if x != nil {
+		return x.Mode
+	}
+	return enums.NethelpersIPVLANMode(0)
+}
+
 // LinkAliasSpecSpec describes status of rendered secrets.
 type LinkAliasSpecSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2445,7 +3331,7 @@ type LinkAliasSpecSpec struct {
 
 func (x *LinkAliasSpecSpec) Reset() {
 	*x = LinkAliasSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2457,7 +3343,7 @@ func (x *LinkAliasSpecSpec) String() string {
 func (*LinkAliasSpecSpec) ProtoMessage() {}
 
 func (x *LinkAliasSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[28]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,7 +3356,7 @@ func (x *LinkAliasSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkAliasSpecSpec.ProtoReflect.Descriptor instead.
 func (*LinkAliasSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{28}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *LinkAliasSpecSpec) GetAlias() string {
@@ -2490,7 +3376,7 @@ type LinkRefreshSpec struct {
 
 func (x *LinkRefreshSpec) Reset() {
 	*x = LinkRefreshSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2502,7 +3388,7 @@ func (x *LinkRefreshSpec) String() string {
 func (*LinkRefreshSpec) ProtoMessage() {}
 
 func (x *LinkRefreshSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[29]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2515,7 +3401,7 @@ func (x *LinkRefreshSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkRefreshSpec.ProtoReflect.Descriptor instead.
 func (*LinkRefreshSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{29}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *LinkRefreshSpec) GetGeneration() int64 {
@@ -2541,7 +3427,7 @@ type LinkSpecSpec struct {
 	// Kind and Type are only required for Logical interfaces.
 	Kind string                   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
 	Type enums.NethelpersLinkType `protobuf:"varint,6,opt,name=type,proto3,enum=talos.resource.definitions.enums.NethelpersLinkType" json:"type,omitempty"`
-	// ParentName indicates link parent for VLAN interfaces.
+	// ParentName indicates link parent for VLAN, MACVLAN and IPVLAN interfaces, or the underlay link for tunnel interfaces.
 	ParentName string `protobuf:"bytes,7,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
 	// BondSlave contains bond slave configuration for interfaces enslaved to a bond.
 	BondSlave *BondSlave `protobuf:"bytes,8,opt,name=bond_slave,json=bondSlave,proto3" json:"bond_slave,omitempty"`
@@ -2560,15 +3446,20 @@ type LinkSpecSpec struct {
 	Multicast bool           `protobuf:"varint,16,opt,name=multicast,proto3" json:"multicast,omitempty"`
 	VrfMaster *VRFMasterSpec `protobuf:"bytes,17,opt,name=vrf_master,json=vrfMaster,proto3" json:"vrf_master,omitempty"`
 	// VRFSlave carries VRF slave details for interfaces in a VRF.
-	VrfSlave      *VRFSlave `protobuf:"bytes,18,opt,name=vrf_slave,json=vrfSlave,proto3" json:"vrf_slave,omitempty"`
-	Veth          *VethSpec `protobuf:"bytes,19,opt,name=veth,proto3" json:"veth,omitempty"`
+	VrfSlave      *VRFSlave    `protobuf:"bytes,18,opt,name=vrf_slave,json=vrfSlave,proto3" json:"vrf_slave,omitempty"`
+	Veth          *VethSpec    `protobuf:"bytes,19,opt,name=veth,proto3" json:"veth,omitempty"`
+	Vxlan         *VXLANSpec   `protobuf:"bytes,20,opt,name=vxlan,proto3" json:"vxlan,omitempty"`
+	Geneve        *GeneveSpec  `protobuf:"bytes,21,opt,name=geneve,proto3" json:"geneve,omitempty"`
+	Gre           *GRESpec     `protobuf:"bytes,22,opt,name=gre,proto3" json:"gre,omitempty"`
+	Macvlan       *MACVLANSpec `protobuf:"bytes,23,opt,name=macvlan,proto3" json:"macvlan,omitempty"`
+	Ipvlan        *IPVLANSpec  `protobuf:"bytes,24,opt,name=ipvlan,proto3" json:"ipvlan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LinkSpecSpec) Reset() {
 	*x = LinkSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2580,7 +3471,7 @@ func (x *LinkSpecSpec) String() string {
 func (*LinkSpecSpec) ProtoMessage() {}
 
 func (x *LinkSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[30]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,7 +3484,7 @@ func (x *LinkSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkSpecSpec.ProtoReflect.Descriptor instead.
 func (*LinkSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{30}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *LinkSpecSpec) GetName() string {
@@ -2729,6 +3620,41 @@ func (x *LinkSpecSpec) GetVeth() *VethSpec {
 	return nil
 }
 
+func (x *LinkSpecSpec) GetVxlan() *VXLANSpec {
+	if x != nil {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetGeneve() *GeneveSpec {
+	if x != nil {
+		return x.Geneve
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetGre() *GRESpec {
+	if x != nil {
+		return x.Gre
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetMacvlan() *MACVLANSpec {
+	if x != nil {
+		return x.Macvlan
+	}
+	return nil
+}
+
+func (x *LinkSpecSpec) GetIpvlan() *IPVLANSpec {
+	if x != nil {
+		return x.Ipvlan
+	}
+	return nil
+}
+
 // LinkStatusSpec describes status of rendered secrets.
 type LinkStatusSpec struct {
 	state            protoimpl.MessageState           `protogen:"open.v1"`
@@ -2769,13 +3695,18 @@ type LinkStatusSpec struct {
 	AltNames      []string       `protobuf:"bytes,32,rep,name=alt_names,json=altNames,proto3" json:"alt_names,omitempty"`
 	VrfMaster     *VRFMasterSpec `protobuf:"bytes,33,opt,name=vrf_master,json=vrfMaster,proto3" json:"vrf_master,omitempty"`
 	Veth          *VethSpec      `protobuf:"bytes,34,opt,name=veth,proto3" json:"veth,omitempty"`
+	Vxlan         *VXLANSpec     `protobuf:"bytes,35,opt,name=vxlan,proto3" json:"vxlan,omitempty"`
+	Geneve        *GeneveSpec    `protobuf:"bytes,36,opt,name=geneve,proto3" json:"geneve,omitempty"`
+	Gre           *GRESpec       `protobuf:"bytes,37,opt,name=gre,proto3" json:"gre,omitempty"`
+	Macvlan       *MACVLANSpec   `protobuf:"bytes,38,opt,name=macvlan,proto3" json:"macvlan,omitempty"`
+	Ipvlan        *IPVLANSpec    `protobuf:"bytes,39,opt,name=ipvlan,proto3" json:"ipvlan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LinkStatusSpec) Reset() {
 	*x = LinkStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2787,7 +3718,7 @@ func (x *LinkStatusSpec) String() string {
 func (*LinkStatusSpec) ProtoMessage() {}
 
 func (x *LinkStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[31]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,7 +3731,7 @@ func (x *LinkStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkStatusSpec.ProtoReflect.Descriptor instead.
 func (*LinkStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{31}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *LinkStatusSpec) GetIndex() uint32 {
@@ -3041,6 +3972,87 @@ func (x *LinkStatusSpec) GetVeth() *VethSpec {
 	return nil
 }
 
+func (x *LinkStatusSpec) GetVxlan() *VXLANSpec {
+	if x != nil {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetGeneve() *GeneveSpec {
+	if x != nil {
+		return x.Geneve
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetGre() *GRESpec {
+	if x != nil {
+		return x.Gre
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetMacvlan() *MACVLANSpec {
+	if x != nil {
+		return x.Macvlan
+	}
+	return nil
+}
+
+func (x *LinkStatusSpec) GetIpvlan() *IPVLANSpec {
+	if x != nil {
+		return x.Ipvlan
+	}
+	return nil
+}
+
+// MACVLANSpec describes MACVLAN settings if Kind == "macvlan".
+type MACVLANSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mode is the MACVLAN mode.
+	Mode          enums.NethelpersMACVLANMode `protobuf:"varint,1,opt,name=mode,proto3,enum=talos.resource.definitions.enums.NethelpersMACVLANMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MACVLANSpec) Reset() {
+	*x = MACVLANSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MACVLANSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MACVLANSpec) ProtoMessage() {}
+
+func (x *MACVLANSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MACVLANSpec.ProtoReflect.Descriptor instead.
+func (*MACVLANSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MACVLANSpec) GetMode() enums.NethelpersMACVLANMode {
+	if x != nil {
+		return x.Mode
+	}
+	return enums.NethelpersMACVLANMode(0)
+}
+
 // NameServerSpec describes a single DNS nameserver with additional configuration.
 type NameServerSpec struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
@@ -3053,7 +4065,7 @@ type NameServerSpec struct {
 
 func (x *NameServerSpec) Reset() {
 	*x = NameServerSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3065,7 +4077,7 @@ func (x *NameServerSpec) String() string {
 func (*NameServerSpec) ProtoMessage() {}
 
 func (x *NameServerSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[32]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3078,7 +4090,7 @@ func (x *NameServerSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NameServerSpec.ProtoReflect.Descriptor instead.
 func (*NameServerSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{32}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *NameServerSpec) GetAddr() *common.NetIP {
@@ -3114,7 +4126,7 @@ type NfTablesAddressMatch struct {
 
 func (x *NfTablesAddressMatch) Reset() {
 	*x = NfTablesAddressMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3126,7 +4138,7 @@ func (x *NfTablesAddressMatch) String() string {
 func (*NfTablesAddressMatch) ProtoMessage() {}
 
 func (x *NfTablesAddressMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[33]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3139,7 +4151,7 @@ func (x *NfTablesAddressMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesAddressMatch.ProtoReflect.Descriptor instead.
 func (*NfTablesAddressMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{33}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *NfTablesAddressMatch) GetIncludeSubnets() []*common.NetIPPrefix {
@@ -3177,7 +4189,7 @@ type NfTablesChainSpec struct {
 
 func (x *NfTablesChainSpec) Reset() {
 	*x = NfTablesChainSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3189,7 +4201,7 @@ func (x *NfTablesChainSpec) String() string {
 func (*NfTablesChainSpec) ProtoMessage() {}
 
 func (x *NfTablesChainSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[34]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3202,7 +4214,7 @@ func (x *NfTablesChainSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesChainSpec.ProtoReflect.Descriptor instead.
 func (*NfTablesChainSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{34}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *NfTablesChainSpec) GetType() string {
@@ -3240,6 +4252,70 @@ func (x *NfTablesChainSpec) GetPolicy() enums.NethelpersNfTablesVerdict {
 	return enums.NethelpersNfTablesVerdict(0)
 }
 
+// NfTablesChainStatusSpec describes the counters of the nftables chain.
+//
+// Only the rules with the anonymous counter are accounted, the dropped packets include the packets
+// dropped by the drop policy of the chain.
+type NfTablesChainStatusSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DroppedPackets uint64                 `protobuf:"varint,1,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"`
+	DroppedBytes   uint64                 `protobuf:"varint,2,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	Rules          []*NfTablesRuleCounter `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NfTablesChainStatusSpec) Reset() {
+	*x = NfTablesChainStatusSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesChainStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesChainStatusSpec) ProtoMessage() {}
+
+func (x *NfTablesChainStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesChainStatusSpec.ProtoReflect.Descriptor instead.
+func (*NfTablesChainStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NfTablesChainStatusSpec) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *NfTablesChainStatusSpec) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+func (x *NfTablesChainStatusSpec) GetRules() []*NfTablesRuleCounter {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 // NfTablesClampMSS describes the TCP MSS clamping operation.
 //
 // MSS is limited by the `MaxMTU` so that:
@@ -3254,7 +4330,7 @@ type NfTablesClampMSS struct {
 
 func (x *NfTablesClampMSS) Reset() {
 	*x = NfTablesClampMSS{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3266,7 +4342,7 @@ func (x *NfTablesClampMSS) String() string {
 func (*NfTablesClampMSS) ProtoMessage() {}
 
 func (x *NfTablesClampMSS) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[35]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3279,7 +4355,7 @@ func (x *NfTablesClampMSS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesClampMSS.ProtoReflect.Descriptor instead.
 func (*NfTablesClampMSS) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{35}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *NfTablesClampMSS) GetMtu() uint32 {
@@ -3299,7 +4375,7 @@ type NfTablesConntrackStateMatch struct {
 
 func (x *NfTablesConntrackStateMatch) Reset() {
 	*x = NfTablesConntrackStateMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3311,7 +4387,7 @@ func (x *NfTablesConntrackStateMatch) String() string {
 func (*NfTablesConntrackStateMatch) ProtoMessage() {}
 
 func (x *NfTablesConntrackStateMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[36]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3324,7 +4400,7 @@ func (x *NfTablesConntrackStateMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesConntrackStateMatch.ProtoReflect.Descriptor instead.
 func (*NfTablesConntrackStateMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{36}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *NfTablesConntrackStateMatch) GetStates() []enums.NethelpersConntrackState {
@@ -3344,7 +4420,7 @@ type NfTablesICMPTypeMatch struct {
 
 func (x *NfTablesICMPTypeMatch) Reset() {
 	*x = NfTablesICMPTypeMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3356,7 +4432,7 @@ func (x *NfTablesICMPTypeMatch) String() string {
 func (*NfTablesICMPTypeMatch) ProtoMessage() {}
 
 func (x *NfTablesICMPTypeMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[37]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3369,7 +4445,7 @@ func (x *NfTablesICMPTypeMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesICMPTypeMatch.ProtoReflect.Descriptor instead.
 func (*NfTablesICMPTypeMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{37}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *NfTablesICMPTypeMatch) GetTypes() []enums.NethelpersICMPType {
@@ -3390,7 +4466,7 @@ type NfTablesIfNameMatch struct {
 
 func (x *NfTablesIfNameMatch) Reset() {
 	*x = NfTablesIfNameMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3402,7 +4478,7 @@ func (x *NfTablesIfNameMatch) String() string {
 func (*NfTablesIfNameMatch) ProtoMessage() {}
 
 func (x *NfTablesIfNameMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[38]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,7 +4491,7 @@ func (x *NfTablesIfNameMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesIfNameMatch.ProtoReflect.Descriptor instead.
 func (*NfTablesIfNameMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{38}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *NfTablesIfNameMatch) GetOperator() enums.NethelpersMatchOperator {
@@ -3445,7 +4521,7 @@ type NfTablesLayer4Match struct {
 
 func (x *NfTablesLayer4Match) Reset() {
 	*x = NfTablesLayer4Match{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3457,7 +4533,7 @@ func (x *NfTablesLayer4Match) String() string {
 func (*NfTablesLayer4Match) ProtoMessage() {}
 
 func (x *NfTablesLayer4Match) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[39]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3470,7 +4546,7 @@ func (x *NfTablesLayer4Match) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesLayer4Match.ProtoReflect.Descriptor instead.
 func (*NfTablesLayer4Match) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{39}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *NfTablesLayer4Match) GetProtocol() enums.NethelpersProtocol {
@@ -3511,7 +4587,7 @@ type NfTablesLimitMatch struct {
 
 func (x *NfTablesLimitMatch) Reset() {
 	*x = NfTablesLimitMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3523,7 +4599,7 @@ func (x *NfTablesLimitMatch) String() string {
 func (*NfTablesLimitMatch) ProtoMessage() {}
 
 func (x *NfTablesLimitMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[40]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3536,7 +4612,7 @@ func (x *NfTablesLimitMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesLimitMatch.ProtoReflect.Descriptor instead.
 func (*NfTablesLimitMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{40}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *NfTablesLimitMatch) GetPacketRatePerSecond() uint64 {
@@ -3564,7 +4640,7 @@ type NfTablesMark struct {
 
 func (x *NfTablesMark) Reset() {
 	*x = NfTablesMark{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3576,7 +4652,7 @@ func (x *NfTablesMark) String() string {
 func (*NfTablesMark) ProtoMessage() {}
 
 func (x *NfTablesMark) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[41]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3589,7 +4665,7 @@ func (x *NfTablesMark) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesMark.ProtoReflect.Descriptor instead.
 func (*NfTablesMark) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{41}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *NfTablesMark) GetMask() uint32 {
@@ -3613,6 +4689,62 @@ func (x *NfTablesMark) GetValue() uint32 {
 	return 0
 }
 
+// NfTablesNAT describes the network address translation operation.
+//
+// NAT is a terminal operation, so the rule should not have a verdict.
+// The source NAT without an address masquerades the traffic (uses the address of the outgoing interface).
+type NfTablesNAT struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *common.NetIP          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Port          uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesNAT) Reset() {
+	*x = NfTablesNAT{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesNAT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesNAT) ProtoMessage() {}
+
+func (x *NfTablesNAT) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesNAT.ProtoReflect.Descriptor instead.
+func (*NfTablesNAT) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *NfTablesNAT) GetAddress() *common.NetIP {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *NfTablesNAT) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
 // NfTablesPortMatch describes the match on the transport layer port.
 type NfTablesPortMatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3623,7 +4755,7 @@ type NfTablesPortMatch struct {
 
 func (x *NfTablesPortMatch) Reset() {
 	*x = NfTablesPortMatch{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3635,7 +4767,7 @@ func (x *NfTablesPortMatch) String() string {
 func (*NfTablesPortMatch) ProtoMessage() {}
 
 func (x *NfTablesPortMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[42]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3648,7 +4780,7 @@ func (x *NfTablesPortMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesPortMatch.ProtoReflect.Descriptor instead.
 func (*NfTablesPortMatch) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{42}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *NfTablesPortMatch) GetRanges() []*PortRange {
@@ -3660,26 +4792,30 @@ func (x *NfTablesPortMatch) GetRanges() []*PortRange {
 
 // NfTablesRule describes a single rule in the nftables chain.
 type NfTablesRule struct {
-	state                   protoimpl.MessageState          `protogen:"open.v1"`
-	MatchOIfName            *NfTablesIfNameMatch            `protobuf:"bytes,1,opt,name=match_o_if_name,json=matchOIfName,proto3" json:"match_o_if_name,omitempty"`
-	Verdict                 enums.NethelpersNfTablesVerdict `protobuf:"varint,2,opt,name=verdict,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesVerdict" json:"verdict,omitempty"`
-	MatchMark               *NfTablesMark                   `protobuf:"bytes,3,opt,name=match_mark,json=matchMark,proto3" json:"match_mark,omitempty"`
-	SetMark                 *NfTablesMark                   `protobuf:"bytes,4,opt,name=set_mark,json=setMark,proto3" json:"set_mark,omitempty"`
-	MatchSourceAddress      *NfTablesAddressMatch           `protobuf:"bytes,5,opt,name=match_source_address,json=matchSourceAddress,proto3" json:"match_source_address,omitempty"`
-	MatchDestinationAddress *NfTablesAddressMatch           `protobuf:"bytes,6,opt,name=match_destination_address,json=matchDestinationAddress,proto3" json:"match_destination_address,omitempty"`
-	MatchLayer4             *NfTablesLayer4Match            `protobuf:"bytes,7,opt,name=match_layer4,json=matchLayer4,proto3" json:"match_layer4,omitempty"`
-	MatchIIfName            *NfTablesIfNameMatch            `protobuf:"bytes,8,opt,name=match_i_if_name,json=matchIIfName,proto3" json:"match_i_if_name,omitempty"`
-	ClampMss                *NfTablesClampMSS               `protobuf:"bytes,9,opt,name=clamp_mss,json=clampMss,proto3" json:"clamp_mss,omitempty"`
-	MatchLimit              *NfTablesLimitMatch             `protobuf:"bytes,10,opt,name=match_limit,json=matchLimit,proto3" json:"match_limit,omitempty"`
-	MatchConntrackState     *NfTablesConntrackStateMatch    `protobuf:"bytes,11,opt,name=match_conntrack_state,json=matchConntrackState,proto3" json:"match_conntrack_state,omitempty"`
-	AnonCounter             bool                            `protobuf:"varint,12,opt,name=anon_counter,json=anonCounter,proto3" json:"anon_counter,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	state                      protoimpl.MessageState              `protogen:"open.v1"`
+	MatchOIfName               *NfTablesIfNameMatch                `protobuf:"bytes,1,opt,name=match_o_if_name,json=matchOIfName,proto3" json:"match_o_if_name,omitempty"`
+	Verdict                    enums.NethelpersNfTablesVerdict     `protobuf:"varint,2,opt,name=verdict,proto3,enum=talos.resource.definitions.enums.NethelpersNfTablesVerdict" json:"verdict,omitempty"`
+	MatchMark                  *NfTablesMark                       `protobuf:"bytes,3,opt,name=match_mark,json=matchMark,proto3" json:"match_mark,omitempty"`
+	SetMark                    *NfTablesMark                       `protobuf:"bytes,4,opt,name=set_mark,json=setMark,proto3" json:"set_mark,omitempty"`
+	MatchSourceAddress         *NfTablesAddressMatch               `protobuf:"bytes,5,opt,name=match_source_address,json=matchSourceAddress,proto3" json:"match_source_address,omitempty"`
+	MatchDestinationAddress    *NfTablesAddressMatch               `protobuf:"bytes,6,opt,name=match_destination_address,json=matchDestinationAddress,proto3" json:"match_destination_address,omitempty"`
+	MatchLayer4                *NfTablesLayer4Match                `protobuf:"bytes,7,opt,name=match_layer4,json=matchLayer4,proto3" json:"match_layer4,omitempty"`
+	MatchIIfName               *NfTablesIfNameMatch                `protobuf:"bytes,8,opt,name=match_i_if_name,json=matchIIfName,proto3" json:"match_i_if_name,omitempty"`
+	ClampMss                   *NfTablesClampMSS                   `protobuf:"bytes,9,opt,name=clamp_mss,json=clampMss,proto3" json:"clamp_mss,omitempty"`
+	MatchLimit                 *NfTablesLimitMatch                 `protobuf:"bytes,10,opt,name=match_limit,json=matchLimit,proto3" json:"match_limit,omitempty"`
+	MatchConntrackState        *NfTablesConntrackStateMatch        `protobuf:"bytes,11,opt,name=match_conntrack_state,json=matchConntrackState,proto3" json:"match_conntrack_state,omitempty"`
+	AnonCounter                bool                                `protobuf:"varint,12,opt,name=anon_counter,json=anonCounter,proto3" json:"anon_counter,omitempty"`
+	SourceNat                  *NfTablesNAT                        `protobuf:"bytes,13,opt,name=source_nat,json=sourceNat,proto3" json:"source_nat,omitempty"`
+	DestinationNat             *NfTablesNAT                        `protobuf:"bytes,14,opt,name=destination_nat,json=destinationNat,proto3" json:"destination_nat,omitempty"`
+	MatchSourceRateLimit       *NfTablesSourceRateLimitMatch       `protobuf:"bytes,15,opt,name=match_source_rate_limit,json=matchSourceRateLimit,proto3" json:"match_source_rate_limit,omitempty"`
+	MatchSourceConnectionLimit *NfTablesSourceConnectionLimitMatch `protobuf:"bytes,16,opt,name=match_source_connection_limit,json=matchSourceConnectionLimit,proto3" json:"match_source_connection_limit,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *NfTablesRule) Reset() {
 	*x = NfTablesRule{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3691,7 +4827,7 @@ func (x *NfTablesRule) String() string {
 func (*NfTablesRule) ProtoMessage() {}
 
 func (x *NfTablesRule) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[43]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3704,7 +4840,7 @@ func (x *NfTablesRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NfTablesRule.ProtoReflect.Descriptor instead.
 func (*NfTablesRule) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{43}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *NfTablesRule) GetMatchOIfName() *NfTablesIfNameMatch {
@@ -3742,53 +4878,277 @@ func (x *NfTablesRule) GetMatchSourceAddress() *NfTablesAddressMatch {
 	return nil
 }
 
-func (x *NfTablesRule) GetMatchDestinationAddress() *NfTablesAddressMatch {
-	if x != nil {
-		return x.MatchDestinationAddress
-	}
-	return nil
+func (x *NfTablesRule) GetMatchDestinationAddress() *NfTablesAddressMatch {
+	if x != nil {
+		return x.MatchDestinationAddress
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchLayer4() *NfTablesLayer4Match {
+	if x != nil {
+		return x.MatchLayer4
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchIIfName() *NfTablesIfNameMatch {
+	if x != nil {
+		return x.MatchIIfName
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetClampMss() *NfTablesClampMSS {
+	if x != nil {
+		return x.ClampMss
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchLimit() *NfTablesLimitMatch {
+	if x != nil {
+		return x.MatchLimit
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchConntrackState() *NfTablesConntrackStateMatch {
+	if x != nil {
+		return x.MatchConntrackState
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetAnonCounter() bool {
+	if x != nil {
+		return x.AnonCounter
+	}
+	return false
+}
+
+func (x *NfTablesRule) GetSourceNat() *NfTablesNAT {
+	if x != nil {
+		return x.SourceNat
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetDestinationNat() *NfTablesNAT {
+	if x != nil {
+		return x.DestinationNat
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchSourceRateLimit() *NfTablesSourceRateLimitMatch {
+	if x != nil {
+		return x.MatchSourceRateLimit
+	}
+	return nil
+}
+
+func (x *NfTablesRule) GetMatchSourceConnectionLimit() *NfTablesSourceConnectionLimitMatch {
+	if x != nil {
+		return x.MatchSourceConnectionLimit
+	}
+	return nil
+}
+
+// NfTablesRuleCounter describes the counter of a single rule in the nftables chain.
+type NfTablesRuleCounter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index of the rule in the NfTablesChain spec.
+	Index         int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Packets       uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes         uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NfTablesRuleCounter) Reset() {
+	*x = NfTablesRuleCounter{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesRuleCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesRuleCounter) ProtoMessage() {}
+
+func (x *NfTablesRuleCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesRuleCounter.ProtoReflect.Descriptor instead.
+func (*NfTablesRuleCounter) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *NfTablesRuleCounter) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NfTablesRuleCounter) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *NfTablesRuleCounter) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+// NfTablesSourceConnectionLimitMatch matches packets from the sources exceeding the number of tracked connections.
+//
+// The connection count is tracked per source prefix of the specified length in a dynamic set (meter).
+type NfTablesSourceConnectionLimitMatch struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxConnections      uint32                 `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	SourcePrefixLength4 int64                  `protobuf:"varint,2,opt,name=source_prefix_length4,json=sourcePrefixLength4,proto3" json:"source_prefix_length4,omitempty"`
+	SourcePrefixLength6 int64                  `protobuf:"varint,3,opt,name=source_prefix_length6,json=sourcePrefixLength6,proto3" json:"source_prefix_length6,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NfTablesSourceConnectionLimitMatch) Reset() {
+	*x = NfTablesSourceConnectionLimitMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesSourceConnectionLimitMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NfTablesSourceConnectionLimitMatch) ProtoMessage() {}
+
+func (x *NfTablesSourceConnectionLimitMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NfTablesSourceConnectionLimitMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesSourceConnectionLimitMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *NfTablesSourceConnectionLimitMatch) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *NfTablesSourceConnectionLimitMatch) GetSourcePrefixLength4() int64 {
+	if x != nil {
+		return x.SourcePrefixLength4
+	}
+	return 0
+}
+
+func (x *NfTablesSourceConnectionLimitMatch) GetSourcePrefixLength6() int64 {
+	if x != nil {
+		return x.SourcePrefixLength6
+	}
+	return 0
+}
+
+// NfTablesSourceRateLimitMatch matches packets from the sources exceeding the packet rate.
+//
+// The rate is tracked per source prefix of the specified length in a dynamic set (meter).
+type NfTablesSourceRateLimitMatch struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PacketRatePerSecond uint64                 `protobuf:"varint,1,opt,name=packet_rate_per_second,json=packetRatePerSecond,proto3" json:"packet_rate_per_second,omitempty"`
+	Burst               uint32                 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	SourcePrefixLength4 int64                  `protobuf:"varint,3,opt,name=source_prefix_length4,json=sourcePrefixLength4,proto3" json:"source_prefix_length4,omitempty"`
+	SourcePrefixLength6 int64                  `protobuf:"varint,4,opt,name=source_prefix_length6,json=sourcePrefixLength6,proto3" json:"source_prefix_length6,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NfTablesSourceRateLimitMatch) Reset() {
+	*x = NfTablesSourceRateLimitMatch{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NfTablesSourceRateLimitMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NfTablesRule) GetMatchLayer4() *NfTablesLayer4Match {
+func (*NfTablesSourceRateLimitMatch) ProtoMessage() {}
+
+func (x *NfTablesSourceRateLimitMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[61]
 	if x != nil {
-		return x.MatchLayer4
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *NfTablesRule) GetMatchIIfName() *NfTablesIfNameMatch {
-	if x != nil {
-		return x.MatchIIfName
-	}
-	return nil
+// Deprecated: Use NfTablesSourceRateLimitMatch.ProtoReflect.Descriptor instead.
+func (*NfTablesSourceRateLimitMatch) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *NfTablesRule) GetClampMss() *NfTablesClampMSS {
+func (x *NfTablesSourceRateLimitMatch) GetPacketRatePerSecond() uint64 {
 	if x != nil {
-		return x.ClampMss
+		return x.PacketRatePerSecond
 	}
-	return nil
+	return 0
 }
 
-func (x *NfTablesRule) GetMatchLimit() *NfTablesLimitMatch {
+func (x *NfTablesSourceRateLimitMatch) GetBurst() uint32 {
 	if x != nil {
-		return x.MatchLimit
+		return x.Burst
 	}
-	return nil
+	return 0
 }
 
-func (x *NfTablesRule) GetMatchConntrackState() *NfTablesConntrackStateMatch {
+func (x *NfTablesSourceRateLimitMatch) GetSourcePrefixLength4() int64 {
 	if x != nil {
-		return x.MatchConntrackState
+		return x.SourcePrefixLength4
 	}
-	return nil
+	return 0
 }
 
-func (x *NfTablesRule) GetAnonCounter() bool {
+func (x *NfTablesSourceRateLimitMatch) GetSourcePrefixLength6() int64 {
 	if x != nil {
-		return x.AnonCounter
+		return x.SourcePrefixLength6
 	}
-	return false
+	return 0
 }
 
 // NodeAddressFilterSpec describes a filter for NodeAddresses.
@@ -3804,7 +5164,7 @@ type NodeAddressFilterSpec struct {
 
 func (x *NodeAddressFilterSpec) Reset() {
 	*x = NodeAddressFilterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3816,7 +5176,7 @@ func (x *NodeAddressFilterSpec) String() string {
 func (*NodeAddressFilterSpec) ProtoMessage() {}
 
 func (x *NodeAddressFilterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[44]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3829,7 +5189,7 @@ func (x *NodeAddressFilterSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAddressFilterSpec.ProtoReflect.Descriptor instead.
 func (*NodeAddressFilterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{44}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *NodeAddressFilterSpec) GetIncludeSubnets() []*common.NetIPPrefix {
@@ -3856,7 +5216,7 @@ type NodeAddressSortAlgorithmSpec struct {
 
 func (x *NodeAddressSortAlgorithmSpec) Reset() {
 	*x = NodeAddressSortAlgorithmSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3868,7 +5228,7 @@ func (x *NodeAddressSortAlgorithmSpec) String() string {
 func (*NodeAddressSortAlgorithmSpec) ProtoMessage() {}
 
 func (x *NodeAddressSortAlgorithmSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[45]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3881,7 +5241,7 @@ func (x *NodeAddressSortAlgorithmSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAddressSortAlgorithmSpec.ProtoReflect.Descriptor instead.
 func (*NodeAddressSortAlgorithmSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{45}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *NodeAddressSortAlgorithmSpec) GetAlgorithm() enums.NethelpersAddressSortAlgorithm {
@@ -3902,7 +5262,7 @@ type NodeAddressSpec struct {
 
 func (x *NodeAddressSpec) Reset() {
 	*x = NodeAddressSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3914,7 +5274,7 @@ func (x *NodeAddressSpec) String() string {
 func (*NodeAddressSpec) ProtoMessage() {}
 
 func (x *NodeAddressSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[46]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3927,7 +5287,7 @@ func (x *NodeAddressSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeAddressSpec.ProtoReflect.Descriptor instead.
 func (*NodeAddressSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{46}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *NodeAddressSpec) GetAddresses() []*common.NetIPPrefix {
@@ -3960,7 +5320,7 @@ type OperatorSpecSpec struct {
 
 func (x *OperatorSpecSpec) Reset() {
 	*x = OperatorSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3972,7 +5332,7 @@ func (x *OperatorSpecSpec) String() string {
 func (*OperatorSpecSpec) ProtoMessage() {}
 
 func (x *OperatorSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[47]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3985,7 +5345,7 @@ func (x *OperatorSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperatorSpecSpec.ProtoReflect.Descriptor instead.
 func (*OperatorSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{47}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *OperatorSpecSpec) GetOperator() enums.NetworkOperator {
@@ -4059,7 +5419,7 @@ type PlatformConfigSpec struct {
 
 func (x *PlatformConfigSpec) Reset() {
 	*x = PlatformConfigSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4071,7 +5431,7 @@ func (x *PlatformConfigSpec) String() string {
 func (*PlatformConfigSpec) ProtoMessage() {}
 
 func (x *PlatformConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[48]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4084,7 +5444,7 @@ func (x *PlatformConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformConfigSpec.ProtoReflect.Descriptor instead.
 func (*PlatformConfigSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{48}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *PlatformConfigSpec) GetAddresses() []*AddressSpecSpec {
@@ -4170,7 +5530,7 @@ type PortRange struct {
 
 func (x *PortRange) Reset() {
 	*x = PortRange{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4182,7 +5542,7 @@ func (x *PortRange) String() string {
 func (*PortRange) ProtoMessage() {}
 
 func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[49]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4195,7 +5555,7 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
 func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{49}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *PortRange) GetLo() uint32 {
@@ -4224,14 +5584,16 @@ type ProbeSpecSpec struct {
 	// Configuration layer.
 	ConfigLayer enums.NetworkConfigLayer `protobuf:"varint,4,opt,name=config_layer,json=configLayer,proto3,enum=talos.resource.definitions.enums.NetworkConfigLayer" json:"config_layer,omitempty"`
 	// HTTP is the HTTP probe spec. One of TCP or HTTP must be specified.
-	Http          *HTTPProbeSpec `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	Http *HTTPProbeSpec `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	// Name of the probe as configured in the machine configuration.
+	Name          string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProbeSpecSpec) Reset() {
 	*x = ProbeSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4243,7 +5605,7 @@ func (x *ProbeSpecSpec) String() string {
 func (*ProbeSpecSpec) ProtoMessage() {}
 
 func (x *ProbeSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[50]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4256,7 +5618,7 @@ func (x *ProbeSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeSpecSpec.ProtoReflect.Descriptor instead.
 func (*ProbeSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{50}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ProbeSpecSpec) GetInterval() *durationpb.Duration {
@@ -4294,20 +5656,29 @@ func (x *ProbeSpecSpec) GetHttp() *HTTPProbeSpec {
 	return nil
 }
 
+func (x *ProbeSpecSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // ProbeStatusSpec describes the Probe.
 type ProbeStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Success of the check.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// Last error of the probe.
-	LastError     string `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastError string `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Name of the probe as configured in the machine configuration.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProbeStatusSpec) Reset() {
 	*x = ProbeStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4319,7 +5690,7 @@ func (x *ProbeStatusSpec) String() string {
 func (*ProbeStatusSpec) ProtoMessage() {}
 
 func (x *ProbeStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[51]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4332,7 +5703,7 @@ func (x *ProbeStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeStatusSpec.ProtoReflect.Descriptor instead.
 func (*ProbeStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{51}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ProbeStatusSpec) GetSuccess() bool {
@@ -4349,6 +5720,13 @@ func (x *ProbeStatusSpec) GetLastError() string {
 	return ""
 }
 
+func (x *ProbeStatusSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // ResolverSpecSpec describes DNS resolvers.
 type ResolverSpecSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -4371,7 +5749,7 @@ type ResolverSpecSpec struct {
 
 func (x *ResolverSpecSpec) Reset() {
 	*x = ResolverSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4383,7 +5761,7 @@ func (x *ResolverSpecSpec) String() string {
 func (*ResolverSpecSpec) ProtoMessage() {}
 
 func (x *ResolverSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[52]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4396,7 +5774,7 @@ func (x *ResolverSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolverSpecSpec.ProtoReflect.Descriptor instead.
 func (*ResolverSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{52}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ResolverSpecSpec) GetDnsServers() []*common.NetIP {
@@ -4450,7 +5828,7 @@ type ResolverStatusSpec struct {
 
 func (x *ResolverStatusSpec) Reset() {
 	*x = ResolverStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4462,7 +5840,7 @@ func (x *ResolverStatusSpec) String() string {
 func (*ResolverStatusSpec) ProtoMessage() {}
 
 func (x *ResolverStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[53]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4475,7 +5853,7 @@ func (x *ResolverStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolverStatusSpec.ProtoReflect.Descriptor instead.
 func (*ResolverStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{53}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ResolverStatusSpec) GetDnsServers() []*common.NetIP {
@@ -4514,7 +5892,7 @@ type RouteNextHop struct {
 
 func (x *RouteNextHop) Reset() {
 	*x = RouteNextHop{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4526,7 +5904,7 @@ func (x *RouteNextHop) String() string {
 func (*RouteNextHop) ProtoMessage() {}
 
 func (x *RouteNextHop) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[54]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4539,7 +5917,7 @@ func (x *RouteNextHop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteNextHop.ProtoReflect.Descriptor instead.
 func (*RouteNextHop) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{54}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *RouteNextHop) GetGateway() *common.NetIP {
@@ -4581,14 +5959,16 @@ type RouteSpecSpec struct {
 	Mtu         uint32                        `protobuf:"varint,13,opt,name=mtu,proto3" json:"mtu,omitempty"`
 	// NextHops, when non-empty, describes a multipath (ECMP) route. The top-level Gateway and
 	// OutLinkName are left unset in that case, mirroring the kernel's RTA_GATEWAY vs RTA_MULTIPATH split.
-	NextHops      []*RouteNextHop `protobuf:"bytes,14,rep,name=next_hops,json=nextHops,proto3" json:"next_hops,omitempty"`
+	NextHops []*RouteNextHop `protobuf:"bytes,14,rep,name=next_hops,json=nextHops,proto3" json:"next_hops,omitempty"`
+	// Probe, when set, withdraws the route while the named probe fails.
+	Probe         string `protobuf:"bytes,15,opt,name=probe,proto3" json:"probe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RouteSpecSpec) Reset() {
 	*x = RouteSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4600,7 +5980,7 @@ func (x *RouteSpecSpec) String() string {
 func (*RouteSpecSpec) ProtoMessage() {}
 
 func (x *RouteSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[55]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4613,7 +5993,7 @@ func (x *RouteSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteSpecSpec.ProtoReflect.Descriptor instead.
 func (*RouteSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{55}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *RouteSpecSpec) GetFamily() enums.NethelpersFamily {
@@ -4714,6 +6094,13 @@ func (x *RouteSpecSpec) GetNextHops() []*RouteNextHop {
 	return nil
 }
 
+func (x *RouteSpecSpec) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
 // RouteStatusSpec describes status of rendered secrets.
 type RouteStatusSpec struct {
 	state        protoimpl.MessageState        `protogen:"open.v1"`
@@ -4738,7 +6125,7 @@ type RouteStatusSpec struct {
 
 func (x *RouteStatusSpec) Reset() {
 	*x = RouteStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4750,7 +6137,7 @@ func (x *RouteStatusSpec) String() string {
 func (*RouteStatusSpec) ProtoMessage() {}
 
 func (x *RouteStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[56]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4763,7 +6150,7 @@ func (x *RouteStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteStatusSpec.ProtoReflect.Descriptor instead.
 func (*RouteStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{56}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *RouteStatusSpec) GetFamily() enums.NethelpersFamily {
@@ -4884,7 +6271,7 @@ type RoutingRuleSpecSpec struct {
 
 func (x *RoutingRuleSpecSpec) Reset() {
 	*x = RoutingRuleSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4896,7 +6283,7 @@ func (x *RoutingRuleSpecSpec) String() string {
 func (*RoutingRuleSpecSpec) ProtoMessage() {}
 
 func (x *RoutingRuleSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[57]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4909,7 +6296,7 @@ func (x *RoutingRuleSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoutingRuleSpecSpec.ProtoReflect.Descriptor instead.
 func (*RoutingRuleSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{57}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *RoutingRuleSpecSpec) GetFamily() enums.NethelpersFamily {
@@ -5008,7 +6395,7 @@ type RoutingRuleStatusSpec struct {
 
 func (x *RoutingRuleStatusSpec) Reset() {
 	*x = RoutingRuleStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[58]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5020,7 +6407,7 @@ func (x *RoutingRuleStatusSpec) String() string {
 func (*RoutingRuleStatusSpec) ProtoMessage() {}
 
 func (x *RoutingRuleStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[58]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5033,7 +6420,7 @@ func (x *RoutingRuleStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoutingRuleStatusSpec.ProtoReflect.Descriptor instead.
 func (*RoutingRuleStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{58}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *RoutingRuleStatusSpec) GetFamily() enums.NethelpersFamily {
@@ -5116,7 +6503,7 @@ type STPSpec struct {
 
 func (x *STPSpec) Reset() {
 	*x = STPSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[59]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5128,7 +6515,7 @@ func (x *STPSpec) String() string {
 func (*STPSpec) ProtoMessage() {}
 
 func (x *STPSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[59]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5141,7 +6528,7 @@ func (x *STPSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use STPSpec.ProtoReflect.Descriptor instead.
 func (*STPSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{59}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *STPSpec) GetEnabled() bool {
@@ -5161,7 +6548,7 @@ type StaticHostSpec struct {
 
 func (x *StaticHostSpec) Reset() {
 	*x = StaticHostSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[60]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5173,7 +6560,7 @@ func (x *StaticHostSpec) String() string {
 func (*StaticHostSpec) ProtoMessage() {}
 
 func (x *StaticHostSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[60]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5186,7 +6573,7 @@ func (x *StaticHostSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticHostSpec.ProtoReflect.Descriptor instead.
 func (*StaticHostSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{60}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *StaticHostSpec) GetAddresses() []*common.NetIP {
@@ -5209,7 +6596,7 @@ type StatusSpec struct {
 
 func (x *StatusSpec) Reset() {
 	*x = StatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[61]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5221,7 +6608,7 @@ func (x *StatusSpec) String() string {
 func (*StatusSpec) ProtoMessage() {}
 
 func (x *StatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[61]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5234,7 +6621,7 @@ func (x *StatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusSpec.ProtoReflect.Descriptor instead.
 func (*StatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{61}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *StatusSpec) GetAddressReady() bool {
@@ -5278,7 +6665,7 @@ type TCPProbeSpec struct {
 
 func (x *TCPProbeSpec) Reset() {
 	*x = TCPProbeSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[62]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5290,7 +6677,7 @@ func (x *TCPProbeSpec) String() string {
 func (*TCPProbeSpec) ProtoMessage() {}
 
 func (x *TCPProbeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[62]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5303,7 +6690,7 @@ func (x *TCPProbeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TCPProbeSpec.ProtoReflect.Descriptor instead.
 func (*TCPProbeSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{62}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *TCPProbeSpec) GetEndpoint() string {
@@ -5332,7 +6719,7 @@ type TimeServerSpecSpec struct {
 
 func (x *TimeServerSpecSpec) Reset() {
 	*x = TimeServerSpecSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[63]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5344,7 +6731,7 @@ func (x *TimeServerSpecSpec) String() string {
 func (*TimeServerSpecSpec) ProtoMessage() {}
 
 func (x *TimeServerSpecSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[63]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5357,7 +6744,7 @@ func (x *TimeServerSpecSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeServerSpecSpec.ProtoReflect.Descriptor instead.
 func (*TimeServerSpecSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{63}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *TimeServerSpecSpec) GetNtpServers() []string {
@@ -5392,7 +6779,7 @@ type TimeServerStatusSpec struct {
 
 func (x *TimeServerStatusSpec) Reset() {
 	*x = TimeServerStatusSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[64]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5404,7 +6791,7 @@ func (x *TimeServerStatusSpec) String() string {
 func (*TimeServerStatusSpec) ProtoMessage() {}
 
 func (x *TimeServerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[64]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5417,7 +6804,7 @@ func (x *TimeServerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeServerStatusSpec.ProtoReflect.Descriptor instead.
 func (*TimeServerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{64}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *TimeServerStatusSpec) GetNtpServers() []string {
@@ -5446,7 +6833,7 @@ type VIPEquinixMetalSpec struct {
 
 func (x *VIPEquinixMetalSpec) Reset() {
 	*x = VIPEquinixMetalSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[65]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5458,7 +6845,7 @@ func (x *VIPEquinixMetalSpec) String() string {
 func (*VIPEquinixMetalSpec) ProtoMessage() {}
 
 func (x *VIPEquinixMetalSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[65]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5471,7 +6858,7 @@ func (x *VIPEquinixMetalSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VIPEquinixMetalSpec.ProtoReflect.Descriptor instead.
 func (*VIPEquinixMetalSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{65}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *VIPEquinixMetalSpec) GetProjectId() string {
@@ -5507,7 +6894,7 @@ type VIPHCloudSpec struct {
 
 func (x *VIPHCloudSpec) Reset() {
 	*x = VIPHCloudSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[66]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5519,7 +6906,7 @@ func (x *VIPHCloudSpec) String() string {
 func (*VIPHCloudSpec) ProtoMessage() {}
 
 func (x *VIPHCloudSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[66]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5532,7 +6919,7 @@ func (x *VIPHCloudSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VIPHCloudSpec.ProtoReflect.Descriptor instead.
 func (*VIPHCloudSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{66}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *VIPHCloudSpec) GetDeviceId() int64 {
@@ -5563,13 +6950,15 @@ type VIPOperatorSpec struct {
 	GratuitousArp bool                   `protobuf:"varint,2,opt,name=gratuitous_arp,json=gratuitousArp,proto3" json:"gratuitous_arp,omitempty"`
 	EquinixMetal  *VIPEquinixMetalSpec   `protobuf:"bytes,3,opt,name=equinix_metal,json=equinixMetal,proto3" json:"equinix_metal,omitempty"`
 	HCloud        *VIPHCloudSpec         `protobuf:"bytes,4,opt,name=h_cloud,json=hCloud,proto3" json:"h_cloud,omitempty"`
+	// Probe, when set, releases the VIP while the named probe fails.
+	Probe         string `protobuf:"bytes,5,opt,name=probe,proto3" json:"probe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VIPOperatorSpec) Reset() {
 	*x = VIPOperatorSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[67]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5581,7 +6970,7 @@ func (x *VIPOperatorSpec) String() string {
 func (*VIPOperatorSpec) ProtoMessage() {}
 
 func (x *VIPOperatorSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[67]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5594,7 +6983,7 @@ func (x *VIPOperatorSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VIPOperatorSpec.ProtoReflect.Descriptor instead.
 func (*VIPOperatorSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{67}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *VIPOperatorSpec) GetIp() *common.NetIP {
@@ -5625,6 +7014,13 @@ func (x *VIPOperatorSpec) GetHCloud() *VIPHCloudSpec {
 	return nil
 }
 
+func (x *VIPOperatorSpec) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
 // VLANSpec describes VLAN settings if Kind == "vlan".
 type VLANSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -5638,7 +7034,7 @@ type VLANSpec struct {
 
 func (x *VLANSpec) Reset() {
 	*x = VLANSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[68]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5650,7 +7046,7 @@ func (x *VLANSpec) String() string {
 func (*VLANSpec) ProtoMessage() {}
 
 func (x *VLANSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[68]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5663,7 +7059,7 @@ func (x *VLANSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VLANSpec.ProtoReflect.Descriptor instead.
 func (*VLANSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{68}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *VLANSpec) GetVid() uint32 {
@@ -5690,7 +7086,7 @@ type VRFMasterSpec struct {
 
 func (x *VRFMasterSpec) Reset() {
 	*x = VRFMasterSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[69]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5702,7 +7098,7 @@ func (x *VRFMasterSpec) String() string {
 func (*VRFMasterSpec) ProtoMessage() {}
 
 func (x *VRFMasterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[69]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5715,7 +7111,7 @@ func (x *VRFMasterSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VRFMasterSpec.ProtoReflect.Descriptor instead.
 func (*VRFMasterSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{69}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *VRFMasterSpec) GetTable() enums.NethelpersRoutingTable {
@@ -5735,7 +7131,7 @@ type VRFSlave struct {
 
 func (x *VRFSlave) Reset() {
 	*x = VRFSlave{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[70]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5747,7 +7143,7 @@ func (x *VRFSlave) String() string {
 func (*VRFSlave) ProtoMessage() {}
 
 func (x *VRFSlave) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[70]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5760,7 +7156,7 @@ func (x *VRFSlave) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VRFSlave.ProtoReflect.Descriptor instead.
 func (*VRFSlave) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{70}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *VRFSlave) GetMasterName() string {
@@ -5770,6 +7166,90 @@ func (x *VRFSlave) GetMasterName() string {
 	return ""
 }
 
+// VXLANSpec describes VXLAN settings if Kind == "vxlan".
+type VXLANSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// VNI is the VXLAN network identifier.
+	Vni uint32 `protobuf:"varint,1,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Local is the source address of the tunnel, might be unset.
+	Local *common.NetIP `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	// Remote is the remote tunnel endpoint or a multicast group address, might be unset.
+	Remote *common.NetIP `protobuf:"bytes,3,opt,name=remote,proto3" json:"remote,omitempty"`
+	// Port is the destination UDP port.
+	Port uint32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	// LinkIndex is the index of the underlay link.
+	//
+	// It is filled in by the link spec controller from the ParentName before the link is created.
+	LinkIndex     uint32 `protobuf:"varint,5,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VXLANSpec) Reset() {
+	*x = VXLANSpec{}
+	mi := &file_resource_definitions_network_network_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VXLANSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VXLANSpec) ProtoMessage() {}
+
+func (x *VXLANSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_network_network_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VXLANSpec.ProtoReflect.Descriptor instead.
+func (*VXLANSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *VXLANSpec) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *VXLANSpec) GetLocal() *common.NetIP {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *VXLANSpec) GetRemote() *common.NetIP {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+func (x *VXLANSpec) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *VXLANSpec) GetLinkIndex() uint32 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
 // VethSpec identifies the expected peer of a veth endpoint.
 type VethSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5780,7 +7260,7 @@ type VethSpec struct {
 
 func (x *VethSpec) Reset() {
 	*x = VethSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[71]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5792,7 +7272,7 @@ func (x *VethSpec) String() string {
 func (*VethSpec) ProtoMessage() {}
 
 func (x *VethSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[71]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5805,7 +7285,7 @@ func (x *VethSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VethSpec.ProtoReflect.Descriptor instead.
 func (*VethSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{71}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *VethSpec) GetPeerName() string {
@@ -5829,7 +7309,7 @@ type WireguardPeer struct {
 
 func (x *WireguardPeer) Reset() {
 	*x = WireguardPeer{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[72]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5841,7 +7321,7 @@ func (x *WireguardPeer) String() string {
 func (*WireguardPeer) ProtoMessage() {}
 
 func (x *WireguardPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[72]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5854,7 +7334,7 @@ func (x *WireguardPeer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardPeer.ProtoReflect.Descriptor instead.
 func (*WireguardPeer) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{72}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *WireguardPeer) GetPublicKey() string {
@@ -5908,7 +7388,7 @@ type WireguardSpec struct {
 
 func (x *WireguardSpec) Reset() {
 	*x = WireguardSpec{}
-	mi := &file_resource_definitions_network_network_proto_msgTypes[73]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5920,7 +7400,7 @@ func (x *WireguardSpec) String() string {
 func (*WireguardSpec) ProtoMessage() {}
 
 func (x *WireguardSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_network_network_proto_msgTypes[73]
+	mi := &file_resource_definitions_network_network_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5933,7 +7413,7 @@ func (x *WireguardSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardSpec.ProtoReflect.Descriptor instead.
 func (*WireguardSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{73}
+	return file_resource_definitions_network_network_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *WireguardSpec) GetPrivateKey() string {
@@ -5998,14 +7478,20 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x05scope\x18\t \x01(\x0e21.talos.resource.definitions.enums.NethelpersScopeR\x05scope\x12\x14\n" +
 	"\x05flags\x18\n" +
 	" \x01(\rR\x05flags\x12\x1a\n" +
-	"\bpriority\x18\v \x01(\rR\bpriority\"\xcd\x01\n" +
+	"\bpriority\x18\v \x01(\rR\bpriority\"A\n" +
+	"\x15BGPAdvertiseProbeSpec\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12\x14\n" +
+	"\x05probe\x18\x02 \x01(\tR\x05probe\"\xcd\x01\n" +
 	"\x10BGPBFDConfigSpec\x12F\n" +
 	"\x11transmit_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x10transmitInterval\x12D\n" +
 	"\x10receive_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0freceiveInterval\x12+\n" +
-	"\x11detect_multiplier\x18\x03 \x01(\rR\x10detectMultiplier\"h\n" +
+	"\x11detect_multiplier\x18\x03 \x01(\rR\x10detectMultiplier\"\xa4\x01\n" +
+	"\x16BGPGracefulRestartSpec\x12<\n" +
+	"\frestart_time\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vrestartTime\x12L\n" +
+	"\x15long_lived_stale_time\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x12longLivedStaleTime\"h\n" +
 	"\x12BGPImportRouteSpec\x12!\n" +
 	"\fbgp_instance\x18\x01 \x01(\tR\vbgpInstance\x12/\n" +
-	"\bprefixes\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\bprefixes\"\xbc\x04\n" +
+	"\bprefixes\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\bprefixes\"\x89\x06\n" +
 	"\x15BGPInstanceConfigSpec\x12\x1b\n" +
 	"\tlocal_asn\x18\x01 \x01(\rR\blocalAsn\x12*\n" +
 	"\trouter_id\x18\x02 \x01(\v2\r.common.NetIPR\brouterId\x120\n" +
@@ -6018,7 +7504,9 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\tvrf_table\x18\t \x01(\x0e28.talos.resource.definitions.enums.NethelpersRoutingTableR\bvrfTable\x12[\n" +
 	"\rimport_routes\x18\n" +
 	" \x03(\v26.talos.resource.definitions.network.BGPImportRouteSpecR\fimportRoutes\x12%\n" +
-	"\x0einstall_routes\x18\v \x01(\bR\rinstallRoutes\"\xa6\x02\n" +
+	"\x0einstall_routes\x18\v \x01(\bR\rinstallRoutes\x12e\n" +
+	"\x10graceful_restart\x18\f \x01(\v2:.talos.resource.definitions.network.BGPGracefulRestartSpecR\x0fgracefulRestart\x12d\n" +
+	"\x10advertise_probes\x18\r \x03(\v29.talos.resource.definitions.network.BGPAdvertiseProbeSpecR\x0fadvertiseProbes\"\xfc\x03\n" +
 	"\x15BGPNeighborConfigSpec\x12'\n" +
 	"\aaddress\x18\x01 \x01(\v2\r.common.NetIPR\aaddress\x12\x12\n" +
 	"\x04link\x18\x02 \x01(\tR\x04link\x12\x19\n" +
@@ -6026,7 +7514,11 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\thold_time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bholdTime\x12F\n" +
 	"\x03bfd\x18\x05 \x01(\v24.talos.resource.definitions.network.BGPBFDConfigSpecR\x03bfd\x12\x1b\n" +
 	"\tlocal_asn\x18\x06 \x01(\rR\blocalAsn\x12\x18\n" +
-	"\apassive\x18\a \x01(\bR\apassive\"\xa1\x03\n" +
+	"\apassive\x18\a \x01(\bR\apassive\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\x12[\n" +
+	"\rimport_policy\x18\t \x01(\v26.talos.resource.definitions.network.BGPRoutePolicySpecR\fimportPolicy\x12[\n" +
+	"\rexport_policy\x18\n" +
+	" \x01(\v26.talos.resource.definitions.network.BGPRoutePolicySpecR\fexportPolicy\"\xa7\x04\n" +
 	"\x11BGPPeerStatusSpec\x12\x12\n" +
 	"\x04peer\x18\x01 \x01(\tR\x04peer\x12\x1b\n" +
 	"\tlocal_asn\x18\x02 \x01(\rR\blocalAsn\x12\x19\n" +
@@ -6041,7 +7533,20 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\baccepted\x18\t \x01(\rR\baccepted\x12\x1b\n" +
 	"\tbfd_state\x18\n" +
 	" \x01(\tR\bbfdState\x12\x1a\n" +
-	"\binstance\x18\v \x01(\tR\binstance\"\xa4\f\n" +
+	"\binstance\x18\v \x01(\tR\binstance\x12\x1a\n" +
+	"\brejected\x18\f \x01(\rR\brejected\x12)\n" +
+	"\x10graceful_restart\x18\r \x01(\bR\x0fgracefulRestart\x12=\n" +
+	"\x1blong_lived_graceful_restart\x18\x0e \x01(\bR\x18longLivedGracefulRestart\"a\n" +
+	"\x13BGPPrefixFilterSpec\x12+\n" +
+	"\x06prefix\x18\x01 \x01(\v2\x13.common.NetIPPrefixR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x02 \x01(\rR\tmaxLength\"\x9b\x02\n" +
+	"\x12BGPRoutePolicySpec\x12S\n" +
+	"\bprefixes\x18\x01 \x03(\v27.talos.resource.definitions.network.BGPPrefixFilterSpecR\bprefixes\x12+\n" +
+	"\x11match_communities\x18\x02 \x03(\tR\x10matchCommunities\x12'\n" +
+	"\x0fadd_communities\x18\x03 \x03(\tR\x0eaddCommunities\x122\n" +
+	"\x15add_large_communities\x18\x04 \x03(\tR\x13addLargeCommunities\x12&\n" +
+	"\x0fas_path_prepend\x18\x05 \x01(\rR\rasPathPrepend\"\xa4\f\n" +
 	"\x0eBondMasterSpec\x12H\n" +
 	"\x04mode\x18\x01 \x01(\x0e24.talos.resource.definitions.enums.NethelpersBondModeR\x04mode\x12_\n" +
 	"\vhash_policy\x18\x02 \x01(\x0e2>.talos.resource.definitions.enums.NethelpersBondXmitHashPolicyR\n" +
@@ -6095,19 +7600,46 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x14ClientIdentifierSpec\x12i\n" +
 	"\x11client_identifier\x18\x01 \x01(\x0e2<.talos.resource.definitions.enums.NethelpersClientIdentifierR\x10clientIdentifier\x12 \n" +
 	"\fduid_raw_hex\x18\x02 \x01(\tR\n" +
-	"duidRawHex\"\xf2\x01\n" +
+	"duidRawHex\"\xdd\x02\n" +
 	"\x11DHCP4OperatorSpec\x12!\n" +
 	"\froute_metric\x18\x01 \x01(\rR\vrouteMetric\x122\n" +
 	"\x15skip_hostname_request\x18\x02 \x01(\bR\x13skipHostnameRequest\x12e\n" +
 	"\x11client_identifier\x18\x03 \x01(\v28.talos.resource.definitions.network.ClientIdentifierSpecR\x10clientIdentifier\x12\x1f\n" +
 	"\vskip_routes\x18\x04 \x01(\bR\n" +
-	"skipRoutes\"\xd1\x01\n" +
+	"skipRoutes\x12'\n" +
+	"\x0frequest_options\x18\x05 \x03(\rR\x0erequestOptions\x12!\n" +
+	"\fvendor_class\x18\x06 \x01(\tR\vvendorClass\x12\x1d\n" +
+	"\n" +
+	"user_class\x18\a \x03(\tR\tuserClass\"\x91\x03\n" +
 	"\x11DHCP6OperatorSpec\x12!\n" +
 	"\froute_metric\x18\x02 \x01(\rR\vrouteMetric\x122\n" +
 	"\x15skip_hostname_request\x18\x03 \x01(\bR\x13skipHostnameRequest\x12e\n" +
-	"\x11client_identifier\x18\x04 \x01(\v28.talos.resource.definitions.network.ClientIdentifierSpecR\x10clientIdentifier\"-\n" +
+	"\x11client_identifier\x18\x04 \x01(\v28.talos.resource.definitions.network.ClientIdentifierSpecR\x10clientIdentifier\x12+\n" +
+	"\x11prefix_delegation\x18\x05 \x01(\bR\x10prefixDelegation\x12#\n" +
+	"\rprefix_length\x18\x06 \x01(\rR\fprefixLength\x12l\n" +
+	"\x12prefix_downstreams\x18\a \x03(\v2=.talos.resource.definitions.network.DHCP6PrefixDownstreamSpecR\x11prefixDownstreams\"U\n" +
+	"\x19DHCP6PrefixDownstreamSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12\x1b\n" +
+	"\tsubnet_id\x18\x02 \x01(\rR\bsubnetId\"J\n" +
+	"\n" +
+	"DHCPOption\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\xc7\x01\n" +
+	"\x0fDHCPOptionsSpec\x12\x1b\n" +
+	"\tlink_name\x18\x01 \x01(\tR\blinkName\x12M\n" +
+	"\boperator\x18\x02 \x01(\x0e21.talos.resource.definitions.enums.NetworkOperatorR\boperator\x12H\n" +
+	"\aoptions\x18\x03 \x03(\v2..talos.resource.definitions.network.DHCPOptionR\aoptions\"-\n" +
 	"\x13DNSResolveCacheSpec\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"h\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xcf\x02\n" +
+	"\x13DelegatedPrefixSpec\x12>\n" +
+	"\x10delegated_prefix\x18\x01 \x01(\v2\x13.common.NetIPPrefixR\x0fdelegatedPrefix\x12+\n" +
+	"\x06prefix\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\x06prefix\x12\x1b\n" +
+	"\tlink_name\x18\x03 \x01(\tR\blinkName\x12,\n" +
+	"\x12upstream_link_name\x18\x04 \x01(\tR\x10upstreamLinkName\x12C\n" +
+	"\x0fpreferred_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0epreferredUntil\x12;\n" +
+	"\vvalid_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\"h\n" +
 	"\x14EthernetChannelsSpec\x12\x0e\n" +
 	"\x02rx\x18\x01 \x01(\rR\x02rx\x12\x0e\n" +
 	"\x02tx\x18\x02 \x01(\rR\x02tx\x12\x14\n" +
@@ -6178,19 +7710,41 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\bfeatures\x18\b \x03(\v29.talos.resource.definitions.network.EthernetFeatureStatusR\bfeatures\x12V\n" +
 	"\bchannels\x18\t \x01(\v2:.talos.resource.definitions.network.EthernetChannelsStatusR\bchannels\x12S\n" +
 	"\vwake_on_lan\x18\n" +
-	" \x03(\x0e23.talos.resource.definitions.enums.NethelpersWOLModeR\twakeOnLan\"c\n" +
+	" \x03(\x0e23.talos.resource.definitions.enums.NethelpersWOLModeR\twakeOnLan\"\x86\x01\n" +
+	"\aGRESpec\x12#\n" +
+	"\x05local\x18\x01 \x01(\v2\r.common.NetIPR\x05local\x12%\n" +
+	"\x06remote\x18\x02 \x01(\v2\r.common.NetIPR\x06remote\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\rR\x03key\x12\x1d\n" +
+	"\n" +
+	"link_index\x18\x04 \x01(\rR\tlinkIndex\"Y\n" +
+	"\n" +
+	"GeneveSpec\x12\x10\n" +
+	"\x03vni\x18\x01 \x01(\rR\x03vni\x12%\n" +
+	"\x06remote\x18\x02 \x01(\v2\r.common.NetIPR\x06remote\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\rR\x04port\"c\n" +
 	"\rHTTPProbeSpec\x12\x1d\n" +
 	"\x03url\x18\x01 \x01(\v2\v.common.URLR\x03url\x123\n" +
 	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"K\n" +
 	"\x10HardwareAddrSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
-	"\rhardware_addr\x18\x02 \x01(\fR\fhardwareAddr\"\xb2\x02\n" +
+	"\rhardware_addr\x18\x02 \x01(\fR\fhardwareAddr\"\xf9\x04\n" +
 	"\x11HostDNSConfigSpec\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12<\n" +
 	"\x10listen_addresses\x18\x02 \x03(\v2\x11.common.NetIPPortR\x0flistenAddresses\x12F\n" +
 	"\x18service_host_dns_address\x18\x03 \x01(\v2\r.common.NetIPR\x15serviceHostDnsAddress\x120\n" +
 	"\x14resolve_member_names\x18\x04 \x01(\bR\x12resolveMemberNames\x12K\n" +
-	"\x1bservice_host_dns_address_v6\x18\x05 \x01(\v2\r.common.NetIPR\x17serviceHostDnsAddressV6\"\xa7\x01\n" +
+	"\x1bservice_host_dns_address_v6\x18\x05 \x01(\v2\r.common.NetIPR\x17serviceHostDnsAddressV6\x12X\n" +
+	"\n" +
+	"forwarders\x18\x06 \x03(\v28.talos.resource.definitions.network.HostDNSForwarderSpecR\n" +
+	"forwarders\x12\x1b\n" +
+	"\tquery_log\x18\a \x01(\bR\bqueryLog\x12.\n" +
+	"\x13negative_cache_size\x18\b \x01(\x03R\x11negativeCacheSize\x12N\n" +
+	"\x16negative_cache_min_ttl\x18\t \x01(\v2\x19.google.protobuf.DurationR\x13negativeCacheMinTtl\x12N\n" +
+	"\x16negative_cache_max_ttl\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x13negativeCacheMaxTtl\"\x87\x01\n" +
+	"\x14HostDNSForwarderSpec\x12\x18\n" +
+	"\adomains\x18\x01 \x03(\tR\adomains\x12U\n" +
+	"\fname_servers\x18\x02 \x03(\v22.talos.resource.definitions.network.NameServerSpecR\vnameServers\"\xa7\x01\n" +
 	"\x10HostnameSpecSpec\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1e\n" +
 	"\n" +
@@ -6201,13 +7755,16 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1e\n" +
 	"\n" +
 	"domainname\x18\x02 \x01(\tR\n" +
-	"domainname\")\n" +
+	"domainname\"X\n" +
+	"\n" +
+	"IPVLANSpec\x12J\n" +
+	"\x04mode\x18\x01 \x01(\x0e26.talos.resource.definitions.enums.NethelpersIPVLANModeR\x04mode\")\n" +
 	"\x11LinkAliasSpecSpec\x12\x14\n" +
 	"\x05alias\x18\x01 \x01(\tR\x05alias\"1\n" +
 	"\x0fLinkRefreshSpec\x12\x1e\n" +
 	"\n" +
 	"generation\x18\x01 \x01(\x03R\n" +
-	"generation\"\xc3\b\n" +
+	"generation\"\xa2\v\n" +
 	"\fLinkSpecSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\alogical\x18\x02 \x01(\bR\alogical\x12\x0e\n" +
@@ -6232,7 +7789,12 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\n" +
 	"vrf_master\x18\x11 \x01(\v21.talos.resource.definitions.network.VRFMasterSpecR\tvrfMaster\x12I\n" +
 	"\tvrf_slave\x18\x12 \x01(\v2,.talos.resource.definitions.network.VRFSlaveR\bvrfSlave\x12@\n" +
-	"\x04veth\x18\x13 \x01(\v2,.talos.resource.definitions.network.VethSpecR\x04veth\"\xf5\v\n" +
+	"\x04veth\x18\x13 \x01(\v2,.talos.resource.definitions.network.VethSpecR\x04veth\x12C\n" +
+	"\x05vxlan\x18\x14 \x01(\v2-.talos.resource.definitions.network.VXLANSpecR\x05vxlan\x12F\n" +
+	"\x06geneve\x18\x15 \x01(\v2..talos.resource.definitions.network.GeneveSpecR\x06geneve\x12=\n" +
+	"\x03gre\x18\x16 \x01(\v2+.talos.resource.definitions.network.GRESpecR\x03gre\x12I\n" +
+	"\amacvlan\x18\x17 \x01(\v2/.talos.resource.definitions.network.MACVLANSpecR\amacvlan\x12F\n" +
+	"\x06ipvlan\x18\x18 \x01(\v2..talos.resource.definitions.network.IPVLANSpecR\x06ipvlan\"\xd4\x0e\n" +
 	"\x0eLinkStatusSpec\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\rR\x05index\x12H\n" +
 	"\x04type\x18\x02 \x01(\x0e24.talos.resource.definitions.enums.NethelpersLinkTypeR\x04type\x12\x1d\n" +
@@ -6275,7 +7837,14 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\talt_names\x18  \x03(\tR\baltNames\x12P\n" +
 	"\n" +
 	"vrf_master\x18! \x01(\v21.talos.resource.definitions.network.VRFMasterSpecR\tvrfMaster\x12@\n" +
-	"\x04veth\x18\" \x01(\v2,.talos.resource.definitions.network.VethSpecR\x04veth\"\xb0\x01\n" +
+	"\x04veth\x18\" \x01(\v2,.talos.resource.definitions.network.VethSpecR\x04veth\x12C\n" +
+	"\x05vxlan\x18# \x01(\v2-.talos.resource.definitions.network.VXLANSpecR\x05vxlan\x12F\n" +
+	"\x06geneve\x18$ \x01(\v2..talos.resource.definitions.network.GeneveSpecR\x06geneve\x12=\n" +
+	"\x03gre\x18% \x01(\v2+.talos.resource.definitions.network.GRESpecR\x03gre\x12I\n" +
+	"\amacvlan\x18& \x01(\v2/.talos.resource.definitions.network.MACVLANSpecR\amacvlan\x12F\n" +
+	"\x06ipvlan\x18' \x01(\v2..talos.resource.definitions.network.IPVLANSpecR\x06ipvlan\"Z\n" +
+	"\vMACVLANSpec\x12K\n" +
+	"\x04mode\x18\x01 \x01(\x0e27.talos.resource.definitions.enums.NethelpersMACVLANModeR\x04mode\"\xb0\x01\n" +
 	"\x0eNameServerSpec\x12!\n" +
 	"\x04addr\x18\x01 \x01(\v2\r.common.NetIPR\x04addr\x12S\n" +
 	"\bprotocol\x18\x02 \x01(\x0e27.talos.resource.definitions.enums.NethelpersDNSProtocolR\bprotocol\x12&\n" +
@@ -6289,7 +7858,11 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x04hook\x18\x02 \x01(\x0e2=.talos.resource.definitions.enums.NethelpersNfTablesChainHookR\x04hook\x12]\n" +
 	"\bpriority\x18\x03 \x01(\x0e2A.talos.resource.definitions.enums.NethelpersNfTablesChainPriorityR\bpriority\x12F\n" +
 	"\x05rules\x18\x04 \x03(\v20.talos.resource.definitions.network.NfTablesRuleR\x05rules\x12S\n" +
-	"\x06policy\x18\x05 \x01(\x0e2;.talos.resource.definitions.enums.NethelpersNfTablesVerdictR\x06policy\"$\n" +
+	"\x06policy\x18\x05 \x01(\x0e2;.talos.resource.definitions.enums.NethelpersNfTablesVerdictR\x06policy\"\xb6\x01\n" +
+	"\x17NfTablesChainStatusSpec\x12'\n" +
+	"\x0fdropped_packets\x18\x01 \x01(\x04R\x0edroppedPackets\x12#\n" +
+	"\rdropped_bytes\x18\x02 \x01(\x04R\fdroppedBytes\x12M\n" +
+	"\x05rules\x18\x03 \x03(\v27.talos.resource.definitions.network.NfTablesRuleCounterR\x05rules\"$\n" +
 	"\x10NfTablesClampMSS\x12\x10\n" +
 	"\x03mtu\x18\x01 \x01(\rR\x03mtu\"q\n" +
 	"\x1bNfTablesConntrackStateMatch\x12R\n" +
@@ -6309,9 +7882,12 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\fNfTablesMark\x12\x12\n" +
 	"\x04mask\x18\x01 \x01(\rR\x04mask\x12\x10\n" +
 	"\x03xor\x18\x02 \x01(\rR\x03xor\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\rR\x05value\"Z\n" +
+	"\x05value\x18\x03 \x01(\rR\x05value\"J\n" +
+	"\vNfTablesNAT\x12'\n" +
+	"\aaddress\x18\x01 \x01(\v2\r.common.NetIPR\aaddress\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\"Z\n" +
 	"\x11NfTablesPortMatch\x12E\n" +
-	"\x06ranges\x18\x01 \x03(\v2-.talos.resource.definitions.network.PortRangeR\x06ranges\"\xc5\b\n" +
+	"\x06ranges\x18\x01 \x03(\v2-.talos.resource.definitions.network.PortRangeR\x06ranges\"\xf4\v\n" +
 	"\fNfTablesRule\x12^\n" +
 	"\x0fmatch_o_if_name\x18\x01 \x01(\v27.talos.resource.definitions.network.NfTablesIfNameMatchR\fmatchOIfName\x12U\n" +
 	"\averdict\x18\x02 \x01(\x0e2;.talos.resource.definitions.enums.NethelpersNfTablesVerdictR\averdict\x12O\n" +
@@ -6327,7 +7903,25 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	" \x01(\v26.talos.resource.definitions.network.NfTablesLimitMatchR\n" +
 	"matchLimit\x12s\n" +
 	"\x15match_conntrack_state\x18\v \x01(\v2?.talos.resource.definitions.network.NfTablesConntrackStateMatchR\x13matchConntrackState\x12!\n" +
-	"\fanon_counter\x18\f \x01(\bR\vanonCounter\"\x93\x01\n" +
+	"\fanon_counter\x18\f \x01(\bR\vanonCounter\x12N\n" +
+	"\n" +
+	"source_nat\x18\r \x01(\v2/.talos.resource.definitions.network.NfTablesNATR\tsourceNat\x12X\n" +
+	"\x0fdestination_nat\x18\x0e \x01(\v2/.talos.resource.definitions.network.NfTablesNATR\x0edestinationNat\x12w\n" +
+	"\x17match_source_rate_limit\x18\x0f \x01(\v2@.talos.resource.definitions.network.NfTablesSourceRateLimitMatchR\x14matchSourceRateLimit\x12\x89\x01\n" +
+	"\x1dmatch_source_connection_limit\x18\x10 \x01(\v2F.talos.resource.definitions.network.NfTablesSourceConnectionLimitMatchR\x1amatchSourceConnectionLimit\"[\n" +
+	"\x13NfTablesRuleCounter\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x18\n" +
+	"\apackets\x18\x02 \x01(\x04R\apackets\x12\x14\n" +
+	"\x05bytes\x18\x03 \x01(\x04R\x05bytes\"\xb5\x01\n" +
+	"\"NfTablesSourceConnectionLimitMatch\x12'\n" +
+	"\x0fmax_connections\x18\x01 \x01(\rR\x0emaxConnections\x122\n" +
+	"\x15source_prefix_length4\x18\x02 \x01(\x03R\x13sourcePrefixLength4\x122\n" +
+	"\x15source_prefix_length6\x18\x03 \x01(\x03R\x13sourcePrefixLength6\"\xd1\x01\n" +
+	"\x1cNfTablesSourceRateLimitMatch\x123\n" +
+	"\x16packet_rate_per_second\x18\x01 \x01(\x04R\x13packetRatePerSecond\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\rR\x05burst\x122\n" +
+	"\x15source_prefix_length4\x18\x03 \x01(\x03R\x13sourcePrefixLength4\x122\n" +
+	"\x15source_prefix_length6\x18\x04 \x01(\x03R\x13sourcePrefixLength6\"\x93\x01\n" +
 	"\x15NodeAddressFilterSpec\x12<\n" +
 	"\x0finclude_subnets\x18\x01 \x03(\v2\x13.common.NetIPPrefixR\x0eincludeSubnets\x12<\n" +
 	"\x0fexclude_subnets\x18\x02 \x03(\v2\x13.common.NetIPPrefixR\x0eexcludeSubnets\"~\n" +
@@ -6359,17 +7953,19 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	" \x01(\v28.talos.resource.definitions.runtime.PlatformMetadataSpecR\bmetadata\"+\n" +
 	"\tPortRange\x12\x0e\n" +
 	"\x02lo\x18\x01 \x01(\rR\x02lo\x12\x0e\n" +
-	"\x02hi\x18\x02 \x01(\rR\x02hi\"\xd7\x02\n" +
+	"\x02hi\x18\x02 \x01(\rR\x02hi\"\xeb\x02\n" +
 	"\rProbeSpecSpec\x125\n" +
 	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12+\n" +
 	"\x11failure_threshold\x18\x02 \x01(\x03R\x10failureThreshold\x12B\n" +
 	"\x03tcp\x18\x03 \x01(\v20.talos.resource.definitions.network.TCPProbeSpecR\x03tcp\x12W\n" +
 	"\fconfig_layer\x18\x04 \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12E\n" +
-	"\x04http\x18\x05 \x01(\v21.talos.resource.definitions.network.HTTPProbeSpecR\x04http\"J\n" +
+	"\x04http\x18\x05 \x01(\v21.talos.resource.definitions.network.HTTPProbeSpecR\x04http\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\"^\n" +
 	"\x0fProbeStatusSpec\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
 	"\n" +
-	"last_error\x18\x02 \x01(\tR\tlastError\"\xd5\x02\n" +
+	"last_error\x18\x02 \x01(\tR\tlastError\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xd5\x02\n" +
 	"\x10ResolverSpecSpec\x12.\n" +
 	"\vdns_servers\x18\x01 \x03(\v2\r.common.NetIPR\n" +
 	"dnsServers\x12W\n" +
@@ -6385,7 +7981,7 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\fRouteNextHop\x12'\n" +
 	"\agateway\x18\x01 \x01(\v2\r.common.NetIPR\agateway\x12\"\n" +
 	"\rout_link_name\x18\x02 \x01(\tR\voutLinkName\x12\x16\n" +
-	"\x06weight\x18\x03 \x01(\rR\x06weight\"\xad\x06\n" +
+	"\x06weight\x18\x03 \x01(\rR\x06weight\"\xc3\x06\n" +
 	"\rRouteSpecSpec\x12J\n" +
 	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x125\n" +
 	"\vdestination\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\vdestination\x12%\n" +
@@ -6401,7 +7997,8 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\bprotocol\x18\v \x01(\x0e29.talos.resource.definitions.enums.NethelpersRouteProtocolR\bprotocol\x12W\n" +
 	"\fconfig_layer\x18\f \x01(\x0e24.talos.resource.definitions.enums.NetworkConfigLayerR\vconfigLayer\x12\x10\n" +
 	"\x03mtu\x18\r \x01(\rR\x03mtu\x12M\n" +
-	"\tnext_hops\x18\x0e \x03(\v20.talos.resource.definitions.network.RouteNextHopR\bnextHops\"\xfc\x05\n" +
+	"\tnext_hops\x18\x0e \x03(\v20.talos.resource.definitions.network.RouteNextHopR\bnextHops\x12\x14\n" +
+	"\x05probe\x18\x0f \x01(\tR\x05probe\"\xfc\x05\n" +
 	"\x0fRouteStatusSpec\x12J\n" +
 	"\x06family\x18\x01 \x01(\x0e22.talos.resource.definitions.enums.NethelpersFamilyR\x06family\x125\n" +
 	"\vdestination\x18\x02 \x01(\v2\x13.common.NetIPPrefixR\vdestination\x12%\n" +
@@ -6474,12 +8071,13 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\tdevice_id\x18\x01 \x01(\x03R\bdeviceId\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x02 \x01(\x03R\tnetworkId\x12\x1b\n" +
-	"\tapi_token\x18\x03 \x01(\tR\bapiToken\"\x81\x02\n" +
+	"\tapi_token\x18\x03 \x01(\tR\bapiToken\"\x97\x02\n" +
 	"\x0fVIPOperatorSpec\x12\x1d\n" +
 	"\x02ip\x18\x01 \x01(\v2\r.common.NetIPR\x02ip\x12%\n" +
 	"\x0egratuitous_arp\x18\x02 \x01(\bR\rgratuitousArp\x12\\\n" +
 	"\requinix_metal\x18\x03 \x01(\v27.talos.resource.definitions.network.VIPEquinixMetalSpecR\fequinixMetal\x12J\n" +
-	"\ah_cloud\x18\x04 \x01(\v21.talos.resource.definitions.network.VIPHCloudSpecR\x06hCloud\"r\n" +
+	"\ah_cloud\x18\x04 \x01(\v21.talos.resource.definitions.network.VIPHCloudSpecR\x06hCloud\x12\x14\n" +
+	"\x05probe\x18\x05 \x01(\tR\x05probe\"r\n" +
 	"\bVLANSpec\x12\x10\n" +
 	"\x03vid\x18\x01 \x01(\rR\x03vid\x12T\n" +
 	"\bprotocol\x18\x02 \x01(\x0e28.talos.resource.definitions.enums.NethelpersVLANProtocolR\bprotocol\"_\n" +
@@ -6487,7 +8085,14 @@ const file_resource_definitions_network_network_proto_rawDesc = "" +
 	"\x05table\x18\x01 \x01(\x0e28.talos.resource.definitions.enums.NethelpersRoutingTableR\x05table\"+\n" +
 	"\bVRFSlave\x12\x1f\n" +
 	"\vmaster_name\x18\x01 \x01(\tR\n" +
-	"masterName\"'\n" +
+	"masterName\"\x9c\x01\n" +
+	"\tVXLANSpec\x12\x10\n" +
+	"\x03vni\x18\x01 \x01(\rR\x03vni\x12#\n" +
+	"\x05local\x18\x02 \x01(\v2\r.common.NetIPR\x05local\x12%\n" +
+	"\x06remote\x18\x03 \x01(\v2\r.common.NetIPR\x06remote\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\rR\x04port\x12\x1d\n" +
+	"\n" +
+	"link_index\x18\x05 \x01(\rR\tlinkIndex\"'\n" +
 	"\bVethSpec\x12\x1b\n" +
 	"\tpeer_name\x18\x01 \x01(\tR\bpeerName\"\x84\x02\n" +
 	"\rWireguardPeer\x12\x1d\n" +
@@ -6521,307 +8126,370 @@ func file_resource_definitions_network_network_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_network_network_proto_rawDescData
 }
 
-var file_resource_definitions_network_network_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
+var file_resource_definitions_network_network_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_resource_definitions_network_network_proto_goTypes = []any{
 	(*AddressSpecSpec)(nil),                    // 0: talos.resource.definitions.network.AddressSpecSpec
 	(*AddressStatusSpec)(nil),                  // 1: talos.resource.definitions.network.AddressStatusSpec
-	(*BGPBFDConfigSpec)(nil),                   // 2: talos.resource.definitions.network.BGPBFDConfigSpec
-	(*BGPImportRouteSpec)(nil),                 // 3: talos.resource.definitions.network.BGPImportRouteSpec
-	(*BGPInstanceConfigSpec)(nil),              // 4: talos.resource.definitions.network.BGPInstanceConfigSpec
-	(*BGPNeighborConfigSpec)(nil),              // 5: talos.resource.definitions.network.BGPNeighborConfigSpec
-	(*BGPPeerStatusSpec)(nil),                  // 6: talos.resource.definitions.network.BGPPeerStatusSpec
-	(*BondMasterSpec)(nil),                     // 7: talos.resource.definitions.network.BondMasterSpec
-	(*BondSlave)(nil),                          // 8: talos.resource.definitions.network.BondSlave
-	(*BridgeMasterSpec)(nil),                   // 9: talos.resource.definitions.network.BridgeMasterSpec
-	(*BridgeSlave)(nil),                        // 10: talos.resource.definitions.network.BridgeSlave
-	(*BridgeVLANSpec)(nil),                     // 11: talos.resource.definitions.network.BridgeVLANSpec
-	(*ClientIdentifierSpec)(nil),               // 12: talos.resource.definitions.network.ClientIdentifierSpec
-	(*DHCP4OperatorSpec)(nil),                  // 13: talos.resource.definitions.network.DHCP4OperatorSpec
-	(*DHCP6OperatorSpec)(nil),                  // 14: talos.resource.definitions.network.DHCP6OperatorSpec
-	(*DNSResolveCacheSpec)(nil),                // 15: talos.resource.definitions.network.DNSResolveCacheSpec
-	(*EthernetChannelsSpec)(nil),               // 16: talos.resource.definitions.network.EthernetChannelsSpec
-	(*EthernetChannelsStatus)(nil),             // 17: talos.resource.definitions.network.EthernetChannelsStatus
-	(*EthernetFeatureStatus)(nil),              // 18: talos.resource.definitions.network.EthernetFeatureStatus
-	(*EthernetRingsSpec)(nil),                  // 19: talos.resource.definitions.network.EthernetRingsSpec
-	(*EthernetRingsStatus)(nil),                // 20: talos.resource.definitions.network.EthernetRingsStatus
-	(*EthernetSpecSpec)(nil),                   // 21: talos.resource.definitions.network.EthernetSpecSpec
-	(*EthernetStatusSpec)(nil),                 // 22: talos.resource.definitions.network.EthernetStatusSpec
-	(*HTTPProbeSpec)(nil),                      // 23: talos.resource.definitions.network.HTTPProbeSpec
-	(*HardwareAddrSpec)(nil),                   // 24: talos.resource.definitions.network.HardwareAddrSpec
-	(*HostDNSConfigSpec)(nil),                  // 25: talos.resource.definitions.network.HostDNSConfigSpec
-	(*HostnameSpecSpec)(nil),                   // 26: talos.resource.definitions.network.HostnameSpecSpec
-	(*HostnameStatusSpec)(nil),                 // 27: talos.resource.definitions.network.HostnameStatusSpec
-	(*LinkAliasSpecSpec)(nil),                  // 28: talos.resource.definitions.network.LinkAliasSpecSpec
-	(*LinkRefreshSpec)(nil),                    // 29: talos.resource.definitions.network.LinkRefreshSpec
-	(*LinkSpecSpec)(nil),                       // 30: talos.resource.definitions.network.LinkSpecSpec
-	(*LinkStatusSpec)(nil),                     // 31: talos.resource.definitions.network.LinkStatusSpec
-	(*NameServerSpec)(nil),                     // 32: talos.resource.definitions.network.NameServerSpec
-	(*NfTablesAddressMatch)(nil),               // 33: talos.resource.definitions.network.NfTablesAddressMatch
-	(*NfTablesChainSpec)(nil),                  // 34: talos.resource.definitions.network.NfTablesChainSpec
-	(*NfTablesClampMSS)(nil),                   // 35: talos.resource.definitions.network.NfTablesClampMSS
-	(*NfTablesConntrackStateMatch)(nil),        // 36: talos.resource.definitions.network.NfTablesConntrackStateMatch
-	(*NfTablesICMPTypeMatch)(nil),              // 37: talos.resource.definitions.network.NfTablesICMPTypeMatch
-	(*NfTablesIfNameMatch)(nil),                // 38: talos.resource.definitions.network.NfTablesIfNameMatch
-	(*NfTablesLayer4Match)(nil),                // 39: talos.resource.definitions.network.NfTablesLayer4Match
-	(*NfTablesLimitMatch)(nil),                 // 40: talos.resource.definitions.network.NfTablesLimitMatch
-	(*NfTablesMark)(nil),                       // 41: talos.resource.definitions.network.NfTablesMark
-	(*NfTablesPortMatch)(nil),                  // 42: talos.resource.definitions.network.NfTablesPortMatch
-	(*NfTablesRule)(nil),                       // 43: talos.resource.definitions.network.NfTablesRule
-	(*NodeAddressFilterSpec)(nil),              // 44: talos.resource.definitions.network.NodeAddressFilterSpec
-	(*NodeAddressSortAlgorithmSpec)(nil),       // 45: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec
-	(*NodeAddressSpec)(nil),                    // 46: talos.resource.definitions.network.NodeAddressSpec
-	(*OperatorSpecSpec)(nil),                   // 47: talos.resource.definitions.network.OperatorSpecSpec
-	(*PlatformConfigSpec)(nil),                 // 48: talos.resource.definitions.network.PlatformConfigSpec
-	(*PortRange)(nil),                          // 49: talos.resource.definitions.network.PortRange
-	(*ProbeSpecSpec)(nil),                      // 50: talos.resource.definitions.network.ProbeSpecSpec
-	(*ProbeStatusSpec)(nil),                    // 51: talos.resource.definitions.network.ProbeStatusSpec
-	(*ResolverSpecSpec)(nil),                   // 52: talos.resource.definitions.network.ResolverSpecSpec
-	(*ResolverStatusSpec)(nil),                 // 53: talos.resource.definitions.network.ResolverStatusSpec
-	(*RouteNextHop)(nil),                       // 54: talos.resource.definitions.network.RouteNextHop
-	(*RouteSpecSpec)(nil),                      // 55: talos.resource.definitions.network.RouteSpecSpec
-	(*RouteStatusSpec)(nil),                    // 56: talos.resource.definitions.network.RouteStatusSpec
-	(*RoutingRuleSpecSpec)(nil),                // 57: talos.resource.definitions.network.RoutingRuleSpecSpec
-	(*RoutingRuleStatusSpec)(nil),              // 58: talos.resource.definitions.network.RoutingRuleStatusSpec
-	(*STPSpec)(nil),                            // 59: talos.resource.definitions.network.STPSpec
-	(*StaticHostSpec)(nil),                     // 60: talos.resource.definitions.network.StaticHostSpec
-	(*StatusSpec)(nil),                         // 61: talos.resource.definitions.network.StatusSpec
-	(*TCPProbeSpec)(nil),                       // 62: talos.resource.definitions.network.TCPProbeSpec
-	(*TimeServerSpecSpec)(nil),                 // 63: talos.resource.definitions.network.TimeServerSpecSpec
-	(*TimeServerStatusSpec)(nil),               // 64: talos.resource.definitions.network.TimeServerStatusSpec
-	(*VIPEquinixMetalSpec)(nil),                // 65: talos.resource.definitions.network.VIPEquinixMetalSpec
-	(*VIPHCloudSpec)(nil),                      // 66: talos.resource.definitions.network.VIPHCloudSpec
-	(*VIPOperatorSpec)(nil),                    // 67: talos.resource.definitions.network.VIPOperatorSpec
-	(*VLANSpec)(nil),                           // 68: talos.resource.definitions.network.VLANSpec
-	(*VRFMasterSpec)(nil),                      // 69: talos.resource.definitions.network.VRFMasterSpec
-	(*VRFSlave)(nil),                           // 70: talos.resource.definitions.network.VRFSlave
-	(*VethSpec)(nil),                           // 71: talos.resource.definitions.network.VethSpec
-	(*WireguardPeer)(nil),                      // 72: talos.resource.definitions.network.WireguardPeer
-	(*WireguardSpec)(nil),                      // 73: talos.resource.definitions.network.WireguardSpec
-	nil,                                        // 74: talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
-	(*common.NetIPPrefix)(nil),                 // 75: common.NetIPPrefix
-	(enums.NethelpersFamily)(0),                // 76: talos.resource.definitions.enums.NethelpersFamily
-	(enums.NethelpersScope)(0),                 // 77: talos.resource.definitions.enums.NethelpersScope
-	(enums.NetworkConfigLayer)(0),              // 78: talos.resource.definitions.enums.NetworkConfigLayer
-	(*common.NetIP)(nil),                       // 79: common.NetIP
-	(*durationpb.Duration)(nil),                // 80: google.protobuf.Duration
-	(enums.NethelpersRoutingTable)(0),          // 81: talos.resource.definitions.enums.NethelpersRoutingTable
-	(enums.NethelpersBGPSessionState)(0),       // 82: talos.resource.definitions.enums.NethelpersBGPSessionState
-	(*timestamppb.Timestamp)(nil),              // 83: google.protobuf.Timestamp
-	(enums.NethelpersBondMode)(0),              // 84: talos.resource.definitions.enums.NethelpersBondMode
-	(enums.NethelpersBondXmitHashPolicy)(0),    // 85: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
-	(enums.NethelpersLACPRate)(0),              // 86: talos.resource.definitions.enums.NethelpersLACPRate
-	(enums.NethelpersARPValidate)(0),           // 87: talos.resource.definitions.enums.NethelpersARPValidate
-	(enums.NethelpersARPAllTargets)(0),         // 88: talos.resource.definitions.enums.NethelpersARPAllTargets
-	(enums.NethelpersPrimaryReselect)(0),       // 89: talos.resource.definitions.enums.NethelpersPrimaryReselect
-	(enums.NethelpersFailOverMAC)(0),           // 90: talos.resource.definitions.enums.NethelpersFailOverMAC
-	(enums.NethelpersADSelect)(0),              // 91: talos.resource.definitions.enums.NethelpersADSelect
-	(enums.NethelpersADLACPActive)(0),          // 92: talos.resource.definitions.enums.NethelpersADLACPActive
-	(enums.NethelpersClientIdentifier)(0),      // 93: talos.resource.definitions.enums.NethelpersClientIdentifier
-	(enums.NethelpersWOLMode)(0),               // 94: talos.resource.definitions.enums.NethelpersWOLMode
-	(enums.NethelpersPort)(0),                  // 95: talos.resource.definitions.enums.NethelpersPort
-	(enums.NethelpersDuplex)(0),                // 96: talos.resource.definitions.enums.NethelpersDuplex
-	(*common.URL)(nil),                         // 97: common.URL
-	(*common.NetIPPort)(nil),                   // 98: common.NetIPPort
-	(enums.NethelpersLinkType)(0),              // 99: talos.resource.definitions.enums.NethelpersLinkType
-	(enums.NethelpersOperationalState)(0),      // 100: talos.resource.definitions.enums.NethelpersOperationalState
-	(enums.NethelpersDNSProtocol)(0),           // 101: talos.resource.definitions.enums.NethelpersDNSProtocol
-	(enums.NethelpersNfTablesChainHook)(0),     // 102: talos.resource.definitions.enums.NethelpersNfTablesChainHook
-	(enums.NethelpersNfTablesChainPriority)(0), // 103: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
-	(enums.NethelpersNfTablesVerdict)(0),       // 104: talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	(enums.NethelpersConntrackState)(0),        // 105: talos.resource.definitions.enums.NethelpersConntrackState
-	(enums.NethelpersICMPType)(0),              // 106: talos.resource.definitions.enums.NethelpersICMPType
-	(enums.NethelpersMatchOperator)(0),         // 107: talos.resource.definitions.enums.NethelpersMatchOperator
-	(enums.NethelpersProtocol)(0),              // 108: talos.resource.definitions.enums.NethelpersProtocol
-	(enums.NethelpersAddressSortAlgorithm)(0),  // 109: talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	(enums.NetworkOperator)(0),                 // 110: talos.resource.definitions.enums.NetworkOperator
-	(*runtime.PlatformMetadataSpec)(nil),       // 111: talos.resource.definitions.runtime.PlatformMetadataSpec
-	(enums.NethelpersRouteType)(0),             // 112: talos.resource.definitions.enums.NethelpersRouteType
-	(enums.NethelpersRouteProtocol)(0),         // 113: talos.resource.definitions.enums.NethelpersRouteProtocol
-	(enums.NethelpersRoutingRuleAction)(0),     // 114: talos.resource.definitions.enums.NethelpersRoutingRuleAction
-	(enums.NethelpersVLANProtocol)(0),          // 115: talos.resource.definitions.enums.NethelpersVLANProtocol
+	(*BGPAdvertiseProbeSpec)(nil),              // 2: talos.resource.definitions.network.BGPAdvertiseProbeSpec
+	(*BGPBFDConfigSpec)(nil),                   // 3: talos.resource.definitions.network.BGPBFDConfigSpec
+	(*BGPGracefulRestartSpec)(nil),             // 4: talos.resource.definitions.network.BGPGracefulRestartSpec
+	(*BGPImportRouteSpec)(nil),                 // 5: talos.resource.definitions.network.BGPImportRouteSpec
+	(*BGPInstanceConfigSpec)(nil),              // 6: talos.resource.definitions.network.BGPInstanceConfigSpec
+	(*BGPNeighborConfigSpec)(nil),              // 7: talos.resource.definitions.network.BGPNeighborConfigSpec
+	(*BGPPeerStatusSpec)(nil),                  // 8: talos.resource.definitions.network.BGPPeerStatusSpec
+	(*BGPPrefixFilterSpec)(nil),                // 9: talos.resource.definitions.network.BGPPrefixFilterSpec
+	(*BGPRoutePolicySpec)(nil),                 // 10: talos.resource.definitions.network.BGPRoutePolicySpec
+	(*BondMasterSpec)(nil),                     // 11: talos.resource.definitions.network.BondMasterSpec
+	(*BondSlave)(nil),                          // 12: talos.resource.definitions.network.BondSlave
+	(*BridgeMasterSpec)(nil),                   // 13: talos.resource.definitions.network.BridgeMasterSpec
+	(*BridgeSlave)(nil),                        // 14: talos.resource.definitions.network.BridgeSlave
+	(*BridgeVLANSpec)(nil),                     // 15: talos.resource.definitions.network.BridgeVLANSpec
+	(*ClientIdentifierSpec)(nil),               // 16: talos.resource.definitions.network.ClientIdentifierSpec
+	(*DHCP4OperatorSpec)(nil),                  // 17: talos.resource.definitions.network.DHCP4OperatorSpec
+	(*DHCP6OperatorSpec)(nil),                  // 18: talos.resource.definitions.network.DHCP6OperatorSpec
+	(*DHCP6PrefixDownstreamSpec)(nil),          // 19: talos.resource.definitions.network.DHCP6PrefixDownstreamSpec
+	(*DHCPOption)(nil),                         // 20: talos.resource.definitions.network.DHCPOption
+	(*DHCPOptionsSpec)(nil),                    // 21: talos.resource.definitions.network.DHCPOptionsSpec
+	(*DNSResolveCacheSpec)(nil),                // 22: talos.resource.definitions.network.DNSResolveCacheSpec
+	(*DelegatedPrefixSpec)(nil),                // 23: talos.resource.definitions.network.DelegatedPrefixSpec
+	(*EthernetChannelsSpec)(nil),               // 24: talos.resource.definitions.network.EthernetChannelsSpec
+	(*EthernetChannelsStatus)(nil),             // 25: talos.resource.definitions.network.EthernetChannelsStatus
+	(*EthernetFeatureStatus)(nil),              // 26: talos.resource.definitions.network.EthernetFeatureStatus
+	(*EthernetRingsSpec)(nil),                  // 27: talos.resource.definitions.network.EthernetRingsSpec
+	(*EthernetRingsStatus)(nil),                // 28: talos.resource.definitions.network.EthernetRingsStatus
+	(*EthernetSpecSpec)(nil),                   // 29: talos.resource.definitions.network.EthernetSpecSpec
+	(*EthernetStatusSpec)(nil),                 // 30: talos.resource.definitions.network.EthernetStatusSpec
+	(*GRESpec)(nil),                            // 31: talos.resource.definitions.network.GRESpec
+	(*GeneveSpec)(nil),                         // 32: talos.resource.definitions.network.GeneveSpec
+	(*HTTPProbeSpec)(nil),                      // 33: talos.resource.definitions.network.HTTPProbeSpec
+	(*HardwareAddrSpec)(nil),                   // 34: talos.resource.definitions.network.HardwareAddrSpec
+	(*HostDNSConfigSpec)(nil),                  // 35: talos.resource.definitions.network.HostDNSConfigSpec
+	(*HostDNSForwarderSpec)(nil),               // 36: talos.resource.definitions.network.HostDNSForwarderSpec
+	(*HostnameSpecSpec)(nil),                   // 37: talos.resource.definitions.network.HostnameSpecSpec
+	(*HostnameStatusSpec)(nil),                 // 38: talos.resource.definitions.network.HostnameStatusSpec
+	(*IPVLANSpec)(nil),                         // 39: talos.resource.definitions.network.IPVLANSpec
+	(*LinkAliasSpecSpec)(nil),                  // 40: talos.resource.definitions.network.LinkAliasSpecSpec
+	(*LinkRefreshSpec)(nil),                    // 41: talos.resource.definitions.network.LinkRefreshSpec
+	(*LinkSpecSpec)(nil),                       // 42: talos.resource.definitions.network.LinkSpecSpec
+	(*LinkStatusSpec)(nil),                     // 43: talos.resource.definitions.network.LinkStatusSpec
+	(*MACVLANSpec)(nil),                        // 44: talos.resource.definitions.network.MACVLANSpec
+	(*NameServerSpec)(nil),                     // 45: talos.resource.definitions.network.NameServerSpec
+	(*NfTablesAddressMatch)(nil),               // 46: talos.resource.definitions.network.NfTablesAddressMatch
+	(*NfTablesChainSpec)(nil),                  // 47: talos.resource.definitions.network.NfTablesChainSpec
+	(*NfTablesChainStatusSpec)(nil),            // 48: talos.resource.definitions.network.NfTablesChainStatusSpec
+	(*NfTablesClampMSS)(nil),                   // 49: talos.resource.definitions.network.NfTablesClampMSS
+	(*NfTablesConntrackStateMatch)(nil),        // 50: talos.resource.definitions.network.NfTablesConntrackStateMatch
+	(*NfTablesICMPTypeMatch)(nil),              // 51: talos.resource.definitions.network.NfTablesICMPTypeMatch
+	(*NfTablesIfNameMatch)(nil),                // 52: talos.resource.definitions.network.NfTablesIfNameMatch
+	(*NfTablesLayer4Match)(nil),                // 53: talos.resource.definitions.network.NfTablesLayer4Match
+	(*NfTablesLimitMatch)(nil),                 // 54: talos.resource.definitions.network.NfTablesLimitMatch
+	(*NfTablesMark)(nil),                       // 55: talos.resource.definitions.network.NfTablesMark
+	(*NfTablesNAT)(nil),                        // 56: talos.resource.definitions.network.NfTablesNAT
+	(*NfTablesPortMatch)(nil),                  // 57: talos.resource.definitions.network.NfTablesPortMatch
+	(*NfTablesRule)(nil),                       // 58: talos.resource.definitions.network.NfTablesRule
+	(*NfTablesRuleCounter)(nil),                // 59: talos.resource.definitions.network.NfTablesRuleCounter
+	(*NfTablesSourceConnectionLimitMatch)(nil), // 60: talos.resource.definitions.network.NfTablesSourceConnectionLimitMatch
+	(*NfTablesSourceRateLimitMatch)(nil),       // 61: talos.resource.definitions.network.NfTablesSourceRateLimitMatch
+	(*NodeAddressFilterSpec)(nil),              // 62: talos.resource.definitions.network.NodeAddressFilterSpec
+	(*NodeAddressSortAlgorithmSpec)(nil),       // 63: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec
+	(*NodeAddressSpec)(nil),                    // 64: talos.resource.definitions.network.NodeAddressSpec
+	(*OperatorSpecSpec)(nil),                   // 65: talos.resource.definitions.network.OperatorSpecSpec
+	(*PlatformConfigSpec)(nil),                 // 66: talos.resource.definitions.network.PlatformConfigSpec
+	(*PortRange)(nil),                          // 67: talos.resource.definitions.network.PortRange
+	(*ProbeSpecSpec)(nil),                      // 68: talos.resource.definitions.network.ProbeSpecSpec
+	(*ProbeStatusSpec)(nil),                    // 69: talos.resource.definitions.network.ProbeStatusSpec
+	(*ResolverSpecSpec)(nil),                   // 70: talos.resource.definitions.network.ResolverSpecSpec
+	(*ResolverStatusSpec)(nil),                 // 71: talos.resource.definitions.network.ResolverStatusSpec
+	(*RouteNextHop)(nil),                       // 72: talos.resource.definitions.network.RouteNextHop
+	(*RouteSpecSpec)(nil),                      // 73: talos.resource.definitions.network.RouteSpecSpec
+	(*RouteStatusSpec)(nil),                    // 74: talos.resource.definitions.network.RouteStatusSpec
+	(*RoutingRuleSpecSpec)(nil),                // 75: talos.resource.definitions.network.RoutingRuleSpecSpec
+	(*RoutingRuleStatusSpec)(nil),              // 76: talos.resource.definitions.network.RoutingRuleStatusSpec
+	(*STPSpec)(nil),                            // 77: talos.resource.definitions.network.STPSpec
+	(*StaticHostSpec)(nil),                     // 78: talos.resource.definitions.network.StaticHostSpec
+	(*StatusSpec)(nil),                         // 79: talos.resource.definitions.network.StatusSpec
+	(*TCPProbeSpec)(nil),                       // 80: talos.resource.definitions.network.TCPProbeSpec
+	(*TimeServerSpecSpec)(nil),                 // 81: talos.resource.definitions.network.TimeServerSpecSpec
+	(*TimeServerStatusSpec)(nil),               // 82: talos.resource.definitions.network.TimeServerStatusSpec
+	(*VIPEquinixMetalSpec)(nil),                // 83: talos.resource.definitions.network.VIPEquinixMetalSpec
+	(*VIPHCloudSpec)(nil),                      // 84: talos.resource.definitions.network.VIPHCloudSpec
+	(*VIPOperatorSpec)(nil),                    // 85: talos.resource.definitions.network.VIPOperatorSpec
+	(*VLANSpec)(nil),                           // 86: talos.resource.definitions.network.VLANSpec
+	(*VRFMasterSpec)(nil),                      // 87: talos.resource.definitions.network.VRFMasterSpec
+	(*VRFSlave)(nil),                           // 88: talos.resource.definitions.network.VRFSlave
+	(*VXLANSpec)(nil),                          // 89: talos.resource.definitions.network.VXLANSpec
+	(*VethSpec)(nil),                           // 90: talos.resource.definitions.network.VethSpec
+	(*WireguardPeer)(nil),                      // 91: talos.resource.definitions.network.WireguardPeer
+	(*WireguardSpec)(nil),                      // 92: talos.resource.definitions.network.WireguardSpec
+	nil,                                        // 93: talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
+	(*common.NetIPPrefix)(nil),                 // 94: common.NetIPPrefix
+	(enums.NethelpersFamily)(0),                // 95: talos.resource.definitions.enums.NethelpersFamily
+	(enums.NethelpersScope)(0),                 // 96: talos.resource.definitions.enums.NethelpersScope
+	(enums.NetworkConfigLayer)(0),              // 97: talos.resource.definitions.enums.NetworkConfigLayer
+	(*common.NetIP)(nil),                       // 98: common.NetIP
+	(*durationpb.Duration)(nil),                // 99: google.protobuf.Duration
+	(enums.NethelpersRoutingTable)(0),          // 100: talos.resource.definitions.enums.NethelpersRoutingTable
+	(enums.NethelpersBGPSessionState)(0),       // 101: talos.resource.definitions.enums.NethelpersBGPSessionState
+	(*timestamppb.Timestamp)(nil),              // 102: google.protobuf.Timestamp
+	(enums.NethelpersBondMode)(0),              // 103: talos.resource.definitions.enums.NethelpersBondMode
+	(enums.NethelpersBondXmitHashPolicy)(0),    // 104: talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	(enums.NethelpersLACPRate)(0),              // 105: talos.resource.definitions.enums.NethelpersLACPRate
+	(enums.NethelpersARPValidate)(0),           // 106: talos.resource.definitions.enums.NethelpersARPValidate
+	(enums.NethelpersARPAllTargets)(0),         // 107: talos.resource.definitions.enums.NethelpersARPAllTargets
+	(enums.NethelpersPrimaryReselect)(0),       // 108: talos.resource.definitions.enums.NethelpersPrimaryReselect
+	(enums.NethelpersFailOverMAC)(0),           // 109: talos.resource.definitions.enums.NethelpersFailOverMAC
+	(enums.NethelpersADSelect)(0),              // 110: talos.resource.definitions.enums.NethelpersADSelect
+	(enums.NethelpersADLACPActive)(0),          // 111: talos.resource.definitions.enums.NethelpersADLACPActive
+	(enums.NethelpersClientIdentifier)(0),      // 112: talos.resource.definitions.enums.NethelpersClientIdentifier
+	(enums.NetworkOperator)(0),                 // 113: talos.resource.definitions.enums.NetworkOperator
+	(enums.NethelpersWOLMode)(0),               // 114: talos.resource.definitions.enums.NethelpersWOLMode
+	(enums.NethelpersPort)(0),                  // 115: talos.resource.definitions.enums.NethelpersPort
+	(enums.NethelpersDuplex)(0),                // 116: talos.resource.definitions.enums.NethelpersDuplex
+	(*common.URL)(nil),                         // 117: common.URL
+	(*common.NetIPPort)(nil),                   // 118: common.NetIPPort
+	(enums.NethelpersIPVLANMode)(0),            // 119: talos.resource.definitions.enums.NethelpersIPVLANMode
+	(enums.NethelpersLinkType)(0),              // 120: talos.resource.definitions.enums.NethelpersLinkType
+	(enums.NethelpersOperationalState)(0),      // 121: talos.resource.definitions.enums.NethelpersOperationalState
+	(enums.NethelpersMACVLANMode)(0),           // 122: talos.resource.definitions.enums.NethelpersMACVLANMode
+	(enums.NethelpersDNSProtocol)(0),           // 123: talos.resource.definitions.enums.NethelpersDNSProtocol
+	(enums.NethelpersNfTablesChainHook)(0),     // 124: talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	(enums.NethelpersNfTablesChainPriority)(0), // 125: talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	(enums.NethelpersNfTablesVerdict)(0),       // 126: talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	(enums.NethelpersConntrackState)(0),        // 127: talos.resource.definitions.enums.NethelpersConntrackState
+	(enums.NethelpersICMPType)(0),              // 128: talos.resource.definitions.enums.NethelpersICMPType
+	(enums.NethelpersMatchOperator)(0),         // 129: talos.resource.definitions.enums.NethelpersMatchOperator
+	(enums.NethelpersProtocol)(0),              // 130: talos.resource.definitions.enums.NethelpersProtocol
+	(enums.NethelpersAddressSortAlgorithm)(0),  // 131: talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	(*runtime.PlatformMetadataSpec)(nil),       // 132: talos.resource.definitions.runtime.PlatformMetadataSpec
+	(enums.NethelpersRouteType)(0),             // 133: talos.resource.definitions.enums.NethelpersRouteType
+	(enums.NethelpersRouteProtocol)(0),         // 134: talos.resource.definitions.enums.NethelpersRouteProtocol
+	(enums.NethelpersRoutingRuleAction)(0),     // 135: talos.resource.definitions.enums.NethelpersRoutingRuleAction
+	(enums.NethelpersVLANProtocol)(0),          // 136: talos.resource.definitions.enums.NethelpersVLANProtocol
 }
 var file_resource_definitions_network_network_proto_depIdxs = []int32{
-	75,  // 0: talos.resource.definitions.network.AddressSpecSpec.address:type_name -> common.NetIPPrefix
-	76,  // 1: talos.resource.definitions.network.AddressSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	77,  // 2: talos.resource.definitions.network.AddressSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	78,  // 3: talos.resource.definitions.network.AddressSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	75,  // 4: talos.resource.definitions.network.AddressStatusSpec.address:type_name -> common.NetIPPrefix
-	79,  // 5: talos.resource.definitions.network.AddressStatusSpec.local:type_name -> common.NetIP
-	79,  // 6: talos.resource.definitions.network.AddressStatusSpec.broadcast:type_name -> common.NetIP
-	79,  // 7: talos.resource.definitions.network.AddressStatusSpec.anycast:type_name -> common.NetIP
-	79,  // 8: talos.resource.definitions.network.AddressStatusSpec.multicast:type_name -> common.NetIP
-	76,  // 9: talos.resource.definitions.network.AddressStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	77,  // 10: talos.resource.definitions.network.AddressStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	80,  // 11: talos.resource.definitions.network.BGPBFDConfigSpec.transmit_interval:type_name -> google.protobuf.Duration
-	80,  // 12: talos.resource.definitions.network.BGPBFDConfigSpec.receive_interval:type_name -> google.protobuf.Duration
-	75,  // 13: talos.resource.definitions.network.BGPImportRouteSpec.prefixes:type_name -> common.NetIPPrefix
-	79,  // 14: talos.resource.definitions.network.BGPInstanceConfigSpec.router_id:type_name -> common.NetIP
-	79,  // 15: talos.resource.definitions.network.BGPInstanceConfigSpec.route_source:type_name -> common.NetIP
-	5,   // 16: talos.resource.definitions.network.BGPInstanceConfigSpec.neighbors:type_name -> talos.resource.definitions.network.BGPNeighborConfigSpec
-	81,  // 17: talos.resource.definitions.network.BGPInstanceConfigSpec.vrf_table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	3,   // 18: talos.resource.definitions.network.BGPInstanceConfigSpec.import_routes:type_name -> talos.resource.definitions.network.BGPImportRouteSpec
-	79,  // 19: talos.resource.definitions.network.BGPNeighborConfigSpec.address:type_name -> common.NetIP
-	80,  // 20: talos.resource.definitions.network.BGPNeighborConfigSpec.hold_time:type_name -> google.protobuf.Duration
-	2,   // 21: talos.resource.definitions.network.BGPNeighborConfigSpec.bfd:type_name -> talos.resource.definitions.network.BGPBFDConfigSpec
-	82,  // 22: talos.resource.definitions.network.BGPPeerStatusSpec.state:type_name -> talos.resource.definitions.enums.NethelpersBGPSessionState
-	79,  // 23: talos.resource.definitions.network.BGPPeerStatusSpec.router_id:type_name -> common.NetIP
-	83,  // 24: talos.resource.definitions.network.BGPPeerStatusSpec.since:type_name -> google.protobuf.Timestamp
-	84,  // 25: talos.resource.definitions.network.BondMasterSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersBondMode
-	85,  // 26: talos.resource.definitions.network.BondMasterSpec.hash_policy:type_name -> talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
-	86,  // 27: talos.resource.definitions.network.BondMasterSpec.lacp_rate:type_name -> talos.resource.definitions.enums.NethelpersLACPRate
-	87,  // 28: talos.resource.definitions.network.BondMasterSpec.arp_validate:type_name -> talos.resource.definitions.enums.NethelpersARPValidate
-	88,  // 29: talos.resource.definitions.network.BondMasterSpec.arp_all_targets:type_name -> talos.resource.definitions.enums.NethelpersARPAllTargets
-	89,  // 30: talos.resource.definitions.network.BondMasterSpec.primary_reselect:type_name -> talos.resource.definitions.enums.NethelpersPrimaryReselect
-	90,  // 31: talos.resource.definitions.network.BondMasterSpec.fail_over_mac:type_name -> talos.resource.definitions.enums.NethelpersFailOverMAC
-	91,  // 32: talos.resource.definitions.network.BondMasterSpec.ad_select:type_name -> talos.resource.definitions.enums.NethelpersADSelect
-	79,  // 33: talos.resource.definitions.network.BondMasterSpec.arpip_targets:type_name -> common.NetIP
-	79,  // 34: talos.resource.definitions.network.BondMasterSpec.nsip6_targets:type_name -> common.NetIP
-	92,  // 35: talos.resource.definitions.network.BondMasterSpec.adlacp_active:type_name -> talos.resource.definitions.enums.NethelpersADLACPActive
-	59,  // 36: talos.resource.definitions.network.BridgeMasterSpec.stp:type_name -> talos.resource.definitions.network.STPSpec
-	11,  // 37: talos.resource.definitions.network.BridgeMasterSpec.vlan:type_name -> talos.resource.definitions.network.BridgeVLANSpec
-	93,  // 38: talos.resource.definitions.network.ClientIdentifierSpec.client_identifier:type_name -> talos.resource.definitions.enums.NethelpersClientIdentifier
-	12,  // 39: talos.resource.definitions.network.DHCP4OperatorSpec.client_identifier:type_name -> talos.resource.definitions.network.ClientIdentifierSpec
-	12,  // 40: talos.resource.definitions.network.DHCP6OperatorSpec.client_identifier:type_name -> talos.resource.definitions.network.ClientIdentifierSpec
-	19,  // 41: talos.resource.definitions.network.EthernetSpecSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsSpec
-	74,  // 42: talos.resource.definitions.network.EthernetSpecSpec.features:type_name -> talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
-	16,  // 43: talos.resource.definitions.network.EthernetSpecSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsSpec
-	94,  // 44: talos.resource.definitions.network.EthernetSpecSpec.wake_on_lan:type_name -> talos.resource.definitions.enums.NethelpersWOLMode
-	95,  // 45: talos.resource.definitions.network.EthernetStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
-	96,  // 46: talos.resource.definitions.network.EthernetStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
-	20,  // 47: talos.resource.definitions.network.EthernetStatusSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsStatus
-	18,  // 48: talos.resource.definitions.network.EthernetStatusSpec.features:type_name -> talos.resource.definitions.network.EthernetFeatureStatus
-	17,  // 49: talos.resource.definitions.network.EthernetStatusSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsStatus
-	94,  // 50: talos.resource.definitions.network.EthernetStatusSpec.wake_on_lan:type_name -> talos.resource.definitions.enums.NethelpersWOLMode
-	97,  // 51: talos.resource.definitions.network.HTTPProbeSpec.url:type_name -> common.URL
-	80,  // 52: talos.resource.definitions.network.HTTPProbeSpec.timeout:type_name -> google.protobuf.Duration
-	98,  // 53: talos.resource.definitions.network.HostDNSConfigSpec.listen_addresses:type_name -> common.NetIPPort
-	79,  // 54: talos.resource.definitions.network.HostDNSConfigSpec.service_host_dns_address:type_name -> common.NetIP
-	79,  // 55: talos.resource.definitions.network.HostDNSConfigSpec.service_host_dns_address_v6:type_name -> common.NetIP
-	78,  // 56: talos.resource.definitions.network.HostnameSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	99,  // 57: talos.resource.definitions.network.LinkSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
-	8,   // 58: talos.resource.definitions.network.LinkSpecSpec.bond_slave:type_name -> talos.resource.definitions.network.BondSlave
-	10,  // 59: talos.resource.definitions.network.LinkSpecSpec.bridge_slave:type_name -> talos.resource.definitions.network.BridgeSlave
-	68,  // 60: talos.resource.definitions.network.LinkSpecSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
-	7,   // 61: talos.resource.definitions.network.LinkSpecSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
-	9,   // 62: talos.resource.definitions.network.LinkSpecSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
-	73,  // 63: talos.resource.definitions.network.LinkSpecSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
-	78,  // 64: talos.resource.definitions.network.LinkSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	69,  // 65: talos.resource.definitions.network.LinkSpecSpec.vrf_master:type_name -> talos.resource.definitions.network.VRFMasterSpec
-	70,  // 66: talos.resource.definitions.network.LinkSpecSpec.vrf_slave:type_name -> talos.resource.definitions.network.VRFSlave
-	71,  // 67: talos.resource.definitions.network.LinkSpecSpec.veth:type_name -> talos.resource.definitions.network.VethSpec
-	99,  // 68: talos.resource.definitions.network.LinkStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
-	100, // 69: talos.resource.definitions.network.LinkStatusSpec.operational_state:type_name -> talos.resource.definitions.enums.NethelpersOperationalState
-	95,  // 70: talos.resource.definitions.network.LinkStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
-	96,  // 71: talos.resource.definitions.network.LinkStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
-	68,  // 72: talos.resource.definitions.network.LinkStatusSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
-	9,   // 73: talos.resource.definitions.network.LinkStatusSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
-	7,   // 74: talos.resource.definitions.network.LinkStatusSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
-	73,  // 75: talos.resource.definitions.network.LinkStatusSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
-	69,  // 76: talos.resource.definitions.network.LinkStatusSpec.vrf_master:type_name -> talos.resource.definitions.network.VRFMasterSpec
-	71,  // 77: talos.resource.definitions.network.LinkStatusSpec.veth:type_name -> talos.resource.definitions.network.VethSpec
-	79,  // 78: talos.resource.definitions.network.NameServerSpec.addr:type_name -> common.NetIP
-	101, // 79: talos.resource.definitions.network.NameServerSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersDNSProtocol
-	75,  // 80: talos.resource.definitions.network.NfTablesAddressMatch.include_subnets:type_name -> common.NetIPPrefix
-	75,  // 81: talos.resource.definitions.network.NfTablesAddressMatch.exclude_subnets:type_name -> common.NetIPPrefix
-	102, // 82: talos.resource.definitions.network.NfTablesChainSpec.hook:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainHook
-	103, // 83: talos.resource.definitions.network.NfTablesChainSpec.priority:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainPriority
-	43,  // 84: talos.resource.definitions.network.NfTablesChainSpec.rules:type_name -> talos.resource.definitions.network.NfTablesRule
-	104, // 85: talos.resource.definitions.network.NfTablesChainSpec.policy:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	105, // 86: talos.resource.definitions.network.NfTablesConntrackStateMatch.states:type_name -> talos.resource.definitions.enums.NethelpersConntrackState
-	106, // 87: talos.resource.definitions.network.NfTablesICMPTypeMatch.types:type_name -> talos.resource.definitions.enums.NethelpersICMPType
-	107, // 88: talos.resource.definitions.network.NfTablesIfNameMatch.operator:type_name -> talos.resource.definitions.enums.NethelpersMatchOperator
-	108, // 89: talos.resource.definitions.network.NfTablesLayer4Match.protocol:type_name -> talos.resource.definitions.enums.NethelpersProtocol
-	42,  // 90: talos.resource.definitions.network.NfTablesLayer4Match.match_source_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
-	42,  // 91: talos.resource.definitions.network.NfTablesLayer4Match.match_destination_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
-	37,  // 92: talos.resource.definitions.network.NfTablesLayer4Match.match_icmp_type:type_name -> talos.resource.definitions.network.NfTablesICMPTypeMatch
-	49,  // 93: talos.resource.definitions.network.NfTablesPortMatch.ranges:type_name -> talos.resource.definitions.network.PortRange
-	38,  // 94: talos.resource.definitions.network.NfTablesRule.match_o_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
-	104, // 95: talos.resource.definitions.network.NfTablesRule.verdict:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
-	41,  // 96: talos.resource.definitions.network.NfTablesRule.match_mark:type_name -> talos.resource.definitions.network.NfTablesMark
-	41,  // 97: talos.resource.definitions.network.NfTablesRule.set_mark:type_name -> talos.resource.definitions.network.NfTablesMark
-	33,  // 98: talos.resource.definitions.network.NfTablesRule.match_source_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
-	33,  // 99: talos.resource.definitions.network.NfTablesRule.match_destination_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
-	39,  // 100: talos.resource.definitions.network.NfTablesRule.match_layer4:type_name -> talos.resource.definitions.network.NfTablesLayer4Match
-	38,  // 101: talos.resource.definitions.network.NfTablesRule.match_i_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
-	35,  // 102: talos.resource.definitions.network.NfTablesRule.clamp_mss:type_name -> talos.resource.definitions.network.NfTablesClampMSS
-	40,  // 103: talos.resource.definitions.network.NfTablesRule.match_limit:type_name -> talos.resource.definitions.network.NfTablesLimitMatch
-	36,  // 104: talos.resource.definitions.network.NfTablesRule.match_conntrack_state:type_name -> talos.resource.definitions.network.NfTablesConntrackStateMatch
-	75,  // 105: talos.resource.definitions.network.NodeAddressFilterSpec.include_subnets:type_name -> common.NetIPPrefix
-	75,  // 106: talos.resource.definitions.network.NodeAddressFilterSpec.exclude_subnets:type_name -> common.NetIPPrefix
-	109, // 107: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec.algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	75,  // 108: talos.resource.definitions.network.NodeAddressSpec.addresses:type_name -> common.NetIPPrefix
-	109, // 109: talos.resource.definitions.network.NodeAddressSpec.sort_algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
-	110, // 110: talos.resource.definitions.network.OperatorSpecSpec.operator:type_name -> talos.resource.definitions.enums.NetworkOperator
-	13,  // 111: talos.resource.definitions.network.OperatorSpecSpec.dhcp4:type_name -> talos.resource.definitions.network.DHCP4OperatorSpec
-	14,  // 112: talos.resource.definitions.network.OperatorSpecSpec.dhcp6:type_name -> talos.resource.definitions.network.DHCP6OperatorSpec
-	67,  // 113: talos.resource.definitions.network.OperatorSpecSpec.vip:type_name -> talos.resource.definitions.network.VIPOperatorSpec
-	78,  // 114: talos.resource.definitions.network.OperatorSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	0,   // 115: talos.resource.definitions.network.PlatformConfigSpec.addresses:type_name -> talos.resource.definitions.network.AddressSpecSpec
-	30,  // 116: talos.resource.definitions.network.PlatformConfigSpec.links:type_name -> talos.resource.definitions.network.LinkSpecSpec
-	55,  // 117: talos.resource.definitions.network.PlatformConfigSpec.routes:type_name -> talos.resource.definitions.network.RouteSpecSpec
-	26,  // 118: talos.resource.definitions.network.PlatformConfigSpec.hostnames:type_name -> talos.resource.definitions.network.HostnameSpecSpec
-	52,  // 119: talos.resource.definitions.network.PlatformConfigSpec.resolvers:type_name -> talos.resource.definitions.network.ResolverSpecSpec
-	63,  // 120: talos.resource.definitions.network.PlatformConfigSpec.time_servers:type_name -> talos.resource.definitions.network.TimeServerSpecSpec
-	47,  // 121: talos.resource.definitions.network.PlatformConfigSpec.operators:type_name -> talos.resource.definitions.network.OperatorSpecSpec
-	79,  // 122: talos.resource.definitions.network.PlatformConfigSpec.external_ips:type_name -> common.NetIP
-	50,  // 123: talos.resource.definitions.network.PlatformConfigSpec.probes:type_name -> talos.resource.definitions.network.ProbeSpecSpec
-	111, // 124: talos.resource.definitions.network.PlatformConfigSpec.metadata:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec
-	80,  // 125: talos.resource.definitions.network.ProbeSpecSpec.interval:type_name -> google.protobuf.Duration
-	62,  // 126: talos.resource.definitions.network.ProbeSpecSpec.tcp:type_name -> talos.resource.definitions.network.TCPProbeSpec
-	78,  // 127: talos.resource.definitions.network.ProbeSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	23,  // 128: talos.resource.definitions.network.ProbeSpecSpec.http:type_name -> talos.resource.definitions.network.HTTPProbeSpec
-	79,  // 129: talos.resource.definitions.network.ResolverSpecSpec.dns_servers:type_name -> common.NetIP
-	78,  // 130: talos.resource.definitions.network.ResolverSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	32,  // 131: talos.resource.definitions.network.ResolverSpecSpec.name_servers:type_name -> talos.resource.definitions.network.NameServerSpec
-	79,  // 132: talos.resource.definitions.network.ResolverStatusSpec.dns_servers:type_name -> common.NetIP
-	32,  // 133: talos.resource.definitions.network.ResolverStatusSpec.name_servers:type_name -> talos.resource.definitions.network.NameServerSpec
-	79,  // 134: talos.resource.definitions.network.RouteNextHop.gateway:type_name -> common.NetIP
-	76,  // 135: talos.resource.definitions.network.RouteSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	75,  // 136: talos.resource.definitions.network.RouteSpecSpec.destination:type_name -> common.NetIPPrefix
-	79,  // 137: talos.resource.definitions.network.RouteSpecSpec.source:type_name -> common.NetIP
-	79,  // 138: talos.resource.definitions.network.RouteSpecSpec.gateway:type_name -> common.NetIP
-	81,  // 139: talos.resource.definitions.network.RouteSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	77,  // 140: talos.resource.definitions.network.RouteSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	112, // 141: talos.resource.definitions.network.RouteSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
-	113, // 142: talos.resource.definitions.network.RouteSpecSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
-	78,  // 143: talos.resource.definitions.network.RouteSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	54,  // 144: talos.resource.definitions.network.RouteSpecSpec.next_hops:type_name -> talos.resource.definitions.network.RouteNextHop
-	76,  // 145: talos.resource.definitions.network.RouteStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	75,  // 146: talos.resource.definitions.network.RouteStatusSpec.destination:type_name -> common.NetIPPrefix
-	79,  // 147: talos.resource.definitions.network.RouteStatusSpec.source:type_name -> common.NetIP
-	79,  // 148: talos.resource.definitions.network.RouteStatusSpec.gateway:type_name -> common.NetIP
-	81,  // 149: talos.resource.definitions.network.RouteStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	77,  // 150: talos.resource.definitions.network.RouteStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
-	112, // 151: talos.resource.definitions.network.RouteStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
-	113, // 152: talos.resource.definitions.network.RouteStatusSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
-	54,  // 153: talos.resource.definitions.network.RouteStatusSpec.next_hops:type_name -> talos.resource.definitions.network.RouteNextHop
-	76,  // 154: talos.resource.definitions.network.RoutingRuleSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	75,  // 155: talos.resource.definitions.network.RoutingRuleSpecSpec.src:type_name -> common.NetIPPrefix
-	75,  // 156: talos.resource.definitions.network.RoutingRuleSpecSpec.dst:type_name -> common.NetIPPrefix
-	81,  // 157: talos.resource.definitions.network.RoutingRuleSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	114, // 158: talos.resource.definitions.network.RoutingRuleSpecSpec.action:type_name -> talos.resource.definitions.enums.NethelpersRoutingRuleAction
-	78,  // 159: talos.resource.definitions.network.RoutingRuleSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	76,  // 160: talos.resource.definitions.network.RoutingRuleStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
-	75,  // 161: talos.resource.definitions.network.RoutingRuleStatusSpec.src:type_name -> common.NetIPPrefix
-	75,  // 162: talos.resource.definitions.network.RoutingRuleStatusSpec.dst:type_name -> common.NetIPPrefix
-	81,  // 163: talos.resource.definitions.network.RoutingRuleStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	114, // 164: talos.resource.definitions.network.RoutingRuleStatusSpec.action:type_name -> talos.resource.definitions.enums.NethelpersRoutingRuleAction
-	79,  // 165: talos.resource.definitions.network.StaticHostSpec.addresses:type_name -> common.NetIP
-	80,  // 166: talos.resource.definitions.network.TCPProbeSpec.timeout:type_name -> google.protobuf.Duration
-	78,  // 167: talos.resource.definitions.network.TimeServerSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
-	79,  // 168: talos.resource.definitions.network.VIPOperatorSpec.ip:type_name -> common.NetIP
-	65,  // 169: talos.resource.definitions.network.VIPOperatorSpec.equinix_metal:type_name -> talos.resource.definitions.network.VIPEquinixMetalSpec
-	66,  // 170: talos.resource.definitions.network.VIPOperatorSpec.h_cloud:type_name -> talos.resource.definitions.network.VIPHCloudSpec
-	115, // 171: talos.resource.definitions.network.VLANSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersVLANProtocol
-	81,  // 172: talos.resource.definitions.network.VRFMasterSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
-	80,  // 173: talos.resource.definitions.network.WireguardPeer.persistent_keepalive_interval:type_name -> google.protobuf.Duration
-	75,  // 174: talos.resource.definitions.network.WireguardPeer.allowed_ips:type_name -> common.NetIPPrefix
-	72,  // 175: talos.resource.definitions.network.WireguardSpec.peers:type_name -> talos.resource.definitions.network.WireguardPeer
-	176, // [176:176] is the sub-list for method output_type
-	176, // [176:176] is the sub-list for method input_type
-	176, // [176:176] is the sub-list for extension type_name
-	176, // [176:176] is the sub-list for extension extendee
-	0,   // [0:176] is the sub-list for field type_name
+	94,  // 0: talos.resource.definitions.network.AddressSpecSpec.address:type_name -> common.NetIPPrefix
+	95,  // 1: talos.resource.definitions.network.AddressSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	96,  // 2: talos.resource.definitions.network.AddressSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	97,  // 3: talos.resource.definitions.network.AddressSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	94,  // 4: talos.resource.definitions.network.AddressStatusSpec.address:type_name -> common.NetIPPrefix
+	98,  // 5: talos.resource.definitions.network.AddressStatusSpec.local:type_name -> common.NetIP
+	98,  // 6: talos.resource.definitions.network.AddressStatusSpec.broadcast:type_name -> common.NetIP
+	98,  // 7: talos.resource.definitions.network.AddressStatusSpec.anycast:type_name -> common.NetIP
+	98,  // 8: talos.resource.definitions.network.AddressStatusSpec.multicast:type_name -> common.NetIP
+	95,  // 9: talos.resource.definitions.network.AddressStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	96,  // 10: talos.resource.definitions.network.AddressStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	99,  // 11: talos.resource.definitions.network.BGPBFDConfigSpec.transmit_interval:type_name -> google.protobuf.Duration
+	99,  // 12: talos.resource.definitions.network.BGPBFDConfigSpec.receive_interval:type_name -> google.protobuf.Duration
+	99,  // 13: talos.resource.definitions.network.BGPGracefulRestartSpec.restart_time:type_name -> google.protobuf.Duration
+	99,  // 14: talos.resource.definitions.network.BGPGracefulRestartSpec.long_lived_stale_time:type_name -> google.protobuf.Duration
+	94,  // 15: talos.resource.definitions.network.BGPImportRouteSpec.prefixes:type_name -> common.NetIPPrefix
+	98,  // 16: talos.resource.definitions.network.BGPInstanceConfigSpec.router_id:type_name -> common.NetIP
+	98,  // 17: talos.resource.definitions.network.BGPInstanceConfigSpec.route_source:type_name -> common.NetIP
+	7,   // 18: talos.resource.definitions.network.BGPInstanceConfigSpec.neighbors:type_name -> talos.resource.definitions.network.BGPNeighborConfigSpec
+	100, // 19: talos.resource.definitions.network.BGPInstanceConfigSpec.vrf_table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	5,   // 20: talos.resource.definitions.network.BGPInstanceConfigSpec.import_routes:type_name -> talos.resource.definitions.network.BGPImportRouteSpec
+	4,   // 21: talos.resource.definitions.network.BGPInstanceConfigSpec.graceful_restart:type_name -> talos.resource.definitions.network.BGPGracefulRestartSpec
+	2,   // 22: talos.resource.definitions.network.BGPInstanceConfigSpec.advertise_probes:type_name -> talos.resource.definitions.network.BGPAdvertiseProbeSpec
+	98,  // 23: talos.resource.definitions.network.BGPNeighborConfigSpec.address:type_name -> common.NetIP
+	99,  // 24: talos.resource.definitions.network.BGPNeighborConfigSpec.hold_time:type_name -> google.protobuf.Duration
+	3,   // 25: talos.resource.definitions.network.BGPNeighborConfigSpec.bfd:type_name -> talos.resource.definitions.network.BGPBFDConfigSpec
+	10,  // 26: talos.resource.definitions.network.BGPNeighborConfigSpec.import_policy:type_name -> talos.resource.definitions.network.BGPRoutePolicySpec
+	10,  // 27: talos.resource.definitions.network.BGPNeighborConfigSpec.export_policy:type_name -> talos.resource.definitions.network.BGPRoutePolicySpec
+	101, // 28: talos.resource.definitions.network.BGPPeerStatusSpec.state:type_name -> talos.resource.definitions.enums.NethelpersBGPSessionState
+	98,  // 29: talos.resource.definitions.network.BGPPeerStatusSpec.router_id:type_name -> common.NetIP
+	102, // 30: talos.resource.definitions.network.BGPPeerStatusSpec.since:type_name -> google.protobuf.Timestamp
+	94,  // 31: talos.resource.definitions.network.BGPPrefixFilterSpec.prefix:type_name -> common.NetIPPrefix
+	9,   // 32: talos.resource.definitions.network.BGPRoutePolicySpec.prefixes:type_name -> talos.resource.definitions.network.BGPPrefixFilterSpec
+	103, // 33: talos.resource.definitions.network.BondMasterSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersBondMode
+	104, // 34: talos.resource.definitions.network.BondMasterSpec.hash_policy:type_name -> talos.resource.definitions.enums.NethelpersBondXmitHashPolicy
+	105, // 35: talos.resource.definitions.network.BondMasterSpec.lacp_rate:type_name -> talos.resource.definitions.enums.NethelpersLACPRate
+	106, // 36: talos.resource.definitions.network.BondMasterSpec.arp_validate:type_name -> talos.resource.definitions.enums.NethelpersARPValidate
+	107, // 37: talos.resource.definitions.network.BondMasterSpec.arp_all_targets:type_name -> talos.resource.definitions.enums.NethelpersARPAllTargets
+	108, // 38: talos.resource.definitions.network.BondMasterSpec.primary_reselect:type_name -> talos.resource.definitions.enums.NethelpersPrimaryReselect
+	109, // 39: talos.resource.definitions.network.BondMasterSpec.fail_over_mac:type_name -> talos.resource.definitions.enums.NethelpersFailOverMAC
+	110, // 40: talos.resource.definitions.network.BondMasterSpec.ad_select:type_name -> talos.resource.definitions.enums.NethelpersADSelect
+	98,  // 41: talos.resource.definitions.network.BondMasterSpec.arpip_targets:type_name -> common.NetIP
+	98,  // 42: talos.resource.definitions.network.BondMasterSpec.nsip6_targets:type_name -> common.NetIP
+	111, // 43: talos.resource.definitions.network.BondMasterSpec.adlacp_active:type_name -> talos.resource.definitions.enums.NethelpersADLACPActive
+	77,  // 44: talos.resource.definitions.network.BridgeMasterSpec.stp:type_name -> talos.resource.definitions.network.STPSpec
+	15,  // 45: talos.resource.definitions.network.BridgeMasterSpec.vlan:type_name -> talos.resource.definitions.network.BridgeVLANSpec
+	112, // 46: talos.resource.definitions.network.ClientIdentifierSpec.client_identifier:type_name -> talos.resource.definitions.enums.NethelpersClientIdentifier
+	16,  // 47: talos.resource.definitions.network.DHCP4OperatorSpec.client_identifier:type_name -> talos.resource.definitions.network.ClientIdentifierSpec
+	16,  // 48: talos.resource.definitions.network.DHCP6OperatorSpec.client_identifier:type_name -> talos.resource.definitions.network.ClientIdentifierSpec
+	19,  // 49: talos.resource.definitions.network.DHCP6OperatorSpec.prefix_downstreams:type_name -> talos.resource.definitions.network.DHCP6PrefixDownstreamSpec
+	113, // 50: talos.resource.definitions.network.DHCPOptionsSpec.operator:type_name -> talos.resource.definitions.enums.NetworkOperator
+	20,  // 51: talos.resource.definitions.network.DHCPOptionsSpec.options:type_name -> talos.resource.definitions.network.DHCPOption
+	94,  // 52: talos.resource.definitions.network.DelegatedPrefixSpec.delegated_prefix:type_name -> common.NetIPPrefix
+	94,  // 53: talos.resource.definitions.network.DelegatedPrefixSpec.prefix:type_name -> common.NetIPPrefix
+	102, // 54: talos.resource.definitions.network.DelegatedPrefixSpec.preferred_until:type_name -> google.protobuf.Timestamp
+	102, // 55: talos.resource.definitions.network.DelegatedPrefixSpec.valid_until:type_name -> google.protobuf.Timestamp
+	27,  // 56: talos.resource.definitions.network.EthernetSpecSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsSpec
+	93,  // 57: talos.resource.definitions.network.EthernetSpecSpec.features:type_name -> talos.resource.definitions.network.EthernetSpecSpec.FeaturesEntry
+	24,  // 58: talos.resource.definitions.network.EthernetSpecSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsSpec
+	114, // 59: talos.resource.definitions.network.EthernetSpecSpec.wake_on_lan:type_name -> talos.resource.definitions.enums.NethelpersWOLMode
+	115, // 60: talos.resource.definitions.network.EthernetStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
+	116, // 61: talos.resource.definitions.network.EthernetStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
+	28,  // 62: talos.resource.definitions.network.EthernetStatusSpec.rings:type_name -> talos.resource.definitions.network.EthernetRingsStatus
+	26,  // 63: talos.resource.definitions.network.EthernetStatusSpec.features:type_name -> talos.resource.definitions.network.EthernetFeatureStatus
+	25,  // 64: talos.resource.definitions.network.EthernetStatusSpec.channels:type_name -> talos.resource.definitions.network.EthernetChannelsStatus
+	114, // 65: talos.resource.definitions.network.EthernetStatusSpec.wake_on_lan:type_name -> talos.resource.definitions.enums.NethelpersWOLMode
+	98,  // 66: talos.resource.definitions.network.GRESpec.local:type_name -> common.NetIP
+	98,  // 67: talos.resource.definitions.network.GRESpec.remote:type_name -> common.NetIP
+	98,  // 68: talos.resource.definitions.network.GeneveSpec.remote:type_name -> common.NetIP
+	117, // 69: talos.resource.definitions.network.HTTPProbeSpec.url:type_name -> common.URL
+	99,  // 70: talos.resource.definitions.network.HTTPProbeSpec.timeout:type_name -> google.protobuf.Duration
+	118, // 71: talos.resource.definitions.network.HostDNSConfigSpec.listen_addresses:type_name -> common.NetIPPort
+	98,  // 72: talos.resource.definitions.network.HostDNSConfigSpec.service_host_dns_address:type_name -> common.NetIP
+	98,  // 73: talos.resource.definitions.network.HostDNSConfigSpec.service_host_dns_address_v6:type_name -> common.NetIP
+	36,  // 74: talos.resource.definitions.network.HostDNSConfigSpec.forwarders:type_name -> talos.resource.definitions.network.HostDNSForwarderSpec
+	99,  // 75: talos.resource.definitions.network.HostDNSConfigSpec.negative_cache_min_ttl:type_name -> google.protobuf.Duration
+	99,  // 76: talos.resource.definitions.network.HostDNSConfigSpec.negative_cache_max_ttl:type_name -> google.protobuf.Duration
+	45,  // 77: talos.resource.definitions.network.HostDNSForwarderSpec.name_servers:type_name -> talos.resource.definitions.network.NameServerSpec
+	97,  // 78: talos.resource.definitions.network.HostnameSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	119, // 79: talos.resource.definitions.network.IPVLANSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersIPVLANMode
+	120, // 80: talos.resource.definitions.network.LinkSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
+	12,  // 81: talos.resource.definitions.network.LinkSpecSpec.bond_slave:type_name -> talos.resource.definitions.network.BondSlave
+	14,  // 82: talos.resource.definitions.network.LinkSpecSpec.bridge_slave:type_name -> talos.resource.definitions.network.BridgeSlave
+	86,  // 83: talos.resource.definitions.network.LinkSpecSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
+	11,  // 84: talos.resource.definitions.network.LinkSpecSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
+	13,  // 85: talos.resource.definitions.network.LinkSpecSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
+	92,  // 86: talos.resource.definitions.network.LinkSpecSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
+	97,  // 87: talos.resource.definitions.network.LinkSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	87,  // 88: talos.resource.definitions.network.LinkSpecSpec.vrf_master:type_name -> talos.resource.definitions.network.VRFMasterSpec
+	88,  // 89: talos.resource.definitions.network.LinkSpecSpec.vrf_slave:type_name -> talos.resource.definitions.network.VRFSlave
+	90,  // 90: talos.resource.definitions.network.LinkSpecSpec.veth:type_name -> talos.resource.definitions.network.VethSpec
+	89,  // 91: talos.resource.definitions.network.LinkSpecSpec.vxlan:type_name -> talos.resource.definitions.network.VXLANSpec
+	32,  // 92: talos.resource.definitions.network.LinkSpecSpec.geneve:type_name -> talos.resource.definitions.network.GeneveSpec
+	31,  // 93: talos.resource.definitions.network.LinkSpecSpec.gre:type_name -> talos.resource.definitions.network.GRESpec
+	44,  // 94: talos.resource.definitions.network.LinkSpecSpec.macvlan:type_name -> talos.resource.definitions.network.MACVLANSpec
+	39,  // 95: talos.resource.definitions.network.LinkSpecSpec.ipvlan:type_name -> talos.resource.definitions.network.IPVLANSpec
+	120, // 96: talos.resource.definitions.network.LinkStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersLinkType
+	121, // 97: talos.resource.definitions.network.LinkStatusSpec.operational_state:type_name -> talos.resource.definitions.enums.NethelpersOperationalState
+	115, // 98: talos.resource.definitions.network.LinkStatusSpec.port:type_name -> talos.resource.definitions.enums.NethelpersPort
+	116, // 99: talos.resource.definitions.network.LinkStatusSpec.duplex:type_name -> talos.resource.definitions.enums.NethelpersDuplex
+	86,  // 100: talos.resource.definitions.network.LinkStatusSpec.vlan:type_name -> talos.resource.definitions.network.VLANSpec
+	13,  // 101: talos.resource.definitions.network.LinkStatusSpec.bridge_master:type_name -> talos.resource.definitions.network.BridgeMasterSpec
+	11,  // 102: talos.resource.definitions.network.LinkStatusSpec.bond_master:type_name -> talos.resource.definitions.network.BondMasterSpec
+	92,  // 103: talos.resource.definitions.network.LinkStatusSpec.wireguard:type_name -> talos.resource.definitions.network.WireguardSpec
+	87,  // 104: talos.resource.definitions.network.LinkStatusSpec.vrf_master:type_name -> talos.resource.definitions.network.VRFMasterSpec
+	90,  // 105: talos.resource.definitions.network.LinkStatusSpec.veth:type_name -> talos.resource.definitions.network.VethSpec
+	89,  // 106: talos.resource.definitions.network.LinkStatusSpec.vxlan:type_name -> talos.resource.definitions.network.VXLANSpec
+	32,  // 107: talos.resource.definitions.network.LinkStatusSpec.geneve:type_name -> talos.resource.definitions.network.GeneveSpec
+	31,  // 108: talos.resource.definitions.network.LinkStatusSpec.gre:type_name -> talos.resource.definitions.network.GRESpec
+	44,  // 109: talos.resource.definitions.network.LinkStatusSpec.macvlan:type_name -> talos.resource.definitions.network.MACVLANSpec
+	39,  // 110: talos.resource.definitions.network.LinkStatusSpec.ipvlan:type_name -> talos.resource.definitions.network.IPVLANSpec
+	122, // 111: talos.resource.definitions.network.MACVLANSpec.mode:type_name -> talos.resource.definitions.enums.NethelpersMACVLANMode
+	98,  // 112: talos.resource.definitions.network.NameServerSpec.addr:type_name -> common.NetIP
+	123, // 113: talos.resource.definitions.network.NameServerSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersDNSProtocol
+	94,  // 114: talos.resource.definitions.network.NfTablesAddressMatch.include_subnets:type_name -> common.NetIPPrefix
+	94,  // 115: talos.resource.definitions.network.NfTablesAddressMatch.exclude_subnets:type_name -> common.NetIPPrefix
+	124, // 116: talos.resource.definitions.network.NfTablesChainSpec.hook:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainHook
+	125, // 117: talos.resource.definitions.network.NfTablesChainSpec.priority:type_name -> talos.resource.definitions.enums.NethelpersNfTablesChainPriority
+	58,  // 118: talos.resource.definitions.network.NfTablesChainSpec.rules:type_name -> talos.resource.definitions.network.NfTablesRule
+	126, // 119: talos.resource.definitions.network.NfTablesChainSpec.policy:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	59,  // 120: talos.resource.definitions.network.NfTablesChainStatusSpec.rules:type_name -> talos.resource.definitions.network.NfTablesRuleCounter
+	127, // 121: talos.resource.definitions.network.NfTablesConntrackStateMatch.states:type_name -> talos.resource.definitions.enums.NethelpersConntrackState
+	128, // 122: talos.resource.definitions.network.NfTablesICMPTypeMatch.types:type_name -> talos.resource.definitions.enums.NethelpersICMPType
+	129, // 123: talos.resource.definitions.network.NfTablesIfNameMatch.operator:type_name -> talos.resource.definitions.enums.NethelpersMatchOperator
+	130, // 124: talos.resource.definitions.network.NfTablesLayer4Match.protocol:type_name -> talos.resource.definitions.enums.NethelpersProtocol
+	57,  // 125: talos.resource.definitions.network.NfTablesLayer4Match.match_source_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
+	57,  // 126: talos.resource.definitions.network.NfTablesLayer4Match.match_destination_port:type_name -> talos.resource.definitions.network.NfTablesPortMatch
+	51,  // 127: talos.resource.definitions.network.NfTablesLayer4Match.match_icmp_type:type_name -> talos.resource.definitions.network.NfTablesICMPTypeMatch
+	98,  // 128: talos.resource.definitions.network.NfTablesNAT.address:type_name -> common.NetIP
+	67,  // 129: talos.resource.definitions.network.NfTablesPortMatch.ranges:type_name -> talos.resource.definitions.network.PortRange
+	52,  // 130: talos.resource.definitions.network.NfTablesRule.match_o_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
+	126, // 131: talos.resource.definitions.network.NfTablesRule.verdict:type_name -> talos.resource.definitions.enums.NethelpersNfTablesVerdict
+	55,  // 132: talos.resource.definitions.network.NfTablesRule.match_mark:type_name -> talos.resource.definitions.network.NfTablesMark
+	55,  // 133: talos.resource.definitions.network.NfTablesRule.set_mark:type_name -> talos.resource.definitions.network.NfTablesMark
+	46,  // 134: talos.resource.definitions.network.NfTablesRule.match_source_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
+	46,  // 135: talos.resource.definitions.network.NfTablesRule.match_destination_address:type_name -> talos.resource.definitions.network.NfTablesAddressMatch
+	53,  // 136: talos.resource.definitions.network.NfTablesRule.match_layer4:type_name -> talos.resource.definitions.network.NfTablesLayer4Match
+	52,  // 137: talos.resource.definitions.network.NfTablesRule.match_i_if_name:type_name -> talos.resource.definitions.network.NfTablesIfNameMatch
+	49,  // 138: talos.resource.definitions.network.NfTablesRule.clamp_mss:type_name -> talos.resource.definitions.network.NfTablesClampMSS
+	54,  // 139: talos.resource.definitions.network.NfTablesRule.match_limit:type_name -> talos.resource.definitions.network.NfTablesLimitMatch
+	50,  // 140: talos.resource.definitions.network.NfTablesRule.match_conntrack_state:type_name -> talos.resource.definitions.network.NfTablesConntrackStateMatch
+	56,  // 141: talos.resource.definitions.network.NfTablesRule.source_nat:type_name -> talos.resource.definitions.network.NfTablesNAT
+	56,  // 142: talos.resource.definitions.network.NfTablesRule.destination_nat:type_name -> talos.resource.definitions.network.NfTablesNAT
+	61,  // 143: talos.resource.definitions.network.NfTablesRule.match_source_rate_limit:type_name -> talos.resource.definitions.network.NfTablesSourceRateLimitMatch
+	60,  // 144: talos.resource.definitions.network.NfTablesRule.match_source_connection_limit:type_name -> talos.resource.definitions.network.NfTablesSourceConnectionLimitMatch
+	94,  // 145: talos.resource.definitions.network.NodeAddressFilterSpec.include_subnets:type_name -> common.NetIPPrefix
+	94,  // 146: talos.resource.definitions.network.NodeAddressFilterSpec.exclude_subnets:type_name -> common.NetIPPrefix
+	131, // 147: talos.resource.definitions.network.NodeAddressSortAlgorithmSpec.algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	94,  // 148: talos.resource.definitions.network.NodeAddressSpec.addresses:type_name -> common.NetIPPrefix
+	131, // 149: talos.resource.definitions.network.NodeAddressSpec.sort_algorithm:type_name -> talos.resource.definitions.enums.NethelpersAddressSortAlgorithm
+	113, // 150: talos.resource.definitions.network.OperatorSpecSpec.operator:type_name -> talos.resource.definitions.enums.NetworkOperator
+	17,  // 151: talos.resource.definitions.network.OperatorSpecSpec.dhcp4:type_name -> talos.resource.definitions.network.DHCP4OperatorSpec
+	18,  // 152: talos.resource.definitions.network.OperatorSpecSpec.dhcp6:type_name -> talos.resource.definitions.network.DHCP6OperatorSpec
+	85,  // 153: talos.resource.definitions.network.OperatorSpecSpec.vip:type_name -> talos.resource.definitions.network.VIPOperatorSpec
+	97,  // 154: talos.resource.definitions.network.OperatorSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	0,   // 155: talos.resource.definitions.network.PlatformConfigSpec.addresses:type_name -> talos.resource.definitions.network.AddressSpecSpec
+	42,  // 156: talos.resource.definitions.network.PlatformConfigSpec.links:type_name -> talos.resource.definitions.network.LinkSpecSpec
+	73,  // 157: talos.resource.definitions.network.PlatformConfigSpec.routes:type_name -> talos.resource.definitions.network.RouteSpecSpec
+	37,  // 158: talos.resource.definitions.network.PlatformConfigSpec.hostnames:type_name -> talos.resource.definitions.network.HostnameSpecSpec
+	70,  // 159: talos.resource.definitions.network.PlatformConfigSpec.resolvers:type_name -> talos.resource.definitions.network.ResolverSpecSpec
+	81,  // 160: talos.resource.definitions.network.PlatformConfigSpec.time_servers:type_name -> talos.resource.definitions.network.TimeServerSpecSpec
+	65,  // 161: talos.resource.definitions.network.PlatformConfigSpec.operators:type_name -> talos.resource.definitions.network.OperatorSpecSpec
+	98,  // 162: talos.resource.definitions.network.PlatformConfigSpec.external_ips:type_name -> common.NetIP
+	68,  // 163: talos.resource.definitions.network.PlatformConfigSpec.probes:type_name -> talos.resource.definitions.network.ProbeSpecSpec
+	132, // 164: talos.resource.definitions.network.PlatformConfigSpec.metadata:type_name -> talos.resource.definitions.runtime.PlatformMetadataSpec
+	99,  // 165: talos.resource.definitions.network.ProbeSpecSpec.interval:type_name -> google.protobuf.Duration
+	80,  // 166: talos.resource.definitions.network.ProbeSpecSpec.tcp:type_name -> talos.resource.definitions.network.TCPProbeSpec
+	97,  // 167: talos.resource.definitions.network.ProbeSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	33,  // 168: talos.resource.definitions.network.ProbeSpecSpec.http:type_name -> talos.resource.definitions.network.HTTPProbeSpec
+	98,  // 169: talos.resource.definitions.network.ResolverSpecSpec.dns_servers:type_name -> common.NetIP
+	97,  // 170: talos.resource.definitions.network.ResolverSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	45,  // 171: talos.resource.definitions.network.ResolverSpecSpec.name_servers:type_name -> talos.resource.definitions.network.NameServerSpec
+	98,  // 172: talos.resource.definitions.network.ResolverStatusSpec.dns_servers:type_name -> common.NetIP
+	45,  // 173: talos.resource.definitions.network.ResolverStatusSpec.name_servers:type_name -> talos.resource.definitions.network.NameServerSpec
+	98,  // 174: talos.resource.definitions.network.RouteNextHop.gateway:type_name -> common.NetIP
+	95,  // 175: talos.resource.definitions.network.RouteSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	94,  // 176: talos.resource.definitions.network.RouteSpecSpec.destination:type_name -> common.NetIPPrefix
+	98,  // 177: talos.resource.definitions.network.RouteSpecSpec.source:type_name -> common.NetIP
+	98,  // 178: talos.resource.definitions.network.RouteSpecSpec.gateway:type_name -> common.NetIP
+	100, // 179: talos.resource.definitions.network.RouteSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	96,  // 180: talos.resource.definitions.network.RouteSpecSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	133, // 181: talos.resource.definitions.network.RouteSpecSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
+	134, // 182: talos.resource.definitions.network.RouteSpecSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
+	97,  // 183: talos.resource.definitions.network.RouteSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	72,  // 184: talos.resource.definitions.network.RouteSpecSpec.next_hops:type_name -> talos.resource.definitions.network.RouteNextHop
+	95,  // 185: talos.resource.definitions.network.RouteStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	94,  // 186: talos.resource.definitions.network.RouteStatusSpec.destination:type_name -> common.NetIPPrefix
+	98,  // 187: talos.resource.definitions.network.RouteStatusSpec.source:type_name -> common.NetIP
+	98,  // 188: talos.resource.definitions.network.RouteStatusSpec.gateway:type_name -> common.NetIP
+	100, // 189: talos.resource.definitions.network.RouteStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	96,  // 190: talos.resource.definitions.network.RouteStatusSpec.scope:type_name -> talos.resource.definitions.enums.NethelpersScope
+	133, // 191: talos.resource.definitions.network.RouteStatusSpec.type:type_name -> talos.resource.definitions.enums.NethelpersRouteType
+	134, // 192: talos.resource.definitions.network.RouteStatusSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersRouteProtocol
+	72,  // 193: talos.resource.definitions.network.RouteStatusSpec.next_hops:type_name -> talos.resource.definitions.network.RouteNextHop
+	95,  // 194: talos.resource.definitions.network.RoutingRuleSpecSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	94,  // 195: talos.resource.definitions.network.RoutingRuleSpecSpec.src:type_name -> common.NetIPPrefix
+	94,  // 196: talos.resource.definitions.network.RoutingRuleSpecSpec.dst:type_name -> common.NetIPPrefix
+	100, // 197: talos.resource.definitions.network.RoutingRuleSpecSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	135, // 198: talos.resource.definitions.network.RoutingRuleSpecSpec.action:type_name -> talos.resource.definitions.enums.NethelpersRoutingRuleAction
+	97,  // 199: talos.resource.definitions.network.RoutingRuleSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	95,  // 200: talos.resource.definitions.network.RoutingRuleStatusSpec.family:type_name -> talos.resource.definitions.enums.NethelpersFamily
+	94,  // 201: talos.resource.definitions.network.RoutingRuleStatusSpec.src:type_name -> common.NetIPPrefix
+	94,  // 202: talos.resource.definitions.network.RoutingRuleStatusSpec.dst:type_name -> common.NetIPPrefix
+	100, // 203: talos.resource.definitions.network.RoutingRuleStatusSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	135, // 204: talos.resource.definitions.network.RoutingRuleStatusSpec.action:type_name -> talos.resource.definitions.enums.NethelpersRoutingRuleAction
+	98,  // 205: talos.resource.definitions.network.StaticHostSpec.addresses:type_name -> common.NetIP
+	99,  // 206: talos.resource.definitions.network.TCPProbeSpec.timeout:type_name -> google.protobuf.Duration
+	97,  // 207: talos.resource.definitions.network.TimeServerSpecSpec.config_layer:type_name -> talos.resource.definitions.enums.NetworkConfigLayer
+	98,  // 208: talos.resource.definitions.network.VIPOperatorSpec.ip:type_name -> common.NetIP
+	83,  // 209: talos.resource.definitions.network.VIPOperatorSpec.equinix_metal:type_name -> talos.resource.definitions.network.VIPEquinixMetalSpec
+	84,  // 210: talos.resource.definitions.network.VIPOperatorSpec.h_cloud:type_name -> talos.resource.definitions.network.VIPHCloudSpec
+	136, // 211: talos.resource.definitions.network.VLANSpec.protocol:type_name -> talos.resource.definitions.enums.NethelpersVLANProtocol
+	100, // 212: talos.resource.definitions.network.VRFMasterSpec.table:type_name -> talos.resource.definitions.enums.NethelpersRoutingTable
+	98,  // 213: talos.resource.definitions.network.VXLANSpec.local:type_name -> common.NetIP
+	98,  // 214: talos.resource.definitions.network.VXLANSpec.remote:type_name -> common.NetIP
+	99,  // 215: talos.resource.definitions.network.WireguardPeer.persistent_keepalive_interval:type_name -> google.protobuf.Duration
+	94,  // 216: talos.resource.definitions.network.WireguardPeer.allowed_ips:type_name -> common.NetIPPrefix
+	91,  // 217: talos.resource.definitions.network.WireguardSpec.peers:type_name -> talos.resource.definitions.network.WireguardPeer
+	218, // [218:218] is the sub-list for method output_type
+	218, // [218:218] is the sub-list for method input_type
+	218, // [218:218] is the sub-list for extension type_name
+	218, // [218:218] is the sub-list for extension extendee
+	0,   // [0:218] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_network_network_proto_init() }
@@ -6835,7 +8503,7 @@ func file_resource_definitions_network_network_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_network_network_proto_rawDesc), len(file_resource_definitions_network_network_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   75,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
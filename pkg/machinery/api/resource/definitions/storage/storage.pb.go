@@ -42,7 +42,9 @@ type LVMLogicalVolumeSpecSpec struct {
 	Mirrors uint32 `protobuf:"varint,6,opt,name=mirrors,proto3" json:"mirrors,omitempty"`
 	// Stripes is the stripe count for raid0/raid10 layouts; 0 means "all PVs",
 	// resolved by the reconcile controller.
-	Stripes       uint32 `protobuf:"varint,7,opt,name=stripes,proto3" json:"stripes,omitempty"`
+	Stripes uint32 `protobuf:"varint,7,opt,name=stripes,proto3" json:"stripes,omitempty"`
+	// ThinPool is the name of the thin pool LV in the same VG backing a thin LV.
+	ThinPool      string `protobuf:"bytes,8,opt,name=thin_pool,json=thinPool,proto3" json:"thin_pool,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +128,13 @@ func (x *LVMLogicalVolumeSpecSpec) GetStripes() uint32 {
 	return 0
 }
 
+func (x *LVMLogicalVolumeSpecSpec) GetThinPool() string {
+	if x != nil {
+		return x.ThinPool
+	}
+	return ""
+}
+
 // LVMLogicalVolumeStatusSpec mirrors selected `lvs` columns.
 type LVMLogicalVolumeStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1397,6 +1406,142 @@ func (x *MDArrayStatusSpec) GetSyncAction() string {
 	return ""
 }
 
+// MDArraySyncStatusSpec is the spec for MDArraySyncStatus resource.
+type MDArraySyncStatusSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Device is the /dev/mdN node of the array.
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Level is the sysfs level value, e.g. raid1.
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// ArrayState is the current sysfs array_state value.
+	ArrayState string `protobuf:"bytes,3,opt,name=array_state,json=arrayState,proto3" json:"array_state,omitempty"`
+	// SyncAction is the current sysfs sync_action value.
+	SyncAction string `protobuf:"bytes,4,opt,name=sync_action,json=syncAction,proto3" json:"sync_action,omitempty"`
+	// SyncCompletedSectors is the number of sectors processed by the running sync operation.
+	SyncCompletedSectors uint64 `protobuf:"varint,5,opt,name=sync_completed_sectors,json=syncCompletedSectors,proto3" json:"sync_completed_sectors,omitempty"`
+	// SyncTotalSectors is the number of sectors the running sync operation covers.
+	SyncTotalSectors uint64 `protobuf:"varint,6,opt,name=sync_total_sectors,json=syncTotalSectors,proto3" json:"sync_total_sectors,omitempty"`
+	// SyncProgress is the progress of the running sync operation in percent.
+	SyncProgress float64 `protobuf:"fixed64,7,opt,name=sync_progress,json=syncProgress,proto3" json:"sync_progress,omitempty"`
+	// MismatchCount is the number of mismatched sectors found by the last check or repair.
+	MismatchCount uint64 `protobuf:"varint,8,opt,name=mismatch_count,json=mismatchCount,proto3" json:"mismatch_count,omitempty"`
+	// RaidDevices is the number of member slots of the array.
+	RaidDevices int64 `protobuf:"varint,9,opt,name=raid_devices,json=raidDevices,proto3" json:"raid_devices,omitempty"`
+	// DegradedDevices is the number of member slots missing a working device.
+	DegradedDevices int64 `protobuf:"varint,10,opt,name=degraded_devices,json=degradedDevices,proto3" json:"degraded_devices,omitempty"`
+	// Degraded is set when at least one member slot is missing a working device.
+	Degraded      bool `protobuf:"varint,11,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDArraySyncStatusSpec) Reset() {
+	*x = MDArraySyncStatusSpec{}
+	mi := &file_resource_definitions_storage_storage_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDArraySyncStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDArraySyncStatusSpec) ProtoMessage() {}
+
+func (x *MDArraySyncStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_storage_storage_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDArraySyncStatusSpec.ProtoReflect.Descriptor instead.
+func (*MDArraySyncStatusSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_storage_storage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MDArraySyncStatusSpec) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *MDArraySyncStatusSpec) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *MDArraySyncStatusSpec) GetArrayState() string {
+	if x != nil {
+		return x.ArrayState
+	}
+	return ""
+}
+
+func (x *MDArraySyncStatusSpec) GetSyncAction() string {
+	if x != nil {
+		return x.SyncAction
+	}
+	return ""
+}
+
+func (x *MDArraySyncStatusSpec) GetSyncCompletedSectors() uint64 {
+	if x != nil {
+		return x.SyncCompletedSectors
+	}
+	return 0
+}
+
+func (x *MDArraySyncStatusSpec) GetSyncTotalSectors() uint64 {
+	if x != nil {
+		return x.SyncTotalSectors
+	}
+	return 0
+}
+
+func (x *MDArraySyncStatusSpec) GetSyncProgress() float64 {
+	if x != nil {
+		return x.SyncProgress
+	}
+	return 0
+}
+
+func (x *MDArraySyncStatusSpec) GetMismatchCount() uint64 {
+	if x != nil {
+		return x.MismatchCount
+	}
+	return 0
+}
+
+func (x *MDArraySyncStatusSpec) GetRaidDevices() int64 {
+	if x != nil {
+		return x.RaidDevices
+	}
+	return 0
+}
+
+func (x *MDArraySyncStatusSpec) GetDegradedDevices() int64 {
+	if x != nil {
+		return x.DegradedDevices
+	}
+	return 0
+}
+
+func (x *MDArraySyncStatusSpec) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
 // MDRefreshRequestSpec is the spec for MDRefreshRequest.
 type MDRefreshRequestSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1407,7 +1552,7 @@ type MDRefreshRequestSpec struct {
 
 func (x *MDRefreshRequestSpec) Reset() {
 	*x = MDRefreshRequestSpec{}
-	mi := &file_resource_definitions_storage_storage_proto_msgTypes[10]
+	mi := &file_resource_definitions_storage_storage_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1419,7 +1564,7 @@ func (x *MDRefreshRequestSpec) String() string {
 func (*MDRefreshRequestSpec) ProtoMessage() {}
 
 func (x *MDRefreshRequestSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_storage_storage_proto_msgTypes[10]
+	mi := &file_resource_definitions_storage_storage_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,7 +1577,7 @@ func (x *MDRefreshRequestSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MDRefreshRequestSpec.ProtoReflect.Descriptor instead.
 func (*MDRefreshRequestSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_storage_storage_proto_rawDescGZIP(), []int{10}
+	return file_resource_definitions_storage_storage_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MDRefreshRequestSpec) GetRequest() int64 {
@@ -1446,7 +1591,7 @@ var File_resource_definitions_storage_storage_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_storage_storage_proto_rawDesc = "" +
 	"\n" +
-	"*resource/definitions/storage/storage.proto\x12\"talos.resource.definitions.storage\x1a&google/api/expr/v1alpha1/checked.proto\x1a&resource/definitions/enums/enums.proto\"\x95\x02\n" +
+	"*resource/definitions/storage/storage.proto\x12\"talos.resource.definitions.storage\x1a&google/api/expr/v1alpha1/checked.proto\x1a&resource/definitions/enums/enums.proto\"\xb2\x02\n" +
 	"\x18LVMLogicalVolumeSpecSpec\x12\x17\n" +
 	"\avg_name\x18\x01 \x01(\tR\x06vgName\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12Q\n" +
@@ -1455,7 +1600,8 @@ const file_resource_definitions_storage_storage_proto_rawDesc = "" +
 	"size_bytes\x18\x04 \x01(\x04R\tsizeBytes\x12&\n" +
 	"\x0fsize_percent_vg\x18\x05 \x01(\rR\rsizePercentVg\x12\x18\n" +
 	"\amirrors\x18\x06 \x01(\rR\amirrors\x12\x18\n" +
-	"\astripes\x18\a \x01(\rR\astripes\"\xdd\b\n" +
+	"\astripes\x18\a \x01(\rR\astripes\x12\x1b\n" +
+	"\tthin_pool\x18\b \x01(\tR\bthinPool\"\xdd\b\n" +
 	"\x1aLVMLogicalVolumeStatusSpec\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x17\n" +
 	"\adm_path\x18\x02 \x01(\tR\x06dmPath\x12\x12\n" +
@@ -1592,7 +1738,22 @@ const file_resource_definitions_storage_storage_proto_rawDesc = "" +
 	" \x01(\tR\n" +
 	"arrayState\x12\x1f\n" +
 	"\vsync_action\x18\v \x01(\tR\n" +
-	"syncAction\"0\n" +
+	"syncAction\"\xa1\x03\n" +
+	"\x15MDArraySyncStatusSpec\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x1f\n" +
+	"\varray_state\x18\x03 \x01(\tR\n" +
+	"arrayState\x12\x1f\n" +
+	"\vsync_action\x18\x04 \x01(\tR\n" +
+	"syncAction\x124\n" +
+	"\x16sync_completed_sectors\x18\x05 \x01(\x04R\x14syncCompletedSectors\x12,\n" +
+	"\x12sync_total_sectors\x18\x06 \x01(\x04R\x10syncTotalSectors\x12#\n" +
+	"\rsync_progress\x18\a \x01(\x01R\fsyncProgress\x12%\n" +
+	"\x0emismatch_count\x18\b \x01(\x04R\rmismatchCount\x12!\n" +
+	"\fraid_devices\x18\t \x01(\x03R\vraidDevices\x12)\n" +
+	"\x10degraded_devices\x18\n" +
+	" \x01(\x03R\x0fdegradedDevices\x12\x1a\n" +
+	"\bdegraded\x18\v \x01(\bR\bdegraded\"0\n" +
 	"\x14MDRefreshRequestSpec\x12\x18\n" +
 	"\arequest\x18\x01 \x01(\x03R\arequestBx\n" +
 	"*dev.talos.api.resource.definitions.storageZJgithub.com/siderolabs/talos/pkg/machinery/api/resource/definitions/storageb\x06proto3"
@@ -1609,7 +1770,7 @@ func file_resource_definitions_storage_storage_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_storage_storage_proto_rawDescData
 }
 
-var file_resource_definitions_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_resource_definitions_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_resource_definitions_storage_storage_proto_goTypes = []any{
 	(*LVMLogicalVolumeSpecSpec)(nil),       // 0: talos.resource.definitions.storage.LVMLogicalVolumeSpecSpec
 	(*LVMLogicalVolumeStatusSpec)(nil),     // 1: talos.resource.definitions.storage.LVMLogicalVolumeStatusSpec
@@ -1621,20 +1782,21 @@ var file_resource_definitions_storage_storage_proto_goTypes = []any{
 	(*LVMVolumeGroupStatusSpec)(nil),       // 7: talos.resource.definitions.storage.LVMVolumeGroupStatusSpec
 	(*MDArraySpecSpec)(nil),                // 8: talos.resource.definitions.storage.MDArraySpecSpec
 	(*MDArrayStatusSpec)(nil),              // 9: talos.resource.definitions.storage.MDArrayStatusSpec
-	(*MDRefreshRequestSpec)(nil),           // 10: talos.resource.definitions.storage.MDRefreshRequestSpec
-	(enums.StorageLVMLogicalVolumeType)(0), // 11: talos.resource.definitions.enums.StorageLVMLogicalVolumeType
-	(enums.StorageMDLevel)(0),              // 12: talos.resource.definitions.enums.StorageMDLevel
-	(*v1alpha1.CheckedExpr)(nil),           // 13: google.api.expr.v1alpha1.CheckedExpr
-	(enums.StorageMDMetadata)(0),           // 14: talos.resource.definitions.enums.StorageMDMetadata
-	(enums.StorageMDArrayPhase)(0),         // 15: talos.resource.definitions.enums.StorageMDArrayPhase
+	(*MDArraySyncStatusSpec)(nil),          // 10: talos.resource.definitions.storage.MDArraySyncStatusSpec
+	(*MDRefreshRequestSpec)(nil),           // 11: talos.resource.definitions.storage.MDRefreshRequestSpec
+	(enums.StorageLVMLogicalVolumeType)(0), // 12: talos.resource.definitions.enums.StorageLVMLogicalVolumeType
+	(enums.StorageMDLevel)(0),              // 13: talos.resource.definitions.enums.StorageMDLevel
+	(*v1alpha1.CheckedExpr)(nil),           // 14: google.api.expr.v1alpha1.CheckedExpr
+	(enums.StorageMDMetadata)(0),           // 15: talos.resource.definitions.enums.StorageMDMetadata
+	(enums.StorageMDArrayPhase)(0),         // 16: talos.resource.definitions.enums.StorageMDArrayPhase
 }
 var file_resource_definitions_storage_storage_proto_depIdxs = []int32{
-	11, // 0: talos.resource.definitions.storage.LVMLogicalVolumeSpecSpec.type:type_name -> talos.resource.definitions.enums.StorageLVMLogicalVolumeType
-	12, // 1: talos.resource.definitions.storage.MDArraySpecSpec.level:type_name -> talos.resource.definitions.enums.StorageMDLevel
-	13, // 2: talos.resource.definitions.storage.MDArraySpecSpec.volume_selector:type_name -> google.api.expr.v1alpha1.CheckedExpr
-	14, // 3: talos.resource.definitions.storage.MDArraySpecSpec.metadata:type_name -> talos.resource.definitions.enums.StorageMDMetadata
-	12, // 4: talos.resource.definitions.storage.MDArrayStatusSpec.level:type_name -> talos.resource.definitions.enums.StorageMDLevel
-	15, // 5: talos.resource.definitions.storage.MDArrayStatusSpec.status:type_name -> talos.resource.definitions.enums.StorageMDArrayPhase
+	12, // 0: talos.resource.definitions.storage.LVMLogicalVolumeSpecSpec.type:type_name -> talos.resource.definitions.enums.StorageLVMLogicalVolumeType
+	13, // 1: talos.resource.definitions.storage.MDArraySpecSpec.level:type_name -> talos.resource.definitions.enums.StorageMDLevel
+	14, // 2: talos.resource.definitions.storage.MDArraySpecSpec.volume_selector:type_name -> google.api.expr.v1alpha1.CheckedExpr
+	15, // 3: talos.resource.definitions.storage.MDArraySpecSpec.metadata:type_name -> talos.resource.definitions.enums.StorageMDMetadata
+	13, // 4: talos.resource.definitions.storage.MDArrayStatusSpec.level:type_name -> talos.resource.definitions.enums.StorageMDLevel
+	16, // 5: talos.resource.definitions.storage.MDArrayStatusSpec.status:type_name -> talos.resource.definitions.enums.StorageMDArrayPhase
 	6,  // [6:6] is the sub-list for method output_type
 	6,  // [6:6] is the sub-list for method input_type
 	6,  // [6:6] is the sub-list for extension type_name
@@ -1653,7 +1815,7 @@ func file_resource_definitions_storage_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_storage_storage_proto_rawDesc), len(file_resource_definitions_storage_storage_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -124,6 +125,76 @@ func (x *AdjtimeStatusSpec) GetState() string {
 	return ""
 }
 
+// NTPServerConfigSpec describes the configuration of the built-in NTP server.
+type NTPServerConfigSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListenAddress string                 `protobuf:"bytes,1,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address,omitempty"`
+	// NTSListenAddress is the address of the NTS-KE server, empty if NTS is disabled.
+	NtsListenAddress string `protobuf:"bytes,2,opt,name=nts_listen_address,json=ntsListenAddress,proto3" json:"nts_listen_address,omitempty"`
+	NtsCertificate   []byte `protobuf:"bytes,3,opt,name=nts_certificate,json=ntsCertificate,proto3" json:"nts_certificate,omitempty"`
+	NtsKey           []byte `protobuf:"bytes,4,opt,name=nts_key,json=ntsKey,proto3" json:"nts_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NTPServerConfigSpec) Reset() {
+	*x = NTPServerConfigSpec{}
+	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NTPServerConfigSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NTPServerConfigSpec) ProtoMessage() {}
+
+func (x *NTPServerConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NTPServerConfigSpec.ProtoReflect.Descriptor instead.
+func (*NTPServerConfigSpec) Descriptor() ([]byte, []int) {
+	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NTPServerConfigSpec) GetListenAddress() string {
+	if x != nil {
+		return x.ListenAddress
+	}
+	return ""
+}
+
+func (x *NTPServerConfigSpec) GetNtsListenAddress() string {
+	if x != nil {
+		return x.NtsListenAddress
+	}
+	return ""
+}
+
+func (x *NTPServerConfigSpec) GetNtsCertificate() []byte {
+	if x != nil {
+		return x.NtsCertificate
+	}
+	return nil
+}
+
+func (x *NTPServerConfigSpec) GetNtsKey() []byte {
+	if x != nil {
+		return x.NtsKey
+	}
+	return nil
+}
+
 // NTPStatusSpec describes the state of the NTP client.
 type NTPStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -134,13 +205,26 @@ type NTPStatusSpec struct {
 	SpikeDetected bool `protobuf:"varint,1,opt,name=spike_detected,json=spikeDetected,proto3" json:"spike_detected,omitempty"`
 	// ConsecutiveSpikes is the number of time measurements discarded in a row by the spike filter.
 	ConsecutiveSpikes int64 `protobuf:"varint,2,opt,name=consecutive_spikes,json=consecutiveSpikes,proto3" json:"consecutive_spikes,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Stratum is the stratum of the local clock: the stratum of the time source plus one,
+	// or zero if the clock is not synchronized to a time source.
+	Stratum int64 `protobuf:"varint,3,opt,name=stratum,proto3" json:"stratum,omitempty"`
+	// Leap is the leap second indicator announced by the time source.
+	Leap int64 `protobuf:"varint,4,opt,name=leap,proto3" json:"leap,omitempty"`
+	// ReferenceID identifies the time source, as announced in the NTP reference ID field.
+	ReferenceId uint32 `protobuf:"varint,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// ReferenceTime is the time the clock was last synchronized.
+	ReferenceTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reference_time,json=referenceTime,proto3" json:"reference_time,omitempty"`
+	// RootDelay is the total round-trip delay to the reference clock.
+	RootDelay *durationpb.Duration `protobuf:"bytes,7,opt,name=root_delay,json=rootDelay,proto3" json:"root_delay,omitempty"`
+	// RootDispersion is the total dispersion to the reference clock at the reference time.
+	RootDispersion *durationpb.Duration `protobuf:"bytes,8,opt,name=root_dispersion,json=rootDispersion,proto3" json:"root_dispersion,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *NTPStatusSpec) Reset() {
 	*x = NTPStatusSpec{}
-	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	mi := &file_resource_definitions_time_time_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -152,7 +236,7 @@ func (x *NTPStatusSpec) String() string {
 func (*NTPStatusSpec) ProtoMessage() {}
 
 func (x *NTPStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_time_time_proto_msgTypes[1]
+	mi := &file_resource_definitions_time_time_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +249,7 @@ func (x *NTPStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NTPStatusSpec.ProtoReflect.Descriptor instead.
 func (*NTPStatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{1}
+	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *NTPStatusSpec) GetSpikeDetected() bool {
@@ -182,6 +266,48 @@ func (x *NTPStatusSpec) GetConsecutiveSpikes() int64 {
 	return 0
 }
 
+func (x *NTPStatusSpec) GetStratum() int64 {
+	if x != nil {
+		return x.Stratum
+	}
+	return 0
+}
+
+func (x *NTPStatusSpec) GetLeap() int64 {
+	if x != nil {
+		return x.Leap
+	}
+	return 0
+}
+
+func (x *NTPStatusSpec) GetReferenceId() uint32 {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return 0
+}
+
+func (x *NTPStatusSpec) GetReferenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReferenceTime
+	}
+	return nil
+}
+
+func (x *NTPStatusSpec) GetRootDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RootDelay
+	}
+	return nil
+}
+
+func (x *NTPStatusSpec) GetRootDispersion() *durationpb.Duration {
+	if x != nil {
+		return x.RootDispersion
+	}
+	return nil
+}
+
 // StatusSpec describes time sync state.
 //
 // This spec is used as a reconcile trigger by other controllers (most notably the
@@ -202,7 +328,7 @@ type StatusSpec struct {
 
 func (x *StatusSpec) Reset() {
 	*x = StatusSpec{}
-	mi := &file_resource_definitions_time_time_proto_msgTypes[2]
+	mi := &file_resource_definitions_time_time_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +340,7 @@ func (x *StatusSpec) String() string {
 func (*StatusSpec) ProtoMessage() {}
 
 func (x *StatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_definitions_time_time_proto_msgTypes[2]
+	mi := &file_resource_definitions_time_time_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +353,7 @@ func (x *StatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusSpec.ProtoReflect.Descriptor instead.
 func (*StatusSpec) Descriptor() ([]byte, []int) {
-	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{2}
+	return file_resource_definitions_time_time_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StatusSpec) GetSynced() bool {
@@ -255,7 +381,7 @@ var File_resource_definitions_time_time_proto protoreflect.FileDescriptor
 
 const file_resource_definitions_time_time_proto_rawDesc = "" +
 	"\n" +
-	"$resource/definitions/time/time.proto\x12\x1ftalos.resource.definitions.time\x1a\x1egoogle/protobuf/duration.proto\"\xdf\x02\n" +
+	"$resource/definitions/time/time.proto\x12\x1ftalos.resource.definitions.time\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x02\n" +
 	"\x11AdjtimeStatusSpec\x121\n" +
 	"\x06offset\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06offset\x12<\n" +
 	"\x1afrequency_adjustment_ratio\x18\x02 \x01(\x01R\x18frequencyAdjustmentRatio\x126\n" +
@@ -265,10 +391,22 @@ const file_resource_definitions_time_time_proto_rawDesc = "" +
 	"\bconstant\x18\x06 \x01(\x03R\bconstant\x12\x1f\n" +
 	"\vsync_status\x18\a \x01(\bR\n" +
 	"syncStatus\x12\x14\n" +
-	"\x05state\x18\b \x01(\tR\x05state\"e\n" +
+	"\x05state\x18\b \x01(\tR\x05state\"\xac\x01\n" +
+	"\x13NTPServerConfigSpec\x12%\n" +
+	"\x0elisten_address\x18\x01 \x01(\tR\rlistenAddress\x12,\n" +
+	"\x12nts_listen_address\x18\x02 \x01(\tR\x10ntsListenAddress\x12'\n" +
+	"\x0fnts_certificate\x18\x03 \x01(\fR\x0entsCertificate\x12\x17\n" +
+	"\ants_key\x18\x04 \x01(\fR\x06ntsKey\"\xf7\x02\n" +
 	"\rNTPStatusSpec\x12%\n" +
 	"\x0espike_detected\x18\x01 \x01(\bR\rspikeDetected\x12-\n" +
-	"\x12consecutive_spikes\x18\x02 \x01(\x03R\x11consecutiveSpikes\"_\n" +
+	"\x12consecutive_spikes\x18\x02 \x01(\x03R\x11consecutiveSpikes\x12\x18\n" +
+	"\astratum\x18\x03 \x01(\x03R\astratum\x12\x12\n" +
+	"\x04leap\x18\x04 \x01(\x03R\x04leap\x12!\n" +
+	"\freference_id\x18\x05 \x01(\rR\vreferenceId\x12A\n" +
+	"\x0ereference_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rreferenceTime\x128\n" +
+	"\n" +
+	"root_delay\x18\a \x01(\v2\x19.google.protobuf.DurationR\trootDelay\x12B\n" +
+	"\x0froot_dispersion\x18\b \x01(\v2\x19.google.protobuf.DurationR\x0erootDispersion\"_\n" +
 	"\n" +
 	"StatusSpec\x12\x16\n" +
 	"\x06synced\x18\x01 \x01(\bR\x06synced\x12\x14\n" +
@@ -288,22 +426,27 @@ func file_resource_definitions_time_time_proto_rawDescGZIP() []byte {
 	return file_resource_definitions_time_time_proto_rawDescData
 }
 
-var file_resource_definitions_time_time_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_resource_definitions_time_time_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_resource_definitions_time_time_proto_goTypes = []any{
-	(*AdjtimeStatusSpec)(nil),   // 0: talos.resource.definitions.time.AdjtimeStatusSpec
-	(*NTPStatusSpec)(nil),       // 1: talos.resource.definitions.time.NTPStatusSpec
-	(*StatusSpec)(nil),          // 2: talos.resource.definitions.time.StatusSpec
-	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
+	(*AdjtimeStatusSpec)(nil),     // 0: talos.resource.definitions.time.AdjtimeStatusSpec
+	(*NTPServerConfigSpec)(nil),   // 1: talos.resource.definitions.time.NTPServerConfigSpec
+	(*NTPStatusSpec)(nil),         // 2: talos.resource.definitions.time.NTPStatusSpec
+	(*StatusSpec)(nil),            // 3: talos.resource.definitions.time.StatusSpec
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_resource_definitions_time_time_proto_depIdxs = []int32{
-	3, // 0: talos.resource.definitions.time.AdjtimeStatusSpec.offset:type_name -> google.protobuf.Duration
-	3, // 1: talos.resource.definitions.time.AdjtimeStatusSpec.max_error:type_name -> google.protobuf.Duration
-	3, // 2: talos.resource.definitions.time.AdjtimeStatusSpec.est_error:type_name -> google.protobuf.Duration
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 0: talos.resource.definitions.time.AdjtimeStatusSpec.offset:type_name -> google.protobuf.Duration
+	4, // 1: talos.resource.definitions.time.AdjtimeStatusSpec.max_error:type_name -> google.protobuf.Duration
+	4, // 2: talos.resource.definitions.time.AdjtimeStatusSpec.est_error:type_name -> google.protobuf.Duration
+	5, // 3: talos.resource.definitions.time.NTPStatusSpec.reference_time:type_name -> google.protobuf.Timestamp
+	4, // 4: talos.resource.definitions.time.NTPStatusSpec.root_delay:type_name -> google.protobuf.Duration
+	4, // 5: talos.resource.definitions.time.NTPStatusSpec.root_dispersion:type_name -> google.protobuf.Duration
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_resource_definitions_time_time_proto_init() }
@@ -317,7 +460,7 @@ func file_resource_definitions_time_time_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_definitions_time_time_proto_rawDesc), len(file_resource_definitions_time_time_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1083,6 +1083,33 @@ func (c *Client) PhysicalVolumeRemove(ctx context.Context, req *machineapi.LVMSe
 	return err
 }
 
+// LogicalVolumeSnapshotCreate takes a snapshot of a single LVM logical volume via LVMService.
+// See LogicalVolumeRemove for multi-node fan-out semantics.
+func (c *Client) LogicalVolumeSnapshotCreate(ctx context.Context, req *machineapi.LVMServiceLogicalVolumeSnapshotCreateRequest, callOptions ...grpc.CallOption) error {
+	_, err := c.LVMClient.LogicalVolumeSnapshotCreate(ctx, req, callOptions...)
+
+	return err
+}
+
+// LogicalVolumeSnapshotList lists LVM logical volume snapshots via LVMService.
+func (c *Client) LogicalVolumeSnapshotList(
+	ctx context.Context,
+	req *machineapi.LVMServiceLogicalVolumeSnapshotListRequest,
+	callOptions ...grpc.CallOption,
+) (resp *machineapi.LVMServiceLogicalVolumeSnapshotListResponse, err error) {
+	resp, err = c.LVMClient.LogicalVolumeSnapshotList(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// LogicalVolumeSnapshotRollback rolls an LVM logical volume back to a snapshot via LVMService.
+// See LogicalVolumeRemove for multi-node fan-out semantics.
+func (c *Client) LogicalVolumeSnapshotRollback(ctx context.Context, req *machineapi.LVMServiceLogicalVolumeSnapshotRollbackRequest, callOptions ...grpc.CallOption) error {
+	_, err := c.LVMClient.LogicalVolumeSnapshotRollback(ctx, req, callOptions...)
+
+	return err
+}
+
 // MDDestroy stops an MD array and clears its member superblocks via MDService.
 //
 // Multi-node fan-out is the caller's responsibility: dispatch one call per
@@ -1092,3 +1119,35 @@ func (c *Client) MDDestroy(ctx context.Context, req *machineapi.MDDestroyRequest
 
 	return err
 }
+
+// MDAddSpare adds a spare device to an MD array via MDService.
+// See MDDestroy for multi-node fan-out semantics.
+func (c *Client) MDAddSpare(ctx context.Context, req *machineapi.MDAddSpareRequest, callOptions ...grpc.CallOption) error {
+	_, err := c.MDClient.AddSpare(ctx, req, callOptions...)
+
+	return err
+}
+
+// MDReplaceMember hot-replaces a member of an MD array via MDService.
+// See MDDestroy for multi-node fan-out semantics.
+func (c *Client) MDReplaceMember(ctx context.Context, req *machineapi.MDReplaceMemberRequest, callOptions ...grpc.CallOption) error {
+	_, err := c.MDClient.ReplaceMember(ctx, req, callOptions...)
+
+	return err
+}
+
+// MDFailMember marks a member of an MD array faulty via MDService.
+// See MDDestroy for multi-node fan-out semantics.
+func (c *Client) MDFailMember(ctx context.Context, req *machineapi.MDFailMemberRequest, callOptions ...grpc.CallOption) error {
+	_, err := c.MDClient.FailMember(ctx, req, callOptions...)
+
+	return err
+}
+
+// MDSetSyncAction starts or aborts a check/repair of an MD array via MDService.
+// See MDDestroy for multi-node fan-out semantics.
+func (c *Client) MDSetSyncAction(ctx context.Context, req *machineapi.MDSetSyncActionRequest, callOptions ...grpc.CallOption) error {
+	_, err := c.MDClient.SetSyncAction(ctx, req, callOptions...)
+
+	return err
+}
@@ -24,6 +24,7 @@ type Config interface { //nolint:interfacebloat
 	NetworkResolverConfig() NetworkResolverConfig
 	NetworkHostDNSConfig() NetworkHostDNSConfig
 	NetworkTimeSyncConfig() NetworkTimeSyncConfig
+	NetworkNTPServerConfig() NetworkNTPServerConfig
 	NetworkKubeSpanConfig() NetworkKubeSpanConfig
 	NetworkCommonLinkConfigs() []NetworkCommonLinkConfig
 	NetworkLinkAliasConfigs() []NetworkLinkAliasConfig
@@ -106,4 +107,5 @@ type Config interface { //nolint:interfacebloat
 	KernelModuleConfigs() []KernelModuleConfig
 	UnattendedInstallConfig() UnattendedInstallConfig
 	SecurityProfileConfig() SecurityProfileConfig
+	MetricsConfig() MetricsConfig
 }
@@ -4,7 +4,14 @@
 
 package config
 
-import "github.com/siderolabs/gen/optional"
+import (
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
 
 // ContainerConfig defines the interface to access container configuration.
 //
@@ -34,6 +41,10 @@ type ContainerConfig interface {
 	Resources() ContainerResourcesConfig
 	// DependsOn conditions gating startup; never nil.
 	DependsOn() ContainerDependsOnConfig
+	// RestartPolicy settings; never nil.
+	RestartPolicy() ContainerRestartPolicyConfig
+	// HealthCheck probing the container once it runs; None means no health check.
+	HealthCheck() optional.Optional[ContainerHealthCheckConfig]
 }
 
 // ContainerMountConfig defines a single container mount.
@@ -107,12 +118,36 @@ const (
 	ContainerNetworkModeNone ContainerNetworkMode = "none"
 	// ContainerNetworkModeHost shares the host network namespace.
 	ContainerNetworkModeHost ContainerNetworkMode = "host"
+	// ContainerNetworkModeBridge gives the container its own network namespace, attached to a
+	// bridge link on the host.
+	ContainerNetworkModeBridge ContainerNetworkMode = "bridge"
+	// ContainerNetworkModeMacvlan gives the container its own network namespace, with a macvlan
+	// link on top of a host link.
+	ContainerNetworkModeMacvlan ContainerNetworkMode = "macvlan"
 )
 
 // ContainerNetworkConfig defines the container network settings.
 type ContainerNetworkConfig interface {
 	// Mode is the network namespace mode; defaults to none.
 	Mode() ContainerNetworkMode
+	// Link is the bridge for the bridge mode, or the parent link for the macvlan mode.
+	Link() string
+	// Subnet the container address is allocated from, for the bridge and macvlan modes.
+	Subnet() optional.Optional[netip.Prefix]
+	// Gateway of the container's default route; None means no default route.
+	Gateway() optional.Optional[netip.Addr]
+	// Ports published on the host, for the bridge mode.
+	Ports() []ContainerPortConfig
+}
+
+// ContainerPortConfig defines a container port published on the host.
+type ContainerPortConfig interface {
+	// Protocol is tcp or udp; defaults to tcp.
+	Protocol() nethelpers.Protocol
+	// HostPort is the port on the host.
+	HostPort() uint16
+	// ContainerPort is the port inside the container.
+	ContainerPort() uint16
 }
 
 // ContainerResourcesConfig defines container resource limits.
@@ -139,6 +174,68 @@ type ContainerDependsOnConfig interface {
 	Networks() []string
 	// Time is true if the clock must be synchronized.
 	Time() bool
-	// Containers which must be running first, by document name.
+	// Containers which must be running and healthy first, by document name.
 	Containers() []string
 }
+
+// ContainerRestartMode selects when a terminated container is restarted.
+type ContainerRestartMode string
+
+// Container restart modes.
+const (
+	// ContainerRestartModeAlways restarts the container whenever it terminates. Default.
+	ContainerRestartModeAlways ContainerRestartMode = "always"
+	// ContainerRestartModeOnFailure restarts the container only if it fails to start or exits
+	// with a non-zero code.
+	ContainerRestartModeOnFailure ContainerRestartMode = "on-failure"
+	// ContainerRestartModeNever leaves a terminated container stopped.
+	ContainerRestartModeNever ContainerRestartMode = "never"
+)
+
+// ContainerRestartPolicyConfig defines when and how fast a terminated container is restarted.
+type ContainerRestartPolicyConfig interface {
+	// Mode selects which terminations lead to a restart; defaults to always.
+	Mode() ContainerRestartMode
+	// MaxRetries caps the number of restarts; zero means unlimited.
+	MaxRetries() uint64
+	// InitialDelay is the delay before the first restart, doubled on each further restart.
+	InitialDelay() time.Duration
+	// MaxDelay caps the restart delay.
+	MaxDelay() time.Duration
+}
+
+// ContainerHealthCheckConfig defines a container health check.
+//
+// Exactly one of the three checks is present.
+type ContainerHealthCheckConfig interface {
+	Exec() optional.Optional[ContainerExecHealthCheckConfig]
+	HTTP() optional.Optional[ContainerHTTPHealthCheckConfig]
+	TCP() optional.Optional[ContainerTCPHealthCheckConfig]
+
+	// InitialDelay before the first check.
+	InitialDelay() time.Duration
+	// Period between the checks.
+	Period() time.Duration
+	// Timeout of a single check.
+	Timeout() time.Duration
+}
+
+// ContainerExecHealthCheckConfig runs a command in the container.
+type ContainerExecHealthCheckConfig interface {
+	// Command to run; healthy if it exits with zero.
+	Command() []string
+}
+
+// ContainerHTTPHealthCheckConfig sends an HTTP GET request to the container.
+type ContainerHTTPHealthCheckConfig interface {
+	// Port on the host loopback address.
+	Port() uint16
+	// Path of the request, defaults to /.
+	Path() string
+}
+
+// ContainerTCPHealthCheckConfig opens a TCP connection to the container.
+type ContainerTCPHealthCheckConfig interface {
+	// Port on the host loopback address.
+	Port() uint16
+}
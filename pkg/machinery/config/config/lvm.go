@@ -41,4 +41,7 @@ type LVMLogicalVolumeConfig interface {
 	MaxSizePercentVG() uint32
 	// MinSizeBytes returns the minimum LV size in bytes, or 0 when unset.
 	MinSizeBytes() uint64
+	// ThinPool returns the name of the thin pool backing a thin LV, or ""
+	// for other layouts.
+	ThinPool() string
 }
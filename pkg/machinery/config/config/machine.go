@@ -332,4 +332,11 @@ type LoggingDestination interface {
 	Endpoint() *url.URL
 	ExtraTags() map[string]string
 	Format() string
+	TLS() LoggingTLSConfig
+}
+
+// LoggingTLSConfig describes TLS settings of the logging destination.
+type LoggingTLSConfig interface {
+	ClientIdentity() *x509.PEMEncodedCertificateAndKey
+	CA() []byte
 }
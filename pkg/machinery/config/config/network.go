@@ -8,6 +8,7 @@ import (
 	"net/netip"
 	"time"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
 
 	"github.com/siderolabs/talos/pkg/machinery/cel"
@@ -19,6 +20,10 @@ import (
 type NetworkRuleConfig interface {
 	NetworkRuleConfigRules
 	NetworkRuleConfigDefaultAction
+	NetworkRuleConfigEgressRules
+	NetworkRuleConfigEgressDefaultAction
+	NetworkRuleConfigSourceNATRules
+	NetworkRuleConfigDestinationNATRules
 }
 
 // NetworkRuleConfigRules defines the interface to access network firewall configuration.
@@ -31,6 +36,26 @@ type NetworkRuleConfigDefaultAction interface {
 	DefaultAction() nethelpers.DefaultAction
 }
 
+// NetworkRuleConfigEgressRules defines the interface to access egress firewall rules.
+type NetworkRuleConfigEgressRules interface {
+	EgressRules() []NetworkRule
+}
+
+// NetworkRuleConfigEgressDefaultAction defines the interface to access egress firewall default action.
+type NetworkRuleConfigEgressDefaultAction interface {
+	EgressDefaultAction() nethelpers.DefaultAction
+}
+
+// NetworkRuleConfigSourceNATRules defines the interface to access source NAT rules.
+type NetworkRuleConfigSourceNATRules interface {
+	SourceNATRules() []NetworkSourceNATRule
+}
+
+// NetworkRuleConfigDestinationNATRules defines the interface to access destination NAT rules.
+type NetworkRuleConfigDestinationNATRules interface {
+	DestinationNATRules() []NetworkDestinationNATRule
+}
+
 // NetworkRuleConfigSignal is used to signal documents which implement either of the NetworkRuleConfig interfaces.
 type NetworkRuleConfigSignal interface {
 	NetworkRuleConfigSignal()
@@ -42,6 +67,38 @@ type NetworkRule interface {
 	PortRanges() [][2]uint16
 	Subnets() []netip.Prefix
 	ExceptSubnets() []netip.Prefix
+	Limits() NetworkRuleLimits
+}
+
+// NetworkRuleLimits defines per-source limits for the new connections matching a network firewall rule.
+//
+// Sources are grouped by the prefix of the specified length, zero value means no limit.
+type NetworkRuleLimits interface {
+	NewConnectionsPerSecond() uint32
+	NewConnectionsBurst() uint32
+	MaxConnections() uint32
+	SourcePrefixLengthIPv4() int
+	SourcePrefixLengthIPv6() int
+}
+
+// NetworkSourceNATRule defines a source NAT rule.
+//
+// If ToAddress is not set, the traffic is masqueraded (translated to the address of the outgoing link).
+type NetworkSourceNATRule interface {
+	SourceSubnets() []netip.Prefix
+	OutLinkName() string
+	ToAddress() optional.Optional[netip.Addr]
+}
+
+// NetworkDestinationNATRule defines a destination NAT (port forwarding) rule.
+type NetworkDestinationNATRule interface {
+	InLinkName() string
+	Protocol() nethelpers.Protocol
+	PortRanges() [][2]uint16
+	Subnets() []netip.Prefix
+	ExceptSubnets() []netip.Prefix
+	ToAddress() netip.Addr
+	ToPort() optional.Optional[uint16]
 }
 
 // WrapNetworkRuleConfigList wraps a list of NetworkConfig into a single NetworkConfig aggregating the results.
@@ -70,6 +127,42 @@ func (w networkRuleConfigWrapper) Rules() []NetworkRule {
 	)
 }
 
+func (w networkRuleConfigWrapper) EgressDefaultAction() nethelpers.DefaultAction {
+	return findFirstValue(
+		filterDocuments[NetworkRuleConfigEgressDefaultAction](w),
+		func(c NetworkRuleConfigEgressDefaultAction) nethelpers.DefaultAction {
+			return c.EgressDefaultAction()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) EgressRules() []NetworkRule {
+	return aggregateValues(
+		filterDocuments[NetworkRuleConfigEgressRules](w),
+		func(c NetworkRuleConfigEgressRules) []NetworkRule {
+			return c.EgressRules()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) SourceNATRules() []NetworkSourceNATRule {
+	return aggregateValues(
+		filterDocuments[NetworkRuleConfigSourceNATRules](w),
+		func(c NetworkRuleConfigSourceNATRules) []NetworkSourceNATRule {
+			return c.SourceNATRules()
+		},
+	)
+}
+
+func (w networkRuleConfigWrapper) DestinationNATRules() []NetworkDestinationNATRule {
+	return aggregateValues(
+		filterDocuments[NetworkRuleConfigDestinationNATRules](w),
+		func(c NetworkRuleConfigDestinationNATRules) []NetworkDestinationNATRule {
+			return c.DestinationNATRules()
+		},
+	)
+}
+
 // EthernetConfig defines a network interface configuration.
 type EthernetConfig interface {
 	NamedDocument
@@ -140,6 +233,19 @@ type NetworkTimeSyncConfig interface {
 	UseNTS() bool
 }
 
+// NetworkNTPServerConfig defines the configuration of the built-in NTP server.
+type NetworkNTPServerConfig interface {
+	ListenAddress() string
+	// NTS returns the NTS key establishment configuration, or nil when NTS is disabled.
+	NTS() NetworkNTPServerNTSConfig
+}
+
+// NetworkNTPServerNTSConfig defines the NTS key establishment configuration of the built-in NTP server.
+type NetworkNTPServerNTSConfig interface {
+	ListenAddress() string
+	ServerIdentity() *x509.PEMEncodedCertificateAndKey
+}
+
 // NetworkPhysicalLinkConfig defines a physical network link configuration.
 type NetworkPhysicalLinkConfig interface {
 	PhysicalLinkConfig()
@@ -196,6 +302,7 @@ type NetworkRouteConfig interface {
 	MTU() optional.Optional[uint32]
 	Metric() optional.Optional[uint32]
 	Table() optional.Optional[nethelpers.RoutingTable]
+	Probe() string
 }
 
 // NetworkLinkAliasConfig defines a network link alias configuration.
@@ -221,6 +328,9 @@ type NetworkDHCPv4Config interface {
 	IgnoreRoutes() optional.Optional[bool]
 	ClientIdentifier() nethelpers.ClientIdentifier
 	DUIDRaw() optional.Optional[nethelpers.HardwareAddr]
+	RequestOptions() []uint8
+	VendorClass() optional.Optional[string]
+	UserClass() []string
 }
 
 // NetworkDHCPv6Config defines a DHCPv6 configuration for a network link.
@@ -232,6 +342,24 @@ type NetworkDHCPv6Config interface {
 	IgnoreHostname() optional.Optional[bool]
 	ClientIdentifier() nethelpers.ClientIdentifier
 	DUIDRaw() optional.Optional[nethelpers.HardwareAddr]
+	// PrefixDelegation returns the prefix delegation configuration, or nil when prefix delegation is disabled.
+	PrefixDelegation() NetworkDHCPv6PrefixDelegation
+}
+
+// NetworkDHCPv6PrefixDelegation defines a DHCPv6 prefix delegation (DHCPv6-PD) configuration.
+type NetworkDHCPv6PrefixDelegation interface {
+	// PrefixLength is the prefix length hint sent to the server; zero means no hint.
+	PrefixLength() int
+	// Downstreams lists the links which get subnets of the delegated prefix.
+	Downstreams() []NetworkDHCPv6PrefixDelegationDownstream
+}
+
+// NetworkDHCPv6PrefixDelegationDownstream defines a downstream link of the delegated prefix.
+type NetworkDHCPv6PrefixDelegationDownstream interface {
+	// Link is the name or alias of the downstream link.
+	Link() string
+	// SubnetID is the index of the /64 subnet of the delegated prefix assigned to the link.
+	SubnetID() uint32
 }
 
 // NetworkVirtualIPConfig defines a common virtual IP configuration.
@@ -241,6 +369,7 @@ type NetworkVirtualIPConfig interface {
 	NamedDocument
 	Link() string
 	VIP() netip.Addr
+	Probe() string
 }
 
 // NetworkLayer2VIPConfig defines a Layer 2 VIP configuration.
@@ -266,6 +395,59 @@ type NetworkVLANConfig interface {
 	VLANMode() optional.Optional[nethelpers.VLANProtocol]
 }
 
+// NetworkMACVLANConfig defines a MACVLAN link configuration.
+type NetworkMACVLANConfig interface {
+	NamedDocument
+	NetworkCommonLinkConfig
+	NetworkHardwareAddressConfig
+	MACVLANConfig()
+	ParentLink() string
+	MACVLANMode() optional.Optional[nethelpers.MACVLANMode]
+}
+
+// NetworkIPVLANConfig defines an IPVLAN link configuration.
+type NetworkIPVLANConfig interface {
+	NamedDocument
+	NetworkCommonLinkConfig
+	IPVLANConfig()
+	ParentLink() string
+	IPVLANMode() optional.Optional[nethelpers.IPVLANMode]
+}
+
+// NetworkVXLANConfig defines a VXLAN link configuration.
+type NetworkVXLANConfig interface {
+	NamedDocument
+	NetworkCommonLinkConfig
+	VXLANConfig()
+	VNI() uint32
+	ParentLink() string
+	Local() optional.Optional[netip.Addr]
+	Remote() optional.Optional[netip.Addr]
+	Port() optional.Optional[uint16]
+}
+
+// NetworkGeneveConfig defines a Geneve link configuration.
+type NetworkGeneveConfig interface {
+	NamedDocument
+	NetworkCommonLinkConfig
+	GeneveConfig()
+	VNI() uint32
+	Remote() netip.Addr
+	Port() optional.Optional[uint16]
+}
+
+// NetworkGREConfig defines a GRE (or GRETAP, if TAP() is true) link configuration.
+type NetworkGREConfig interface {
+	NamedDocument
+	NetworkCommonLinkConfig
+	GREConfig()
+	TAP() bool
+	ParentLink() string
+	Local() optional.Optional[netip.Addr]
+	Remote() netip.Addr
+	Key() optional.Optional[uint32]
+}
+
 // NetworkBondConfig defines a bond link configuration.
 //
 //nolint:interfacebloat
@@ -364,6 +546,9 @@ type NetworkKubeSpanConfig interface {
 	HarvestExtraEndpoints() bool
 	MTU() uint32
 	Filters() NetworkKubeSpanFilters
+	Relay() bool
+	KeyRotationInterval() time.Duration
+	PerPeerPresharedKeys() bool
 }
 
 // NetworkKubeSpanFilters configures KubeSpan filters.
@@ -415,6 +600,25 @@ type NetworkHostDNSConfig interface {
 	HostDNSEnabled() bool
 	ForwardKubeDNSToHost() bool
 	ResolveMemberNames() bool
+	Forwarders() []NetworkHostDNSForwarder
+	QueryLog() bool
+	NegativeCache() NetworkHostDNSNegativeCache
+}
+
+// NetworkHostDNSNegativeCache is a host DNS negative response cache configuration.
+type NetworkHostDNSNegativeCache struct {
+	// Size is the maximum number of cached responses.
+	Size int
+	// MinTTL and MaxTTL clamp the TTL of the cached responses.
+	MinTTL time.Duration
+	MaxTTL time.Duration
+}
+
+// NetworkHostDNSForwarder is a host DNS per-domain forwarder.
+type NetworkHostDNSForwarder struct {
+	// Domains are normalized: lowercase, without the trailing dot.
+	Domains   []string
+	Resolvers []NetworkResolver
 }
 
 // NetworkHTTPProbeConfig defines an HTTP probe configuration.
@@ -442,6 +646,8 @@ type NetworkBGPInstanceConfig interface {
 	RouteSource() netip.Addr
 	// AdvertiseLinks lists link names or aliases whose addresses are originated as connected networks using their configured prefix lengths.
 	AdvertiseLinks() []string
+	// AdvertiseProbes lists the probes gating the advertisement of the links.
+	AdvertiseProbes() []NetworkBGPAdvertiseProbe
 	// Multipath enables ECMP (multiple best paths) for received routes.
 	Multipath() bool
 	// MaxPaths caps the number of ECMP next-hops; zero means use the implementation default.
@@ -452,6 +658,24 @@ type NetworkBGPInstanceConfig interface {
 	ImportRoutes() []NetworkBGPImportRoute
 	// Neighbors lists the configured BGP neighbors.
 	Neighbors() []NetworkBGPNeighbor
+	// GracefulRestart returns the graceful restart configuration, or nil when graceful restart is disabled.
+	GracefulRestart() NetworkBGPGracefulRestart
+}
+
+// NetworkBGPGracefulRestart defines BGP graceful restart parameters.
+type NetworkBGPGracefulRestart interface {
+	// RestartTime is the time the neighbors retain the routes after the session goes down.
+	RestartTime() time.Duration
+	// LongLivedStaleTime is the long-lived graceful restart stale time; zero disables long-lived graceful restart.
+	LongLivedStaleTime() time.Duration
+}
+
+// NetworkBGPAdvertiseProbe defines a probe gating the advertisement of a link's addresses.
+type NetworkBGPAdvertiseProbe interface {
+	// Link is the name or alias of the advertised link.
+	Link() string
+	// Probe is the name of the probe; the link's addresses are withdrawn while it fails.
+	Probe() string
 }
 
 // NetworkBGPImportRoute defines selected routes imported from another BGP instance.
@@ -478,6 +702,34 @@ type NetworkBGPNeighbor interface {
 	HoldTime() time.Duration
 	// BFD returns this neighbor's BFD configuration, or nil when BFD is disabled.
 	BFD() NetworkBGPBFD
+	// Password is the TCP-MD5 session password; empty disables session authentication.
+	Password() string
+	// ImportPolicy returns the policy for routes received from the neighbor, or nil when not configured.
+	ImportPolicy() NetworkBGPRoutePolicy
+	// ExportPolicy returns the policy for routes advertised to the neighbor, or nil when not configured.
+	ExportPolicy() NetworkBGPRoutePolicy
+}
+
+// NetworkBGPRoutePolicy defines route filtering and modification for a BGP neighbor.
+type NetworkBGPRoutePolicy interface {
+	// Prefixes is the prefix list routes must match; empty accepts any prefix.
+	Prefixes() []NetworkBGPPrefixFilter
+	// MatchCommunities lists standard communities, one of which must be carried by the route; empty accepts any route.
+	MatchCommunities() []string
+	// AddCommunities lists standard communities added to the accepted routes.
+	AddCommunities() []string
+	// AddLargeCommunities lists large communities added to the accepted routes.
+	AddLargeCommunities() []string
+	// ASPathPrepend is the number of times the local ASN is prepended to the AS path.
+	ASPathPrepend() uint8
+}
+
+// NetworkBGPPrefixFilter defines a single BGP prefix list entry.
+type NetworkBGPPrefixFilter interface {
+	// Prefix is the matched prefix.
+	Prefix() netip.Prefix
+	// MaxLength is the longest matched prefix length.
+	MaxLength() uint8
 }
 
 // NetworkBGPBFD defines BFD parameters for a BGP neighbor.
@@ -20,6 +20,7 @@ import (
 type RuntimeConfig interface {
 	EventsEndpoint() *string
 	KmsgLogURLs() []*url.URL
+	KmsgLogDestinations() []LoggingDestination
 	WatchdogTimer() WatchdogTimerConfig
 }
 
@@ -102,6 +103,12 @@ type SecurityProfileConfig interface {
 	WorkloadIsolation() bool
 }
 
+// MetricsConfig defines the interface to access Talos metrics endpoint configuration.
+type MetricsConfig interface {
+	MetricsConfigSignal()
+	ListenAddress() string
+}
+
 // WrapRuntimeConfigList wraps a list of RuntimeConfig into a single RuntimeConfig aggregating the results.
 func WrapRuntimeConfigList(configs ...RuntimeConfig) RuntimeConfig {
 	return runtimeConfigWrapper(configs)
@@ -121,6 +128,12 @@ func (w runtimeConfigWrapper) KmsgLogURLs() []*url.URL {
 	})
 }
 
+func (w runtimeConfigWrapper) KmsgLogDestinations() []LoggingDestination {
+	return aggregateValues(w, func(c RuntimeConfig) []LoggingDestination {
+		return c.KmsgLogDestinations()
+	})
+}
+
 func (w runtimeConfigWrapper) WatchdogTimer() WatchdogTimerConfig {
 	return findFirstValue(w, func(c RuntimeConfig) WatchdogTimerConfig {
 		return c.WatchdogTimer()
@@ -211,6 +211,9 @@ type ExistingVolumeMountConfig interface {
 type ExternalVolumeMountConfig interface {
 	ExistingVolumeMountConfig
 	Virtiofs() optional.Optional[ExternalVolumeMountConfigSpec]
+	NFS() optional.Optional[ExternalVolumeNFSConfig]
+	ISCSI() optional.Optional[ExternalVolumeISCSIConfig]
+	NVMeTCP() optional.Optional[ExternalVolumeNVMeTCPConfig]
 }
 
 // ExternalVolumeMountConfigSpec defines the interface to access external mount configuration spec.
@@ -219,6 +222,28 @@ type ExternalVolumeMountConfigSpec interface {
 	Parameters() ([]block.ParameterSpec, error)
 }
 
+// ExternalVolumeNFSConfig defines the interface to access NFS external volume configuration.
+type ExternalVolumeNFSConfig interface {
+	ExternalVolumeMountConfigSpec
+	Server() string
+}
+
+// ExternalVolumeISCSIConfig defines the interface to access iSCSI external volume configuration.
+type ExternalVolumeISCSIConfig interface {
+	Portal() string
+	Port() uint16
+	Target() string
+	LUN() uint32
+}
+
+// ExternalVolumeNVMeTCPConfig defines the interface to access NVMe over TCP external volume configuration.
+type ExternalVolumeNVMeTCPConfig interface {
+	Address() string
+	Port() uint16
+	SubsystemNQN() string
+	NamespaceID() uint32
+}
+
 // FilesystemConfig defines the interface to access filesystem configuration.
 type FilesystemConfig interface {
 	SystemVolumeFilesystemConfig
@@ -523,6 +523,16 @@ func (container *Container) SecurityProfileConfig() config.SecurityProfileConfig
 	return matching[0]
 }
 
+// MetricsConfig implements config.Config interface.
+func (container *Container) MetricsConfig() config.MetricsConfig {
+	matching := findMatchingDocs[config.MetricsConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // FilesystemScrubConfig implements config.Config interface.
 func (container *Container) FilesystemScrubConfig() config.FilesystemScrubConfig {
 	matching := findMatchingDocs[config.FilesystemScrubConfig](container.documents)
@@ -606,6 +616,16 @@ func (container *Container) NetworkTimeSyncConfig() config.NetworkTimeSyncConfig
 	return nil
 }
 
+// NetworkNTPServerConfig implements config.Config interface.
+func (container *Container) NetworkNTPServerConfig() config.NetworkNTPServerConfig {
+	matching := findMatchingDocs[config.NetworkNTPServerConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // NetworkKubeSpanConfig implements config.Config interface.
 func (container *Container) NetworkKubeSpanConfig() config.NetworkKubeSpanConfig {
 	// first check if we have a dedicated document
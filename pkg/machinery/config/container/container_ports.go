@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// validateContainerHostPorts checks that no host port is published by more than one container.
+//
+// Each document only checks its own ports, so a collision between two containers is only
+// visible across the documents: the second destination NAT rule would never match.
+func validateContainerHostPorts(configs []config.ContainerConfig) error {
+	type hostPort struct {
+		protocol nethelpers.Protocol
+		port     uint16
+	}
+
+	publishedBy := map[hostPort]string{}
+
+	var errs error
+
+	for _, cfg := range configs {
+		if cfg.Network().Mode() != config.ContainerNetworkModeBridge {
+			continue
+		}
+
+		for _, port := range cfg.Network().Ports() {
+			key := hostPort{protocol: port.Protocol(), port: port.HostPort()}
+
+			owner, exists := publishedBy[key]
+
+			switch {
+			case !exists:
+				publishedBy[key] = cfg.Name()
+			case owner != cfg.Name():
+				errs = multierror.Append(errs,
+					fmt.Errorf("container %q publishes host port %s/%d, which is already published by container %q", cfg.Name(), key.protocol, key.port, owner))
+			}
+		}
+	}
+
+	if errs == nil {
+		return nil
+	}
+
+	if multiErr, ok := errors.AsType[*multierror.Error](errs); ok {
+		return multiErr.ErrorOrNil()
+	}
+
+	return errs
+}
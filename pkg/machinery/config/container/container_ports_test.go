@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	containercfg "github.com/siderolabs/talos/pkg/machinery/config/types/container"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// withHostPorts attaches the container to the bridge network publishing the given ports.
+func withHostPorts(doc *containercfg.ContainerConfigV1Alpha1, ports ...containercfg.ContainerPort) *containercfg.ContainerConfigV1Alpha1 {
+	doc.NetworkConfig = &containercfg.ContainerNetwork{
+		NetworkMode:  config.ContainerNetworkModeBridge,
+		NetworkPorts: ports,
+	}
+
+	return doc
+}
+
+func TestContainerHostPortCollisions(t *testing.T) {
+	t.Parallel()
+
+	tcp8080 := containercfg.ContainerPort{PortContainerPort: 80, PortHostPort: 8080}
+	udp8080 := containercfg.ContainerPort{PortContainerPort: 80, PortHostPort: 8080, PortProtocol: nethelpers.ProtocolUDP}
+	tcp8081 := containercfg.ContainerPort{PortContainerPort: 80, PortHostPort: 8081}
+
+	for _, test := range []struct {
+		name        string
+		docs        []*containercfg.ContainerConfigV1Alpha1
+		expectedErr string
+	}{
+		{
+			name: "distinct ports",
+			docs: []*containercfg.ContainerConfigV1Alpha1{
+				withHostPorts(newContainerDoc("a"), tcp8080),
+				withHostPorts(newContainerDoc("b"), tcp8081),
+			},
+		},
+		{
+			name: "same port, different protocols",
+			docs: []*containercfg.ContainerConfigV1Alpha1{
+				withHostPorts(newContainerDoc("a"), tcp8080),
+				withHostPorts(newContainerDoc("b"), udp8080),
+			},
+		},
+		{
+			name: "same port and protocol",
+			docs: []*containercfg.ContainerConfigV1Alpha1{
+				withHostPorts(newContainerDoc("a"), tcp8080),
+				withHostPorts(newContainerDoc("b"), tcp8081, tcp8080),
+			},
+			expectedErr: `container "b" publishes host port tcp/8080, which is already published by container "a"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateDocs(t, test.docs...)
+
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
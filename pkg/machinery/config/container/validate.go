@@ -262,6 +262,11 @@ func (container *Container) validateContainer(mode validation.RuntimeMode) error
 		errs = multierror.Append(errs, err)
 	}
 
+	// Host ports published by the containers must not collide, which is again only visible across the documents.
+	if err := validateContainerHostPorts(container.ContainerConfigs()); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	// KubeSpan requires a cluster identity, provided either by the deprecated .cluster.id/.cluster.secret
 	// or by a DiscoveryIdentityConfig document. The identity may live in a separate document, so this
 	// cross-document check is done at the container level.
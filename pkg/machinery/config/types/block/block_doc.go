@@ -455,10 +455,14 @@ func (ExternalVolumeConfigV1Alpha1) Doc() *encoder.Doc {
 				Name:        "filesystemType",
 				Type:        "FilesystemType",
 				Note:        "",
-				Description: "Filesystem type.",
+				Description: "Filesystem type.\n\nFor iSCSI and NVMe over TCP volumes, this is the type of the existing filesystem on the target\n(the filesystem is never created by Talos).",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Filesystem type." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"virtiofs",
+					"nfs",
+					"xfs",
+					"ext4",
+					"btrfs",
 				},
 			},
 			{
@@ -473,6 +477,12 @@ func (ExternalVolumeConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.AddExample("", exampleExternalVolumeConfigV1Alpha1Virtiofs())
 
+	doc.AddExample("", exampleExternalVolumeConfigV1Alpha1NFS())
+
+	doc.AddExample("", exampleExternalVolumeConfigV1Alpha1ISCSI())
+
+	doc.AddExample("", exampleExternalVolumeConfigV1Alpha1NVMeTCP())
+
 	return doc
 }
 
@@ -516,6 +526,27 @@ func (ExternalMountSpec) Doc() *encoder.Doc {
 				Description: "Virtiofs mount options.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Virtiofs mount options." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "nfs",
+				Type:        "NFSMountSpec",
+				Note:        "",
+				Description: "NFS mount options.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NFS mount options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "iscsi",
+				Type:        "ISCSIMountSpec",
+				Note:        "",
+				Description: "iSCSI target to log in to before the volume is mounted.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "iSCSI target to log in to before the volume is mounted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nvmeTCP",
+				Type:        "NVMeTCPMountSpec",
+				Note:        "",
+				Description: "NVMe over TCP target to connect to before the volume is mounted.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NVMe over TCP target to connect to before the volume is mounted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -547,6 +578,152 @@ func (VirtiofsMountSpec) Doc() *encoder.Doc {
 	return doc
 }
 
+func (NFSMountSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NFSMountSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NFSMountSpec describes NFS mount options." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NFSMountSpec describes NFS mount options.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ExternalMountSpec",
+				FieldName: "nfs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "server",
+				Type:        "string",
+				Note:        "",
+				Description: "NFS server hostname or IP address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NFS server hostname or IP address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "path",
+				Type:        "string",
+				Note:        "",
+				Description: "Path of the export on the NFS server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Path of the export on the NFS server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "version",
+				Type:        "string",
+				Note:        "",
+				Description: "NFS protocol version.\n\nDefaults to 4.2.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NFS protocol version." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"3",
+					"4",
+					"4.1",
+					"4.2",
+				},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "192.168.1.20")
+	doc.Fields[1].AddExample("", "/exports/data")
+
+	return doc
+}
+
+func (ISCSIMountSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ISCSIMountSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ISCSIMountSpec describes the iSCSI target of the volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ISCSIMountSpec describes the iSCSI target of the volume.\n\nThe iSCSI initiator name is generated by Talos (`/etc/iscsi/initiatorname.iscsi`), and\nthe `iscsi-tools` system extension is required to log in to the target.\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ExternalMountSpec",
+				FieldName: "iscsi",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "portal",
+				Type:        "string",
+				Note:        "",
+				Description: "Address (hostname or IP address) of the iSCSI portal.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address (hostname or IP address) of the iSCSI portal." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "TCP port of the iSCSI portal.\n\nDefaults to 3260.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP port of the iSCSI portal." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "target",
+				Type:        "string",
+				Note:        "",
+				Description: "iSCSI qualified name (IQN) of the target.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "iSCSI qualified name (IQN) of the target." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "lun",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Logical unit number (LUN) of the volume on the target.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Logical unit number (LUN) of the volume on the target." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "192.168.1.30")
+	doc.Fields[2].AddExample("", "iqn.2003-01.org.linux-iscsi.storage:data")
+
+	return doc
+}
+
+func (NVMeTCPMountSpec) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NVMeTCPMountSpec",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NVMeTCPMountSpec describes the NVMe over TCP target of the volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NVMeTCPMountSpec describes the NVMe over TCP target of the volume.\n\nThe host NQN and host ID are generated by Talos (`/etc/nvme/hostnqn` and `/etc/nvme/hostid`).\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ExternalMountSpec",
+				FieldName: "nvmeTCP",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "address",
+				Type:        "string",
+				Note:        "",
+				Description: "IP address of the NVMe over TCP target.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "IP address of the NVMe over TCP target." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "TCP port of the NVMe over TCP target.\n\nDefaults to 4420.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP port of the NVMe over TCP target." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "subsystemNQN",
+				Type:        "string",
+				Note:        "",
+				Description: "NVMe qualified name (NQN) of the target subsystem.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NVMe qualified name (NQN) of the target subsystem." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "namespaceID",
+				Type:        "uint32",
+				Note:        "",
+				Description: "ID of the namespace of the volume in the subsystem.\n\nDefaults to 1.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ID of the namespace of the volume in the subsystem." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "192.168.1.40")
+	doc.Fields[2].AddExample("", "nqn.2014-08.org.nvmexpress:storage:data")
+
+	return doc
+}
+
 func (FilesystemTrimConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "FilesystemTrimConfig",
@@ -1211,6 +1388,9 @@ func GetFileDoc() *encoder.FileDoc {
 			ExternalVolumeConfigV1Alpha1{}.Doc(),
 			ExternalMountSpec{}.Doc(),
 			VirtiofsMountSpec{}.Doc(),
+			NFSMountSpec{}.Doc(),
+			ISCSIMountSpec{}.Doc(),
+			NVMeTCPMountSpec{}.Doc(),
 			FilesystemTrimConfigV1Alpha1{}.Doc(),
 			FilesystemScrubConfigV1Alpha1{}.Doc(),
 			RawVolumeConfigV1Alpha1{}.Doc(),
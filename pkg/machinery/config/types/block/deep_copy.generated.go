@@ -379,6 +379,18 @@ func (o *ExternalVolumeConfigV1Alpha1) DeepCopy() *ExternalVolumeConfigV1Alpha1
 		cp.MountSpec.MountVirtiofs = new(VirtiofsMountSpec)
 		*cp.MountSpec.MountVirtiofs = *o.MountSpec.MountVirtiofs
 	}
+	if o.MountSpec.MountNFS != nil {
+		cp.MountSpec.MountNFS = new(NFSMountSpec)
+		*cp.MountSpec.MountNFS = *o.MountSpec.MountNFS
+	}
+	if o.MountSpec.MountISCSI != nil {
+		cp.MountSpec.MountISCSI = new(ISCSIMountSpec)
+		*cp.MountSpec.MountISCSI = *o.MountSpec.MountISCSI
+	}
+	if o.MountSpec.MountNVMeTCP != nil {
+		cp.MountSpec.MountNVMeTCP = new(NVMeTCPMountSpec)
+		*cp.MountSpec.MountNVMeTCP = *o.MountSpec.MountNVMeTCP
+	}
 	return &cp
 }
 
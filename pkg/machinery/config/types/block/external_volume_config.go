@@ -9,6 +9,10 @@ package block
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"path"
+	"slices"
 	"strings"
 
 	"github.com/siderolabs/gen/optional"
@@ -56,6 +60,9 @@ type FilesystemType = block.FilesystemType
 //	  The external volume config name should not conflict with user volume names.
 //	examples:
 //	  - value: exampleExternalVolumeConfigV1Alpha1Virtiofs()
+//	  - value: exampleExternalVolumeConfigV1Alpha1NFS()
+//	  - value: exampleExternalVolumeConfigV1Alpha1ISCSI()
+//	  - value: exampleExternalVolumeConfigV1Alpha1NVMeTCP()
 //	alias: ExternalVolumeConfig
 //	schemaRoot: true
 //	schemaMeta: v1alpha1/ExternalVolumeConfig
@@ -70,8 +77,15 @@ type ExternalVolumeConfigV1Alpha1 struct {
 	MetaName string `yaml:"name"`
 	//   description: |
 	//     Filesystem type.
+	//
+	//     For iSCSI and NVMe over TCP volumes, this is the type of the existing filesystem on the target
+	//     (the filesystem is never created by Talos).
 	//   values:
 	//     - virtiofs
+	//     - nfs
+	//     - xfs
+	//     - ext4
+	//     - btrfs
 	//  schema:
 	//    type: string
 	FilesystemType FilesystemType `yaml:"filesystemType"`
@@ -97,6 +111,15 @@ type ExternalMountSpec struct {
 	//   description: |
 	//     Virtiofs mount options.
 	MountVirtiofs *VirtiofsMountSpec `yaml:"virtiofs,omitempty"`
+	//   description: |
+	//     NFS mount options.
+	MountNFS *NFSMountSpec `yaml:"nfs,omitempty"`
+	//   description: |
+	//     iSCSI target to log in to before the volume is mounted.
+	MountISCSI *ISCSIMountSpec `yaml:"iscsi,omitempty"`
+	//   description: |
+	//     NVMe over TCP target to connect to before the volume is mounted.
+	MountNVMeTCP *NVMeTCPMountSpec `yaml:"nvmeTCP,omitempty"`
 }
 
 // VirtiofsMountSpec describes Virtiofs mount options.
@@ -106,6 +129,97 @@ type VirtiofsMountSpec struct {
 	VirtiofsTag string `yaml:"tag"`
 }
 
+// NFSMountSpec describes NFS mount options.
+type NFSMountSpec struct {
+	//   description: |
+	//     NFS server hostname or IP address.
+	//   examples:
+	//    - value: >
+	//       "192.168.1.20"
+	NFSServer string `yaml:"server"`
+	//   description: |
+	//     Path of the export on the NFS server.
+	//   examples:
+	//    - value: >
+	//       "/exports/data"
+	NFSPath string `yaml:"path"`
+	//   description: |
+	//     NFS protocol version.
+	//
+	//     Defaults to 4.2.
+	//   values:
+	//     - "3"
+	//     - "4"
+	//     - "4.1"
+	//     - "4.2"
+	NFSVersion string `yaml:"version,omitempty"`
+}
+
+// ISCSIMountSpec describes the iSCSI target of the volume.
+//
+// The iSCSI initiator name is generated by Talos (`/etc/iscsi/initiatorname.iscsi`), and
+// the `iscsi-tools` system extension is required to log in to the target.
+type ISCSIMountSpec struct {
+	//   description: |
+	//     Address (hostname or IP address) of the iSCSI portal.
+	//   examples:
+	//    - value: >
+	//       "192.168.1.30"
+	ISCSIPortal string `yaml:"portal"`
+	//   description: |
+	//     TCP port of the iSCSI portal.
+	//
+	//     Defaults to 3260.
+	ISCSIPort uint16 `yaml:"port,omitempty"`
+	//   description: |
+	//     iSCSI qualified name (IQN) of the target.
+	//   examples:
+	//    - value: >
+	//       "iqn.2003-01.org.linux-iscsi.storage:data"
+	ISCSITarget string `yaml:"target"`
+	//   description: |
+	//     Logical unit number (LUN) of the volume on the target.
+	ISCSILUN uint32 `yaml:"lun,omitempty"`
+}
+
+// NVMeTCPMountSpec describes the NVMe over TCP target of the volume.
+//
+// The host NQN and host ID are generated by Talos (`/etc/nvme/hostnqn` and `/etc/nvme/hostid`).
+type NVMeTCPMountSpec struct {
+	//   description: |
+	//     IP address of the NVMe over TCP target.
+	//   examples:
+	//    - value: >
+	//       "192.168.1.40"
+	NVMeTCPAddress string `yaml:"address"`
+	//   description: |
+	//     TCP port of the NVMe over TCP target.
+	//
+	//     Defaults to 4420.
+	NVMeTCPPort uint16 `yaml:"port,omitempty"`
+	//   description: |
+	//     NVMe qualified name (NQN) of the target subsystem.
+	//   examples:
+	//    - value: >
+	//       "nqn.2014-08.org.nvmexpress:storage:data"
+	NVMeTCPSubsystemNQN string `yaml:"subsystemNQN"`
+	//   description: |
+	//     ID of the namespace of the volume in the subsystem.
+	//
+	//     Defaults to 1.
+	NVMeTCPNamespaceID uint32 `yaml:"namespaceID,omitempty"`
+}
+
+// Default ports and values for the network external volumes.
+const (
+	defaultNFSVersion      = "4.2"
+	defaultISCSIPort       = 3260
+	defaultNVMeTCPPort     = 4420
+	defaultNVMeNamespaceID = 1
+)
+
+var validNFSVersions = []string{"3", "4", "4.1", "4.2"}
+
 // NewExternalVolumeConfigV1Alpha1 creates a new user mount config document.
 func NewExternalVolumeConfigV1Alpha1() *ExternalVolumeConfigV1Alpha1 {
 	return &ExternalVolumeConfigV1Alpha1{
@@ -127,6 +241,42 @@ func exampleExternalVolumeConfigV1Alpha1Virtiofs() *ExternalVolumeConfigV1Alpha1
 	return cfg
 }
 
+func exampleExternalVolumeConfigV1Alpha1NFS() *ExternalVolumeConfigV1Alpha1 {
+	cfg := NewExternalVolumeConfigV1Alpha1()
+	cfg.MetaName = "shared"
+	cfg.FilesystemType = block.FilesystemTypeNFS
+	cfg.MountSpec.MountNFS = &NFSMountSpec{
+		NFSServer: "192.168.1.20",
+		NFSPath:   "/exports/shared",
+	}
+
+	return cfg
+}
+
+func exampleExternalVolumeConfigV1Alpha1ISCSI() *ExternalVolumeConfigV1Alpha1 {
+	cfg := NewExternalVolumeConfigV1Alpha1()
+	cfg.MetaName = "iscsi-data"
+	cfg.FilesystemType = block.FilesystemTypeXFS
+	cfg.MountSpec.MountISCSI = &ISCSIMountSpec{
+		ISCSIPortal: "192.168.1.30",
+		ISCSITarget: "iqn.2003-01.org.linux-iscsi.storage:data",
+	}
+
+	return cfg
+}
+
+func exampleExternalVolumeConfigV1Alpha1NVMeTCP() *ExternalVolumeConfigV1Alpha1 {
+	cfg := NewExternalVolumeConfigV1Alpha1()
+	cfg.MetaName = "nvme-data"
+	cfg.FilesystemType = block.FilesystemTypeEXT4
+	cfg.MountSpec.MountNVMeTCP = &NVMeTCPMountSpec{
+		NVMeTCPAddress:      "192.168.1.40",
+		NVMeTCPSubsystemNQN: "nqn.2014-08.org.nvmexpress:storage:data",
+	}
+
+	return cfg
+}
+
 // Name implements config.NamedDocument interface.
 func (s *ExternalVolumeConfigV1Alpha1) Name() string {
 	return s.MetaName
@@ -171,6 +321,10 @@ func (s *ExternalVolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...valid
 		validationErrors = errors.Join(validationErrors, errors.New("name can only contain lowercase and uppercase ASCII letters, digits, and hyphens"))
 	}
 
+	if s.MountSpec.mountSpecCount() > 1 {
+		validationErrors = errors.Join(validationErrors, errors.New("only one of virtiofs, nfs, iscsi or nvmeTCP mount specs can be set"))
+	}
+
 	switch s.FilesystemType {
 	case block.FilesystemTypeVirtiofs:
 		extraWarnings, extraErrors := s.MountSpec.MountVirtiofs.Validate()
@@ -178,7 +332,30 @@ func (s *ExternalVolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...valid
 		warnings = append(warnings, extraWarnings...)
 		validationErrors = errors.Join(validationErrors, extraErrors)
 
-	case block.FilesystemTypeNone, block.FilesystemTypeXFS, block.FilesystemTypeVFAT, block.FilesystemTypeEXT4, block.FilesystemTypeISO9660, block.FilesystemTypeSwap:
+	case block.FilesystemTypeNFS:
+		extraWarnings, extraErrors := s.MountSpec.MountNFS.Validate()
+
+		warnings = append(warnings, extraWarnings...)
+		validationErrors = errors.Join(validationErrors, extraErrors)
+
+	case block.FilesystemTypeXFS, block.FilesystemTypeEXT4, block.FilesystemTypeBtrfs:
+		// block filesystems can be only mounted from a network block device
+		switch {
+		case s.MountSpec.MountISCSI != nil:
+			extraWarnings, extraErrors := s.MountSpec.MountISCSI.Validate()
+
+			warnings = append(warnings, extraWarnings...)
+			validationErrors = errors.Join(validationErrors, extraErrors)
+		case s.MountSpec.MountNVMeTCP != nil:
+			extraWarnings, extraErrors := s.MountSpec.MountNVMeTCP.Validate()
+
+			warnings = append(warnings, extraWarnings...)
+			validationErrors = errors.Join(validationErrors, extraErrors)
+		default:
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("invalid filesystem type: %s", s.FilesystemType))
+		}
+
+	case block.FilesystemTypeNone, block.FilesystemTypeVFAT, block.FilesystemTypeISO9660, block.FilesystemTypeSwap:
 		fallthrough
 
 	default:
@@ -229,6 +406,45 @@ func (s ExternalMountSpec) Virtiofs() optional.Optional[config.ExternalVolumeMou
 	return optional.Some[config.ExternalVolumeMountConfigSpec](*s.MountVirtiofs)
 }
 
+// NFS implements config.ExternalVolumeMountConfig interface.
+func (s ExternalMountSpec) NFS() optional.Optional[config.ExternalVolumeNFSConfig] {
+	if s.MountNFS == nil {
+		return optional.None[config.ExternalVolumeNFSConfig]()
+	}
+
+	return optional.Some[config.ExternalVolumeNFSConfig](*s.MountNFS)
+}
+
+// ISCSI implements config.ExternalVolumeMountConfig interface.
+func (s ExternalMountSpec) ISCSI() optional.Optional[config.ExternalVolumeISCSIConfig] {
+	if s.MountISCSI == nil {
+		return optional.None[config.ExternalVolumeISCSIConfig]()
+	}
+
+	return optional.Some[config.ExternalVolumeISCSIConfig](*s.MountISCSI)
+}
+
+// NVMeTCP implements config.ExternalVolumeMountConfig interface.
+func (s ExternalMountSpec) NVMeTCP() optional.Optional[config.ExternalVolumeNVMeTCPConfig] {
+	if s.MountNVMeTCP == nil {
+		return optional.None[config.ExternalVolumeNVMeTCPConfig]()
+	}
+
+	return optional.Some[config.ExternalVolumeNVMeTCPConfig](*s.MountNVMeTCP)
+}
+
+func (s ExternalMountSpec) mountSpecCount() int {
+	count := 0
+
+	for _, set := range []bool{s.MountVirtiofs != nil, s.MountNFS != nil, s.MountISCSI != nil, s.MountNVMeTCP != nil} {
+		if set {
+			count++
+		}
+	}
+
+	return count
+}
+
 // Source implements config.ExternalVolumeMountConfigSpec interface.
 func (s VirtiofsMountSpec) Source() string {
 	return s.VirtiofsTag
@@ -253,3 +469,156 @@ func (s *VirtiofsMountSpec) Validate() ([]string, error) {
 
 	return nil, validationErrors
 }
+
+// Server implements config.ExternalVolumeNFSConfig interface.
+func (s NFSMountSpec) Server() string {
+	return s.NFSServer
+}
+
+// Source implements config.ExternalVolumeMountConfigSpec interface.
+func (s NFSMountSpec) Source() string {
+	if addr, err := netip.ParseAddr(s.NFSServer); err == nil && addr.Is6() {
+		return "[" + s.NFSServer + "]:" + s.NFSPath
+	}
+
+	return s.NFSServer + ":" + s.NFSPath
+}
+
+// Parameters implements config.ExternalVolumeMountConfigSpec interface.
+//
+// The server address (`addr`) is resolved when the volume is attached, as the kernel NFS client doesn't resolve hostnames.
+func (s NFSMountSpec) Parameters() ([]block.ParameterSpec, error) {
+	version := s.NFSVersion
+	if version == "" {
+		version = defaultNFSVersion
+	}
+
+	params := []block.ParameterSpec{
+		block.NewStringParameter("vers", version),
+	}
+
+	if version == "3" {
+		// there is no rpc.statd on Talos, so NLM locking is not available
+		params = append(params, block.NewBooleanParameter("nolock"))
+	}
+
+	return params, nil
+}
+
+// Validate implements config.Validator interface.
+func (s *NFSMountSpec) Validate() ([]string, error) {
+	var validationErrors error
+
+	if s == nil {
+		return nil, errors.New("nfs mount spec is required")
+	}
+
+	if s.NFSServer == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("nfs server is required"))
+	}
+
+	if !path.IsAbs(s.NFSPath) {
+		validationErrors = errors.Join(validationErrors, errors.New("nfs path must be an absolute path"))
+	}
+
+	if s.NFSVersion != "" && !slices.Contains(validNFSVersions, s.NFSVersion) {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("nfs version must be one of %s", strings.Join(validNFSVersions, ", ")))
+	}
+
+	return nil, validationErrors
+}
+
+// Portal implements config.ExternalVolumeISCSIConfig interface.
+func (s ISCSIMountSpec) Portal() string {
+	return s.ISCSIPortal
+}
+
+// Port implements config.ExternalVolumeISCSIConfig interface.
+func (s ISCSIMountSpec) Port() uint16 {
+	if s.ISCSIPort == 0 {
+		return defaultISCSIPort
+	}
+
+	return s.ISCSIPort
+}
+
+// Target implements config.ExternalVolumeISCSIConfig interface.
+func (s ISCSIMountSpec) Target() string {
+	return s.ISCSITarget
+}
+
+// LUN implements config.ExternalVolumeISCSIConfig interface.
+func (s ISCSIMountSpec) LUN() uint32 {
+	return s.ISCSILUN
+}
+
+// Validate implements config.Validator interface.
+func (s *ISCSIMountSpec) Validate() ([]string, error) {
+	var validationErrors error
+
+	if s == nil {
+		return nil, errors.New("iscsi mount spec is required")
+	}
+
+	if s.ISCSIPortal == "" {
+		validationErrors = errors.Join(validationErrors, errors.New("iscsi portal is required"))
+	} else if _, _, err := net.SplitHostPort(s.ISCSIPortal); err == nil {
+		validationErrors = errors.Join(validationErrors, errors.New("iscsi portal should not include the port, use the port field instead"))
+	}
+
+	switch {
+	case s.ISCSITarget == "":
+		validationErrors = errors.Join(validationErrors, errors.New("iscsi target is required"))
+	case !strings.HasPrefix(s.ISCSITarget, "iqn.") && !strings.HasPrefix(s.ISCSITarget, "eui.") && !strings.HasPrefix(s.ISCSITarget, "naa."):
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("iscsi target %q is not a valid iSCSI name", s.ISCSITarget))
+	}
+
+	return nil, validationErrors
+}
+
+// Address implements config.ExternalVolumeNVMeTCPConfig interface.
+func (s NVMeTCPMountSpec) Address() string {
+	return s.NVMeTCPAddress
+}
+
+// Port implements config.ExternalVolumeNVMeTCPConfig interface.
+func (s NVMeTCPMountSpec) Port() uint16 {
+	if s.NVMeTCPPort == 0 {
+		return defaultNVMeTCPPort
+	}
+
+	return s.NVMeTCPPort
+}
+
+// SubsystemNQN implements config.ExternalVolumeNVMeTCPConfig interface.
+func (s NVMeTCPMountSpec) SubsystemNQN() string {
+	return s.NVMeTCPSubsystemNQN
+}
+
+// NamespaceID implements config.ExternalVolumeNVMeTCPConfig interface.
+func (s NVMeTCPMountSpec) NamespaceID() uint32 {
+	if s.NVMeTCPNamespaceID == 0 {
+		return defaultNVMeNamespaceID
+	}
+
+	return s.NVMeTCPNamespaceID
+}
+
+// Validate implements config.Validator interface.
+func (s *NVMeTCPMountSpec) Validate() ([]string, error) {
+	var validationErrors error
+
+	if s == nil {
+		return nil, errors.New("nvmeTCP mount spec is required")
+	}
+
+	if _, err := netip.ParseAddr(s.NVMeTCPAddress); err != nil {
+		validationErrors = errors.Join(validationErrors, errors.New("nvmeTCP address must be a valid IP address"))
+	}
+
+	if !strings.HasPrefix(s.NVMeTCPSubsystemNQN, "nqn.") {
+		validationErrors = errors.Join(validationErrors, errors.New("nvmeTCP subsystemNQN must be a valid NVMe qualified name"))
+	}
+
+	return nil, validationErrors
+}
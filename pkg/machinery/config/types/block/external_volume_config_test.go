@@ -39,6 +39,56 @@ func TestExternalVolumeConfigMarshalUnmarshal(t *testing.T) {
 				c.MountSpec.MountVirtiofs = new(block.VirtiofsMountSpec)
 				c.MountSpec.MountVirtiofs.VirtiofsTag = "Data"
 
+				return c
+			},
+		},
+		{
+			name:     "nfs",
+			filename: "externalvolumeconfig_nfs.yaml",
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "shared"
+				c.FilesystemType = blockres.FilesystemTypeNFS
+				c.MountSpec.MountNFS = &block.NFSMountSpec{
+					NFSServer:  "nfs.example.com",
+					NFSPath:    "/exports/shared",
+					NFSVersion: "4.1",
+				}
+
+				return c
+			},
+		},
+		{
+			name:     "iscsi",
+			filename: "externalvolumeconfig_iscsi.yaml",
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "iscsi-data"
+				c.FilesystemType = blockres.FilesystemTypeXFS
+				c.MountSpec.MountISCSI = &block.ISCSIMountSpec{
+					ISCSIPortal: "192.168.1.30",
+					ISCSIPort:   3261,
+					ISCSITarget: "iqn.2003-01.org.linux-iscsi.storage:data",
+					ISCSILUN:    2,
+				}
+
+				return c
+			},
+		},
+		{
+			name:     "nvme-tcp",
+			filename: "externalvolumeconfig_nvmetcp.yaml",
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "nvme-data"
+				c.FilesystemType = blockres.FilesystemTypeEXT4
+				c.MountSpec.MountReadOnly = new(true)
+				c.MountSpec.MountNVMeTCP = &block.NVMeTCPMountSpec{
+					NVMeTCPAddress:      "fd00::40",
+					NVMeTCPSubsystemNQN: "nqn.2014-08.org.nvmexpress:storage:data",
+					NVMeTCPNamespaceID:  3,
+				}
+
 				return c
 			},
 		},
@@ -154,6 +204,101 @@ func TestExternalVolumeConfigValidate(t *testing.T) {
 
 			expectedErrors: "invalid filesystem type: none",
 		},
+		{
+			name: "multiple mount specs",
+
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "data"
+				c.FilesystemType = blockres.FilesystemTypeVirtiofs
+				c.MountSpec.MountVirtiofs = new(block.VirtiofsMountSpec)
+				c.MountSpec.MountVirtiofs.VirtiofsTag = "Data"
+				c.MountSpec.MountNFS = &block.NFSMountSpec{
+					NFSServer: "192.168.1.20",
+					NFSPath:   "/exports/data",
+				}
+
+				return c
+			},
+
+			expectedErrors: "only one of virtiofs, nfs, iscsi or nvmeTCP mount specs can be set",
+		},
+		{
+			name: "invalid nfs",
+
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "data"
+				c.FilesystemType = blockres.FilesystemTypeNFS
+				c.MountSpec.MountNFS = &block.NFSMountSpec{
+					NFSPath:    "exports/data",
+					NFSVersion: "2",
+				}
+
+				return c
+			},
+
+			expectedErrors: "nfs server is required\nnfs path must be an absolute path\nnfs version must be one of 3, 4, 4.1, 4.2",
+		},
+		{
+			name: "no nfs mount spec",
+
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "data"
+				c.FilesystemType = blockres.FilesystemTypeNFS
+
+				return c
+			},
+
+			expectedErrors: "nfs mount spec is required",
+		},
+		{
+			name: "invalid iscsi",
+
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "data"
+				c.FilesystemType = blockres.FilesystemTypeXFS
+				c.MountSpec.MountISCSI = &block.ISCSIMountSpec{
+					ISCSIPortal: "192.168.1.30:3260",
+					ISCSITarget: "storage:data",
+				}
+
+				return c
+			},
+
+			expectedErrors: "iscsi portal should not include the port, use the port field instead\niscsi target \"storage:data\" is not a valid iSCSI name",
+		},
+		{
+			name: "invalid nvme-tcp",
+
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "data"
+				c.FilesystemType = blockres.FilesystemTypeBtrfs
+				c.MountSpec.MountNVMeTCP = &block.NVMeTCPMountSpec{
+					NVMeTCPAddress: "storage.example.com",
+				}
+
+				return c
+			},
+
+			expectedErrors: "nvmeTCP address must be a valid IP address\nnvmeTCP subsystemNQN must be a valid NVMe qualified name",
+		},
+		{
+			name: "block filesystem without target",
+
+			cfg: func(t *testing.T) *block.ExternalVolumeConfigV1Alpha1 {
+				c := block.NewExternalVolumeConfigV1Alpha1()
+				c.MetaName = "data"
+				c.FilesystemType = blockres.FilesystemTypeXFS
+
+				return c
+			},
+
+			expectedErrors: "invalid filesystem type: xfs",
+		},
 		{
 			name: "valid virtiofs",
 
@@ -5,6 +5,6 @@
 // Package container provides container configuration documents.
 package container
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output container_doc.go container.go container_config.go mounts.go runas.go runtime.go security.go
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output container_doc.go container.go container_config.go health.go mounts.go restart.go runas.go runtime.go security.go
 
 //go:generate go tool github.com/siderolabs/deep-copy -type ContainerConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/siderolabs/gen/optional"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
@@ -55,10 +56,11 @@ var (
 //
 //	  The container is started as soon as the configuration is applied, with no image rebuild
 //	  and no reboot. It runs against the CRI containerd instance in the dedicated
-//	  `taloscontainers` namespace, and is restarted automatically 5 seconds after it stops.
+//	  `taloscontainers` namespace, and is restarted after it stops as its restart policy says.
 //
 //	  Containers are not Talos services: they do not appear in `talosctl services`, and
-//	  `talosctl service` does not apply to them. Status is reported via `ContainerStatus`.
+//	  `talosctl service` does not apply to them. Status is reported via `ContainerStatus`, and
+//	  the container output via `talosctl logs container-<name>`.
 //	examples:
 //	  - value: exampleContainerConfigV1Alpha1()
 //	alias: ContainerConfig
@@ -71,8 +73,8 @@ type ContainerConfigV1Alpha1 struct {
 	//     Name of the container.
 	//
 	//     Must be between 1 and 63 characters long, and can only contain lowercase ASCII
-	//     letters, digits and hyphens. It is used as the containerd container ID and as the
-	//     container's log identifier, so it may not collide with a Talos service name.
+	//     letters, digits and hyphens. It is used as the containerd container ID, and the
+	//     container's log identifier is the name prefixed with `container-`.
 	MetaName string `yaml:"name"`
 	//   description: |
 	//     OCI image reference supplying the container's root filesystem.
@@ -120,6 +122,20 @@ type ContainerConfigV1Alpha1 struct {
 	//   description: |
 	//     Conditions which must be satisfied before the container is started.
 	DependsOnConfig *ContainerDependsOn `yaml:"dependsOn,omitempty"`
+	//   description: |
+	//     When and how fast the container is restarted after it terminates.
+	//
+	//     By default the container is always restarted, after a delay starting at 5s and
+	//     doubling up to 5m.
+	RestartPolicyConfig *ContainerRestartPolicy `yaml:"restartPolicy,omitempty"`
+	//   description: |
+	//     Health check probing the container once it is running.
+	//
+	//     The result is reported in `ContainerStatus`, and containers depending on this one
+	//     via `dependsOn.containers` only start once it passes. A container without a health
+	//     check counts as healthy as soon as it is running. A failing health check does not
+	//     restart the container.
+	HealthCheckConfig *ContainerHealthCheck `yaml:"healthCheck,omitempty"`
 }
 
 // NewContainerConfigV1Alpha1 creates a new container config document.
@@ -161,6 +177,15 @@ func exampleContainerConfigV1Alpha1() *ContainerConfigV1Alpha1 {
 	cfg.DependsOnConfig = &ContainerDependsOn{
 		NetworksConfig: []string{"addresses"},
 	}
+	cfg.RestartPolicyConfig = &ContainerRestartPolicy{
+		RestartMode:       config.ContainerRestartModeOnFailure,
+		RestartMaxRetries: 10,
+	}
+	cfg.HealthCheckConfig = &ContainerHealthCheck{
+		ExecCheck: &ContainerExecHealthCheck{
+			ExecCommand: []string{"curl", "-f", "http://localhost:8080/"},
+		},
+	}
 
 	return cfg
 }
@@ -280,6 +305,14 @@ func (c *ContainerConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation
 		validationErrors = errors.Join(validationErrors, c.RunAsConfig.Validate())
 	}
 
+	if c.RestartPolicyConfig != nil {
+		validationErrors = errors.Join(validationErrors, c.RestartPolicyConfig.Validate())
+	}
+
+	if c.HealthCheckConfig != nil {
+		validationErrors = errors.Join(validationErrors, c.HealthCheckConfig.Validate(c.Network().Mode()))
+	}
+
 	return warnings, validationErrors
 }
 
@@ -354,6 +387,24 @@ func (c *ContainerConfigV1Alpha1) DependsOn() config.ContainerDependsOnConfig {
 	return c.DependsOnConfig
 }
 
+// RestartPolicy implements config.ContainerConfig interface.
+func (c *ContainerConfigV1Alpha1) RestartPolicy() config.ContainerRestartPolicyConfig {
+	if c.RestartPolicyConfig == nil {
+		return &ContainerRestartPolicy{}
+	}
+
+	return c.RestartPolicyConfig
+}
+
+// HealthCheck implements config.ContainerConfig interface.
+func (c *ContainerConfigV1Alpha1) HealthCheck() optional.Optional[config.ContainerHealthCheckConfig] {
+	if c.HealthCheckConfig == nil {
+		return optional.None[config.ContainerHealthCheckConfig]()
+	}
+
+	return optional.Some[config.ContainerHealthCheckConfig](c.HealthCheckConfig)
+}
+
 // mustParse re-parses an already-normalized reference. Normalization has succeeded by the time
 // this is called, so a failure here is impossible.
 func mustParse(ref string) name.Reference {
@@ -5,14 +5,18 @@
 package container_test
 
 import (
+	"net/netip"
 	"strings"
 	"testing"
 
+	"github.com/siderolabs/gen/optional"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/container"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
 // load parses a single-document machine configuration and returns the container document.
@@ -53,6 +57,8 @@ image: nginx
 	assert.Equal(t, "none", string(cfg.Network().Mode()))
 	assert.False(t, cfg.Resources().MemoryLimit().IsPresent())
 	assert.Empty(t, cfg.DependsOn().Paths())
+	assert.Equal(t, "always", string(cfg.RestartPolicy().Mode()))
+	assert.False(t, cfg.HealthCheck().IsPresent())
 }
 
 func TestContainerConfigDigestPinnedDoesNotWarn(t *testing.T) {
@@ -161,6 +167,45 @@ dependsOn:
 	assert.Equal(t, []string{"ro"}, hp.MountOptions())
 }
 
+func TestContainerConfigBridgeNetwork(t *testing.T) {
+	t.Parallel()
+
+	cfg := load(t, `apiVersion: v1alpha1
+kind: ContainerConfig
+name: web
+image: nginx
+network:
+  mode: bridge
+  link: br-containers
+  subnet: 10.88.0.0/24
+  gateway: 10.88.0.1
+  ports:
+    - containerPort: 80
+      hostPort: 8080
+    - containerPort: 53
+      hostPort: 5353
+      protocol: udp
+`)
+
+	_, err := cfg.Validate(validationMode{})
+	require.NoError(t, err)
+
+	network := cfg.Network()
+	assert.Equal(t, config.ContainerNetworkModeBridge, network.Mode())
+	assert.Equal(t, "br-containers", network.Link())
+	assert.Equal(t, optional.Some(netip.MustParsePrefix("10.88.0.0/24")), network.Subnet())
+	assert.Equal(t, optional.Some(netip.MustParseAddr("10.88.0.1")), network.Gateway())
+
+	ports := network.Ports()
+	require.Len(t, ports, 2)
+
+	// The protocol defaults to tcp.
+	assert.Equal(t, nethelpers.ProtocolTCP, ports[0].Protocol())
+	assert.EqualValues(t, 8080, ports[0].HostPort())
+	assert.EqualValues(t, 80, ports[0].ContainerPort())
+	assert.Equal(t, nethelpers.ProtocolUDP, ports[1].Protocol())
+}
+
 func TestContainerConfigValidationErrors(t *testing.T) {
 	t.Parallel()
 
@@ -283,9 +328,59 @@ mounts:
 		},
 		{
 			name:        "unknown network mode",
-			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: overlay",
 			expectedErr: "unsupported network mode",
 		},
+		{
+			name:        "bridge without link and subnet",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge",
+			expectedErr: "network.link is required in the bridge mode",
+		},
+		{
+			name:        "macvlan without subnet",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: macvlan\n  link: eth0",
+			expectedErr: "network.subnet is required in the macvlan mode",
+		},
+		{
+			name:        "subnet with host bits",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge\n  link: br0\n  subnet: 10.88.0.1/24",
+			expectedErr: "network.subnet 10.88.0.1/24 has host bits set",
+		},
+		{
+			name:        "gateway outside the subnet",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge\n  link: br0\n  subnet: 10.88.0.0/24\n  gateway: 10.89.0.1",
+			expectedErr: "network.gateway 10.89.0.1 is not within the subnet 10.88.0.0/24",
+		},
+		{
+			name:        "subnet in the host mode",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: host\n  subnet: 10.88.0.0/24",
+			expectedErr: "link, subnet and gateway are only supported in the bridge and macvlan modes",
+		},
+		{
+			name:        "ports in the macvlan mode",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: macvlan\n  link: eth0\n  subnet: 192.168.1.0/24\n  ports:\n    - containerPort: 80\n      hostPort: 8080",
+			expectedErr: "network.ports are only supported in the bridge mode",
+		},
+		{
+			name:        "port published twice",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge\n  link: br0\n  subnet: 10.88.0.0/24\n  ports:\n    - containerPort: 80\n      hostPort: 8080\n    - containerPort: 81\n      hostPort: 8080\n      protocol: tcp",
+			expectedErr: "network.ports[1]: host port tcp/8080 is published twice",
+		},
+		{
+			name:        "reserved host port",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge\n  link: br0\n  subnet: 10.88.0.0/24\n  ports:\n    - containerPort: 80\n      hostPort: 50000",
+			expectedErr: "network.ports[0]: host port tcp/50000 is reserved by Talos",
+		},
+		{
+			name:        "reserved udp host port",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge\n  link: br0\n  subnet: 10.88.0.0/24\n  ports:\n    - containerPort: 80\n      hostPort: 51820\n      protocol: udp",
+			expectedErr: "network.ports[0]: host port udp/51820 is reserved by Talos",
+		},
+		{
+			name:        "port without host port",
+			doc:         "name: nginx\nimage: nginx\nnetwork:\n  mode: bridge\n  link: br0\n  subnet: 10.88.0.0/24\n  ports:\n    - containerPort: 80",
+			expectedErr: "network.ports[0]: hostPort is required",
+		},
 		{
 			name:        "cpu without millicores",
 			doc:         "name: nginx\nimage: nginx\nresources:\n  limits:\n    cpu: \"2\"",
@@ -316,6 +411,26 @@ mounts:
 			doc:         "name: nginx\nimage: nginx\ndependsOn:\n  containers: [nginx]",
 			expectedErr: "cannot depend on itself",
 		},
+		{
+			name:        "unknown restart mode",
+			doc:         "name: nginx\nimage: nginx\nrestartPolicy:\n  mode: sometimes",
+			expectedErr: `unsupported restartPolicy.mode "sometimes"`,
+		},
+		{
+			name:        "max delay below initial delay",
+			doc:         "name: nginx\nimage: nginx\nrestartPolicy:\n  initialDelay: 1m\n  maxDelay: 10s",
+			expectedErr: "restartPolicy.maxDelay 10s must not be less than initialDelay 1m0s",
+		},
+		{
+			name:        "health check without a check",
+			doc:         "name: nginx\nimage: nginx\nhealthCheck:\n  period: 10s",
+			expectedErr: "exactly one of exec, http or tcp must be set",
+		},
+		{
+			name:        "http health check without host network",
+			doc:         "name: nginx\nimage: nginx\nhealthCheck:\n  http:\n    port: 8080",
+			expectedErr: "http and tcp checks require the host or bridge network mode",
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
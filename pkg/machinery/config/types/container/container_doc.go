@@ -7,16 +7,19 @@
 package container
 
 import (
+	"net/netip"
+
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 )
 
 func (ContainerConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "ContainerConfig",
 		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerConfig is a container configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "ContainerConfig is a container configuration document.\nContainerConfig declares a container to be run by Talos directly, without Kubernetes.\n\nThe container is started as soon as the configuration is applied, with no image rebuild\nand no reboot. It runs against the CRI containerd instance in the dedicated\n`taloscontainers` namespace, and is restarted automatically 5 seconds after it stops.\n\nContainers are not Talos services: they do not appear in `talosctl services`, and\n`talosctl service` does not apply to them. Status is reported via `ContainerStatus`.\n",
+		Description: "ContainerConfig is a container configuration document.\nContainerConfig declares a container to be run by Talos directly, without Kubernetes.\n\nThe container is started as soon as the configuration is applied, with no image rebuild\nand no reboot. It runs against the CRI containerd instance in the dedicated\n`taloscontainers` namespace, and is restarted after it stops as its restart policy says.\n\nContainers are not Talos services: they do not appear in `talosctl services`, and\n`talosctl service` does not apply to them. Status is reported via `ContainerStatus`, and\nthe container output via `talosctl logs container-<name>`.\n",
 		Fields: []encoder.Doc{
 			{
 				Type:   "Meta",
@@ -26,7 +29,7 @@ func (ContainerConfigV1Alpha1) Doc() *encoder.Doc {
 				Name:        "name",
 				Type:        "string",
 				Note:        "",
-				Description: "Name of the container.\n\nMust be between 1 and 63 characters long, and can only contain lowercase ASCII\nletters, digits and hyphens. It is used as the containerd container ID and as the\ncontainer's log identifier, so it may not collide with a Talos service name.",
+				Description: "Name of the container.\n\nMust be between 1 and 63 characters long, and can only contain lowercase ASCII\nletters, digits and hyphens. It is used as the containerd container ID, and the\ncontainer's log identifier is the name prefixed with `container-`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the container." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -106,6 +109,20 @@ func (ContainerConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Conditions which must be satisfied before the container is started.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Conditions which must be satisfied before the container is started." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "restartPolicy",
+				Type:        "ContainerRestartPolicy",
+				Note:        "",
+				Description: "When and how fast the container is restarted after it terminates.\n\nBy default the container is always restarted, after a delay starting at 5s and\ndoubling up to 5m.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "When and how fast the container is restarted after it terminates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "healthCheck",
+				Type:        "ContainerHealthCheck",
+				Note:        "",
+				Description: "Health check probing the container once it is running.\n\nThe result is reported in `ContainerStatus`, and containers depending on this one\nvia `dependsOn.containers` only start once it passes. A container without a health\ncheck counts as healthy as soon as it is running. A failing health check does not\nrestart the container.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Health check probing the container once it is running." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -116,6 +133,155 @@ func (ContainerConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (ContainerHealthCheck) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerHealthCheck",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerHealthCheck probes the container once it is running." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerHealthCheck probes the container once it is running.\n\nExactly one check must be set.\n",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ContainerConfigV1Alpha1",
+				FieldName: "healthCheck",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "exec",
+				Type:        "ContainerExecHealthCheck",
+				Note:        "",
+				Description: "Run a command inside the container, healthy if it exits with zero.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Run a command inside the container, healthy if it exits with zero." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "http",
+				Type:        "ContainerHTTPHealthCheck",
+				Note:        "",
+				Description: "Send an HTTP GET request, healthy on a 2xx or 3xx response.\n\nThe request goes from the host to the container, so this check requires the `host`\nor `bridge` network mode. Redirects are not followed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Send an HTTP GET request, healthy on a 2xx or 3xx response." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tcp",
+				Type:        "ContainerTCPHealthCheck",
+				Note:        "",
+				Description: "Open a TCP connection, healthy if it is accepted.\n\nThe connection goes from the host to the container, so this check requires the `host`\nor `bridge` network mode.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Open a TCP connection, healthy if it is accepted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "initialDelay",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Delay after the container starts before the first check.\n\nDefaults to 1s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Delay after the container starts before the first check." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "period",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between the checks.\n\nDefaults to 5s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between the checks." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout of a single check.\n\nDefaults to 1s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout of a single check." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (ContainerExecHealthCheck) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerExecHealthCheck",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerExecHealthCheck runs a command inside the container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerExecHealthCheck runs a command inside the container.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ContainerHealthCheck",
+				FieldName: "exec",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "command",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Command to run, with its arguments. It is not run through a shell.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Command to run, with its arguments. It is not run through a shell." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", []string{"pg_isready", "-U", "postgres"})
+
+	return doc
+}
+
+func (ContainerHTTPHealthCheck) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerHTTPHealthCheck",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerHTTPHealthCheck sends an HTTP GET request to the container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerHTTPHealthCheck sends an HTTP GET request to the container.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ContainerHealthCheck",
+				FieldName: "http",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Port to connect to: on the host loopback address in the `host` network mode, on the\ncontainer address in the `bridge` mode.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port to connect to: on the host loopback address in the `host` network mode, on the" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "path",
+				Type:        "string",
+				Note:        "",
+				Description: "Request path. Defaults to `/`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Request path. Defaults to `/`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 8080)
+	doc.Fields[1].AddExample("", "/healthz")
+
+	return doc
+}
+
+func (ContainerTCPHealthCheck) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerTCPHealthCheck",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerTCPHealthCheck opens a TCP connection to the container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerTCPHealthCheck opens a TCP connection to the container.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ContainerHealthCheck",
+				FieldName: "tcp",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Port to connect to: on the host loopback address in the `host` network mode, on the\ncontainer address in the `bridge` mode.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port to connect to: on the host loopback address in the `host` network mode, on the" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", 5000)
+
+	return doc
+}
+
 func (ContainerMount) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "ContainerMount",
@@ -284,6 +450,59 @@ func (HostPathMount) Doc() *encoder.Doc {
 	return doc
 }
 
+func (ContainerRestartPolicy) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerRestartPolicy",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerRestartPolicy configures when and how fast a terminated container is restarted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerRestartPolicy configures when and how fast a terminated container is restarted.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ContainerConfigV1Alpha1",
+				FieldName: "restartPolicy",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "mode",
+				Type:        "ContainerRestartMode",
+				Note:        "",
+				Description: "Which terminations lead to a restart.\n\n`always` restarts the container whenever it terminates. `on-failure` restarts it only\nif it could not be started or exited with a non-zero code. `never` leaves it stopped.\nChanging the container configuration starts it again regardless of the mode.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Which terminations lead to a restart." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"always",
+					"on-failure",
+					"never",
+				},
+			},
+			{
+				Name:        "maxRetries",
+				Type:        "uint64",
+				Note:        "",
+				Description: "Maximum number of restarts, after which the container is left stopped.\n\nZero or unset means unlimited. The count starts over when the container is removed\nfrom the machine configuration or the machine reboots.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of restarts, after which the container is left stopped." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "initialDelay",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Delay before the first restart, doubled on each further restart.\n\nDefaults to 5s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Delay before the first restart, doubled on each further restart." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxDelay",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Upper bound of the restart delay.\n\nDefaults to 5m.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Upper bound of the restart delay." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", uint64(10))
+
+	return doc
+}
+
 func (ContainerRunAs) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "ContainerRunAs",
@@ -335,16 +554,96 @@ func (ContainerNetwork) Doc() *encoder.Doc {
 				Name:        "mode",
 				Type:        "ContainerNetworkMode",
 				Note:        "",
-				Description: "Network mode.\n\n`none` gives the container its own empty network namespace with no host access.\n`host` shares the host network namespace, so the container sees every interface and\ncan bind any port.",
+				Description: "Network mode.\n\n`none` gives the container its own empty network namespace with no host access.\n`host` shares the host network namespace, so the container sees every interface and\ncan bind any port.\n`bridge` gives the container its own network namespace, attached to a bridge link on\nthe host through a veth pair.\n`macvlan` gives the container its own network namespace, with a macvlan link on top of\na host link, so that it appears on that link's network as a machine of its own.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Network mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"none",
 					"host",
+					"bridge",
+					"macvlan",
 				},
 			},
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Host link the container is attached to.\n\nFor the `bridge` mode, the name of a bridge link, e.g. one declared with a\n`BridgeConfig` document. For the `macvlan` mode, the name of the parent link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Host link the container is attached to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "subnet",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Subnet the container address is allocated from, required for the `bridge` and\n`macvlan` modes.\n\nThe address is allocated by Talos and stays the same while the container exists. The\nnetwork and broadcast addresses, the gateway and the addresses of the node itself are\nnever allocated, so the subnet may be the one the bridge address is in.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Subnet the container address is allocated from, required for the `bridge` and" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "gateway",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Gateway of the container's default route, within the subnet.\n\nFor the `bridge` mode this is usually the address of the bridge itself. If not set,\nthe container only reaches its subnet.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Gateway of the container's default route, within the subnet." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ports",
+				Type:        "[]ContainerPort",
+				Note:        "",
+				Description: "Container ports published on the host, only supported in the `bridge` mode.\n\nConnections to the host port on any of the node addresses are forwarded to the\ncontainer.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Container ports published on the host, only supported in the `bridge` mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
+	doc.Fields[1].AddExample("", "br-containers")
+	doc.Fields[2].AddExample("", meta.Prefix{Prefix: netip.MustParsePrefix("10.88.0.0/24")})
+	doc.Fields[3].AddExample("", meta.Addr{Addr: netip.MustParseAddr("10.88.0.1")})
+
+	return doc
+}
+
+func (ContainerPort) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ContainerPort",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ContainerPort publishes a container port on the host." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ContainerPort publishes a container port on the host.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ContainerNetwork",
+				FieldName: "ports",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "containerPort",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Port inside the container.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port inside the container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "hostPort",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Port on the host.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port on the host." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "protocol",
+				Type:        "Protocol",
+				Note:        "",
+				Description: "Protocol of the port, defaults to `tcp`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Protocol of the port, defaults to `tcp`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"tcp",
+					"udp",
+				},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", uint16(80))
+	doc.Fields[1].AddExample("", uint16(8080))
+
 	return doc
 }
 
@@ -451,8 +750,8 @@ func (ContainerDependsOn) Doc() *encoder.Doc {
 				Name:        "containers",
 				Type:        "[]string",
 				Note:        "",
-				Description: "Other containers, by document name, which must be running first.\n\nCycles are rejected when the machine configuration is applied.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Other containers, by document name, which must be running first." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Other containers, by document name, which must be running and healthy first.\n\nA container without a health check counts as healthy as soon as it is running.\nCycles are rejected when the machine configuration is applied.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Other containers, by document name, which must be running and healthy first." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
@@ -540,12 +839,18 @@ func GetFileDoc() *encoder.FileDoc {
 		Description: "Package container provides container configuration documents.\n",
 		Structs: []*encoder.Doc{
 			ContainerConfigV1Alpha1{}.Doc(),
+			ContainerHealthCheck{}.Doc(),
+			ContainerExecHealthCheck{}.Doc(),
+			ContainerHTTPHealthCheck{}.Doc(),
+			ContainerTCPHealthCheck{}.Doc(),
 			ContainerMount{}.Doc(),
 			UserVolumeMount{}.Doc(),
 			TmpfsMount{}.Doc(),
 			HostPathMount{}.Doc(),
+			ContainerRestartPolicy{}.Doc(),
 			ContainerRunAs{}.Doc(),
 			ContainerNetwork{}.Doc(),
+			ContainerPort{}.Doc(),
 			ContainerResources{}.Doc(),
 			ContainerResourceLimits{}.Doc(),
 			ContainerDependsOn{}.Doc(),
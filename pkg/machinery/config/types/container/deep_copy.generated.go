@@ -82,6 +82,10 @@ func (o *ContainerConfigV1Alpha1) DeepCopy() *ContainerConfigV1Alpha1 {
 	if o.NetworkConfig != nil {
 		cp.NetworkConfig = new(ContainerNetwork)
 		*cp.NetworkConfig = *o.NetworkConfig
+		if o.NetworkConfig.NetworkPorts != nil {
+			cp.NetworkConfig.NetworkPorts = make([]ContainerPort, len(o.NetworkConfig.NetworkPorts))
+			copy(cp.NetworkConfig.NetworkPorts, o.NetworkConfig.NetworkPorts)
+		}
 	}
 	if o.ResourcesConfig != nil {
 		cp.ResourcesConfig = new(ContainerResources)
@@ -111,5 +115,29 @@ func (o *ContainerConfigV1Alpha1) DeepCopy() *ContainerConfigV1Alpha1 {
 			copy(cp.DependsOnConfig.ContainersConfig, o.DependsOnConfig.ContainersConfig)
 		}
 	}
+	if o.RestartPolicyConfig != nil {
+		cp.RestartPolicyConfig = new(ContainerRestartPolicy)
+		*cp.RestartPolicyConfig = *o.RestartPolicyConfig
+	}
+	if o.HealthCheckConfig != nil {
+		cp.HealthCheckConfig = new(ContainerHealthCheck)
+		*cp.HealthCheckConfig = *o.HealthCheckConfig
+		if o.HealthCheckConfig.ExecCheck != nil {
+			cp.HealthCheckConfig.ExecCheck = new(ContainerExecHealthCheck)
+			*cp.HealthCheckConfig.ExecCheck = *o.HealthCheckConfig.ExecCheck
+			if o.HealthCheckConfig.ExecCheck.ExecCommand != nil {
+				cp.HealthCheckConfig.ExecCheck.ExecCommand = make([]string, len(o.HealthCheckConfig.ExecCheck.ExecCommand))
+				copy(cp.HealthCheckConfig.ExecCheck.ExecCommand, o.HealthCheckConfig.ExecCheck.ExecCommand)
+			}
+		}
+		if o.HealthCheckConfig.HTTPCheck != nil {
+			cp.HealthCheckConfig.HTTPCheck = new(ContainerHTTPHealthCheck)
+			*cp.HealthCheckConfig.HTTPCheck = *o.HealthCheckConfig.HTTPCheck
+		}
+		if o.HealthCheckConfig.TCPCheck != nil {
+			cp.HealthCheckConfig.TCPCheck = new(ContainerTCPHealthCheck)
+			*cp.HealthCheckConfig.TCPCheck = *o.HealthCheckConfig.TCPCheck
+		}
+	}
 	return &cp
 }
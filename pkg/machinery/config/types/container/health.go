@@ -0,0 +1,249 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// Health check defaults.
+const (
+	defaultHealthInitialDelay = time.Second
+	defaultHealthPeriod       = 5 * time.Second
+	defaultHealthTimeout      = time.Second
+)
+
+// ContainerHealthCheck probes the container once it is running.
+//
+// Exactly one check must be set.
+type ContainerHealthCheck struct {
+	//   description: |
+	//     Run a command inside the container, healthy if it exits with zero.
+	ExecCheck *ContainerExecHealthCheck `yaml:"exec,omitempty"`
+	//   description: |
+	//     Send an HTTP GET request, healthy on a 2xx or 3xx response.
+	//
+	//     The request goes from the host to the container, so this check requires the `host`
+	//     or `bridge` network mode. Redirects are not followed.
+	HTTPCheck *ContainerHTTPHealthCheck `yaml:"http,omitempty"`
+	//   description: |
+	//     Open a TCP connection, healthy if it is accepted.
+	//
+	//     The connection goes from the host to the container, so this check requires the `host`
+	//     or `bridge` network mode.
+	TCPCheck *ContainerTCPHealthCheck `yaml:"tcp,omitempty"`
+	//   description: |
+	//     Delay after the container starts before the first check.
+	//
+	//     Defaults to 1s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthInitialDelay time.Duration `yaml:"initialDelay,omitempty"`
+	//   description: |
+	//     Interval between the checks.
+	//
+	//     Defaults to 5s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthPeriod time.Duration `yaml:"period,omitempty"`
+	//   description: |
+	//     Timeout of a single check.
+	//
+	//     Defaults to 1s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// ContainerExecHealthCheck runs a command inside the container.
+type ContainerExecHealthCheck struct {
+	//   description: |
+	//     Command to run, with its arguments. It is not run through a shell.
+	//   examples:
+	//     - value: '[]string{"pg_isready", "-U", "postgres"}'
+	ExecCommand []string `yaml:"command"`
+}
+
+// ContainerHTTPHealthCheck sends an HTTP GET request to the container.
+type ContainerHTTPHealthCheck struct {
+	//   description: |
+	//     Port to connect to: on the host loopback address in the `host` network mode, on the
+	//     container address in the `bridge` mode.
+	//   examples:
+	//     - value: 8080
+	HTTPPort uint16 `yaml:"port"`
+	//   description: |
+	//     Request path. Defaults to `/`.
+	//   examples:
+	//     - value: '"/healthz"'
+	HTTPPath string `yaml:"path,omitempty"`
+}
+
+// ContainerTCPHealthCheck opens a TCP connection to the container.
+type ContainerTCPHealthCheck struct {
+	//   description: |
+	//     Port to connect to: on the host loopback address in the `host` network mode, on the
+	//     container address in the `bridge` mode.
+	//   examples:
+	//     - value: 5000
+	TCPPort uint16 `yaml:"port"`
+}
+
+// Check interfaces.
+var (
+	_ config.ContainerHealthCheckConfig     = &ContainerHealthCheck{}
+	_ config.ContainerExecHealthCheckConfig = &ContainerExecHealthCheck{}
+	_ config.ContainerHTTPHealthCheckConfig = &ContainerHTTPHealthCheck{}
+	_ config.ContainerTCPHealthCheckConfig  = &ContainerTCPHealthCheck{}
+)
+
+// Exec implements config.ContainerHealthCheckConfig interface.
+func (h *ContainerHealthCheck) Exec() optional.Optional[config.ContainerExecHealthCheckConfig] {
+	if h.ExecCheck == nil {
+		return optional.None[config.ContainerExecHealthCheckConfig]()
+	}
+
+	return optional.Some[config.ContainerExecHealthCheckConfig](h.ExecCheck)
+}
+
+// HTTP implements config.ContainerHealthCheckConfig interface.
+func (h *ContainerHealthCheck) HTTP() optional.Optional[config.ContainerHTTPHealthCheckConfig] {
+	if h.HTTPCheck == nil {
+		return optional.None[config.ContainerHTTPHealthCheckConfig]()
+	}
+
+	return optional.Some[config.ContainerHTTPHealthCheckConfig](h.HTTPCheck)
+}
+
+// TCP implements config.ContainerHealthCheckConfig interface.
+func (h *ContainerHealthCheck) TCP() optional.Optional[config.ContainerTCPHealthCheckConfig] {
+	if h.TCPCheck == nil {
+		return optional.None[config.ContainerTCPHealthCheckConfig]()
+	}
+
+	return optional.Some[config.ContainerTCPHealthCheckConfig](h.TCPCheck)
+}
+
+// InitialDelay implements config.ContainerHealthCheckConfig interface.
+func (h *ContainerHealthCheck) InitialDelay() time.Duration {
+	if h.HealthInitialDelay == 0 {
+		return defaultHealthInitialDelay
+	}
+
+	return h.HealthInitialDelay
+}
+
+// Period implements config.ContainerHealthCheckConfig interface.
+func (h *ContainerHealthCheck) Period() time.Duration {
+	if h.HealthPeriod == 0 {
+		return defaultHealthPeriod
+	}
+
+	return h.HealthPeriod
+}
+
+// Timeout implements config.ContainerHealthCheckConfig interface.
+func (h *ContainerHealthCheck) Timeout() time.Duration {
+	if h.HealthTimeout == 0 {
+		return defaultHealthTimeout
+	}
+
+	return h.HealthTimeout
+}
+
+// Command implements config.ContainerExecHealthCheckConfig interface.
+func (c *ContainerExecHealthCheck) Command() []string { return c.ExecCommand }
+
+// Port implements config.ContainerHTTPHealthCheckConfig interface.
+func (c *ContainerHTTPHealthCheck) Port() uint16 { return c.HTTPPort }
+
+// Path implements config.ContainerHTTPHealthCheckConfig interface.
+func (c *ContainerHTTPHealthCheck) Path() string {
+	if c.HTTPPath == "" {
+		return "/"
+	}
+
+	return c.HTTPPath
+}
+
+// Port implements config.ContainerTCPHealthCheckConfig interface.
+func (c *ContainerTCPHealthCheck) Port() uint16 { return c.TCPPort }
+
+// Validate checks the health check.
+//
+// networkMode is the owning container's network mode: the HTTP and TCP checks connect from the
+// host, so they can only reach a container sharing the host network namespace, or one attached
+// to a bridge on the host.
+func (h *ContainerHealthCheck) Validate(networkMode config.ContainerNetworkMode) error {
+	matchCount := 0
+
+	if h.ExecCheck != nil {
+		matchCount++
+	}
+
+	if h.HTTPCheck != nil {
+		matchCount++
+	}
+
+	if h.TCPCheck != nil {
+		matchCount++
+	}
+
+	if matchCount != 1 {
+		return errors.New("healthCheck: exactly one of exec, http or tcp must be set")
+	}
+
+	var validationErrors error
+
+	switch {
+	case h.ExecCheck != nil:
+		if len(h.ExecCheck.ExecCommand) == 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("healthCheck.exec.command is required"))
+		}
+	case h.HTTPCheck != nil:
+		if h.HTTPCheck.HTTPPort == 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("healthCheck.http.port is required"))
+		}
+
+		if h.HTTPCheck.HTTPPath != "" && !strings.HasPrefix(h.HTTPCheck.HTTPPath, "/") {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("healthCheck.http.path %q must start with /", h.HTTPCheck.HTTPPath))
+		}
+	case h.TCPCheck != nil:
+		if h.TCPCheck.TCPPort == 0 {
+			validationErrors = errors.Join(validationErrors, errors.New("healthCheck.tcp.port is required"))
+		}
+	}
+
+	if (h.HTTPCheck != nil || h.TCPCheck != nil) && networkMode != config.ContainerNetworkModeHost && networkMode != config.ContainerNetworkModeBridge {
+		validationErrors = errors.Join(validationErrors, errors.New("healthCheck: http and tcp checks require the host or bridge network mode"))
+	}
+
+	for _, field := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"initialDelay", h.HealthInitialDelay},
+		{"period", h.HealthPeriod},
+		{"timeout", h.HealthTimeout},
+	} {
+		if field.value < 0 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("healthCheck.%s must be non-negative, got %s", field.name, field.value))
+		}
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/container"
+)
+
+func TestContainerHealthCheckDefaults(t *testing.T) {
+	t.Parallel()
+
+	check := &container.ContainerHealthCheck{
+		HTTPCheck: &container.ContainerHTTPHealthCheck{HTTPPort: 8080},
+	}
+
+	assert.Equal(t, time.Second, check.InitialDelay())
+	assert.Equal(t, 5*time.Second, check.Period())
+	assert.Equal(t, time.Second, check.Timeout())
+
+	assert.False(t, check.Exec().IsPresent())
+	assert.False(t, check.TCP().IsPresent())
+
+	http, ok := check.HTTP().Get()
+	require.True(t, ok)
+	assert.EqualValues(t, 8080, http.Port())
+	assert.Equal(t, "/", http.Path())
+}
+
+func TestContainerHealthCheckValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name         string
+		check        container.ContainerHealthCheck
+		networkMode  config.ContainerNetworkMode
+		expectedErrs []string
+	}{
+		{
+			name: "exec",
+			check: container.ContainerHealthCheck{
+				ExecCheck: &container.ContainerExecHealthCheck{ExecCommand: []string{"pg_isready"}},
+			},
+			networkMode: config.ContainerNetworkModeNone,
+		},
+		{
+			name: "http with host network",
+			check: container.ContainerHealthCheck{
+				HTTPCheck: &container.ContainerHTTPHealthCheck{HTTPPort: 8080, HTTPPath: "/healthz"},
+			},
+			networkMode: config.ContainerNetworkModeHost,
+		},
+		{
+			name: "tcp with host network",
+			check: container.ContainerHealthCheck{
+				TCPCheck: &container.ContainerTCPHealthCheck{TCPPort: 5000},
+			},
+			networkMode: config.ContainerNetworkModeHost,
+		},
+		{
+			name:         "no check",
+			networkMode:  config.ContainerNetworkModeHost,
+			expectedErrs: []string{"healthCheck: exactly one of exec, http or tcp must be set"},
+		},
+		{
+			name: "two checks",
+			check: container.ContainerHealthCheck{
+				ExecCheck: &container.ContainerExecHealthCheck{ExecCommand: []string{"true"}},
+				TCPCheck:  &container.ContainerTCPHealthCheck{TCPPort: 5000},
+			},
+			networkMode:  config.ContainerNetworkModeHost,
+			expectedErrs: []string{"healthCheck: exactly one of exec, http or tcp must be set"},
+		},
+		{
+			name: "empty exec command",
+			check: container.ContainerHealthCheck{
+				ExecCheck: &container.ContainerExecHealthCheck{},
+			},
+			networkMode:  config.ContainerNetworkModeNone,
+			expectedErrs: []string{"healthCheck.exec.command is required"},
+		},
+		{
+			name: "http without port and with a relative path",
+			check: container.ContainerHealthCheck{
+				HTTPCheck: &container.ContainerHTTPHealthCheck{HTTPPath: "healthz"},
+			},
+			networkMode: config.ContainerNetworkModeHost,
+			expectedErrs: []string{
+				"healthCheck.http.port is required",
+				`healthCheck.http.path "healthz" must start with /`,
+			},
+		},
+		{
+			name: "tcp without host network",
+			check: container.ContainerHealthCheck{
+				TCPCheck: &container.ContainerTCPHealthCheck{TCPPort: 5000},
+			},
+			networkMode:  config.ContainerNetworkModeNone,
+			expectedErrs: []string{"healthCheck: http and tcp checks require the host or bridge network mode"},
+		},
+		{
+			name: "tcp with bridge network",
+			check: container.ContainerHealthCheck{
+				TCPCheck: &container.ContainerTCPHealthCheck{TCPPort: 5000},
+			},
+			networkMode: config.ContainerNetworkModeBridge,
+		},
+		{
+			name: "http with macvlan network",
+			check: container.ContainerHealthCheck{
+				HTTPCheck: &container.ContainerHTTPHealthCheck{HTTPPort: 8080},
+			},
+			networkMode:  config.ContainerNetworkModeMacvlan,
+			expectedErrs: []string{"healthCheck: http and tcp checks require the host or bridge network mode"},
+		},
+		{
+			name: "negative timings",
+			check: container.ContainerHealthCheck{
+				ExecCheck:     &container.ContainerExecHealthCheck{ExecCommand: []string{"true"}},
+				HealthPeriod:  -time.Second,
+				HealthTimeout: -time.Second,
+			},
+			networkMode: config.ContainerNetworkModeNone,
+			expectedErrs: []string{
+				"healthCheck.period must be non-negative, got -1s",
+				"healthCheck.timeout must be non-negative, got -1s",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.check.Validate(test.networkMode)
+
+			if len(test.expectedErrs) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			for _, expected := range test.expectedErrs {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
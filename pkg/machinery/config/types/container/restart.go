@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// Restart delay defaults.
+const (
+	defaultRestartInitialDelay = 5 * time.Second
+	defaultRestartMaxDelay     = 5 * time.Minute
+)
+
+// ContainerRestartPolicy configures when and how fast a terminated container is restarted.
+type ContainerRestartPolicy struct {
+	//   description: |
+	//     Which terminations lead to a restart.
+	//
+	//     `always` restarts the container whenever it terminates. `on-failure` restarts it only
+	//     if it could not be started or exited with a non-zero code. `never` leaves it stopped.
+	//     Changing the container configuration starts it again regardless of the mode.
+	//   values:
+	//     - always
+	//     - on-failure
+	//     - never
+	//   schema:
+	//     type: string
+	RestartMode config.ContainerRestartMode `yaml:"mode,omitempty"`
+	//   description: |
+	//     Maximum number of restarts, after which the container is left stopped.
+	//
+	//     Zero or unset means unlimited. The count starts over when the container is removed
+	//     from the machine configuration or the machine reboots.
+	//   examples:
+	//     - value: uint64(10)
+	RestartMaxRetries uint64 `yaml:"maxRetries,omitempty"`
+	//   description: |
+	//     Delay before the first restart, doubled on each further restart.
+	//
+	//     Defaults to 5s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	RestartInitialDelay time.Duration `yaml:"initialDelay,omitempty"`
+	//   description: |
+	//     Upper bound of the restart delay.
+	//
+	//     Defaults to 5m.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	RestartMaxDelay time.Duration `yaml:"maxDelay,omitempty"`
+}
+
+// Check interfaces.
+var _ config.ContainerRestartPolicyConfig = &ContainerRestartPolicy{}
+
+// Mode implements config.ContainerRestartPolicyConfig interface.
+func (p *ContainerRestartPolicy) Mode() config.ContainerRestartMode {
+	if p.RestartMode == "" {
+		return config.ContainerRestartModeAlways
+	}
+
+	return p.RestartMode
+}
+
+// MaxRetries implements config.ContainerRestartPolicyConfig interface.
+func (p *ContainerRestartPolicy) MaxRetries() uint64 {
+	return p.RestartMaxRetries
+}
+
+// InitialDelay implements config.ContainerRestartPolicyConfig interface.
+func (p *ContainerRestartPolicy) InitialDelay() time.Duration {
+	if p.RestartInitialDelay == 0 {
+		return defaultRestartInitialDelay
+	}
+
+	return p.RestartInitialDelay
+}
+
+// MaxDelay implements config.ContainerRestartPolicyConfig interface.
+//
+// It is never below the initial delay, so an initial delay raised above the default on its own
+// is not silently capped.
+func (p *ContainerRestartPolicy) MaxDelay() time.Duration {
+	if p.RestartMaxDelay == 0 {
+		return max(defaultRestartMaxDelay, p.InitialDelay())
+	}
+
+	return p.RestartMaxDelay
+}
+
+// Validate checks the restart policy.
+func (p *ContainerRestartPolicy) Validate() error {
+	var validationErrors error
+
+	switch p.Mode() {
+	case config.ContainerRestartModeAlways, config.ContainerRestartModeOnFailure, config.ContainerRestartModeNever:
+	default:
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("unsupported restartPolicy.mode %q, expected always, on-failure or never", p.RestartMode))
+	}
+
+	if p.RestartInitialDelay < 0 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("restartPolicy.initialDelay must be non-negative, got %s", p.RestartInitialDelay))
+	}
+
+	if p.RestartMaxDelay < 0 {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("restartPolicy.maxDelay must be non-negative, got %s", p.RestartMaxDelay))
+	}
+
+	if p.RestartMaxDelay > 0 && p.RestartMaxDelay < p.InitialDelay() {
+		validationErrors = errors.Join(validationErrors,
+			fmt.Errorf("restartPolicy.maxDelay %s must not be less than initialDelay %s", p.RestartMaxDelay, p.InitialDelay()))
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package container_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/container"
+)
+
+func TestContainerRestartPolicyDefaults(t *testing.T) {
+	t.Parallel()
+
+	policy := &container.ContainerRestartPolicy{}
+
+	assert.Equal(t, config.ContainerRestartModeAlways, policy.Mode())
+	assert.Zero(t, policy.MaxRetries())
+	assert.Equal(t, 5*time.Second, policy.InitialDelay())
+	assert.Equal(t, 5*time.Minute, policy.MaxDelay())
+
+	// An initial delay above the default maximum raises the maximum with it.
+	policy = &container.ContainerRestartPolicy{RestartInitialDelay: 10 * time.Minute}
+
+	assert.Equal(t, 10*time.Minute, policy.MaxDelay())
+}
+
+func TestContainerRestartPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name         string
+		policy       container.ContainerRestartPolicy
+		expectedErrs []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "full",
+			policy: container.ContainerRestartPolicy{
+				RestartMode:         config.ContainerRestartModeOnFailure,
+				RestartMaxRetries:   3,
+				RestartInitialDelay: time.Second,
+				RestartMaxDelay:     time.Minute,
+			},
+		},
+		{
+			name: "never",
+			policy: container.ContainerRestartPolicy{
+				RestartMode: config.ContainerRestartModeNever,
+			},
+		},
+		{
+			name: "unknown mode",
+			policy: container.ContainerRestartPolicy{
+				RestartMode: "unless-stopped",
+			},
+			expectedErrs: []string{`unsupported restartPolicy.mode "unless-stopped"`},
+		},
+		{
+			name: "negative delays",
+			policy: container.ContainerRestartPolicy{
+				RestartInitialDelay: -time.Second,
+				RestartMaxDelay:     -time.Second,
+			},
+			expectedErrs: []string{
+				"restartPolicy.initialDelay must be non-negative, got -1s",
+				"restartPolicy.maxDelay must be non-negative, got -1s",
+			},
+		},
+		{
+			name: "max delay below the default initial delay",
+			policy: container.ContainerRestartPolicy{
+				RestartMaxDelay: time.Second,
+			},
+			expectedErrs: []string{"restartPolicy.maxDelay 1s must not be less than initialDelay 5s"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.policy.Validate()
+
+			if len(test.expectedErrs) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			for _, expected := range test.expectedErrs {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
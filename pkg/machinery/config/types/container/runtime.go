@@ -9,6 +9,7 @@ package container
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"slices"
 	"strconv"
 	"strings"
@@ -18,8 +19,33 @@ import (
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
+// reservedHostPorts are the ports Talos and the Kubernetes components listen on on the node addresses.
+//
+// Publishing a container port there would redirect the traffic of the service to the container.
+var reservedHostPorts = map[nethelpers.Protocol][]uint16{
+	nethelpers.ProtocolTCP: {
+		constants.BGPDefaultPort,
+		constants.EtcdClientPort,
+		constants.EtcdPeerPort,
+		constants.EtcdClientHTTPPort,
+		constants.DefaultControlPlanePort,
+		constants.DefaultKubePrismPort,
+		constants.KubeletPort,
+		constants.ApidPort,
+		constants.TrustdPort,
+		constants.MetricsPort,
+	},
+	nethelpers.ProtocolUDP: {
+		constants.FlannelDefaultBackendPort,
+		constants.KubeSpanDefaultPort,
+	},
+}
+
 // validNetworkConditions are the network readiness conditions accepted in dependsOn.networks.
 //
 // These mirror the conditions the network subsystem already reports.
@@ -33,17 +59,87 @@ type ContainerNetwork struct {
 	//     `none` gives the container its own empty network namespace with no host access.
 	//     `host` shares the host network namespace, so the container sees every interface and
 	//     can bind any port.
+	//     `bridge` gives the container its own network namespace, attached to a bridge link on
+	//     the host through a veth pair.
+	//     `macvlan` gives the container its own network namespace, with a macvlan link on top of
+	//     a host link, so that it appears on that link's network as a machine of its own.
 	//   values:
 	//     - none
 	//     - host
+	//     - bridge
+	//     - macvlan
 	//   schema:
 	//     type: string
 	NetworkMode config.ContainerNetworkMode `yaml:"mode,omitempty"`
+	//   description: |
+	//     Host link the container is attached to.
+	//
+	//     For the `bridge` mode, the name of a bridge link, e.g. one declared with a
+	//     `BridgeConfig` document. For the `macvlan` mode, the name of the parent link.
+	//   examples:
+	//     - value: '"br-containers"'
+	NetworkLink string `yaml:"link,omitempty"`
+	//   description: |
+	//     Subnet the container address is allocated from, required for the `bridge` and
+	//     `macvlan` modes.
+	//
+	//     The address is allocated by Talos and stays the same while the container exists. The
+	//     network and broadcast addresses, the gateway and the addresses of the node itself are
+	//     never allocated, so the subnet may be the one the bridge address is in.
+	//   examples:
+	//     - value: >
+	//         meta.Prefix{Prefix: netip.MustParsePrefix("10.88.0.0/24")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	NetworkSubnet meta.Prefix `yaml:"subnet,omitempty"`
+	//   description: |
+	//     Gateway of the container's default route, within the subnet.
+	//
+	//     For the `bridge` mode this is usually the address of the bridge itself. If not set,
+	//     the container only reaches its subnet.
+	//   examples:
+	//     - value: >
+	//         meta.Addr{Addr: netip.MustParseAddr("10.88.0.1")}
+	//   schema:
+	//     type: string
+	NetworkGateway meta.Addr `yaml:"gateway,omitempty"`
+	//   description: |
+	//     Container ports published on the host, only supported in the `bridge` mode.
+	//
+	//     Connections to the host port on any of the node addresses are forwarded to the
+	//     container.
+	NetworkPorts []ContainerPort `yaml:"ports,omitempty"`
+}
+
+// ContainerPort publishes a container port on the host.
+type ContainerPort struct {
+	//   description: |
+	//     Port inside the container.
+	//   examples:
+	//     - value: uint16(80)
+	//   schemaRequired: true
+	PortContainerPort uint16 `yaml:"containerPort"`
+	//   description: |
+	//     Port on the host.
+	//   examples:
+	//     - value: uint16(8080)
+	//   schemaRequired: true
+	PortHostPort uint16 `yaml:"hostPort"`
+	//   description: |
+	//     Protocol of the port, defaults to `tcp`.
+	//   values:
+	//     - tcp
+	//     - udp
+	//   schema:
+	//     type: string
+	PortProtocol nethelpers.Protocol `yaml:"protocol,omitempty"`
 }
 
 // Check interfaces.
 var (
 	_ config.ContainerNetworkConfig   = &ContainerNetwork{}
+	_ config.ContainerPortConfig      = &ContainerPort{}
 	_ config.ContainerResourcesConfig = &ContainerResources{}
 	_ config.ContainerDependsOnConfig = &ContainerDependsOn{}
 )
@@ -57,16 +153,125 @@ func (n *ContainerNetwork) Mode() config.ContainerNetworkMode {
 	return n.NetworkMode
 }
 
+// Link implements config.ContainerNetworkConfig interface.
+func (n *ContainerNetwork) Link() string { return n.NetworkLink }
+
+// Subnet implements config.ContainerNetworkConfig interface.
+func (n *ContainerNetwork) Subnet() optional.Optional[netip.Prefix] {
+	if !n.NetworkSubnet.IsValid() {
+		return optional.None[netip.Prefix]()
+	}
+
+	return optional.Some(n.NetworkSubnet.Prefix)
+}
+
+// Gateway implements config.ContainerNetworkConfig interface.
+func (n *ContainerNetwork) Gateway() optional.Optional[netip.Addr] {
+	if !n.NetworkGateway.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(n.NetworkGateway.Addr)
+}
+
+// Ports implements config.ContainerNetworkConfig interface.
+func (n *ContainerNetwork) Ports() []config.ContainerPortConfig {
+	out := make([]config.ContainerPortConfig, 0, len(n.NetworkPorts))
+
+	for i := range n.NetworkPorts {
+		out = append(out, &n.NetworkPorts[i])
+	}
+
+	return out
+}
+
 // Validate checks the network settings.
+//
+//nolint:gocyclo
 func (n *ContainerNetwork) Validate() error {
+	var validationErrors error
+
 	switch n.Mode() {
 	case config.ContainerNetworkModeNone, config.ContainerNetworkModeHost:
-		return nil
+		if n.NetworkLink != "" || n.NetworkSubnet.IsValid() || n.NetworkGateway.IsValid() {
+			validationErrors = errors.Join(validationErrors,
+				errors.New("network: link, subnet and gateway are only supported in the bridge and macvlan modes"))
+		}
+	case config.ContainerNetworkModeBridge, config.ContainerNetworkModeMacvlan:
+		if n.NetworkLink == "" {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.link is required in the %s mode", n.NetworkMode))
+		}
+
+		switch {
+		case !n.NetworkSubnet.IsValid():
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.subnet is required in the %s mode", n.NetworkMode))
+		case n.NetworkSubnet.Prefix != n.NetworkSubnet.Masked():
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.subnet %s has host bits set", n.NetworkSubnet.Prefix))
+		case n.NetworkSubnet.Addr().Is4() && n.NetworkSubnet.Bits() > 30,
+			n.NetworkSubnet.Addr().Is6() && n.NetworkSubnet.Bits() > 126:
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.subnet %s is too small", n.NetworkSubnet.Prefix))
+		case n.NetworkGateway.IsValid() && !n.NetworkSubnet.Contains(n.NetworkGateway.Addr):
+			validationErrors = errors.Join(validationErrors,
+				fmt.Errorf("network.gateway %s is not within the subnet %s", n.NetworkGateway.Addr, n.NetworkSubnet.Prefix))
+		}
 	default:
-		return fmt.Errorf("unsupported network mode %q, expected none or host", n.NetworkMode)
+		return fmt.Errorf("unsupported network mode %q, expected none, host, bridge or macvlan", n.NetworkMode)
 	}
+
+	if len(n.NetworkPorts) > 0 && n.Mode() != config.ContainerNetworkModeBridge {
+		validationErrors = errors.Join(validationErrors, errors.New("network.ports are only supported in the bridge mode"))
+	}
+
+	published := map[[2]uint16]struct{}{}
+
+	for i, port := range n.NetworkPorts {
+		if port.PortContainerPort == 0 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.ports[%d]: containerPort is required", i))
+		}
+
+		if port.PortHostPort == 0 {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.ports[%d]: hostPort is required", i))
+		}
+
+		protocol := port.Protocol()
+
+		if protocol != nethelpers.ProtocolTCP && protocol != nethelpers.ProtocolUDP {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.ports[%d]: unsupported protocol %s, expected tcp or udp", i, protocol))
+
+			continue
+		}
+
+		if slices.Contains(reservedHostPorts[protocol], port.PortHostPort) {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.ports[%d]: host port %s/%d is reserved by Talos", i, protocol, port.PortHostPort))
+		}
+
+		key := [2]uint16{uint16(protocol), port.PortHostPort}
+
+		if _, exists := published[key]; exists {
+			validationErrors = errors.Join(validationErrors, fmt.Errorf("network.ports[%d]: host port %s/%d is published twice", i, protocol, port.PortHostPort))
+		}
+
+		published[key] = struct{}{}
+	}
+
+	return validationErrors
 }
 
+// Protocol implements config.ContainerPortConfig interface.
+func (p *ContainerPort) Protocol() nethelpers.Protocol {
+	if p.PortProtocol == 0 {
+		return nethelpers.ProtocolTCP
+	}
+
+	return p.PortProtocol
+}
+
+// HostPort implements config.ContainerPortConfig interface.
+func (p *ContainerPort) HostPort() uint16 { return p.PortHostPort }
+
+// ContainerPort implements config.ContainerPortConfig interface.
+func (p *ContainerPort) ContainerPort() uint16 { return p.PortContainerPort }
+
 // ContainerResources configures cgroup v2 resource limits.
 type ContainerResources struct {
 	//   description: |
@@ -190,8 +395,9 @@ type ContainerDependsOn struct {
 	//     Whether the clock must be synchronized before the container starts.
 	TimeConfig *bool `yaml:"time,omitempty"`
 	//   description: |
-	//     Other containers, by document name, which must be running first.
+	//     Other containers, by document name, which must be running and healthy first.
 	//
+	//     A container without a health check counts as healthy as soon as it is running.
 	//     Cycles are rejected when the machine configuration is applied.
 	ContainersConfig []string `yaml:"containers,omitempty"`
 }
@@ -10,6 +10,9 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/siderolabs/gen/xslices"
@@ -41,6 +44,19 @@ var (
 	_ config.NetworkBGPInstanceConfig = &BGPInstanceConfigV1Alpha1{}
 	_ config.NamedDocument            = &BGPInstanceConfigV1Alpha1{}
 	_ config.Validator                = &BGPInstanceConfigV1Alpha1{}
+	_ config.SecretDocument           = &BGPInstanceConfigV1Alpha1{}
+)
+
+const (
+	// bgpMaxPasswordLength is the maximum TCP-MD5 key length supported by the kernel (TCP_MD5SIG_MAXKEYLEN).
+	bgpMaxPasswordLength = 80
+
+	// bgpDefaultRestartTime is the graceful restart time advertised when not configured.
+	bgpDefaultRestartTime = 120 * time.Second
+	// bgpMaxRestartTime is the largest restart time which fits the 12-bit graceful restart capability field.
+	bgpMaxRestartTime = 4095 * time.Second
+	// bgpMaxLongLivedStaleTime is the largest stale time which fits the 24-bit long-lived graceful restart capability field.
+	bgpMaxLongLivedStaleTime = (1<<24 - 1) * time.Second
 )
 
 // BGPInstanceConfigV1Alpha1 configures a native BGP routing instance on the host.
@@ -94,6 +110,10 @@ type BGPInstanceConfigV1Alpha1 struct {
 	//       []string{"dummy0"}
 	BGPAdvertise []string `yaml:"advertise,omitempty" merge:"replace"`
 	//   description: |
+	//     Probes gating the advertisement of the links listed in `advertise`.
+	//     While the probe fails, the addresses of the link are withdrawn from BGP.
+	BGPAdvertiseProbes []BGPAdvertiseProbe `yaml:"advertiseProbes,omitempty" merge:"replace"`
+	//   description: |
 	//     Enable ECMP (multipath) for routes learned from multiple neighbors. Defaults to false.
 	BGPMultipath *bool `yaml:"multipath,omitempty"`
 	//   description: |
@@ -113,6 +133,46 @@ type BGPInstanceConfigV1Alpha1 struct {
 	//   description: |
 	//     BGP neighbors in this routing instance.
 	BGPNeighborConfigs []BGPNeighborConfig `yaml:"neighbors,omitempty" merge:"replace"`
+	//   description: |
+	//     Graceful restart (RFC 4724) settings for all neighbors of this instance.
+	//     The presence of this block enables graceful restart; an empty block uses the defaults.
+	//     With graceful restart, neighbors keep the routes of this node while its BGP sessions are restarted,
+	//     for example during a Talos reboot or upgrade.
+	BGPGracefulRestart *BGPGracefulRestartConfig `yaml:"gracefulRestart,omitempty"`
+}
+
+// BGPGracefulRestartConfig configures BGP graceful restart.
+type BGPGracefulRestartConfig struct {
+	//   description: |
+	//     Time the neighbors keep the routes of this node after the session goes down.
+	//     Defaults to 120s, the maximum is 4095s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\.\d+|\d+)([nuµm]?s|m|h))|0)+$
+	GracefulRestartTime time.Duration `yaml:"restartTime,omitempty"`
+	//   description: |
+	//     Enables long-lived graceful restart (RFC 9494): once the restart time expires,
+	//     the neighbors keep the routes of this node as least-preferred for this duration.
+	//     If not set, long-lived graceful restart is disabled.
+	//   examples:
+	//    - value: >
+	//       time.Hour
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\.\d+|\d+)([nuµm]?s|m|h))|0)+$
+	GracefulRestartLongLivedStaleTime time.Duration `yaml:"longLivedStaleTime,omitempty"`
+}
+
+// BGPAdvertiseProbe gates the advertisement of a link's addresses on a probe.
+type BGPAdvertiseProbe struct {
+	//   description: |
+	//     Name or alias of the advertised link.
+	//   schemaRequired: true
+	AdvertiseLink string `yaml:"link"`
+	//   description: |
+	//     Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`).
+	//   schemaRequired: true
+	AdvertiseProbe string `yaml:"probe"`
 }
 
 // BGPImportRoute selects routes learned by another BGP instance for one-way import.
@@ -163,6 +223,65 @@ type BGPNeighborConfig struct {
 	//     The presence of this block enables BFD; an empty block uses the implementation defaults.
 	//     BFD is supported only when the BGP instance uses the default routing domain, not a VRF.
 	NeighborBFDConfig *BGPBFDConfig `yaml:"bfd,omitempty"`
+	//   description: |
+	//     TCP-MD5 (RFC 2385) password used to authenticate the session with this neighbor.
+	//     TCP-AO is not supported.
+	NeighborPassword string `yaml:"password,omitempty"`
+	//   description: |
+	//     Policy applied to routes received from this neighbor.
+	NeighborImportPolicy *BGPRoutePolicy `yaml:"import,omitempty"`
+	//   description: |
+	//     Policy applied to routes advertised to this neighbor.
+	NeighborExportPolicy *BGPRoutePolicy `yaml:"export,omitempty"`
+}
+
+// BGPRoutePolicy filters and modifies routes exchanged with a BGP neighbor.
+type BGPRoutePolicy struct {
+	//   description: |
+	//     Prefix list the routes must match to be accepted.
+	//     If not set, routes of any prefix are accepted.
+	PolicyPrefixes []BGPPrefixFilter `yaml:"prefixes,omitempty"`
+	//   description: |
+	//     Standard communities (`ASN:value`), at least one of which must be carried by the route for it to be accepted.
+	//     If not set, routes are accepted regardless of their communities.
+	//   examples:
+	//    - value: >
+	//       []string{"65000:100"}
+	PolicyMatchCommunities []string `yaml:"matchCommunities,omitempty"`
+	//   description: |
+	//     Standard communities (`ASN:value`) added to the accepted routes.
+	//   examples:
+	//    - value: >
+	//       []string{"65001:200"}
+	PolicyAddCommunities []string `yaml:"addCommunities,omitempty"`
+	//   description: |
+	//     Large communities (`ASN:value:value`) added to the accepted routes.
+	//   examples:
+	//    - value: >
+	//       []string{"65001:1:2"}
+	PolicyAddLargeCommunities []string `yaml:"addLargeCommunities,omitempty"`
+	//   description: |
+	//     Number of times the local ASN is prepended to the AS path of the advertised routes.
+	//     Only supported in the `export` policy.
+	PolicyASPathPrepend uint8 `yaml:"asPathPrepend,omitempty"`
+}
+
+// BGPPrefixFilter is a single entry of a BGP prefix list.
+type BGPPrefixFilter struct {
+	//   description: |
+	//     Prefix matched by this entry.
+	//   examples:
+	//    - value: >
+	//       meta.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	//   schemaRequired: true
+	FilterPrefix meta.Prefix `yaml:"prefix"`
+	//   description: |
+	//     Longest prefix length matched by this entry.
+	//     If not set, only the exact prefix length matches.
+	FilterMaxLength uint8 `yaml:"maxLength,omitempty"`
 }
 
 // BGPBFDConfig configures BFD for a BGP neighbor.
@@ -199,6 +318,10 @@ func exampleBGPInstanceConfigV1Alpha1() *BGPInstanceConfigV1Alpha1 {
 	cfg := NewBGPInstanceConfigV1Alpha1("fabric")
 	cfg.BGPLocalASN = 65001
 	cfg.BGPAdvertise = []string{"dummy0"}
+	cfg.BGPAdvertiseProbes = []BGPAdvertiseProbe{{
+		AdvertiseLink:  "dummy0",
+		AdvertiseProbe: "service-check",
+	}}
 	cfg.BGPMultipath = new(true)
 	cfg.BGPImportRoutes = []BGPImportRoute{{
 		ImportBGPInstance: "workload",
@@ -224,9 +347,19 @@ func exampleBGPInstanceConfigV1Alpha1() *BGPInstanceConfigV1Alpha1 {
 				BFDReceiveInterval:  300 * time.Millisecond,
 				BFDDetectMultiplier: 3,
 			},
+			NeighborPassword: "s3cr3t",
+			NeighborExportPolicy: &BGPRoutePolicy{
+				PolicyAddCommunities:      []string{"65001:200"},
+				PolicyAddLargeCommunities: []string{"65001:1:2"},
+				PolicyASPathPrepend:       2,
+			},
 		},
 	}
 
+	cfg.BGPGracefulRestart = &BGPGracefulRestartConfig{
+		GracefulRestartTime: 120 * time.Second,
+	}
+
 	return cfg
 }
 
@@ -236,6 +369,15 @@ func (s *BGPInstanceConfigV1Alpha1) Clone() config.Document { return s.DeepCopy(
 // Name implements config.NamedDocument interface.
 func (s *BGPInstanceConfigV1Alpha1) Name() string { return s.MetaName }
 
+// Redact implements config.SecretDocument interface.
+func (s *BGPInstanceConfigV1Alpha1) Redact(replacement string) {
+	for i := range s.BGPNeighborConfigs {
+		if s.BGPNeighborConfigs[i].NeighborPassword != "" {
+			s.BGPNeighborConfigs[i].NeighborPassword = replacement
+		}
+	}
+}
+
 // BGPInstanceConfigSignal implements config.NetworkBGPInstanceConfig interface.
 func (s *BGPInstanceConfigV1Alpha1) BGPInstanceConfigSignal() {}
 
@@ -254,6 +396,11 @@ func (s *BGPInstanceConfigV1Alpha1) RouteSource() netip.Addr { return s.BGPRoute
 // AdvertiseLinks implements config.NetworkBGPInstanceConfig interface.
 func (s *BGPInstanceConfigV1Alpha1) AdvertiseLinks() []string { return s.BGPAdvertise }
 
+// AdvertiseProbes implements config.NetworkBGPInstanceConfig interface.
+func (s *BGPInstanceConfigV1Alpha1) AdvertiseProbes() []config.NetworkBGPAdvertiseProbe {
+	return xslices.Map(s.BGPAdvertiseProbes, func(probe BGPAdvertiseProbe) config.NetworkBGPAdvertiseProbe { return probe })
+}
+
 // Multipath implements config.NetworkBGPInstanceConfig interface.
 func (s *BGPInstanceConfigV1Alpha1) Multipath() bool {
 	if s.BGPMultipath == nil {
@@ -285,6 +432,15 @@ func (s *BGPInstanceConfigV1Alpha1) Neighbors() []config.NetworkBGPNeighbor {
 	return xslices.Map(s.BGPNeighborConfigs, func(n BGPNeighborConfig) config.NetworkBGPNeighbor { return n })
 }
 
+// GracefulRestart implements config.NetworkBGPInstanceConfig interface.
+func (s *BGPInstanceConfigV1Alpha1) GracefulRestart() config.NetworkBGPGracefulRestart {
+	if s.BGPGracefulRestart == nil {
+		return nil
+	}
+
+	return s.BGPGracefulRestart
+}
+
 // Validate implements config.Validator interface.
 func (s *BGPInstanceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	var (
@@ -326,16 +482,59 @@ func (s *BGPInstanceConfigV1Alpha1) Validate(validation.RuntimeMode, ...validati
 	}
 
 	errs = errors.Join(errs, validateBGPImportRoutes(s.MetaName, s.BGPImportRoutes))
+	errs = errors.Join(errs, validateBGPAdvertiseProbes(s.BGPAdvertise, s.BGPAdvertiseProbes))
+
+	if s.BGPGracefulRestart != nil {
+		errs = errors.Join(errs, s.BGPGracefulRestart.validate())
+	}
 
 	return warnings, errs
 }
 
+func (gr *BGPGracefulRestartConfig) validate() error {
+	var errs error
+
+	if gr.GracefulRestartTime < 0 || gr.GracefulRestartTime > bgpMaxRestartTime {
+		errs = errors.Join(errs, fmt.Errorf("gracefulRestart: restartTime must be between 0 and %s", bgpMaxRestartTime))
+	}
+
+	if gr.GracefulRestartLongLivedStaleTime < 0 || gr.GracefulRestartLongLivedStaleTime > bgpMaxLongLivedStaleTime {
+		errs = errors.Join(errs, fmt.Errorf("gracefulRestart: longLivedStaleTime must be between 0 and %s", bgpMaxLongLivedStaleTime))
+	}
+
+	return errs
+}
+
 //docgen:nodoc
 type indexedBGPImportSelector struct {
 	prefix     netip.Prefix
 	routeIndex int
 }
 
+func validateBGPAdvertiseProbes(advertise []string, probes []BGPAdvertiseProbe) error {
+	var errs error
+
+	seen := map[string]struct{}{}
+
+	for i, probe := range probes {
+		if probe.AdvertiseProbe == "" {
+			errs = errors.Join(errs, fmt.Errorf("advertiseProbes[%d]: probe must be specified", i))
+		}
+
+		if !slices.Contains(advertise, probe.AdvertiseLink) {
+			errs = errors.Join(errs, fmt.Errorf("advertiseProbes[%d]: link %q is not advertised", i, probe.AdvertiseLink))
+		}
+
+		if _, ok := seen[probe.AdvertiseLink]; ok {
+			errs = errors.Join(errs, fmt.Errorf("advertiseProbes[%d]: duplicate link %q", i, probe.AdvertiseLink))
+		}
+
+		seen[probe.AdvertiseLink] = struct{}{}
+	}
+
+	return errs
+}
+
 func validateBGPImportRoutes(instanceName string, routes []BGPImportRoute) error {
 	var errs error
 
@@ -450,6 +649,22 @@ func validateBGPNeighborBehavior(index int, neighbor BGPNeighborConfig, vrf stri
 		errs = errors.Join(errs, fmt.Errorf("neighbor[%d]: holdTime must not be negative", index))
 	}
 
+	if len(neighbor.NeighborPassword) > bgpMaxPasswordLength {
+		errs = errors.Join(errs, fmt.Errorf("neighbor[%d]: password must not be longer than %d bytes", index, bgpMaxPasswordLength))
+	}
+
+	if neighbor.NeighborImportPolicy != nil {
+		errs = errors.Join(errs, neighbor.NeighborImportPolicy.validate(fmt.Sprintf("neighbor[%d].import", index)))
+
+		if neighbor.NeighborImportPolicy.PolicyASPathPrepend != 0 {
+			errs = errors.Join(errs, fmt.Errorf("neighbor[%d].import: asPathPrepend is only supported in the export policy", index))
+		}
+	}
+
+	if neighbor.NeighborExportPolicy != nil {
+		errs = errors.Join(errs, neighbor.NeighborExportPolicy.validate(fmt.Sprintf("neighbor[%d].export", index)))
+	}
+
 	if neighbor.NeighborBFDConfig == nil {
 		return errs
 	}
@@ -469,6 +684,64 @@ func validateBGPNeighborBehavior(index int, neighbor BGPNeighborConfig, vrf stri
 	return errs
 }
 
+func (p *BGPRoutePolicy) validate(path string) error {
+	var errs error
+
+	for i, filter := range p.PolicyPrefixes {
+		prefix := filter.FilterPrefix.Prefix
+
+		if !prefix.IsValid() {
+			errs = errors.Join(errs, fmt.Errorf("%s.prefixes[%d]: invalid prefix", path, i))
+
+			continue
+		}
+
+		if prefix != prefix.Masked() {
+			errs = errors.Join(errs, fmt.Errorf("%s.prefixes[%d]: prefix %s must be masked", path, i, prefix))
+		}
+
+		if filter.FilterMaxLength != 0 && (int(filter.FilterMaxLength) < prefix.Bits() || int(filter.FilterMaxLength) > prefix.Addr().BitLen()) {
+			errs = errors.Join(errs, fmt.Errorf("%s.prefixes[%d]: maxLength %d is out of range for prefix %s", path, i, filter.FilterMaxLength, prefix))
+		}
+	}
+
+	for i, community := range p.PolicyMatchCommunities {
+		if !validBGPCommunity(community, 2, 16) {
+			errs = errors.Join(errs, fmt.Errorf("%s.matchCommunities[%d]: invalid community %q", path, i, community))
+		}
+	}
+
+	for i, community := range p.PolicyAddCommunities {
+		if !validBGPCommunity(community, 2, 16) {
+			errs = errors.Join(errs, fmt.Errorf("%s.addCommunities[%d]: invalid community %q", path, i, community))
+		}
+	}
+
+	for i, community := range p.PolicyAddLargeCommunities {
+		if !validBGPCommunity(community, 3, 32) {
+			errs = errors.Join(errs, fmt.Errorf("%s.addLargeCommunities[%d]: invalid large community %q", path, i, community))
+		}
+	}
+
+	return errs
+}
+
+// validBGPCommunity checks that the community consists of the given number of colon-separated unsigned integers.
+func validBGPCommunity(community string, parts, bitSize int) bool {
+	fields := strings.Split(community, ":")
+	if len(fields) != parts {
+		return false
+	}
+
+	for _, field := range fields {
+		if _, err := strconv.ParseUint(field, 10, bitSize); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Address implements config.NetworkBGPNeighbor interface.
 func (n BGPNeighborConfig) Address() netip.Addr { return n.NeighborAddressConfig.Addr }
 
@@ -496,6 +769,62 @@ func (n BGPNeighborConfig) BFD() config.NetworkBGPBFD {
 	return n.NeighborBFDConfig
 }
 
+// Password implements config.NetworkBGPNeighbor interface.
+func (n BGPNeighborConfig) Password() string { return n.NeighborPassword }
+
+// ImportPolicy implements config.NetworkBGPNeighbor interface.
+func (n BGPNeighborConfig) ImportPolicy() config.NetworkBGPRoutePolicy {
+	if n.NeighborImportPolicy == nil {
+		return nil
+	}
+
+	return n.NeighborImportPolicy
+}
+
+// ExportPolicy implements config.NetworkBGPNeighbor interface.
+func (n BGPNeighborConfig) ExportPolicy() config.NetworkBGPRoutePolicy {
+	if n.NeighborExportPolicy == nil {
+		return nil
+	}
+
+	return n.NeighborExportPolicy
+}
+
+// Prefixes implements config.NetworkBGPRoutePolicy interface.
+func (p *BGPRoutePolicy) Prefixes() []config.NetworkBGPPrefixFilter {
+	return xslices.Map(p.PolicyPrefixes, func(filter BGPPrefixFilter) config.NetworkBGPPrefixFilter { return filter })
+}
+
+// MatchCommunities implements config.NetworkBGPRoutePolicy interface.
+func (p *BGPRoutePolicy) MatchCommunities() []string { return p.PolicyMatchCommunities }
+
+// AddCommunities implements config.NetworkBGPRoutePolicy interface.
+func (p *BGPRoutePolicy) AddCommunities() []string { return p.PolicyAddCommunities }
+
+// AddLargeCommunities implements config.NetworkBGPRoutePolicy interface.
+func (p *BGPRoutePolicy) AddLargeCommunities() []string { return p.PolicyAddLargeCommunities }
+
+// ASPathPrepend implements config.NetworkBGPRoutePolicy interface.
+func (p *BGPRoutePolicy) ASPathPrepend() uint8 { return p.PolicyASPathPrepend }
+
+// Prefix implements config.NetworkBGPPrefixFilter interface.
+func (f BGPPrefixFilter) Prefix() netip.Prefix { return f.FilterPrefix.Prefix }
+
+// MaxLength implements config.NetworkBGPPrefixFilter interface.
+func (f BGPPrefixFilter) MaxLength() uint8 {
+	if f.FilterMaxLength == 0 {
+		return uint8(f.FilterPrefix.Bits())
+	}
+
+	return f.FilterMaxLength
+}
+
+// Link implements config.NetworkBGPAdvertiseProbe interface.
+func (probe BGPAdvertiseProbe) Link() string { return probe.AdvertiseLink }
+
+// Probe implements config.NetworkBGPAdvertiseProbe interface.
+func (probe BGPAdvertiseProbe) Probe() string { return probe.AdvertiseProbe }
+
 // BGPInstance implements config.NetworkBGPImportRoute interface.
 func (route BGPImportRoute) BGPInstance() string { return route.ImportBGPInstance }
 
@@ -512,3 +841,17 @@ func (b *BGPBFDConfig) ReceiveInterval() time.Duration { return b.BFDReceiveInte
 
 // DetectMultiplier implements config.NetworkBGPBFD interface.
 func (b *BGPBFDConfig) DetectMultiplier() uint8 { return b.BFDDetectMultiplier }
+
+// RestartTime implements config.NetworkBGPGracefulRestart interface.
+func (gr *BGPGracefulRestartConfig) RestartTime() time.Duration {
+	if gr.GracefulRestartTime == 0 {
+		return bgpDefaultRestartTime
+	}
+
+	return gr.GracefulRestartTime
+}
+
+// LongLivedStaleTime implements config.NetworkBGPGracefulRestart interface.
+func (gr *BGPGracefulRestartConfig) LongLivedStaleTime() time.Duration {
+	return gr.GracefulRestartLongLivedStaleTime
+}
@@ -29,6 +29,7 @@ func bgpInstanceTestConfig() *network.BGPInstanceConfigV1Alpha1 {
 	cfg.BGPLocalASN = 65001
 	cfg.BGPRouterID = meta.Addr{Addr: netip.MustParseAddr("10.0.0.1")}
 	cfg.BGPAdvertise = []string{"dummy0"}
+	cfg.BGPAdvertiseProbes = []network.BGPAdvertiseProbe{{AdvertiseLink: "dummy0", AdvertiseProbe: "service-check"}}
 	cfg.BGPMultipath = new(true)
 	cfg.BGPInstallRoutes = new(false)
 	cfg.BGPImportRoutes = []network.BGPImportRoute{{
@@ -41,6 +42,19 @@ func bgpInstanceTestConfig() *network.BGPInstanceConfigV1Alpha1 {
 			NeighborPeerASN:       65000,
 			NeighborPassive:       true,
 			NeighborHoldTime:      9 * time.Second,
+			NeighborPassword:      "s3cr3t",
+			NeighborImportPolicy: &network.BGPRoutePolicy{
+				PolicyPrefixes: []network.BGPPrefixFilter{
+					{FilterPrefix: meta.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")}, FilterMaxLength: 24},
+					{FilterPrefix: meta.Prefix{Prefix: netip.MustParsePrefix("2001:db8::/32")}},
+				},
+				PolicyMatchCommunities: []string{"65000:100"},
+			},
+			NeighborExportPolicy: &network.BGPRoutePolicy{
+				PolicyAddCommunities:      []string{"65001:200"},
+				PolicyAddLargeCommunities: []string{"65001:1:2"},
+				PolicyASPathPrepend:       2,
+			},
 		},
 		{
 			NeighborLinkConfig: "enp0s1",
@@ -53,6 +67,9 @@ func bgpInstanceTestConfig() *network.BGPInstanceConfigV1Alpha1 {
 			},
 		},
 	}
+	cfg.BGPGracefulRestart = &network.BGPGracefulRestartConfig{
+		GracefulRestartLongLivedStaleTime: time.Hour,
+	}
 
 	return cfg
 }
@@ -78,6 +95,57 @@ func TestBGPConfigUnmarshal(t *testing.T) {
 	assert.Equal(t, bgpInstanceTestConfig(), docs[0])
 }
 
+func TestBGPConfigPolicy(t *testing.T) {
+	t.Parallel()
+
+	neighbors := bgpInstanceTestConfig().Neighbors()
+	require.Len(t, neighbors, 2)
+
+	assert.Equal(t, "s3cr3t", neighbors[0].Password())
+
+	importPolicy := neighbors[0].ImportPolicy()
+	require.NotNil(t, importPolicy)
+	require.Len(t, importPolicy.Prefixes(), 2)
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), importPolicy.Prefixes()[0].Prefix())
+	assert.Equal(t, uint8(24), importPolicy.Prefixes()[0].MaxLength())
+	assert.Equal(t, uint8(32), importPolicy.Prefixes()[1].MaxLength())
+	assert.Equal(t, []string{"65000:100"}, importPolicy.MatchCommunities())
+
+	exportPolicy := neighbors[0].ExportPolicy()
+	require.NotNil(t, exportPolicy)
+	assert.Equal(t, []string{"65001:200"}, exportPolicy.AddCommunities())
+	assert.Equal(t, []string{"65001:1:2"}, exportPolicy.AddLargeCommunities())
+	assert.Equal(t, uint8(2), exportPolicy.ASPathPrepend())
+
+	assert.Empty(t, neighbors[1].Password())
+	assert.Nil(t, neighbors[1].ImportPolicy())
+	assert.Nil(t, neighbors[1].ExportPolicy())
+}
+
+func TestBGPConfigGracefulRestart(t *testing.T) {
+	t.Parallel()
+
+	gracefulRestart := bgpInstanceTestConfig().GracefulRestart()
+	require.NotNil(t, gracefulRestart)
+
+	assert.Equal(t, 120*time.Second, gracefulRestart.RestartTime(), "restart time must default to 120s")
+	assert.Equal(t, time.Hour, gracefulRestart.LongLivedStaleTime())
+
+	assert.Nil(t, network.NewBGPInstanceConfigV1Alpha1("fabric").GracefulRestart())
+}
+
+func TestBGPConfigRedact(t *testing.T) {
+	t.Parallel()
+
+	cfg := bgpInstanceTestConfig()
+
+	cfg.Redact("REDACTED")
+
+	assert.Equal(t, "REDACTED", cfg.BGPNeighborConfigs[0].NeighborPassword)
+	assert.Empty(t, cfg.BGPNeighborConfigs[1].NeighborPassword, "empty passwords must stay empty")
+	assert.Equal(t, uint32(65000), cfg.BGPNeighborConfigs[0].NeighborPeerASN, "non-secret fields must be untouched")
+}
+
 func TestBGPConfigUnmarshalEmptyBFD(t *testing.T) {
 	t.Parallel()
 
@@ -266,6 +334,81 @@ func TestBGPInstanceConfigValidate(t *testing.T) {
 			},
 			expectedError: "neighbor[0]: bfd is not supported for VRF-bound BGP instances",
 		},
+		{
+			name: "invalid neighbor policies",
+			cfg: func() *network.BGPInstanceConfigV1Alpha1 {
+				cfg := network.NewBGPInstanceConfigV1Alpha1("fabric")
+				cfg.BGPLocalASN = 65001
+				cfg.BGPNeighborConfigs = []network.BGPNeighborConfig{{
+					NeighborLinkConfig: "enp0s1",
+					NeighborPassword:   strings.Repeat("x", 81),
+					NeighborImportPolicy: &network.BGPRoutePolicy{
+						PolicyPrefixes: []network.BGPPrefixFilter{
+							{},
+							{FilterPrefix: meta.Prefix{Prefix: netip.MustParsePrefix("10.0.0.1/8")}},
+							{FilterPrefix: meta.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/16")}, FilterMaxLength: 8},
+						},
+						PolicyMatchCommunities: []string{"65000"},
+						PolicyASPathPrepend:    1,
+					},
+					NeighborExportPolicy: &network.BGPRoutePolicy{
+						PolicyAddCommunities:      []string{"65536:1"},
+						PolicyAddLargeCommunities: []string{"65001:1"},
+					},
+				}}
+
+				return cfg
+			},
+			expectedError: strings.Join([]string{
+				"neighbor[0]: password must not be longer than 80 bytes",
+				"neighbor[0].import.prefixes[0]: invalid prefix",
+				"neighbor[0].import.prefixes[1]: prefix 10.0.0.1/8 must be masked",
+				"neighbor[0].import.prefixes[2]: maxLength 8 is out of range for prefix 10.0.0.0/16",
+				"neighbor[0].import.matchCommunities[0]: invalid community \"65000\"",
+				"neighbor[0].import: asPathPrepend is only supported in the export policy",
+				"neighbor[0].export.addCommunities[0]: invalid community \"65536:1\"",
+				"neighbor[0].export.addLargeCommunities[0]: invalid large community \"65001:1\"",
+			}, "\n"),
+		},
+		{
+			name: "invalid graceful restart",
+			cfg: func() *network.BGPInstanceConfigV1Alpha1 {
+				cfg := network.NewBGPInstanceConfigV1Alpha1("fabric")
+				cfg.BGPLocalASN = 65001
+				cfg.BGPNeighborConfigs = []network.BGPNeighborConfig{{NeighborLinkConfig: "enp0s1"}}
+				cfg.BGPGracefulRestart = &network.BGPGracefulRestartConfig{
+					GracefulRestartTime:               time.Hour + time.Second,
+					GracefulRestartLongLivedStaleTime: -time.Second,
+				}
+
+				return cfg
+			},
+			expectedError: strings.Join([]string{
+				"gracefulRestart: restartTime must be between 0 and 1h8m15s",
+				"gracefulRestart: longLivedStaleTime must be between 0 and 4660h20m15s",
+			}, "\n"),
+		},
+		{
+			name: "invalid advertise probes",
+			cfg: func() *network.BGPInstanceConfigV1Alpha1 {
+				cfg := network.NewBGPInstanceConfigV1Alpha1("fabric")
+				cfg.BGPLocalASN = 65001
+				cfg.BGPAdvertise = []string{"dummy0"}
+				cfg.BGPNeighborConfigs = []network.BGPNeighborConfig{{NeighborLinkConfig: "enp0s1"}}
+				cfg.BGPAdvertiseProbes = []network.BGPAdvertiseProbe{
+					{AdvertiseLink: "dummy0", AdvertiseProbe: "service-check"},
+					{AdvertiseLink: "dummy0"},
+					{AdvertiseLink: "dummy1", AdvertiseProbe: "service-check"},
+				}
+
+				return cfg
+			},
+			expectedError: strings.Join([]string{
+				"advertiseProbes[1]: probe must be specified",
+				"advertiseProbes[1]: duplicate link \"dummy0\"",
+				"advertiseProbes[2]: link \"dummy1\" is not advertised",
+			}, "\n"),
+		},
 		{
 			name: "empty BFD uses defaults",
 			cfg: func() *network.BGPInstanceConfigV1Alpha1 {
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type BGPInstanceConfigV1Alpha1 -type BlackholeRouteConfigV1Alpha1 -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type VRFConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DummyLinkConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HCloudVIPConfigV1Alpha1 -type HTTPProbeConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type KubeSpanConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type Layer2VIPConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LinkAliasConfigV1Alpha1 -type ResolverConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -type TCPProbeConfigV1Alpha1 -type TimeSyncConfigV1Alpha1 -type VethConfigV1Alpha1 -type VLANConfigV1Alpha1 -type WireguardConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BGPInstanceConfigV1Alpha1 -type BlackholeRouteConfigV1Alpha1 -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type VRFConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DNATConfigV1Alpha1 -type DummyLinkConfigV1Alpha1 -type EgressRuleConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type GeneveConfigV1Alpha1 -type GREConfigV1Alpha1 -type GRETAPConfigV1Alpha1 -type HCloudVIPConfigV1Alpha1 -type HTTPProbeConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type IPVLANConfigV1Alpha1 -type KubeSpanConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type Layer2VIPConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LinkAliasConfigV1Alpha1 -type MACVLANConfigV1Alpha1 -type NTPServerConfigV1Alpha1 -type ResolverConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SNATConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -type TCPProbeConfigV1Alpha1 -type TimeSyncConfigV1Alpha1 -type VethConfigV1Alpha1 -type VLANConfigV1Alpha1 -type VXLANConfigV1Alpha1 -type WireguardConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -21,6 +21,10 @@ func (o *BGPInstanceConfigV1Alpha1) DeepCopy() *BGPInstanceConfigV1Alpha1 {
 		cp.BGPAdvertise = make([]string, len(o.BGPAdvertise))
 		copy(cp.BGPAdvertise, o.BGPAdvertise)
 	}
+	if o.BGPAdvertiseProbes != nil {
+		cp.BGPAdvertiseProbes = make([]BGPAdvertiseProbe, len(o.BGPAdvertiseProbes))
+		copy(cp.BGPAdvertiseProbes, o.BGPAdvertiseProbes)
+	}
 	if o.BGPMultipath != nil {
 		cp.BGPMultipath = new(bool)
 		*cp.BGPMultipath = *o.BGPMultipath
@@ -47,8 +51,52 @@ func (o *BGPInstanceConfigV1Alpha1) DeepCopy() *BGPInstanceConfigV1Alpha1 {
 				cp.BGPNeighborConfigs[i2].NeighborBFDConfig = new(BGPBFDConfig)
 				*cp.BGPNeighborConfigs[i2].NeighborBFDConfig = *o.BGPNeighborConfigs[i2].NeighborBFDConfig
 			}
+			if o.BGPNeighborConfigs[i2].NeighborImportPolicy != nil {
+				cp.BGPNeighborConfigs[i2].NeighborImportPolicy = new(BGPRoutePolicy)
+				*cp.BGPNeighborConfigs[i2].NeighborImportPolicy = *o.BGPNeighborConfigs[i2].NeighborImportPolicy
+				if o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyPrefixes != nil {
+					cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyPrefixes = make([]BGPPrefixFilter, len(o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyPrefixes))
+					copy(cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyPrefixes, o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyPrefixes)
+				}
+				if o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyMatchCommunities != nil {
+					cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyMatchCommunities = make([]string, len(o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyMatchCommunities))
+					copy(cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyMatchCommunities, o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyMatchCommunities)
+				}
+				if o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddCommunities != nil {
+					cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddCommunities = make([]string, len(o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddCommunities))
+					copy(cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddCommunities, o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddCommunities)
+				}
+				if o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddLargeCommunities != nil {
+					cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddLargeCommunities = make([]string, len(o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddLargeCommunities))
+					copy(cp.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddLargeCommunities, o.BGPNeighborConfigs[i2].NeighborImportPolicy.PolicyAddLargeCommunities)
+				}
+			}
+			if o.BGPNeighborConfigs[i2].NeighborExportPolicy != nil {
+				cp.BGPNeighborConfigs[i2].NeighborExportPolicy = new(BGPRoutePolicy)
+				*cp.BGPNeighborConfigs[i2].NeighborExportPolicy = *o.BGPNeighborConfigs[i2].NeighborExportPolicy
+				if o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyPrefixes != nil {
+					cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyPrefixes = make([]BGPPrefixFilter, len(o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyPrefixes))
+					copy(cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyPrefixes, o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyPrefixes)
+				}
+				if o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyMatchCommunities != nil {
+					cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyMatchCommunities = make([]string, len(o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyMatchCommunities))
+					copy(cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyMatchCommunities, o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyMatchCommunities)
+				}
+				if o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddCommunities != nil {
+					cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddCommunities = make([]string, len(o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddCommunities))
+					copy(cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddCommunities, o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddCommunities)
+				}
+				if o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddLargeCommunities != nil {
+					cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddLargeCommunities = make([]string, len(o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddLargeCommunities))
+					copy(cp.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddLargeCommunities, o.BGPNeighborConfigs[i2].NeighborExportPolicy.PolicyAddLargeCommunities)
+				}
+			}
 		}
 	}
+	if o.BGPGracefulRestart != nil {
+		cp.BGPGracefulRestart = new(BGPGracefulRestartConfig)
+		*cp.BGPGracefulRestart = *o.BGPGracefulRestart
+	}
 	return &cp
 }
 
@@ -311,6 +359,14 @@ func (o *DHCPv4ConfigV1Alpha1) DeepCopy() *DHCPv4ConfigV1Alpha1 {
 		cp.ConfigDUIDRaw = make([]byte, len(o.ConfigDUIDRaw))
 		copy(cp.ConfigDUIDRaw, o.ConfigDUIDRaw)
 	}
+	if o.ConfigRequestOptions != nil {
+		cp.ConfigRequestOptions = make([]int, len(o.ConfigRequestOptions))
+		copy(cp.ConfigRequestOptions, o.ConfigRequestOptions)
+	}
+	if o.ConfigUserClass != nil {
+		cp.ConfigUserClass = make([]string, len(o.ConfigUserClass))
+		copy(cp.ConfigUserClass, o.ConfigUserClass)
+	}
 	return &cp
 }
 
@@ -329,6 +385,28 @@ func (o *DHCPv6ConfigV1Alpha1) DeepCopy() *DHCPv6ConfigV1Alpha1 {
 		cp.ConfigDUIDRaw = make([]byte, len(o.ConfigDUIDRaw))
 		copy(cp.ConfigDUIDRaw, o.ConfigDUIDRaw)
 	}
+	if o.ConfigPrefixDelegation != nil {
+		cp.ConfigPrefixDelegation = new(DHCPv6PrefixDelegationConfig)
+		*cp.ConfigPrefixDelegation = *o.ConfigPrefixDelegation
+		if o.ConfigPrefixDelegation.ConfigDownstreams != nil {
+			cp.ConfigPrefixDelegation.ConfigDownstreams = make([]DHCPv6PrefixDelegationDownstream, len(o.ConfigPrefixDelegation.ConfigDownstreams))
+			copy(cp.ConfigPrefixDelegation.ConfigDownstreams, o.ConfigPrefixDelegation.ConfigDownstreams)
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *DNATConfigV1Alpha1.
+func (o *DNATConfigV1Alpha1) DeepCopy() *DNATConfigV1Alpha1 {
+	var cp DNATConfigV1Alpha1 = *o
+	if o.PortSelector.Ports != nil {
+		cp.PortSelector.Ports = make([]PortRange, len(o.PortSelector.Ports))
+		copy(cp.PortSelector.Ports, o.PortSelector.Ports)
+	}
+	if o.Ingress != nil {
+		cp.Ingress = make([]IngressRule, len(o.Ingress))
+		copy(cp.Ingress, o.Ingress)
+	}
 	return &cp
 }
 
@@ -364,6 +442,20 @@ func (o *DummyLinkConfigV1Alpha1) DeepCopy() *DummyLinkConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *EgressRuleConfigV1Alpha1.
+func (o *EgressRuleConfigV1Alpha1) DeepCopy() *EgressRuleConfigV1Alpha1 {
+	var cp EgressRuleConfigV1Alpha1 = *o
+	if o.PortSelector.Ports != nil {
+		cp.PortSelector.Ports = make([]PortRange, len(o.PortSelector.Ports))
+		copy(cp.PortSelector.Ports, o.PortSelector.Ports)
+	}
+	if o.Egress != nil {
+		cp.Egress = make([]EgressRule, len(o.Egress))
+		copy(cp.Egress, o.Egress)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *EthernetConfigV1Alpha1.
 func (o *EthernetConfigV1Alpha1) DeepCopy() *EthernetConfigV1Alpha1 {
 	var cp EthernetConfigV1Alpha1 = *o
@@ -444,6 +536,98 @@ func (o *EthernetConfigV1Alpha1) DeepCopy() *EthernetConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *GeneveConfigV1Alpha1.
+func (o *GeneveConfigV1Alpha1) DeepCopy() *GeneveConfigV1Alpha1 {
+	var cp GeneveConfigV1Alpha1 = *o
+	if o.CommonLinkConfig.LinkUp != nil {
+		cp.CommonLinkConfig.LinkUp = new(bool)
+		*cp.CommonLinkConfig.LinkUp = *o.CommonLinkConfig.LinkUp
+	}
+	if o.CommonLinkConfig.LinkAddresses != nil {
+		cp.CommonLinkConfig.LinkAddresses = make([]AddressConfig, len(o.CommonLinkConfig.LinkAddresses))
+		copy(cp.CommonLinkConfig.LinkAddresses, o.CommonLinkConfig.LinkAddresses)
+		for i3 := range o.CommonLinkConfig.LinkAddresses {
+			if o.CommonLinkConfig.LinkAddresses[i3].AddressPriority != nil {
+				cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = new(uint32)
+				*cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = *o.CommonLinkConfig.LinkAddresses[i3].AddressPriority
+			}
+		}
+	}
+	if o.CommonLinkConfig.LinkRoutes != nil {
+		cp.CommonLinkConfig.LinkRoutes = make([]RouteConfig, len(o.CommonLinkConfig.LinkRoutes))
+		copy(cp.CommonLinkConfig.LinkRoutes, o.CommonLinkConfig.LinkRoutes)
+	}
+	if o.CommonLinkConfig.LinkMulticast != nil {
+		cp.CommonLinkConfig.LinkMulticast = new(bool)
+		*cp.CommonLinkConfig.LinkMulticast = *o.CommonLinkConfig.LinkMulticast
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *GREConfigV1Alpha1.
+func (o *GREConfigV1Alpha1) DeepCopy() *GREConfigV1Alpha1 {
+	var cp GREConfigV1Alpha1 = *o
+	if o.GRETunnelConfig.GREKey != nil {
+		cp.GRETunnelConfig.GREKey = new(uint32)
+		*cp.GRETunnelConfig.GREKey = *o.GRETunnelConfig.GREKey
+	}
+	if o.CommonLinkConfig.LinkUp != nil {
+		cp.CommonLinkConfig.LinkUp = new(bool)
+		*cp.CommonLinkConfig.LinkUp = *o.CommonLinkConfig.LinkUp
+	}
+	if o.CommonLinkConfig.LinkAddresses != nil {
+		cp.CommonLinkConfig.LinkAddresses = make([]AddressConfig, len(o.CommonLinkConfig.LinkAddresses))
+		copy(cp.CommonLinkConfig.LinkAddresses, o.CommonLinkConfig.LinkAddresses)
+		for i3 := range o.CommonLinkConfig.LinkAddresses {
+			if o.CommonLinkConfig.LinkAddresses[i3].AddressPriority != nil {
+				cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = new(uint32)
+				*cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = *o.CommonLinkConfig.LinkAddresses[i3].AddressPriority
+			}
+		}
+	}
+	if o.CommonLinkConfig.LinkRoutes != nil {
+		cp.CommonLinkConfig.LinkRoutes = make([]RouteConfig, len(o.CommonLinkConfig.LinkRoutes))
+		copy(cp.CommonLinkConfig.LinkRoutes, o.CommonLinkConfig.LinkRoutes)
+	}
+	if o.CommonLinkConfig.LinkMulticast != nil {
+		cp.CommonLinkConfig.LinkMulticast = new(bool)
+		*cp.CommonLinkConfig.LinkMulticast = *o.CommonLinkConfig.LinkMulticast
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *GRETAPConfigV1Alpha1.
+func (o *GRETAPConfigV1Alpha1) DeepCopy() *GRETAPConfigV1Alpha1 {
+	var cp GRETAPConfigV1Alpha1 = *o
+	if o.GRETunnelConfig.GREKey != nil {
+		cp.GRETunnelConfig.GREKey = new(uint32)
+		*cp.GRETunnelConfig.GREKey = *o.GRETunnelConfig.GREKey
+	}
+	if o.CommonLinkConfig.LinkUp != nil {
+		cp.CommonLinkConfig.LinkUp = new(bool)
+		*cp.CommonLinkConfig.LinkUp = *o.CommonLinkConfig.LinkUp
+	}
+	if o.CommonLinkConfig.LinkAddresses != nil {
+		cp.CommonLinkConfig.LinkAddresses = make([]AddressConfig, len(o.CommonLinkConfig.LinkAddresses))
+		copy(cp.CommonLinkConfig.LinkAddresses, o.CommonLinkConfig.LinkAddresses)
+		for i3 := range o.CommonLinkConfig.LinkAddresses {
+			if o.CommonLinkConfig.LinkAddresses[i3].AddressPriority != nil {
+				cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = new(uint32)
+				*cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = *o.CommonLinkConfig.LinkAddresses[i3].AddressPriority
+			}
+		}
+	}
+	if o.CommonLinkConfig.LinkRoutes != nil {
+		cp.CommonLinkConfig.LinkRoutes = make([]RouteConfig, len(o.CommonLinkConfig.LinkRoutes))
+		copy(cp.CommonLinkConfig.LinkRoutes, o.CommonLinkConfig.LinkRoutes)
+	}
+	if o.CommonLinkConfig.LinkMulticast != nil {
+		cp.CommonLinkConfig.LinkMulticast = new(bool)
+		*cp.CommonLinkConfig.LinkMulticast = *o.CommonLinkConfig.LinkMulticast
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *HCloudVIPConfigV1Alpha1.
 func (o *HCloudVIPConfigV1Alpha1) DeepCopy() *HCloudVIPConfigV1Alpha1 {
 	var cp HCloudVIPConfigV1Alpha1 = *o
@@ -474,6 +658,38 @@ func (o *HostnameConfigV1Alpha1) DeepCopy() *HostnameConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *IPVLANConfigV1Alpha1.
+func (o *IPVLANConfigV1Alpha1) DeepCopy() *IPVLANConfigV1Alpha1 {
+	var cp IPVLANConfigV1Alpha1 = *o
+	if o.IPVLANModeConfig != nil {
+		cp.IPVLANModeConfig = new(nethelpers.IPVLANMode)
+		*cp.IPVLANModeConfig = *o.IPVLANModeConfig
+	}
+	if o.CommonLinkConfig.LinkUp != nil {
+		cp.CommonLinkConfig.LinkUp = new(bool)
+		*cp.CommonLinkConfig.LinkUp = *o.CommonLinkConfig.LinkUp
+	}
+	if o.CommonLinkConfig.LinkAddresses != nil {
+		cp.CommonLinkConfig.LinkAddresses = make([]AddressConfig, len(o.CommonLinkConfig.LinkAddresses))
+		copy(cp.CommonLinkConfig.LinkAddresses, o.CommonLinkConfig.LinkAddresses)
+		for i3 := range o.CommonLinkConfig.LinkAddresses {
+			if o.CommonLinkConfig.LinkAddresses[i3].AddressPriority != nil {
+				cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = new(uint32)
+				*cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = *o.CommonLinkConfig.LinkAddresses[i3].AddressPriority
+			}
+		}
+	}
+	if o.CommonLinkConfig.LinkRoutes != nil {
+		cp.CommonLinkConfig.LinkRoutes = make([]RouteConfig, len(o.CommonLinkConfig.LinkRoutes))
+		copy(cp.CommonLinkConfig.LinkRoutes, o.CommonLinkConfig.LinkRoutes)
+	}
+	if o.CommonLinkConfig.LinkMulticast != nil {
+		cp.CommonLinkConfig.LinkMulticast = new(bool)
+		*cp.CommonLinkConfig.LinkMulticast = *o.CommonLinkConfig.LinkMulticast
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *KubeSpanConfigV1Alpha1.
 func (o *KubeSpanConfigV1Alpha1) DeepCopy() *KubeSpanConfigV1Alpha1 {
 	var cp KubeSpanConfigV1Alpha1 = *o
@@ -509,6 +725,14 @@ func (o *KubeSpanConfigV1Alpha1) DeepCopy() *KubeSpanConfigV1Alpha1 {
 			copy(cp.ConfigFilters.ConfigExcludeAdvertisedNetworks, o.ConfigFilters.ConfigExcludeAdvertisedNetworks)
 		}
 	}
+	if o.ConfigRelay != nil {
+		cp.ConfigRelay = new(bool)
+		*cp.ConfigRelay = *o.ConfigRelay
+	}
+	if o.ConfigPerPeerPresharedKeys != nil {
+		cp.ConfigPerPeerPresharedKeys = new(bool)
+		*cp.ConfigPerPeerPresharedKeys = *o.ConfigPerPeerPresharedKeys
+	}
 	return &cp
 }
 
@@ -562,6 +786,56 @@ func (o *LinkAliasConfigV1Alpha1) DeepCopy() *LinkAliasConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *MACVLANConfigV1Alpha1.
+func (o *MACVLANConfigV1Alpha1) DeepCopy() *MACVLANConfigV1Alpha1 {
+	var cp MACVLANConfigV1Alpha1 = *o
+	if o.MACVLANModeConfig != nil {
+		cp.MACVLANModeConfig = new(nethelpers.MACVLANMode)
+		*cp.MACVLANModeConfig = *o.MACVLANModeConfig
+	}
+	if o.HardwareAddressConfig != nil {
+		cp.HardwareAddressConfig = make([]byte, len(o.HardwareAddressConfig))
+		copy(cp.HardwareAddressConfig, o.HardwareAddressConfig)
+	}
+	if o.CommonLinkConfig.LinkUp != nil {
+		cp.CommonLinkConfig.LinkUp = new(bool)
+		*cp.CommonLinkConfig.LinkUp = *o.CommonLinkConfig.LinkUp
+	}
+	if o.CommonLinkConfig.LinkAddresses != nil {
+		cp.CommonLinkConfig.LinkAddresses = make([]AddressConfig, len(o.CommonLinkConfig.LinkAddresses))
+		copy(cp.CommonLinkConfig.LinkAddresses, o.CommonLinkConfig.LinkAddresses)
+		for i3 := range o.CommonLinkConfig.LinkAddresses {
+			if o.CommonLinkConfig.LinkAddresses[i3].AddressPriority != nil {
+				cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = new(uint32)
+				*cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = *o.CommonLinkConfig.LinkAddresses[i3].AddressPriority
+			}
+		}
+	}
+	if o.CommonLinkConfig.LinkRoutes != nil {
+		cp.CommonLinkConfig.LinkRoutes = make([]RouteConfig, len(o.CommonLinkConfig.LinkRoutes))
+		copy(cp.CommonLinkConfig.LinkRoutes, o.CommonLinkConfig.LinkRoutes)
+	}
+	if o.CommonLinkConfig.LinkMulticast != nil {
+		cp.CommonLinkConfig.LinkMulticast = new(bool)
+		*cp.CommonLinkConfig.LinkMulticast = *o.CommonLinkConfig.LinkMulticast
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *NTPServerConfigV1Alpha1.
+func (o *NTPServerConfigV1Alpha1) DeepCopy() *NTPServerConfigV1Alpha1 {
+	var cp NTPServerConfigV1Alpha1 = *o
+	if o.NTPNTS != nil {
+		cp.NTPNTS = new(NTPServerNTSConfig)
+		*cp.NTPNTS = *o.NTPNTS
+		if o.NTPNTS.NTSServerIdentity != nil {
+			cp.NTPNTS.NTSServerIdentity = new(meta.CertificateAndKey)
+			*cp.NTPNTS.NTSServerIdentity = *o.NTPNTS.NTSServerIdentity
+		}
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *ResolverConfigV1Alpha1.
 func (o *ResolverConfigV1Alpha1) DeepCopy() *ResolverConfigV1Alpha1 {
 	var cp ResolverConfigV1Alpha1 = *o
@@ -589,6 +863,28 @@ func (o *ResolverConfigV1Alpha1) DeepCopy() *ResolverConfigV1Alpha1 {
 		cp.ResolverHostDNS.HostDNSResolveMemberNames = new(bool)
 		*cp.ResolverHostDNS.HostDNSResolveMemberNames = *o.ResolverHostDNS.HostDNSResolveMemberNames
 	}
+	if o.ResolverHostDNS.HostDNSForwarders != nil {
+		cp.ResolverHostDNS.HostDNSForwarders = make([]HostDNSForwarderConfig, len(o.ResolverHostDNS.HostDNSForwarders))
+		copy(cp.ResolverHostDNS.HostDNSForwarders, o.ResolverHostDNS.HostDNSForwarders)
+		for i3 := range o.ResolverHostDNS.HostDNSForwarders {
+			if o.ResolverHostDNS.HostDNSForwarders[i3].ForwarderDomains != nil {
+				cp.ResolverHostDNS.HostDNSForwarders[i3].ForwarderDomains = make([]string, len(o.ResolverHostDNS.HostDNSForwarders[i3].ForwarderDomains))
+				copy(cp.ResolverHostDNS.HostDNSForwarders[i3].ForwarderDomains, o.ResolverHostDNS.HostDNSForwarders[i3].ForwarderDomains)
+			}
+			if o.ResolverHostDNS.HostDNSForwarders[i3].ForwarderNameservers != nil {
+				cp.ResolverHostDNS.HostDNSForwarders[i3].ForwarderNameservers = make([]NameserverConfig, len(o.ResolverHostDNS.HostDNSForwarders[i3].ForwarderNameservers))
+				copy(cp.ResolverHostDNS.HostDNSForwarders[i3].ForwarderNameservers, o.ResolverHostDNS.HostDNSForwarders[i3].ForwarderNameservers)
+			}
+		}
+	}
+	if o.ResolverHostDNS.HostDNSQueryLog != nil {
+		cp.ResolverHostDNS.HostDNSQueryLog = new(bool)
+		*cp.ResolverHostDNS.HostDNSQueryLog = *o.ResolverHostDNS.HostDNSQueryLog
+	}
+	if o.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheSize != nil {
+		cp.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheSize = new(int)
+		*cp.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheSize = *o.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheSize
+	}
 	return &cp
 }
 
@@ -612,6 +908,16 @@ func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *SNATConfigV1Alpha1.
+func (o *SNATConfigV1Alpha1) DeepCopy() *SNATConfigV1Alpha1 {
+	var cp SNATConfigV1Alpha1 = *o
+	if o.SNATSourceSubnets != nil {
+		cp.SNATSourceSubnets = make([]meta.Prefix, len(o.SNATSourceSubnets))
+		copy(cp.SNATSourceSubnets, o.SNATSourceSubnets)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *StaticHostConfigV1Alpha1.
 func (o *StaticHostConfigV1Alpha1) DeepCopy() *StaticHostConfigV1Alpha1 {
 	var cp StaticHostConfigV1Alpha1 = *o
@@ -740,6 +1046,34 @@ func (o *VLANConfigV1Alpha1) DeepCopy() *VLANConfigV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *VXLANConfigV1Alpha1.
+func (o *VXLANConfigV1Alpha1) DeepCopy() *VXLANConfigV1Alpha1 {
+	var cp VXLANConfigV1Alpha1 = *o
+	if o.CommonLinkConfig.LinkUp != nil {
+		cp.CommonLinkConfig.LinkUp = new(bool)
+		*cp.CommonLinkConfig.LinkUp = *o.CommonLinkConfig.LinkUp
+	}
+	if o.CommonLinkConfig.LinkAddresses != nil {
+		cp.CommonLinkConfig.LinkAddresses = make([]AddressConfig, len(o.CommonLinkConfig.LinkAddresses))
+		copy(cp.CommonLinkConfig.LinkAddresses, o.CommonLinkConfig.LinkAddresses)
+		for i3 := range o.CommonLinkConfig.LinkAddresses {
+			if o.CommonLinkConfig.LinkAddresses[i3].AddressPriority != nil {
+				cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = new(uint32)
+				*cp.CommonLinkConfig.LinkAddresses[i3].AddressPriority = *o.CommonLinkConfig.LinkAddresses[i3].AddressPriority
+			}
+		}
+	}
+	if o.CommonLinkConfig.LinkRoutes != nil {
+		cp.CommonLinkConfig.LinkRoutes = make([]RouteConfig, len(o.CommonLinkConfig.LinkRoutes))
+		copy(cp.CommonLinkConfig.LinkRoutes, o.CommonLinkConfig.LinkRoutes)
+	}
+	if o.CommonLinkConfig.LinkMulticast != nil {
+		cp.CommonLinkConfig.LinkMulticast = new(bool)
+		*cp.CommonLinkConfig.LinkMulticast = *o.CommonLinkConfig.LinkMulticast
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *WireguardConfigV1Alpha1.
 func (o *WireguardConfigV1Alpha1) DeepCopy() *WireguardConfigV1Alpha1 {
 	var cp WireguardConfigV1Alpha1 = *o
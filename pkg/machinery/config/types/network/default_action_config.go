@@ -29,11 +29,12 @@ func init() {
 
 // Check interfaces.
 var (
-	_ config.NetworkRuleConfigDefaultAction = &DefaultActionConfigV1Alpha1{}
-	_ config.NetworkRuleConfigSignal        = &DefaultActionConfigV1Alpha1{}
+	_ config.NetworkRuleConfigDefaultAction       = &DefaultActionConfigV1Alpha1{}
+	_ config.NetworkRuleConfigEgressDefaultAction = &DefaultActionConfigV1Alpha1{}
+	_ config.NetworkRuleConfigSignal              = &DefaultActionConfigV1Alpha1{}
 )
 
-// DefaultActionConfigV1Alpha1 is a ingress firewall default action configuration document.
+// DefaultActionConfigV1Alpha1 is a firewall default action configuration document.
 //
 //	examples:
 //	  - value: exampleDefaultActionConfigV1Alpha1()
@@ -49,6 +50,14 @@ type DefaultActionConfigV1Alpha1 struct {
 	//     - "accept"
 	//     - "block"
 	Ingress nethelpers.DefaultAction `yaml:"ingress"`
+	//   description: |
+	//     Default action for all not explicitly configured egress traffic originating from the host: accept or block.
+	//
+	//     Egress rules are configured with `NetworkEgressRuleConfig` documents.
+	//   values:
+	//     - "accept"
+	//     - "block"
+	Egress nethelpers.DefaultAction `yaml:"egress,omitempty"`
 }
 
 // NewDefaultActionConfigV1Alpha1 creates a new DefaultActionConfig config document.
@@ -80,3 +89,8 @@ func (s *DefaultActionConfigV1Alpha1) NetworkRuleConfigSignal() {}
 func (s *DefaultActionConfigV1Alpha1) DefaultAction() nethelpers.DefaultAction {
 	return s.Ingress
 }
+
+// EgressDefaultAction implements config.NetworkRuleConfigEgressDefaultAction interface.
+func (s *DefaultActionConfigV1Alpha1) EgressDefaultAction() nethelpers.DefaultAction {
+	return s.Egress
+}
@@ -26,6 +26,7 @@ func TestDefaultActionConfigMarshalStability(t *testing.T) {
 
 	cfg := network.NewDefaultActionConfigV1Alpha1()
 	cfg.Ingress = nethelpers.DefaultActionBlock
+	cfg.Egress = nethelpers.DefaultActionBlock
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
@@ -50,5 +51,6 @@ func TestDefaultActionConfigUnmarshal(t *testing.T) {
 			MetaKind:       network.DefaultActionConfig,
 		},
 		Ingress: nethelpers.DefaultActionBlock,
+		Egress:  nethelpers.DefaultActionBlock,
 	}, docs[0])
 }
@@ -9,8 +9,10 @@ package network
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -93,6 +95,29 @@ type DHCPv4ConfigV1Alpha1 struct {
 	//     type: string
 	//     pattern: ^([0-9a-fA-F]{2}(:[0-9a-fA-F]{2})+)$
 	ConfigDUIDRaw nethelpers.HardwareAddr `yaml:"duidRaw,omitempty"`
+	//   description: |
+	//     Additional DHCP option codes to request from the DHCP server.
+	//
+	//     Raw values of all options received from the DHCP server are published
+	//     in the `DHCPOptions` resource.
+	//     Option 249 (Microsoft classless static routes) is used as a fallback for option 121
+	//     if it is requested and the server doesn't send option 121.
+	//   examples:
+	//    - value: >
+	//       []int{43, 224, 249}
+	ConfigRequestOptions []int `yaml:"requestOptions,omitempty"`
+	//   description: |
+	//     Vendor class identifier (option 60) to send to the DHCP server.
+	//   examples:
+	//    - value: >
+	//       "PXEClient"
+	ConfigVendorClass string `yaml:"vendorClass,omitempty"`
+	//   description: |
+	//     User class (option 77) values to send to the DHCP server.
+	//   examples:
+	//    - value: >
+	//       []string{"provisioning"}
+	ConfigUserClass []string `yaml:"userClass,omitempty"`
 }
 
 // NewDHCPv4ConfigV1Alpha1 creates a new DHCPv4Config config document.
@@ -174,6 +199,27 @@ func (s *DHCPv4ConfigV1Alpha1) DUIDRaw() optional.Optional[nethelpers.HardwareAd
 	return optional.Some(s.ConfigDUIDRaw)
 }
 
+// RequestOptions returns the additional DHCP option codes to request.
+func (s *DHCPv4ConfigV1Alpha1) RequestOptions() []uint8 {
+	return xslices.Map(s.ConfigRequestOptions, func(code int) uint8 {
+		return uint8(code)
+	})
+}
+
+// VendorClass returns the vendor class identifier.
+func (s *DHCPv4ConfigV1Alpha1) VendorClass() optional.Optional[string] {
+	if s.ConfigVendorClass == "" {
+		return optional.None[string]()
+	}
+
+	return optional.Some(s.ConfigVendorClass)
+}
+
+// UserClass returns the user class values.
+func (s *DHCPv4ConfigV1Alpha1) UserClass() []string {
+	return s.ConfigUserClass
+}
+
 // Validate implements config.Validator interface.
 func (s *DHCPv4ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	var (
@@ -193,5 +239,22 @@ func (s *DHCPv4ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Op
 		errs = errors.Join(errs, errors.New("duidRaw must be set if clientIdentifier is 'duid'"))
 	}
 
+	for _, code := range s.ConfigRequestOptions {
+		// 0 (pad) and 255 (end) are not real options
+		if code < 1 || code > 254 {
+			errs = errors.Join(errs, fmt.Errorf("DHCP option code %d is out of range (1-254)", code))
+		}
+	}
+
+	if len(s.ConfigVendorClass) > 255 {
+		errs = errors.Join(errs, errors.New("vendorClass must be at most 255 bytes long"))
+	}
+
+	for _, class := range s.ConfigUserClass {
+		if class == "" || len(class) > 255 {
+			errs = errors.Join(errs, fmt.Errorf("userClass %q must be 1-255 bytes long", class))
+		}
+	}
+
 	return warnings, errs
 }
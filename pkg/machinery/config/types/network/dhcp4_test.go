@@ -31,6 +31,9 @@ func TestDHCPv4ConfigMarshalStability(t *testing.T) {
 	cfg.ConfigIgnoreRoutes = new(true)
 	cfg.ConfigClientIdentifier = new(nethelpers.ClientIdentifierDUID)
 	cfg.ConfigDUIDRaw = nethelpers.HardwareAddr{0x00, 0x01, 0x00, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45}
+	cfg.ConfigRequestOptions = []int{43, 224}
+	cfg.ConfigVendorClass = "PXEClient"
+	cfg.ConfigUserClass = []string{"provisioning"}
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
@@ -60,6 +63,9 @@ func TestDHCPv4ConfigUnmarshal(t *testing.T) {
 		ConfigIgnoreRoutes:     new(true),
 		ConfigClientIdentifier: new(nethelpers.ClientIdentifierDUID),
 		ConfigDUIDRaw:          nethelpers.HardwareAddr{0x00, 0x01, 0x00, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45},
+		ConfigRequestOptions:   []int{43, 224},
+		ConfigVendorClass:      "PXEClient",
+		ConfigUserClass:        []string{"provisioning"},
 	}, docs[0])
 }
 
@@ -102,6 +108,28 @@ func TestDHCPv4ConfigValidate(t *testing.T) {
 			},
 			expectedError: "duidRaw can only be set if clientIdentifier is 'duid'",
 		},
+		{
+			name: "valid config with option passthrough",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				c := network.NewDHCPv4ConfigV1Alpha1("enp0s3")
+				c.ConfigRequestOptions = []int{43, 224, 249}
+				c.ConfigVendorClass = "PXEClient"
+				c.ConfigUserClass = []string{"provisioning", "rack-a12"}
+
+				return c
+			},
+		},
+		{
+			name: "invalid option codes and user class",
+			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
+				c := network.NewDHCPv4ConfigV1Alpha1("enp0s3")
+				c.ConfigRequestOptions = []int{0, 43, 255}
+				c.ConfigUserClass = []string{""}
+
+				return c
+			},
+			expectedError: "DHCP option code 0 is out of range (1-254)\nDHCP option code 255 is out of range (1-254)\nuserClass \"\" must be 1-255 bytes long",
+		},
 		{
 			name: "empty",
 			cfg: func() *network.DHCPv4ConfigV1Alpha1 {
@@ -9,8 +9,11 @@ package network
 
 import (
 	"errors"
+	"fmt"
+	"math/bits"
 
 	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -39,6 +42,9 @@ var (
 	_ config.NetworkDHCPv6Config = &DHCPv6ConfigV1Alpha1{}
 	_ config.NamedDocument       = &DHCPv6ConfigV1Alpha1{}
 	_ config.Validator           = &DHCPv6ConfigV1Alpha1{}
+
+	_ config.NetworkDHCPv6PrefixDelegation           = &DHCPv6PrefixDelegationConfig{}
+	_ config.NetworkDHCPv6PrefixDelegationDownstream = DHCPv6PrefixDelegationDownstream{}
 )
 
 // DHCPv6ConfigV1Alpha1 is a config document to configure DHCPv6 on a network link.
@@ -88,6 +94,53 @@ type DHCPv6ConfigV1Alpha1 struct {
 	//     type: string
 	//     pattern: ^([0-9a-fA-F]{2}(:[0-9a-fA-F]{2})+)$
 	ConfigDUIDRaw nethelpers.HardwareAddr `yaml:"duidRaw,omitempty"`
+	//   description: |
+	//     DHCPv6 prefix delegation (DHCPv6-PD) configuration.
+	//
+	//     If set, a prefix is requested from the DHCPv6 server on this link, and /64 subnets
+	//     of the delegated prefix are assigned to the downstream links.
+	//     Router advertisements are sent on the downstream links, so that hosts can configure
+	//     addresses with SLAAC.
+	//   examples:
+	//    - value: exampleDHCPv6PrefixDelegationConfig()
+	ConfigPrefixDelegation *DHCPv6PrefixDelegationConfig `yaml:"prefixDelegation,omitempty"`
+}
+
+// DHCPv6PrefixDelegationConfig is the DHCPv6 prefix delegation configuration.
+type DHCPv6PrefixDelegationConfig struct {
+	//   description: |
+	//     Prefix length to request from the DHCPv6 server.
+	//
+	//     This is a hint, the server might delegate a prefix of a different length.
+	//   examples:
+	//    - value: 56
+	//   schema:
+	//     type: integer
+	//     minimum: 1
+	//     maximum: 64
+	ConfigPrefixLength int `yaml:"prefixLength,omitempty"`
+	//   description: |
+	//     Downstream links to assign /64 subnets of the delegated prefix to.
+	ConfigDownstreams []DHCPv6PrefixDelegationDownstream `yaml:"downstreams,omitempty"`
+}
+
+// DHCPv6PrefixDelegationDownstream is a downstream link of the delegated prefix.
+type DHCPv6PrefixDelegationDownstream struct {
+	//   description: |
+	//     Name or alias of the downstream link.
+	//   examples:
+	//    - value: >
+	//       "br0"
+	//   schemaRequired: true
+	ConfigLink string `yaml:"link"`
+	//   description: |
+	//     Index of the /64 subnet of the delegated prefix to assign to the link.
+	//
+	//     E.g. for the delegated prefix 2001:db8:0:ab00::/56 and subnet ID 1,
+	//     the link gets 2001:db8:0:ab01::/64.
+	//   examples:
+	//    - value: 1
+	ConfigSubnetID uint32 `yaml:"subnetID"`
 }
 
 // NewDHCPv6ConfigV1Alpha1 creates a new DHCPv6Config config document.
@@ -107,6 +160,18 @@ func exampleDHCPv6ConfigV1Alpha1() *DHCPv6ConfigV1Alpha1 {
 	return cfg
 }
 
+func exampleDHCPv6PrefixDelegationConfig() *DHCPv6PrefixDelegationConfig {
+	return &DHCPv6PrefixDelegationConfig{
+		ConfigPrefixLength: 56,
+		ConfigDownstreams: []DHCPv6PrefixDelegationDownstream{
+			{
+				ConfigLink:     "br0",
+				ConfigSubnetID: 1,
+			},
+		},
+	}
+}
+
 // Clone implements config.Document interface.
 func (s *DHCPv6ConfigV1Alpha1) Clone() config.Document {
 	return s.DeepCopy()
@@ -159,6 +224,37 @@ func (s *DHCPv6ConfigV1Alpha1) DUIDRaw() optional.Optional[nethelpers.HardwareAd
 	return optional.Some(s.ConfigDUIDRaw)
 }
 
+// PrefixDelegation implements config.NetworkDHCPv6Config interface.
+func (s *DHCPv6ConfigV1Alpha1) PrefixDelegation() config.NetworkDHCPv6PrefixDelegation {
+	if s.ConfigPrefixDelegation == nil {
+		return nil
+	}
+
+	return s.ConfigPrefixDelegation
+}
+
+// PrefixLength implements config.NetworkDHCPv6PrefixDelegation interface.
+func (s *DHCPv6PrefixDelegationConfig) PrefixLength() int {
+	return s.ConfigPrefixLength
+}
+
+// Downstreams implements config.NetworkDHCPv6PrefixDelegation interface.
+func (s *DHCPv6PrefixDelegationConfig) Downstreams() []config.NetworkDHCPv6PrefixDelegationDownstream {
+	return xslices.Map(s.ConfigDownstreams, func(d DHCPv6PrefixDelegationDownstream) config.NetworkDHCPv6PrefixDelegationDownstream {
+		return d
+	})
+}
+
+// Link implements config.NetworkDHCPv6PrefixDelegationDownstream interface.
+func (d DHCPv6PrefixDelegationDownstream) Link() string {
+	return d.ConfigLink
+}
+
+// SubnetID implements config.NetworkDHCPv6PrefixDelegationDownstream interface.
+func (d DHCPv6PrefixDelegationDownstream) SubnetID() uint32 {
+	return d.ConfigSubnetID
+}
+
 // Validate implements config.Validator interface.
 func (s *DHCPv6ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	var (
@@ -178,5 +274,45 @@ func (s *DHCPv6ConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Op
 		errs = errors.Join(errs, errors.New("duidRaw must be set if clientIdentifier is 'duid'"))
 	}
 
+	if s.ConfigPrefixDelegation != nil {
+		errs = errors.Join(errs, s.ConfigPrefixDelegation.validate())
+	}
+
 	return warnings, errs
 }
+
+func (s *DHCPv6PrefixDelegationConfig) validate() error {
+	var errs error
+
+	if s.ConfigPrefixLength < 0 || s.ConfigPrefixLength > 64 {
+		errs = errors.Join(errs, fmt.Errorf("prefix delegation prefix length %d is out of range (1-64)", s.ConfigPrefixLength))
+	}
+
+	links := map[string]struct{}{}
+	subnetIDs := map[uint32]struct{}{}
+
+	for _, downstream := range s.ConfigDownstreams {
+		if downstream.ConfigLink == "" {
+			errs = errors.Join(errs, errors.New("prefix delegation downstream link must be specified"))
+
+			continue
+		}
+
+		if _, ok := links[downstream.ConfigLink]; ok {
+			errs = errors.Join(errs, fmt.Errorf("prefix delegation downstream link %q is duplicated", downstream.ConfigLink))
+		}
+
+		if _, ok := subnetIDs[downstream.ConfigSubnetID]; ok {
+			errs = errors.Join(errs, fmt.Errorf("prefix delegation subnet ID %d is duplicated", downstream.ConfigSubnetID))
+		}
+
+		if s.ConfigPrefixLength > 0 && bits.Len32(downstream.ConfigSubnetID) > 64-s.ConfigPrefixLength {
+			errs = errors.Join(errs, fmt.Errorf("prefix delegation subnet ID %d doesn't fit into /%d prefix", downstream.ConfigSubnetID, s.ConfigPrefixLength))
+		}
+
+		links[downstream.ConfigLink] = struct{}{}
+		subnetIDs[downstream.ConfigSubnetID] = struct{}{}
+	}
+
+	return errs
+}
@@ -27,6 +27,15 @@ func TestDHCPv6ConfigMarshalStability(t *testing.T) {
 
 	cfg := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
 	cfg.ConfigClientIdentifier = new(nethelpers.ClientIdentifierMAC)
+	cfg.ConfigPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+		ConfigPrefixLength: 56,
+		ConfigDownstreams: []network.DHCPv6PrefixDelegationDownstream{
+			{
+				ConfigLink:     "br0",
+				ConfigSubnetID: 1,
+			},
+		},
+	}
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
@@ -52,6 +61,15 @@ func TestDHCPv6ConfigUnmarshal(t *testing.T) {
 		},
 		MetaName:               "enp0s3",
 		ConfigClientIdentifier: new(nethelpers.ClientIdentifierMAC),
+		ConfigPrefixDelegation: &network.DHCPv6PrefixDelegationConfig{
+			ConfigPrefixLength: 56,
+			ConfigDownstreams: []network.DHCPv6PrefixDelegationDownstream{
+				{
+					ConfigLink:     "br0",
+					ConfigSubnetID: 1,
+				},
+			},
+		},
 	}, docs[0])
 }
 
@@ -94,6 +112,54 @@ func TestDHCPv6ConfigValidate(t *testing.T) {
 			},
 			expectedError: "duidRaw can only be set if clientIdentifier is 'duid'",
 		},
+		{
+			name: "valid prefix delegation",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				c := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
+				c.ConfigPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+					ConfigPrefixLength: 60,
+					ConfigDownstreams: []network.DHCPv6PrefixDelegationDownstream{
+						{ConfigLink: "br0", ConfigSubnetID: 0},
+						{ConfigLink: "br0.10", ConfigSubnetID: 15},
+					},
+				}
+
+				return c
+			},
+		},
+		{
+			name: "invalid prefix delegation",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				c := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
+				c.ConfigPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+					ConfigPrefixLength: 60,
+					ConfigDownstreams: []network.DHCPv6PrefixDelegationDownstream{
+						{ConfigLink: "br0", ConfigSubnetID: 1},
+						{ConfigLink: "br0", ConfigSubnetID: 16},
+						{ConfigLink: "br1", ConfigSubnetID: 1},
+						{},
+					},
+				}
+
+				return c
+			},
+			expectedError: "prefix delegation downstream link \"br0\" is duplicated\n" +
+				"prefix delegation subnet ID 16 doesn't fit into /60 prefix\n" +
+				"prefix delegation subnet ID 1 is duplicated\n" +
+				"prefix delegation downstream link must be specified",
+		},
+		{
+			name: "prefix delegation prefix length out of range",
+			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
+				c := network.NewDHCPv6ConfigV1Alpha1("enp0s3")
+				c.ConfigPrefixDelegation = &network.DHCPv6PrefixDelegationConfig{
+					ConfigPrefixLength: 72,
+				}
+
+				return c
+			},
+			expectedError: "prefix delegation prefix length 72 is out of range (1-64)",
+		},
 		{
 			name: "empty",
 			cfg: func() *network.DHCPv6ConfigV1Alpha1 {
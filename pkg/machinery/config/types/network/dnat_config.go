@@ -0,0 +1,233 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/value"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// DNATConfigKind is a destination NAT config document kind.
+const DNATConfigKind = "NetworkDNATConfig"
+
+func init() {
+	registry.Register(DNATConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &DNATConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRuleConfigDestinationNATRules = &DNATConfigV1Alpha1{}
+	_ config.NetworkDestinationNATRule            = &DNATConfigV1Alpha1{}
+	_ config.NetworkRuleConfigSignal              = &DNATConfigV1Alpha1{}
+	_ config.NamedDocument                        = &DNATConfigV1Alpha1{}
+	_ config.Validator                            = &DNATConfigV1Alpha1{}
+)
+
+// DNATConfigV1Alpha1 is a destination NAT (port forwarding) config document.
+//
+//	examples:
+//	  - value: exampleDNATConfigV1Alpha1()
+//	alias: NetworkDNATConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkDNATConfig
+type DNATConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the link the traffic enters the host through.
+	//
+	//     If not set, the traffic is forwarded regardless of the incoming link.
+	//   examples:
+	//    - value: >
+	//       "eth0"
+	DNATInLinkName string `yaml:"inLinkName,omitempty"`
+	//   description: |
+	//     Port selector defines which host ports and protocols are forwarded.
+	//
+	//     Only `tcp` and `udp` protocols are supported.
+	PortSelector RulePortSelector `yaml:"portSelector"`
+	//   description: |
+	//     Ingress defines which source subnets are allowed to use the port forwarding.
+	//
+	//     If not set, traffic from any source is forwarded.
+	Ingress IngressConfig `yaml:"ingress,omitempty" merge:"replace"`
+	//   description: |
+	//     Address to forward the traffic to.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")}
+	//   schema:
+	//     type: string
+	//   schemaRequired: true
+	DNATToAddress meta.Addr `yaml:"toAddress"`
+	//   description: |
+	//     Port to forward the traffic to.
+	//
+	//     If not set, the original destination port is kept.
+	//   examples:
+	//    - value: >
+	//       uint16(8080)
+	DNATToPort uint16 `yaml:"toPort,omitempty"`
+}
+
+// NewDNATConfigV1Alpha1 creates a new DNATConfig config document.
+func NewDNATConfigV1Alpha1() *DNATConfigV1Alpha1 {
+	return &DNATConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       DNATConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleDNATConfigV1Alpha1() *DNATConfigV1Alpha1 {
+	cfg := NewDNATConfigV1Alpha1()
+	cfg.MetaName = "downstream-web"
+	cfg.DNATInLinkName = "eth0"
+	cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	cfg.PortSelector.Ports = PortRanges{
+		{Lo: 8443, Hi: 8443},
+	}
+	cfg.DNATToAddress = meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")}
+	cfg.DNATToPort = 443
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *DNATConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *DNATConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *DNATConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if !protocolHasPorts(s.PortSelector.Protocol) {
+		return nil, fmt.Errorf("unsupported protocol %s, only tcp and udp are supported", s.PortSelector.Protocol)
+	}
+
+	if len(s.PortSelector.Ports) == 0 {
+		return nil, errors.New("portSelector.ports is required")
+	}
+
+	if err := s.PortSelector.Ports.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !s.DNATToAddress.IsValid() {
+		return nil, errors.New("toAddress is required")
+	}
+
+	for _, rule := range s.Ingress {
+		if !rule.Subnet.IsValid() {
+			return nil, fmt.Errorf("invalid subnet: %s", rule.Subnet)
+		}
+
+		if rule.Subnet.Addr().Is4() != s.DNATToAddress.Is4() {
+			return nil, fmt.Errorf("subnet %s and toAddress %s are of different address families", rule.Subnet, s.DNATToAddress.Addr)
+		}
+
+		if !value.IsZero(rule.Except) && !rule.Except.IsValid() {
+			return nil, fmt.Errorf("invalid except: %s", rule.Except)
+		}
+	}
+
+	return nil, nil
+}
+
+// NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
+func (s *DNATConfigV1Alpha1) NetworkRuleConfigSignal() {}
+
+// DestinationNATRules implements config.NetworkRuleConfigDestinationNATRules interface.
+func (s *DNATConfigV1Alpha1) DestinationNATRules() []config.NetworkDestinationNATRule {
+	return []config.NetworkDestinationNATRule{s}
+}
+
+// InLinkName implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) InLinkName() string {
+	return s.DNATInLinkName
+}
+
+// Protocol implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) Protocol() nethelpers.Protocol {
+	return s.PortSelector.Protocol
+}
+
+// PortRanges implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) PortRanges() [][2]uint16 {
+	return xslices.Map(s.PortSelector.Ports, func(pr PortRange) [2]uint16 {
+		return [2]uint16{pr.Lo, pr.Hi}
+	})
+}
+
+// Subnets implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) Subnets() []netip.Prefix {
+	return xslices.Map(s.Ingress, func(rule IngressRule) netip.Prefix {
+		return rule.Subnet
+	})
+}
+
+// ExceptSubnets implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
+	return xslices.Map(
+		xslices.Filter(
+			s.Ingress,
+			func(rule IngressRule) bool {
+				return rule.Except.IsValid()
+			},
+		),
+		func(rule IngressRule) netip.Prefix {
+			return rule.Except.Prefix
+		},
+	)
+}
+
+// ToAddress implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) ToAddress() netip.Addr {
+	return s.DNATToAddress.Addr
+}
+
+// ToPort implements config.NetworkDestinationNATRule interface.
+func (s *DNATConfigV1Alpha1) ToPort() optional.Optional[uint16] {
+	if s.DNATToPort == 0 {
+		return optional.None[uint16]()
+	}
+
+	return optional.Some(s.DNATToPort)
+}
@@ -0,0 +1,197 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/dnatconfig.yaml
+var expectedDNATConfigDocument []byte
+
+func TestDNATConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewDNATConfigV1Alpha1()
+	cfg.MetaName = "test"
+	cfg.DNATInLinkName = "eth0"
+	cfg.PortSelector = network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports: network.PortRanges{
+			{Lo: 8443, Hi: 8443},
+		},
+	}
+	cfg.Ingress = network.IngressConfig{
+		{
+			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+		},
+	}
+	cfg.DNATToAddress = meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")}
+	cfg.DNATToPort = 443
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedDNATConfigDocument, marshaled)
+}
+
+func TestDNATConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedDNATConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.DNATConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.DNATConfigKind,
+		},
+		MetaName:       "test",
+		DNATInLinkName: "eth0",
+		PortSelector: network.RulePortSelector{
+			Protocol: nethelpers.ProtocolTCP,
+			Ports: network.PortRanges{
+				{Lo: 8443, Hi: 8443},
+			},
+		},
+		Ingress: network.IngressConfig{
+			{
+				Subnet: netip.MustParsePrefix("192.168.0.0/16"),
+			},
+		},
+		DNATToAddress: meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")},
+		DNATToPort:    443,
+	}, docs[0])
+
+	rules := provider.NetworkRules().DestinationNATRules()
+	require.Len(t, rules, 1)
+
+	assert.Equal(t, [][2]uint16{{8443, 8443}}, rules[0].PortRanges())
+	assert.Equal(t, netip.MustParseAddr("10.5.0.10"), rules[0].ToAddress())
+	assert.Equal(t, uint16(443), rules[0].ToPort().ValueOrZero())
+}
+
+func TestDNATConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.DNATConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewDNATConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "icmp",
+			cfg: func() *network.DNATConfigV1Alpha1 {
+				cfg := network.NewDNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolICMP
+
+				return cfg
+			},
+
+			expectedError: "unsupported protocol icmp, only tcp and udp are supported",
+		},
+		{
+			name: "no ports",
+			cfg: func() *network.DNATConfigV1Alpha1 {
+				cfg := network.NewDNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolUDP
+
+				return cfg
+			},
+
+			expectedError: "portSelector.ports is required",
+		},
+		{
+			name: "no address",
+			cfg: func() *network.DNATConfigV1Alpha1 {
+				cfg := network.NewDNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolUDP
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 53, Hi: 53},
+				}
+
+				return cfg
+			},
+
+			expectedError: "toAddress is required",
+		},
+		{
+			name: "mixed families",
+			cfg: func() *network.DNATConfigV1Alpha1 {
+				cfg := network.NewDNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 80},
+				}
+				cfg.Ingress = network.IngressConfig{
+					{
+						Subnet: netip.MustParsePrefix("2001:db8::/32"),
+					},
+				}
+				cfg.DNATToAddress = meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")}
+
+				return cfg
+			},
+
+			expectedError: "subnet 2001:db8::/32 and toAddress 10.5.0.10 are of different address families",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.DNATConfigV1Alpha1 {
+				cfg := network.NewDNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 8000, Hi: 8100},
+				}
+				cfg.DNATToAddress = meta.Addr{Addr: netip.MustParseAddr("fd00::10")}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/value"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// EgressRuleConfigKind is an egress rule config document kind.
+const EgressRuleConfigKind = "NetworkEgressRuleConfig"
+
+func init() {
+	registry.Register(EgressRuleConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &EgressRuleConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRuleConfigEgressRules = &EgressRuleConfigV1Alpha1{}
+	_ config.NetworkRuleConfigSignal      = &EgressRuleConfigV1Alpha1{}
+	_ config.NamedDocument                = &EgressRuleConfigV1Alpha1{}
+	_ config.Validator                    = &EgressRuleConfigV1Alpha1{}
+)
+
+// EgressRuleConfigV1Alpha1 is a network firewall egress rule config document.
+//
+//	examples:
+//	  - value: exampleEgressRuleConfigV1Alpha1()
+//	alias: NetworkEgressRuleConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkEgressRuleConfig
+type EgressRuleConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Port selector defines which destination ports and protocols are affected by the rule.
+	//
+	//     If no ports are specified, the rule applies to all ports of the protocol.
+	PortSelector RulePortSelector `yaml:"portSelector"`
+	//   description: |
+	//     Egress defines which destination subnets the host is allowed to reach on the ports/protocols defined by the `portSelector`.
+	Egress EgressConfig `yaml:"egress" merge:"replace"`
+}
+
+// EgressConfig is an egress config.
+//
+//docgen:alias
+type EgressConfig []EgressRule
+
+// EgressRule is an egress rule.
+type EgressRule struct {
+	//   description: |
+	//     Subnet defines a destination subnet.
+	//   examples:
+	//    - value: >
+	//       netip.MustParsePrefix("10.3.4.0/24")
+	//    - value: >
+	//       netip.MustParsePrefix("2001:db8::/32")
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	Subnet netip.Prefix `yaml:"subnet"`
+	//   description: |
+	//     Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+/\d{1,3}$
+	Except meta.Prefix `yaml:"except,omitempty"`
+}
+
+// NewEgressRuleConfigV1Alpha1 creates a new EgressRuleConfig config document.
+func NewEgressRuleConfigV1Alpha1() *EgressRuleConfigV1Alpha1 {
+	return &EgressRuleConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       EgressRuleConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleEgressRuleConfigV1Alpha1() *EgressRuleConfigV1Alpha1 {
+	cfg := NewEgressRuleConfigV1Alpha1()
+	cfg.MetaName = "egress-registry"
+	cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+	cfg.PortSelector.Ports = PortRanges{
+		{Lo: 443, Hi: 443},
+	}
+	cfg.Egress = EgressConfig{
+		{
+			Subnet: netip.MustParsePrefix("10.10.0.0/16"),
+		},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *EgressRuleConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *EgressRuleConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *EgressRuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if err := s.PortSelector.Ports.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(s.PortSelector.Ports) > 0 && !protocolHasPorts(s.PortSelector.Protocol) {
+		return nil, fmt.Errorf("portSelector.ports is not supported for protocol %s", s.PortSelector.Protocol)
+	}
+
+	if len(s.Egress) == 0 {
+		return nil, errors.New("egress is required")
+	}
+
+	for _, rule := range s.Egress {
+		if !rule.Subnet.IsValid() {
+			return nil, fmt.Errorf("invalid subnet: %s", rule.Subnet)
+		}
+
+		if !value.IsZero(rule.Except) && !rule.Except.IsValid() {
+			return nil, fmt.Errorf("invalid except: %s", rule.Except)
+		}
+	}
+
+	return nil, nil
+}
+
+// NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
+func (s *EgressRuleConfigV1Alpha1) NetworkRuleConfigSignal() {}
+
+// EgressRules implements config.NetworkRuleConfigEgressRules interface.
+func (s *EgressRuleConfigV1Alpha1) EgressRules() []config.NetworkRule {
+	return []config.NetworkRule{s}
+}
+
+// Protocol implements config.NetworkRule interface.
+func (s *EgressRuleConfigV1Alpha1) Protocol() nethelpers.Protocol {
+	return s.PortSelector.Protocol
+}
+
+// PortRanges implements config.NetworkRule interface.
+func (s *EgressRuleConfigV1Alpha1) PortRanges() [][2]uint16 {
+	return xslices.Map(s.PortSelector.Ports, func(pr PortRange) [2]uint16 {
+		return [2]uint16{pr.Lo, pr.Hi}
+	})
+}
+
+// Subnets implements config.NetworkRule interface.
+func (s *EgressRuleConfigV1Alpha1) Subnets() []netip.Prefix {
+	return xslices.Map(s.Egress, func(rule EgressRule) netip.Prefix {
+		return rule.Subnet
+	})
+}
+
+// ExceptSubnets implements config.NetworkRule interface.
+func (s *EgressRuleConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
+	return xslices.Map(
+		xslices.Filter(
+			s.Egress,
+			func(rule EgressRule) bool {
+				return rule.Except.IsValid()
+			},
+		),
+		func(rule EgressRule) netip.Prefix {
+			return rule.Except.Prefix
+		},
+	)
+}
+
+// Limits implements config.NetworkRule interface.
+func (s *EgressRuleConfigV1Alpha1) Limits() config.NetworkRuleLimits {
+	return nil
+}
+
+// protocolHasPorts returns true if the protocol has a notion of ports.
+func protocolHasPorts(protocol nethelpers.Protocol) bool {
+	switch protocol { //nolint:exhaustive
+	case nethelpers.ProtocolTCP, nethelpers.ProtocolUDP:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/egressruleconfig.yaml
+var expectedEgressRuleConfigDocument []byte
+
+func TestEgressRuleConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewEgressRuleConfigV1Alpha1()
+	cfg.MetaName = "test"
+
+	cfg.PortSelector = network.RulePortSelector{
+		Protocol: nethelpers.ProtocolTCP,
+		Ports: network.PortRanges{
+			{Lo: 443, Hi: 443},
+		},
+	}
+
+	cfg.Egress = network.EgressConfig{
+		{
+			Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+			Except: meta.Prefix{Prefix: netip.MustParsePrefix("10.3.0.0/16")},
+		},
+		{
+			Subnet: netip.MustParsePrefix("2001:db8::/32"),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedEgressRuleConfigDocument, marshaled)
+}
+
+func TestEgressRuleConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedEgressRuleConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.EgressRuleConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.EgressRuleConfigKind,
+		},
+		MetaName: "test",
+		PortSelector: network.RulePortSelector{
+			Protocol: nethelpers.ProtocolTCP,
+			Ports: network.PortRanges{
+				{Lo: 443, Hi: 443},
+			},
+		},
+		Egress: network.EgressConfig{
+			{
+				Subnet: netip.MustParsePrefix("10.0.0.0/8"),
+				Except: meta.Prefix{Prefix: netip.MustParsePrefix("10.3.0.0/16")},
+			},
+			{
+				Subnet: netip.MustParsePrefix("2001:db8::/32"),
+			},
+		},
+	}, docs[0])
+
+	rules := provider.NetworkRules().EgressRules()
+	require.Len(t, rules, 1)
+
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::/32")}, rules[0].Subnets())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.3.0.0/16")}, rules[0].ExceptSubnets())
+	assert.Empty(t, provider.NetworkRules().Rules())
+}
+
+func TestEgressRuleConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.EgressRuleConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewEgressRuleConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "no egress",
+			cfg: func() *network.EgressRuleConfigV1Alpha1 {
+				cfg := network.NewEgressRuleConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolTCP
+
+				return cfg
+			},
+
+			expectedError: "egress is required",
+		},
+		{
+			name: "ports for icmp",
+			cfg: func() *network.EgressRuleConfigV1Alpha1 {
+				cfg := network.NewEgressRuleConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolICMP
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 80},
+				}
+
+				return cfg
+			},
+
+			expectedError: "portSelector.ports is not supported for protocol icmp",
+		},
+		{
+			name: "invalid subnet",
+			cfg: func() *network.EgressRuleConfigV1Alpha1 {
+				cfg := network.NewEgressRuleConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolUDP
+				cfg.Egress = network.EgressConfig{
+					{},
+				}
+
+				return cfg
+			},
+
+			expectedError: "invalid subnet: invalid Prefix",
+		},
+		{
+			name: "valid without ports",
+			cfg: func() *network.EgressRuleConfigV1Alpha1 {
+				cfg := network.NewEgressRuleConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolICMP
+				cfg.Egress = network.EgressConfig{
+					{
+						Subnet: netip.MustParsePrefix("0.0.0.0/0"),
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// GeneveKind is a Geneve config document kind.
+const GeneveKind = "GeneveConfig"
+
+func init() {
+	registry.Register(GeneveKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &GeneveConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkGeneveConfig = &GeneveConfigV1Alpha1{}
+	_ config.ConflictingDocument = &GeneveConfigV1Alpha1{}
+	_ config.NamedDocument       = &GeneveConfigV1Alpha1{}
+	_ config.Validator           = &GeneveConfigV1Alpha1{}
+)
+
+// GeneveConfigV1Alpha1 is a config document to create a Geneve (generic network virtualization encapsulation) tunnel link.
+//
+//	examples:
+//	  - value: exampleGeneveConfigV1Alpha1()
+//	alias: GeneveConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/GeneveConfig
+type GeneveConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the Geneve link (interface) to be created.
+	//
+	//   examples:
+	//    - value: >
+	//       "geneve42"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Geneve network identifier (VNI).
+	//
+	//   examples:
+	//    - value: >
+	//       42
+	//   schemaRequired: true
+	GeneveVNI uint32 `yaml:"vni,omitempty"`
+	//   description: |
+	//     Remote IP address of the tunnel endpoint.
+	//
+	//     Geneve links can't be bound to a local address or an underlay link,
+	//     the outgoing link is selected by the route lookup for this address.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	//   schemaRequired: true
+	GeneveRemote meta.Addr `yaml:"remote,omitempty"`
+	//   description: |
+	//     Destination UDP port.
+	//     If not set, defaults to the IANA-assigned port 6081.
+	//   examples:
+	//    - value: >
+	//       6081
+	GenevePort uint16 `yaml:"port,omitempty"`
+
+	//nolint:embeddedstructfieldcheck
+	CommonLinkConfig `yaml:",inline"`
+}
+
+// NewGeneveConfigV1Alpha1 creates a new GeneveConfig config document.
+func NewGeneveConfigV1Alpha1(name string) *GeneveConfigV1Alpha1 {
+	return &GeneveConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       GeneveKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleGeneveConfigV1Alpha1() *GeneveConfigV1Alpha1 {
+	cfg := NewGeneveConfigV1Alpha1("geneve42")
+	cfg.GeneveVNI = 42
+	cfg.GeneveRemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	cfg.LinkAddresses = []AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.42.0.1/24"),
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *GeneveConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *GeneveConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// GeneveConfig implements NetworkGeneveConfig interface.
+func (s *GeneveConfigV1Alpha1) GeneveConfig() {}
+
+// VNI implements NetworkGeneveConfig interface.
+func (s *GeneveConfigV1Alpha1) VNI() uint32 {
+	return s.GeneveVNI
+}
+
+// Remote implements NetworkGeneveConfig interface.
+func (s *GeneveConfigV1Alpha1) Remote() netip.Addr {
+	return s.GeneveRemote.Addr
+}
+
+// Port implements NetworkGeneveConfig interface.
+func (s *GeneveConfigV1Alpha1) Port() optional.Optional[uint16] {
+	if s.GenevePort == 0 {
+		return optional.None[uint16]()
+	}
+
+	return optional.Some(s.GenevePort)
+}
+
+// ConflictsWithKinds implements config.ConflictingDocument interface.
+func (s *GeneveConfigV1Alpha1) ConflictsWithKinds() []string {
+	return conflictingLinkKinds(GeneveKind)
+}
+
+// Validate implements config.Validator interface.
+func (s *GeneveConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		errs     error
+		warnings []string //nolint:prealloc
+	)
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name must be specified"))
+	}
+
+	if s.GeneveVNI == 0 || s.GeneveVNI > maxVNI {
+		errs = errors.Join(errs, errors.New("vni must be specified and between 1 and 16777215"))
+	}
+
+	switch {
+	case !s.GeneveRemote.IsValid():
+		errs = errors.Join(errs, errors.New("remote must be specified"))
+	case s.GeneveRemote.IsUnspecified() || s.GeneveRemote.IsMulticast():
+		errs = errors.Join(errs, errors.New("remote must be a unicast address"))
+	}
+
+	extraWarnings, extraErrs := s.CommonLinkConfig.Validate()
+	errs, warnings = errors.Join(errs, extraErrs), append(warnings, extraWarnings...)
+
+	return warnings, errs
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/geneveconfig.yaml
+var expectedGeneveConfigDocument []byte
+
+func TestGeneveConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewGeneveConfigV1Alpha1("geneve42")
+	cfg.GeneveVNI = 42
+	cfg.GeneveRemote = meta.Addr{Addr: netip.MustParseAddr("fd00::2")}
+	cfg.GenevePort = 6081
+	cfg.LinkMTU = 1400
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedGeneveConfigDocument, marshaled)
+}
+
+func TestGeneveConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedGeneveConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.GeneveConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.GeneveKind,
+		},
+		MetaName:     "geneve42",
+		GeneveVNI:    42,
+		GeneveRemote: meta.Addr{Addr: netip.MustParseAddr("fd00::2")},
+		GenevePort:   6081,
+		CommonLinkConfig: network.CommonLinkConfig{
+			LinkMTU: 1400,
+		},
+	}, docs[0])
+}
+
+func TestGeneveValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.GeneveConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.GeneveConfigV1Alpha1 {
+				return network.NewGeneveConfigV1Alpha1("")
+			},
+
+			expectedError: "name must be specified\nvni must be specified and between 1 and 16777215\nremote must be specified",
+		},
+		{
+			name: "multicast remote",
+			cfg: func() *network.GeneveConfigV1Alpha1 {
+				cfg := network.NewGeneveConfigV1Alpha1("geneve0")
+				cfg.GeneveVNI = 1
+				cfg.GeneveRemote = meta.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+
+				return cfg
+			},
+
+			expectedError: "remote must be a unicast address",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.GeneveConfigV1Alpha1 {
+				cfg := network.NewGeneveConfigV1Alpha1("geneve0")
+				cfg.GeneveVNI = 1
+				cfg.GeneveRemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// GREKind is a GRE config document kind.
+const GREKind = "GREConfig"
+
+// GRETAPKind is a GRETAP config document kind.
+const GRETAPKind = "GRETAPConfig"
+
+func init() {
+	registry.Register(GREKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &GREConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+
+	registry.Register(GRETAPKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &GRETAPConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkGREConfig    = &GREConfigV1Alpha1{}
+	_ config.ConflictingDocument = &GREConfigV1Alpha1{}
+	_ config.NamedDocument       = &GREConfigV1Alpha1{}
+	_ config.Validator           = &GREConfigV1Alpha1{}
+
+	_ config.NetworkGREConfig    = &GRETAPConfigV1Alpha1{}
+	_ config.ConflictingDocument = &GRETAPConfigV1Alpha1{}
+	_ config.NamedDocument       = &GRETAPConfigV1Alpha1{}
+	_ config.Validator           = &GRETAPConfigV1Alpha1{}
+)
+
+// GREConfigV1Alpha1 is a config document to create a GRE (generic routing encapsulation) layer 3 tunnel link.
+//
+//	examples:
+//	  - value: exampleGREConfigV1Alpha1()
+//	alias: GREConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/GREConfig
+type GREConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the GRE link (interface) to be created.
+	//
+	//   examples:
+	//    - value: >
+	//       "gre0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+
+	//nolint:embeddedstructfieldcheck
+	GRETunnelConfig `yaml:",inline"`
+	//nolint:embeddedstructfieldcheck
+	CommonLinkConfig `yaml:",inline"`
+}
+
+// GRETAPConfigV1Alpha1 is a config document to create a GRETAP (Ethernet over GRE) layer 2 tunnel link.
+//
+//	examples:
+//	  - value: exampleGRETAPConfigV1Alpha1()
+//	alias: GRETAPConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/GRETAPConfig
+type GRETAPConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the GRETAP link (interface) to be created.
+	//
+	//   examples:
+	//    - value: >
+	//       "gretap0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+
+	//nolint:embeddedstructfieldcheck
+	GRETunnelConfig `yaml:",inline"`
+	//nolint:embeddedstructfieldcheck
+	CommonLinkConfig `yaml:",inline"`
+}
+
+// GRETunnelConfig is the common configuration for GRE and GRETAP tunnels.
+type GRETunnelConfig struct {
+	//   description: |
+	//     Name of the underlay link (interface) the tunnel traffic is sent over.
+	//     Link aliases can be used here as well.
+	//
+	//     If not set, the underlay link is selected by the route lookup for the remote address.
+	//   examples:
+	//    - value: >
+	//       "enp0s3"
+	GREParentLink string `yaml:"parent,omitempty"`
+	//   description: |
+	//     Local (source) IP address of the tunnel endpoint.
+	//     If not set, the source address is selected by the kernel.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	GRELocal meta.Addr `yaml:"local,omitempty"`
+	//   description: |
+	//     Remote IP address of the tunnel endpoint.
+	//
+	//     IPv4 endpoints create `gre`/`gretap` links, IPv6 endpoints create `ip6gre`/`ip6gretap` links.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	//   schemaRequired: true
+	GRERemote meta.Addr `yaml:"remote,omitempty"`
+	//   description: |
+	//     GRE key used for both directions of the tunnel.
+	//     If not set, packets are sent without a key.
+	//     The key must not be zero, as the kernel does not tell a zero key from no key.
+	//   examples:
+	//    - value: >
+	//       42
+	GREKey *uint32 `yaml:"key,omitempty"`
+}
+
+// NewGREConfigV1Alpha1 creates a new GREConfig config document.
+func NewGREConfigV1Alpha1(name string) *GREConfigV1Alpha1 {
+	return &GREConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       GREKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+// NewGRETAPConfigV1Alpha1 creates a new GRETAPConfig config document.
+func NewGRETAPConfigV1Alpha1(name string) *GRETAPConfigV1Alpha1 {
+	return &GRETAPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       GRETAPKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleGREConfigV1Alpha1() *GREConfigV1Alpha1 {
+	cfg := NewGREConfigV1Alpha1("gre0")
+	cfg.GREParentLink = "enp0s3"
+	cfg.GRELocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	cfg.LinkAddresses = []AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.255.0.1/30"),
+		},
+	}
+
+	return cfg
+}
+
+func exampleGRETAPConfigV1Alpha1() *GRETAPConfigV1Alpha1 {
+	cfg := NewGRETAPConfigV1Alpha1("gretap0")
+	cfg.GRELocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	cfg.GREKey = new(uint32(42))
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *GREConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *GREConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// GREConfig implements NetworkGREConfig interface.
+func (s *GREConfigV1Alpha1) GREConfig() {}
+
+// TAP implements NetworkGREConfig interface.
+func (s *GREConfigV1Alpha1) TAP() bool {
+	return false
+}
+
+// ConflictsWithKinds implements config.ConflictingDocument interface.
+func (s *GREConfigV1Alpha1) ConflictsWithKinds() []string {
+	return conflictingLinkKinds(GREKind)
+}
+
+// Validate implements config.Validator interface.
+func (s *GREConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	return validateGRETunnel(s.MetaName, &s.GRETunnelConfig, &s.CommonLinkConfig)
+}
+
+// Clone implements config.Document interface.
+func (s *GRETAPConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *GRETAPConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// GREConfig implements NetworkGREConfig interface.
+func (s *GRETAPConfigV1Alpha1) GREConfig() {}
+
+// TAP implements NetworkGREConfig interface.
+func (s *GRETAPConfigV1Alpha1) TAP() bool {
+	return true
+}
+
+// ConflictsWithKinds implements config.ConflictingDocument interface.
+func (s *GRETAPConfigV1Alpha1) ConflictsWithKinds() []string {
+	return conflictingLinkKinds(GRETAPKind)
+}
+
+// Validate implements config.Validator interface.
+func (s *GRETAPConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	return validateGRETunnel(s.MetaName, &s.GRETunnelConfig, &s.CommonLinkConfig)
+}
+
+// ParentLink implements NetworkGREConfig interface.
+func (s *GRETunnelConfig) ParentLink() string {
+	return s.GREParentLink
+}
+
+// Local implements NetworkGREConfig interface.
+func (s *GRETunnelConfig) Local() optional.Optional[netip.Addr] {
+	if !s.GRELocal.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(s.GRELocal.Addr)
+}
+
+// Remote implements NetworkGREConfig interface.
+func (s *GRETunnelConfig) Remote() netip.Addr {
+	return s.GRERemote.Addr
+}
+
+// Key implements NetworkGREConfig interface.
+func (s *GRETunnelConfig) Key() optional.Optional[uint32] {
+	if s.GREKey == nil {
+		return optional.None[uint32]()
+	}
+
+	return optional.Some(*s.GREKey)
+}
+
+func validateGRETunnel(name string, tunnel *GRETunnelConfig, common *CommonLinkConfig) ([]string, error) {
+	var (
+		errs     error
+		warnings []string //nolint:prealloc
+	)
+
+	if name == "" {
+		errs = errors.Join(errs, errors.New("name must be specified"))
+	}
+
+	switch {
+	case !tunnel.GRERemote.IsValid():
+		errs = errors.Join(errs, errors.New("remote must be specified"))
+	case tunnel.GRERemote.IsUnspecified() || tunnel.GRERemote.IsMulticast():
+		errs = errors.Join(errs, errors.New("remote must be a unicast address"))
+	case tunnel.GRELocal.IsValid() && tunnel.GRELocal.Is4() != tunnel.GRERemote.Is4():
+		errs = errors.Join(errs, errors.New("local and remote addresses must be of the same address family"))
+	}
+
+	if tunnel.GREKey != nil && *tunnel.GREKey == 0 {
+		errs = errors.Join(errs, errors.New("key must not be zero, leave it unset for an unkeyed tunnel"))
+	}
+
+	extraWarnings, extraErrs := common.Validate()
+	errs, warnings = errors.Join(errs, extraErrs), append(warnings, extraWarnings...)
+
+	return warnings, errs
+}
@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/greconfig.yaml
+var expectedGREConfigDocument []byte
+
+//go:embed testdata/gretapconfig.yaml
+var expectedGRETAPConfigDocument []byte
+
+func TestGREConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewGREConfigV1Alpha1("gre0")
+	cfg.GREParentLink = "enp0s3"
+	cfg.GRELocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	cfg.LinkAddresses = []network.AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.255.0.1/30"),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedGREConfigDocument, marshaled)
+}
+
+func TestGREConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedGREConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.GREConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.GREKind,
+		},
+		MetaName: "gre0",
+		GRETunnelConfig: network.GRETunnelConfig{
+			GREParentLink: "enp0s3",
+			GRELocal:      meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")},
+			GRERemote:     meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")},
+		},
+		CommonLinkConfig: network.CommonLinkConfig{
+			LinkAddresses: []network.AddressConfig{
+				{
+					AddressAddress: netip.MustParsePrefix("10.255.0.1/30"),
+				},
+			},
+		},
+	}, docs[0])
+}
+
+func TestGRETAPConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewGRETAPConfigV1Alpha1("gretap0")
+	cfg.GRELocal = meta.Addr{Addr: netip.MustParseAddr("fd00::1")}
+	cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("fd00::2")}
+	cfg.GREKey = new(uint32(42))
+	cfg.LinkUp = new(true)
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedGRETAPConfigDocument, marshaled)
+}
+
+func TestGRETAPConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedGRETAPConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.GRETAPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.GRETAPKind,
+		},
+		MetaName: "gretap0",
+		GRETunnelConfig: network.GRETunnelConfig{
+			GRELocal:  meta.Addr{Addr: netip.MustParseAddr("fd00::1")},
+			GRERemote: meta.Addr{Addr: netip.MustParseAddr("fd00::2")},
+			GREKey:    new(uint32(42)),
+		},
+		CommonLinkConfig: network.CommonLinkConfig{
+			LinkUp: new(true),
+		},
+	}, docs[0])
+}
+
+func TestGREValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() config.Validator
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() config.Validator {
+				return network.NewGREConfigV1Alpha1("")
+			},
+
+			expectedError: "name must be specified\nremote must be specified",
+		},
+		{
+			name: "unspecified remote",
+			cfg: func() config.Validator {
+				cfg := network.NewGRETAPConfigV1Alpha1("gretap0")
+				cfg.GRERemote = meta.Addr{Addr: netip.IPv4Unspecified()}
+
+				return cfg
+			},
+
+			expectedError: "remote must be a unicast address",
+		},
+		{
+			name: "mixed address families",
+			cfg: func() config.Validator {
+				cfg := network.NewGREConfigV1Alpha1("gre0")
+				cfg.GRELocal = meta.Addr{Addr: netip.MustParseAddr("fd00::1")}
+				cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+
+				return cfg
+			},
+
+			expectedError: "local and remote addresses must be of the same address family",
+		},
+		{
+			name: "zero key",
+			cfg: func() config.Validator {
+				cfg := network.NewGREConfigV1Alpha1("gre0")
+				cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+				cfg.GREKey = new(uint32(0))
+
+				return cfg
+			},
+
+			expectedError: "key must not be zero, leave it unset for an unkeyed tunnel",
+		},
+		{
+			name: "valid",
+			cfg: func() config.Validator {
+				cfg := network.NewGRETAPConfigV1Alpha1("gretap0")
+				cfg.GRERemote = meta.Addr{Addr: netip.MustParseAddr("fd00::2")}
+				cfg.GREKey = new(uint32(42))
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
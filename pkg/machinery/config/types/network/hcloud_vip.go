@@ -71,6 +71,15 @@ type HCloudVIPConfigV1Alpha1 struct {
 	//     If multiple selectors match the same link, the first one is used.
 	LinkName string `yaml:"link"`
 	//   description: |
+	//     Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the VIP.
+	//
+	//     While the probe fails, this node releases the VIP and does not campaign for it,
+	//     so that the VIP fails over to a healthy node.
+	//   examples:
+	//    - value: >
+	//       "uplink-check"
+	VIPProbe string `yaml:"probe,omitempty"`
+	//   description: |
 	//     Specifies the Hetzner Cloud API Token.
 	APIToken string `yaml:"apiToken"`
 }
@@ -140,6 +149,11 @@ func (s *HCloudVIPConfigV1Alpha1) VIP() netip.Addr {
 	return addr
 }
 
+// Probe implements config.NetworkHCloudVIPConfig interface.
+func (s *HCloudVIPConfigV1Alpha1) Probe() string {
+	return s.VIPProbe
+}
+
 // HCloudAPIToken implements config.NetworkHCloudVIPConfig interface.
 func (s *HCloudVIPConfigV1Alpha1) HCloudAPIToken() string {
 	return s.APIToken
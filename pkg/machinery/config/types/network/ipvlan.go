@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// IPVLANKind is an IPVLAN config document kind.
+const IPVLANKind = "IPVLANConfig"
+
+func init() {
+	registry.Register(IPVLANKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &IPVLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkIPVLANConfig = &IPVLANConfigV1Alpha1{}
+	_ config.ConflictingDocument = &IPVLANConfigV1Alpha1{}
+	_ config.NamedDocument       = &IPVLANConfigV1Alpha1{}
+	_ config.Validator           = &IPVLANConfigV1Alpha1{}
+)
+
+// IPVLANConfigV1Alpha1 is a config document to create an IPVLAN link (a sub-interface sharing the MAC address of the parent) over a parent link.
+//
+//	examples:
+//	  - value: exampleIPVLANConfigV1Alpha1()
+//	alias: IPVLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/IPVLANConfig
+type IPVLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the IPVLAN link (interface) to be created.
+	//
+	//   examples:
+	//    - value: >
+	//       "ipvlan0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the parent link (interface) on which the IPVLAN link will be created.
+	//     Link aliases can be used here as well.
+	//
+	//   examples:
+	//    - value: >
+	//       "enp0s3"
+	//   schemaRequired: true
+	ParentLinkConfig string `yaml:"parent,omitempty"`
+	//   description: |
+	//     Set the IPVLAN mode to use.
+	//     If not set, defaults to 'l2'.
+	//
+	//   examples:
+	//    - value: >
+	//       "l3"
+	//   values:
+	//     - "l2"
+	//     - "l3"
+	//     - "l3s"
+	IPVLANModeConfig *nethelpers.IPVLANMode `yaml:"mode,omitempty"`
+
+	//nolint:embeddedstructfieldcheck
+	CommonLinkConfig `yaml:",inline"`
+}
+
+// NewIPVLANConfigV1Alpha1 creates a new IPVLANConfig config document.
+func NewIPVLANConfigV1Alpha1(name string) *IPVLANConfigV1Alpha1 {
+	return &IPVLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       IPVLANKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleIPVLANConfigV1Alpha1() *IPVLANConfigV1Alpha1 {
+	cfg := NewIPVLANConfigV1Alpha1("ipvlan0")
+	cfg.ParentLinkConfig = "enp0s3"
+	cfg.IPVLANModeConfig = new(nethelpers.IPVLANModeL3)
+	cfg.LinkAddresses = []AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.10.0.2/32"),
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *IPVLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *IPVLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// IPVLANConfig implements NetworkIPVLANConfig interface.
+func (s *IPVLANConfigV1Alpha1) IPVLANConfig() {}
+
+// ParentLink returns the parent link name.
+func (s *IPVLANConfigV1Alpha1) ParentLink() string {
+	return s.ParentLinkConfig
+}
+
+// IPVLANMode returns the IPVLAN mode.
+func (s *IPVLANConfigV1Alpha1) IPVLANMode() optional.Optional[nethelpers.IPVLANMode] {
+	if s.IPVLANModeConfig == nil {
+		return optional.None[nethelpers.IPVLANMode]()
+	}
+
+	return optional.Some(*s.IPVLANModeConfig)
+}
+
+// ConflictsWithKinds implements config.ConflictingDocument interface.
+func (s *IPVLANConfigV1Alpha1) ConflictsWithKinds() []string {
+	return conflictingLinkKinds(IPVLANKind)
+}
+
+// Validate implements config.Validator interface.
+func (s *IPVLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		errs     error
+		warnings []string //nolint:prealloc
+	)
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name must be specified"))
+	}
+
+	if s.ParentLinkConfig == "" {
+		errs = errors.Join(errs, errors.New("parent must be specified"))
+	}
+
+	if s.IPVLANModeConfig != nil && !s.IPVLANModeConfig.IsAIPVLANMode() {
+		errs = errors.Join(errs, errors.New("mode must be one of 'l2', 'l3' or 'l3s'"))
+	}
+
+	extraWarnings, extraErrs := s.CommonLinkConfig.Validate()
+	errs, warnings = errors.Join(errs, extraErrs), append(warnings, extraWarnings...)
+
+	return warnings, errs
+}
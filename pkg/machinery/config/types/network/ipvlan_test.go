@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/ipvlanconfig.yaml
+var expectedIPVLANConfigDocument []byte
+
+func TestIPVLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewIPVLANConfigV1Alpha1("ipvlan0")
+	cfg.ParentLinkConfig = "enp0s3"
+	cfg.IPVLANModeConfig = new(nethelpers.IPVLANModeL3S)
+	cfg.LinkAddresses = []network.AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.10.0.2/32"),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedIPVLANConfigDocument, marshaled)
+}
+
+func TestIPVLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedIPVLANConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.IPVLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.IPVLANKind,
+		},
+		MetaName:         "ipvlan0",
+		ParentLinkConfig: "enp0s3",
+		IPVLANModeConfig: new(nethelpers.IPVLANModeL3S),
+		CommonLinkConfig: network.CommonLinkConfig{
+			LinkAddresses: []network.AddressConfig{
+				{
+					AddressAddress: netip.MustParsePrefix("10.10.0.2/32"),
+				},
+			},
+		},
+	}, docs[0])
+}
+
+func TestIPVLANValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.IPVLANConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.IPVLANConfigV1Alpha1 {
+				return network.NewIPVLANConfigV1Alpha1("")
+			},
+
+			expectedError: "name must be specified\nparent must be specified",
+		},
+		{
+			name: "invalid mode",
+			cfg: func() *network.IPVLANConfigV1Alpha1 {
+				cfg := network.NewIPVLANConfigV1Alpha1("ipvlan0")
+				cfg.ParentLinkConfig = "enp0s3"
+				cfg.IPVLANModeConfig = new(nethelpers.IPVLANMode(3))
+
+				return cfg
+			},
+
+			expectedError: "mode must be one of 'l2', 'l3' or 'l3s'",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.IPVLANConfigV1Alpha1 {
+				cfg := network.NewIPVLANConfigV1Alpha1("ipvlan0")
+				cfg.ParentLinkConfig = "enp0s3"
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
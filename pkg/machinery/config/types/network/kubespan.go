@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/netip"
 	"strings"
+	"time"
 
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
@@ -97,6 +98,50 @@ type KubeSpanConfigV1Alpha1 struct {
 	//     KubeSpan advanced filtering of network addresses.
 	//     Settings are optional and apply only to this node.
 	ConfigFilters *KubeSpanFiltersConfig `yaml:"filters,omitempty"`
+
+	//   description: |
+	//     Offer this node as a relay for KubeSpan peers which can't establish a direct connection
+	//     to each other (e.g. behind double NAT or strict firewalls).
+	//
+	//     Relay capability is advertised to other nodes via the cluster discovery.
+	//     When a peer is down, each node picks the relay with the lowest public key among
+	//     the relays it is connected to, and routes the traffic to the peer via that relay,
+	//     while still trying to re-establish the direct connection.
+	//     Relaying works only if both ends of the broken connection have the same relay up.
+	//
+	//     Relay nodes should have good connectivity to all other nodes, and should allow
+	//     forwarding of the traffic between KubeSpan peers.
+	//   schema:
+	//     type: boolean
+	ConfigRelay *bool `yaml:"relay,omitempty"`
+
+	//   description: |
+	//     Rotate the KubeSpan identity key of the node with the specified interval.
+	//
+	//     The next key is announced to the peers via the cluster discovery ahead of the rotation,
+	//     and the peers accept both the old and the new key during the overlap window around the rotation.
+	//     Rotation can be forced with `talosctl kubespan rotate-key`.
+	//
+	//     Default value is 0 (no scheduled rotation), the minimum interval is 1h.
+	//   examples:
+	//    - value: >
+	//       30 * 24 * time.Hour
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\.\d+|\d+)([nuµm]?s|m|h))|0)+$
+	ConfigKeyRotationInterval time.Duration `yaml:"keyRotationInterval,omitempty"`
+
+	//   description: |
+	//     Derive a separate Wireguard preshared key for each pair of peers from the cluster secret
+	//     (instead of using the cluster secret as the preshared key for all peers).
+	//
+	//     The preshared key is mixed into the Wireguard handshake to protect the traffic against
+	//     the future quantum computers which might break the Curve25519 key exchange.
+	//     Should be enabled on all nodes of the cluster at once, as the peers with different settings
+	//     can't establish a connection.
+	//   schema:
+	//     type: boolean
+	ConfigPerPeerPresharedKeys *bool `yaml:"perPeerPresharedKeys,omitempty"`
 }
 
 // KubeSpanFiltersConfig configures KubeSpan endpoint filters.
@@ -175,6 +220,10 @@ func (s *KubeSpanConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.
 		errs = errors.Join(errs, fmt.Errorf("kubespan link MTU must be at least %d", constants.KubeSpanLinkMinimumMTU))
 	}
 
+	if s.ConfigKeyRotationInterval != 0 && s.ConfigKeyRotationInterval < constants.KubeSpanMinimumKeyRotationInterval {
+		errs = errors.Join(errs, fmt.Errorf("kubespan key rotation interval must be at least %s", constants.KubeSpanMinimumKeyRotationInterval))
+	}
+
 	if s.ConfigFilters != nil {
 		for _, cidr := range s.ConfigFilters.ConfigEndpoints {
 			cidr = strings.TrimPrefix(cidr, "!")
@@ -228,6 +277,21 @@ func (s *KubeSpanConfigV1Alpha1) MTU() uint32 {
 	return constants.KubeSpanLinkMTU
 }
 
+// Relay implements config.NetworkKubeSpanConfig interface.
+func (s *KubeSpanConfigV1Alpha1) Relay() bool {
+	return pointer.SafeDeref(s.ConfigRelay)
+}
+
+// KeyRotationInterval implements config.NetworkKubeSpanConfig interface.
+func (s *KubeSpanConfigV1Alpha1) KeyRotationInterval() time.Duration {
+	return s.ConfigKeyRotationInterval
+}
+
+// PerPeerPresharedKeys implements config.NetworkKubeSpanConfig interface.
+func (s *KubeSpanConfigV1Alpha1) PerPeerPresharedKeys() bool {
+	return pointer.SafeDeref(s.ConfigPerPeerPresharedKeys)
+}
+
 // Filters implements config.NetworkKubeSpanConfig interface.
 func (s *KubeSpanConfigV1Alpha1) Filters() config.NetworkKubeSpanFilters {
 	if s.ConfigFilters == nil {
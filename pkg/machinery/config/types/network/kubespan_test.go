@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -140,6 +141,17 @@ func TestKubeSpanConfigValidate(t *testing.T) {
 			},
 			expectedError: `KubeSpan endpoint filter is not valid: "/8"`,
 		},
+		{
+			name: "key rotation interval too short",
+			cfg: func() *network.KubeSpanConfigV1Alpha1 {
+				cfg := network.NewKubeSpanV1Alpha1()
+				cfg.ConfigEnabled = new(true)
+				cfg.ConfigKeyRotationInterval = 10 * time.Minute
+
+				return cfg
+			},
+			expectedError: "kubespan key rotation interval must be at least 1h0m0s",
+		},
 		{
 			name: "all options enabled",
 			cfg: func() *network.KubeSpanConfigV1Alpha1 {
@@ -149,6 +161,8 @@ func TestKubeSpanConfigValidate(t *testing.T) {
 				cfg.ConfigAllowDownPeerBypass = new(true)
 				cfg.ConfigHarvestExtraEndpoints = new(true)
 				cfg.ConfigMTU = new(uint32(1400))
+				cfg.ConfigKeyRotationInterval = 30 * 24 * time.Hour
+				cfg.ConfigPerPeerPresharedKeys = new(true)
 
 				return cfg
 			},
@@ -243,6 +257,9 @@ func TestKubeSpanConfigInterface(t *testing.T) {
 		ConfigEndpoints:                 []string{"192.168.0.0/16"},
 		ConfigExcludeAdvertisedNetworks: []meta.Prefix{{Prefix: netip.MustParsePrefix("0.0.0.0/0")}},
 	}
+	cfg.ConfigRelay = new(true)
+	cfg.ConfigKeyRotationInterval = 24 * time.Hour
+	cfg.ConfigPerPeerPresharedKeys = new(true)
 
 	// Test interface methods
 	assert.True(t, cfg.Enabled())
@@ -250,6 +267,9 @@ func TestKubeSpanConfigInterface(t *testing.T) {
 	assert.True(t, cfg.ForceRouting())
 	assert.True(t, cfg.HarvestExtraEndpoints())
 	assert.Equal(t, uint32(1380), cfg.MTU())
+	assert.True(t, cfg.Relay())
+	assert.Equal(t, 24*time.Hour, cfg.KeyRotationInterval())
+	assert.True(t, cfg.PerPeerPresharedKeys())
 	assert.NotNil(t, cfg.Filters())
 	assert.Equal(t, []string{"192.168.0.0/16"}, cfg.Filters().Endpoints())
 	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}, cfg.Filters().ExcludeAdvertisedNetworks())
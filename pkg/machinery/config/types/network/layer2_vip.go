@@ -69,6 +69,15 @@ type Layer2VIPConfigV1Alpha1 struct {
 	//     Selector must match exactly one link, otherwise an error is returned.
 	//     If multiple selectors match the same link, the first one is used.
 	LinkName string `yaml:"link"`
+	//   description: |
+	//     Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the VIP.
+	//
+	//     While the probe fails, this node releases the VIP and does not campaign for it,
+	//     so that the VIP fails over to a healthy node.
+	//   examples:
+	//    - value: >
+	//       "uplink-check"
+	VIPProbe string `yaml:"probe,omitempty"`
 }
 
 // NewLayer2VIPConfigV1Alpha1 creates a new Layer2VIPConfig config document.
@@ -131,6 +140,11 @@ func (s *Layer2VIPConfigV1Alpha1) VIP() netip.Addr {
 	return addr
 }
 
+// Probe implements config.NetworkLayer2VIPConfig interface.
+func (s *Layer2VIPConfigV1Alpha1) Probe() string {
+	return s.VIPProbe
+}
+
 // ConflictsWithKinds implements config.ConflictingDocument interface.
 func (s *Layer2VIPConfigV1Alpha1) ConflictsWithKinds() []string {
 	return []string{HCloudVIPKind}
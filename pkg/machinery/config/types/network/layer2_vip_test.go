@@ -25,6 +25,7 @@ func TestLayer2VIPConfigMarshalStability(t *testing.T) {
 
 	cfg := network.NewLayer2VIPConfigV1Alpha1("1.2.3.4")
 	cfg.LinkName = "net0"
+	cfg.VIPProbe = "uplink-check"
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
@@ -50,6 +51,7 @@ func TestLayer2VIPConfigUnmarshal(t *testing.T) {
 		},
 		MetaName: "1.2.3.4",
 		LinkName: "net0",
+		VIPProbe: "uplink-check",
 	}
 
 	assert.Equal(t, c, docs[0])
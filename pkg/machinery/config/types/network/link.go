@@ -40,10 +40,16 @@ func conflictingLinkKinds(selfKind string) []string {
 		BondKind,
 		BridgeKind,
 		DummyLinkKind,
+		GeneveKind,
+		GREKind,
+		GRETAPKind,
+		IPVLANKind,
 		LinkKind,
+		MACVLANKind,
 		VethKind,
 		VLANKind,
 		VRFKind,
+		VXLANKind,
 		WireguardKind,
 	}, func(kind string) bool {
 		return kind != selfKind
@@ -169,6 +175,15 @@ type RouteConfig struct {
 	//   schema:
 	//     type: string
 	RouteTable nethelpers.RoutingTable `yaml:"table,omitempty"`
+	//   description: |
+	//     Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the route.
+	//
+	//     While the probe fails, the route is withdrawn from the routing table.
+	//     The probe should not depend on the route it gates, otherwise the route is never restored.
+	//   examples:
+	//    - value: >
+	//       "uplink-check"
+	RouteProbe string `yaml:"probe,omitempty"`
 }
 
 // NewLinkConfigV1Alpha1 creates a new LinkConfig config document.
@@ -387,3 +402,8 @@ func (r RouteConfig) Table() optional.Optional[nethelpers.RoutingTable] {
 
 	return optional.Some(r.RouteTable)
 }
+
+// Probe implements NetworkRouteConfig interface.
+func (r RouteConfig) Probe() string {
+	return r.RouteProbe
+}
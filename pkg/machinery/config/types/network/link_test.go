@@ -43,6 +43,7 @@ func TestLinkConfigMarshalStability(t *testing.T) {
 		},
 		{
 			RouteGateway: meta.Addr{Addr: netip.MustParseAddr("fe80::1")},
+			RouteProbe:   "uplink-check",
 		},
 	}
 	cfg.LinkMulticast = new(true)
@@ -89,6 +90,7 @@ func TestLinkConfigUnmarshal(t *testing.T) {
 				},
 				{
 					RouteGateway: meta.Addr{Addr: netip.MustParseAddr("fe80::1")},
+					RouteProbe:   "uplink-check",
 				},
 			},
 			LinkMulticast: new(true),
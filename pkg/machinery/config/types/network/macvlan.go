@@ -0,0 +1,187 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// MACVLANKind is a MACVLAN config document kind.
+const MACVLANKind = "MACVLANConfig"
+
+func init() {
+	registry.Register(MACVLANKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &MACVLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkMACVLANConfig = &MACVLANConfigV1Alpha1{}
+	_ config.ConflictingDocument  = &MACVLANConfigV1Alpha1{}
+	_ config.NamedDocument        = &MACVLANConfigV1Alpha1{}
+	_ config.Validator            = &MACVLANConfigV1Alpha1{}
+)
+
+// MACVLANConfigV1Alpha1 is a config document to create a MACVLAN link (a sub-interface with its own MAC address) over a parent link.
+//
+//	examples:
+//	  - value: exampleMACVLANConfigV1Alpha1()
+//	alias: MACVLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/MACVLANConfig
+type MACVLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the MACVLAN link (interface) to be created.
+	//
+	//   examples:
+	//    - value: >
+	//       "macvlan0"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Name of the parent link (interface) on which the MACVLAN link will be created.
+	//     Link aliases can be used here as well.
+	//
+	//   examples:
+	//    - value: >
+	//       "enp0s3"
+	//   schemaRequired: true
+	ParentLinkConfig string `yaml:"parent,omitempty"`
+	//   description: |
+	//     Set the MACVLAN mode to use.
+	//     If not set, defaults to 'bridge'.
+	//
+	//   examples:
+	//    - value: >
+	//       "bridge"
+	//   values:
+	//     - "private"
+	//     - "vepa"
+	//     - "bridge"
+	//     - "passthru"
+	MACVLANModeConfig *nethelpers.MACVLANMode `yaml:"mode,omitempty"`
+	//   description: |
+	//     Override the hardware (MAC) address of the link.
+	//
+	//   examples:
+	//    - value: >
+	//       nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f:]+$
+	HardwareAddressConfig nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty"`
+
+	//nolint:embeddedstructfieldcheck
+	CommonLinkConfig `yaml:",inline"`
+}
+
+// NewMACVLANConfigV1Alpha1 creates a new MACVLANConfig config document.
+func NewMACVLANConfigV1Alpha1(name string) *MACVLANConfigV1Alpha1 {
+	return &MACVLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       MACVLANKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleMACVLANConfigV1Alpha1() *MACVLANConfigV1Alpha1 {
+	cfg := NewMACVLANConfigV1Alpha1("macvlan0")
+	cfg.ParentLinkConfig = "enp0s3"
+	cfg.MACVLANModeConfig = new(nethelpers.MACVLANModeBridge)
+	cfg.LinkAddresses = []AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("192.168.1.200/24"),
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *MACVLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *MACVLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// MACVLANConfig implements NetworkMACVLANConfig interface.
+func (s *MACVLANConfigV1Alpha1) MACVLANConfig() {}
+
+// ParentLink returns the parent link name.
+func (s *MACVLANConfigV1Alpha1) ParentLink() string {
+	return s.ParentLinkConfig
+}
+
+// MACVLANMode returns the MACVLAN mode.
+func (s *MACVLANConfigV1Alpha1) MACVLANMode() optional.Optional[nethelpers.MACVLANMode] {
+	if s.MACVLANModeConfig == nil {
+		return optional.None[nethelpers.MACVLANMode]()
+	}
+
+	return optional.Some(*s.MACVLANModeConfig)
+}
+
+// HardwareAddress implements NetworkMACVLANConfig interface.
+func (s *MACVLANConfigV1Alpha1) HardwareAddress() optional.Optional[nethelpers.HardwareAddr] {
+	if len(s.HardwareAddressConfig) == 0 {
+		return optional.None[nethelpers.HardwareAddr]()
+	}
+
+	return optional.Some(s.HardwareAddressConfig)
+}
+
+// ConflictsWithKinds implements config.ConflictingDocument interface.
+func (s *MACVLANConfigV1Alpha1) ConflictsWithKinds() []string {
+	return conflictingLinkKinds(MACVLANKind)
+}
+
+// Validate implements config.Validator interface.
+func (s *MACVLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		errs     error
+		warnings []string //nolint:prealloc
+	)
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name must be specified"))
+	}
+
+	if s.ParentLinkConfig == "" {
+		errs = errors.Join(errs, errors.New("parent must be specified"))
+	}
+
+	if s.MACVLANModeConfig != nil && !s.MACVLANModeConfig.IsAMACVLANMode() {
+		errs = errors.Join(errs, errors.New("mode must be one of 'private', 'vepa', 'bridge' or 'passthru'"))
+	}
+
+	extraWarnings, extraErrs := s.CommonLinkConfig.Validate()
+	errs, warnings = errors.Join(errs, extraErrs), append(warnings, extraWarnings...)
+
+	return warnings, errs
+}
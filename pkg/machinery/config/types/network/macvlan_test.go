@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+//go:embed testdata/macvlanconfig.yaml
+var expectedMACVLANConfigDocument []byte
+
+func TestMACVLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewMACVLANConfigV1Alpha1("macvlan0")
+	cfg.ParentLinkConfig = "enp0s3"
+	cfg.MACVLANModeConfig = new(nethelpers.MACVLANModeBridge)
+	cfg.HardwareAddressConfig = nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70}
+	cfg.LinkUp = new(true)
+	cfg.LinkAddresses = []network.AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("192.168.1.200/24"),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedMACVLANConfigDocument, marshaled)
+}
+
+func TestMACVLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedMACVLANConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.MACVLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.MACVLANKind,
+		},
+		MetaName:              "macvlan0",
+		ParentLinkConfig:      "enp0s3",
+		MACVLANModeConfig:     new(nethelpers.MACVLANModeBridge),
+		HardwareAddressConfig: nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70},
+		CommonLinkConfig: network.CommonLinkConfig{
+			LinkUp: new(true),
+			LinkAddresses: []network.AddressConfig{
+				{
+					AddressAddress: netip.MustParsePrefix("192.168.1.200/24"),
+				},
+			},
+		},
+	}, docs[0])
+}
+
+func TestMACVLANValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.MACVLANConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.MACVLANConfigV1Alpha1 {
+				return network.NewMACVLANConfigV1Alpha1("")
+			},
+
+			expectedError: "name must be specified\nparent must be specified",
+		},
+		{
+			name: "invalid mode",
+			cfg: func() *network.MACVLANConfigV1Alpha1 {
+				cfg := network.NewMACVLANConfigV1Alpha1("macvlan0")
+				cfg.ParentLinkConfig = "enp0s3"
+				cfg.MACVLANModeConfig = new(nethelpers.MACVLANMode(16))
+
+				return cfg
+			},
+
+			expectedError: "mode must be one of 'private', 'vepa', 'bridge' or 'passthru'",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.MACVLANConfigV1Alpha1 {
+				cfg := network.NewMACVLANConfigV1Alpha1("macvlan0")
+				cfg.ParentLinkConfig = "enp0s3"
+				cfg.MACVLANModeConfig = new(nethelpers.MACVLANModePassthru)
+				cfg.LinkAddresses = []network.AddressConfig{
+					{
+						AddressAddress: netip.MustParsePrefix("192.168.1.200/24"),
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
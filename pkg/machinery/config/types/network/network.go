@@ -5,6 +5,6 @@
 // Package network provides network machine configuration documents.
 package network
 
-//go:generate go tool github.com/siderolabs/talos/tools/docgen -output network_doc.go network.go bgp.go blackhole_route.go bond.go bridge.go vrf.go default_action_config.go dhcp4.go dhcp6.go dummy.go ethernet.go hcloud_vip.go hostname.go http_probe.go kubespan.go kubespan_endpoints.go layer2_vip.go link.go link_alias.go port_range.go resolver.go routing_rule.go rule_config.go static_host.go tcp_probe.go time_sync.go veth.go vlan.go wireguard.go
+//go:generate go tool github.com/siderolabs/talos/tools/docgen -output network_doc.go network.go bgp.go blackhole_route.go bond.go bridge.go vrf.go default_action_config.go dhcp4.go dhcp6.go dnat_config.go dummy.go egress_rule_config.go ethernet.go geneve.go gre.go hcloud_vip.go hostname.go http_probe.go ipvlan.go kubespan.go kubespan_endpoints.go layer2_vip.go link.go link_alias.go macvlan.go ntp_server.go port_range.go resolver.go routing_rule.go rule_config.go snat_config.go static_host.go tcp_probe.go time_sync.go veth.go vlan.go vxlan.go wireguard.go
 
-//go:generate go tool github.com/siderolabs/deep-copy -type BGPInstanceConfigV1Alpha1 -type BlackholeRouteConfigV1Alpha1 -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type VRFConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DummyLinkConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type HCloudVIPConfigV1Alpha1 -type HTTPProbeConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type KubeSpanConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type Layer2VIPConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LinkAliasConfigV1Alpha1 -type ResolverConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -type TCPProbeConfigV1Alpha1 -type TimeSyncConfigV1Alpha1 -type VethConfigV1Alpha1 -type VLANConfigV1Alpha1 -type WireguardConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type BGPInstanceConfigV1Alpha1 -type BlackholeRouteConfigV1Alpha1 -type BondConfigV1Alpha1 -type BridgeConfigV1Alpha1 -type VRFConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type DHCPv4ConfigV1Alpha1 -type DHCPv6ConfigV1Alpha1 -type DNATConfigV1Alpha1 -type DummyLinkConfigV1Alpha1 -type EgressRuleConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type GeneveConfigV1Alpha1 -type GREConfigV1Alpha1 -type GRETAPConfigV1Alpha1 -type HCloudVIPConfigV1Alpha1 -type HTTPProbeConfigV1Alpha1 -type HostnameConfigV1Alpha1 -type IPVLANConfigV1Alpha1 -type KubeSpanConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type Layer2VIPConfigV1Alpha1 -type LinkConfigV1Alpha1 -type LinkAliasConfigV1Alpha1 -type MACVLANConfigV1Alpha1 -type NTPServerConfigV1Alpha1 -type ResolverConfigV1Alpha1 -type RoutingRuleConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SNATConfigV1Alpha1 -type StaticHostConfigV1Alpha1 -type TCPProbeConfigV1Alpha1 -type TimeSyncConfigV1Alpha1 -type VethConfigV1Alpha1 -type VLANConfigV1Alpha1 -type VXLANConfigV1Alpha1 -type WireguardConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
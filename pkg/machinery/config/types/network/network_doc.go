@@ -67,6 +67,13 @@ func (BGPInstanceConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Names or aliases of the links whose addresses are originated into BGP as connected networks using their configured prefix lengths.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Names or aliases of the links whose addresses are originated into BGP as connected networks using their configured prefix lengths." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "advertiseProbes",
+				Type:        "[]BGPAdvertiseProbe",
+				Note:        "",
+				Description: "Probes gating the advertisement of the links listed in `advertise`.\nWhile the probe fails, the addresses of the link are withdrawn from BGP.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Probes gating the advertisement of the links listed in `advertise`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "multipath",
 				Type:        "bool",
@@ -102,6 +109,13 @@ func (BGPInstanceConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "BGP neighbors in this routing instance.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "BGP neighbors in this routing instance." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "gracefulRestart",
+				Type:        "BGPGracefulRestartConfig",
+				Note:        "",
+				Description: "Graceful restart (RFC 4724) settings for all neighbors of this instance.\nThe presence of this block enables graceful restart; an empty block uses the defaults.\nWith graceful restart, neighbors keep the routes of this node while its BGP sessions are restarted,\nfor example during a Talos reboot or upgrade.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Graceful restart (RFC 4724) settings for all neighbors of this instance." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -116,6 +130,72 @@ func (BGPInstanceConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (BGPGracefulRestartConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPGracefulRestartConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPGracefulRestartConfig configures BGP graceful restart." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPGracefulRestartConfig configures BGP graceful restart.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BGPInstanceConfigV1Alpha1",
+				FieldName: "gracefulRestart",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "restartTime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Time the neighbors keep the routes of this node after the session goes down.\nDefaults to 120s, the maximum is 4095s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Time the neighbors keep the routes of this node after the session goes down." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "longLivedStaleTime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Enables long-lived graceful restart (RFC 9494): once the restart time expires,\nthe neighbors keep the routes of this node as least-preferred for this duration.\nIf not set, long-lived graceful restart is disabled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enables long-lived graceful restart (RFC 9494): once the restart time expires," /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[1].AddExample("", time.Hour)
+
+	return doc
+}
+
+func (BGPAdvertiseProbe) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPAdvertiseProbe",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPAdvertiseProbe gates the advertisement of a link's addresses on a probe." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPAdvertiseProbe gates the advertisement of a link's addresses on a probe.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BGPInstanceConfigV1Alpha1",
+				FieldName: "advertiseProbes",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "link",
+				Type:        "string",
+				Note:        "",
+				Description: "Name or alias of the advertised link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name or alias of the advertised link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (BGPImportRoute) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "BGPImportRoute",
@@ -209,9 +289,125 @@ func (BGPNeighborConfig) Doc() *encoder.Doc {
 				Description: "BFD (Bidirectional Forwarding Detection) settings for this neighbor.\nThe presence of this block enables BFD; an empty block uses the implementation defaults.\nBFD is supported only when the BGP instance uses the default routing domain, not a VRF.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "BFD (Bidirectional Forwarding Detection) settings for this neighbor." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "password",
+				Type:        "string",
+				Note:        "",
+				Description: "TCP-MD5 (RFC 2385) password used to authenticate the session with this neighbor.\nTCP-AO is not supported.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP-MD5 (RFC 2385) password used to authenticate the session with this neighbor." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "import",
+				Type:        "BGPRoutePolicy",
+				Note:        "",
+				Description: "Policy applied to routes received from this neighbor.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Policy applied to routes received from this neighbor." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "export",
+				Type:        "BGPRoutePolicy",
+				Note:        "",
+				Description: "Policy applied to routes advertised to this neighbor.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Policy applied to routes advertised to this neighbor." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (BGPRoutePolicy) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPRoutePolicy",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPRoutePolicy filters and modifies routes exchanged with a BGP neighbor." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPRoutePolicy filters and modifies routes exchanged with a BGP neighbor.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BGPNeighborConfig",
+				FieldName: "import",
+			},
+			{
+				TypeName:  "BGPNeighborConfig",
+				FieldName: "export",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "prefixes",
+				Type:        "[]BGPPrefixFilter",
+				Note:        "",
+				Description: "Prefix list the routes must match to be accepted.\nIf not set, routes of any prefix are accepted.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix list the routes must match to be accepted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "matchCommunities",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Standard communities (`ASN:value`), at least one of which must be carried by the route for it to be accepted.\nIf not set, routes are accepted regardless of their communities.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Standard communities (`ASN:value`), at least one of which must be carried by the route for it to be accepted." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "addCommunities",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Standard communities (`ASN:value`) added to the accepted routes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Standard communities (`ASN:value`) added to the accepted routes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "addLargeCommunities",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Large communities (`ASN:value:value`) added to the accepted routes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Large communities (`ASN:value:value`) added to the accepted routes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "asPathPrepend",
+				Type:        "uint8",
+				Note:        "",
+				Description: "Number of times the local ASN is prepended to the AS path of the advertised routes.\nOnly supported in the `export` policy.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of times the local ASN is prepended to the AS path of the advertised routes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
+	doc.Fields[1].AddExample("", []string{"65000:100"})
+	doc.Fields[2].AddExample("", []string{"65001:200"})
+	doc.Fields[3].AddExample("", []string{"65001:1:2"})
+
+	return doc
+}
+
+func (BGPPrefixFilter) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPPrefixFilter",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPPrefixFilter is a single entry of a BGP prefix list." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPPrefixFilter is a single entry of a BGP prefix list.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BGPRoutePolicy",
+				FieldName: "prefixes",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "prefix",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Prefix matched by this entry.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix matched by this entry." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxLength",
+				Type:        "uint8",
+				Note:        "",
+				Description: "Longest prefix length matched by this entry.\nIf not set, only the exact prefix length matches.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Longest prefix length matched by this entry." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", meta.Prefix{Prefix: netip.MustParsePrefix("10.0.0.0/8")})
+
 	return doc
 }
 
@@ -776,8 +972,8 @@ func (VRFConfigV1Alpha1) Doc() *encoder.Doc {
 func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkDefaultActionConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDefaultActionConfig is a ingress firewall default action configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "NetworkDefaultActionConfig is a ingress firewall default action configuration document.",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDefaultActionConfig is a firewall default action configuration document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkDefaultActionConfig is a firewall default action configuration document.",
 		Fields: []encoder.Doc{
 			{
 				Type:   "Meta",
@@ -794,6 +990,17 @@ func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 					"block",
 				},
 			},
+			{
+				Name:        "egress",
+				Type:        "DefaultAction",
+				Note:        "",
+				Description: "Default action for all not explicitly configured egress traffic originating from the host: accept or block.\n\nEgress rules are configured with `NetworkEgressRuleConfig` documents.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Default action for all not explicitly configured egress traffic originating from the host: accept or block." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"accept",
+					"block",
+				},
+			},
 		},
 	}
 
@@ -859,6 +1066,27 @@ func (DHCPv4ConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Raw value of the DUID to use as client identifier.\n\nThis field is only used if 'clientIdentifier' is set to 'duid'.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Raw value of the DUID to use as client identifier." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "requestOptions",
+				Type:        "[]int",
+				Note:        "",
+				Description: "Additional DHCP option codes to request from the DHCP server.\n\nRaw values of all options received from the DHCP server are published\nin the `DHCPOptions` resource.\nOption 249 (Microsoft classless static routes) is used as a fallback for option 121\nif it is requested and the server doesn't send option 121.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Additional DHCP option codes to request from the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vendorClass",
+				Type:        "string",
+				Note:        "",
+				Description: "Vendor class identifier (option 60) to send to the DHCP server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Vendor class identifier (option 60) to send to the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "userClass",
+				Type:        "[]string",
+				Note:        "",
+				Description: "User class (option 77) values to send to the DHCP server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "User class (option 77) values to send to the DHCP server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -866,6 +1094,9 @@ func (DHCPv4ConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.Fields[1].AddExample("", "enp0s2")
 	doc.Fields[6].AddExample("", "00:01:00:01:23:45:67:89:ab:cd:ef:01:23:45")
+	doc.Fields[7].AddExample("", []int{43, 224, 249})
+	doc.Fields[8].AddExample("", "PXEClient")
+	doc.Fields[9].AddExample("", []string{"provisioning"})
 
 	return doc
 }
@@ -920,6 +1151,13 @@ func (DHCPv6ConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Raw value of the DUID to use as client identifier.\n\nThis field is only used if 'clientIdentifier' is set to 'duid'.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Raw value of the DUID to use as client identifier." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "prefixDelegation",
+				Type:        "DHCPv6PrefixDelegationConfig",
+				Note:        "",
+				Description: "DHCPv6 prefix delegation (DHCPv6-PD) configuration.\n\nIf set, a prefix is requested from the DHCPv6 server on this link, and /64 subnets\nof the delegated prefix are assigned to the downstream links.\nRouter advertisements are sent on the downstream links, so that hosts can configure\naddresses with SLAAC.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6 prefix delegation (DHCPv6-PD) configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -927,54 +1165,87 @@ func (DHCPv6ConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.Fields[1].AddExample("", "enp0s2")
 	doc.Fields[5].AddExample("", "00:01:00:01:23:45:67:89:ab:cd:ef:01:23:45")
+	doc.Fields[6].AddExample("", exampleDHCPv6PrefixDelegationConfig())
 
 	return doc
 }
 
-func (DummyLinkConfigV1Alpha1) Doc() *encoder.Doc {
+func (DHCPv6PrefixDelegationConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "DummyLinkConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "DummyLinkConfig is a config document to create a dummy (virtual) network link." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "DummyLinkConfig is a config document to create a dummy (virtual) network link.",
+		Type:        "DHCPv6PrefixDelegationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6PrefixDelegationConfig is the DHCPv6 prefix delegation configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6PrefixDelegationConfig is the DHCPv6 prefix delegation configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DHCPv6ConfigV1Alpha1",
+				FieldName: "prefixDelegation",
+			},
+		},
 		Fields: []encoder.Doc{
 			{
-				Type:   "Meta",
-				Inline: true,
+				Name:        "prefixLength",
+				Type:        "int",
+				Note:        "",
+				Description: "Prefix length to request from the DHCPv6 server.\n\nThis is a hint, the server might delegate a prefix of a different length.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix length to request from the DHCPv6 server." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "name",
-				Type:        "string",
+				Name:        "downstreams",
+				Type:        "[]DHCPv6PrefixDelegationDownstream",
 				Note:        "",
-				Description: "Name of the dummy link (interface).",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the dummy link (interface)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Downstream links to assign /64 subnets of the delegated prefix to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Downstream links to assign /64 subnets of the delegated prefix to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDHCPv6PrefixDelegationConfig())
+
+	doc.Fields[0].AddExample("", 56)
+
+	return doc
+}
+
+func (DHCPv6PrefixDelegationDownstream) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "DHCPv6PrefixDelegationDownstream",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DHCPv6PrefixDelegationDownstream is a downstream link of the delegated prefix." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DHCPv6PrefixDelegationDownstream is a downstream link of the delegated prefix.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DHCPv6PrefixDelegationConfig",
+				FieldName: "downstreams",
 			},
+		},
+		Fields: []encoder.Doc{
 			{
-				Name:        "hardwareAddr",
-				Type:        "HardwareAddr",
+				Name:        "link",
+				Type:        "string",
 				Note:        "",
-				Description: "Override the hardware (MAC) address of the link.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the hardware (MAC) address of the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name or alias of the downstream link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name or alias of the downstream link." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Type:   "CommonLinkConfig",
-				Inline: true,
+				Name:        "subnetID",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Index of the /64 subnet of the delegated prefix to assign to the link.\n\nE.g. for the delegated prefix 2001:db8:0:ab00::/56 and subnet ID 1,\nthe link gets 2001:db8:0:ab01::/64.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the /64 subnet of the delegated prefix to assign to the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
-	doc.AddExample("", exampleDummyLinkConfigV1Alpha1())
-
-	doc.Fields[1].AddExample("", "dummy1")
-	doc.Fields[2].AddExample("", nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70})
+	doc.Fields[0].AddExample("", "br0")
+	doc.Fields[1].AddExample("", 1)
 
 	return doc
 }
 
-func (EthernetConfigV1Alpha1) Doc() *encoder.Doc {
+func (DNATConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "EthernetConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetConfig is a config document to configure Ethernet interfaces." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "EthernetConfig is a config document to configure Ethernet interfaces.",
+		Type:        "NetworkDNATConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkDNATConfig is a destination NAT (port forwarding) config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkDNATConfig is a destination NAT (port forwarding) config document.",
 		Fields: []encoder.Doc{
 			{
 				Type:   "Meta",
@@ -984,79 +1255,256 @@ func (EthernetConfigV1Alpha1) Doc() *encoder.Doc {
 				Name:        "name",
 				Type:        "string",
 				Note:        "",
-				Description: "Name of the link (interface).",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (interface)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "features",
-				Type:        "map[string]bool",
+				Name:        "inLinkName",
+				Type:        "string",
 				Note:        "",
-				Description: "Configuration for Ethernet features.\n\nSet of features available and whether they can be enabled or disabled is driver specific.\nUse `talosctl get ethernetstatus <link> -o yaml` to get the list of available features and\ntheir current status.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration for Ethernet features." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name of the link the traffic enters the host through.\n\nIf not set, the traffic is forwarded regardless of the incoming link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link the traffic enters the host through." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "rings",
-				Type:        "EthernetRingsConfig",
+				Name:        "portSelector",
+				Type:        "RulePortSelector",
 				Note:        "",
-				Description: "Configuration for Ethernet link rings.\n\nThis is similar to `ethtool -G` command.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration for Ethernet link rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Port selector defines which host ports and protocols are forwarded.\n\nOnly `tcp` and `udp` protocols are supported.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which host ports and protocols are forwarded." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "channels",
-				Type:        "EthernetChannelsConfig",
+				Name:        "ingress",
+				Type:        "[]IngressRule",
 				Note:        "",
-				Description: "Configuration for Ethernet link channels.\n\nThis is similar to `ethtool -L` command.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration for Ethernet link channels." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Ingress defines which source subnets are allowed to use the port forwarding.\n\nIf not set, traffic from any source is forwarded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Ingress defines which source subnets are allowed to use the port forwarding." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "wakeOnLan",
-				Type:        "[]WOLMode",
+				Name:        "toAddress",
+				Type:        "Addr",
 				Note:        "",
-				Description: "Wake-on-LAN modes to enable.\n\nIf this field is omitted, Wake-on-LAN configuration is not changed.\nAn empty list disables Wake-on-LAN.\n\nThis is similar to `ethtool -s <link> wol <options>` command.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Wake-on-LAN modes to enable." /* encoder.LineComment */, "" /* encoder.FootComment */},
-				Values: []string{
-					"phy",
-					"unicast",
-					"multicast",
-					"broadcast",
-					"arp",
-					"magic",
-					"magicsecure",
-					"filter",
-				},
+				Description: "Address to forward the traffic to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to forward the traffic to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "toPort",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Port to forward the traffic to.\n\nIf not set, the original destination port is kept.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port to forward the traffic to." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
-	doc.AddExample("", exampleEthernetConfigV1Alpha1())
+	doc.AddExample("", exampleDNATConfigV1Alpha1())
 
-	doc.Fields[5].AddExample("", []nethelpers.WOLMode{nethelpers.WOLModeUnicast, nethelpers.WOLModeMagic})
+	doc.Fields[2].AddExample("", "eth0")
+	doc.Fields[5].AddExample("", meta.Addr{Addr: netip.MustParseAddr("10.5.0.10")})
+	doc.Fields[6].AddExample("", uint16(8080))
 
 	return doc
 }
 
-func (EthernetRingsConfig) Doc() *encoder.Doc {
+func (DummyLinkConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "EthernetRingsConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetRingsConfig is a configuration for Ethernet link rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "EthernetRingsConfig is a configuration for Ethernet link rings.",
-		AppearsIn: []encoder.Appearance{
+		Type:        "DummyLinkConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "DummyLinkConfig is a config document to create a dummy (virtual) network link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "DummyLinkConfig is a config document to create a dummy (virtual) network link.",
+		Fields: []encoder.Doc{
 			{
-				TypeName:  "EthernetConfigV1Alpha1",
-				FieldName: "rings",
+				Type:   "Meta",
+				Inline: true,
 			},
-		},
-		Fields: []encoder.Doc{
 			{
-				Name:        "rx",
-				Type:        "uint32",
+				Name:        "name",
+				Type:        "string",
 				Note:        "",
-				Description: "Number of RX rings.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of RX rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name of the dummy link (interface).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the dummy link (interface)." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "tx",
-				Type:        "uint32",
+				Name:        "hardwareAddr",
+				Type:        "HardwareAddr",
+				Note:        "",
+				Description: "Override the hardware (MAC) address of the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the hardware (MAC) address of the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
+			},
+		},
+	}
+
+	doc.AddExample("", exampleDummyLinkConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "dummy1")
+	doc.Fields[2].AddExample("", nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70})
+
+	return doc
+}
+
+func (EgressRuleConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetworkEgressRuleConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkEgressRuleConfig is a network firewall egress rule config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkEgressRuleConfig is a network firewall egress rule config document.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "portSelector",
+				Type:        "RulePortSelector",
+				Note:        "",
+				Description: "Port selector defines which destination ports and protocols are affected by the rule.\n\nIf no ports are specified, the rule applies to all ports of the protocol.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Port selector defines which destination ports and protocols are affected by the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "egress",
+				Type:        "[]EgressRule",
+				Note:        "",
+				Description: "Egress defines which destination subnets the host is allowed to reach on the ports/protocols defined by the `portSelector`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Egress defines which destination subnets the host is allowed to reach on the ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleEgressRuleConfigV1Alpha1())
+
+	return doc
+}
+
+func (EgressRule) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EgressRule",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EgressRule is an egress rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EgressRule is an egress rule.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EgressRuleConfigV1Alpha1",
+				FieldName: "egress",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "subnet",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Subnet defines a destination subnet.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Subnet defines a destination subnet." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "except",
+				Type:        "Prefix",
+				Note:        "",
+				Description: "Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Except defines a destination subnet to exclude from the rule, it gets excluded from the `subnet`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("10.3.4.0/24"))
+	doc.Fields[0].AddExample("", netip.MustParsePrefix("2001:db8::/32"))
+
+	return doc
+}
+
+func (EthernetConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthernetConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetConfig is a config document to configure Ethernet interfaces." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthernetConfig is a config document to configure Ethernet interfaces.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link (interface).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link (interface)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "features",
+				Type:        "map[string]bool",
+				Note:        "",
+				Description: "Configuration for Ethernet features.\n\nSet of features available and whether they can be enabled or disabled is driver specific.\nUse `talosctl get ethernetstatus <link> -o yaml` to get the list of available features and\ntheir current status.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration for Ethernet features." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rings",
+				Type:        "EthernetRingsConfig",
+				Note:        "",
+				Description: "Configuration for Ethernet link rings.\n\nThis is similar to `ethtool -G` command.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration for Ethernet link rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "channels",
+				Type:        "EthernetChannelsConfig",
+				Note:        "",
+				Description: "Configuration for Ethernet link channels.\n\nThis is similar to `ethtool -L` command.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration for Ethernet link channels." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "wakeOnLan",
+				Type:        "[]WOLMode",
+				Note:        "",
+				Description: "Wake-on-LAN modes to enable.\n\nIf this field is omitted, Wake-on-LAN configuration is not changed.\nAn empty list disables Wake-on-LAN.\n\nThis is similar to `ethtool -s <link> wol <options>` command.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Wake-on-LAN modes to enable." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"phy",
+					"unicast",
+					"multicast",
+					"broadcast",
+					"arp",
+					"magic",
+					"magicsecure",
+					"filter",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleEthernetConfigV1Alpha1())
+
+	doc.Fields[5].AddExample("", []nethelpers.WOLMode{nethelpers.WOLModeUnicast, nethelpers.WOLModeMagic})
+
+	return doc
+}
+
+func (EthernetRingsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthernetRingsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetRingsConfig is a configuration for Ethernet link rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthernetRingsConfig is a configuration for Ethernet link rings.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthernetConfigV1Alpha1",
+				FieldName: "rings",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of RX rings.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of RX rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tx",
+				Type:        "uint32",
 				Note:        "",
 				Description: "Number of TX rings.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of TX rings." /* encoder.LineComment */, "" /* encoder.FootComment */},
@@ -1169,6 +1617,186 @@ func (EthernetChannelsConfig) Doc() *encoder.Doc {
 	return doc
 }
 
+func (GeneveConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "GeneveConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "GeneveConfig is a config document to create a Geneve (generic network virtualization encapsulation) tunnel link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "GeneveConfig is a config document to create a Geneve (generic network virtualization encapsulation) tunnel link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the Geneve link (interface) to be created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the Geneve link (interface) to be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vni",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Geneve network identifier (VNI).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Geneve network identifier (VNI)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "remote",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Remote IP address of the tunnel endpoint.\n\nGeneve links can't be bound to a local address or an underlay link,\nthe outgoing link is selected by the route lookup for this address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Remote IP address of the tunnel endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Destination UDP port.\nIf not set, defaults to the IANA-assigned port 6081.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Destination UDP port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
+			},
+		},
+	}
+
+	doc.AddExample("", exampleGeneveConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "geneve42")
+	doc.Fields[2].AddExample("", 42)
+	doc.Fields[3].AddExample("", meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")})
+	doc.Fields[4].AddExample("", 6081)
+
+	return doc
+}
+
+func (GREConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "GREConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "GREConfig is a config document to create a GRE (generic routing encapsulation) layer 3 tunnel link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "GREConfig is a config document to create a GRE (generic routing encapsulation) layer 3 tunnel link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the GRE link (interface) to be created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the GRE link (interface) to be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Type:   "GRETunnelConfig",
+				Inline: true,
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
+			},
+		},
+	}
+
+	doc.AddExample("", exampleGREConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "gre0")
+
+	return doc
+}
+
+func (GRETAPConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "GRETAPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "GRETAPConfig is a config document to create a GRETAP (Ethernet over GRE) layer 2 tunnel link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "GRETAPConfig is a config document to create a GRETAP (Ethernet over GRE) layer 2 tunnel link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the GRETAP link (interface) to be created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the GRETAP link (interface) to be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Type:   "GRETunnelConfig",
+				Inline: true,
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
+			},
+		},
+	}
+
+	doc.AddExample("", exampleGRETAPConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "gretap0")
+
+	return doc
+}
+
+func (GRETunnelConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "GRETunnelConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "GRETunnelConfig is the common configuration for GRE and GRETAP tunnels." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "GRETunnelConfig is the common configuration for GRE and GRETAP tunnels.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "GREConfigV1Alpha1",
+				FieldName: "",
+			},
+			{
+				TypeName:  "GRETAPConfigV1Alpha1",
+				FieldName: "",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the underlay link (interface) the tunnel traffic is sent over.\nLink aliases can be used here as well.\n\nIf not set, the underlay link is selected by the route lookup for the remote address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the underlay link (interface) the tunnel traffic is sent over." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "local",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Local (source) IP address of the tunnel endpoint.\nIf not set, the source address is selected by the kernel.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Local (source) IP address of the tunnel endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "remote",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Remote IP address of the tunnel endpoint.\n\nIPv4 endpoints create `gre`/`gretap` links, IPv6 endpoints create `ip6gre`/`ip6gretap` links.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Remote IP address of the tunnel endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "key",
+				Type:        "uint32",
+				Note:        "",
+				Description: "GRE key used for both directions of the tunnel.\nIf not set, packets are sent without a key.\nThe key must not be zero, as the kernel does not tell a zero key from no key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "GRE key used for both directions of the tunnel." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "enp0s3")
+	doc.Fields[1].AddExample("", meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")})
+	doc.Fields[2].AddExample("", meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")})
+	doc.Fields[3].AddExample("", 42)
+
+	return doc
+}
+
 func (HCloudVIPConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "HCloudVIPConfig",
@@ -1193,6 +1821,13 @@ func (HCloudVIPConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Name of the link to assign the VIP to.\n\nSelector must match exactly one link, otherwise an error is returned.\nIf multiple selectors match the same link, the first one is used.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to assign the VIP to." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the VIP.\n\nWhile the probe fails, this node releases the VIP and does not campaign for it,\nso that the VIP fails over to a healthy node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the VIP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "apiToken",
 				Type:        "string",
@@ -1207,6 +1842,7 @@ func (HCloudVIPConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.Fields[1].AddExample("", "192.168.100.1")
 	doc.Fields[1].AddExample("", "fd00::1")
+	doc.Fields[3].AddExample("", "uplink-check")
 
 	return doc
 }
@@ -1299,6 +1935,58 @@ func (HTTPProbeConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (IPVLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "IPVLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "IPVLANConfig is a config document to create an IPVLAN link (a sub-interface sharing the MAC address of the parent) over a parent link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "IPVLANConfig is a config document to create an IPVLAN link (a sub-interface sharing the MAC address of the parent) over a parent link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the IPVLAN link (interface) to be created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the IPVLAN link (interface) to be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the parent link (interface) on which the IPVLAN link will be created.\nLink aliases can be used here as well.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the parent link (interface) on which the IPVLAN link will be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mode",
+				Type:        "IPVLANMode",
+				Note:        "",
+				Description: "Set the IPVLAN mode to use.\nIf not set, defaults to 'l2'.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set the IPVLAN mode to use." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"l2",
+					"l3",
+					"l3s",
+				},
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
+			},
+		},
+	}
+
+	doc.AddExample("", exampleIPVLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "ipvlan0")
+	doc.Fields[2].AddExample("", "enp0s3")
+	doc.Fields[3].AddExample("", "l3")
+
+	return doc
+}
+
 func (KubeSpanConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "KubeSpanConfig",
@@ -1351,11 +2039,34 @@ func (KubeSpanConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "KubeSpan advanced filtering of network addresses.\nSettings are optional and apply only to this node.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "KubeSpan advanced filtering of network addresses." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "relay",
+				Type:        "bool",
+				Note:        "",
+				Description: "Offer this node as a relay for KubeSpan peers which can't establish a direct connection\nto each other (e.g. behind double NAT or strict firewalls).\n\nRelay capability is advertised to other nodes via the cluster discovery.\nWhen a peer is down, each node picks the relay with the lowest public key among\nthe relays it is connected to, and routes the traffic to the peer via that relay,\nwhile still trying to re-establish the direct connection.\nRelaying works only if both ends of the broken connection have the same relay up.\n\nRelay nodes should have good connectivity to all other nodes, and should allow\nforwarding of the traffic between KubeSpan peers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Offer this node as a relay for KubeSpan peers which can't establish a direct connection" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "keyRotationInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Rotate the KubeSpan identity key of the node with the specified interval.\n\nThe next key is announced to the peers via the cluster discovery ahead of the rotation,\nand the peers accept both the old and the new key during the overlap window around the rotation.\nRotation can be forced with `talosctl kubespan rotate-key`.\n\nDefault value is 0 (no scheduled rotation), the minimum interval is 1h.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Rotate the KubeSpan identity key of the node with the specified interval." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "perPeerPresharedKeys",
+				Type:        "bool",
+				Note:        "",
+				Description: "Derive a separate Wireguard preshared key for each pair of peers from the cluster secret\n(instead of using the cluster secret as the preshared key for all peers).\n\nThe preshared key is mixed into the Wireguard handshake to protect the traffic against\nthe future quantum computers which might break the Curve25519 key exchange.\nShould be enabled on all nodes of the cluster at once, as the peers with different settings\ncan't establish a connection.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Derive a separate Wireguard preshared key for each pair of peers from the cluster secret" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", exampleKubeSpanV1Alpha1())
 
+	doc.Fields[8].AddExample("", 30*24*time.Hour)
+
 	return doc
 }
 
@@ -1443,6 +2154,13 @@ func (Layer2VIPConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Name of the link to assign the VIP to.\n\nSelector must match exactly one link, otherwise an error is returned.\nIf multiple selectors match the same link, the first one is used.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to assign the VIP to." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the VIP.\n\nWhile the probe fails, this node releases the VIP and does not campaign for it,\nso that the VIP fails over to a healthy node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the VIP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -1450,6 +2168,7 @@ func (Layer2VIPConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.Fields[1].AddExample("", "192.168.100.1")
 	doc.Fields[1].AddExample("", "fd00::1")
+	doc.Fields[3].AddExample("", "uplink-check")
 
 	return doc
 }
@@ -1508,10 +2227,30 @@ func (CommonLinkConfig) Doc() *encoder.Doc {
 				TypeName:  "DummyLinkConfigV1Alpha1",
 				FieldName: "",
 			},
+			{
+				TypeName:  "GeneveConfigV1Alpha1",
+				FieldName: "",
+			},
+			{
+				TypeName:  "GREConfigV1Alpha1",
+				FieldName: "",
+			},
+			{
+				TypeName:  "GRETAPConfigV1Alpha1",
+				FieldName: "",
+			},
+			{
+				TypeName:  "IPVLANConfigV1Alpha1",
+				FieldName: "",
+			},
 			{
 				TypeName:  "LinkConfigV1Alpha1",
 				FieldName: "",
 			},
+			{
+				TypeName:  "MACVLANConfigV1Alpha1",
+				FieldName: "",
+			},
 			{
 				TypeName:  "VethConfigV1Alpha1",
 				FieldName: "",
@@ -1524,6 +2263,10 @@ func (CommonLinkConfig) Doc() *encoder.Doc {
 				TypeName:  "VLANConfigV1Alpha1",
 				FieldName: "",
 			},
+			{
+				TypeName:  "VXLANConfigV1Alpha1",
+				FieldName: "",
+			},
 			{
 				TypeName:  "WireguardConfigV1Alpha1",
 				FieldName: "",
@@ -1633,105 +2376,242 @@ func (RouteConfig) Doc() *encoder.Doc {
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The route's gateway (if empty, creates link scope route)." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "source",
-				Type:        "Addr",
+				Name:        "source",
+				Type:        "Addr",
+				Note:        "",
+				Description: "The route's source address (optional).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The route's source address (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "metric",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The optional metric for the route.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The optional metric for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "The optional MTU for the route.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The optional MTU for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "table",
+				Type:        "RoutingTable",
+				Note:        "",
+				Description: "The routing table to use for the route.\n\nIf not specified, the main routing table will be used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The routing table to use for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "probe",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the route.\n\nWhile the probe fails, the route is withdrawn from the routing table.\nThe probe should not depend on the route it gates, otherwise the route is never restored.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the probe (`TCPProbeConfig` or `HTTPProbeConfig`) gating the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", meta.Prefix{netip.MustParsePrefix("10.0.0.0/8")})
+	doc.Fields[1].AddExample("", meta.Addr{netip.MustParseAddr("10.0.0.1")})
+	doc.Fields[6].AddExample("", "uplink-check")
+
+	return doc
+}
+
+func (LinkAliasConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LinkAliasConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkAliasConfig is a config document to alias (give a different name) to a physical link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LinkAliasConfig is a config document to alias (give a different name) to a physical link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Alias for the link.\n\nDon't use system interface names like \"eth0\", \"ens3\", \"enp0s2\", etc. as those may conflict\nwith existing physical interfaces.\n\nThe name can contain a single integer format verb (`%d`) to create multiple aliases\nfrom a single config document. When a format verb is detected, each matched link receives a sequential\nalias (e.g. `net0`, `net1`, ...) based on hardware address order of the links.\nLinks already aliased by a previous config are automatically skipped.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Alias for the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "selector",
+				Type:        "LinkSelector",
+				Note:        "",
+				Description: "Selector to match the link to alias.\n\nWhen the alias name is a fixed string, the selector must match exactly one link.\nWhen the alias name contains a format verb (e.g. `net%d`), the selector may match multiple links\nand each match receives a sequential alias.\nIf multiple selectors match the same link, the first one is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector to match the link to alias." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLinkAliasConfigV1Alpha1())
+
+	doc.AddExample("", exampleLinkAliasMultipleConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "net0")
+	doc.Fields[1].AddExample("", "private")
+	doc.Fields[1].AddExample("", "net%d")
+
+	return doc
+}
+
+func (LinkSelector) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LinkSelector",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkSelector selects a link to alias." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LinkSelector selects a link to alias.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "LinkAliasConfigV1Alpha1",
+				FieldName: "selector",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "match",
+				Type:        "Expression",
+				Note:        "",
+				Description: "The Common Expression Language (CEL) expression to match the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The Common Expression Language (CEL) expression to match the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("match links with a specific MAC address", exampleLinkSelector1())
+	doc.Fields[0].AddExample("match links by MAC address prefix", exampleLinkSelector2())
+	doc.Fields[0].AddExample("match links by driver name", exampleLinkSelector3())
+
+	return doc
+}
+
+func (MACVLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MACVLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MACVLANConfig is a config document to create a MACVLAN link (a sub-interface with its own MAC address) over a parent link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MACVLANConfig is a config document to create a MACVLAN link (a sub-interface with its own MAC address) over a parent link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
 				Note:        "",
-				Description: "The route's source address (optional).",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "The route's source address (optional)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name of the MACVLAN link (interface) to be created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the MACVLAN link (interface) to be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "metric",
-				Type:        "uint32",
+				Name:        "parent",
+				Type:        "string",
 				Note:        "",
-				Description: "The optional metric for the route.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "The optional metric for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Name of the parent link (interface) on which the MACVLAN link will be created.\nLink aliases can be used here as well.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the parent link (interface) on which the MACVLAN link will be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "mtu",
-				Type:        "uint32",
+				Name:        "mode",
+				Type:        "MACVLANMode",
 				Note:        "",
-				Description: "The optional MTU for the route.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "The optional MTU for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Set the MACVLAN mode to use.\nIf not set, defaults to 'bridge'.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Set the MACVLAN mode to use." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"private",
+					"vepa",
+					"bridge",
+					"passthru",
+				},
 			},
 			{
-				Name:        "table",
-				Type:        "RoutingTable",
+				Name:        "hardwareAddr",
+				Type:        "HardwareAddr",
 				Note:        "",
-				Description: "The routing table to use for the route.\n\nIf not specified, the main routing table will be used.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "The routing table to use for the route." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Override the hardware (MAC) address of the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Override the hardware (MAC) address of the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
 			},
 		},
 	}
 
-	doc.Fields[0].AddExample("", meta.Prefix{netip.MustParsePrefix("10.0.0.0/8")})
-	doc.Fields[1].AddExample("", meta.Addr{netip.MustParseAddr("10.0.0.1")})
+	doc.AddExample("", exampleMACVLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "macvlan0")
+	doc.Fields[2].AddExample("", "enp0s3")
+	doc.Fields[3].AddExample("", "bridge")
+	doc.Fields[4].AddExample("", nethelpers.HardwareAddr{0x2e, 0x3c, 0x4d, 0x5e, 0x6f, 0x70})
 
 	return doc
 }
 
-func (LinkAliasConfigV1Alpha1) Doc() *encoder.Doc {
+func (NTPServerConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "LinkAliasConfig",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkAliasConfig is a config document to alias (give a different name) to a physical link." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "LinkAliasConfig is a config document to alias (give a different name) to a physical link.",
+		Type:        "NTPServerConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NTPServerConfig is a config document to serve time to other machines over NTP." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NTPServerConfig is a config document to serve time to other machines over NTP.\nThe machine answers NTP (RFC 5905) client requests with the state of its own clock,\nas synchronized according to `TimeSyncConfig` (from NTP servers or PTP devices).\nStratum and leap second state are derived from the time source; until the clock is synchronized,\nresponses are marked as unsynchronized, so that clients ignore them.\n\nOptionally, the machine runs an NTS-KE (RFC 8915) server, so that clients can authenticate the time they receive.\n\nThe server accepts requests from any client: access should be restricted with `NetworkRuleConfig` documents\n(UDP port 123 for NTP and TCP port 4460 for NTS-KE by default).\n",
 		Fields: []encoder.Doc{
 			{
 				Type:   "Meta",
 				Inline: true,
 			},
 			{
-				Name:        "name",
+				Name:        "listen",
 				Type:        "string",
 				Note:        "",
-				Description: "Alias for the link.\n\nDon't use system interface names like \"eth0\", \"ens3\", \"enp0s2\", etc. as those may conflict\nwith existing physical interfaces.\n\nThe name can contain a single integer format verb (`%d`) to create multiple aliases\nfrom a single config document. When a format verb is detected, each matched link receives a sequential\nalias (e.g. `net0`, `net1`, ...) based on hardware address order of the links.\nLinks already aliased by a previous config are automatically skipped.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Alias for the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Address to listen on for NTP requests.\n\nDefault value is `:123` (all addresses).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to listen on for NTP requests." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
-				Name:        "selector",
-				Type:        "LinkSelector",
+				Name:        "nts",
+				Type:        "NTPServerNTSConfig",
 				Note:        "",
-				Description: "Selector to match the link to alias.\n\nWhen the alias name is a fixed string, the selector must match exactly one link.\nWhen the alias name contains a format verb (e.g. `net%d`), the selector may match multiple links\nand each match receives a sequential alias.\nIf multiple selectors match the same link, the first one is used.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Selector to match the link to alias." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "NTS (Network Time Security) key establishment settings.\nIf not set, NTS is disabled and only unauthenticated NTP requests are served.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NTS (Network Time Security) key establishment settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
-	doc.AddExample("", exampleLinkAliasConfigV1Alpha1())
-
-	doc.AddExample("", exampleLinkAliasMultipleConfigV1Alpha1())
+	doc.AddExample("", exampleNTPServerConfigV1Alpha1())
 
-	doc.Fields[1].AddExample("", "net0")
-	doc.Fields[1].AddExample("", "private")
-	doc.Fields[1].AddExample("", "net%d")
+	doc.Fields[1].AddExample("", "10.0.0.1:123")
 
 	return doc
 }
 
-func (LinkSelector) Doc() *encoder.Doc {
+func (NTPServerNTSConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
-		Type:        "LinkSelector",
-		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkSelector selects a link to alias." /* encoder.LineComment */, "" /* encoder.FootComment */},
-		Description: "LinkSelector selects a link to alias.",
+		Type:        "NTPServerNTSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NTPServerNTSConfig describes the NTS key establishment server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NTPServerNTSConfig describes the NTS key establishment server.",
 		AppearsIn: []encoder.Appearance{
 			{
-				TypeName:  "LinkAliasConfigV1Alpha1",
-				FieldName: "selector",
+				TypeName:  "NTPServerConfigV1Alpha1",
+				FieldName: "nts",
 			},
 		},
 		Fields: []encoder.Doc{
 			{
-				Name:        "match",
-				Type:        "Expression",
+				Name:        "listen",
+				Type:        "string",
 				Note:        "",
-				Description: "The Common Expression Language (CEL) expression to match the link.",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "The Common Expression Language (CEL) expression to match the link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Address to listen on for NTS-KE connections.\n\nDefault value is `:4460` (all addresses).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to listen on for NTS-KE connections." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serverIdentity",
+				Type:        "CertificateAndKey",
+				Note:        "",
+				Description: "Server certificate and key to present to NTS-KE clients.\nCertificate and key should be PEM-encoded, and the certificate should be valid\nfor the hostname the clients use to reach the machine.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Server certificate and key to present to NTS-KE clients." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
 	}
 
-	doc.Fields[0].AddExample("match links with a specific MAC address", exampleLinkSelector1())
-	doc.Fields[0].AddExample("match links by MAC address prefix", exampleLinkSelector2())
-	doc.Fields[0].AddExample("match links by driver name", exampleLinkSelector3())
+	doc.Fields[0].AddExample("", "10.0.0.1:4460")
 
 	return doc
 }
@@ -1780,6 +2660,10 @@ func (ResolverConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.AddExample("", exampleResolverConfigV1Alpha5())
 
+	doc.AddExample("", exampleResolverConfigV1Alpha6())
+
+	doc.AddExample("", exampleResolverConfigV1Alpha7())
+
 	return doc
 }
 
@@ -1793,6 +2677,10 @@ func (NameserverConfig) Doc() *encoder.Doc {
 				TypeName:  "ResolverConfigV1Alpha1",
 				FieldName: "nameservers",
 			},
+			{
+				TypeName:  "HostDNSForwarderConfig",
+				FieldName: "nameservers",
+			},
 		},
 		Fields: []encoder.Doc{
 			{
@@ -1895,9 +2783,110 @@ func (HostDNSConfig) Doc() *encoder.Doc {
 				Description: "Resolve member hostnames using the host DNS resolver.\n\nWhen enabled, cluster member hostnames and node names are resolved using the host DNS resolver.\nThis requires service discovery to be enabled.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Resolve member hostnames using the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "forwarders",
+				Type:        "[]HostDNSForwarderConfig",
+				Note:        "",
+				Description: "Per-domain forwarders for the host DNS resolver.\n\nQueries for the listed domains (and all their subdomains) are forwarded to the forwarder nameservers\ninstead of the nameservers configured in this document.\nIf several forwarders match the query, the one with the longest matching domain is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Per-domain forwarders for the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "queryLog",
+				Type:        "bool",
+				Note:        "",
+				Description: "Log all queries handled by the host DNS resolver.\n\nEach query is logged with the client address, the response code, the latency and\nthe source of the answer (cache or the upstream nameserver).\nThe query log is available as the `dns-queries` service log (`talosctl logs dns-queries`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Log all queries handled by the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "negativeCache",
+				Type:        "HostDNSNegativeCacheConfig",
+				Note:        "",
+				Description: "Configuration of the negative response (NXDOMAIN and NODATA) cache of the host DNS resolver.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration of the negative response (NXDOMAIN and NODATA) cache of the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[3].AddExample("", exampleHostDNSForwarders())
+	doc.Fields[5].AddExample("", exampleHostDNSNegativeCache())
+
+	return doc
+}
+
+func (HostDNSNegativeCacheConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HostDNSNegativeCacheConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HostDNSNegativeCacheConfig represents host DNS negative response cache configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HostDNSNegativeCacheConfig represents host DNS negative response cache configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "HostDNSConfig",
+				FieldName: "negativeCache",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "size",
+				Type:        "int",
+				Note:        "",
+				Description: "Maximum number of negative responses to cache.\n\nWhen the cache is full, the responses which expire first are evicted.\nSet to 0 to disable negative response caching.\nDefaults to 10000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of negative responses to cache." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "minTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Minimum time to cache a negative response for.\n\nThe TTL of the negative response (derived from the SOA record) is raised to this value.\nDefaults to 5s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Minimum time to cache a negative response for." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxTTL",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Maximum time to cache a negative response for.\n\nThe TTL of the negative response (derived from the SOA record) is lowered to this value.\nDefaults to 10s.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum time to cache a negative response for." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleHostDNSNegativeCache())
+
+	return doc
+}
+
+func (HostDNSForwarderConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HostDNSForwarderConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HostDNSForwarderConfig represents a host DNS per-domain forwarder." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HostDNSForwarderConfig represents a host DNS per-domain forwarder.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "HostDNSConfig",
+				FieldName: "forwarders",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "domains",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of domains to forward to the nameservers.\n\nEach domain matches itself and all its subdomains.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of domains to forward to the nameservers." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nameservers",
+				Type:        "[]NameserverConfig",
+				Note:        "",
+				Description: "A list of nameservers to forward the queries to.\n\nNameservers are tried in order, the next nameserver is used if the previous one fails.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "A list of nameservers to forward the queries to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
+	doc.AddExample("", exampleHostDNSForwarders())
+
+	doc.Fields[0].AddExample("", []string{"corp.example"})
+
 	return doc
 }
 
@@ -2028,6 +3017,13 @@ func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "limits",
+				Type:        "RuleLimits",
+				Note:        "",
+				Description: "Limits define per-source limits for the new connections matching the rule.\n\nConnections exceeding the limits are dropped.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Limits define per-source limits for the new connections matching the rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -2042,6 +3038,14 @@ func (RulePortSelector) Doc() *encoder.Doc {
 		Comments:    [3]string{"" /* encoder.HeadComment */, "RulePortSelector is a port selector for the network rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
 		Description: "RulePortSelector is a port selector for the network rule.",
 		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DNATConfigV1Alpha1",
+				FieldName: "portSelector",
+			},
+			{
+				TypeName:  "EgressRuleConfigV1Alpha1",
+				FieldName: "portSelector",
+			},
 			{
 				TypeName:  "RuleConfigV1Alpha1",
 				FieldName: "portSelector",
@@ -2083,6 +3087,10 @@ func (IngressRule) Doc() *encoder.Doc {
 		Comments:    [3]string{"" /* encoder.HeadComment */, "IngressRule is a ingress rule." /* encoder.LineComment */, "" /* encoder.FootComment */},
 		Description: "IngressRule is a ingress rule.",
 		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "DNATConfigV1Alpha1",
+				FieldName: "ingress",
+			},
 			{
 				TypeName:  "RuleConfigV1Alpha1",
 				FieldName: "ingress",
@@ -2113,6 +3121,109 @@ func (IngressRule) Doc() *encoder.Doc {
 	return doc
 }
 
+func (RuleLimits) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "RuleLimits",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "RuleLimits defines per-source connection limits." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "RuleLimits defines per-source connection limits.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "RuleConfigV1Alpha1",
+				FieldName: "limits",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "newConnectionsPerSecond",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Maximum rate of new connections per second from a single source.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum rate of new connections per second from a single source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "newConnectionsBurst",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of new connections allowed to exceed the `newConnectionsPerSecond` rate in a burst.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of new connections allowed to exceed the `newConnectionsPerSecond` rate in a burst." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxConnections",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Maximum number of concurrent connections from a single source.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of concurrent connections from a single source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "sourcePrefixLengthIPv4",
+				Type:        "int",
+				Note:        "",
+				Description: "Prefix length which groups IPv4 source addresses into a single source.\n\nDefaults to 32 (each address is a separate source).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix length which groups IPv4 source addresses into a single source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "sourcePrefixLengthIPv6",
+				Type:        "int",
+				Note:        "",
+				Description: "Prefix length which groups IPv6 source addresses into a single source.\n\nDefaults to 64.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefix length which groups IPv6 source addresses into a single source." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (SNATConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "NetworkSNATConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "NetworkSNATConfig is a source NAT (masquerade) config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "NetworkSNATConfig is a source NAT (masquerade) config document.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "sourceSubnets",
+				Type:        "[]Prefix",
+				Note:        "",
+				Description: "Source subnets of the traffic to translate.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Source subnets of the traffic to translate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "outLinkName",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link the traffic leaves the host through.\n\nIf not set, the traffic is translated regardless of the outgoing link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link the traffic leaves the host through." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "toAddress",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Address to translate the source address to.\n\nIf not set, the traffic is masqueraded: the address of the outgoing link is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to translate the source address to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSNATConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", []meta.Prefix{{Prefix: netip.MustParsePrefix("10.5.0.0/24")}})
+	doc.Fields[3].AddExample("", "eth0")
+	doc.Fields[4].AddExample("", meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")})
+
+	return doc
+}
+
 func (StaticHostConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "StaticHostConfig",
@@ -2464,6 +3575,77 @@ func (VLANConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (VXLANConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "VXLANConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "VXLANConfig is a config document to create a VXLAN (virtual extensible LAN) tunnel link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "VXLANConfig is a config document to create a VXLAN (virtual extensible LAN) tunnel link.",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the VXLAN link (interface) to be created.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the VXLAN link (interface) to be created." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vni",
+				Type:        "uint32",
+				Note:        "",
+				Description: "VXLAN network identifier (VNI).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VXLAN network identifier (VNI)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "parent",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the underlay link (interface) the tunnel traffic is sent over.\nLink aliases can be used here as well.\n\nRequired if `remote` is a multicast group address.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the underlay link (interface) the tunnel traffic is sent over." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "local",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Local (source) IP address of the tunnel endpoint.\nIf not set, the source address is selected by the kernel.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Local (source) IP address of the tunnel endpoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "remote",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Remote IP address of the tunnel endpoint, or a multicast group address.\nIf not set, forwarding database entries must be managed externally.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Remote IP address of the tunnel endpoint, or a multicast group address." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "Destination UDP port.\nIf not set, defaults to the IANA-assigned port 4789.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Destination UDP port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Type:   "CommonLinkConfig",
+				Inline: true,
+			},
+		},
+	}
+
+	doc.AddExample("", exampleVXLANConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "vxlan42")
+	doc.Fields[2].AddExample("", 42)
+	doc.Fields[3].AddExample("", "enp0s3")
+	doc.Fields[4].AddExample("", meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")})
+	doc.Fields[5].AddExample("", meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")})
+	doc.Fields[6].AddExample("", 4789)
+
+	return doc
+}
+
 func (WireguardConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "WireguardConfig",
@@ -2583,8 +3765,12 @@ func GetFileDoc() *encoder.FileDoc {
 		Description: "Package network provides network machine configuration documents.\n",
 		Structs: []*encoder.Doc{
 			BGPInstanceConfigV1Alpha1{}.Doc(),
+			BGPGracefulRestartConfig{}.Doc(),
+			BGPAdvertiseProbe{}.Doc(),
 			BGPImportRoute{}.Doc(),
 			BGPNeighborConfig{}.Doc(),
+			BGPRoutePolicy{}.Doc(),
+			BGPPrefixFilter{}.Doc(),
 			BGPBFDConfig{}.Doc(),
 			BlackholeRouteConfigV1Alpha1{}.Doc(),
 			BondConfigV1Alpha1{}.Doc(),
@@ -2595,13 +3781,23 @@ func GetFileDoc() *encoder.FileDoc {
 			DefaultActionConfigV1Alpha1{}.Doc(),
 			DHCPv4ConfigV1Alpha1{}.Doc(),
 			DHCPv6ConfigV1Alpha1{}.Doc(),
+			DHCPv6PrefixDelegationConfig{}.Doc(),
+			DHCPv6PrefixDelegationDownstream{}.Doc(),
+			DNATConfigV1Alpha1{}.Doc(),
 			DummyLinkConfigV1Alpha1{}.Doc(),
+			EgressRuleConfigV1Alpha1{}.Doc(),
+			EgressRule{}.Doc(),
 			EthernetConfigV1Alpha1{}.Doc(),
 			EthernetRingsConfig{}.Doc(),
 			EthernetChannelsConfig{}.Doc(),
+			GeneveConfigV1Alpha1{}.Doc(),
+			GREConfigV1Alpha1{}.Doc(),
+			GRETAPConfigV1Alpha1{}.Doc(),
+			GRETunnelConfig{}.Doc(),
 			HCloudVIPConfigV1Alpha1{}.Doc(),
 			HostnameConfigV1Alpha1{}.Doc(),
 			HTTPProbeConfigV1Alpha1{}.Doc(),
+			IPVLANConfigV1Alpha1{}.Doc(),
 			KubeSpanConfigV1Alpha1{}.Doc(),
 			KubeSpanFiltersConfig{}.Doc(),
 			KubespanEndpointsConfigV1Alpha1{}.Doc(),
@@ -2612,14 +3808,21 @@ func GetFileDoc() *encoder.FileDoc {
 			RouteConfig{}.Doc(),
 			LinkAliasConfigV1Alpha1{}.Doc(),
 			LinkSelector{}.Doc(),
+			MACVLANConfigV1Alpha1{}.Doc(),
+			NTPServerConfigV1Alpha1{}.Doc(),
+			NTPServerNTSConfig{}.Doc(),
 			ResolverConfigV1Alpha1{}.Doc(),
 			NameserverConfig{}.Doc(),
 			SearchDomainsConfig{}.Doc(),
 			HostDNSConfig{}.Doc(),
+			HostDNSNegativeCacheConfig{}.Doc(),
+			HostDNSForwarderConfig{}.Doc(),
 			RoutingRuleConfigV1Alpha1{}.Doc(),
 			RuleConfigV1Alpha1{}.Doc(),
 			RulePortSelector{}.Doc(),
 			IngressRule{}.Doc(),
+			RuleLimits{}.Doc(),
+			SNATConfigV1Alpha1{}.Doc(),
 			StaticHostConfigV1Alpha1{}.Doc(),
 			TCPProbeConfigV1Alpha1{}.Doc(),
 			CommonProbeConfig{}.Doc(),
@@ -2629,6 +3832,7 @@ func GetFileDoc() *encoder.FileDoc {
 			VethConfigV1Alpha1{}.Doc(),
 			VethPeerConfig{}.Doc(),
 			VLANConfigV1Alpha1{}.Doc(),
+			VXLANConfigV1Alpha1{}.Doc(),
 			WireguardConfigV1Alpha1{}.Doc(),
 			WireguardPeer{}.Doc(),
 		},
@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// NTPServerKind is a NTPServerConfig document kind.
+const NTPServerKind = "NTPServerConfig"
+
+func init() {
+	registry.Register(NTPServerKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &NTPServerConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkNTPServerConfig    = &NTPServerConfigV1Alpha1{}
+	_ config.NetworkNTPServerNTSConfig = &NTPServerNTSConfig{}
+	_ config.Validator                 = &NTPServerConfigV1Alpha1{}
+	_ config.SecretDocument            = &NTPServerConfigV1Alpha1{}
+)
+
+// NTPServerConfigV1Alpha1 is a config document to serve time to other machines over NTP.
+//
+//	description: |
+//	  The machine answers NTP (RFC 5905) client requests with the state of its own clock,
+//	  as synchronized according to `TimeSyncConfig` (from NTP servers or PTP devices).
+//	  Stratum and leap second state are derived from the time source; until the clock is synchronized,
+//	  responses are marked as unsynchronized, so that clients ignore them.
+//
+//	  Optionally, the machine runs an NTS-KE (RFC 8915) server, so that clients can authenticate the time they receive.
+//
+//	  The server accepts requests from any client: access should be restricted with `NetworkRuleConfig` documents
+//	  (UDP port 123 for NTP and TCP port 4460 for NTS-KE by default).
+//	examples:
+//	  - value: exampleNTPServerConfigV1Alpha1()
+//	alias: NTPServerConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NTPServerConfig
+type NTPServerConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Address to listen on for NTP requests.
+	//
+	//     Default value is `:123` (all addresses).
+	//   examples:
+	//     - value: >
+	//        "10.0.0.1:123"
+	NTPListen string `yaml:"listen,omitempty"`
+	//   description: |
+	//     NTS (Network Time Security) key establishment settings.
+	//     If not set, NTS is disabled and only unauthenticated NTP requests are served.
+	NTPNTS *NTPServerNTSConfig `yaml:"nts,omitempty"`
+}
+
+// NTPServerNTSConfig describes the NTS key establishment server.
+type NTPServerNTSConfig struct {
+	//   description: |
+	//     Address to listen on for NTS-KE connections.
+	//
+	//     Default value is `:4460` (all addresses).
+	//   examples:
+	//     - value: >
+	//        "10.0.0.1:4460"
+	NTSListen string `yaml:"listen,omitempty"`
+	//   description: |
+	//     Server certificate and key to present to NTS-KE clients.
+	//     Certificate and key should be PEM-encoded, and the certificate should be valid
+	//     for the hostname the clients use to reach the machine.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       cert:
+	//         type: string
+	//       key:
+	//         type: string
+	NTSServerIdentity *meta.CertificateAndKey `yaml:"serverIdentity,omitempty"`
+}
+
+// NewNTPServerConfigV1Alpha1 creates a new NTPServerConfig config document.
+func NewNTPServerConfigV1Alpha1() *NTPServerConfigV1Alpha1 {
+	return &NTPServerConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       NTPServerKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleNTPServerConfigV1Alpha1() *NTPServerConfigV1Alpha1 {
+	cfg := NewNTPServerConfigV1Alpha1()
+	cfg.NTPListen = ":123"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *NTPServerConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Redact implements config.SecretDocument interface.
+func (s *NTPServerConfigV1Alpha1) Redact(replacement string) {
+	if s.NTPNTS != nil && s.NTPNTS.NTSServerIdentity != nil && s.NTPNTS.NTSServerIdentity.Key != "" {
+		s.NTPNTS.NTSServerIdentity.Key = replacement
+	}
+}
+
+// Validate implements config.Validator interface.
+func (s *NTPServerConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.NTPListen != "" {
+		errs = errors.Join(errs, validateListenAddress("listen", s.NTPListen))
+	}
+
+	if s.NTPNTS != nil {
+		if s.NTPNTS.NTSListen != "" {
+			errs = errors.Join(errs, validateListenAddress("nts.listen", s.NTPNTS.NTSListen))
+		}
+
+		if s.NTPNTS.NTSServerIdentity == nil {
+			errs = errors.Join(errs, errors.New("nts.serverIdentity is required"))
+		} else if err := s.NTPNTS.NTSServerIdentity.Validate(true); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("nts.serverIdentity: %w", err))
+		}
+	}
+
+	return nil, errs
+}
+
+func validateListenAddress(field, address string) error {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%s address %q is invalid: %w", field, address, err)
+	}
+
+	if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+		return fmt.Errorf("%s port %q is invalid: %w", field, port, err)
+	}
+
+	return nil
+}
+
+// ListenAddress implements config.NetworkNTPServerConfig interface.
+func (s *NTPServerConfigV1Alpha1) ListenAddress() string {
+	if s.NTPListen == "" {
+		return net.JoinHostPort("", strconv.Itoa(constants.NTPPort))
+	}
+
+	return s.NTPListen
+}
+
+// NTS implements config.NetworkNTPServerConfig interface.
+func (s *NTPServerConfigV1Alpha1) NTS() config.NetworkNTPServerNTSConfig {
+	if s.NTPNTS == nil {
+		return nil
+	}
+
+	return s.NTPNTS
+}
+
+// ListenAddress implements config.NetworkNTPServerNTSConfig interface.
+func (c *NTPServerNTSConfig) ListenAddress() string {
+	if c.NTSListen == "" {
+		return net.JoinHostPort("", strconv.Itoa(constants.NTSKEPort))
+	}
+
+	return c.NTSListen
+}
+
+// ServerIdentity implements config.NetworkNTPServerNTSConfig interface.
+func (c *NTPServerNTSConfig) ServerIdentity() *x509.PEMEncodedCertificateAndKey {
+	return c.NTSServerIdentity.ToX509()
+}
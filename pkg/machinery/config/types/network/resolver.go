@@ -7,10 +7,13 @@ package network
 //docgen:jsonschema
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"net/netip"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/value"
@@ -23,6 +26,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
@@ -56,6 +60,8 @@ var (
 //	  - value: exampleResolverConfigV1Alpha3()
 //	  - value: exampleResolverConfigV1Alpha4()
 //	  - value: exampleResolverConfigV1Alpha5()
+//	  - value: exampleResolverConfigV1Alpha6()
+//	  - value: exampleResolverConfigV1Alpha7()
 //	alias: ResolverConfig
 //	schemaRoot: true
 //	schemaMeta: v1alpha1/ResolverConfig
@@ -169,6 +175,75 @@ type HostDNSConfig struct {
 	//     When enabled, cluster member hostnames and node names are resolved using the host DNS resolver.
 	//     This requires service discovery to be enabled.
 	HostDNSResolveMemberNames *bool `yaml:"resolveMemberNames,omitempty"`
+	//   description: |
+	//     Per-domain forwarders for the host DNS resolver.
+	//
+	//     Queries for the listed domains (and all their subdomains) are forwarded to the forwarder nameservers
+	//     instead of the nameservers configured in this document.
+	//     If several forwarders match the query, the one with the longest matching domain is used.
+	//   examples:
+	//    - value: exampleHostDNSForwarders()
+	HostDNSForwarders []HostDNSForwarderConfig `yaml:"forwarders,omitempty"`
+	//   description: |
+	//     Log all queries handled by the host DNS resolver.
+	//
+	//     Each query is logged with the client address, the response code, the latency and
+	//     the source of the answer (cache or the upstream nameserver).
+	//     The query log is available as the `dns-queries` service log (`talosctl logs dns-queries`).
+	HostDNSQueryLog *bool `yaml:"queryLog,omitempty"`
+	//   description: |
+	//     Configuration of the negative response (NXDOMAIN and NODATA) cache of the host DNS resolver.
+	//   examples:
+	//    - value: exampleHostDNSNegativeCache()
+	HostDNSNegativeCache HostDNSNegativeCacheConfig `yaml:"negativeCache,omitempty"`
+}
+
+// HostDNSNegativeCacheConfig represents host DNS negative response cache configuration.
+type HostDNSNegativeCacheConfig struct {
+	//   description: |
+	//     Maximum number of negative responses to cache.
+	//
+	//     When the cache is full, the responses which expire first are evicted.
+	//     Set to 0 to disable negative response caching.
+	//     Defaults to 10000.
+	NegativeCacheSize *int `yaml:"size,omitempty"`
+	//   description: |
+	//     Minimum time to cache a negative response for.
+	//
+	//     The TTL of the negative response (derived from the SOA record) is raised to this value.
+	//     Defaults to 5s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	NegativeCacheMinTTL time.Duration `yaml:"minTTL,omitempty"`
+	//   description: |
+	//     Maximum time to cache a negative response for.
+	//
+	//     The TTL of the negative response (derived from the SOA record) is lowered to this value.
+	//     Defaults to 10s.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	NegativeCacheMaxTTL time.Duration `yaml:"maxTTL,omitempty"`
+}
+
+// HostDNSForwarderConfig represents a host DNS per-domain forwarder.
+type HostDNSForwarderConfig struct {
+	//   description: |
+	//     List of domains to forward to the nameservers.
+	//
+	//     Each domain matches itself and all its subdomains.
+	//   examples:
+	//    - value: >
+	//       []string{"corp.example"}
+	//   schemaRequired: true
+	ForwarderDomains []string `yaml:"domains"`
+	//   description: |
+	//     A list of nameservers to forward the queries to.
+	//
+	//     Nameservers are tried in order, the next nameserver is used if the previous one fails.
+	//   schemaRequired: true
+	ForwarderNameservers []NameserverConfig `yaml:"nameservers"`
 }
 
 // NewResolverConfigV1Alpha1 creates a new ResolverConfig config document.
@@ -254,6 +329,66 @@ func exampleResolverConfigV1Alpha5() *ResolverConfigV1Alpha1 {
 	return cfg
 }
 
+func exampleResolverConfigV1Alpha6() *ResolverConfigV1Alpha1 {
+	cfg := NewResolverConfigV1Alpha1()
+	cfg.ResolverNameservers = []NameserverConfig{
+		{
+			Address:       meta.Addr{Addr: netip.MustParseAddr("1.1.1.1")},
+			Protocol:      nethelpers.DNSProtocolDNSOverHTTP,
+			TLSServerName: "cloudflare-dns.com",
+		},
+	}
+	cfg.ResolverHostDNS = HostDNSConfig{
+		HostDNSEnabled:    new(true),
+		HostDNSForwarders: exampleHostDNSForwarders(),
+	}
+
+	return cfg
+}
+
+func exampleResolverConfigV1Alpha7() *ResolverConfigV1Alpha1 {
+	cfg := NewResolverConfigV1Alpha1()
+	cfg.ResolverHostDNS = HostDNSConfig{
+		HostDNSEnabled:       new(true),
+		HostDNSQueryLog:      new(true),
+		HostDNSNegativeCache: exampleHostDNSNegativeCache(),
+	}
+
+	return cfg
+}
+
+func exampleHostDNSNegativeCache() HostDNSNegativeCacheConfig {
+	return HostDNSNegativeCacheConfig{
+		NegativeCacheSize:   new(1000),
+		NegativeCacheMinTTL: time.Second,
+		NegativeCacheMaxTTL: time.Minute,
+	}
+}
+
+func exampleHostDNSForwarders() []HostDNSForwarderConfig {
+	return []HostDNSForwarderConfig{
+		{
+			ForwarderDomains: []string{"corp.example"},
+			ForwarderNameservers: []NameserverConfig{
+				{
+					Address: meta.Addr{Addr: netip.MustParseAddr("10.0.0.53")},
+				},
+				{
+					Address: meta.Addr{Addr: netip.MustParseAddr("10.0.1.53")},
+				},
+			},
+		},
+		{
+			ForwarderDomains: []string{"cluster.local"},
+			ForwarderNameservers: []NameserverConfig{
+				{
+					Address: meta.Addr{Addr: netip.MustParseAddr("10.96.0.10")},
+				},
+			},
+		},
+	}
+}
+
 // Clone implements config.Document interface.
 func (s *ResolverConfigV1Alpha1) Clone() config.Document {
 	return s.DeepCopy()
@@ -300,37 +435,72 @@ func (s *ResolverConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.
 			if s.ResolveMemberNames() {
 				errs = errors.Join(errs, errors.New("hostDNS.resolveMemberNames cannot be enabled when hostDNS.enabled is false"))
 			}
+
+			if len(s.ResolverHostDNS.HostDNSForwarders) > 0 {
+				errs = errors.Join(errs, errors.New("hostDNS.forwarders cannot be set when hostDNS.enabled is false"))
+			}
+
+			if s.QueryLog() {
+				errs = errors.Join(errs, errors.New("hostDNS.queryLog cannot be enabled when hostDNS.enabled is false"))
+			}
 		}
 	}
 
+	if size := s.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheSize; size != nil && *size < 0 {
+		errs = errors.Join(errs, fmt.Errorf("hostDNS.negativeCache.size must not be negative: %d", *size))
+	}
+
+	if s.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheMinTTL < 0 {
+		errs = errors.Join(errs, errors.New("hostDNS.negativeCache.minTTL must not be negative"))
+	}
+
+	if s.ResolverHostDNS.HostDNSNegativeCache.NegativeCacheMaxTTL < 0 {
+		errs = errors.Join(errs, errors.New("hostDNS.negativeCache.maxTTL must not be negative"))
+	}
+
+	if negativeCache := s.NegativeCache(); negativeCache.MinTTL > negativeCache.MaxTTL {
+		errs = errors.Join(errs, fmt.Errorf("hostDNS.negativeCache.minTTL %s is greater than maxTTL %s", negativeCache.MinTTL, negativeCache.MaxTTL))
+	}
+
 	nonRegularDNS := 0
 
 	for idx, ns := range s.ResolverNameservers {
-		switch ns.Protocol {
-		case nethelpers.DNSProtocolDNSOverTLS:
+		if ns.Protocol != nethelpers.DNSProtocolDefault {
 			nonRegularDNS++
+		}
 
-			if ns.TLSServerName == "" {
-				errs = errors.Join(errs, fmt.Errorf("tlsServerName must be set when protocol is DoT: entry %d", idx))
-			}
+		errs = errors.Join(errs, ns.validate(fmt.Sprintf("entry %d", idx)))
+	}
 
-		case nethelpers.DNSProtocolDNSOverHTTP:
-			nonRegularDNS++
+	seenDomains := map[string]struct{}{}
+
+	for idx, forwarder := range s.ResolverHostDNS.HostDNSForwarders {
+		if len(forwarder.ForwarderDomains) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("hostDNS.forwarders[%d]: at least one domain must be specified", idx))
+		}
+
+		if len(forwarder.ForwarderNameservers) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("hostDNS.forwarders[%d]: at least one nameserver must be specified", idx))
+		}
+
+		for _, domain := range forwarder.ForwarderDomains {
+			if err := validateForwarderDomain(domain); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("hostDNS.forwarders[%d]: %w", idx, err))
 
-			if ns.TLSServerName == "" {
-				errs = errors.Join(errs, fmt.Errorf("tlsServerName must be set when protocol is DoH: entry %d", idx))
+				continue
 			}
 
-		case nethelpers.DNSProtocolDefault:
-			if ns.TLSServerName != "" {
-				errs = errors.Join(errs, fmt.Errorf("tlsServerName must be empty when protocol is Do53: entry %d", idx))
+			normalized := normalizeForwarderDomain(domain)
+
+			if _, seen := seenDomains[normalized]; seen {
+				errs = errors.Join(errs, fmt.Errorf("hostDNS.forwarders[%d]: domain %q is duplicated", idx, domain))
 			}
-		default:
-			errs = errors.Join(errs, fmt.Errorf("unsupported DNS protocol: entry %d", idx))
+
+			seenDomains[normalized] = struct{}{}
 		}
 
-		if !ns.Address.IsValid() {
-			errs = errors.Join(errs, fmt.Errorf("nameserver address must be a valid IP: entry %d", idx))
+		for nsIdx, ns := range forwarder.ForwarderNameservers {
+			errs = errors.Join(errs, ns.validate(fmt.Sprintf("hostDNS.forwarders[%d] entry %d", idx, nsIdx)))
 		}
 	}
 
@@ -346,15 +516,68 @@ func (s *ResolverConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.
 	return warnings, errs
 }
 
+func (ns NameserverConfig) validate(entry string) error {
+	var errs error
+
+	switch ns.Protocol {
+	case nethelpers.DNSProtocolDNSOverTLS:
+		if ns.TLSServerName == "" {
+			errs = errors.Join(errs, fmt.Errorf("tlsServerName must be set when protocol is DoT: %s", entry))
+		}
+	case nethelpers.DNSProtocolDNSOverHTTP:
+		if ns.TLSServerName == "" {
+			errs = errors.Join(errs, fmt.Errorf("tlsServerName must be set when protocol is DoH: %s", entry))
+		}
+	case nethelpers.DNSProtocolDefault:
+		if ns.TLSServerName != "" {
+			errs = errors.Join(errs, fmt.Errorf("tlsServerName must be empty when protocol is Do53: %s", entry))
+		}
+	default:
+		errs = errors.Join(errs, fmt.Errorf("unsupported DNS protocol: %s", entry))
+	}
+
+	if !ns.Address.IsValid() {
+		errs = errors.Join(errs, fmt.Errorf("nameserver address must be a valid IP: %s", entry))
+	}
+
+	return errs
+}
+
+func (ns NameserverConfig) resolver() config.NetworkResolver {
+	return config.NetworkResolver{
+		Addr:          ns.Address.Addr,
+		Protocol:      ns.Protocol,
+		TLSServerName: ns.TLSServerName,
+	}
+}
+
+func normalizeForwarderDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(domain, "."))
+}
+
+func validateForwarderDomain(domain string) error {
+	normalized := normalizeForwarderDomain(domain)
+
+	if strings.Contains(normalized, "*") {
+		return fmt.Errorf("domain %q must not contain wildcards, subdomains are always matched", domain)
+	}
+
+	if normalized == "" || len(normalized) > 253 {
+		return fmt.Errorf("domain %q is invalid", domain)
+	}
+
+	for label := range strings.SplitSeq(normalized, ".") {
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("domain %q is invalid", domain)
+		}
+	}
+
+	return nil
+}
+
 // Resolvers implements NetworkResolverConfig interface.
 func (s *ResolverConfigV1Alpha1) Resolvers() []config.NetworkResolver {
-	return xslices.Map(s.ResolverNameservers, func(ns NameserverConfig) config.NetworkResolver {
-		return config.NetworkResolver{
-			Addr:          ns.Address.Addr,
-			Protocol:      ns.Protocol,
-			TLSServerName: ns.TLSServerName,
-		}
-	})
+	return xslices.Map(s.ResolverNameservers, NameserverConfig.resolver)
 }
 
 // SearchDomains implements NetworkResolverConfig interface.
@@ -385,3 +608,40 @@ func (s *ResolverConfigV1Alpha1) ForwardKubeDNSToHost() bool {
 func (s *ResolverConfigV1Alpha1) ResolveMemberNames() bool {
 	return pointer.SafeDeref(s.ResolverHostDNS.HostDNSResolveMemberNames)
 }
+
+// QueryLog implements NetworkHostDNSConfig interface.
+func (s *ResolverConfigV1Alpha1) QueryLog() bool {
+	return pointer.SafeDeref(s.ResolverHostDNS.HostDNSQueryLog)
+}
+
+// NegativeCache implements NetworkHostDNSConfig interface.
+func (s *ResolverConfigV1Alpha1) NegativeCache() config.NetworkHostDNSNegativeCache {
+	negativeCache := s.ResolverHostDNS.HostDNSNegativeCache
+
+	res := config.NetworkHostDNSNegativeCache{
+		Size:   constants.HostDNSNegativeCacheSize,
+		MinTTL: negativeCache.NegativeCacheMinTTL,
+		MaxTTL: cmp.Or(negativeCache.NegativeCacheMaxTTL, constants.HostDNSNegativeCacheMaxTTL),
+	}
+
+	if negativeCache.NegativeCacheSize != nil {
+		res.Size = *negativeCache.NegativeCacheSize
+	}
+
+	if res.MinTTL == 0 {
+		// default minimum TTL should never exceed the explicitly configured maximum TTL
+		res.MinTTL = min(constants.HostDNSNegativeCacheMinTTL, res.MaxTTL)
+	}
+
+	return res
+}
+
+// Forwarders implements NetworkHostDNSConfig interface.
+func (s *ResolverConfigV1Alpha1) Forwarders() []config.NetworkHostDNSForwarder {
+	return xslices.Map(s.ResolverHostDNS.HostDNSForwarders, func(forwarder HostDNSForwarderConfig) config.NetworkHostDNSForwarder {
+		return config.NetworkHostDNSForwarder{
+			Domains:   xslices.Map(forwarder.ForwarderDomains, normalizeForwarderDomain),
+			Resolvers: xslices.Map(forwarder.ForwarderNameservers, NameserverConfig.resolver),
+		}
+	})
+}
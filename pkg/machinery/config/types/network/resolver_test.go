@@ -8,15 +8,18 @@ import (
 	_ "embed"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
@@ -66,6 +69,16 @@ func TestResolverConfigMarshalStabilityWithHostDNS(t *testing.T) {
 		HostDNSEnabled:              new(true),
 		HostDNSForwardKubeDNSToHost: new(true),
 		HostDNSResolveMemberNames:   new(false),
+		HostDNSForwarders: []network.HostDNSForwarderConfig{
+			{
+				ForwarderDomains: []string{"corp.example"},
+				ForwarderNameservers: []network.NameserverConfig{
+					{
+						Address: meta.Addr{Addr: netip.MustParseAddr("10.0.0.53")},
+					},
+				},
+			},
+		},
 	}
 
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
@@ -76,6 +89,69 @@ func TestResolverConfigMarshalStabilityWithHostDNS(t *testing.T) {
 	assert.Equal(t, expectedResolverConfigDocumentWithHostDNS, marshaled)
 }
 
+func TestResolverConfigNegativeCache(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  network.HostDNSNegativeCacheConfig
+
+		expected config.NetworkHostDNSNegativeCache
+	}{
+		{
+			name: "defaults",
+			expected: config.NetworkHostDNSNegativeCache{
+				Size:   constants.HostDNSNegativeCacheSize,
+				MinTTL: constants.HostDNSNegativeCacheMinTTL,
+				MaxTTL: constants.HostDNSNegativeCacheMaxTTL,
+			},
+		},
+		{
+			name: "disabled",
+			cfg: network.HostDNSNegativeCacheConfig{
+				NegativeCacheSize: new(0),
+			},
+			expected: config.NetworkHostDNSNegativeCache{
+				MinTTL: constants.HostDNSNegativeCacheMinTTL,
+				MaxTTL: constants.HostDNSNegativeCacheMaxTTL,
+			},
+		},
+		{
+			name: "max TTL below default min TTL",
+			cfg: network.HostDNSNegativeCacheConfig{
+				NegativeCacheMaxTTL: time.Second,
+			},
+			expected: config.NetworkHostDNSNegativeCache{
+				Size:   constants.HostDNSNegativeCacheSize,
+				MinTTL: time.Second,
+				MaxTTL: time.Second,
+			},
+		},
+		{
+			name: "custom",
+			cfg: network.HostDNSNegativeCacheConfig{
+				NegativeCacheSize:   new(100),
+				NegativeCacheMinTTL: time.Second,
+				NegativeCacheMaxTTL: time.Hour,
+			},
+			expected: config.NetworkHostDNSNegativeCache{
+				Size:   100,
+				MinTTL: time.Second,
+				MaxTTL: time.Hour,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := network.NewResolverConfigV1Alpha1()
+			cfg.ResolverHostDNS.HostDNSNegativeCache = test.cfg
+
+			assert.Equal(t, test.expected, cfg.NegativeCache())
+		})
+	}
+}
+
 func TestResolverConfigUnmarshal(t *testing.T) {
 	t.Parallel()
 
@@ -285,6 +361,120 @@ func TestResolverV1Alpha1Validate(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			name: "hostDNS forwarders valid",
+			cfg: func() *network.ResolverConfigV1Alpha1 {
+				cfg := network.NewResolverConfigV1Alpha1()
+				cfg.ResolverHostDNS = network.HostDNSConfig{
+					HostDNSEnabled: new(true),
+					HostDNSForwarders: []network.HostDNSForwarderConfig{
+						{
+							ForwarderDomains: []string{"corp.example", "corp.example.org."},
+							ForwarderNameservers: []network.NameserverConfig{
+								{
+									Address: meta.Addr{Addr: netip.MustParseAddr("10.0.0.53")},
+								},
+								{
+									Address:       meta.Addr{Addr: netip.MustParseAddr("10.0.1.53")},
+									Protocol:      nethelpers.DNSProtocolDNSOverTLS,
+									TLSServerName: "dns.corp.example",
+								},
+							},
+						},
+					},
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "hostDNS forwarders invalid",
+			cfg: func() *network.ResolverConfigV1Alpha1 {
+				cfg := network.NewResolverConfigV1Alpha1()
+				cfg.ResolverHostDNS = network.HostDNSConfig{
+					HostDNSEnabled: new(true),
+					HostDNSForwarders: []network.HostDNSForwarderConfig{
+						{
+							ForwarderDomains: []string{"*.corp.example", "cluster.local"},
+							ForwarderNameservers: []network.NameserverConfig{
+								{
+									Address:  meta.Addr{Addr: netip.MustParseAddr("10.0.0.53")},
+									Protocol: nethelpers.DNSProtocolDNSOverHTTP,
+								},
+							},
+						},
+						{
+							ForwarderDomains: []string{"Cluster.Local.", "foo..bar"},
+						},
+					},
+				}
+
+				return cfg
+			},
+			expectedError: "hostDNS.forwarders[0]: domain \"*.corp.example\" must not contain wildcards, subdomains are always matched\n" +
+				"tlsServerName must be set when protocol is DoH: hostDNS.forwarders[0] entry 0\n" +
+				"hostDNS.forwarders[1]: at least one nameserver must be specified\n" +
+				"hostDNS.forwarders[1]: domain \"Cluster.Local.\" is duplicated\n" +
+				"hostDNS.forwarders[1]: domain \"foo..bar\" is invalid",
+		},
+		{
+			name: "hostDNS forwarders with hostDNS disabled",
+			cfg: func() *network.ResolverConfigV1Alpha1 {
+				cfg := network.NewResolverConfigV1Alpha1()
+				cfg.ResolverHostDNS = network.HostDNSConfig{
+					HostDNSEnabled: new(false),
+					HostDNSForwarders: []network.HostDNSForwarderConfig{
+						{
+							ForwarderDomains: []string{"corp.example"},
+							ForwarderNameservers: []network.NameserverConfig{
+								{
+									Address: meta.Addr{Addr: netip.MustParseAddr("10.0.0.53")},
+								},
+							},
+						},
+					},
+				}
+
+				return cfg
+			},
+			expectedError: "hostDNS.forwarders cannot be set when hostDNS.enabled is false",
+		},
+		{
+			name: "hostDNS query log and negative cache valid",
+			cfg: func() *network.ResolverConfigV1Alpha1 {
+				cfg := network.NewResolverConfigV1Alpha1()
+				cfg.ResolverHostDNS = network.HostDNSConfig{
+					HostDNSEnabled:  new(true),
+					HostDNSQueryLog: new(true),
+					HostDNSNegativeCache: network.HostDNSNegativeCacheConfig{
+						NegativeCacheSize:   new(0),
+						NegativeCacheMaxTTL: time.Second,
+					},
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "hostDNS negative cache invalid",
+			cfg: func() *network.ResolverConfigV1Alpha1 {
+				cfg := network.NewResolverConfigV1Alpha1()
+				cfg.ResolverHostDNS = network.HostDNSConfig{
+					HostDNSEnabled:  new(false),
+					HostDNSQueryLog: new(true),
+					HostDNSNegativeCache: network.HostDNSNegativeCacheConfig{
+						NegativeCacheSize:   new(-1),
+						NegativeCacheMinTTL: time.Minute,
+						NegativeCacheMaxTTL: time.Second,
+					},
+				}
+
+				return cfg
+			},
+			expectedError: "hostDNS.queryLog cannot be enabled when hostDNS.enabled is false\n" +
+				"hostDNS.negativeCache.size must not be negative: -1\n" +
+				"hostDNS.negativeCache.minTTL 1m0s is greater than maxTTL 1s",
+		},
 		{
 			name: "DoT mixed with plain DNS, no warning",
 			cfg: func() *network.ResolverConfigV1Alpha1 {
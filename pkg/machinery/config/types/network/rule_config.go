@@ -63,6 +63,11 @@ type RuleConfigV1Alpha1 struct {
 	//   description: |
 	//     Ingress defines which source subnets are allowed to access the host ports/protocols defined by the `portSelector`.
 	Ingress IngressConfig `yaml:"ingress" merge:"replace"`
+	//   description: |
+	//     Limits define per-source limits for the new connections matching the rule.
+	//
+	//     Connections exceeding the limits are dropped.
+	ConnectionLimits RuleLimits `yaml:"limits,omitempty"`
 }
 
 // RulePortSelector is a port selector for the network rule.
@@ -120,6 +125,29 @@ type IngressRule struct {
 	Except meta.Prefix `yaml:"except,omitempty"`
 }
 
+// RuleLimits defines per-source connection limits.
+type RuleLimits struct {
+	//   description: |
+	//     Maximum rate of new connections per second from a single source.
+	NewConnectionsPerSecondLimit uint32 `yaml:"newConnectionsPerSecond,omitempty"`
+	//   description: |
+	//     Number of new connections allowed to exceed the `newConnectionsPerSecond` rate in a burst.
+	NewConnectionsBurstLimit uint32 `yaml:"newConnectionsBurst,omitempty"`
+	//   description: |
+	//     Maximum number of concurrent connections from a single source.
+	MaxConnectionsLimit uint32 `yaml:"maxConnections,omitempty"`
+	//   description: |
+	//     Prefix length which groups IPv4 source addresses into a single source.
+	//
+	//     Defaults to 32 (each address is a separate source).
+	SourcePrefixLengthIPv4Config int `yaml:"sourcePrefixLengthIPv4,omitempty"`
+	//   description: |
+	//     Prefix length which groups IPv6 source addresses into a single source.
+	//
+	//     Defaults to 64.
+	SourcePrefixLengthIPv6Config int `yaml:"sourcePrefixLengthIPv6,omitempty"`
+}
+
 // NewRuleConfigV1Alpha1 creates a new RuleConfig config document.
 func NewRuleConfigV1Alpha1() *RuleConfigV1Alpha1 {
 	return &RuleConfigV1Alpha1{
@@ -142,6 +170,11 @@ func exampleRuleConfigV1Alpha1() *RuleConfigV1Alpha1 {
 			Subnet: netip.MustParsePrefix("192.168.0.0/16"),
 		},
 	}
+	cfg.ConnectionLimits = RuleLimits{
+		NewConnectionsPerSecondLimit: 10,
+		NewConnectionsBurstLimit:     20,
+		MaxConnectionsLimit:          100,
+	}
 
 	return cfg
 }
@@ -180,9 +213,34 @@ func (s *RuleConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Opti
 		}
 	}
 
+	if err := s.ConnectionLimits.Validate(s.PortSelector.Protocol); err != nil {
+		return nil, err
+	}
+
 	return nil, nil
 }
 
+// Validate the limits.
+func (l RuleLimits) Validate(protocol nethelpers.Protocol) error {
+	if l.NewConnectionsBurstLimit > 0 && l.NewConnectionsPerSecondLimit == 0 {
+		return errors.New("limits.newConnectionsBurst requires limits.newConnectionsPerSecond")
+	}
+
+	if l.MaxConnectionsLimit > 0 && !protocolHasPorts(protocol) {
+		return fmt.Errorf("limits.maxConnections is not supported for protocol %s", protocol)
+	}
+
+	if l.SourcePrefixLengthIPv4Config < 0 || l.SourcePrefixLengthIPv4Config > 32 {
+		return fmt.Errorf("invalid limits.sourcePrefixLengthIPv4: %d", l.SourcePrefixLengthIPv4Config)
+	}
+
+	if l.SourcePrefixLengthIPv6Config < 0 || l.SourcePrefixLengthIPv6Config > 128 {
+		return fmt.Errorf("invalid limits.sourcePrefixLengthIPv6: %d", l.SourcePrefixLengthIPv6Config)
+	}
+
+	return nil
+}
+
 // NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
 func (s *RuleConfigV1Alpha1) NetworkRuleConfigSignal() {}
 
@@ -224,3 +282,45 @@ func (s *RuleConfigV1Alpha1) ExceptSubnets() []netip.Prefix {
 		},
 	)
 }
+
+// Limits implements config.NetworkRule interface.
+func (s *RuleConfigV1Alpha1) Limits() config.NetworkRuleLimits {
+	if s.ConnectionLimits.NewConnectionsPerSecondLimit == 0 && s.ConnectionLimits.MaxConnectionsLimit == 0 {
+		return nil
+	}
+
+	return s.ConnectionLimits
+}
+
+// NewConnectionsPerSecond implements config.NetworkRuleLimits interface.
+func (l RuleLimits) NewConnectionsPerSecond() uint32 {
+	return l.NewConnectionsPerSecondLimit
+}
+
+// NewConnectionsBurst implements config.NetworkRuleLimits interface.
+func (l RuleLimits) NewConnectionsBurst() uint32 {
+	return l.NewConnectionsBurstLimit
+}
+
+// MaxConnections implements config.NetworkRuleLimits interface.
+func (l RuleLimits) MaxConnections() uint32 {
+	return l.MaxConnectionsLimit
+}
+
+// SourcePrefixLengthIPv4 implements config.NetworkRuleLimits interface.
+func (l RuleLimits) SourcePrefixLengthIPv4() int {
+	if l.SourcePrefixLengthIPv4Config == 0 {
+		return 32
+	}
+
+	return l.SourcePrefixLengthIPv4Config
+}
+
+// SourcePrefixLengthIPv6 implements config.NetworkRuleLimits interface.
+func (l RuleLimits) SourcePrefixLengthIPv6() int {
+	if l.SourcePrefixLengthIPv6Config == 0 {
+		return 64
+	}
+
+	return l.SourcePrefixLengthIPv6Config
+}
@@ -46,6 +46,12 @@ func TestRuleConfigMarshalStability(t *testing.T) {
 		},
 	}
 
+	cfg.ConnectionLimits = network.RuleLimits{
+		NewConnectionsPerSecondLimit: 10,
+		NewConnectionsBurstLimit:     20,
+		SourcePrefixLengthIPv6Config: 56,
+	}
+
 	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
 	require.NoError(t, err)
 
@@ -85,7 +91,24 @@ func TestRuleConfigUnmarshal(t *testing.T) {
 				Subnet: netip.MustParsePrefix("2001::/16"),
 			},
 		},
+		ConnectionLimits: network.RuleLimits{
+			NewConnectionsPerSecondLimit: 10,
+			NewConnectionsBurstLimit:     20,
+			SourcePrefixLengthIPv6Config: 56,
+		},
 	}, docs[0])
+
+	rules := provider.NetworkRules().Rules()
+	require.Len(t, rules, 1)
+
+	limits := rules[0].Limits()
+	require.NotNil(t, limits)
+
+	assert.Equal(t, uint32(10), limits.NewConnectionsPerSecond())
+	assert.Equal(t, uint32(20), limits.NewConnectionsBurst())
+	assert.Equal(t, uint32(0), limits.MaxConnections())
+	assert.Equal(t, 32, limits.SourcePrefixLengthIPv4())
+	assert.Equal(t, 56, limits.SourcePrefixLengthIPv6())
 }
 
 func TestRuleConfigValidate(t *testing.T) {
@@ -147,6 +170,53 @@ func TestRuleConfigValidate(t *testing.T) {
 
 			expectedError: "invalid subnet: invalid Prefix",
 		},
+		{
+			name: "burst without rate",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 80},
+				}
+				cfg.ConnectionLimits.NewConnectionsBurstLimit = 10
+
+				return cfg
+			},
+
+			expectedError: "limits.newConnectionsBurst requires limits.newConnectionsPerSecond",
+		},
+		{
+			name: "max connections for icmp",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Protocol = nethelpers.ProtocolICMP
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 80},
+				}
+				cfg.ConnectionLimits.MaxConnectionsLimit = 10
+
+				return cfg
+			},
+
+			expectedError: "limits.maxConnections is not supported for protocol icmp",
+		},
+		{
+			name: "invalid prefix length",
+			cfg: func() *network.RuleConfigV1Alpha1 {
+				cfg := network.NewRuleConfigV1Alpha1()
+				cfg.MetaName = "--"
+				cfg.PortSelector.Ports = network.PortRanges{
+					{Lo: 80, Hi: 80},
+				}
+				cfg.ConnectionLimits.NewConnectionsPerSecondLimit = 10
+				cfg.ConnectionLimits.SourcePrefixLengthIPv4Config = 33
+
+				return cfg
+			},
+
+			expectedError: "invalid limits.sourcePrefixLengthIPv4: 33",
+		},
 		{
 			name: "valid",
 			cfg: func() *network.RuleConfigV1Alpha1 {
@@ -0,0 +1,172 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SNATConfigKind is a source NAT config document kind.
+const SNATConfigKind = "NetworkSNATConfig"
+
+func init() {
+	registry.Register(SNATConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &SNATConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkRuleConfigSourceNATRules = &SNATConfigV1Alpha1{}
+	_ config.NetworkSourceNATRule            = &SNATConfigV1Alpha1{}
+	_ config.NetworkRuleConfigSignal         = &SNATConfigV1Alpha1{}
+	_ config.NamedDocument                   = &SNATConfigV1Alpha1{}
+	_ config.Validator                       = &SNATConfigV1Alpha1{}
+)
+
+// SNATConfigV1Alpha1 is a source NAT (masquerade) config document.
+//
+//	examples:
+//	  - value: exampleSNATConfigV1Alpha1()
+//	alias: NetworkSNATConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/NetworkSNATConfig
+type SNATConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Source subnets of the traffic to translate.
+	//   examples:
+	//    - value: >
+	//       []meta.Prefix{{Prefix: netip.MustParsePrefix("10.5.0.0/24")}}
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       pattern: ^[0-9a-f.:]+/\d{1,3}$
+	//     minItems: 1
+	//   schemaRequired: true
+	SNATSourceSubnets []meta.Prefix `yaml:"sourceSubnets" merge:"replace"`
+	//   description: |
+	//     Name of the link the traffic leaves the host through.
+	//
+	//     If not set, the traffic is translated regardless of the outgoing link.
+	//   examples:
+	//    - value: >
+	//       "eth0"
+	SNATOutLinkName string `yaml:"outLinkName,omitempty"`
+	//   description: |
+	//     Address to translate the source address to.
+	//
+	//     If not set, the traffic is masqueraded: the address of the outgoing link is used.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	//   schema:
+	//     type: string
+	SNATToAddress meta.Addr `yaml:"toAddress,omitempty"`
+}
+
+// NewSNATConfigV1Alpha1 creates a new SNATConfig config document.
+func NewSNATConfigV1Alpha1() *SNATConfigV1Alpha1 {
+	return &SNATConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SNATConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSNATConfigV1Alpha1() *SNATConfigV1Alpha1 {
+	cfg := NewSNATConfigV1Alpha1()
+	cfg.MetaName = "downstream"
+	cfg.SNATSourceSubnets = []meta.Prefix{
+		{Prefix: netip.MustParsePrefix("10.5.0.0/24")},
+	}
+	cfg.SNATOutLinkName = "eth0"
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SNATConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *SNATConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Validate implements config.Validator interface.
+func (s *SNATConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetaName == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if len(s.SNATSourceSubnets) == 0 {
+		return nil, errors.New("sourceSubnets is required")
+	}
+
+	for _, subnet := range s.SNATSourceSubnets {
+		if !subnet.IsValid() {
+			return nil, fmt.Errorf("invalid source subnet: %s", subnet.Prefix)
+		}
+
+		if s.SNATToAddress.IsValid() && subnet.Addr().Is4() != s.SNATToAddress.Is4() {
+			return nil, fmt.Errorf("source subnet %s and toAddress %s are of different address families", subnet.Prefix, s.SNATToAddress.Addr)
+		}
+	}
+
+	return nil, nil
+}
+
+// NetworkRuleConfigSignal implements config.NetworkRuleConfigSignal interface.
+func (s *SNATConfigV1Alpha1) NetworkRuleConfigSignal() {}
+
+// SourceNATRules implements config.NetworkRuleConfigSourceNATRules interface.
+func (s *SNATConfigV1Alpha1) SourceNATRules() []config.NetworkSourceNATRule {
+	return []config.NetworkSourceNATRule{s}
+}
+
+// SourceSubnets implements config.NetworkSourceNATRule interface.
+func (s *SNATConfigV1Alpha1) SourceSubnets() []netip.Prefix {
+	return xslices.Map(s.SNATSourceSubnets, func(p meta.Prefix) netip.Prefix { return p.Prefix })
+}
+
+// OutLinkName implements config.NetworkSourceNATRule interface.
+func (s *SNATConfigV1Alpha1) OutLinkName() string {
+	return s.SNATOutLinkName
+}
+
+// ToAddress implements config.NetworkSourceNATRule interface.
+func (s *SNATConfigV1Alpha1) ToAddress() optional.Optional[netip.Addr] {
+	if !s.SNATToAddress.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(s.SNATToAddress.Addr)
+}
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/snatconfig.yaml
+var expectedSNATConfigDocument []byte
+
+func TestSNATConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewSNATConfigV1Alpha1()
+	cfg.MetaName = "test"
+	cfg.SNATSourceSubnets = []meta.Prefix{
+		{Prefix: netip.MustParsePrefix("10.5.0.0/24")},
+	}
+	cfg.SNATOutLinkName = "eth0"
+	cfg.SNATToAddress = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSNATConfigDocument, marshaled)
+}
+
+func TestSNATConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSNATConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.SNATConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.SNATConfigKind,
+		},
+		MetaName: "test",
+		SNATSourceSubnets: []meta.Prefix{
+			{Prefix: netip.MustParsePrefix("10.5.0.0/24")},
+		},
+		SNATOutLinkName: "eth0",
+		SNATToAddress:   meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")},
+	}, docs[0])
+
+	rules := provider.NetworkRules().SourceNATRules()
+	require.Len(t, rules, 1)
+
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.5.0.0/24")}, rules[0].SourceSubnets())
+	assert.Equal(t, "eth0", rules[0].OutLinkName())
+	assert.Equal(t, netip.MustParseAddr("192.168.1.10"), rules[0].ToAddress().ValueOrZero())
+}
+
+func TestSNATConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.SNATConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg:  network.NewSNATConfigV1Alpha1,
+
+			expectedError: "name is required",
+		},
+		{
+			name: "no source subnets",
+			cfg: func() *network.SNATConfigV1Alpha1 {
+				cfg := network.NewSNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+
+				return cfg
+			},
+
+			expectedError: "sourceSubnets is required",
+		},
+		{
+			name: "mixed families",
+			cfg: func() *network.SNATConfigV1Alpha1 {
+				cfg := network.NewSNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.SNATSourceSubnets = []meta.Prefix{
+					{Prefix: netip.MustParsePrefix("fd00::/64")},
+				}
+				cfg.SNATToAddress = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+
+				return cfg
+			},
+
+			expectedError: "source subnet fd00::/64 and toAddress 192.168.1.10 are of different address families",
+		},
+		{
+			name: "masquerade",
+			cfg: func() *network.SNATConfigV1Alpha1 {
+				cfg := network.NewSNATConfigV1Alpha1()
+				cfg.MetaName = "-"
+				cfg.SNATSourceSubnets = []meta.Prefix{
+					{Prefix: netip.MustParsePrefix("10.5.0.0/24")},
+					{Prefix: netip.MustParsePrefix("fd00::/64")},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
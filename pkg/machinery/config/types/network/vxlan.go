@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// VXLANKind is a VXLAN config document kind.
+const VXLANKind = "VXLANConfig"
+
+func init() {
+	registry.Register(VXLANKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &VXLANConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.NetworkVXLANConfig  = &VXLANConfigV1Alpha1{}
+	_ config.ConflictingDocument = &VXLANConfigV1Alpha1{}
+	_ config.NamedDocument       = &VXLANConfigV1Alpha1{}
+	_ config.Validator           = &VXLANConfigV1Alpha1{}
+)
+
+// maxVNI is the largest VXLAN/Geneve network identifier (24 bits).
+const maxVNI = 1<<24 - 1
+
+// VXLANConfigV1Alpha1 is a config document to create a VXLAN (virtual extensible LAN) tunnel link.
+//
+//	examples:
+//	  - value: exampleVXLANConfigV1Alpha1()
+//	alias: VXLANConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/VXLANConfig
+type VXLANConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Name of the VXLAN link (interface) to be created.
+	//
+	//   examples:
+	//    - value: >
+	//       "vxlan42"
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     VXLAN network identifier (VNI).
+	//
+	//   examples:
+	//    - value: >
+	//       42
+	//   schemaRequired: true
+	VXLANVNI uint32 `yaml:"vni,omitempty"`
+	//   description: |
+	//     Name of the underlay link (interface) the tunnel traffic is sent over.
+	//     Link aliases can be used here as well.
+	//
+	//     Required if `remote` is a multicast group address.
+	//   examples:
+	//    - value: >
+	//       "enp0s3"
+	VXLANParentLink string `yaml:"parent,omitempty"`
+	//   description: |
+	//     Local (source) IP address of the tunnel endpoint.
+	//     If not set, the source address is selected by the kernel.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	VXLANLocal meta.Addr `yaml:"local,omitempty"`
+	//   description: |
+	//     Remote IP address of the tunnel endpoint, or a multicast group address.
+	//     If not set, forwarding database entries must be managed externally.
+	//   examples:
+	//    - value: >
+	//       meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	VXLANRemote meta.Addr `yaml:"remote,omitempty"`
+	//   description: |
+	//     Destination UDP port.
+	//     If not set, defaults to the IANA-assigned port 4789.
+	//   examples:
+	//    - value: >
+	//       4789
+	VXLANPort uint16 `yaml:"port,omitempty"`
+
+	//nolint:embeddedstructfieldcheck
+	CommonLinkConfig `yaml:",inline"`
+}
+
+// NewVXLANConfigV1Alpha1 creates a new VXLANConfig config document.
+func NewVXLANConfigV1Alpha1(name string) *VXLANConfigV1Alpha1 {
+	return &VXLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       VXLANKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleVXLANConfigV1Alpha1() *VXLANConfigV1Alpha1 {
+	cfg := NewVXLANConfigV1Alpha1("vxlan42")
+	cfg.VXLANVNI = 42
+	cfg.VXLANParentLink = "enp0s3"
+	cfg.VXLANLocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	cfg.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	cfg.LinkAddresses = []AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.42.0.1/24"),
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *VXLANConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Name implements config.NamedDocument interface.
+func (s *VXLANConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// VXLANConfig implements NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) VXLANConfig() {}
+
+// VNI implements NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) VNI() uint32 {
+	return s.VXLANVNI
+}
+
+// ParentLink implements NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) ParentLink() string {
+	return s.VXLANParentLink
+}
+
+// Local implements NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) Local() optional.Optional[netip.Addr] {
+	if !s.VXLANLocal.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(s.VXLANLocal.Addr)
+}
+
+// Remote implements NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) Remote() optional.Optional[netip.Addr] {
+	if !s.VXLANRemote.IsValid() {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(s.VXLANRemote.Addr)
+}
+
+// Port implements NetworkVXLANConfig interface.
+func (s *VXLANConfigV1Alpha1) Port() optional.Optional[uint16] {
+	if s.VXLANPort == 0 {
+		return optional.None[uint16]()
+	}
+
+	return optional.Some(s.VXLANPort)
+}
+
+// ConflictsWithKinds implements config.ConflictingDocument interface.
+func (s *VXLANConfigV1Alpha1) ConflictsWithKinds() []string {
+	return conflictingLinkKinds(VXLANKind)
+}
+
+// Validate implements config.Validator interface.
+func (s *VXLANConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		errs     error
+		warnings []string //nolint:prealloc
+	)
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name must be specified"))
+	}
+
+	if s.VXLANVNI == 0 || s.VXLANVNI > maxVNI {
+		errs = errors.Join(errs, errors.New("vni must be specified and between 1 and 16777215"))
+	}
+
+	if s.VXLANLocal.IsValid() && s.VXLANRemote.IsValid() && s.VXLANLocal.Is4() != s.VXLANRemote.Is4() {
+		errs = errors.Join(errs, errors.New("local and remote addresses must be of the same address family"))
+	}
+
+	if s.VXLANRemote.IsValid() && s.VXLANRemote.IsMulticast() && s.VXLANParentLink == "" {
+		errs = errors.Join(errs, errors.New("parent must be specified for a multicast remote address"))
+	}
+
+	extraWarnings, extraErrs := s.CommonLinkConfig.Validate()
+	errs, warnings = errors.Join(errs, extraErrs), append(warnings, extraWarnings...)
+
+	return warnings, errs
+}
@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/vxlanconfig.yaml
+var expectedVXLANConfigDocument []byte
+
+func TestVXLANConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewVXLANConfigV1Alpha1("vxlan42")
+	cfg.VXLANVNI = 42
+	cfg.VXLANParentLink = "enp0s3"
+	cfg.VXLANLocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+	cfg.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")}
+	cfg.VXLANPort = 4789
+	cfg.LinkUp = new(true)
+	cfg.LinkAddresses = []network.AddressConfig{
+		{
+			AddressAddress: netip.MustParsePrefix("10.42.0.1/24"),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedVXLANConfigDocument, marshaled)
+}
+
+func TestVXLANConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedVXLANConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.VXLANConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.VXLANKind,
+		},
+		MetaName:        "vxlan42",
+		VXLANVNI:        42,
+		VXLANParentLink: "enp0s3",
+		VXLANLocal:      meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")},
+		VXLANRemote:     meta.Addr{Addr: netip.MustParseAddr("192.168.2.10")},
+		VXLANPort:       4789,
+		CommonLinkConfig: network.CommonLinkConfig{
+			LinkUp: new(true),
+			LinkAddresses: []network.AddressConfig{
+				{
+					AddressAddress: netip.MustParsePrefix("10.42.0.1/24"),
+				},
+			},
+		},
+	}, docs[0])
+}
+
+func TestVXLANValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.VXLANConfigV1Alpha1
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				return network.NewVXLANConfigV1Alpha1("")
+			},
+
+			expectedError: "name must be specified\nvni must be specified and between 1 and 16777215",
+		},
+		{
+			name: "high VNI",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1("vxlan0")
+				cfg.VXLANVNI = 1 << 24
+
+				return cfg
+			},
+
+			expectedError: "vni must be specified and between 1 and 16777215",
+		},
+		{
+			name: "mixed address families",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1("vxlan0")
+				cfg.VXLANVNI = 100
+				cfg.VXLANLocal = meta.Addr{Addr: netip.MustParseAddr("192.168.1.10")}
+				cfg.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("fd00::10")}
+
+				return cfg
+			},
+
+			expectedError: "local and remote addresses must be of the same address family",
+		},
+		{
+			name: "multicast without parent",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1("vxlan0")
+				cfg.VXLANVNI = 100
+				cfg.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+
+				return cfg
+			},
+
+			expectedError: "parent must be specified for a multicast remote address",
+		},
+		{
+			name: "valid multicast",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1("vxlan0")
+				cfg.VXLANVNI = 100
+				cfg.VXLANParentLink = "eth0"
+				cfg.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("239.1.1.1")}
+
+				return cfg
+			},
+		},
+		{
+			name: "valid",
+			cfg: func() *network.VXLANConfigV1Alpha1 {
+				cfg := network.NewVXLANConfigV1Alpha1("vxlan0")
+				cfg.VXLANVNI = 16777215
+				cfg.VXLANLocal = meta.Addr{Addr: netip.MustParseAddr("fd00::1")}
+				cfg.VXLANRemote = meta.Addr{Addr: netip.MustParseAddr("fd00::2")}
+				cfg.LinkAddresses = []network.AddressConfig{
+					{
+						AddressAddress: netip.MustParsePrefix("10.42.0.1/24"),
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, err := test.cfg().Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
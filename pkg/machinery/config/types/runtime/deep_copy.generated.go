@@ -2,12 +2,14 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type EventSinkV1Alpha1 -type EnvironmentV1Alpha1 -type KmsgLogV1Alpha1 -type OOMV1Alpha1 -type SysctlConfigV1Alpha1 -type SysfsConfigV1Alpha1 -type EtcFileConfigV1Alpha1 -type UdevRulesConfigV1Alpha1 -type UnattendedInstallConfigV1Alpha1 -type WatchdogTimerV1Alpha1 -type SecurityProfileConfigV1Alpha1 -type KernelModuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type EventSinkV1Alpha1 -type EnvironmentV1Alpha1 -type KmsgLogV1Alpha1 -type OOMV1Alpha1 -type SysctlConfigV1Alpha1 -type SysfsConfigV1Alpha1 -type EtcFileConfigV1Alpha1 -type UdevRulesConfigV1Alpha1 -type UnattendedInstallConfigV1Alpha1 -type WatchdogTimerV1Alpha1 -type SecurityProfileConfigV1Alpha1 -type KernelModuleConfigV1Alpha1 -type MetricsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
 import (
 	"net/url"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 )
 
 // DeepCopy generates a deep copy of *EventSinkV1Alpha1.
@@ -39,6 +41,14 @@ func (o *KmsgLogV1Alpha1) DeepCopy() *KmsgLogV1Alpha1 {
 			*cp.KmsgLogURL.URL.User = *o.KmsgLogURL.URL.User
 		}
 	}
+	if o.KmsgLogTLS != nil {
+		cp.KmsgLogTLS = new(KmsgLogTLSConfig)
+		*cp.KmsgLogTLS = *o.KmsgLogTLS
+		if o.KmsgLogTLS.TLSClientIdentity != nil {
+			cp.KmsgLogTLS.TLSClientIdentity = new(meta.CertificateAndKey)
+			*cp.KmsgLogTLS.TLSClientIdentity = *o.KmsgLogTLS.TLSClientIdentity
+		}
+	}
 	return &cp
 }
 
@@ -131,3 +141,9 @@ func (o *KernelModuleConfigV1Alpha1) DeepCopy() *KernelModuleConfigV1Alpha1 {
 	}
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *MetricsConfigV1Alpha1.
+func (o *MetricsConfigV1Alpha1) DeepCopy() *MetricsConfigV1Alpha1 {
+	var cp MetricsConfigV1Alpha1 = *o
+	return &cp
+}
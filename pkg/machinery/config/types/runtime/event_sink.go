@@ -92,6 +92,11 @@ func (s *EventSinkV1Alpha1) KmsgLogURLs() []*url.URL {
 	return nil
 }
 
+// KmsgLogDestinations implements config.RuntimeConfig interface.
+func (s *EventSinkV1Alpha1) KmsgLogDestinations() []config.LoggingDestination {
+	return nil
+}
+
 // WatchdogTimer implements config.RuntimeConfig interface.
 func (s *EventSinkV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return nil
@@ -7,15 +7,20 @@ package runtime
 //docgen:jsonschema
 
 import (
+	stdx509 "crypto/x509"
 	"errors"
+	"fmt"
 	"net/url"
+	"slices"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/ensure"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // KmsgLogKind is a kmsg log config document kind.
@@ -34,9 +39,11 @@ func init() {
 
 // Check interfaces.
 var (
-	_ config.RuntimeConfig = &KmsgLogV1Alpha1{}
-	_ config.NamedDocument = &KmsgLogV1Alpha1{}
-	_ config.Validator     = &KmsgLogV1Alpha1{}
+	_ config.RuntimeConfig      = &KmsgLogV1Alpha1{}
+	_ config.NamedDocument      = &KmsgLogV1Alpha1{}
+	_ config.Validator          = &KmsgLogV1Alpha1{}
+	_ config.SecretDocument     = &KmsgLogV1Alpha1{}
+	_ config.LoggingDestination = &KmsgLogV1Alpha1{}
 )
 
 // KmsgLogV1Alpha1 is a event sink config document.
@@ -54,16 +61,55 @@ type KmsgLogV1Alpha1 struct {
 	MetaName string `yaml:"name"`
 	//   description: |
 	//     The URL encodes the log destination.
-	//     The scheme must be tcp:// or udp://.
-	//     The path must be empty.
+	//     The scheme must be tcp:// or udp:// (JSON lines), http:// or https:// (OTLP/HTTP),
+	//     grpc:// or grpcs:// (OTLP/gRPC, grpcs:// uses TLS), tcp:// or tls:// (syslog).
+	//     The path must be empty, except for OTLP/HTTP where it defaults to /v1/logs.
 	//     The port is required.
 	//   examples:
 	//     - value: >
 	//        "udp://10.3.7.3:2810"
+	//     - value: >
+	//        "grpc://10.3.7.3:4317"
 	//   schema:
 	//     type: string
-	//     pattern: "^(tcp|udp)://"
+	//     pattern: "^(tcp|udp|tls|https?|grpcs?)://"
 	KmsgLogURL meta.URL `yaml:"url"`
+	//   description: |
+	//     Logs format.
+	//
+	//     If not set, the format is derived from the URL scheme: `json_lines` for tcp:// and udp://,
+	//     `otlp` for http://, https://, grpc:// and grpcs://.
+	//     The `syslog` format sends RFC 5424 messages over tcp:// or tls://.
+	//   values:
+	//     - json_lines
+	//     - otlp
+	//     - syslog
+	KmsgLogFormat string `yaml:"format,omitempty"`
+	//   description: |
+	//     TLS settings for the tls:// URL scheme.
+	KmsgLogTLS *KmsgLogTLSConfig `yaml:"tls,omitempty"`
+}
+
+// KmsgLogTLSConfig configures TLS for the kmsg log destination.
+type KmsgLogTLSConfig struct {
+	//   description: |
+	//     Client certificate and key to present to the log receiver.
+	//     Client certificate and key should be PEM-encoded.
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       cert:
+	//         type: string
+	//       key:
+	//         type: string
+	TLSClientIdentity *meta.CertificateAndKey `yaml:"clientIdentity,omitempty"`
+	//   description: |
+	//     CA certificate to verify the log receiver certificate with, system roots are used if not set.
+	//     Certificate should be PEM-encoded.
+	//   schema:
+	//     type: string
+	TLSCA string `yaml:"ca,omitempty"`
 }
 
 // NewKmsgLogV1Alpha1 creates a new eventsink config document.
@@ -109,11 +155,70 @@ func (s *KmsgLogV1Alpha1) KmsgLogURLs() []*url.URL {
 	return []*url.URL{s.KmsgLogURL.URL}
 }
 
+// KmsgLogDestinations implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) KmsgLogDestinations() []config.LoggingDestination {
+	return []config.LoggingDestination{s}
+}
+
 // WatchdogTimer implements config.RuntimeConfig interface.
 func (s *KmsgLogV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return nil
 }
 
+// Endpoint implements config.LoggingDestination interface.
+func (s *KmsgLogV1Alpha1) Endpoint() *url.URL {
+	return s.KmsgLogURL.URL
+}
+
+// ExtraTags implements config.LoggingDestination interface.
+func (s *KmsgLogV1Alpha1) ExtraTags() map[string]string {
+	return nil
+}
+
+// Format implements config.LoggingDestination interface.
+func (s *KmsgLogV1Alpha1) Format() string {
+	if s.KmsgLogFormat != "" {
+		return s.KmsgLogFormat
+	}
+
+	switch s.KmsgLogURL.URL.Scheme {
+	case "http", "https", "grpc", "grpcs":
+		return constants.LoggingFormatOTLP
+	default:
+		return constants.LoggingFormatJSONLines
+	}
+}
+
+// TLS implements config.LoggingDestination interface.
+func (s *KmsgLogV1Alpha1) TLS() config.LoggingTLSConfig {
+	if s.KmsgLogTLS == nil {
+		return nil
+	}
+
+	return s.KmsgLogTLS
+}
+
+// Redact implements config.SecretDocument interface.
+func (s *KmsgLogV1Alpha1) Redact(replacement string) {
+	if s.KmsgLogTLS != nil && s.KmsgLogTLS.TLSClientIdentity != nil && s.KmsgLogTLS.TLSClientIdentity.Key != "" {
+		s.KmsgLogTLS.TLSClientIdentity.Key = replacement
+	}
+}
+
+// ClientIdentity implements config.LoggingTLSConfig interface.
+func (c *KmsgLogTLSConfig) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return c.TLSClientIdentity.ToX509()
+}
+
+// CA implements config.LoggingTLSConfig interface.
+func (c *KmsgLogTLSConfig) CA() []byte {
+	if c.TLSCA == "" {
+		return nil
+	}
+
+	return []byte(c.TLSCA)
+}
+
 // Validate implements config.Validator interface.
 func (s *KmsgLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	if s.MetaName == "" {
@@ -124,23 +229,56 @@ func (s *KmsgLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option)
 		return nil, errors.New("url is required")
 	}
 
-	switch s.KmsgLogURL.URL.Scheme {
-	case "tcp":
-	case "udp":
+	var schemes []string
+
+	switch s.KmsgLogFormat {
+	case "":
+		schemes = []string{"tcp", "udp", "http", "https", "grpc", "grpcs"}
+	case constants.LoggingFormatJSONLines:
+		schemes = []string{"tcp", "udp"}
+	case constants.LoggingFormatOTLP:
+		schemes = []string{"http", "https", "grpc", "grpcs"}
+	case constants.LoggingFormatSyslog:
+		schemes = []string{"tcp", "tls"}
 	default:
-		return nil, errors.New("url scheme must be tcp:// or udp://")
+		return nil, fmt.Errorf("unknown format %q", s.KmsgLogFormat)
 	}
 
-	switch s.KmsgLogURL.URL.Path {
-	case "/":
-	case "":
+	switch {
+	case s.KmsgLogFormat == "" && !slices.Contains(schemes, s.KmsgLogURL.URL.Scheme):
+		return nil, errors.New("url scheme must be one of tcp://, udp://, http://, https://, grpc:// or grpcs://")
+	case !slices.Contains(schemes, s.KmsgLogURL.URL.Scheme):
+		return nil, fmt.Errorf("url scheme %q is not supported for the %s format", s.KmsgLogURL.URL.Scheme, s.KmsgLogFormat)
+	}
+
+	switch s.KmsgLogURL.URL.Scheme {
+	case "http", "https":
 	default:
-		return nil, errors.New("url path must be empty")
+		switch s.KmsgLogURL.URL.Path {
+		case "/":
+		case "":
+		default:
+			return nil, errors.New("url path must be empty")
+		}
 	}
 
 	if s.KmsgLogURL.URL.Port() == "" {
 		return nil, errors.New("url port is required")
 	}
 
+	if s.KmsgLogTLS != nil {
+		if s.KmsgLogURL.URL.Scheme != "tls" {
+			return nil, errors.New("tls settings are only supported for tls:// urls")
+		}
+
+		if s.KmsgLogTLS.TLSCA != "" && !stdx509.NewCertPool().AppendCertsFromPEM([]byte(s.KmsgLogTLS.TLSCA)) {
+			return nil, errors.New("tls ca must be a valid PEM-encoded certificate")
+		}
+
+		if err := s.KmsgLogTLS.TLSClientIdentity.Validate(false); err != nil {
+			return nil, fmt.Errorf("tls client identity: %w", err)
+		}
+	}
+
 	return nil, nil
 }
@@ -65,12 +65,12 @@ func TestKmsgLogValidate(t *testing.T) {
 			cfg: func() *runtime.KmsgLogV1Alpha1 {
 				cfg := runtime.NewKmsgLogV1Alpha1()
 				cfg.MetaName = "name2"
-				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("https://some.destination/path"))
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("ftp://some.destination/path"))
 
 				return cfg
 			},
 
-			expectedError: "url scheme must be tcp:// or udp://",
+			expectedError: "url scheme must be one of tcp://, udp://, http://, https://, grpc:// or grpcs://",
 		},
 		{
 			name: "extra path",
@@ -116,6 +116,92 @@ func TestKmsgLogValidate(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			name: "valid OTLP HTTP",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name7"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("https://10.2.3.4:4318/otlp/v1/logs"))
+
+				return cfg
+			},
+		},
+		{
+			name: "valid OTLP gRPC",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name8"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("grpc://10.2.3.4:4317"))
+
+				return cfg
+			},
+		},
+		{
+			name: "valid syslog TLS",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name9"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tls://10.2.3.4:6514"))
+				cfg.KmsgLogFormat = "syslog"
+				cfg.KmsgLogTLS = &runtime.KmsgLogTLSConfig{}
+
+				return cfg
+			},
+		},
+		{
+			name: "syslog over UDP",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name10"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("udp://10.2.3.4:514"))
+				cfg.KmsgLogFormat = "syslog"
+
+				return cfg
+			},
+
+			expectedError: "url scheme \"udp\" is not supported for the syslog format",
+		},
+		{
+			name: "TLS without format",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name11"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tls://10.2.3.4:6514"))
+
+				return cfg
+			},
+
+			expectedError: "url scheme must be one of tcp://, udp://, http://, https://, grpc:// or grpcs://",
+		},
+		{
+			name: "TLS settings for TCP",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name12"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:514"))
+				cfg.KmsgLogFormat = "syslog"
+				cfg.KmsgLogTLS = &runtime.KmsgLogTLSConfig{
+					TLSCA: "not a certificate",
+				}
+
+				return cfg
+			},
+
+			expectedError: "tls settings are only supported for tls:// urls",
+		},
+		{
+			name: "unknown format",
+			cfg: func() *runtime.KmsgLogV1Alpha1 {
+				cfg := runtime.NewKmsgLogV1Alpha1()
+				cfg.MetaName = "name13"
+				cfg.KmsgLogURL.URL = ensure.Value(url.Parse("tcp://10.2.3.4:514"))
+				cfg.KmsgLogFormat = "gelf"
+
+				return cfg
+			},
+
+			expectedError: "unknown format \"gelf\"",
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
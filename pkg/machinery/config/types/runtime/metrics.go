@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// MetricsConfigKind is a metrics config document kind.
+const MetricsConfigKind = "MetricsConfig"
+
+func init() {
+	registry.Register(MetricsConfigKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1": //nolint:goconst
+			return &MetricsConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.MetricsConfig = &MetricsConfigV1Alpha1{}
+	_ config.Validator     = &MetricsConfigV1Alpha1{}
+)
+
+// MetricsConfigV1Alpha1 is a Prometheus metrics endpoint config document.
+//
+//	description: |
+//	  Enables the Prometheus/OpenMetrics endpoint at `https://<listen>/metrics`.
+//
+//	  The endpoint is protected with mutual TLS using the Talos API certificates: scrapers
+//	  should present a client certificate issued by the Talos machine CA carrying one of the
+//	  `os:reader`, `os:operator` or `os:admin` roles (e.g. generated with `talosctl config new --roles os:reader`).
+//
+//	  Exported metrics include CPU and memory stats, service health, controller runtime reconcile and crash counts,
+//	  volume phases, KubeSpan peer states and etcd member health.
+//	  The gRPC request counts and latencies of apid are exported at `https://<listen>/metrics/apid`.
+//	examples:
+//	  - value: exampleMetricsConfigV1Alpha1()
+//	alias: MetricsConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/MetricsConfig
+type MetricsConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+
+	//   description: |
+	//     Address to listen on for metrics scrapes.
+	//
+	//     Default value is `:50002` (all addresses).
+	//   examples:
+	//     - value: >
+	//        "10.0.0.1:50002"
+	MetricsListen string `yaml:"listen,omitempty"`
+}
+
+// NewMetricsConfigV1Alpha1 creates a new metrics config document.
+func NewMetricsConfigV1Alpha1() *MetricsConfigV1Alpha1 {
+	return &MetricsConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       MetricsConfigKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleMetricsConfigV1Alpha1() *MetricsConfigV1Alpha1 {
+	cfg := NewMetricsConfigV1Alpha1()
+	cfg.MetricsListen = ":50002"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *MetricsConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// MetricsConfigSignal is a signal for metrics config.
+func (s *MetricsConfigV1Alpha1) MetricsConfigSignal() {}
+
+// ListenAddress implements config.MetricsConfig interface.
+func (s *MetricsConfigV1Alpha1) ListenAddress() string {
+	if s.MetricsListen == "" {
+		return net.JoinHostPort("", strconv.Itoa(constants.MetricsPort))
+	}
+
+	return s.MetricsListen
+}
+
+// Validate implements config.Validator interface.
+func (s *MetricsConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetricsListen == "" {
+		return nil, nil
+	}
+
+	_, port, err := net.SplitHostPort(s.MetricsListen)
+	if err != nil {
+		return nil, fmt.Errorf("metrics listen address %q is invalid: %w", s.MetricsListen, err)
+	}
+
+	if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+		return nil, fmt.Errorf("metrics listen port %q is invalid: %w", port, err)
+	}
+
+	return nil, nil
+}
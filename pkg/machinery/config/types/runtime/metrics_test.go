@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/metricsconfig.yaml
+var expectedMetricsConfigDocument []byte
+
+func TestMetricsConfigMarshalStability(t *testing.T) {
+	cfg := runtime.NewMetricsConfigV1Alpha1()
+	cfg.MetricsListen = "10.0.0.1:9100"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedMetricsConfigDocument, marshaled)
+}
+
+func TestMetricsConfigListenAddress(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewMetricsConfigV1Alpha1()
+	assert.Equal(t, ":50002", cfg.ListenAddress())
+
+	cfg.MetricsListen = "[fd00::1]:9100"
+	assert.Equal(t, "[fd00::1]:9100", cfg.ListenAddress())
+}
+
+func TestMetricsConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		listen string
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "valid",
+			listen: "127.0.0.1:9100",
+		},
+		{
+			name:   "no port",
+			listen: "127.0.0.1",
+
+			expectedError: "metrics listen address \"127.0.0.1\" is invalid: address 127.0.0.1: missing port in address",
+		},
+		{
+			name:   "bad port",
+			listen: ":metrics",
+
+			expectedError: "metrics listen port \"metrics\" is invalid: strconv.ParseUint: parsing \"metrics\": invalid syntax",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := runtime.NewMetricsConfigV1Alpha1()
+			cfg.MetricsListen = test.listen
+
+			warnings, err := cfg.Validate(validationMode{})
+			assert.Empty(t, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -31,15 +31,67 @@ func (KmsgLogV1Alpha1) Doc() *encoder.Doc {
 				Name:        "url",
 				Type:        "URL",
 				Note:        "",
-				Description: "The URL encodes the log destination.\nThe scheme must be tcp:// or udp://.\nThe path must be empty.\nThe port is required.",
+				Description: "The URL encodes the log destination.\nThe scheme must be tcp:// or udp:// (JSON lines), http:// or https:// (OTLP/HTTP),\ngrpc:// or grpcs:// (OTLP/gRPC, grpcs:// uses TLS), tcp:// or tls:// (syslog).\nThe path must be empty, except for OTLP/HTTP where it defaults to /v1/logs.\nThe port is required.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL encodes the log destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "format",
+				Type:        "string",
+				Note:        "",
+				Description: "Logs format.\n\nIf not set, the format is derived from the URL scheme: `json_lines` for tcp:// and udp://,\n`otlp` for http://, https://, grpc:// and grpcs://.\nThe `syslog` format sends RFC 5424 messages over tcp:// or tls://.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Logs format." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"json_lines",
+					"otlp",
+					"syslog",
+				},
+			},
+			{
+				Name:        "tls",
+				Type:        "KmsgLogTLSConfig",
+				Note:        "",
+				Description: "TLS settings for the tls:// URL scheme.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TLS settings for the tls:// URL scheme." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", exampleKmsgLogV1Alpha1())
 
 	doc.Fields[2].AddExample("", "udp://10.3.7.3:2810")
+	doc.Fields[2].AddExample("", "grpc://10.3.7.3:4317")
+
+	return doc
+}
+
+func (KmsgLogTLSConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KmsgLogTLSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KmsgLogTLSConfig configures TLS for the kmsg log destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KmsgLogTLSConfig configures TLS for the kmsg log destination.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KmsgLogV1Alpha1",
+				FieldName: "tls",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "clientIdentity",
+				Type:        "CertificateAndKey",
+				Note:        "",
+				Description: "Client certificate and key to present to the log receiver.\nClient certificate and key should be PEM-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Client certificate and key to present to the log receiver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "string",
+				Note:        "",
+				Description: "CA certificate to verify the log receiver certificate with, system roots are used if not set.\nCertificate should be PEM-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the log receiver certificate with, system roots are used if not set." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
 
 	return doc
 }
@@ -497,6 +549,33 @@ func (SecurityProfileConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (MetricsConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MetricsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MetricsConfig is a Prometheus metrics endpoint config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MetricsConfig is a Prometheus metrics endpoint config document.\nEnables the Prometheus/OpenMetrics endpoint at `https://<listen>/metrics`.\n\nThe endpoint is protected with mutual TLS using the Talos API certificates: scrapers\nshould present a client certificate issued by the Talos machine CA carrying one of the\n`os:reader`, `os:operator` or `os:admin` roles (e.g. generated with `talosctl config new --roles os:reader`).\n\nExported metrics include CPU and memory stats, service health, controller runtime reconcile and crash counts,\nvolume phases, KubeSpan peer states and etcd member health.\nThe gRPC request counts and latencies of apid are exported at `https://<listen>/metrics/apid`.\n",
+		Fields: []encoder.Doc{
+			{
+				Type:   "Meta",
+				Inline: true,
+			},
+			{
+				Name:        "listen",
+				Type:        "string",
+				Note:        "",
+				Description: "Address to listen on for metrics scrapes.\n\nDefault value is `:50002` (all addresses).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to listen on for metrics scrapes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleMetricsConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", "10.0.0.1:50002")
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file runtime_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -504,6 +583,7 @@ func GetFileDoc() *encoder.FileDoc {
 		Description: "Package runtime provides runtime machine configuration documents.\n",
 		Structs: []*encoder.Doc{
 			KmsgLogV1Alpha1{}.Doc(),
+			KmsgLogTLSConfig{}.Doc(),
 			EventSinkV1Alpha1{}.Doc(),
 			EnvironmentV1Alpha1{}.Doc(),
 			OOMV1Alpha1{}.Doc(),
@@ -518,6 +598,7 @@ func GetFileDoc() *encoder.FileDoc {
 			WatchdogTimerV1Alpha1{}.Doc(),
 			KernelModuleConfigV1Alpha1{}.Doc(),
 			SecurityProfileConfigV1Alpha1{}.Doc(),
+			MetricsConfigV1Alpha1{}.Doc(),
 		},
 	}
 }
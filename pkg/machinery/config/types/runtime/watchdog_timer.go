@@ -109,6 +109,11 @@ func (s *WatchdogTimerV1Alpha1) KmsgLogURLs() []*url.URL {
 	return nil
 }
 
+// KmsgLogDestinations implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) KmsgLogDestinations() []config.LoggingDestination {
+	return nil
+}
+
 // WatchdogTimer implements config.RuntimeConfig interface.
 func (s *WatchdogTimerV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return s
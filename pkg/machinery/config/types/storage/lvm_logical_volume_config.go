@@ -67,6 +67,8 @@ type LVMLogicalVolumeConfigV1Alpha1 struct {
 	//     - raid0
 	//     - raid1
 	//     - raid10
+	//     - thin-pool
+	//     - thin
 	//   schema:
 	//     type: string
 	LVType storageres.LVMLogicalVolumeType `yaml:"type"`
@@ -83,6 +85,15 @@ type LVMLogicalVolumeConfigV1Alpha1 struct {
 	//     least 2. Not valid for `linear` or `raid1`.
 	LVStripes *uint32 `yaml:"stripes,omitempty"`
 	//   description: |
+	//     Name of the thin pool logical volume (in the same volume group) which
+	//     backs a `thin` logical volume.
+	//
+	//     Required for `thin`, not valid for other layouts.
+	//   examples:
+	//     - value: >
+	//         "lv-pool"
+	LVThinPool string `yaml:"thinPool,omitempty"`
+	//   description: |
 	//     Describes how the logical volume is provisioned.
 	Provisioning LVMLogicalVolumeProvisioningSpec `yaml:"provisioning"`
 }
@@ -104,6 +115,11 @@ type LVMLogicalVolumeProvisioningSpec struct {
 	//
 	//    Size is specified in bytes or in percents of the volume group.
 	//    It can be expressed in human readable format, e.g. 100MB or 80%.
+	//
+	//    For `thin` logical volumes this is the virtual size, which can exceed
+	//    the size of the thin pool; it must be specified in bytes.
+	//
+	//    Increasing the size grows the logical volume online; shrinking is not supported.
 	//  schema:
 	//    type: string
 	ProvisioningMaxSize block.Size `yaml:"maxSize,omitempty"`
@@ -201,6 +217,11 @@ func (s *LVMLogicalVolumeConfigV1Alpha1) Stripes() uint32 {
 	return 0
 }
 
+// ThinPool implements config.LVMLogicalVolumeConfig.
+func (s *LVMLogicalVolumeConfigV1Alpha1) ThinPool() string {
+	return s.LVThinPool
+}
+
 // Validate implements config.Validator interface.
 //
 //nolint:gocyclo,cyclop
@@ -254,6 +275,22 @@ func (s *LVMLogicalVolumeConfigV1Alpha1) Validate(validation.RuntimeMode, ...val
 		}
 	}
 
+	if s.LVType == storageres.LVMLogicalVolumeTypeThin {
+		if s.LVThinPool == "" {
+			validationErrors = errors.Join(validationErrors, errors.New("thinPool is required for thin logical volumes"))
+		}
+
+		if s.Provisioning.ProvisioningMaxSize.IsRelative() {
+			validationErrors = errors.Join(validationErrors, errors.New("provisioning.maxSize must be an absolute size for thin logical volumes"))
+		}
+	} else if s.LVThinPool != "" {
+		validationErrors = errors.Join(validationErrors, fmt.Errorf("thinPool is only valid for thin, not %s", s.LVType))
+	}
+
+	if s.LVThinPool == s.MetaName && s.LVThinPool != "" {
+		validationErrors = errors.Join(validationErrors, errors.New("thinPool must refer to another logical volume"))
+	}
+
 	if s.Provisioning.VolumeGroup == "" {
 		validationErrors = errors.Join(validationErrors, errors.New("provisioning.volumeGroup is required"))
 	}
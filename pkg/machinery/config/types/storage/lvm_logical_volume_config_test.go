@@ -40,6 +40,20 @@ func TestLVMLogicalVolumeConfigMarshalUnmarshal(t *testing.T) {
 				c.Provisioning.VolumeGroup = "vg-pool"
 				c.Provisioning.ProvisioningMaxSize = block.MustSize("50GiB")
 
+				return c
+			},
+		},
+		{
+			name:     "thin",
+			filename: "lvmlogicalvolumeconfig_thin.yaml",
+			cfg: func(t *testing.T) *storagecfg.LVMLogicalVolumeConfigV1Alpha1 {
+				c := storagecfg.NewLVMLogicalVolumeConfigV1Alpha1()
+				c.MetaName = "lv-thin"
+				c.LVType = storageres.LVMLogicalVolumeTypeThin
+				c.LVThinPool = "lv-pool"
+				c.Provisioning.VolumeGroup = "vg-pool"
+				c.Provisioning.ProvisioningMaxSize = block.MustSize("200GiB")
+
 				return c
 			},
 		},
@@ -211,6 +225,58 @@ func TestLVMLogicalVolumeConfigValidate(t *testing.T) {
 				return c
 			},
 		},
+		{
+			name: "valid thin pool",
+			cfg: func(t *testing.T) *storagecfg.LVMLogicalVolumeConfigV1Alpha1 {
+				c := storagecfg.NewLVMLogicalVolumeConfigV1Alpha1()
+				c.MetaName = "lv-pool"
+				c.LVType = storageres.LVMLogicalVolumeTypeThinPool
+				c.Provisioning.VolumeGroup = "vg-pool"
+				c.Provisioning.ProvisioningMaxSize = block.MustSize("90%")
+
+				return c
+			},
+		},
+		{
+			name: "thin without pool",
+			cfg: func(t *testing.T) *storagecfg.LVMLogicalVolumeConfigV1Alpha1 {
+				c := storagecfg.NewLVMLogicalVolumeConfigV1Alpha1()
+				c.MetaName = "lv-thin"
+				c.LVType = storageres.LVMLogicalVolumeTypeThin
+				c.Provisioning.VolumeGroup = "vg-pool"
+				c.Provisioning.ProvisioningMaxSize = block.MustSize("50%")
+
+				return c
+			},
+			expectedErrors: "thinPool is required for thin logical volumes\nprovisioning.maxSize must be an absolute size for thin logical volumes",
+		},
+		{
+			name: "thin pool on linear",
+			cfg: func(t *testing.T) *storagecfg.LVMLogicalVolumeConfigV1Alpha1 {
+				c := storagecfg.NewLVMLogicalVolumeConfigV1Alpha1()
+				c.MetaName = "lv-data"
+				c.LVThinPool = "lv-pool"
+				c.Provisioning.VolumeGroup = "vg-pool"
+				c.Provisioning.ProvisioningMaxSize = block.MustSize("50GiB")
+
+				return c
+			},
+			expectedErrors: "thinPool is only valid for thin, not linear",
+		},
+		{
+			name: "thin in itself",
+			cfg: func(t *testing.T) *storagecfg.LVMLogicalVolumeConfigV1Alpha1 {
+				c := storagecfg.NewLVMLogicalVolumeConfigV1Alpha1()
+				c.MetaName = "lv-thin"
+				c.LVType = storageres.LVMLogicalVolumeTypeThin
+				c.LVThinPool = "lv-thin"
+				c.Provisioning.VolumeGroup = "vg-pool"
+				c.Provisioning.ProvisioningMaxSize = block.MustSize("50GiB")
+
+				return c
+			},
+			expectedErrors: "thinPool must refer to another logical volume",
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
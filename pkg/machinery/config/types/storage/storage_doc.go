@@ -122,6 +122,8 @@ func (LVMLogicalVolumeConfigV1Alpha1) Doc() *encoder.Doc {
 					"raid0",
 					"raid1",
 					"raid10",
+					"thin-pool",
+					"thin",
 				},
 			},
 			{
@@ -138,6 +140,13 @@ func (LVMLogicalVolumeConfigV1Alpha1) Doc() *encoder.Doc {
 				Description: "Number of stripes for `raid0` / `raid10` layouts.\n\nDefaults to all available physical volumes when unset. Must be at\nleast 2. Not valid for `linear` or `raid1`.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of stripes for `raid0` / `raid10` layouts." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "thinPool",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the thin pool logical volume (in the same volume group) which\nbacks a `thin` logical volume.\n\nRequired for `thin`, not valid for other layouts.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the thin pool logical volume (in the same volume group) which" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "provisioning",
 				Type:        "LVMLogicalVolumeProvisioningSpec",
@@ -150,6 +159,8 @@ func (LVMLogicalVolumeConfigV1Alpha1) Doc() *encoder.Doc {
 
 	doc.AddExample("", exampleLVMLogicalVolumeConfigV1Alpha1())
 
+	doc.Fields[5].AddExample("", "lv-pool")
+
 	return doc
 }
 
@@ -183,7 +194,7 @@ func (LVMLogicalVolumeProvisioningSpec) Doc() *encoder.Doc {
 				Name:        "maxSize",
 				Type:        "Size",
 				Note:        "",
-				Description: "The maximum size of the volume.\n\nSize is specified in bytes or in percents of the volume group.\nIt can be expressed in human readable format, e.g. 100MB or 80%.",
+				Description: "The maximum size of the volume.\n\nSize is specified in bytes or in percents of the volume group.\nIt can be expressed in human readable format, e.g. 100MB or 80%.\n\nFor `thin` logical volumes this is the virtual size, which can exceed\nthe size of the thin pool; it must be specified in bytes.\n\nIncreasing the size grows the logical volume online; shrinking is not supported.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The maximum size of the volume." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 		},
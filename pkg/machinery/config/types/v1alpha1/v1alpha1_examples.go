@@ -134,3 +134,32 @@ func machineLoggingExample2() LoggingConfig {
 		},
 	}
 }
+
+func machineLoggingExample3() LoggingConfig {
+	return LoggingConfig{
+		LoggingDestinations: []LoggingDestination{
+			{
+				LoggingEndpoint: &Endpoint{
+					mustParseURL("grpc://otel-collector.example.com:4317"),
+				},
+				LoggingFormat: constants.LoggingFormatOTLP,
+			},
+		},
+	}
+}
+
+func machineLoggingExample4() LoggingConfig {
+	return LoggingConfig{
+		LoggingDestinations: []LoggingDestination{
+			{
+				LoggingEndpoint: &Endpoint{
+					mustParseURL("tls://syslog.example.com:6514"),
+				},
+				LoggingFormat: constants.LoggingFormatSyslog,
+				LoggingTLS: &LoggingTLSConfig{
+					TLSClientIdentity: pemEncodedCertificateExample(),
+				},
+			},
+		},
+	}
+}
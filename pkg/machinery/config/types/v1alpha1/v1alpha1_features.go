@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	"github.com/siderolabs/go-pointer"
 
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 )
 
@@ -70,6 +72,25 @@ func (h *HostDNSConfig) ResolveMemberNames() bool {
 	return pointer.SafeDeref(h.HostDNSResolveMemberNames)
 }
 
+// Forwarders implements config.NetworkHostDNSConfig interface.
+func (h *HostDNSConfig) Forwarders() []config.NetworkHostDNSForwarder {
+	return nil
+}
+
+// QueryLog implements config.NetworkHostDNSConfig interface.
+func (h *HostDNSConfig) QueryLog() bool {
+	return false
+}
+
+// NegativeCache implements config.NetworkHostDNSConfig interface.
+func (h *HostDNSConfig) NegativeCache() config.NetworkHostDNSNegativeCache {
+	return config.NetworkHostDNSNegativeCache{
+		Size:   constants.HostDNSNegativeCacheSize,
+		MinTTL: constants.HostDNSNegativeCacheMinTTL,
+		MaxTTL: constants.HostDNSNegativeCacheMaxTTL,
+	}
+}
+
 // LocalEnabled implements config.ImageCache.
 func (i *ImageCacheConfig) LocalEnabled() bool {
 	return pointer.SafeDeref(i.CacheLocalEnabled)
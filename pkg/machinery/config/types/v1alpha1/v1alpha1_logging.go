@@ -5,11 +5,15 @@
 package v1alpha1
 
 import (
+	"crypto/tls"
+	stdx509 "crypto/x509"
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
@@ -28,22 +32,42 @@ func (lc *LoggingConfig) Validate() error {
 
 		if endpoint == nil {
 			errs = multierror.Append(errs, errors.New("empty logging endpoint"))
-		} else {
-			if endpoint.Host == "" {
-				errs = multierror.Append(errs, errors.New("empty logging endpoint's host"))
-			}
-
-			if endpoint.Scheme != "tcp" && endpoint.Scheme != "udp" {
-				errs = multierror.Append(errs, fmt.Errorf("unexpected logging endpoint scheme %q", endpoint.Scheme))
-			}
+		} else if endpoint.Host == "" {
+			errs = multierror.Append(errs, errors.New("empty logging endpoint's host"))
 		}
 
+		var schemes []string
+
 		switch f := dest.LoggingFormat; f {
 		case constants.LoggingFormatJSONLines:
-			// nothing
+			schemes = []string{"tcp", "udp"}
+		case constants.LoggingFormatOTLP:
+			schemes = []string{"http", "https", "grpc", "grpcs"}
+		case constants.LoggingFormatSyslog:
+			schemes = []string{"tcp", "tls"}
 		default:
 			errs = multierror.Append(errs, fmt.Errorf("unknown logging format %q", f))
 		}
+
+		if endpoint != nil && schemes != nil && !slices.Contains(schemes, endpoint.Scheme) {
+			errs = multierror.Append(errs, fmt.Errorf("unexpected logging endpoint scheme %q", endpoint.Scheme))
+		}
+
+		if dest.LoggingTLS != nil {
+			if endpoint != nil && endpoint.Scheme != "tls" {
+				errs = multierror.Append(errs, fmt.Errorf("tls settings are not supported for the logging endpoint scheme %q", endpoint.Scheme))
+			}
+
+			if len(dest.LoggingTLS.TLSCA) > 0 && !stdx509.NewCertPool().AppendCertsFromPEM(dest.LoggingTLS.TLSCA) {
+				errs = multierror.Append(errs, errors.New("logging tls ca must be a valid PEM-encoded certificate"))
+			}
+
+			if identity := dest.LoggingTLS.TLSClientIdentity; identity != nil {
+				if _, err := tls.X509KeyPair(identity.Crt, identity.Key); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("invalid logging tls client identity: %w", err))
+				}
+			}
+		}
 	}
 
 	return errs.ErrorOrNil()
@@ -68,3 +92,22 @@ func (ld LoggingDestination) ExtraTags() map[string]string {
 func (ld LoggingDestination) Format() string {
 	return ld.LoggingFormat
 }
+
+// TLS implements config.LoggingDestination interface.
+func (ld LoggingDestination) TLS() config.LoggingTLSConfig {
+	if ld.LoggingTLS == nil {
+		return nil
+	}
+
+	return ld.LoggingTLS
+}
+
+// ClientIdentity implements config.LoggingTLSConfig interface.
+func (c *LoggingTLSConfig) ClientIdentity() *x509.PEMEncodedCertificateAndKey {
+	return c.TLSClientIdentity
+}
+
+// CA implements config.LoggingTLSConfig interface.
+func (c *LoggingTLSConfig) CA() []byte {
+	return c.TLSCA
+}
@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestLoggingConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		endpoint string
+		format   string
+		tls      *v1alpha1.LoggingTLSConfig
+
+		expectedError string
+	}{
+		{
+			name:     "json lines",
+			endpoint: "udp://127.0.0.1:12345",
+			format:   constants.LoggingFormatJSONLines,
+		},
+		{
+			name:     "json lines over http",
+			endpoint: "http://127.0.0.1:12345",
+			format:   constants.LoggingFormatJSONLines,
+
+			expectedError: "1 error occurred:\n\t* unexpected logging endpoint scheme \"http\"\n\n",
+		},
+		{
+			name:     "otlp http",
+			endpoint: "https://otel-collector:4318/v1/logs",
+			format:   constants.LoggingFormatOTLP,
+		},
+		{
+			name:     "otlp grpc",
+			endpoint: "grpc://otel-collector:4317",
+			format:   constants.LoggingFormatOTLP,
+		},
+		{
+			name:     "otlp over tcp",
+			endpoint: "tcp://otel-collector:4317",
+			format:   constants.LoggingFormatOTLP,
+
+			expectedError: "1 error occurred:\n\t* unexpected logging endpoint scheme \"tcp\"\n\n",
+		},
+		{
+			name:     "syslog tcp",
+			endpoint: "tcp://syslog:514",
+			format:   constants.LoggingFormatSyslog,
+		},
+		{
+			name:     "syslog tls",
+			endpoint: "tls://syslog:6514",
+			format:   constants.LoggingFormatSyslog,
+			tls:      &v1alpha1.LoggingTLSConfig{},
+		},
+		{
+			name:     "syslog over udp",
+			endpoint: "udp://syslog:514",
+			format:   constants.LoggingFormatSyslog,
+
+			expectedError: "1 error occurred:\n\t* unexpected logging endpoint scheme \"udp\"\n\n",
+		},
+		{
+			name:     "tls settings without tls",
+			endpoint: "tcp://syslog:514",
+			format:   constants.LoggingFormatSyslog,
+			tls: &v1alpha1.LoggingTLSConfig{
+				TLSCA: []byte("not a certificate"),
+			},
+
+			expectedError: "2 errors occurred:\n\t* tls settings are not supported for the logging endpoint scheme \"tcp\"\n\t* logging tls ca must be a valid PEM-encoded certificate\n\n",
+		},
+		{
+			name:     "unknown format",
+			endpoint: "tcp://127.0.0.1:12345",
+			format:   "gelf",
+
+			expectedError: "1 error occurred:\n\t* unknown logging format \"gelf\"\n\n",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := url.Parse(test.endpoint)
+			require.NoError(t, err)
+
+			cfg := &v1alpha1.LoggingConfig{
+				LoggingDestinations: []v1alpha1.LoggingDestination{
+					{
+						LoggingEndpoint: &v1alpha1.Endpoint{URL: u},
+						LoggingFormat:   test.format,
+						LoggingTLS:      test.tls,
+					},
+				},
+			}
+
+			err = cfg.Validate()
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -181,6 +181,14 @@ func (c *Config) Redact(replacement string) {
 				}
 			}
 		}
+
+		if c.MachineConfig.MachineLogging != nil {
+			for _, dest := range c.MachineConfig.MachineLogging.LoggingDestinations {
+				if dest.LoggingTLS != nil && dest.LoggingTLS.TLSClientIdentity != nil {
+					dest.LoggingTLS.TLSClientIdentity.Key = redactBytes(dest.LoggingTLS.TLSClientIdentity.Key)
+				}
+			}
+		}
 	}
 
 	if c.ClusterConfig != nil {
@@ -1156,6 +1164,27 @@ func (k *NetworkKubeSpan) MTU() uint32 {
 	return mtu
 }
 
+// Relay implements the NetworkKubeSpanConfig interface.
+//
+// Relaying is only supported with the KubeSpanConfig document.
+func (k *NetworkKubeSpan) Relay() bool {
+	return false
+}
+
+// KeyRotationInterval implements the NetworkKubeSpanConfig interface.
+//
+// Key rotation is only supported with the KubeSpanConfig document.
+func (k *NetworkKubeSpan) KeyRotationInterval() time.Duration {
+	return 0
+}
+
+// PerPeerPresharedKeys implements the NetworkKubeSpanConfig interface.
+//
+// Per-peer preshared keys are only supported with the KubeSpanConfig document.
+func (k *NetworkKubeSpan) PerPeerPresharedKeys() bool {
+	return false
+}
+
 // Filters implements the NetworkKubeSpanConfig interface.
 func (k *NetworkKubeSpan) Filters() config.NetworkKubeSpanFilters {
 	if k.KubeSpanFilters == nil {
@@ -156,6 +156,22 @@ func TestRedactExtendedSecrets(t *testing.T) {
 					},
 				},
 			},
+			MachineLogging: &v1alpha1.LoggingConfig{
+				LoggingDestinations: []v1alpha1.LoggingDestination{
+					{
+						LoggingFormat: "syslog",
+						LoggingTLS: &v1alpha1.LoggingTLSConfig{
+							TLSClientIdentity: &x509.PEMEncodedCertificateAndKey{
+								Crt: []byte("syslog-cert"),
+								Key: []byte("syslog-key"),
+							},
+						},
+					},
+					{
+						LoggingFormat: "json_lines",
+					},
+				},
+			},
 		},
 	}
 
@@ -183,4 +199,8 @@ func TestRedactExtendedSecrets(t *testing.T) {
 
 	require.Equal(t, replacement, cfg.MachineConfig.MachineSystemDiskEncryption.StatePartition.EncryptionKeys[0].KeyStatic.KeyData)
 	require.Equal(t, replacement, cfg.MachineConfig.MachineSystemDiskEncryption.EphemeralPartition.EncryptionKeys[0].KeyStatic.KeyData)
+
+	logging := cfg.MachineConfig.MachineLogging.LoggingDestinations[0].LoggingTLS
+	require.Equal(t, "syslog-cert", string(logging.TLSClientIdentity.Crt), "client cert is public and must not be redacted")
+	require.Equal(t, replacement, string(logging.TLSClientIdentity.Key))
 }
@@ -214,6 +214,8 @@ type MachineConfig struct {
 	//   examples:
 	//     - value: machineLoggingExample1()
 	//     - value: machineLoggingExample2()
+	//     - value: machineLoggingExample3()
+	//     - value: machineLoggingExample4()
 	MachineLogging *LoggingConfig `yaml:"logging,omitempty"`
 	// docgen:nodoc
 	//
@@ -2485,16 +2487,52 @@ type LoggingConfig struct {
 // LoggingDestination struct configures Talos logging destination.
 type LoggingDestination struct {
 	// description: |
-	//   Where to send logs. Supported protocols are "tcp" and "udp".
+	//   Where to send logs.
+	//
+	//   Supported protocols are "tcp" and "udp" for the `json_lines` format,
+	//   "http" and "https" (OTLP/HTTP), "grpc" and "grpcs" (OTLP/gRPC, "grpcs" uses TLS) for the `otlp` format,
+	//   "tcp" and "tls" for the `syslog` format.
 	LoggingEndpoint *Endpoint `yaml:"endpoint"`
 	// description: |
 	//   Logs format.
+	//
+	//   The `otlp` format sends logs as OpenTelemetry log records in batches, buffering undelivered batches on disk.
+	//   The `syslog` format sends logs as RFC 5424 messages with octet-counted framing (RFC 6587).
 	// values:
 	//   - json_lines
+	//   - otlp
+	//   - syslog
 	LoggingFormat string `yaml:"format"`
 	// description: |
 	//   Extra tags (key-value) pairs to attach to every log message sent.
 	LoggingExtraTags map[string]string `yaml:"extraTags,omitempty"`
+	// description: |
+	//   TLS settings for the "tls" endpoint scheme.
+	LoggingTLS *LoggingTLSConfig `yaml:"tls,omitempty"`
+}
+
+// LoggingTLSConfig configures TLS for the logging destination.
+type LoggingTLSConfig struct {
+	//   description: |
+	//     Client certificate and key to present to the log receiver.
+	//     Client certificate and key should be base64-encoded.
+	//   examples:
+	//     - value: pemEncodedCertificateExample()
+	//   schema:
+	//     type: object
+	//     additionalProperties: false
+	//     properties:
+	//       crt:
+	//         type: string
+	//       key:
+	//         type: string
+	TLSClientIdentity *x509.PEMEncodedCertificateAndKey `yaml:"clientIdentity,omitempty"`
+	//   description: |
+	//     CA certificate to verify the log receiver certificate with, system roots are used if not set.
+	//     Certificate should be base64-encoded.
+	//   schema:
+	//     type: string
+	TLSCA Base64Bytes `yaml:"ca,omitempty"`
 }
 
 // KernelConfig struct configures Talos Linux kernel.
@@ -164,6 +164,8 @@ func (MachineConfig) Doc() *encoder.Doc {
 	doc.Fields[18].AddExample("", machineFeaturesExample())
 	doc.Fields[20].AddExample("", machineLoggingExample1())
 	doc.Fields[20].AddExample("", machineLoggingExample2())
+	doc.Fields[20].AddExample("", machineLoggingExample3())
+	doc.Fields[20].AddExample("", machineLoggingExample4())
 	doc.Fields[22].AddExample("", machineSeccompExample())
 
 	return doc
@@ -494,6 +496,10 @@ func (LoggingConfig) Doc() *encoder.Doc {
 
 	doc.AddExample("", machineLoggingExample2())
 
+	doc.AddExample("", machineLoggingExample3())
+
+	doc.AddExample("", machineLoggingExample4())
+
 	return doc
 }
 
@@ -513,17 +519,19 @@ func (LoggingDestination) Doc() *encoder.Doc {
 				Name:        "endpoint",
 				Type:        "Endpoint",
 				Note:        "",
-				Description: "Where to send logs. Supported protocols are \"tcp\" and \"udp\".",
-				Comments:    [3]string{"" /* encoder.HeadComment */, "Where to send logs. Supported protocols are \"tcp\" and \"udp\"." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Description: "Where to send logs.\n\nSupported protocols are \"tcp\" and \"udp\" for the `json_lines` format,\n\"http\" and \"https\" (OTLP/HTTP), \"grpc\" and \"grpcs\" (OTLP/gRPC, \"grpcs\" uses TLS) for the `otlp` format,\n\"tcp\" and \"tls\" for the `syslog` format.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Where to send logs." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
 				Name:        "format",
 				Type:        "string",
 				Note:        "",
-				Description: "Logs format.",
+				Description: "Logs format.\n\nThe `otlp` format sends logs as OpenTelemetry log records in batches, buffering undelivered batches on disk.\nThe `syslog` format sends logs as RFC 5424 messages with octet-counted framing (RFC 6587).",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Logs format." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"json_lines",
+					"otlp",
+					"syslog",
 				},
 			},
 			{
@@ -533,12 +541,53 @@ func (LoggingDestination) Doc() *encoder.Doc {
 				Description: "Extra tags (key-value) pairs to attach to every log message sent.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra tags (key-value) pairs to attach to every log message sent." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "tls",
+				Type:        "LoggingTLSConfig",
+				Note:        "",
+				Description: "TLS settings for the \"tls\" endpoint scheme.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TLS settings for the \"tls\" endpoint scheme." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	return doc
 }
 
+func (LoggingTLSConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LoggingTLSConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LoggingTLSConfig configures TLS for the logging destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LoggingTLSConfig configures TLS for the logging destination.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "LoggingDestination",
+				FieldName: "tls",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "clientIdentity",
+				Type:        "PEMEncodedCertificateAndKey",
+				Note:        "",
+				Description: "Client certificate and key to present to the log receiver.\nClient certificate and key should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Client certificate and key to present to the log receiver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ca",
+				Type:        "Base64Bytes",
+				Note:        "",
+				Description: "CA certificate to verify the log receiver certificate with, system roots are used if not set.\nCertificate should be base64-encoded.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CA certificate to verify the log receiver certificate with, system roots are used if not set." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", pemEncodedCertificateExample())
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file ./v1alpha1_types_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -556,6 +605,7 @@ func GetFileDoc() *encoder.FileDoc {
 			FeaturesConfig{}.Doc(),
 			LoggingConfig{}.Doc(),
 			LoggingDestination{}.Doc(),
+			LoggingTLSConfig{}.Doc(),
 		},
 	}
 }
@@ -1543,6 +1543,11 @@ func (in *LoggingDestination) DeepCopyInto(out *LoggingDestination) {
 			(*out)[key] = val
 		}
 	}
+	if in.LoggingTLS != nil {
+		in, out := &in.LoggingTLS, &out.LoggingTLS
+		*out = new(LoggingTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1556,6 +1561,31 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingTLSConfig) DeepCopyInto(out *LoggingTLSConfig) {
+	*out = *in
+	if in.TLSClientIdentity != nil {
+		in, out := &in.TLSClientIdentity, &out.TLSClientIdentity
+		*out = (*in).DeepCopy()
+	}
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = make(Base64Bytes, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingTLSConfig.
+func (in *LoggingTLSConfig) DeepCopy() *LoggingTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
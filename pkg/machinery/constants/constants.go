@@ -561,6 +561,15 @@ const (
 	// TrustdUserID is the user ID for trustd.
 	TrustdUserID = 51
 
+	// MetricsPort is the default port for the Prometheus metrics listener.
+	MetricsPort = 50002
+
+	// NTPPort is the default port for the NTP server.
+	NTPPort = 123
+
+	// NTSKEPort is the default port for the NTS key establishment server.
+	NTSKEPort = 4460
+
 	// DefaultContainerdVersion is the default container runtime version.
 	DefaultContainerdVersion = "2.3.4"
 
@@ -615,6 +624,9 @@ const (
 	// APIRuntimeSocketPath is the path to file socket of runtime server for apid.
 	APIRuntimeSocketPath = SystemRunPath + "/apid/runtime.sock"
 
+	// APIMetricsSocketPath is the path to file socket apid serves its metrics on.
+	APIMetricsSocketPath = SystemRunPath + "/apid/metrics/metrics.sock"
+
 	// APIRuntimeSocketLabel is the SELinux label for apid runtime socket file.
 	APIRuntimeSocketLabel = "system_u:object_r:apid_runtime_socket_t:s0"
 
@@ -879,6 +891,9 @@ const (
 	// CgroupExtensions is the cgroup name for system extension processes.
 	CgroupExtensions = CgroupSystem + "/extensions"
 
+	// CgroupTalosContainers is the cgroup name for containers declared via ContainerConfig.
+	CgroupTalosContainers = CgroupSystem + "/containers"
+
 	// CgroupInstaller is the cgroup name for the installer container (install/upgrade).
 	CgroupInstaller = CgroupSystem + "/installer"
 
@@ -1118,6 +1133,13 @@ const (
 	// KubeSpanDefaultPeerKeepalive is the interval at which Wireguard Peer Keepalives should be sent.
 	KubeSpanDefaultPeerKeepalive = 25 * time.Second
 
+	// KubeSpanKeyRotationOverlap is the time the next KubeSpan key is announced before the key rotation,
+	// and the previous key is still announced after the rotation.
+	KubeSpanKeyRotationOverlap = 10 * time.Minute
+
+	// KubeSpanMinimumKeyRotationInterval is the minimum interval of the scheduled KubeSpan key rotation.
+	KubeSpanMinimumKeyRotationInterval = time.Hour
+
 	// KubeSpanDefaultRulePriority is the default priority for KubeSpan IPv4 routing rules.
 	KubeSpanDefaultRulePriority = 32500
 
@@ -1157,6 +1179,12 @@ const (
 	// KubeSpanExcludeAdvertisedNetworksAnnotation is the node annotation used to list the (comma-separated) set of subnets to be excluded from advertisement.
 	KubeSpanExcludeAdvertisedNetworksAnnotation = "networking.talos.dev/kubespan-exclude-advertised-networks"
 
+	// KubeSpanRelayAnnotation is the node annotation used to indicate that the node offers to relay KubeSpan traffic for other peers.
+	KubeSpanRelayAnnotation = "networking.talos.dev/kubespan-relay"
+
+	// KubeSpanAlternatePublicKeyAnnotation is the node annotation used to announce another KubeSpan public key of the node during the key rotation.
+	KubeSpanAlternatePublicKeyAnnotation = "networking.talos.dev/kubespan-alternate-public-key"
+
 	// KubeSpanLinkName is the link name for the KubeSpan Wireguard interface.
 	KubeSpanLinkName = "kubespan"
 
@@ -1181,6 +1209,15 @@ const (
 	// LoggingFormatJSONLines represents "JSON lines" logging format.
 	LoggingFormatJSONLines = "json_lines"
 
+	// LoggingFormatOTLP represents OpenTelemetry (OTLP) logging format.
+	LoggingFormatOTLP = "otlp"
+
+	// LoggingFormatSyslog represents RFC 5424 syslog logging format.
+	LoggingFormatSyslog = "syslog"
+
+	// OTLPLogBufferPath is the directory where OTLP log senders persist undelivered batches.
+	OTLPLogBufferPath = LogMountPoint + "/.otlp"
+
 	// SideroLinkName is the interface name for SideroLink.
 	SideroLinkName = "siderolink"
 
@@ -1381,6 +1418,18 @@ const (
 	// Note: fd54:616c:6f73::204f:5320:444e:53 is "Talos OS DNS".
 	HostDNSAddressV6 = "fd54:616c:6f73::204f:5320:444e:531"
 
+	// HostDNSNegativeCacheSize is the default maximum number of negative responses cached by the host DNS.
+	HostDNSNegativeCacheSize = 10000
+
+	// HostDNSNegativeCacheMinTTL is the default minimum TTL of the negative responses cached by the host DNS.
+	HostDNSNegativeCacheMinTTL = 5 * time.Second
+
+	// HostDNSNegativeCacheMaxTTL is the default maximum TTL of the negative responses cached by the host DNS.
+	HostDNSNegativeCacheMaxTTL = 10 * time.Second
+
+	// HostDNSQueryLogServiceName is the name of the service log holding the host DNS query log.
+	HostDNSQueryLogServiceName = "dns-queries"
+
 	// MetalAgentModeFlagPath is the path to the file indicating if the node is running in Metal Agent mode.
 	MetalAgentModeFlagPath = "/usr/local/etc/is-metal-agent"
 
@@ -1479,6 +1528,21 @@ const (
 	// ImageLabelVerified is the label key for the verified image label.
 	ImageLabelVerified = "talos.dev/verified"
 
+	// ImageLabelVerifiedRule is the label key for the image verification rule a verified image matched.
+	ImageLabelVerifiedRule = "talos.dev/verified-rule"
+
+	// ImageLabelVerifiedSigner is the label key for the signer identity of a verified image.
+	ImageLabelVerifiedSigner = "talos.dev/verified-signer"
+
+	// ImageLabelVerifiedIssuer is the label key for the OIDC issuer of a verified image's signer.
+	ImageLabelVerifiedIssuer = "talos.dev/verified-issuer"
+
+	// ImageLabelVerifiedRekorLogID is the label key for the transparency log a verified image's signature is recorded in.
+	ImageLabelVerifiedRekorLogID = "talos.dev/verified-rekor-log-id"
+
+	// ImageLabelVerifiedRekorLogIndex is the label key for the index of a verified image's transparency log entry.
+	ImageLabelVerifiedRekorLogIndex = "talos.dev/verified-rekor-log-index"
+
 	// TarPaxHeaderSELinux is the name of the PAX header for storing SELinux labels.
 	TarPaxHeaderSELinux = "SCHILY.xattr.security.selinux"
 
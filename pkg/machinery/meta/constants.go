@@ -29,4 +29,8 @@ const (
 	UniqueMachineToken
 	// DiskImageBootloader stores the bootloader used for the disk image, this key is wiped on first boot.
 	DiskImageBootloader
+	// BGPGracefulRestart stores the time when a BGP graceful restart of the node was initiated.
+	BGPGracefulRestart
+	// KubeSpanKeyRotation stores the time when the KubeSpan key rotation was requested.
+	KubeSpanKeyRotation
 )
@@ -1,4 +1,4 @@
-// Code generated by "enumer -type=ARPAllTargets,ARPValidate,AddressFlag,AddressSortAlgorithm,ADSelect,ADLACPActive,AutoHostnameKind,BGPSessionState,BondMode,BondXmitHashPolicy,ClientIdentifier,ConntrackState,DefaultAction,DNSProtocol,Duplex,Family,LACPRate,LinkFlag,LinkType,MatchOperator,NfTablesChainHook,NfTablesChainPriority,NfTablesVerdict,OperationalState,Port,PrimaryReselect,Protocol,RouteFlag,RouteProtocol,RouteType,RoutingRuleAction,RoutingTable,Scope,Status,VLANProtocol,WOLMode -linecomment -text"; DO NOT EDIT.
+// Code generated by "enumer -type=ARPAllTargets,ARPValidate,AddressFlag,AddressSortAlgorithm,ADSelect,ADLACPActive,AutoHostnameKind,BGPSessionState,BondMode,BondXmitHashPolicy,ClientIdentifier,ConntrackState,DefaultAction,DNSProtocol,Duplex,Family,IPVLANMode,LACPRate,LinkFlag,LinkType,MACVLANMode,MatchOperator,NfTablesChainHook,NfTablesChainPriority,NfTablesVerdict,OperationalState,Port,PrimaryReselect,Protocol,RouteFlag,RouteProtocol,RouteType,RoutingRuleAction,RoutingTable,Scope,Status,VLANProtocol,WOLMode -linecomment -text"; DO NOT EDIT.
 
 package nethelpers
 
@@ -1503,6 +1503,92 @@ func (i *Family) UnmarshalText(text []byte) error {
 	return err
 }
 
+const _IPVLANModeName = "l2l3l3s"
+
+var _IPVLANModeIndex = [...]uint8{0, 2, 4, 7}
+
+const _IPVLANModeLowerName = "l2l3l3s"
+
+func (i IPVLANMode) String() string {
+	if i >= IPVLANMode(len(_IPVLANModeIndex)-1) {
+		return fmt.Sprintf("IPVLANMode(%d)", i)
+	}
+	return _IPVLANModeName[_IPVLANModeIndex[i]:_IPVLANModeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _IPVLANModeNoOp() {
+	var x [1]struct{}
+	_ = x[IPVLANModeL2-(0)]
+	_ = x[IPVLANModeL3-(1)]
+	_ = x[IPVLANModeL3S-(2)]
+}
+
+var _IPVLANModeValues = []IPVLANMode{IPVLANModeL2, IPVLANModeL3, IPVLANModeL3S}
+
+var _IPVLANModeNameToValueMap = map[string]IPVLANMode{
+	_IPVLANModeName[0:2]:      IPVLANModeL2,
+	_IPVLANModeLowerName[0:2]: IPVLANModeL2,
+	_IPVLANModeName[2:4]:      IPVLANModeL3,
+	_IPVLANModeLowerName[2:4]: IPVLANModeL3,
+	_IPVLANModeName[4:7]:      IPVLANModeL3S,
+	_IPVLANModeLowerName[4:7]: IPVLANModeL3S,
+}
+
+var _IPVLANModeNames = []string{
+	_IPVLANModeName[0:2],
+	_IPVLANModeName[2:4],
+	_IPVLANModeName[4:7],
+}
+
+// IPVLANModeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func IPVLANModeString(s string) (IPVLANMode, error) {
+	if val, ok := _IPVLANModeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _IPVLANModeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to IPVLANMode values", s)
+}
+
+// IPVLANModeValues returns all values of the enum
+func IPVLANModeValues() []IPVLANMode {
+	return _IPVLANModeValues
+}
+
+// IPVLANModeStrings returns a slice of all String values of the enum
+func IPVLANModeStrings() []string {
+	strs := make([]string, len(_IPVLANModeNames))
+	copy(strs, _IPVLANModeNames)
+	return strs
+}
+
+// IsAIPVLANMode returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i IPVLANMode) IsAIPVLANMode() bool {
+	for _, v := range _IPVLANModeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for IPVLANMode
+func (i IPVLANMode) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for IPVLANMode
+func (i *IPVLANMode) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = IPVLANModeString(string(text))
+	return err
+}
+
 const _LACPRateName = "slowfast"
 
 var _LACPRateIndex = [...]uint8{0, 4, 8}
@@ -2200,6 +2286,112 @@ func (i *LinkType) UnmarshalText(text []byte) error {
 	return err
 }
 
+const (
+	_MACVLANModeName_0      = "privatevepa"
+	_MACVLANModeLowerName_0 = "privatevepa"
+	_MACVLANModeName_1      = "bridge"
+	_MACVLANModeLowerName_1 = "bridge"
+	_MACVLANModeName_2      = "passthru"
+	_MACVLANModeLowerName_2 = "passthru"
+)
+
+var (
+	_MACVLANModeIndex_0 = [...]uint8{0, 7, 11}
+	_MACVLANModeIndex_1 = [...]uint8{0, 6}
+	_MACVLANModeIndex_2 = [...]uint8{0, 8}
+)
+
+func (i MACVLANMode) String() string {
+	switch {
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _MACVLANModeName_0[_MACVLANModeIndex_0[i]:_MACVLANModeIndex_0[i+1]]
+	case i == 4:
+		return _MACVLANModeName_1
+	case i == 8:
+		return _MACVLANModeName_2
+	default:
+		return fmt.Sprintf("MACVLANMode(%d)", i)
+	}
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _MACVLANModeNoOp() {
+	var x [1]struct{}
+	_ = x[MACVLANModePrivate-(1)]
+	_ = x[MACVLANModeVEPA-(2)]
+	_ = x[MACVLANModeBridge-(4)]
+	_ = x[MACVLANModePassthru-(8)]
+}
+
+var _MACVLANModeValues = []MACVLANMode{MACVLANModePrivate, MACVLANModeVEPA, MACVLANModeBridge, MACVLANModePassthru}
+
+var _MACVLANModeNameToValueMap = map[string]MACVLANMode{
+	_MACVLANModeName_0[0:7]:       MACVLANModePrivate,
+	_MACVLANModeLowerName_0[0:7]:  MACVLANModePrivate,
+	_MACVLANModeName_0[7:11]:      MACVLANModeVEPA,
+	_MACVLANModeLowerName_0[7:11]: MACVLANModeVEPA,
+	_MACVLANModeName_1[0:6]:       MACVLANModeBridge,
+	_MACVLANModeLowerName_1[0:6]:  MACVLANModeBridge,
+	_MACVLANModeName_2[0:8]:       MACVLANModePassthru,
+	_MACVLANModeLowerName_2[0:8]:  MACVLANModePassthru,
+}
+
+var _MACVLANModeNames = []string{
+	_MACVLANModeName_0[0:7],
+	_MACVLANModeName_0[7:11],
+	_MACVLANModeName_1[0:6],
+	_MACVLANModeName_2[0:8],
+}
+
+// MACVLANModeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func MACVLANModeString(s string) (MACVLANMode, error) {
+	if val, ok := _MACVLANModeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _MACVLANModeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to MACVLANMode values", s)
+}
+
+// MACVLANModeValues returns all values of the enum
+func MACVLANModeValues() []MACVLANMode {
+	return _MACVLANModeValues
+}
+
+// MACVLANModeStrings returns a slice of all String values of the enum
+func MACVLANModeStrings() []string {
+	strs := make([]string, len(_MACVLANModeNames))
+	copy(strs, _MACVLANModeNames)
+	return strs
+}
+
+// IsAMACVLANMode returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i MACVLANMode) IsAMACVLANMode() bool {
+	for _, v := range _MACVLANModeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for MACVLANMode
+func (i MACVLANMode) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for MACVLANMode
+func (i *MACVLANMode) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = MACVLANModeString(string(text))
+	return err
+}
+
 const _MatchOperatorName = "==!="
 
 var _MatchOperatorIndex = [...]uint8{0, 2, 4}
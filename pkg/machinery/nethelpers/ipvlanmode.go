@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// IPVLANMode is an IPVLAN link mode.
+type IPVLANMode uint16
+
+// IPVLANMode constants.
+//
+// See linux/if_link.h.
+//
+//structprotogen:gen_enum
+const (
+	IPVLANModeL2  IPVLANMode = iota // l2
+	IPVLANModeL3                    // l3
+	IPVLANModeL3S                   // l3s
+)
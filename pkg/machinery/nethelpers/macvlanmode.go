@@ -0,0 +1,20 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// MACVLANMode is a MACVLAN link mode.
+type MACVLANMode uint32
+
+// MACVLANMode constants.
+//
+// See linux/if_link.h.
+//
+//structprotogen:gen_enum
+const (
+	MACVLANModePrivate  MACVLANMode = 1 // private
+	MACVLANModeVEPA     MACVLANMode = 2 // vepa
+	MACVLANModeBridge   MACVLANMode = 4 // bridge
+	MACVLANModePassthru MACVLANMode = 8 // passthru
+)
@@ -0,0 +1,18 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package block
+
+// NetworkTargetType describes the transport used to attach an external volume.
+type NetworkTargetType int
+
+// Network target types.
+//
+//structprotogen:gen_enum
+const (
+	NetworkTargetTypeNone    NetworkTargetType = iota // none
+	NetworkTargetTypeNFS                              // nfs
+	NetworkTargetTypeISCSI                            // iscsi
+	NetworkTargetTypeNVMETCP                          // nvme-tcp
+)
@@ -91,8 +91,25 @@ type ProvisioningSpec struct {
 //
 //gotagsrewrite:gen
 type DiskSelector struct {
-	Match    cel.Expression `yaml:"match,omitempty" protobuf:"1"`
-	External string         `yaml:"external,omitempty" protobuf:"2"`
+	Match         cel.Expression    `yaml:"match,omitempty" protobuf:"1"`
+	External      string            `yaml:"external,omitempty" protobuf:"2"`
+	NetworkTarget NetworkTargetSpec `yaml:"networkTarget,omitempty" protobuf:"3"`
+}
+
+// NetworkTargetSpec describes a network target which should be attached before the external volume is mounted.
+//
+//gotagsrewrite:gen
+type NetworkTargetSpec struct {
+	// Type of the network target.
+	Type NetworkTargetType `yaml:"type" protobuf:"1"`
+	// Address of the target: NFS server, iSCSI portal or NVMe transport address.
+	Address string `yaml:"address" protobuf:"2"`
+	// Port of the target, zero for the transport default.
+	Port uint16 `yaml:"port,omitempty" protobuf:"3"`
+	// Name of the target: iSCSI target IQN or NVMe subsystem NQN.
+	Name string `yaml:"name,omitempty" protobuf:"4"`
+	// Unit is the iSCSI LUN or the NVMe namespace ID of the volume.
+	Unit uint32 `yaml:"unit,omitempty" protobuf:"5"`
 }
 
 // PartitionSpec is the spec for volume partitioning.
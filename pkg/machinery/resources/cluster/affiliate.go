@@ -37,6 +37,8 @@ type KubeSpanAffiliateSpec struct {
 	AdditionalAddresses       []netip.Prefix   `yaml:"additionalAddresses" protobuf:"3"`
 	Endpoints                 []netip.AddrPort `yaml:"endpoints" protobuf:"4"`
 	ExcludeAdvertisedNetworks []netip.Prefix   `yaml:"excludeAdvertisedNetworks" protobuf:"5"`
+	Relay                     bool             `yaml:"relay,omitempty" protobuf:"6"`
+	AlternatePublicKey        string           `yaml:"alternatePublicKey,omitempty" protobuf:"7"`
 }
 
 // NewAffiliate initializes the Affiliate resource.
@@ -130,6 +132,14 @@ func (spec *AffiliateSpec) Merge(other *AffiliateSpec) {
 		spec.KubeSpan.Address = other.KubeSpan.Address
 	}
 
+	if other.KubeSpan.Relay {
+		spec.KubeSpan.Relay = true
+	}
+
+	if other.KubeSpan.AlternatePublicKey != "" {
+		spec.KubeSpan.AlternatePublicKey = other.KubeSpan.AlternatePublicKey
+	}
+
 	for _, addr := range other.KubeSpan.AdditionalAddresses {
 		found := slices.Contains(spec.KubeSpan.AdditionalAddresses, addr)
 
@@ -7,6 +7,7 @@ package containers
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"os"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	timeres "github.com/siderolabs/talos/pkg/machinery/resources/time"
@@ -57,15 +59,15 @@ type ContainerSpecSpec struct {
 	Network   ContainerNetworkSpec   `yaml:"network,omitempty" protobuf:"9"`
 	Resources ContainerResourcesSpec `yaml:"resources,omitempty" protobuf:"10"`
 	DependsOn ContainerDependsOnSpec `yaml:"dependsOn,omitempty" protobuf:"11"`
+
+	Restart     ContainerRestartSpec     `yaml:"restart" protobuf:"12"`
+	HealthCheck ContainerHealthCheckSpec `yaml:"healthCheck,omitempty" protobuf:"13"`
 }
 
-// Ready reports the container's unmet dependencies (image, mounts, dependsOn gates),
+// Ready reports the container's unmet dependencies (image, mounts, network, dependsOn gates),
 // and how soon to recheck them.
 //
 // containerID is the owning ContainerSpec resource's ID: the spec itself doesn't carry it.
-//
-// dependsOn.containers is not checked here: it would need the aggregated ContainerStatus, which
-// only arrives with StatusController.
 func (spec ContainerSpecSpec) Ready(ctx context.Context, r controller.Reader, containerID string) ([]string, optional.Optional[time.Duration], error) {
 	var waitingFor []string
 
@@ -78,10 +80,22 @@ func (spec ContainerSpecSpec) Ready(ctx context.Context, r controller.Reader, co
 		waitingFor = append(waitingFor, "image")
 	}
 
-	if _, mountsReady := ResolveInstanceMounts(spec.Mounts); !mountsReady {
-		waitingFor = append(waitingFor, "mounts")
+	_, unmounted, err := ResolveContainerMounts(ctx, r, containerID, spec.Mounts)
+	if err != nil {
+		return nil, optional.None[time.Duration](), err
+	}
+
+	for _, volumeID := range unmounted {
+		waitingFor = append(waitingFor, "volume: "+volumeID)
 	}
 
+	unmetNetwork, err := spec.Network.Ready(ctx, r, containerID)
+	if err != nil {
+		return nil, optional.None[time.Duration](), err
+	}
+
+	waitingFor = append(waitingFor, unmetNetwork...)
+
 	unmet, wakeUpAfter, err := spec.DependsOn.Ready(ctx, r)
 	if err != nil {
 		return nil, optional.None[time.Duration](), err
@@ -137,6 +151,55 @@ type ContainerSecuritySpec struct {
 type ContainerNetworkSpec struct {
 	// HostNetwork shares the host network namespace instead of creating an empty one.
 	HostNetwork bool `yaml:"hostNetwork,omitempty" protobuf:"1"`
+
+	// Kind is "bridge" or "macvlan" for a container attached to a host link, empty otherwise.
+	Kind string `yaml:"kind,omitempty" protobuf:"2"`
+	// Link is the bridge the container is attached to, or the parent link of its macvlan.
+	Link string `yaml:"link,omitempty" protobuf:"3"`
+	// Subnet the container address is allocated from.
+	Subnet netip.Prefix `yaml:"subnet,omitempty" protobuf:"4"`
+	// Gateway is the container's default gateway; the zero value means no default route.
+	Gateway netip.Addr `yaml:"gateway,omitempty" protobuf:"5"`
+
+	Ports []ContainerPortSpec `yaml:"ports,omitempty" protobuf:"6"`
+}
+
+// Network kinds.
+const (
+	NetworkKindBridge  = "bridge"
+	NetworkKindMacvlan = "macvlan"
+)
+
+// ContainerPortSpec is a container port published on the host addresses.
+//
+//gotagsrewrite:gen
+type ContainerPortSpec struct {
+	Protocol      nethelpers.Protocol `yaml:"protocol" protobuf:"1"`
+	HostPort      uint16              `yaml:"hostPort" protobuf:"2"`
+	ContainerPort uint16              `yaml:"containerPort" protobuf:"3"`
+}
+
+// Ready reports the unmet network conditions of a container attached to a host link: an address
+// allocated on its subnet, and the link being up.
+func (network ContainerNetworkSpec) Ready(ctx context.Context, r controller.Reader, containerID string) ([]string, error) {
+	if network.Kind == "" {
+		return nil, nil
+	}
+
+	status, err := GetNetworkStatus(ctx, r, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := status.AddressOn(network); !ok {
+		return []string{"address"}, nil
+	}
+
+	if !status.LinkUp {
+		return []string{"link: " + network.Link}, nil
+	}
+
+	return nil, nil
 }
 
 // ContainerResourcesSpec is the resolved cgroup configuration, in bytes and millicores.
@@ -187,6 +250,14 @@ func (dependsOn ContainerDependsOnSpec) Ready(
 		waitingFor = append(waitingFor, "time")
 	}
 
+	// dependsOn.containers
+	unmetContainers, err := dependsOn.ContainersReady(ctx, r)
+	if err != nil {
+		return nil, optional.None[time.Duration](), fmt.Errorf("failed to check containers ready: %w", err)
+	}
+
+	waitingFor = append(waitingFor, unmetContainers...)
+
 	// dependsOn.paths
 	for _, path := range dependsOn.Paths {
 		if _, err := os.Stat(path); err != nil {
@@ -226,6 +297,26 @@ func (dependsOn ContainerDependsOnSpec) TimeReady(ctx context.Context, r control
 	return status.TypedSpec().Synced, nil
 }
 
+// ContainersReady reports the declared dependsOn.containers peers that are not yet healthy.
+//
+// A peer whose ContainerStatus doesn't exist yet counts as not healthy.
+func (dependsOn ContainerDependsOnSpec) ContainersReady(ctx context.Context, r controller.Reader) ([]string, error) {
+	var waitingFor []string
+
+	for _, name := range dependsOn.Containers {
+		status, err := safe.ReaderGetByID[*ContainerStatus](ctx, r, name)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, fmt.Errorf("failed to get container status %q: %w", name, err)
+		}
+
+		if status == nil || !status.TypedSpec().Healthy {
+			waitingFor = append(waitingFor, "container: "+name)
+		}
+	}
+
+	return waitingFor, nil
+}
+
 // NetworksReady reports the declared dependsOn.networks conditions that are not yet satisfied.
 //
 // A status resource that doesn't exist yet counts every declared condition as not satisfied.
@@ -277,6 +368,96 @@ func (ContainerDependsOnSpec) NetworkConditionMet(status *network.Status, condit
 	}
 }
 
+// ContainerRestartSpec is the resolved restart policy.
+//
+//gotagsrewrite:gen
+type ContainerRestartSpec struct {
+	// Mode is one of "always", "on-failure" or "never".
+	Mode string `yaml:"mode" protobuf:"1"`
+	// MaxRetries caps the number of restarts; zero means unlimited.
+	MaxRetries uint64 `yaml:"maxRetries,omitempty" protobuf:"2"`
+	// InitialDelay is the delay before the first restart.
+	InitialDelay time.Duration `yaml:"initialDelay" protobuf:"3"`
+	// MaxDelay caps the delay, which doubles with each restart up to it.
+	MaxDelay time.Duration `yaml:"maxDelay" protobuf:"4"`
+}
+
+// Restart modes.
+const (
+	RestartModeAlways    = "always"
+	RestartModeOnFailure = "on-failure"
+	RestartModeNever     = "never"
+)
+
+// ShouldRestart reports whether an instance with the given status is to be replaced by the next
+// generation.
+//
+// Only a terminated instance is ever restarted. An empty mode is treated as "always".
+func (policy ContainerRestartSpec) ShouldRestart(status ContainerInstanceStatusSpec) bool {
+	if !status.Terminated() {
+		return false
+	}
+
+	if policy.MaxRetries > 0 && status.Restarts >= policy.MaxRetries {
+		return false
+	}
+
+	switch policy.Mode {
+	case RestartModeNever:
+		return false
+	case RestartModeOnFailure:
+		return status.Phase == ContainerInstancePhaseFailed || status.ExitCode != 0
+	default:
+		return true
+	}
+}
+
+// Delay returns how long after terminating an instance is restarted, given the number of earlier
+// restarts of its container.
+//
+// The delay starts at InitialDelay and doubles with each restart, up to MaxDelay. A zero MaxDelay
+// keeps it at InitialDelay.
+func (policy ContainerRestartSpec) Delay(restarts uint64) time.Duration {
+	delay := policy.InitialDelay
+
+	for range restarts {
+		if delay == 0 || delay >= policy.MaxDelay {
+			break
+		}
+
+		delay *= 2
+	}
+
+	return min(delay, max(policy.MaxDelay, policy.InitialDelay))
+}
+
+// ContainerHealthCheckSpec is the resolved health check.
+//
+// Kind is empty when the container declares no health check.
+//
+//gotagsrewrite:gen
+type ContainerHealthCheckSpec struct {
+	// Kind is one of "exec", "http" or "tcp".
+	Kind string `yaml:"kind,omitempty" protobuf:"1"`
+	// Command is run inside the container by an exec check.
+	Command []string `yaml:"command,omitempty" protobuf:"2"`
+	// Port is the host loopback port an http or tcp check connects to.
+	Port uint16 `yaml:"port,omitempty" protobuf:"3"`
+	// Path is the request path of an http check.
+	Path string `yaml:"path,omitempty" protobuf:"4"`
+
+	InitialDelay time.Duration `yaml:"initialDelay,omitempty" protobuf:"5"`
+	Period       time.Duration `yaml:"period,omitempty" protobuf:"6"`
+	Timeout      time.Duration `yaml:"timeout,omitempty" protobuf:"7"`
+}
+
+// Health check kinds.
+const (
+	HealthCheckKindExec = "exec"
+	HealthCheckKindHTTP = "http"
+	HealthCheckKindTCP  = "tcp"
+)
+
 // ContainerRunAsSpec is the resolved uid/gid override.
 //
 // Nil means use the image's own USER for that half.
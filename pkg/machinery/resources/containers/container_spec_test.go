@@ -5,9 +5,11 @@
 package containers_test
 
 import (
+	"net/netip"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
@@ -206,6 +208,14 @@ func TestReady(t *testing.T) {
 	timeStatus.TypedSpec().Synced = true
 	require.NoError(t, st.Create(ctx, timeStatus))
 
+	healthyPeer := containers.NewContainerStatus(containers.NamespaceName, "registry")
+	healthyPeer.TypedSpec().Healthy = true
+	require.NoError(t, st.Create(ctx, healthyPeer))
+
+	unhealthyPeer := containers.NewContainerStatus(containers.NamespaceName, "database")
+	unhealthyPeer.TypedSpec().Phase = containers.ContainerPhaseRunning
+	require.NoError(t, st.Create(ctx, unhealthyPeer))
+
 	existingPath := filepath.Join(t.TempDir(), "exists")
 	require.NoError(t, os.WriteFile(existingPath, nil, 0o644))
 
@@ -240,6 +250,19 @@ func TestReady(t *testing.T) {
 				Time: true,
 			},
 		},
+		{
+			name: "container peer healthy",
+			dependsOn: containers.ContainerDependsOnSpec{
+				Containers: []string{"registry"},
+			},
+		},
+		{
+			name: "container peers running but unhealthy, and missing",
+			dependsOn: containers.ContainerDependsOnSpec{
+				Containers: []string{"registry", "database", "cache"},
+			},
+			wantWaitingFor: []string{"container: database", "container: cache"},
+		},
 		{
 			name: "path exists",
 			dependsOn: containers.ContainerDependsOnSpec{
@@ -278,14 +301,201 @@ func TestReadyMissingStatuses(t *testing.T) {
 	st := state.WrapCore(namespaced.NewState(inmem.Build))
 
 	dependsOn := containers.ContainerDependsOnSpec{
-		Networks: []string{"addresses"},
-		Time:     true,
+		Networks:   []string{"addresses"},
+		Time:       true,
+		Containers: []string{"registry"},
 	}
 
 	waitingFor, wakeUpAfter, err := dependsOn.Ready(ctx, st)
 	require.NoError(t, err)
-	assert.Equal(t, []string{"network: addresses", "time"}, waitingFor)
+	assert.Equal(t, []string{"network: addresses", "time", "container: registry"}, waitingFor)
 
 	_, wakeUpAfterSet := wakeUpAfter.Get()
 	assert.False(t, wakeUpAfterSet)
 }
+
+// TestSpecReadyWaitsForVolumes covers the userVolume gate: each volume not mounted for the container
+// is reported on its own.
+func TestSpecReadyWaitsForVolumes(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	imageStatus := containers.NewContainerImageStatus(containers.NamespaceName, "nginx")
+	imageStatus.TypedSpec().Phase = containers.ContainerImagePhaseReady
+	imageStatus.TypedSpec().Image = "docker.io/library/nginx:latest"
+	imageStatus.TypedSpec().Digest = "sha256:abc"
+	require.NoError(t, st.Create(ctx, imageStatus))
+
+	spec := containers.ContainerSpecSpec{
+		Image: containers.ContainerImageSpec{Ref: "docker.io/library/nginx:latest"},
+		Mounts: []containers.ContainerMountSpec{
+			{Kind: containers.MountKindUserVolume, VolumeID: "u-data", Destination: "/data"},
+			{Kind: containers.MountKindUserVolume, VolumeID: "u-logs", Destination: "/logs"},
+		},
+	}
+
+	waitingFor, _, err := spec.Ready(ctx, st, "nginx")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"volume: u-data", "volume: u-logs"}, waitingFor)
+
+	mountStatus := containers.NewContainerMountStatus(containers.NamespaceName, "nginx")
+	mountStatus.TypedSpec().Volumes = []containers.ContainerVolumeMountSpec{{VolumeID: "u-data", Target: "/var/mnt/data"}}
+	require.NoError(t, st.Create(ctx, mountStatus))
+
+	waitingFor, _, err = spec.Ready(ctx, st, "nginx")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"volume: u-logs"}, waitingFor)
+}
+
+// TestNetworkReady covers the gate of a container attached to a host link: it waits for an address
+// allocated on its own subnet, then for the link.
+func TestNetworkReady(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	spec := containers.ContainerNetworkSpec{
+		Kind:   containers.NetworkKindBridge,
+		Link:   "br-containers",
+		Subnet: netip.MustParsePrefix("10.88.0.0/24"),
+	}
+
+	waitingFor, err := spec.Ready(ctx, st, "nginx")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"address"}, waitingFor)
+
+	// An allocation from the previous subnet is not believed.
+	networkStatus := containers.NewContainerNetworkStatus(containers.NamespaceName, "nginx")
+	networkStatus.TypedSpec().Address = netip.MustParsePrefix("10.99.0.2/24")
+	networkStatus.TypedSpec().Link = "br-containers"
+	require.NoError(t, st.Create(ctx, networkStatus))
+
+	waitingFor, err = spec.Ready(ctx, st, "nginx")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"address"}, waitingFor)
+
+	networkStatus.TypedSpec().Address = netip.MustParsePrefix("10.88.0.2/24")
+	require.NoError(t, st.Update(ctx, networkStatus))
+
+	waitingFor, err = spec.Ready(ctx, st, "nginx")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"link: br-containers"}, waitingFor)
+
+	networkStatus.TypedSpec().LinkUp = true
+	require.NoError(t, st.Update(ctx, networkStatus))
+
+	waitingFor, err = spec.Ready(ctx, st, "nginx")
+	require.NoError(t, err)
+	assert.Empty(t, waitingFor)
+
+	// Neither host nor isolated networking waits for anything.
+	waitingFor, err = containers.ContainerNetworkSpec{HostNetwork: true}.Ready(ctx, st, "other")
+	require.NoError(t, err)
+	assert.Empty(t, waitingFor)
+}
+
+func TestRestartShouldRestart(t *testing.T) {
+	t.Parallel()
+
+	exited := func(exitCode uint32, restarts uint64) containers.ContainerInstanceStatusSpec {
+		return containers.ContainerInstanceStatusSpec{
+			Phase:    containers.ContainerInstancePhaseExited,
+			ExitCode: exitCode,
+			Restarts: restarts,
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		policy containers.ContainerRestartSpec
+		status containers.ContainerInstanceStatusSpec
+		want   bool
+	}{
+		{
+			name:   "running is never restarted",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeAlways},
+			status: containers.ContainerInstanceStatusSpec{Phase: containers.ContainerInstancePhaseRunning},
+		},
+		{
+			name:   "always restarts a clean exit",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeAlways},
+			status: exited(0, 0),
+			want:   true,
+		},
+		{
+			name:   "empty mode behaves as always",
+			status: exited(0, 0),
+			want:   true,
+		},
+		{
+			name:   "on-failure skips a clean exit",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeOnFailure},
+			status: exited(0, 0),
+		},
+		{
+			name:   "on-failure restarts a non-zero exit",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeOnFailure},
+			status: exited(1, 0),
+			want:   true,
+		},
+		{
+			name:   "on-failure restarts a task which failed to start",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeOnFailure},
+			status: containers.ContainerInstanceStatusSpec{Phase: containers.ContainerInstancePhaseFailed},
+			want:   true,
+		},
+		{
+			name:   "never",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeNever},
+			status: exited(1, 0),
+		},
+		{
+			name:   "below max retries",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeAlways, MaxRetries: 3},
+			status: exited(1, 2),
+			want:   true,
+		},
+		{
+			name:   "max retries reached",
+			policy: containers.ContainerRestartSpec{Mode: containers.RestartModeAlways, MaxRetries: 3},
+			status: exited(1, 3),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.policy.ShouldRestart(tt.status))
+		})
+	}
+}
+
+func TestRestartDelay(t *testing.T) {
+	t.Parallel()
+
+	policy := containers.ContainerRestartSpec{
+		InitialDelay: 5 * time.Second,
+		MaxDelay:     time.Minute,
+	}
+
+	for restarts, want := range []time.Duration{
+		5 * time.Second,
+		10 * time.Second,
+		20 * time.Second,
+		40 * time.Second,
+		time.Minute,
+		time.Minute,
+	} {
+		assert.Equal(t, want, policy.Delay(uint64(restarts)), "restarts: %d", restarts)
+	}
+
+	// A huge restart count neither overflows nor loops for long.
+	assert.Equal(t, time.Minute, policy.Delay(1<<40))
+
+	// Without a maximum the delay does not grow.
+	assert.Equal(t, 5*time.Second, containers.ContainerRestartSpec{InitialDelay: 5 * time.Second}.Delay(3))
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ContainerStatusType is type of ContainerStatus resource.
+const ContainerStatusType = resource.Type("ContainerStatuses.containers.talos.dev")
+
+// ContainerStatus resource is the aggregated state of a single container.
+//
+// The ID matches the ContainerSpec it describes. Unlike ContainerInstanceStatus, it outlives the
+// instances, so it is the one place to look at a container across restarts.
+type ContainerStatus = typed.Resource[ContainerStatusSpec, ContainerStatusExtension]
+
+// ContainerStatusSpec is the spec for ContainerStatus.
+//
+//gotagsrewrite:gen
+type ContainerStatusSpec struct {
+	// Image is the OCI reference in canonical form, as declared.
+	Image string `yaml:"image" protobuf:"1"`
+
+	Phase ContainerPhase `yaml:"phase" protobuf:"2"`
+	// Healthy is set while the container is running and passing its health check, if it declares
+	// one. It is what dependsOn.containers waits for.
+	Healthy bool `yaml:"healthy" protobuf:"3"`
+	// HealthMessage is the last health check message.
+	HealthMessage string `yaml:"healthMessage,omitempty" protobuf:"4"`
+
+	// Generation is the sequence number of the current instance.
+	Generation uint64 `yaml:"generation" protobuf:"5"`
+	PID        uint32 `yaml:"pid,omitempty" protobuf:"6"`
+	ExitCode   uint32 `yaml:"exitCode,omitempty" protobuf:"7"`
+	Restarts   uint64 `yaml:"restarts" protobuf:"8"`
+
+	// WaitingFor lists the unmet dependencies while the container is waiting to start.
+	WaitingFor []string `yaml:"waitingFor,omitempty" protobuf:"9"`
+	// LastError is the last failure to pull the image, or to create, start or track the task.
+	LastError string `yaml:"lastError,omitempty" protobuf:"10"`
+}
+
+// NewContainerStatus initializes a ContainerStatus resource.
+func NewContainerStatus(namespace resource.Namespace, id resource.ID) *ContainerStatus {
+	return typed.NewResource[ContainerStatusSpec, ContainerStatusExtension](
+		resource.NewMetadata(namespace, ContainerStatusType, id, resource.VersionUndefined),
+		ContainerStatusSpec{},
+	)
+}
+
+// ContainerStatusExtension is auxiliary resource data for ContainerStatus.
+type ContainerStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ContainerStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ContainerStatusType,
+		Aliases:          []resource.Type{"containerstatus", "containerstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: `{.phase}`,
+			},
+			{
+				Name:     "Healthy",
+				JSONPath: `{.healthy}`,
+			},
+			{
+				Name:     "Restarts",
+				JSONPath: `{.restarts}`,
+			},
+			{
+				Name:     "Image",
+				JSONPath: `{.image}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic(ContainerStatusType, &ContainerStatus{}); err != nil {
+		panic(err)
+	}
+}
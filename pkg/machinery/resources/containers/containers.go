@@ -9,17 +9,26 @@
 //
 //	ContainerConfig (machine config) -> ContainerSpec -> ContainerInstanceSpec -> ContainerInstanceStatus
 //
-// with ContainerImageStatus and ContainerMountStatus gating the step from spec to instance, and
-// ContainerStatus as the aggregated user-facing surface. This package currently carries
-// ContainerSpec, ContainerImageStatus and ContainerInstanceSpec; ContainerInstanceStatus,
-// ContainerMountStatus and ContainerStatus arrive in follow-up controllers.
+// with ContainerImageStatus, ContainerMountStatus and ContainerNetworkStatus gating the step from
+// spec to instance, and ContainerStatus as the aggregated user-facing surface.
 package containers
 
 import "github.com/cosi-project/runtime/pkg/resource"
 
-//go:generate go tool github.com/siderolabs/deep-copy -type ContainerSpecSpec -type ContainerImageStatusSpec -type ContainerInstanceSpecSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type ContainerSpecSpec -type ContainerImageStatusSpec -type ContainerInstanceSpecSpec -type ContainerInstanceStatusSpec -type ContainerStatusSpec -type ContainerMountStatusSpec -type ContainerNetworkStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
-//go:generate go tool github.com/dmarkham/enumer -type=ContainerImagePhase -linecomment -text
+//go:generate go tool github.com/dmarkham/enumer -type=ContainerImagePhase,ContainerInstancePhase,ContainerPhase -linecomment -text
 
 // NamespaceName contains resources for Talos-managed containers.
 const NamespaceName resource.Namespace = "containers"
+
+// LogIDPrefix is prepended to the container name to build the ID of the container log.
+//
+// The prefix keeps the container logs apart from the Talos service logs, so that e.g. a container
+// named `etcd` can't write to the etcd log.
+const LogIDPrefix = "container-"
+
+// LogID returns the ID of the log the container output is written to (`talosctl logs <id>`).
+func LogID(containerID string) string {
+	return LogIDPrefix + containerID
+}
@@ -5,7 +5,9 @@
 package containers_test
 
 import (
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -17,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
 )
 
@@ -30,6 +33,10 @@ func TestRegisterResource(t *testing.T) {
 		&containers.ContainerSpec{},
 		&containers.ContainerImageStatus{},
 		&containers.ContainerInstanceSpec{},
+		&containers.ContainerInstanceStatus{},
+		&containers.ContainerStatus{},
+		&containers.ContainerMountStatus{},
+		&containers.ContainerNetworkStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, res))
 	}
@@ -78,6 +85,20 @@ func TestProtobufRoundTrip(t *testing.T) {
 			Time:       true,
 			Containers: []string{"other"},
 		},
+		Restart: containers.ContainerRestartSpec{
+			Mode:         containers.RestartModeOnFailure,
+			MaxRetries:   10,
+			InitialDelay: 5 * time.Second,
+			MaxDelay:     5 * time.Minute,
+		},
+		HealthCheck: containers.ContainerHealthCheckSpec{
+			Kind:         containers.HealthCheckKindHTTP,
+			Port:         8080,
+			Path:         "/healthz",
+			InitialDelay: time.Second,
+			Period:       5 * time.Second,
+			Timeout:      time.Second,
+		},
 	}
 
 	assertRoundTrip(t, spec)
@@ -94,6 +115,15 @@ func TestImageStatusProtobufRoundTrip(t *testing.T) {
 		Image:  "docker.io/library/nginx:latest",
 		Digest: "sha256:abc123",
 		Error:  "signature verification denied",
+		Verification: containers.ContainerImageVerificationSpec{
+			Verified:      true,
+			Rule:          "0",
+			Message:       "verified via bundle",
+			Signer:        "releasemgr-svc@talos-production.iam.gserviceaccount.com",
+			Issuer:        "https://accounts.google.com",
+			RekorLogID:    "c0d23d6ad406973f9559f3ba2d1ca01f84147d8ffc5b8445c224f98b9591801d",
+			RekorLogIndex: 123456789,
+		},
 	}
 
 	assertRoundTrip(t, status)
@@ -134,13 +164,105 @@ func TestInstanceProtobufRoundTrip(t *testing.T) {
 			CapabilitiesAdd:  []string{"NET_ADMIN"},
 			CapabilitiesDrop: []string{"ALL"},
 		},
-		Network:   containers.ContainerNetworkSpec{HostNetwork: true},
+		Network: containers.ContainerNetworkSpec{
+			Kind:    containers.NetworkKindBridge,
+			Link:    "br-containers",
+			Subnet:  netip.MustParsePrefix("10.88.0.0/24"),
+			Gateway: netip.MustParseAddr("10.88.0.1"),
+			Ports: []containers.ContainerPortSpec{
+				{Protocol: nethelpers.ProtocolTCP, HostPort: 8080, ContainerPort: 80},
+				{Protocol: nethelpers.ProtocolUDP, HostPort: 5353, ContainerPort: 53},
+			},
+		},
 		Resources: containers.ContainerResourcesSpec{MemoryLimit: 1 << 29, CPULimit: 1500},
+		HealthCheck: containers.ContainerHealthCheckSpec{
+			Kind:         containers.HealthCheckKindExec,
+			Command:      []string{"pg_isready", "-U", "postgres"},
+			InitialDelay: time.Second,
+			Period:       5 * time.Second,
+			Timeout:      time.Second,
+		},
+		Address: netip.MustParsePrefix("10.88.0.2/24"),
 	}
 
 	assertRoundTrip(t, spec)
 }
 
+// TestInstanceStatusProtobufRoundTrip guards the protobuf tags on ContainerInstanceStatusSpec,
+// including the timestamps.
+func TestInstanceStatusProtobufRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	status := containers.NewContainerInstanceStatus(containers.NamespaceName, containers.InstanceID("nginx", 3))
+	*status.TypedSpec() = containers.ContainerInstanceStatusSpec{
+		ContainerID: "nginx",
+		Generation:  3,
+		Phase:       containers.ContainerInstancePhaseExited,
+		PID:         4242,
+		ExitCode:    137,
+		Restarts:    2,
+		StartedAt:   time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		FinishedAt:  time.Date(2026, 10, 1, 12, 5, 0, 0, time.UTC),
+		LastError:   "task wait stream closed",
+		Health: containers.ContainerHealthSpec{
+			Healthy:     true,
+			LastMessage: "exit code 0",
+			LastChange:  time.Date(2026, 10, 1, 12, 1, 0, 0, time.UTC),
+		},
+	}
+
+	assertRoundTrip(t, status)
+}
+
+// TestContainerStatusProtobufRoundTrip guards the protobuf tags on ContainerStatusSpec.
+func TestContainerStatusProtobufRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	status := containers.NewContainerStatus(containers.NamespaceName, "nginx")
+	*status.TypedSpec() = containers.ContainerStatusSpec{
+		Image:         "docker.io/library/nginx:latest",
+		Phase:         containers.ContainerPhaseRestarting,
+		HealthMessage: "connection refused",
+		Generation:    3,
+		PID:           4242,
+		ExitCode:      1,
+		Restarts:      2,
+		WaitingFor:    []string{"container: registry"},
+		LastError:     "task wait stream closed",
+	}
+
+	assertRoundTrip(t, status)
+}
+
+// TestContainerMountStatusProtobufRoundTrip guards the protobuf tags on ContainerMountStatusSpec.
+func TestContainerMountStatusProtobufRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	status := containers.NewContainerMountStatus(containers.NamespaceName, "nginx")
+	*status.TypedSpec() = containers.ContainerMountStatusSpec{
+		Volumes: []containers.ContainerVolumeMountSpec{
+			{VolumeID: "u-web-content", Target: "/var/mnt/web-content"},
+			{VolumeID: "u-cache", Target: "/var/mnt/cache"},
+		},
+	}
+
+	assertRoundTrip(t, status)
+}
+
+// TestContainerNetworkStatusProtobufRoundTrip guards the protobuf tags on ContainerNetworkStatusSpec.
+func TestContainerNetworkStatusProtobufRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	status := containers.NewContainerNetworkStatus(containers.NamespaceName, "nginx")
+	*status.TypedSpec() = containers.ContainerNetworkStatusSpec{
+		Address: netip.MustParsePrefix("fd00:88::2/64"),
+		Link:    "br-containers",
+		LinkUp:  true,
+	}
+
+	assertRoundTrip(t, status)
+}
+
 func assertRoundTrip[T resource.Resource](t *testing.T, res T) {
 	t.Helper()
 
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type ContainerSpecSpec -type ContainerImageStatusSpec -type ContainerInstanceSpecSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ContainerSpecSpec -type ContainerImageStatusSpec -type ContainerInstanceSpecSpec -type ContainerInstanceStatusSpec -type ContainerStatusSpec -type ContainerMountStatusSpec -type ContainerNetworkStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package containers
 
@@ -47,6 +47,10 @@ func (o ContainerSpecSpec) DeepCopy() ContainerSpecSpec {
 		cp.Security.CapabilitiesDrop = make([]string, len(o.Security.CapabilitiesDrop))
 		copy(cp.Security.CapabilitiesDrop, o.Security.CapabilitiesDrop)
 	}
+	if o.Network.Ports != nil {
+		cp.Network.Ports = make([]ContainerPortSpec, len(o.Network.Ports))
+		copy(cp.Network.Ports, o.Network.Ports)
+	}
 	if o.DependsOn.Paths != nil {
 		cp.DependsOn.Paths = make([]string, len(o.DependsOn.Paths))
 		copy(cp.DependsOn.Paths, o.DependsOn.Paths)
@@ -59,6 +63,10 @@ func (o ContainerSpecSpec) DeepCopy() ContainerSpecSpec {
 		cp.DependsOn.Containers = make([]string, len(o.DependsOn.Containers))
 		copy(cp.DependsOn.Containers, o.DependsOn.Containers)
 	}
+	if o.HealthCheck.Command != nil {
+		cp.HealthCheck.Command = make([]string, len(o.HealthCheck.Command))
+		copy(cp.HealthCheck.Command, o.HealthCheck.Command)
+	}
 	return cp
 }
 
@@ -109,5 +117,45 @@ func (o ContainerInstanceSpecSpec) DeepCopy() ContainerInstanceSpecSpec {
 		cp.Security.CapabilitiesDrop = make([]string, len(o.Security.CapabilitiesDrop))
 		copy(cp.Security.CapabilitiesDrop, o.Security.CapabilitiesDrop)
 	}
+	if o.Network.Ports != nil {
+		cp.Network.Ports = make([]ContainerPortSpec, len(o.Network.Ports))
+		copy(cp.Network.Ports, o.Network.Ports)
+	}
+	if o.HealthCheck.Command != nil {
+		cp.HealthCheck.Command = make([]string, len(o.HealthCheck.Command))
+		copy(cp.HealthCheck.Command, o.HealthCheck.Command)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of ContainerInstanceStatusSpec.
+func (o ContainerInstanceStatusSpec) DeepCopy() ContainerInstanceStatusSpec {
+	var cp ContainerInstanceStatusSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ContainerStatusSpec.
+func (o ContainerStatusSpec) DeepCopy() ContainerStatusSpec {
+	var cp ContainerStatusSpec = o
+	if o.WaitingFor != nil {
+		cp.WaitingFor = make([]string, len(o.WaitingFor))
+		copy(cp.WaitingFor, o.WaitingFor)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of ContainerMountStatusSpec.
+func (o ContainerMountStatusSpec) DeepCopy() ContainerMountStatusSpec {
+	var cp ContainerMountStatusSpec = o
+	if o.Volumes != nil {
+		cp.Volumes = make([]ContainerVolumeMountSpec, len(o.Volumes))
+		copy(cp.Volumes, o.Volumes)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of ContainerNetworkStatusSpec.
+func (o ContainerNetworkStatusSpec) DeepCopy() ContainerNetworkStatusSpec {
+	var cp ContainerNetworkStatusSpec = o
 	return cp
 }
@@ -16,3 +16,34 @@ const (
 	ContainerImagePhaseReady                              // ready
 	ContainerImagePhaseFailed                             // failed
 )
+
+// ContainerInstancePhase describes the state of a container instance's task.
+type ContainerInstancePhase int
+
+// Container instance phases.
+//
+//structprotogen:gen_enum
+const (
+	ContainerInstancePhasePending  ContainerInstancePhase = iota // pending
+	ContainerInstancePhaseStarting                               // starting
+	ContainerInstancePhaseRunning                                // running
+	ContainerInstancePhaseStopping                               // stopping
+	ContainerInstancePhaseExited                                 // exited
+	ContainerInstancePhaseFailed                                 // failed
+)
+
+// ContainerPhase describes the aggregated state of a container.
+type ContainerPhase int
+
+// Container phases.
+//
+//structprotogen:gen_enum
+const (
+	ContainerPhaseWaiting    ContainerPhase = iota // waiting
+	ContainerPhaseStarting                         // starting
+	ContainerPhaseRunning                          // running
+	ContainerPhaseStopping                         // stopping
+	ContainerPhaseRestarting                       // restarting
+	ContainerPhaseExited                           // exited
+	ContainerPhaseFailed                           // failed
+)
@@ -30,6 +30,29 @@ type ContainerImageStatusSpec struct {
 	Digest string `yaml:"digest,omitempty" protobuf:"3"`
 	// Error is the last pull failure, verbatim.
 	Error string `yaml:"error,omitempty" protobuf:"4"`
+	// Verification records how the pulled image was checked against the image verification rules.
+	Verification ContainerImageVerificationSpec `yaml:"verification,omitempty" protobuf:"5"`
+}
+
+// ContainerImageVerificationSpec records the outcome of checking an image against the image
+// verification rules.
+//
+//gotagsrewrite:gen
+type ContainerImageVerificationSpec struct {
+	// Verified is set if the image matched a rule and its signature was verified.
+	Verified bool `yaml:"verified" protobuf:"1"`
+	// Rule is the ID of the matched rule.
+	Rule string `yaml:"rule,omitempty" protobuf:"2"`
+	// Message describes how the signature was found and verified.
+	Message string `yaml:"message,omitempty" protobuf:"3"`
+	// Signer is the identity the image was signed with, empty for public key signatures.
+	Signer string `yaml:"signer,omitempty" protobuf:"4"`
+	// Issuer is the OIDC issuer which vouched for Signer.
+	Issuer string `yaml:"issuer,omitempty" protobuf:"5"`
+	// RekorLogID is the transparency log the signature is recorded in, empty if it was not checked.
+	RekorLogID string `yaml:"rekorLogID,omitempty" protobuf:"6"`
+	// RekorLogIndex is the index of the signature's entry in the transparency log.
+	RekorLogIndex int64 `yaml:"rekorLogIndex,omitempty" protobuf:"7"`
 }
 
 // NewContainerImageStatus initializes a ContainerImageStatus resource.
@@ -58,6 +81,10 @@ func (ContainerImageStatusExtension) ResourceDefinition() meta.ResourceDefinitio
 				Name:     "Digest",
 				JSONPath: `{.digest}`,
 			},
+			{
+				Name:     "Verified",
+				JSONPath: `{.verification.verified}`,
+			},
 		},
 	}
 }
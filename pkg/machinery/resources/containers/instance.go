@@ -7,6 +7,7 @@ package containers
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"slices"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -68,6 +69,11 @@ type ContainerInstanceSpecSpec struct {
 	Security  ContainerSecuritySpec  `yaml:"security,omitempty" protobuf:"10"`
 	Network   ContainerNetworkSpec   `yaml:"network,omitempty" protobuf:"11"`
 	Resources ContainerResourcesSpec `yaml:"resources,omitempty" protobuf:"12"`
+
+	HealthCheck ContainerHealthCheckSpec `yaml:"healthCheck,omitempty" protobuf:"13"`
+
+	// Address is the container's address on its link, for a container attached to a host link.
+	Address netip.Prefix `yaml:"address,omitempty" protobuf:"14"`
 }
 
 // ResolvedMountSpec is a mount with its host-side source resolved.
@@ -138,17 +144,39 @@ func (instanceSpec ContainerInstanceSpecSpec) InSyncWithContainerSpec(ctx contex
 		return false, nil
 	}
 
-	resolvedMounts, mountsReady := ResolveInstanceMounts(s.Mounts)
+	resolvedMounts, unmounted, err := ResolveContainerMounts(ctx, r, instanceSpec.ContainerID, s.Mounts)
+	if err != nil {
+		return false, err
+	}
 
-	if !mountsReady {
+	if len(unmounted) > 0 {
 		return false, nil
 	}
 
+	var (
+		address       netip.Prefix
+		networkStatus *ContainerNetworkStatusSpec
+	)
+
+	if s.Network.Kind != "" {
+		if networkStatus, err = GetNetworkStatus(ctx, r, instanceSpec.ContainerID); err != nil {
+			return false, err
+		}
+
+		var allocated bool
+
+		if address, allocated = networkStatus.AddressOn(s.Network); !allocated {
+			return false, nil
+		}
+	}
+
 	inSync := ProcessEqual(s, &instanceSpec) &&
 		ResolvedMountsEqual(resolvedMounts, instanceSpec.Mounts) &&
 		SecurityEqual(s.Security, instanceSpec.Security) &&
-		s.Network == instanceSpec.Network &&
-		s.Resources == instanceSpec.Resources
+		NetworkEqual(s.Network, instanceSpec.Network) &&
+		address == instanceSpec.Address &&
+		s.Resources == instanceSpec.Resources &&
+		HealthCheckEqual(s.HealthCheck, instanceSpec.HealthCheck)
 
 	return inSync, nil
 }
@@ -176,17 +204,55 @@ func GetImageDigest(ctx context.Context, r controller.Reader, containerID, image
 	return imageStatus.TypedSpec().Digest, nil
 }
 
-// ResolveInstanceMounts resolves a container's mounts from the ContainerSpec.
-func ResolveInstanceMounts(mounts []ContainerMountSpec) (resolved []ResolvedMountSpec, ready bool) {
-	ready = true
+// ResolveContainerMounts resolves a container's mounts against its ContainerMountStatus.
+//
+// It returns the volume IDs of the userVolume mounts which could not be resolved, i.e. whose
+// volumes are not mounted for the container.
+func ResolveContainerMounts(ctx context.Context, r controller.Reader, containerID string, mounts []ContainerMountSpec) (
+	resolved []ResolvedMountSpec, unmounted []string, err error,
+) {
+	var (
+		mountStatus *ContainerMountStatusSpec
+		status      *ContainerMountStatus
+	)
+
+	if slices.ContainsFunc(mounts, func(mount ContainerMountSpec) bool { return mount.Kind == MountKindUserVolume }) {
+		status, err = safe.ReaderGetByID[*ContainerMountStatus](ctx, r, containerID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, nil, fmt.Errorf("failed to get mount status %q: %w", containerID, err)
+		}
 
+		if status != nil {
+			mountStatus = status.TypedSpec()
+		}
+	}
+
+	resolved, unmounted = ResolveInstanceMounts(mounts, mountStatus)
+
+	return resolved, unmounted, nil
+}
+
+// ResolveInstanceMounts resolves a container's mounts from the ContainerSpec.
+//
+// A userVolume mount is bound from the host path its volume is mounted at, as reported by
+// mountStatus, which may be nil. The volume IDs of those that are not mounted are returned instead.
+func ResolveInstanceMounts(mounts []ContainerMountSpec, mountStatus *ContainerMountStatusSpec) (resolved []ResolvedMountSpec, unmounted []string) {
 	for _, mount := range mounts {
 		switch mount.Kind {
 		case MountKindUserVolume:
-			// A userVolume mount needs MountController to learn its real host path, which doesn't
-			// exist yet, so any containers mounting userVolumes will deadlock as not-ready. This
-			// will start working once we complete the mount implementation.
-			ready = false
+			target, mounted := mountStatus.Target(mount.VolumeID)
+			if !mounted {
+				unmounted = append(unmounted, mount.VolumeID)
+
+				continue
+			}
+
+			resolved = append(resolved, ResolvedMountSpec{
+				Kind:        mount.Kind,
+				Source:      target,
+				Destination: mount.Destination,
+				Options:     mount.Options,
+			})
 		case MountKindTmpfs:
 			resolved = append(resolved, ResolvedMountSpec{
 				Kind:        mount.Kind,
@@ -204,7 +270,29 @@ func ResolveInstanceMounts(mounts []ContainerMountSpec) (resolved []ResolvedMoun
 		}
 	}
 
-	return resolved, ready
+	return resolved, unmounted
+}
+
+// VolumesMounted reports whether every user volume the instance binds from is still mounted for
+// its container.
+//
+// A volume which is being closed drops out of the mount status before it is unmounted, and is only
+// unmounted once no instance binds from it any more: an instance for which this is false has to be
+// stopped, whatever its spec says.
+func (instanceSpec ContainerInstanceSpecSpec) VolumesMounted(mountStatus *ContainerMountStatusSpec) bool {
+	for _, mount := range instanceSpec.Mounts {
+		if mount.Kind != MountKindUserVolume {
+			continue
+		}
+
+		if !slices.ContainsFunc(mountStatus.volumes(), func(volume ContainerVolumeMountSpec) bool {
+			return volume.Target == mount.Source
+		}) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // ProcessEqual compares the parts of the spec that describe the process itself.
@@ -223,6 +311,27 @@ func SecurityEqual(a, b ContainerSecuritySpec) bool {
 		slices.Equal(a.CapabilitiesDrop, b.CapabilitiesDrop)
 }
 
+// NetworkEqual compares two network specs field by field, as they carry slices.
+func NetworkEqual(a, b ContainerNetworkSpec) bool {
+	return a.HostNetwork == b.HostNetwork &&
+		a.Kind == b.Kind &&
+		a.Link == b.Link &&
+		a.Subnet == b.Subnet &&
+		a.Gateway == b.Gateway &&
+		slices.Equal(a.Ports, b.Ports)
+}
+
+// HealthCheckEqual compares two health check specs field by field, as they carry slices.
+func HealthCheckEqual(a, b ContainerHealthCheckSpec) bool {
+	return a.Kind == b.Kind &&
+		slices.Equal(a.Command, b.Command) &&
+		a.Port == b.Port &&
+		a.Path == b.Path &&
+		a.InitialDelay == b.InitialDelay &&
+		a.Period == b.Period &&
+		a.Timeout == b.Timeout
+}
+
 // RunAsEqual compares two RunAs specs, treating nil UID/GID halves as equal only to each other.
 func RunAsEqual(a, b ContainerRunAsSpec) bool {
 	return Int32PtrEqual(a.UID, b.UID) && Int32PtrEqual(a.GID, b.GID)
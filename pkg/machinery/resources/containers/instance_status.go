@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ContainerInstanceStatusType is type of ContainerInstanceStatus resource.
+const ContainerInstanceStatusType = resource.Type("ContainerInstanceStatuses.containers.talos.dev")
+
+// ContainerInstanceStatus resource holds the state of the task running a ContainerInstanceSpec.
+//
+// The ID matches the ContainerInstanceSpec it describes, and the status goes away with it.
+type ContainerInstanceStatus = typed.Resource[ContainerInstanceStatusSpec, ContainerInstanceStatusExtension]
+
+// ContainerInstanceStatusSpec is the spec for ContainerInstanceStatus.
+//
+//gotagsrewrite:gen
+type ContainerInstanceStatusSpec struct {
+	// ContainerID is the name of the owning container, i.e. the ContainerSpec ID.
+	ContainerID string `yaml:"containerID" protobuf:"1"`
+	// Generation is the instance's sequence number for that container.
+	Generation uint64 `yaml:"generation" protobuf:"2"`
+
+	Phase ContainerInstancePhase `yaml:"phase" protobuf:"3"`
+	// PID is the host PID of the task's init process, set once it has started.
+	PID uint32 `yaml:"pid,omitempty" protobuf:"4"`
+	// ExitCode is the task's exit status, set once it has exited.
+	ExitCode uint32 `yaml:"exitCode,omitempty" protobuf:"5"`
+	// Restarts counts the earlier instances of this container which terminated on their own.
+	//
+	// Replacing an instance because its spec changed is not a restart.
+	Restarts uint64 `yaml:"restarts" protobuf:"6"`
+
+	StartedAt  time.Time `yaml:"startedAt,omitempty" protobuf:"7"`
+	FinishedAt time.Time `yaml:"finishedAt,omitempty" protobuf:"8"`
+
+	// LastError is the last failure to create, start or track the task, verbatim.
+	LastError string `yaml:"lastError,omitempty" protobuf:"9"`
+
+	// Health is the state of the health check, if the instance declares one.
+	Health ContainerHealthSpec `yaml:"health,omitempty" protobuf:"10"`
+}
+
+// ContainerHealthSpec is the state of a health check, as reported by the health check runner.
+//
+//gotagsrewrite:gen
+type ContainerHealthSpec struct {
+	// Unknown is set until the first check completes.
+	Unknown     bool      `yaml:"unknown,omitempty" protobuf:"1"`
+	Healthy     bool      `yaml:"healthy" protobuf:"2"`
+	LastMessage string    `yaml:"lastMessage,omitempty" protobuf:"3"`
+	LastChange  time.Time `yaml:"lastChange,omitempty" protobuf:"4"`
+}
+
+// Terminated reports whether the task is gone without having been asked to stop, or never started.
+func (spec ContainerInstanceStatusSpec) Terminated() bool {
+	return spec.Phase == ContainerInstancePhaseExited || spec.Phase == ContainerInstancePhaseFailed
+}
+
+// NewContainerInstanceStatus initializes a ContainerInstanceStatus resource.
+func NewContainerInstanceStatus(namespace resource.Namespace, id resource.ID) *ContainerInstanceStatus {
+	return typed.NewResource[ContainerInstanceStatusSpec, ContainerInstanceStatusExtension](
+		resource.NewMetadata(namespace, ContainerInstanceStatusType, id, resource.VersionUndefined),
+		ContainerInstanceStatusSpec{},
+	)
+}
+
+// ContainerInstanceStatusExtension is auxiliary resource data for ContainerInstanceStatus.
+type ContainerInstanceStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ContainerInstanceStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ContainerInstanceStatusType,
+		Aliases:          []resource.Type{"containerinstancestatus", "containerinstancestatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Container",
+				JSONPath: `{.containerID}`,
+			},
+			{
+				Name:     "Phase",
+				JSONPath: `{.phase}`,
+			},
+			{
+				Name:     "PID",
+				JSONPath: `{.pid}`,
+			},
+			{
+				Name:     "Restarts",
+				JSONPath: `{.restarts}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic(ContainerInstanceStatusType, &ContainerInstanceStatus{}); err != nil {
+		panic(err)
+	}
+}
@@ -5,10 +5,12 @@
 package containers_test
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/containers"
 )
 
@@ -206,6 +208,67 @@ func TestSecurityEqual(t *testing.T) {
 	}
 }
 
+func TestNetworkEqual(t *testing.T) {
+	t.Parallel()
+
+	bridge := func() containers.ContainerNetworkSpec {
+		return containers.ContainerNetworkSpec{
+			Kind:    containers.NetworkKindBridge,
+			Link:    "br-containers",
+			Subnet:  netip.MustParsePrefix("10.88.0.0/24"),
+			Gateway: netip.MustParseAddr("10.88.0.1"),
+			Ports: []containers.ContainerPortSpec{
+				{Protocol: nethelpers.ProtocolTCP, HostPort: 8080, ContainerPort: 80},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*containers.ContainerNetworkSpec)
+		want   bool
+	}{
+		{
+			name:   "equal",
+			modify: func(*containers.ContainerNetworkSpec) {},
+			want:   true,
+		},
+		{
+			name:   "link differs",
+			modify: func(spec *containers.ContainerNetworkSpec) { spec.Link = "br-other" },
+			want:   false,
+		},
+		{
+			name:   "gateway differs",
+			modify: func(spec *containers.ContainerNetworkSpec) { spec.Gateway = netip.Addr{} },
+			want:   false,
+		},
+		{
+			name: "port differs",
+			modify: func(spec *containers.ContainerNetworkSpec) {
+				spec.Ports = []containers.ContainerPortSpec{{Protocol: nethelpers.ProtocolUDP, HostPort: 8080, ContainerPort: 80}}
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := bridge()
+			tt.modify(&b)
+
+			assert.Equal(t, tt.want, containers.NetworkEqual(bridge(), b))
+		})
+	}
+
+	assert.True(t, containers.NetworkEqual(
+		containers.ContainerNetworkSpec{HostNetwork: true},
+		containers.ContainerNetworkSpec{HostNetwork: true, Ports: []containers.ContainerPortSpec{}},
+	))
+}
+
 func TestRunAsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -517,17 +580,22 @@ func TestResolvedMountsEqual(t *testing.T) {
 func TestResolveInstanceMounts(t *testing.T) {
 	t.Parallel()
 
+	mountStatus := &containers.ContainerMountStatusSpec{
+		Volumes: []containers.ContainerVolumeMountSpec{
+			{VolumeID: "u-data", Target: "/var/mnt/data"},
+		},
+	}
+
 	tests := []struct {
-		name      string
-		mounts    []containers.ContainerMountSpec
-		wantReady bool
-		wantCount int
+		name          string
+		mounts        []containers.ContainerMountSpec
+		mountStatus   *containers.ContainerMountStatusSpec
+		wantResolved  []containers.ResolvedMountSpec
+		wantUnmounted []string
 	}{
 		{
-			name:      "no mounts",
-			mounts:    []containers.ContainerMountSpec{},
-			wantReady: true,
-			wantCount: 0,
+			name:   "no mounts",
+			mounts: []containers.ContainerMountSpec{},
 		},
 		{
 			name: "tmpfs mount",
@@ -538,8 +606,9 @@ func TestResolveInstanceMounts(t *testing.T) {
 					Size:        1024,
 				},
 			},
-			wantReady: true,
-			wantCount: 1,
+			wantResolved: []containers.ResolvedMountSpec{
+				{Kind: containers.MountKindTmpfs, Destination: "/tmp", Size: 1024},
+			},
 		},
 		{
 			name: "hostpath mount",
@@ -550,22 +619,38 @@ func TestResolveInstanceMounts(t *testing.T) {
 					Destination: "/data",
 				},
 			},
-			wantReady: true,
-			wantCount: 1,
+			wantResolved: []containers.ResolvedMountSpec{
+				{Kind: containers.MountKindHostPath, Source: "/var", Destination: "/data"},
+			},
+		},
+		{
+			name: "user volume without mount status",
+			mounts: []containers.ContainerMountSpec{
+				{
+					Kind:        containers.MountKindUserVolume,
+					VolumeID:    "u-data",
+					Destination: "/mnt",
+				},
+			},
+			wantUnmounted: []string{"u-data"},
 		},
 		{
-			name: "user volume mount",
+			name: "mounted user volume",
 			mounts: []containers.ContainerMountSpec{
 				{
 					Kind:        containers.MountKindUserVolume,
+					VolumeID:    "u-data",
 					Destination: "/mnt",
+					Options:     []string{"ro"},
 				},
 			},
-			wantReady: false,
-			wantCount: 0,
+			mountStatus: mountStatus,
+			wantResolved: []containers.ResolvedMountSpec{
+				{Kind: containers.MountKindUserVolume, Source: "/var/mnt/data", Destination: "/mnt", Options: []string{"ro"}},
+			},
 		},
 		{
-			name: "mixed mounts with user volume",
+			name: "mixed mounts with an unmounted user volume",
 			mounts: []containers.ContainerMountSpec{
 				{
 					Kind:        containers.MountKindTmpfs,
@@ -573,16 +658,27 @@ func TestResolveInstanceMounts(t *testing.T) {
 				},
 				{
 					Kind:        containers.MountKindUserVolume,
+					VolumeID:    "u-data",
 					Destination: "/mnt",
 				},
+				{
+					Kind:        containers.MountKindUserVolume,
+					VolumeID:    "u-logs",
+					Destination: "/logs",
+				},
 				{
 					Kind:        containers.MountKindHostPath,
 					Source:      "/var",
 					Destination: "/data",
 				},
 			},
-			wantReady: false,
-			wantCount: 2,
+			mountStatus: mountStatus,
+			wantResolved: []containers.ResolvedMountSpec{
+				{Kind: containers.MountKindTmpfs, Destination: "/tmp"},
+				{Kind: containers.MountKindUserVolume, Source: "/var/mnt/data", Destination: "/mnt"},
+				{Kind: containers.MountKindHostPath, Source: "/var", Destination: "/data"},
+			},
+			wantUnmounted: []string{"u-logs"},
 		},
 	}
 
@@ -590,9 +686,32 @@ func TestResolveInstanceMounts(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, ready := containers.ResolveInstanceMounts(tt.mounts)
-			assert.Equal(t, tt.wantReady, ready)
-			assert.Equal(t, tt.wantCount, len(got))
+			resolved, unmounted := containers.ResolveInstanceMounts(tt.mounts, tt.mountStatus)
+			assert.Equal(t, tt.wantResolved, resolved)
+			assert.Equal(t, tt.wantUnmounted, unmounted)
 		})
 	}
 }
+
+func TestVolumesMounted(t *testing.T) {
+	t.Parallel()
+
+	instance := containers.ContainerInstanceSpecSpec{
+		Mounts: []containers.ResolvedMountSpec{
+			{Kind: containers.MountKindTmpfs, Destination: "/tmp"},
+			{Kind: containers.MountKindHostPath, Source: "/var/lib/data", Destination: "/host"},
+			{Kind: containers.MountKindUserVolume, Source: "/var/mnt/data", Destination: "/data"},
+		},
+	}
+
+	assert.True(t, instance.VolumesMounted(&containers.ContainerMountStatusSpec{
+		Volumes: []containers.ContainerVolumeMountSpec{{VolumeID: "u-data", Target: "/var/mnt/data"}},
+	}))
+
+	// The volume is being closed, so it is no longer listed.
+	assert.False(t, instance.VolumesMounted(&containers.ContainerMountStatusSpec{}))
+	assert.False(t, instance.VolumesMounted(nil))
+
+	// An instance without user volumes doesn't depend on the mount status at all.
+	assert.True(t, containers.ContainerInstanceSpecSpec{Mounts: instance.Mounts[:2]}.VolumesMounted(nil))
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ContainerMountStatusType is type of ContainerMountStatus resource.
+const ContainerMountStatusType = resource.Type("ContainerMountStatuses.containers.talos.dev")
+
+// ContainerMountStatus resource reports the user volumes of a single container which are mounted
+// on the host.
+//
+// The ID matches the ContainerSpec it describes. It only exists for a container declaring
+// userVolume mounts, and a volume is only listed while it is held mounted for the container, so a
+// volume missing from it is one the container cannot run with.
+type ContainerMountStatus = typed.Resource[ContainerMountStatusSpec, ContainerMountStatusExtension]
+
+// ContainerMountStatusSpec is the spec for ContainerMountStatus.
+//
+//gotagsrewrite:gen
+type ContainerMountStatusSpec struct {
+	Volumes []ContainerVolumeMountSpec `yaml:"volumes,omitempty" protobuf:"1"`
+}
+
+// ContainerVolumeMountSpec is a user volume mounted on the host.
+//
+//gotagsrewrite:gen
+type ContainerVolumeMountSpec struct {
+	// VolumeID is the block volume ID, e.g. "u-web-content".
+	VolumeID string `yaml:"volumeID" protobuf:"1"`
+	// Target is the host path the volume is mounted at.
+	Target string `yaml:"target" protobuf:"2"`
+}
+
+// Target returns the host path a volume is mounted at, if it is.
+func (s *ContainerMountStatusSpec) Target(volumeID string) (string, bool) {
+	for _, volume := range s.volumes() {
+		if volume.VolumeID == volumeID {
+			return volume.Target, true
+		}
+	}
+
+	return "", false
+}
+
+// volumes is nil-safe access to Volumes.
+func (s *ContainerMountStatusSpec) volumes() []ContainerVolumeMountSpec {
+	if s == nil {
+		return nil
+	}
+
+	return s.Volumes
+}
+
+// NewContainerMountStatus initializes a ContainerMountStatus resource.
+func NewContainerMountStatus(namespace resource.Namespace, id resource.ID) *ContainerMountStatus {
+	return typed.NewResource[ContainerMountStatusSpec, ContainerMountStatusExtension](
+		resource.NewMetadata(namespace, ContainerMountStatusType, id, resource.VersionUndefined),
+		ContainerMountStatusSpec{},
+	)
+}
+
+// ContainerMountStatusExtension is auxiliary resource data for ContainerMountStatus.
+type ContainerMountStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ContainerMountStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ContainerMountStatusType,
+		Aliases:          []resource.Type{"containermountstatus", "containermountstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Volumes",
+				JSONPath: `{.volumes[*].volumeID}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic(ContainerMountStatusType, &ContainerMountStatus{}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// ContainerNetworkStatusType is type of ContainerNetworkStatus resource.
+const ContainerNetworkStatusType = resource.Type("ContainerNetworkStatuses.containers.talos.dev")
+
+// ContainerNetworkStatus resource reports the address allocated to a single container attached to
+// a host link, and the state of that link.
+//
+// The ID matches the ContainerSpec it describes. It only exists for a container in the bridge or
+// macvlan network mode.
+type ContainerNetworkStatus = typed.Resource[ContainerNetworkStatusSpec, ContainerNetworkStatusExtension]
+
+// ContainerNetworkStatusSpec is the spec for ContainerNetworkStatus.
+//
+//gotagsrewrite:gen
+type ContainerNetworkStatusSpec struct {
+	// Address allocated to the container, with the prefix length of its subnet.
+	Address netip.Prefix `yaml:"address" protobuf:"1"`
+	// Link the address was allocated on.
+	Link string `yaml:"link" protobuf:"2"`
+	// LinkUp is set while the link exists and is operationally up.
+	LinkUp bool `yaml:"linkUp" protobuf:"3"`
+}
+
+// GetNetworkStatus returns the network status of a container, or nil if it has none.
+func GetNetworkStatus(ctx context.Context, r controller.Reader, containerID string) (*ContainerNetworkStatusSpec, error) {
+	status, err := safe.ReaderGetByID[*ContainerNetworkStatus](ctx, r, containerID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get network status %q: %w", containerID, err)
+	}
+
+	return status.TypedSpec(), nil
+}
+
+// AddressOn returns the address allocated to the container, if it was allocated on the given network.
+//
+// The status is only believed when it describes the network itself: an edited link or subnet leaves
+// the previous allocation in place until NetworkController allocates again.
+func (s *ContainerNetworkStatusSpec) AddressOn(network ContainerNetworkSpec) (netip.Prefix, bool) {
+	if s == nil || !s.Address.IsValid() || s.Link != network.Link {
+		return netip.Prefix{}, false
+	}
+
+	if s.Address.Bits() != network.Subnet.Bits() || !network.Subnet.Contains(s.Address.Addr()) {
+		return netip.Prefix{}, false
+	}
+
+	return s.Address, true
+}
+
+// NewContainerNetworkStatus initializes a ContainerNetworkStatus resource.
+func NewContainerNetworkStatus(namespace resource.Namespace, id resource.ID) *ContainerNetworkStatus {
+	return typed.NewResource[ContainerNetworkStatusSpec, ContainerNetworkStatusExtension](
+		resource.NewMetadata(namespace, ContainerNetworkStatusType, id, resource.VersionUndefined),
+		ContainerNetworkStatusSpec{},
+	)
+}
+
+// ContainerNetworkStatusExtension is auxiliary resource data for ContainerNetworkStatus.
+type ContainerNetworkStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ContainerNetworkStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ContainerNetworkStatusType,
+		Aliases:          []resource.Type{"containernetworkstatus", "containernetworkstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Address",
+				JSONPath: `{.address}`,
+			},
+			{
+				Name:     "Link",
+				JSONPath: `{.link}`,
+			},
+			{
+				Name:     "Link Up",
+				JSONPath: `{.linkUp}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic(ContainerNetworkStatusType, &ContainerNetworkStatus{}); err != nil {
+		panic(err)
+	}
+}
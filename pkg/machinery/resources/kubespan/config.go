@@ -6,6 +6,7 @@ package kubespan
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -48,6 +49,12 @@ type ConfigSpec struct {
 	ExtraEndpoints []netip.AddrPort `yaml:"extraEndpoints,omitempty" protobuf:"9"`
 	// If not empty, filter advertised networks using the list of CIDRs.
 	ExcludeAdvertisedNetworks []netip.Prefix `yaml:"excludeAdvertisedNetworks,omitempty" protobuf:"10"`
+	// Offer this node as a relay for the peers which can't connect directly.
+	Relay bool `yaml:"relay,omitempty" protobuf:"11"`
+	// Rotate the identity key with the interval, zero disables the rotation.
+	KeyRotationInterval time.Duration `yaml:"keyRotationInterval,omitempty" protobuf:"12"`
+	// Derive Wireguard preshared keys for each pair of peers from the shared secret.
+	PerPeerPresharedKeys bool `yaml:"perPeerPresharedKeys,omitempty" protobuf:"13"`
 }
 
 // NewConfig initializes a Config resource.
@@ -6,6 +6,7 @@ package kubespan
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -37,6 +38,14 @@ type IdentitySpec struct {
 	// Public and private Wireguard keys.
 	PrivateKey string `yaml:"privateKey" protobuf:"3"`
 	PublicKey  string `yaml:"publicKey" protobuf:"4"`
+	// Time the current key was put in use.
+	KeyCreated time.Time `yaml:"keyCreated,omitempty" protobuf:"5"`
+	// Next key, announced to the peers ahead of the key rotation.
+	NextPrivateKey string    `yaml:"nextPrivateKey,omitempty" protobuf:"6"`
+	NextPublicKey  string    `yaml:"nextPublicKey,omitempty" protobuf:"7"`
+	NextKeyCreated time.Time `yaml:"nextKeyCreated,omitempty" protobuf:"8"`
+	// Previous public key, still announced to the peers right after the key rotation.
+	PreviousPublicKey string `yaml:"previousPublicKey,omitempty" protobuf:"9"`
 }
 
 // NewIdentity initializes a Identity resource.
@@ -65,6 +74,14 @@ func (IdentityExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "PublicKey",
 				JSONPath: `{.publicKey}`,
 			},
+			{
+				Name:     "Key Created",
+				JSONPath: `{.keyCreated}`,
+			},
+			{
+				Name:     "Next PublicKey",
+				JSONPath: `{.nextPublicKey}`,
+			},
 		},
 		Sensitivity: meta.Sensitive,
 	}
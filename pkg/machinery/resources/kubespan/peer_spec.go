@@ -31,6 +31,9 @@ type PeerSpecSpec struct {
 	AllowedIPs []netip.Prefix   `yaml:"allowedIPs" protobuf:"2"`
 	Endpoints  []netip.AddrPort `yaml:"endpoints" protobuf:"3"`
 	Label      string           `yaml:"label" protobuf:"4"`
+	Relay      bool             `yaml:"relay,omitempty" protobuf:"5"`
+	// Another public key of the peer accepted during the key rotation.
+	AlternatePublicKey string `yaml:"alternatePublicKey,omitempty" protobuf:"6"`
 }
 
 // NewPeerSpec initializes a PeerSpec resource.
@@ -42,6 +42,10 @@ type PeerStatusSpec struct {
 	// Endpoint selection input.
 	LastUsedEndpoint   netip.AddrPort `yaml:"lastUsedEndpoint" protobuf:"7"`
 	LastEndpointChange time.Time      `yaml:"lastEndpointChange" protobuf:"8"`
+	// Label of the relay peer the traffic is routed via, if the peer is not reachable directly.
+	RelayedVia string `yaml:"relayedVia,omitempty" protobuf:"9"`
+	// Public key of the relay peer.
+	RelayPublicKey string `yaml:"relayPublicKey,omitempty" protobuf:"10"`
 }
 
 // NewPeerStatus initializes a PeerStatus resource.
@@ -74,6 +78,10 @@ func (PeerStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "State",
 				JSONPath: `{.state}`,
 			},
+			{
+				Name:     "Relayed Via",
+				JSONPath: `{.relayedVia}`,
+			},
 			{
 				Name:     "Rx",
 				JSONPath: `{.receiveBytes}`,
@@ -43,6 +43,29 @@ type BGPNeighborConfigSpec struct {
 	BFD      *BGPBFDConfigSpec `yaml:"bfd,omitempty" protobuf:"5"`
 	LocalASN uint32            `yaml:"localASN,omitempty" protobuf:"6"`
 	Passive  bool              `yaml:"passive,omitempty" protobuf:"7"`
+	Password string            `yaml:"password,omitempty" protobuf:"8"`
+
+	ImportPolicy *BGPRoutePolicySpec `yaml:"importPolicy,omitempty" protobuf:"9"`
+	ExportPolicy *BGPRoutePolicySpec `yaml:"exportPolicy,omitempty" protobuf:"10"`
+}
+
+// BGPRoutePolicySpec contains the route filtering and modification policy for a BGP neighbor.
+//
+//gotagsrewrite:gen
+type BGPRoutePolicySpec struct {
+	Prefixes            []BGPPrefixFilterSpec `yaml:"prefixes,omitempty" protobuf:"1"`
+	MatchCommunities    []string              `yaml:"matchCommunities,omitempty" protobuf:"2"`
+	AddCommunities      []string              `yaml:"addCommunities,omitempty" protobuf:"3"`
+	AddLargeCommunities []string              `yaml:"addLargeCommunities,omitempty" protobuf:"4"`
+	ASPathPrepend       uint8                 `yaml:"asPathPrepend,omitempty" protobuf:"5"`
+}
+
+// BGPPrefixFilterSpec is a single entry of a BGP prefix list.
+//
+//gotagsrewrite:gen
+type BGPPrefixFilterSpec struct {
+	Prefix    netip.Prefix `yaml:"prefix" protobuf:"1"`
+	MaxLength uint8        `yaml:"maxLength" protobuf:"2"`
 }
 
 // BGPImportRouteSpec selects routes learned by another BGP instance for one-way import.
@@ -68,6 +91,25 @@ type BGPInstanceConfigSpec struct {
 	VRFTable       nethelpers.RoutingTable `yaml:"vrfTable,omitempty" protobuf:"9"`
 	ImportRoutes   []BGPImportRouteSpec    `yaml:"importRoutes,omitempty" protobuf:"10"`
 	InstallRoutes  bool                    `yaml:"installRoutes" protobuf:"11"`
+
+	GracefulRestart *BGPGracefulRestartSpec `yaml:"gracefulRestart,omitempty" protobuf:"12"`
+	AdvertiseProbes []BGPAdvertiseProbeSpec `yaml:"advertiseProbes,omitempty" protobuf:"13"`
+}
+
+// BGPAdvertiseProbeSpec gates the advertisement of a link's addresses on a probe.
+//
+//gotagsrewrite:gen
+type BGPAdvertiseProbeSpec struct {
+	Link  string `yaml:"link" protobuf:"1"`
+	Probe string `yaml:"probe" protobuf:"2"`
+}
+
+// BGPGracefulRestartSpec contains the graceful restart parameters of a BGP instance.
+//
+//gotagsrewrite:gen
+type BGPGracefulRestartSpec struct {
+	RestartTime        time.Duration `yaml:"restartTime" protobuf:"1"`
+	LongLivedStaleTime time.Duration `yaml:"longLivedStaleTime,omitempty" protobuf:"2"`
 }
 
 // NewBGPInstanceConfig initializes a named BGPInstanceConfig resource.
@@ -93,6 +135,7 @@ func (BGPInstanceConfigExtension) ResourceDefinition() meta.ResourceDefinitionSp
 			{Name: "VRF", JSONPath: "{.vrf}"},
 			{Name: "Neighbors", JSONPath: "{.neighbors}"},
 		},
+		Sensitivity: meta.Sensitive,
 	}
 }
 
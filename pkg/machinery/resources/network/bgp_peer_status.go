@@ -38,6 +38,10 @@ type BGPPeerStatusSpec struct {
 	Accepted   uint32                     `yaml:"accepted" protobuf:"9"`
 	BFDState   string                     `yaml:"bfdState,omitempty" protobuf:"10"`
 	Instance   string                     `yaml:"instance" protobuf:"11"`
+	Rejected   uint32                     `yaml:"rejected" protobuf:"12"`
+
+	GracefulRestart          bool `yaml:"gracefulRestart,omitempty" protobuf:"13"`
+	LongLivedGracefulRestart bool `yaml:"longLivedGracefulRestart,omitempty" protobuf:"14"`
 }
 
 // NewBGPPeerStatus initializes a BGPPeerStatus resource.
@@ -82,6 +86,14 @@ func (BGPPeerStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Received",
 				JSONPath: `{.received}`,
 			},
+			{
+				Name:     "Rejected",
+				JSONPath: `{.rejected}`,
+			},
+			{
+				Name:     "Graceful Restart",
+				JSONPath: `{.gracefulRestart}`,
+			},
 		},
 	}
 }
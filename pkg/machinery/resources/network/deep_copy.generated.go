@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type BGPBFDConfigSpec -type BGPNeighborConfigSpec -type BGPInstanceConfigSpec -type BGPPeerStatusSpec -type BondMasterSpec -type DNSResolveCacheSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkAliasSpecSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressSortAlgorithmSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PlatformConfigSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type StaticHostSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type BGPBFDConfigSpec -type BGPNeighborConfigSpec -type BGPInstanceConfigSpec -type BGPPeerStatusSpec -type BondMasterSpec -type DelegatedPrefixSpec -type DHCPOptionsSpec -type DNSResolveCacheSpec -type EthernetSpecSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkAliasSpecSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NfTablesChainStatusSpec -type NodeAddressSpec -type NodeAddressSortAlgorithmSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type PlatformConfigSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type RoutingRuleSpecSpec -type RoutingRuleStatusSpec -type StaticHostSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -38,6 +38,46 @@ func (o BGPNeighborConfigSpec) DeepCopy() BGPNeighborConfigSpec {
 		retV := o.BFD.DeepCopy()
 		cp.BFD = &retV
 	}
+	if o.ImportPolicy != nil {
+		cp.ImportPolicy = new(BGPRoutePolicySpec)
+		*cp.ImportPolicy = *o.ImportPolicy
+		if o.ImportPolicy.Prefixes != nil {
+			cp.ImportPolicy.Prefixes = make([]BGPPrefixFilterSpec, len(o.ImportPolicy.Prefixes))
+			copy(cp.ImportPolicy.Prefixes, o.ImportPolicy.Prefixes)
+		}
+		if o.ImportPolicy.MatchCommunities != nil {
+			cp.ImportPolicy.MatchCommunities = make([]string, len(o.ImportPolicy.MatchCommunities))
+			copy(cp.ImportPolicy.MatchCommunities, o.ImportPolicy.MatchCommunities)
+		}
+		if o.ImportPolicy.AddCommunities != nil {
+			cp.ImportPolicy.AddCommunities = make([]string, len(o.ImportPolicy.AddCommunities))
+			copy(cp.ImportPolicy.AddCommunities, o.ImportPolicy.AddCommunities)
+		}
+		if o.ImportPolicy.AddLargeCommunities != nil {
+			cp.ImportPolicy.AddLargeCommunities = make([]string, len(o.ImportPolicy.AddLargeCommunities))
+			copy(cp.ImportPolicy.AddLargeCommunities, o.ImportPolicy.AddLargeCommunities)
+		}
+	}
+	if o.ExportPolicy != nil {
+		cp.ExportPolicy = new(BGPRoutePolicySpec)
+		*cp.ExportPolicy = *o.ExportPolicy
+		if o.ExportPolicy.Prefixes != nil {
+			cp.ExportPolicy.Prefixes = make([]BGPPrefixFilterSpec, len(o.ExportPolicy.Prefixes))
+			copy(cp.ExportPolicy.Prefixes, o.ExportPolicy.Prefixes)
+		}
+		if o.ExportPolicy.MatchCommunities != nil {
+			cp.ExportPolicy.MatchCommunities = make([]string, len(o.ExportPolicy.MatchCommunities))
+			copy(cp.ExportPolicy.MatchCommunities, o.ExportPolicy.MatchCommunities)
+		}
+		if o.ExportPolicy.AddCommunities != nil {
+			cp.ExportPolicy.AddCommunities = make([]string, len(o.ExportPolicy.AddCommunities))
+			copy(cp.ExportPolicy.AddCommunities, o.ExportPolicy.AddCommunities)
+		}
+		if o.ExportPolicy.AddLargeCommunities != nil {
+			cp.ExportPolicy.AddLargeCommunities = make([]string, len(o.ExportPolicy.AddLargeCommunities))
+			copy(cp.ExportPolicy.AddLargeCommunities, o.ExportPolicy.AddLargeCommunities)
+		}
+	}
 	return cp
 }
 
@@ -65,6 +105,14 @@ func (o BGPInstanceConfigSpec) DeepCopy() BGPInstanceConfigSpec {
 			}
 		}
 	}
+	if o.GracefulRestart != nil {
+		cp.GracefulRestart = new(BGPGracefulRestartSpec)
+		*cp.GracefulRestart = *o.GracefulRestart
+	}
+	if o.AdvertiseProbes != nil {
+		cp.AdvertiseProbes = make([]BGPAdvertiseProbeSpec, len(o.AdvertiseProbes))
+		copy(cp.AdvertiseProbes, o.AdvertiseProbes)
+	}
 	return cp
 }
 
@@ -96,6 +144,22 @@ func (o BondMasterSpec) DeepCopy() BondMasterSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of DelegatedPrefixSpec.
+func (o DelegatedPrefixSpec) DeepCopy() DelegatedPrefixSpec {
+	var cp DelegatedPrefixSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of DHCPOptionsSpec.
+func (o DHCPOptionsSpec) DeepCopy() DHCPOptionsSpec {
+	var cp DHCPOptionsSpec = o
+	if o.Options != nil {
+		cp.Options = make([]DHCPOption, len(o.Options))
+		copy(cp.Options, o.Options)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DNSResolveCacheSpec.
 func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
 	var cp DNSResolveCacheSpec = o
@@ -317,6 +381,20 @@ func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
 		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
 		copy(cp.ListenAddresses, o.ListenAddresses)
 	}
+	if o.Forwarders != nil {
+		cp.Forwarders = make([]HostDNSForwarderSpec, len(o.Forwarders))
+		copy(cp.Forwarders, o.Forwarders)
+		for i2 := range o.Forwarders {
+			if o.Forwarders[i2].Domains != nil {
+				cp.Forwarders[i2].Domains = make([]string, len(o.Forwarders[i2].Domains))
+				copy(cp.Forwarders[i2].Domains, o.Forwarders[i2].Domains)
+			}
+			if o.Forwarders[i2].NameServers != nil {
+				cp.Forwarders[i2].NameServers = make([]NameServerSpec, len(o.Forwarders[i2].NameServers))
+				copy(cp.Forwarders[i2].NameServers, o.Forwarders[i2].NameServers)
+			}
+		}
+	}
 	return cp
 }
 
@@ -493,6 +571,14 @@ func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
 				cp.Rules[i2].MatchLimit = new(NfTablesLimitMatch)
 				*cp.Rules[i2].MatchLimit = *o.Rules[i2].MatchLimit
 			}
+			if o.Rules[i2].MatchSourceRateLimit != nil {
+				cp.Rules[i2].MatchSourceRateLimit = new(NfTablesSourceRateLimitMatch)
+				*cp.Rules[i2].MatchSourceRateLimit = *o.Rules[i2].MatchSourceRateLimit
+			}
+			if o.Rules[i2].MatchSourceConnectionLimit != nil {
+				cp.Rules[i2].MatchSourceConnectionLimit = new(NfTablesSourceConnectionLimitMatch)
+				*cp.Rules[i2].MatchSourceConnectionLimit = *o.Rules[i2].MatchSourceConnectionLimit
+			}
 			if o.Rules[i2].ClampMSS != nil {
 				cp.Rules[i2].ClampMSS = new(NfTablesClampMSS)
 				*cp.Rules[i2].ClampMSS = *o.Rules[i2].ClampMSS
@@ -501,6 +587,14 @@ func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
 				cp.Rules[i2].SetMark = new(NfTablesMark)
 				*cp.Rules[i2].SetMark = *o.Rules[i2].SetMark
 			}
+			if o.Rules[i2].SourceNAT != nil {
+				cp.Rules[i2].SourceNAT = new(NfTablesNAT)
+				*cp.Rules[i2].SourceNAT = *o.Rules[i2].SourceNAT
+			}
+			if o.Rules[i2].DestinationNAT != nil {
+				cp.Rules[i2].DestinationNAT = new(NfTablesNAT)
+				*cp.Rules[i2].DestinationNAT = *o.Rules[i2].DestinationNAT
+			}
 			if o.Rules[i2].Verdict != nil {
 				cp.Rules[i2].Verdict = new(nethelpers.NfTablesVerdict)
 				*cp.Rules[i2].Verdict = *o.Rules[i2].Verdict
@@ -510,6 +604,16 @@ func (o NfTablesChainSpec) DeepCopy() NfTablesChainSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NfTablesChainStatusSpec.
+func (o NfTablesChainStatusSpec) DeepCopy() NfTablesChainStatusSpec {
+	var cp NfTablesChainStatusSpec = o
+	if o.Rules != nil {
+		cp.Rules = make([]NfTablesRuleCounter, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeAddressSpec.
 func (o NodeAddressSpec) DeepCopy() NodeAddressSpec {
 	var cp NodeAddressSpec = o
@@ -543,6 +647,18 @@ func (o NodeAddressFilterSpec) DeepCopy() NodeAddressFilterSpec {
 // DeepCopy generates a deep copy of OperatorSpecSpec.
 func (o OperatorSpecSpec) DeepCopy() OperatorSpecSpec {
 	var cp OperatorSpecSpec = o
+	if o.DHCP4.RequestOptions != nil {
+		cp.DHCP4.RequestOptions = make([]uint32, len(o.DHCP4.RequestOptions))
+		copy(cp.DHCP4.RequestOptions, o.DHCP4.RequestOptions)
+	}
+	if o.DHCP4.UserClass != nil {
+		cp.DHCP4.UserClass = make([]string, len(o.DHCP4.UserClass))
+		copy(cp.DHCP4.UserClass, o.DHCP4.UserClass)
+	}
+	if o.DHCP6.PrefixDownstreams != nil {
+		cp.DHCP6.PrefixDownstreams = make([]DHCP6PrefixDownstreamSpec, len(o.DHCP6.PrefixDownstreams))
+		copy(cp.DHCP6.PrefixDownstreams, o.DHCP6.PrefixDownstreams)
+	}
 	return cp
 }
 
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DelegatedPrefixType is type of DelegatedPrefix resource.
+const DelegatedPrefixType = resource.Type("DelegatedPrefixes.net.talos.dev")
+
+// DelegatedPrefix resource holds a subnet of the DHCPv6 delegated prefix assigned to a downstream link.
+type DelegatedPrefix = typed.Resource[DelegatedPrefixSpec, DelegatedPrefixExtension]
+
+// DelegatedPrefixSpec describes a subnet of the DHCPv6 delegated prefix assigned to a downstream link.
+//
+//gotagsrewrite:gen
+type DelegatedPrefixSpec struct {
+	// DelegatedPrefix is the whole prefix delegated by the upstream server.
+	DelegatedPrefix netip.Prefix `yaml:"delegatedPrefix" protobuf:"1"`
+	// Prefix is the /64 subnet assigned to the downstream link.
+	Prefix netip.Prefix `yaml:"prefix" protobuf:"2"`
+	// LinkName is the downstream link.
+	LinkName string `yaml:"linkName" protobuf:"3"`
+	// UpstreamLinkName is the link the prefix was delegated on.
+	UpstreamLinkName string    `yaml:"upstreamLinkName" protobuf:"4"`
+	PreferredUntil   time.Time `yaml:"preferredUntil" protobuf:"5"`
+	ValidUntil       time.Time `yaml:"validUntil" protobuf:"6"`
+}
+
+// NewDelegatedPrefix initializes a DelegatedPrefix resource.
+func NewDelegatedPrefix(namespace resource.Namespace, id resource.ID) *DelegatedPrefix {
+	return typed.NewResource[DelegatedPrefixSpec, DelegatedPrefixExtension](
+		resource.NewMetadata(namespace, DelegatedPrefixType, id, resource.VersionUndefined),
+		DelegatedPrefixSpec{},
+	)
+}
+
+// DelegatedPrefixExtension provides auxiliary methods for DelegatedPrefix.
+type DelegatedPrefixExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DelegatedPrefixExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DelegatedPrefixType,
+		Aliases:          []resource.Type{"delegatedprefix", "delegatedprefixes"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Prefix",
+				JSONPath: "{.prefix}",
+			},
+			{
+				Name:     "Upstream",
+				JSONPath: "{.upstreamLinkName}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DelegatedPrefixSpec](DelegatedPrefixType, &DelegatedPrefix{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"encoding/hex"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// DHCPOptionsType is type of DHCPOptions resource.
+const DHCPOptionsType = resource.Type("DHCPOptions.net.talos.dev")
+
+// DHCPOptions resource holds raw options received from the DHCP server.
+type DHCPOptions = typed.Resource[DHCPOptionsSpec, DHCPOptionsExtension]
+
+// DHCPOptionsSpec describes the raw options received from the DHCP server.
+//
+//gotagsrewrite:gen
+type DHCPOptionsSpec struct {
+	LinkName string       `yaml:"linkName" protobuf:"1"`
+	Operator Operator     `yaml:"operator" protobuf:"2"`
+	Options  []DHCPOption `yaml:"options,omitempty" protobuf:"3"`
+}
+
+// DHCPOption describes a single raw DHCP option.
+//
+//gotagsrewrite:gen
+type DHCPOption struct {
+	// Code of the option.
+	Code uint32 `yaml:"code" protobuf:"1"`
+	// Value of the option, hex-encoded.
+	Value string `yaml:"value" protobuf:"2"`
+	// Value of the option as text, set only if the value is printable.
+	Text string `yaml:"text,omitempty" protobuf:"3"`
+}
+
+// NewDHCPOption builds a DHCPOption from the raw option value.
+func NewDHCPOption(code uint32, value []byte) DHCPOption {
+	option := DHCPOption{
+		Code:  code,
+		Value: hex.EncodeToString(value),
+	}
+
+	if len(value) > 0 && utf8.Valid(value) {
+		printable := true
+
+		for _, r := range string(value) {
+			if !unicode.IsPrint(r) {
+				printable = false
+
+				break
+			}
+		}
+
+		if printable {
+			option.Text = string(value)
+		}
+	}
+
+	return option
+}
+
+// NewDHCPOptions initializes a DHCPOptions resource.
+func NewDHCPOptions(namespace resource.Namespace, id resource.ID) *DHCPOptions {
+	return typed.NewResource[DHCPOptionsSpec, DHCPOptionsExtension](
+		resource.NewMetadata(namespace, DHCPOptionsType, id, resource.VersionUndefined),
+		DHCPOptionsSpec{},
+	)
+}
+
+// DHCPOptionsExtension provides auxiliary methods for DHCPOptions.
+type DHCPOptionsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DHCPOptionsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DHCPOptionsType,
+		Aliases:          []resource.Type{"dhcpoption", "dhcpoptions"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "Operator",
+				JSONPath: "{.operator}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[DHCPOptionsSpec](DHCPOptionsType, &DHCPOptions{})
+	if err != nil {
+		panic(err)
+	}
+}
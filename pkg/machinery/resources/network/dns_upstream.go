@@ -6,6 +6,7 @@ package network
 
 import (
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -27,16 +28,30 @@ type DNSUpstreamSpec = handle.ResourceSpec[DNSUpstreamSpecSpec]
 // DNSUpstreamSpecSpec describes DNS upstreams status.
 type DNSUpstreamSpecSpec struct {
 	Conn *DNSConn
+
+	// Domains is set for the upstreams of the per-domain forwarders.
+	Domains []string
 }
 
 // MarshalYAML implements yaml.Marshaler interface.
 func (d DNSUpstreamSpecSpec) MarshalYAML() (any, error) {
 	d.Conn.Healthcheck()
 
-	return map[string]string{
-		"healthy": strconv.FormatBool(d.Conn.Fails() == 0),
-		"addr":    d.Conn.Addr(),
-	}, nil
+	stats := d.Conn.Stats()
+
+	res := map[string]string{
+		"healthy":    strconv.FormatBool(d.Conn.Fails() == 0),
+		"addr":       d.Conn.Addr(),
+		"queries":    strconv.FormatUint(stats.Queries, 10),
+		"errors":     strconv.FormatUint(stats.Errors, 10),
+		"avgLatency": stats.AvgLatency.String(),
+	}
+
+	if len(d.Domains) > 0 {
+		res["domains"] = strings.Join(d.Domains, ",")
+	}
+
+	return res, nil
 }
 
 // NewDNSUpstream initializes a DNSUpstream resource.
@@ -65,6 +80,22 @@ func (DNSUpstreamExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Address",
 				JSONPath: "{.addr}",
 			},
+			{
+				Name:     "Domains",
+				JSONPath: "{.domains}",
+			},
+			{
+				Name:     "Queries",
+				JSONPath: "{.queries}",
+			},
+			{
+				Name:     "Errors",
+				JSONPath: "{.errors}",
+			},
+			{
+				Name:     "Avg Latency",
+				JSONPath: "{.avgLatency}",
+			},
 		},
 	}
 }
@@ -82,6 +113,11 @@ type Proxy interface {
 // DNSConn is a wrapper around a Proxy.
 type DNSConn struct {
 	counter atomic.Int64
+
+	queries      atomic.Uint64
+	errors       atomic.Uint64
+	totalLatency atomic.Int64
+
 	// Proxy is essentially a *proxy.Proxy interface. It's here because we don't want machinery to depend on coredns.
 	// We could use a generic struct here, but without generic aliases the usage would look ugly.
 	// Once generic aliases are here, redo the type above as `type DNSUpstream[P Proxy] = typed.Resource[...]`.
@@ -111,6 +147,40 @@ func (u *DNSConn) Proxy() Proxy { return u.proxy }
 // Healthcheck kicks of a round of health checks for this DNSConn.
 func (u *DNSConn) Healthcheck() { u.proxy.Healthcheck() }
 
+// ObserveQuery records the outcome of a query sent to the upstream.
+func (u *DNSConn) ObserveQuery(latency time.Duration, failed bool) {
+	u.queries.Add(1)
+
+	if failed {
+		u.errors.Add(1)
+
+		return
+	}
+
+	u.totalLatency.Add(int64(latency))
+}
+
+// DNSConnStats describes the queries sent to the upstream.
+type DNSConnStats struct {
+	Queries uint64
+	Errors  uint64
+	// AvgLatency is the average latency of the successful queries.
+	AvgLatency time.Duration
+}
+
+// Stats returns the query statistics of the DNSConn.
+func (u *DNSConn) Stats() DNSConnStats {
+	// load errors first: queries are counted before errors, so the number of queries is never below
+	stats := DNSConnStats{Errors: u.errors.Load()}
+	stats.Queries = u.queries.Load()
+
+	if succeeded := stats.Queries - stats.Errors; succeeded > 0 {
+		stats.AvgLatency = (time.Duration(u.totalLatency.Load()) / time.Duration(succeeded)).Round(time.Microsecond)
+	}
+
+	return stats
+}
+
 // Close stops the DNSConn.
 func (u *DNSConn) Close() {
 	if u.counter.Add(-1) == 0 {
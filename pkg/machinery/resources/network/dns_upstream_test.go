@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type fakeProxy struct{}
+
+func (fakeProxy) Addr() string          { return "1.1.1.1:53" }
+func (fakeProxy) Fails() uint32         { return 0 }
+func (fakeProxy) Healthcheck()          {}
+func (fakeProxy) Close()                {}
+func (fakeProxy) Start(_ time.Duration) {}
+
+func TestDNSConnStats(t *testing.T) {
+	t.Parallel()
+
+	conn := network.NewDNSConn(fakeProxy{})
+	defer conn.Close()
+
+	assert.Equal(t, network.DNSConnStats{}, conn.Stats())
+
+	conn.ObserveQuery(10*time.Millisecond, false)
+	conn.ObserveQuery(30*time.Millisecond, false)
+	conn.ObserveQuery(time.Second, true)
+
+	assert.Equal(t, network.DNSConnStats{
+		Queries:    3,
+		Errors:     1,
+		AvgLatency: 20 * time.Millisecond,
+	}, conn.Stats())
+
+	// stats are shared between the references
+	conn.NewRef().ObserveQuery(time.Millisecond, true)
+
+	assert.EqualValues(t, 4, conn.Stats().Queries)
+}
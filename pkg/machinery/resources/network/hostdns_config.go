@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -33,6 +34,22 @@ type HostDNSConfigSpec struct {
 	ServiceHostDNSAddress   netip.Addr       `yaml:"serviceHostDNSAddress,omitempty" protobuf:"3"`
 	ResolveMemberNames      bool             `yaml:"resolveMemberNames,omitempty" protobuf:"4"`
 	ServiceHostDNSAddressV6 netip.Addr       `yaml:"serviceHostDNSAddressV6,omitempty" protobuf:"5"`
+
+	Forwarders []HostDNSForwarderSpec `yaml:"forwarders,omitempty" protobuf:"6"`
+
+	QueryLog            bool          `yaml:"queryLog,omitempty" protobuf:"7"`
+	NegativeCacheSize   int           `yaml:"negativeCacheSize" protobuf:"8"`
+	NegativeCacheMinTTL time.Duration `yaml:"negativeCacheMinTTL" protobuf:"9"`
+	NegativeCacheMaxTTL time.Duration `yaml:"negativeCacheMaxTTL" protobuf:"10"`
+}
+
+// HostDNSForwarderSpec describes a host DNS per-domain forwarder.
+//
+//gotagsrewrite:gen
+type HostDNSForwarderSpec struct {
+	// Domains are matched together with all their subdomains.
+	Domains     []string         `yaml:"domains" protobuf:"1"`
+	NameServers []NameServerSpec `yaml:"nameServers" protobuf:"2"`
 }
 
 // NewHostDNSConfig initializes a HostDNSConfig resource.
@@ -24,6 +24,22 @@ type VLANSpec struct {
 	Protocol nethelpers.VLANProtocol `yaml:"vlanProtocol" protobuf:"2"`
 }
 
+// MACVLANSpec describes MACVLAN settings if Kind == "macvlan".
+//
+//gotagsrewrite:gen
+type MACVLANSpec struct {
+	// Mode is the MACVLAN mode.
+	Mode nethelpers.MACVLANMode `yaml:"mode" protobuf:"1"`
+}
+
+// IPVLANSpec describes IPVLAN settings if Kind == "ipvlan".
+//
+//gotagsrewrite:gen
+type IPVLANSpec struct {
+	// Mode is the IPVLAN mode.
+	Mode nethelpers.IPVLANMode `yaml:"mode" protobuf:"1"`
+}
+
 // BondMasterSpec describes bond settings if Kind == "bond".
 //
 //gotagsrewrite:gen
@@ -315,6 +331,52 @@ type VRFMasterSpec struct {
 	Table nethelpers.RoutingTable `yaml:"table" protobuf:"1"`
 }
 
+// VXLANSpec describes VXLAN settings if Kind == "vxlan".
+//
+//gotagsrewrite:gen
+type VXLANSpec struct {
+	// VNI is the VXLAN network identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+	// Local is the source address of the tunnel, might be unset.
+	Local netip.Addr `yaml:"local,omitempty" protobuf:"2"`
+	// Remote is the remote tunnel endpoint or a multicast group address, might be unset.
+	Remote netip.Addr `yaml:"remote,omitempty" protobuf:"3"`
+	// Port is the destination UDP port.
+	Port uint16 `yaml:"port" protobuf:"4"`
+	// LinkIndex is the index of the underlay link.
+	//
+	// It is filled in by the link spec controller from the ParentName before the link is created.
+	LinkIndex uint32 `yaml:"linkIndex,omitempty" protobuf:"5"`
+}
+
+// GeneveSpec describes Geneve settings if Kind == "geneve".
+//
+//gotagsrewrite:gen
+type GeneveSpec struct {
+	// VNI is the Geneve network identifier.
+	VNI uint32 `yaml:"vni" protobuf:"1"`
+	// Remote is the remote tunnel endpoint.
+	Remote netip.Addr `yaml:"remote" protobuf:"2"`
+	// Port is the destination UDP port.
+	Port uint16 `yaml:"port" protobuf:"3"`
+}
+
+// GRESpec describes GRE settings if Kind is one of "gre", "gretap", "ip6gre" or "ip6gretap".
+//
+//gotagsrewrite:gen
+type GRESpec struct {
+	// Local is the source address of the tunnel, might be unset.
+	Local netip.Addr `yaml:"local,omitempty" protobuf:"1"`
+	// Remote is the remote tunnel endpoint.
+	Remote netip.Addr `yaml:"remote" protobuf:"2"`
+	// Key is the GRE key used in both directions, zero means no key.
+	Key uint32 `yaml:"key,omitempty" protobuf:"3"`
+	// LinkIndex is the index of the underlay link.
+	//
+	// It is filled in by the link spec controller from the ParentName before the link is created.
+	LinkIndex uint32 `yaml:"linkIndex,omitempty" protobuf:"4"`
+}
+
 // WireguardSpec describes Wireguard settings if Kind == "wireguard".
 //
 //gotagsrewrite:gen
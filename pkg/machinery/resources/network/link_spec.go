@@ -48,7 +48,7 @@ type LinkSpecSpec struct {
 	// Override hardware (MAC) address (if supported).
 	HardwareAddress nethelpers.HardwareAddr `yaml:"hardwareAddr,omitempty" protobuf:"15"`
 
-	// ParentName indicates link parent for VLAN interfaces.
+	// ParentName indicates link parent for VLAN, MACVLAN and IPVLAN interfaces, or the underlay link for tunnel interfaces.
 	ParentName string `yaml:"parentName,omitempty" protobuf:"7"`
 
 	// BondSlave contains bond slave configuration for interfaces enslaved to a bond.
@@ -67,6 +67,11 @@ type LinkSpecSpec struct {
 	VRFMaster    VRFMasterSpec    `yaml:"vrfMaster,omitempty" protobuf:"17"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"13"`
 	Veth         VethSpec         `yaml:"veth,omitempty" protobuf:"19"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"20"`
+	Geneve       GeneveSpec       `yaml:"geneve,omitempty" protobuf:"21"`
+	GRE          GRESpec          `yaml:"gre,omitempty" protobuf:"22"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"23"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"24"`
 
 	// Configuration layer.
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"14"`
@@ -124,6 +129,11 @@ func (spec *LinkSpecSpec) Merge(other *LinkSpecSpec) error {
 	updateIfNotZero(&spec.VRFMaster, other.VRFMaster)
 	updateIfNotZero(&spec.VRFSlave, other.VRFSlave)
 	updateIfNotZero(&spec.Veth, other.Veth)
+	updateIfNotZero(&spec.VXLAN, other.VXLAN)
+	updateIfNotZero(&spec.Geneve, other.Geneve)
+	updateIfNotZero(&spec.GRE, other.GRE)
+	updateIfNotZero(&spec.MACVLAN, other.MACVLAN)
+	updateIfNotZero(&spec.IPVLAN, other.IPVLAN)
 
 	if !other.BondMaster.IsZero() {
 		spec.BondMaster = other.BondMaster.DeepCopy()
@@ -63,6 +63,11 @@ type LinkStatusSpec struct {
 	VRFMaster    VRFMasterSpec    `yaml:"vrfMaster,omitempty" protobuf:"33"`
 	Wireguard    WireguardSpec    `yaml:"wireguard,omitempty" protobuf:"29"`
 	Veth         VethSpec         `yaml:"veth,omitempty" protobuf:"34"`
+	VXLAN        VXLANSpec        `yaml:"vxlan,omitempty" protobuf:"35"`
+	Geneve       GeneveSpec       `yaml:"geneve,omitempty" protobuf:"36"`
+	GRE          GRESpec          `yaml:"gre,omitempty" protobuf:"37"`
+	MACVLAN      MACVLANSpec      `yaml:"macvlan,omitempty" protobuf:"38"`
+	IPVLAN       IPVLANSpec       `yaml:"ipvlan,omitempty" protobuf:"39"`
 }
 
 // Physical checks if the link is physical ethernet.
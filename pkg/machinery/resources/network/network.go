@@ -89,4 +89,12 @@ const (
 	LinkKindVRF       = "vrf"
 	LinkKindVeth      = "veth"
 	LinkKindWireguard = "wireguard"
+	LinkKindVXLAN     = "vxlan"
+	LinkKindGeneve    = "geneve"
+	LinkKindGRE       = "gre"
+	LinkKindGRETAP    = "gretap"
+	LinkKindIP6GRE    = "ip6gre"
+	LinkKindIP6GRETAP = "ip6gretap"
+	LinkKindMACVLAN   = "macvlan"
+	LinkKindIPVLAN    = "ipvlan"
 )
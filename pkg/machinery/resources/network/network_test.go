@@ -35,6 +35,8 @@ func TestRegisterResource(t *testing.T) {
 		&network.AddressSpec{},
 		&network.BGPInstanceConfig{},
 		&network.HardwareAddr{},
+		&network.DHCPOptions{},
+		&network.DelegatedPrefix{},
 		&network.DNSUpstream{},
 		&network.EthernetSpec{},
 		&network.EthernetStatus{},
@@ -46,6 +48,7 @@ func TestRegisterResource(t *testing.T) {
 		&network.LinkStatus{},
 		&network.LinkSpec{},
 		&network.NfTablesChain{},
+		&network.NfTablesChainStatus{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
 		&network.NodeAddressSortAlgorithm{},
@@ -96,6 +99,10 @@ func TestProtobufInterop(t *testing.T) {
 			res:  &network.NfTablesChain{},
 			spec: &networkpb.NfTablesChainSpec{},
 		},
+		{
+			res:  &network.NfTablesChainStatus{},
+			spec: &networkpb.NfTablesChainStatusSpec{},
+		},
 		{
 			res:  &network.OperatorSpec{},
 			spec: &networkpb.OperatorSpecSpec{},
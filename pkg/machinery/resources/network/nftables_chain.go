@@ -47,10 +47,15 @@ type NfTablesRule struct {
 	MatchLayer4             *NfTablesLayer4Match         `yaml:"matchLayer4,omitempty" protobuf:"7"`
 	MatchLimit              *NfTablesLimitMatch          `yaml:"matchLimit,omitempty" protobuf:"10"`
 
-	ClampMSS    *NfTablesClampMSS           `yaml:"clampMSS,omitempty" protobuf:"9"`
-	SetMark     *NfTablesMark               `yaml:"setMark,omitempty" protobuf:"4"`
-	AnonCounter bool                        `yaml:"anonymousCounter,omitempty" protobuf:"12"`
-	Verdict     *nethelpers.NfTablesVerdict `yaml:"verdict,omitempty" protobuf:"2"`
+	MatchSourceRateLimit       *NfTablesSourceRateLimitMatch       `yaml:"matchSourceRateLimit,omitempty" protobuf:"15"`
+	MatchSourceConnectionLimit *NfTablesSourceConnectionLimitMatch `yaml:"matchSourceConnectionLimit,omitempty" protobuf:"16"`
+
+	ClampMSS       *NfTablesClampMSS           `yaml:"clampMSS,omitempty" protobuf:"9"`
+	SetMark        *NfTablesMark               `yaml:"setMark,omitempty" protobuf:"4"`
+	AnonCounter    bool                        `yaml:"anonymousCounter,omitempty" protobuf:"12"`
+	SourceNAT      *NfTablesNAT                `yaml:"sourceNAT,omitempty" protobuf:"13"`
+	DestinationNAT *NfTablesNAT                `yaml:"destinationNAT,omitempty" protobuf:"14"`
+	Verdict        *nethelpers.NfTablesVerdict `yaml:"verdict,omitempty" protobuf:"2"`
 }
 
 // NfTablesIfNameMatch describes the match on the interface name.
@@ -130,6 +135,17 @@ type NfTablesClampMSS struct {
 	MTU uint16 `yaml:"mtu" protobuf:"1"`
 }
 
+// NfTablesNAT describes the network address translation operation.
+//
+// NAT is a terminal operation, so the rule should not have a verdict.
+// The source NAT without an address masquerades the traffic (uses the address of the outgoing interface).
+//
+//gotagsrewrite:gen
+type NfTablesNAT struct {
+	Address netip.Addr `yaml:"address,omitempty" protobuf:"1"`
+	Port    uint16     `yaml:"port,omitempty" protobuf:"2"`
+}
+
 // NfTablesLimitMatch describes the match on the packet rate.
 //
 //gotagsrewrite:gen
@@ -137,6 +153,29 @@ type NfTablesLimitMatch struct {
 	PacketRatePerSecond uint64 `yaml:"packetRatePerSecond" protobuf:"1"`
 }
 
+// NfTablesSourceRateLimitMatch matches packets from the sources exceeding the packet rate.
+//
+// The rate is tracked per source prefix of the specified length in a dynamic set (meter).
+//
+//gotagsrewrite:gen
+type NfTablesSourceRateLimitMatch struct {
+	PacketRatePerSecond uint64 `yaml:"packetRatePerSecond" protobuf:"1"`
+	Burst               uint32 `yaml:"burst,omitempty" protobuf:"2"`
+	SourcePrefixLength4 int    `yaml:"sourcePrefixLength4" protobuf:"3"`
+	SourcePrefixLength6 int    `yaml:"sourcePrefixLength6" protobuf:"4"`
+}
+
+// NfTablesSourceConnectionLimitMatch matches packets from the sources exceeding the number of tracked connections.
+//
+// The connection count is tracked per source prefix of the specified length in a dynamic set (meter).
+//
+//gotagsrewrite:gen
+type NfTablesSourceConnectionLimitMatch struct {
+	MaxConnections      uint32 `yaml:"maxConnections" protobuf:"1"`
+	SourcePrefixLength4 int    `yaml:"sourcePrefixLength4" protobuf:"2"`
+	SourcePrefixLength6 int    `yaml:"sourcePrefixLength6" protobuf:"3"`
+}
+
 // NfTablesConntrackStateMatch describes the match on the connection tracking state.
 //
 //gotagsrewrite:gen
@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// NfTablesChainStatusType is type of NfTablesChainStatus resource.
+const NfTablesChainStatusType = resource.Type("NfTablesChainStatuses.net.talos.dev")
+
+// NfTablesChainStatus resource holds the observed counters of the nftables chain.
+type NfTablesChainStatus = typed.Resource[NfTablesChainStatusSpec, NfTablesChainStatusExtension]
+
+// NfTablesChainStatusSpec describes the counters of the nftables chain.
+//
+// Only the rules with the anonymous counter are accounted, the dropped packets include the packets
+// dropped by the drop policy of the chain.
+//
+//gotagsrewrite:gen
+type NfTablesChainStatusSpec struct {
+	DroppedPackets uint64 `yaml:"droppedPackets" protobuf:"1"`
+	DroppedBytes   uint64 `yaml:"droppedBytes" protobuf:"2"`
+
+	Rules []NfTablesRuleCounter `yaml:"rules,omitempty" protobuf:"3"`
+}
+
+// NfTablesRuleCounter describes the counter of a single rule in the nftables chain.
+//
+//gotagsrewrite:gen
+type NfTablesRuleCounter struct {
+	// Index of the rule in the NfTablesChain spec.
+	Index   int    `yaml:"index" protobuf:"1"`
+	Packets uint64 `yaml:"packets" protobuf:"2"`
+	Bytes   uint64 `yaml:"bytes" protobuf:"3"`
+}
+
+// NewNfTablesChainStatus initializes a NfTablesChainStatus resource.
+func NewNfTablesChainStatus(namespace resource.Namespace, id resource.ID) *NfTablesChainStatus {
+	return typed.NewResource[NfTablesChainStatusSpec, NfTablesChainStatusExtension](
+		resource.NewMetadata(namespace, NfTablesChainStatusType, id, resource.VersionUndefined),
+		NfTablesChainStatusSpec{},
+	)
+}
+
+// NfTablesChainStatusExtension provides auxiliary methods for NfTablesChainStatus.
+type NfTablesChainStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NfTablesChainStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NfTablesChainStatusType,
+		Aliases:          []resource.Type{"chainstatus", "chainstatuses"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Dropped Packets",
+				JSONPath: `{.droppedPackets}`,
+			},
+			{
+				Name:     "Dropped Bytes",
+				JSONPath: `{.droppedBytes}`,
+			},
+		},
+		Sensitivity: meta.NonSensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NfTablesChainStatusSpec](NfTablesChainStatusType, &NfTablesChainStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
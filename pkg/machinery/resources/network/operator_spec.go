@@ -6,6 +6,7 @@ package network
 
 import (
 	"net/netip"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -41,9 +42,12 @@ type OperatorSpecSpec struct {
 // Equal implements equality check for OperatorSpecSpec.
 func (spec OperatorSpecSpec) Equal(other OperatorSpecSpec) bool {
 	// config layer is not important for equality check
-	spec.ConfigLayer = other.ConfigLayer
-
-	return spec == other
+	return spec.Operator == other.Operator &&
+		spec.LinkName == other.LinkName &&
+		spec.RequireUp == other.RequireUp &&
+		spec.DHCP4.Equal(other.DHCP4) &&
+		spec.DHCP6.Equal(other.DHCP6) &&
+		spec.VIP == other.VIP
 }
 
 // ClientIdentifierSpec is a shared DHCP4/DHCP6 client identifier spec.
@@ -62,6 +66,20 @@ type DHCP4OperatorSpec struct {
 	SkipHostnameRequest bool                 `yaml:"skipHostnameRequest,omitempty" protobuf:"2"`
 	ClientIdentifier    ClientIdentifierSpec `yaml:"clientIdentifier,omitempty" protobuf:"3"`
 	SkipRoutes          bool                 `yaml:"skipRoutes,omitempty" protobuf:"4"`
+	RequestOptions      []uint32             `yaml:"requestOptions,omitempty" protobuf:"5"`
+	VendorClass         string               `yaml:"vendorClass,omitempty" protobuf:"6"`
+	UserClass           []string             `yaml:"userClass,omitempty" protobuf:"7"`
+}
+
+// Equal implements equality check for DHCP4OperatorSpec.
+func (spec DHCP4OperatorSpec) Equal(other DHCP4OperatorSpec) bool {
+	return spec.RouteMetric == other.RouteMetric &&
+		spec.SkipHostnameRequest == other.SkipHostnameRequest &&
+		spec.ClientIdentifier == other.ClientIdentifier &&
+		spec.SkipRoutes == other.SkipRoutes &&
+		slices.Equal(spec.RequestOptions, other.RequestOptions) &&
+		spec.VendorClass == other.VendorClass &&
+		slices.Equal(spec.UserClass, other.UserClass)
 }
 
 // DHCP6OperatorSpec describes DHCP6 operator options.
@@ -71,6 +89,31 @@ type DHCP6OperatorSpec struct {
 	RouteMetric         uint32               `yaml:"routeMetric" protobuf:"2"`
 	SkipHostnameRequest bool                 `yaml:"skipHostnameRequest,omitempty" protobuf:"3"`
 	ClientIdentifier    ClientIdentifierSpec `yaml:"clientIdentifier,omitempty" protobuf:"4"`
+
+	// PrefixDelegation enables requesting a delegated prefix (IA_PD).
+	PrefixDelegation bool `yaml:"prefixDelegation,omitempty" protobuf:"5"`
+	// PrefixLength is the prefix length hint sent to the server, zero means no hint.
+	PrefixLength uint32 `yaml:"prefixLength,omitempty" protobuf:"6"`
+	// PrefixDownstreams lists links which get a /64 subnet of the delegated prefix.
+	PrefixDownstreams []DHCP6PrefixDownstreamSpec `yaml:"prefixDownstreams,omitempty" protobuf:"7"`
+}
+
+// Equal implements equality check for DHCP6OperatorSpec.
+func (spec DHCP6OperatorSpec) Equal(other DHCP6OperatorSpec) bool {
+	return spec.RouteMetric == other.RouteMetric &&
+		spec.SkipHostnameRequest == other.SkipHostnameRequest &&
+		spec.ClientIdentifier == other.ClientIdentifier &&
+		spec.PrefixDelegation == other.PrefixDelegation &&
+		spec.PrefixLength == other.PrefixLength &&
+		slices.Equal(spec.PrefixDownstreams, other.PrefixDownstreams)
+}
+
+// DHCP6PrefixDownstreamSpec describes a downstream link of the delegated prefix.
+//
+//gotagsrewrite:gen
+type DHCP6PrefixDownstreamSpec struct {
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	SubnetID uint32 `yaml:"subnetID" protobuf:"2"`
 }
 
 // VIPOperatorSpec describes virtual IP operator options.
@@ -82,6 +125,9 @@ type VIPOperatorSpec struct {
 
 	EquinixMetal VIPEquinixMetalSpec `yaml:"equinixMetal,omitempty" protobuf:"3"`
 	HCloud       VIPHCloudSpec       `yaml:"hcloud,omitempty" protobuf:"4"`
+
+	// Probe, when set, releases the VIP while the named probe fails.
+	Probe string `yaml:"probe,omitempty" protobuf:"5"`
 }
 
 // VIPEquinixMetalSpec describes virtual (elastic) IP settings for Equinix Metal.
@@ -39,6 +39,8 @@ type ProbeSpecSpec struct {
 	ConfigLayer ConfigLayer `yaml:"layer" protobuf:"4"`
 	// HTTP is the HTTP probe spec. One of TCP or HTTP must be specified.
 	HTTP HTTPProbeSpec `yaml:"http,omitempty" protobuf:"5"`
+	// Name of the probe as configured in the machine configuration.
+	Name string `yaml:"name,omitempty" protobuf:"6"`
 }
 
 // ID returns the ID of the resource based on the spec.
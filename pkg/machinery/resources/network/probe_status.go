@@ -27,6 +27,8 @@ type ProbeStatusSpec struct {
 	Success bool `yaml:"success" protobuf:"1"`
 	// Last error of the probe.
 	LastError string `yaml:"lastError" protobuf:"2"`
+	// Name of the probe as configured in the machine configuration.
+	Name string `yaml:"name,omitempty" protobuf:"3"`
 }
 
 // NewProbeStatus initializes a ProbeStatus resource.
@@ -47,6 +49,10 @@ func (ProbeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 		Aliases:          []resource.Type{"probe", "probes"},
 		DefaultNamespace: NamespaceName,
 		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: "{.name}",
+			},
 			{
 				Name:     "Success",
 				JSONPath: "{.success}",
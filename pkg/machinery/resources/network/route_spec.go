@@ -44,6 +44,8 @@ type RouteSpecSpec struct {
 	// NextHops, when non-empty, describes a multipath (ECMP) route. The top-level Gateway and
 	// OutLinkName are left unset in that case, mirroring the kernel's RTA_GATEWAY vs RTA_MULTIPATH split.
 	NextHops []RouteNextHop `yaml:"nextHops,omitempty" protobuf:"14"`
+	// Probe, when set, withdraws the route while the named probe fails.
+	Probe string `yaml:"probe,omitempty" protobuf:"15"`
 }
 
 // RouteNextHop describes a single next-hop of a (possibly multipath) route.
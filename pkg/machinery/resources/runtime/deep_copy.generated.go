@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type APIServiceConfigSpec -type BootIDSpec -type BootedEntrySpec -type DevicesStatusSpec -type DiagnosticSpec -type EnvironmentSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ImageFactorySchematicSpec -type KernelCmdlineSpec -type KernelModuleStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type OOMActionSpec -type PlatformMetadataSpec -type RebootRequestSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type ServicePIDSpec -type UnattendedInstallStatusSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type APIServiceConfigSpec -type BootIDSpec -type BootedEntrySpec -type DevicesStatusSpec -type DiagnosticSpec -type EnvironmentSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ImageFactorySchematicSpec -type KernelCmdlineSpec -type KernelModuleStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MetricsConfigSpec -type MountStatusSpec -type OOMActionSpec -type PlatformMetadataSpec -type RebootRequestSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type ServicePIDSpec -type UnattendedInstallStatusSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -142,6 +142,32 @@ func (o KmsgLogConfigSpec) DeepCopy() KmsgLogConfigSpec {
 			}
 		}
 	}
+	if o.SyslogDestinations != nil {
+		cp.SyslogDestinations = make([]KmsgLogSyslogDestination, len(o.SyslogDestinations))
+		copy(cp.SyslogDestinations, o.SyslogDestinations)
+		for i2 := range o.SyslogDestinations {
+			if o.SyslogDestinations[i2].Endpoint != nil {
+				cp.SyslogDestinations[i2].Endpoint = new(url.URL)
+				*cp.SyslogDestinations[i2].Endpoint = *o.SyslogDestinations[i2].Endpoint
+				if o.SyslogDestinations[i2].Endpoint.User != nil {
+					cp.SyslogDestinations[i2].Endpoint.User = new(url.Userinfo)
+					*cp.SyslogDestinations[i2].Endpoint.User = *o.SyslogDestinations[i2].Endpoint.User
+				}
+			}
+			if o.SyslogDestinations[i2].CA != nil {
+				cp.SyslogDestinations[i2].CA = make([]byte, len(o.SyslogDestinations[i2].CA))
+				copy(cp.SyslogDestinations[i2].CA, o.SyslogDestinations[i2].CA)
+			}
+			if o.SyslogDestinations[i2].ClientCert != nil {
+				cp.SyslogDestinations[i2].ClientCert = make([]byte, len(o.SyslogDestinations[i2].ClientCert))
+				copy(cp.SyslogDestinations[i2].ClientCert, o.SyslogDestinations[i2].ClientCert)
+			}
+			if o.SyslogDestinations[i2].ClientKey != nil {
+				cp.SyslogDestinations[i2].ClientKey = make([]byte, len(o.SyslogDestinations[i2].ClientKey))
+				copy(cp.SyslogDestinations[i2].ClientKey, o.SyslogDestinations[i2].ClientKey)
+			}
+		}
+	}
 	return cp
 }
 
@@ -193,6 +219,12 @@ func (o MetaKeySpec) DeepCopy() MetaKeySpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of MetricsConfigSpec.
+func (o MetricsConfigSpec) DeepCopy() MetricsConfigSpec {
+	var cp MetricsConfigSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of MountStatusSpec.
 func (o MountStatusSpec) DeepCopy() MountStatusSpec {
 	var cp MountStatusSpec = o
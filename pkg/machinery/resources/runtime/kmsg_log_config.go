@@ -28,7 +28,18 @@ const KmsgLogConfigID resource.ID = "kmsg-log"
 //
 //gotagsrewrite:gen
 type KmsgLogConfigSpec struct {
-	Destinations []*url.URL `yaml:"destinations" protobuf:"1"`
+	Destinations       []*url.URL                 `yaml:"destinations" protobuf:"1"`
+	SyslogDestinations []KmsgLogSyslogDestination `yaml:"syslogDestinations,omitempty" protobuf:"2"`
+}
+
+// KmsgLogSyslogDestination describes a syslog destination for kernel logs.
+//
+//gotagsrewrite:gen
+type KmsgLogSyslogDestination struct {
+	Endpoint   *url.URL `yaml:"endpoint" protobuf:"1"`
+	CA         []byte   `yaml:"ca,omitempty" protobuf:"2"`
+	ClientCert []byte   `yaml:"clientCert,omitempty" protobuf:"3"`
+	ClientKey  []byte   `yaml:"clientKey,omitempty" protobuf:"4"`
 }
 
 // NewKmsgLogConfig initializes a KmsgLogConfig resource.
@@ -48,6 +59,8 @@ func (KmsgLogConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 		Type:             KmsgLogConfigType,
 		Aliases:          []resource.Type{},
 		DefaultNamespace: NamespaceName,
+		// syslog destinations might carry the TLS client key
+		Sensitivity: meta.Sensitive,
 	}
 }
 
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// MetricsConfigType is type of MetricsConfig resource.
+const MetricsConfigType = resource.Type("MetricsConfigs.runtime.talos.dev")
+
+// MetricsConfig resource holds configuration for the Prometheus metrics endpoint.
+type MetricsConfig = typed.Resource[MetricsConfigSpec, MetricsConfigExtension]
+
+// MetricsConfigID is a resource ID for MetricsConfig.
+const MetricsConfigID resource.ID = "metrics"
+
+// MetricsConfigSpec describes configuration of the Prometheus metrics endpoint.
+//
+//gotagsrewrite:gen
+type MetricsConfigSpec struct {
+	ListenAddress string `yaml:"listenAddress" protobuf:"1"`
+}
+
+// NewMetricsConfig initializes a MetricsConfig resource.
+func NewMetricsConfig() *MetricsConfig {
+	return typed.NewResource[MetricsConfigSpec, MetricsConfigExtension](
+		resource.NewMetadata(NamespaceName, MetricsConfigType, MetricsConfigID, resource.VersionUndefined),
+		MetricsConfigSpec{},
+	)
+}
+
+// MetricsConfigExtension is auxiliary resource data for MetricsConfig.
+type MetricsConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (MetricsConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MetricsConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Listen Address",
+				JSONPath: `{.listenAddress}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[MetricsConfigSpec](MetricsConfigType, &MetricsConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
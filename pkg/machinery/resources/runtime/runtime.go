@@ -10,7 +10,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 )
 
-//go:generate go tool github.com/siderolabs/deep-copy -type APIServiceConfigSpec -type BootIDSpec -type BootedEntrySpec -type DevicesStatusSpec -type DiagnosticSpec -type EnvironmentSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ImageFactorySchematicSpec -type KernelCmdlineSpec -type KernelModuleStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type OOMActionSpec -type PlatformMetadataSpec -type RebootRequestSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type ServicePIDSpec -type UnattendedInstallStatusSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type APIServiceConfigSpec -type BootIDSpec -type BootedEntrySpec -type DevicesStatusSpec -type DiagnosticSpec -type EnvironmentSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type ImageFactorySchematicSpec -type KernelCmdlineSpec -type KernelModuleStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LoadedKernelModuleSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MetricsConfigSpec -type MountStatusSpec -type OOMActionSpec -type PlatformMetadataSpec -type RebootRequestSpec -type SecurityStateSpec -type MetaLoadedSpec -type SBOMItemSpec -type ServicePIDSpec -type UnattendedInstallStatusSpec -type UniqueMachineTokenSpec -type VersionSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
 //go:generate go tool github.com/dmarkham/enumer -type=MachineStage -type=KernelModuleState -type=KernelModuleType -type=FIPSState -type=SELinuxState -type=UnattendedInstallPhase -linecomment -text
 
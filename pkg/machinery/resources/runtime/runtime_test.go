@@ -44,6 +44,7 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.MaintenanceServiceRequest{},
 		&runtime.MetaKey{},
 		&runtime.MetaLoaded{},
+		&runtime.MetricsConfig{},
 		&runtime.MountStatus{},
 		&runtime.OOMAction{},
 		&runtime.PlatformMetadata{},
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type LVMLogicalVolumeSpecSpec -type LVMLogicalVolumeStatusSpec -type LVMPhysicalVolumeSpecSpec -type LVMPhysicalVolumeStatusSpec -type LVMRefreshRequestSpec -type LVMValidationErrorSpec -type LVMVolumeGroupSpecSpec -type LVMVolumeGroupStatusSpec -type MDArraySpecSpec -type MDArrayStatusSpec -type MDRefreshRequestSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type LVMLogicalVolumeSpecSpec -type LVMLogicalVolumeStatusSpec -type LVMPhysicalVolumeSpecSpec -type LVMPhysicalVolumeStatusSpec -type LVMRefreshRequestSpec -type LVMValidationErrorSpec -type LVMVolumeGroupSpecSpec -type LVMVolumeGroupStatusSpec -type MDArraySpecSpec -type MDArrayStatusSpec -type MDArraySyncStatusSpec -type MDRefreshRequestSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package storage
 
@@ -86,6 +86,12 @@ func (o MDArrayStatusSpec) DeepCopy() MDArrayStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of MDArraySyncStatusSpec.
+func (o MDArraySyncStatusSpec) DeepCopy() MDArraySyncStatusSpec {
+	var cp MDArraySyncStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of MDRefreshRequestSpec.
 func (o MDRefreshRequestSpec) DeepCopy() MDRefreshRequestSpec {
 	var cp MDRefreshRequestSpec = o
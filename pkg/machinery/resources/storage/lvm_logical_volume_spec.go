@@ -38,6 +38,8 @@ type LVMLogicalVolumeSpecSpec struct {
 	// Stripes is the stripe count for raid0/raid10 layouts; 0 means "all PVs",
 	// resolved by the reconcile controller.
 	Stripes uint32 `yaml:"stripes" protobuf:"7"`
+	// ThinPool is the name of the thin pool LV in the same VG backing a thin LV.
+	ThinPool string `yaml:"thinPool,omitempty" protobuf:"8"`
 }
 
 // NewLVMLogicalVolumeSpec initializes a LVMLogicalVolumeSpec resource.
@@ -11,8 +11,10 @@ type LVMLogicalVolumeType int
 //
 //structprotogen:gen_enum
 const (
-	LVMLogicalVolumeTypeLinear LVMLogicalVolumeType = iota // linear
-	LVMLogicalVolumeTypeRAID1                              // raid1
-	LVMLogicalVolumeTypeRAID0                              // raid0
-	LVMLogicalVolumeTypeRAID10                             // raid10
+	LVMLogicalVolumeTypeLinear   LVMLogicalVolumeType = iota // linear
+	LVMLogicalVolumeTypeRAID1                                // raid1
+	LVMLogicalVolumeTypeRAID0                                // raid0
+	LVMLogicalVolumeTypeRAID10                               // raid10
+	LVMLogicalVolumeTypeThinPool                             // thin-pool
+	LVMLogicalVolumeTypeThin                                 // thin
 )
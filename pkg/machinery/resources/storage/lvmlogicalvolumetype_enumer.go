@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _LVMLogicalVolumeTypeName = "linearraid1raid0raid10"
+const _LVMLogicalVolumeTypeName = "linearraid1raid0raid10thin-poolthin"
 
-var _LVMLogicalVolumeTypeIndex = [...]uint8{0, 6, 11, 16, 22}
+var _LVMLogicalVolumeTypeIndex = [...]uint8{0, 6, 11, 16, 22, 31, 35}
 
-const _LVMLogicalVolumeTypeLowerName = "linearraid1raid0raid10"
+const _LVMLogicalVolumeTypeLowerName = "linearraid1raid0raid10thin-poolthin"
 
 func (i LVMLogicalVolumeType) String() string {
 	if i < 0 || i >= LVMLogicalVolumeType(len(_LVMLogicalVolumeTypeIndex)-1) {
@@ -28,9 +28,11 @@ func _LVMLogicalVolumeTypeNoOp() {
 	_ = x[LVMLogicalVolumeTypeRAID1-(1)]
 	_ = x[LVMLogicalVolumeTypeRAID0-(2)]
 	_ = x[LVMLogicalVolumeTypeRAID10-(3)]
+	_ = x[LVMLogicalVolumeTypeThinPool-(4)]
+	_ = x[LVMLogicalVolumeTypeThin-(5)]
 }
 
-var _LVMLogicalVolumeTypeValues = []LVMLogicalVolumeType{LVMLogicalVolumeTypeLinear, LVMLogicalVolumeTypeRAID1, LVMLogicalVolumeTypeRAID0, LVMLogicalVolumeTypeRAID10}
+var _LVMLogicalVolumeTypeValues = []LVMLogicalVolumeType{LVMLogicalVolumeTypeLinear, LVMLogicalVolumeTypeRAID1, LVMLogicalVolumeTypeRAID0, LVMLogicalVolumeTypeRAID10, LVMLogicalVolumeTypeThinPool, LVMLogicalVolumeTypeThin}
 
 var _LVMLogicalVolumeTypeNameToValueMap = map[string]LVMLogicalVolumeType{
 	_LVMLogicalVolumeTypeName[0:6]:        LVMLogicalVolumeTypeLinear,
@@ -41,6 +43,10 @@ var _LVMLogicalVolumeTypeNameToValueMap = map[string]LVMLogicalVolumeType{
 	_LVMLogicalVolumeTypeLowerName[11:16]: LVMLogicalVolumeTypeRAID0,
 	_LVMLogicalVolumeTypeName[16:22]:      LVMLogicalVolumeTypeRAID10,
 	_LVMLogicalVolumeTypeLowerName[16:22]: LVMLogicalVolumeTypeRAID10,
+	_LVMLogicalVolumeTypeName[22:31]:      LVMLogicalVolumeTypeThinPool,
+	_LVMLogicalVolumeTypeLowerName[22:31]: LVMLogicalVolumeTypeThinPool,
+	_LVMLogicalVolumeTypeName[31:35]:      LVMLogicalVolumeTypeThin,
+	_LVMLogicalVolumeTypeLowerName[31:35]: LVMLogicalVolumeTypeThin,
 }
 
 var _LVMLogicalVolumeTypeNames = []string{
@@ -48,6 +54,8 @@ var _LVMLogicalVolumeTypeNames = []string{
 	_LVMLogicalVolumeTypeName[6:11],
 	_LVMLogicalVolumeTypeName[11:16],
 	_LVMLogicalVolumeTypeName[16:22],
+	_LVMLogicalVolumeTypeName[22:31],
+	_LVMLogicalVolumeTypeName[31:35],
 }
 
 // LVMLogicalVolumeTypeString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package storage
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// MDArraySyncStatusType is the type of MDArraySyncStatus resource.
+const MDArraySyncStatusType = resource.Type("MDArraySyncStatuses.storage.talos.dev")
+
+// MDArraySyncStatus is the sync progress and health of an MD (software RAID) array.
+//
+// Unlike MDArrayStatus, it covers every array known to the kernel (including
+// ones not declared in the machine config), keyed by the kernel device name (e.g. md0).
+type MDArraySyncStatus = typed.Resource[MDArraySyncStatusSpec, MDArraySyncStatusExtension]
+
+// MDArraySyncStatusSpec is the spec for MDArraySyncStatus resource.
+//
+//gotagsrewrite:gen
+type MDArraySyncStatusSpec struct {
+	// Device is the /dev/mdN node of the array.
+	Device string `yaml:"device" protobuf:"1"`
+	// Level is the sysfs level value, e.g. raid1.
+	Level string `yaml:"level" protobuf:"2"`
+	// ArrayState is the current sysfs array_state value.
+	ArrayState string `yaml:"arrayState" protobuf:"3"`
+	// SyncAction is the current sysfs sync_action value.
+	SyncAction string `yaml:"syncAction,omitempty" protobuf:"4"`
+	// SyncCompletedSectors is the number of sectors processed by the running sync operation.
+	SyncCompletedSectors uint64 `yaml:"syncCompletedSectors,omitempty" protobuf:"5"`
+	// SyncTotalSectors is the number of sectors the running sync operation covers.
+	SyncTotalSectors uint64 `yaml:"syncTotalSectors,omitempty" protobuf:"6"`
+	// SyncProgress is the progress of the running sync operation in percent.
+	SyncProgress float64 `yaml:"syncProgress,omitempty" protobuf:"7"`
+	// MismatchCount is the number of mismatched sectors found by the last check or repair.
+	MismatchCount uint64 `yaml:"mismatchCount" protobuf:"8"`
+	// RaidDevices is the number of member slots of the array.
+	RaidDevices int `yaml:"raidDevices" protobuf:"9"`
+	// DegradedDevices is the number of member slots missing a working device.
+	DegradedDevices int `yaml:"degradedDevices" protobuf:"10"`
+	// Degraded is set when at least one member slot is missing a working device.
+	Degraded bool `yaml:"degraded" protobuf:"11"`
+}
+
+// NewMDArraySyncStatus initializes an MDArraySyncStatus resource.
+func NewMDArraySyncStatus(namespace resource.Namespace, id resource.ID) *MDArraySyncStatus {
+	return typed.NewResource[MDArraySyncStatusSpec, MDArraySyncStatusExtension](
+		resource.NewMetadata(namespace, MDArraySyncStatusType, id, resource.VersionUndefined),
+		MDArraySyncStatusSpec{},
+	)
+}
+
+// MDArraySyncStatusExtension is auxiliary resource data for MDArraySyncStatus.
+type MDArraySyncStatusExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (MDArraySyncStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MDArraySyncStatusType,
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{Name: "Level", JSONPath: "{.level}"},
+			{Name: "State", JSONPath: "{.arrayState}"},
+			{Name: "Degraded", JSONPath: "{.degraded}"},
+			{Name: "Sync", JSONPath: "{.syncAction}"},
+			{Name: "Progress", JSONPath: "{.syncProgress}"},
+			{Name: "Mismatches", JSONPath: "{.mismatchCount}"},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic(MDArraySyncStatusType, &MDArraySyncStatus{}); err != nil {
+		panic(err)
+	}
+}
@@ -11,7 +11,7 @@ import (
 
 //go:generate go tool github.com/dmarkham/enumer -type=LVMLogicalVolumeType,MDLevel,MDMetadata,MDArrayPhase -linecomment -text
 
-//go:generate go tool github.com/siderolabs/deep-copy -type LVMLogicalVolumeSpecSpec -type LVMLogicalVolumeStatusSpec -type LVMPhysicalVolumeSpecSpec -type LVMPhysicalVolumeStatusSpec -type LVMRefreshRequestSpec -type LVMValidationErrorSpec -type LVMVolumeGroupSpecSpec -type LVMVolumeGroupStatusSpec -type MDArraySpecSpec -type MDArrayStatusSpec -type MDRefreshRequestSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate go tool github.com/siderolabs/deep-copy -type LVMLogicalVolumeSpecSpec -type LVMLogicalVolumeStatusSpec -type LVMPhysicalVolumeSpecSpec -type LVMPhysicalVolumeStatusSpec -type LVMRefreshRequestSpec -type LVMValidationErrorSpec -type LVMVolumeGroupSpecSpec -type LVMVolumeGroupStatusSpec -type MDArraySpecSpec -type MDArrayStatusSpec -type MDArraySyncStatusSpec -type MDRefreshRequestSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
 // NamespaceName contains storage resources.
 const NamespaceName resource.Namespace = "storage"
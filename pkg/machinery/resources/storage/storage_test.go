@@ -29,6 +29,7 @@ func TestRegisterResource(t *testing.T) {
 		&storage.LVMPhysicalVolumeStatus{},
 		&storage.LVMRefreshRequest{},
 		&storage.MDRefreshRequest{},
+		&storage.MDArraySyncStatus{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdjtimeStatusSpec -type NTPStatusSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdjtimeStatusSpec -type NTPServerConfigSpec -type NTPStatusSpec -type StatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package time
 
@@ -12,6 +12,20 @@ func (o AdjtimeStatusSpec) DeepCopy() AdjtimeStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NTPServerConfigSpec.
+func (o NTPServerConfigSpec) DeepCopy() NTPServerConfigSpec {
+	var cp NTPServerConfigSpec = o
+	if o.NTSCertificate != nil {
+		cp.NTSCertificate = make([]byte, len(o.NTSCertificate))
+		copy(cp.NTSCertificate, o.NTSCertificate)
+	}
+	if o.NTSKey != nil {
+		cp.NTSKey = make([]byte, len(o.NTSKey))
+		copy(cp.NTSKey, o.NTSKey)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NTPStatusSpec.
 func (o NTPStatusSpec) DeepCopy() NTPStatusSpec {
 	var cp NTPStatusSpec = o
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package time
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// NTPServerConfigType is type of NTPServerConfig resource.
+const NTPServerConfigType = resource.Type("NTPServerConfigs.v1alpha1.talos.dev")
+
+// NTPServerConfigID is the ID of the singleton resource.
+const NTPServerConfigID = resource.ID("ntp-server")
+
+// NTPServerConfig describes the configuration of the built-in NTP server.
+type NTPServerConfig = typed.Resource[NTPServerConfigSpec, NTPServerConfigExtension]
+
+// NTPServerConfigSpec describes the configuration of the built-in NTP server.
+//
+//gotagsrewrite:gen
+type NTPServerConfigSpec struct {
+	ListenAddress string `yaml:"listenAddress" protobuf:"1"`
+
+	// NTSListenAddress is the address of the NTS-KE server, empty if NTS is disabled.
+	NTSListenAddress string `yaml:"ntsListenAddress,omitempty" protobuf:"2"`
+	NTSCertificate   []byte `yaml:"ntsCertificate,omitempty" protobuf:"3"`
+	NTSKey           []byte `yaml:"ntsKey,omitempty" protobuf:"4"`
+}
+
+// NewNTPServerConfig initializes a NTPServerConfig resource.
+func NewNTPServerConfig() *NTPServerConfig {
+	return typed.NewResource[NTPServerConfigSpec, NTPServerConfigExtension](
+		resource.NewMetadata(v1alpha1.NamespaceName, NTPServerConfigType, NTPServerConfigID, resource.VersionUndefined),
+		NTPServerConfigSpec{},
+	)
+}
+
+// NTPServerConfigExtension provides auxiliary methods for NTPServerConfig.
+type NTPServerConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (NTPServerConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NTPServerConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: v1alpha1.NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Listen Address",
+				JSONPath: "{.listenAddress}",
+			},
+			{
+				Name:     "NTS Listen Address",
+				JSONPath: "{.ntsListenAddress}",
+			},
+		},
+		// carries the NTS-KE server key
+		Sensitivity: meta.Sensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[NTPServerConfigSpec](NTPServerConfigType, &NTPServerConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -5,6 +5,8 @@
 package time
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -22,9 +24,9 @@ const NTPStatusID = resource.ID("node")
 
 // NTPStatus describes the state of the NTP client.
 //
-// This resource is purely observational: it is updated on every NTP poll, and
-// it is deliberately not an input to any other controller, so that the churn
-// doesn't trigger reconciliation elsewhere (see [Status] instead).
+// This resource is updated on every NTP poll, so the only controller using it as an input
+// is the NTP server, which announces the time source state to its clients: other controllers
+// should watch [Status] instead, so that the churn doesn't trigger reconciliation elsewhere.
 type NTPStatus = typed.Resource[NTPStatusSpec, NTPStatusExtension]
 
 // NTPStatusSpec describes the state of the NTP client.
@@ -39,6 +41,20 @@ type NTPStatusSpec struct {
 
 	// ConsecutiveSpikes is the number of time measurements discarded in a row by the spike filter.
 	ConsecutiveSpikes int `yaml:"consecutiveSpikes" protobuf:"2"`
+
+	// Stratum is the stratum of the local clock: the stratum of the time source plus one,
+	// or zero if the clock is not synchronized to a time source.
+	Stratum int `yaml:"stratum" protobuf:"3"`
+	// Leap is the leap second indicator announced by the time source.
+	Leap int `yaml:"leap" protobuf:"4"`
+	// ReferenceID identifies the time source, as announced in the NTP reference ID field.
+	ReferenceID uint32 `yaml:"referenceID" protobuf:"5"`
+	// ReferenceTime is the time the clock was last synchronized.
+	ReferenceTime time.Time `yaml:"referenceTime" protobuf:"6"`
+	// RootDelay is the total round-trip delay to the reference clock.
+	RootDelay time.Duration `yaml:"rootDelay" protobuf:"7"`
+	// RootDispersion is the total dispersion to the reference clock at the reference time.
+	RootDispersion time.Duration `yaml:"rootDispersion" protobuf:"8"`
 }
 
 // NewNTPStatus initializes an NTPStatus resource.
@@ -67,6 +83,10 @@ func (NTPStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Consecutive Spikes",
 				JSONPath: "{.consecutiveSpikes}",
 			},
+			{
+				Name:     "Stratum",
+				JSONPath: "{.stratum}",
+			},
 		},
 	}
 }